@@ -14,6 +14,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common/file"
 	"github.com/thrasher-corp/gocryptotrader/encoding/json"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/log"
 )
 
@@ -213,6 +214,12 @@ func (c *Config) validateCurrencySettings() error {
 			return errBadSlippageRates
 		}
 		c.CurrencySettings[i].ExchangeName = strings.ToLower(c.CurrencySettings[i].ExchangeName)
+		if err := c.validateAuxiliaryData(&c.CurrencySettings[i]); err != nil {
+			return err
+		}
+		if err := c.validateFuturesDetails(&c.CurrencySettings[i]); err != nil {
+			return err
+		}
 	}
 	if hasSlippage && hasFutures {
 		return fmt.Errorf("%w futures sizing currently incompatible with slippage", errFeatureIncompatible)
@@ -220,6 +227,87 @@ func (c *Config) validateCurrencySettings() error {
 	return nil
 }
 
+// validateAuxiliaryData defaults any unset auxiliary data fields to those of
+// the currency settings and ensures each entry is unique
+func (c *Config) validateAuxiliaryData(cs *CurrencySettings) error {
+	if len(cs.AuxiliaryData) == 0 {
+		return nil
+	}
+	if c.DataSettings.LiveData != nil {
+		return fmt.Errorf("%w auxiliary data cannot be used with live data", errFeatureIncompatible)
+	}
+	for i := range cs.AuxiliaryData {
+		aux := &cs.AuxiliaryData[i]
+		if aux.ExchangeName == "" {
+			aux.ExchangeName = cs.ExchangeName
+		}
+		aux.ExchangeName = strings.ToLower(aux.ExchangeName)
+		if aux.Asset == asset.Empty {
+			aux.Asset = cs.Asset
+		}
+		if !aux.Asset.IsValid() {
+			return fmt.Errorf("auxiliary data %v %w", aux.Asset, asset.ErrNotSupported)
+		}
+		if aux.Base.IsEmpty() && aux.Quote.IsEmpty() {
+			aux.Base, aux.Quote = cs.Base, cs.Quote
+		}
+		if aux.Base.IsEmpty() {
+			return fmt.Errorf("auxiliary data %w", errUnsetCurrency)
+		}
+		if aux.Interval == 0 {
+			aux.Interval = c.DataSettings.Interval
+		}
+		if aux.Interval <= 0 {
+			return fmt.Errorf("auxiliary data %w %v", kline.ErrInvalidInterval, aux.Interval)
+		}
+		if c.DataSettings.CSVData != nil && aux.CSVFullPath == "" {
+			return fmt.Errorf("%w for %v %v %v-%v %v", errAuxiliaryCSVPathUnset, aux.ExchangeName, aux.Asset, aux.Base, aux.Quote, aux.Interval)
+		}
+		if aux.ExchangeName == cs.ExchangeName &&
+			aux.Asset == cs.Asset &&
+			aux.Base.Equal(cs.Base) &&
+			aux.Quote.Equal(cs.Quote) &&
+			aux.Interval == c.DataSettings.Interval {
+			return fmt.Errorf("%w %v %v %v-%v %v is the traded data", errDuplicateAuxiliaryData, aux.ExchangeName, aux.Asset, aux.Base, aux.Quote, aux.Interval)
+		}
+		for j := range i {
+			if cs.AuxiliaryData[j].ExchangeName == aux.ExchangeName &&
+				cs.AuxiliaryData[j].Asset == aux.Asset &&
+				cs.AuxiliaryData[j].Base.Equal(aux.Base) &&
+				cs.AuxiliaryData[j].Quote.Equal(aux.Quote) &&
+				cs.AuxiliaryData[j].Interval == aux.Interval {
+				return fmt.Errorf("%w %v %v %v-%v %v", errDuplicateAuxiliaryData, aux.ExchangeName, aux.Asset, aux.Base, aux.Quote, aux.Interval)
+			}
+		}
+	}
+	return nil
+}
+
+// validateFuturesDetails ensures margin rates are within bounds and that
+// funding rates can be retrieved for the configured data source
+func (c *Config) validateFuturesDetails(cs *CurrencySettings) error {
+	if cs.FuturesDetails == nil {
+		return nil
+	}
+	fd := cs.FuturesDetails
+	if fd.MaintenanceMarginRate.IsNegative() || fd.MaintenanceMarginRate.GreaterThanOrEqual(decimal.NewFromInt(1)) {
+		return fmt.Errorf("maintenance %w: %v", errInvalidMarginRate, fd.MaintenanceMarginRate)
+	}
+	if fd.LiquidationFeeRate.IsNegative() || fd.LiquidationFeeRate.GreaterThanOrEqual(decimal.NewFromInt(1)) {
+		return fmt.Errorf("liquidation fee %w: %v", errInvalidMarginRate, fd.LiquidationFeeRate)
+	}
+	if !fd.ApplyFundingRates {
+		return nil
+	}
+	if !cs.Asset.IsFutures() {
+		return fmt.Errorf("%w funding rates cannot be applied to %v", errFeatureIncompatible, cs.Asset)
+	}
+	if c.DataSettings.APIData == nil && c.DataSettings.DatabaseData == nil {
+		return fmt.Errorf("%w funding rates require api or database data", errFeatureIncompatible)
+	}
+	return nil
+}
+
 // PrintSetting prints relevant settings to the console for easy reading
 func (c *Config) PrintSetting() {
 	log.Infoln(common.Config, common.CMDColours.H1+"------------------Backtester Settings------------------------"+common.CMDColours.Default)
@@ -235,6 +323,9 @@ func (c *Config) PrintSetting() {
 	}
 	log.Infof(common.Config, "Simultaneous Signal Processing: %v", c.StrategySettings.SimultaneousSignalProcessing)
 	log.Infof(common.Config, "USD value tracking: %v", !c.StrategySettings.DisableUSDTracking)
+	if c.StrategySettings.FXRatesPath != "" {
+		log.Infof(common.Config, "Historical FX rates: %v", c.StrategySettings.FXRatesPath)
+	}
 
 	if c.FundingSettings.UseExchangeLevelFunding && c.StrategySettings.SimultaneousSignalProcessing {
 		log.Infoln(common.Config, common.CMDColours.H2+"------------------Funding Settings---------------------------"+common.CMDColours.Default)
@@ -295,7 +386,20 @@ func (c *Config) PrintSetting() {
 		if c.CurrencySettings[i].FuturesDetails != nil && c.CurrencySettings[i].Asset == asset.Futures {
 			log.Infof(common.Config, "Leverage rules: %+v", c.CurrencySettings[i].FuturesDetails.Leverage)
 		}
+		if c.CurrencySettings[i].FuturesDetails != nil && c.CurrencySettings[i].Asset.IsFutures() {
+			log.Infof(common.Config, "Maintenance margin rate: %v", c.CurrencySettings[i].FuturesDetails.MaintenanceMarginRate)
+			log.Infof(common.Config, "Liquidation fee rate: %v", c.CurrencySettings[i].FuturesDetails.LiquidationFeeRate)
+			log.Infof(common.Config, "Apply funding rates: %v", c.CurrencySettings[i].FuturesDetails.ApplyFundingRates)
+		}
 		log.Infof(common.Config, "Can use exchange defined order execution limits: %+v", c.CurrencySettings[i].CanUseExchangeLimits)
+		for j := range c.CurrencySettings[i].AuxiliaryData {
+			log.Infof(common.Config, "Auxiliary data: %v %v %v-%v %v",
+				c.CurrencySettings[i].AuxiliaryData[j].ExchangeName,
+				c.CurrencySettings[i].AuxiliaryData[j].Asset,
+				c.CurrencySettings[i].AuxiliaryData[j].Base,
+				c.CurrencySettings[i].AuxiliaryData[j].Quote,
+				c.CurrencySettings[i].AuxiliaryData[j].Interval)
+		}
 	}
 
 	log.Infoln(common.Config, common.CMDColours.H2+"------------------Portfolio Settings-------------------------"+common.CMDColours.Default)
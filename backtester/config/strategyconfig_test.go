@@ -8,6 +8,7 @@ import (
 
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/base"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/top2bottom2"
@@ -177,6 +178,78 @@ func TestValidateCurrencySettings(t *testing.T) {
 	assert.ErrorIs(t, err, errBadInitialFunds)
 }
 
+func TestValidateAuxiliaryData(t *testing.T) {
+	t.Parallel()
+	c := Config{DataSettings: DataSettings{Interval: kline.OneHour}}
+	cs := &CurrencySettings{
+		ExchangeName: mainExchange,
+		Asset:        asset.Spot,
+		Base:         mainCurrencyPair.Base,
+		Quote:        mainCurrencyPair.Quote,
+	}
+	require.NoError(t, c.validateAuxiliaryData(cs), "validateAuxiliaryData must not error without auxiliary data")
+
+	cs.AuxiliaryData = []AuxiliaryData{{}}
+	err := c.validateAuxiliaryData(cs)
+	assert.ErrorIs(t, err, errDuplicateAuxiliaryData)
+
+	cs.AuxiliaryData = []AuxiliaryData{{Interval: -1}}
+	err = c.validateAuxiliaryData(cs)
+	assert.ErrorIs(t, err, kline.ErrInvalidInterval)
+
+	cs.AuxiliaryData = []AuxiliaryData{{Base: currency.EMPTYCODE, Quote: currency.USDT, Interval: kline.OneDay}}
+	err = c.validateAuxiliaryData(cs)
+	assert.ErrorIs(t, err, errUnsetCurrency)
+
+	cs.AuxiliaryData = []AuxiliaryData{{Interval: kline.OneDay}, {Base: currency.ETH, Quote: currency.USDT}, {Interval: kline.OneDay}}
+	err = c.validateAuxiliaryData(cs)
+	assert.ErrorIs(t, err, errDuplicateAuxiliaryData)
+
+	cs.AuxiliaryData = cs.AuxiliaryData[:2]
+	require.NoError(t, c.validateAuxiliaryData(cs), "validateAuxiliaryData must not error")
+	assert.Equal(t, AuxiliaryData{ExchangeName: mainExchange, Asset: asset.Spot, Base: mainCurrencyPair.Base, Quote: mainCurrencyPair.Quote, Interval: kline.OneDay}, cs.AuxiliaryData[0], "unset fields should default to the currency settings")
+	assert.Equal(t, kline.OneHour, cs.AuxiliaryData[1].Interval, "unset interval should default to the data settings interval")
+
+	c.DataSettings.CSVData = &CSVData{}
+	err = c.validateAuxiliaryData(cs)
+	assert.ErrorIs(t, err, errAuxiliaryCSVPathUnset)
+
+	c.DataSettings.CSVData = nil
+	c.DataSettings.LiveData = &LiveData{}
+	err = c.validateAuxiliaryData(cs)
+	assert.ErrorIs(t, err, errFeatureIncompatible)
+}
+
+func TestValidateFuturesDetails(t *testing.T) {
+	t.Parallel()
+	c := Config{}
+	cs := &CurrencySettings{Asset: asset.Spot}
+	require.NoError(t, c.validateFuturesDetails(cs), "validateFuturesDetails must not error without futures details")
+
+	cs.FuturesDetails = &FuturesDetails{MaintenanceMarginRate: decimal.NewFromInt(-1)}
+	err := c.validateFuturesDetails(cs)
+	assert.ErrorIs(t, err, errInvalidMarginRate)
+
+	cs.FuturesDetails = &FuturesDetails{LiquidationFeeRate: decimal.NewFromInt(1)}
+	err = c.validateFuturesDetails(cs)
+	assert.ErrorIs(t, err, errInvalidMarginRate)
+
+	cs.FuturesDetails = &FuturesDetails{
+		MaintenanceMarginRate: decimal.NewFromFloat(0.004),
+		LiquidationFeeRate:    decimal.NewFromFloat(0.0125),
+		ApplyFundingRates:     true,
+	}
+	err = c.validateFuturesDetails(cs)
+	assert.ErrorIs(t, err, errFeatureIncompatible)
+
+	cs.Asset = asset.USDTMarginedFutures
+	err = c.validateFuturesDetails(cs)
+	assert.ErrorIs(t, err, errFeatureIncompatible)
+
+	c.DataSettings.APIData = &APIData{}
+	assert.NoError(t, c.validateFuturesDetails(cs), "validateFuturesDetails should not error with api data")
+}
+
 func TestValidateMinMaxes(t *testing.T) {
 	t.Parallel()
 	c := &Config{}
@@ -26,6 +26,9 @@ var (
 	errMinMaxEqual                      = errors.New("minimum and maximum limits cannot be equal")
 	errPerpetualsUnsupported            = errors.New("perpetual futures not yet supported")
 	errFeatureIncompatible              = errors.New("feature is not compatible")
+	errDuplicateAuxiliaryData           = errors.New("auxiliary data duplicates existing data")
+	errAuxiliaryCSVPathUnset            = errors.New("auxiliary data requires a csv file path when loading csv data")
+	errInvalidMarginRate                = errors.New("margin rate must be zero or greater and less than one")
 )
 
 // Config defines what is in an individual strategy config
@@ -67,8 +70,11 @@ type StrategySettings struct {
 
 	// If true, won't track USD values against currency pair
 	// bool language is opposite to encourage use by default
-	DisableUSDTracking bool           `json:"disable-usd-tracking"`
-	CustomSettings     map[string]any `json:"custom-settings,omitempty"`
+	DisableUSDTracking bool `json:"disable-usd-tracking"`
+	// FXRatesPath is a csv file of historical fx rates, allowing pairs
+	// quoted in fiat currencies other than USD to be tracked against USD
+	FXRatesPath    string         `json:"fx-rates-path,omitempty"`
+	CustomSettings map[string]any `json:"custom-settings,omitempty"`
 }
 
 // ExchangeLevelFunding allows the portfolio manager to access
@@ -153,6 +159,24 @@ type CurrencySettings struct {
 	CanUseExchangeLimits          bool `json:"use-exchange-order-limits"`
 	ShowExchangeOrderLimitWarning bool `json:"-"`
 	UseExchangePNLCalculation     bool `json:"use-exchange-pnl-calculation"`
+
+	AuxiliaryData []AuxiliaryData `json:"auxiliary-data,omitempty"`
+}
+
+// AuxiliaryData defines additional candle data which a strategy can read
+// alongside the currency settings it is attached to, such as a higher
+// timeframe or a correlated pair. It is only used for signalling and is never
+// traded. An unset exchange, asset, base or quote defaults to that of the
+// currency settings and an unset interval defaults to the data settings interval
+type AuxiliaryData struct {
+	ExchangeName string         `json:"exchange-name"`
+	Asset        asset.Item     `json:"asset"`
+	Base         currency.Code  `json:"base"`
+	Quote        currency.Code  `json:"quote"`
+	Interval     kline.Interval `json:"interval"`
+	// CSVFullPath is required when data settings use CSV data as each file
+	// only contains one series
+	CSVFullPath string `json:"csv-full-path,omitempty"`
 }
 
 // SpotDetails contains funding information that cannot be shared with another
@@ -165,6 +189,17 @@ type SpotDetails struct {
 // FuturesDetails contains data relevant to futures currency pairs
 type FuturesDetails struct {
 	Leverage Leverage `json:"leverage"`
+	// MaintenanceMarginRate is the ratio of position notional value which must
+	// remain as collateral. When set, positions are liquidated at the price
+	// where equity falls below maintenance margin rather than when collateral
+	// is exhausted
+	MaintenanceMarginRate decimal.Decimal `json:"maintenance-margin-rate"`
+	// LiquidationFeeRate is the ratio of position notional value charged when
+	// a position is liquidated
+	LiquidationFeeRate decimal.Decimal `json:"liquidation-fee-rate"`
+	// ApplyFundingRates retrieves historical funding rates for the contract
+	// and settles funding payments against collateral for open positions
+	ApplyFundingRates bool `json:"apply-funding-rates"`
 }
 
 // APIData defines all fields to configure API based data
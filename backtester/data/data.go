@@ -10,6 +10,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common/key"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 )
 
 // NewHandlerHolder returns a new HandlerHolder
@@ -100,6 +101,7 @@ func (b *Base) Reset() error {
 	b.latest = nil
 	b.offset = 0
 	b.isLiveData = false
+	b.auxiliary = nil
 	return nil
 }
 
@@ -303,6 +305,117 @@ func (b *Base) SetLive(isLive bool) error {
 	return nil
 }
 
+// NewAuxiliary returns auxiliary data for a single exchange, asset and pair
+// at the supplied interval, sorted by time
+func NewAuxiliary(interval kline.Interval, s Events) (*Auxiliary, error) {
+	if interval <= 0 {
+		return nil, fmt.Errorf("%w %v", kline.ErrInvalidInterval, interval)
+	}
+	if len(s) == 0 {
+		return nil, ErrEmptySlice
+	}
+	stream := make(Events, len(s))
+	copy(stream, s)
+	for x := range stream {
+		if stream[x] == nil {
+			return nil, fmt.Errorf("%w Event", gctcommon.ErrNilPointer)
+		}
+		if stream[x].GetExchange() == "" || !stream[x].GetAssetType().IsValid() || stream[x].Pair().IsEmpty() || stream[x].GetTime().IsZero() {
+			return nil, ErrInvalidEventSupplied
+		}
+		if stream[x].GetExchange() != stream[0].GetExchange() ||
+			stream[x].GetAssetType() != stream[0].GetAssetType() ||
+			!stream[x].Pair().Equal(stream[0].Pair()) {
+			return nil, fmt.Errorf("%w cannot set auxiliary stream from %v %v %v to %v %v %v", errMismatchedEvent, stream[x].GetExchange(), stream[x].GetAssetType(), stream[x].Pair(), stream[0].GetExchange(), stream[0].GetAssetType(), stream[0].Pair())
+		}
+	}
+	sort.Slice(stream, func(i, j int) bool {
+		return stream[i].GetTime().Before(stream[j].GetTime())
+	})
+	return &Auxiliary{
+		Exchange: stream[0].GetExchange(),
+		Asset:    stream[0].GetAssetType(),
+		Pair:     stream[0].Pair(),
+		Interval: interval,
+		stream:   stream,
+	}, nil
+}
+
+// AddAuxiliaryData attaches signal only data to the Base which can be
+// retrieved via GetAuxiliaryData
+func (b *Base) AddAuxiliaryData(aux ...*Auxiliary) error {
+	if b == nil {
+		return fmt.Errorf("%w Base", gctcommon.ErrNilPointer)
+	}
+	b.m.Lock()
+	defer b.m.Unlock()
+	for x := range aux {
+		if aux[x] == nil {
+			return fmt.Errorf("%w Auxiliary", gctcommon.ErrNilPointer)
+		}
+		if b.getAuxiliary(aux[x].Exchange, aux[x].Asset, aux[x].Pair, aux[x].Interval) != nil {
+			return fmt.Errorf("%w for %v %v %v %v", errAuxiliaryExists, aux[x].Exchange, aux[x].Asset, aux[x].Pair, aux[x].Interval)
+		}
+		b.auxiliary = append(b.auxiliary, aux[x])
+	}
+	return nil
+}
+
+// GetAuxiliaryData returns the auxiliary events which have closed by the close
+// of the latest event, ensuring a strategy cannot look ahead when comparing
+// timeframes. An unset exchange, asset or pair defaults to that of the Base
+func (b *Base) GetAuxiliaryData(exch string, a asset.Item, p currency.Pair, interval kline.Interval) (Events, error) {
+	if b == nil {
+		return nil, fmt.Errorf("%w Base", gctcommon.ErrNilPointer)
+	}
+	b.m.Lock()
+	defer b.m.Unlock()
+
+	ref := b.latest
+	if ref == nil && len(b.stream) > 0 {
+		ref = b.stream[0]
+	}
+	if ref != nil {
+		if exch == "" {
+			exch = ref.GetExchange()
+		}
+		if a == asset.Empty {
+			a = ref.GetAssetType()
+		}
+		if p.IsEmpty() {
+			p = ref.Pair()
+		}
+	}
+	aux := b.getAuxiliary(exch, a, p, interval)
+	if aux == nil {
+		return nil, fmt.Errorf("%w for %v %v %v %v", ErrAuxiliaryDataNotFound, exch, a, p, interval)
+	}
+	if b.latest == nil {
+		return Events{}, nil
+	}
+	closeTime := b.latest.GetTime().Add(b.latest.GetInterval().Duration())
+	idx := sort.Search(len(aux.stream), func(i int) bool {
+		return aux.stream[i].GetTime().Add(aux.Interval.Duration()).After(closeTime)
+	})
+	stream := make(Events, idx)
+	copy(stream, aux.stream[:idx])
+	return stream, nil
+}
+
+// getAuxiliary returns matching auxiliary data. Must be called with the lock
+// held
+func (b *Base) getAuxiliary(exch string, a asset.Item, p currency.Pair, interval kline.Interval) *Auxiliary {
+	for x := range b.auxiliary {
+		if strings.EqualFold(b.auxiliary[x].Exchange, exch) &&
+			b.auxiliary[x].Asset == a &&
+			b.auxiliary[x].Pair.Equal(p) &&
+			b.auxiliary[x].Interval == interval {
+			return b.auxiliary[x]
+		}
+	}
+	return nil
+}
+
 // First returns the first element of a slice
 func (e Events) First() (Event, error) {
 	if len(e) == 0 {
@@ -586,6 +586,85 @@ func TestLast(t *testing.T) {
 	assert.Equal(t, id3, last.GetOffset())
 }
 
+func TestNewAuxiliary(t *testing.T) {
+	t.Parallel()
+	_, err := NewAuxiliary(0, nil)
+	assert.ErrorIs(t, err, gctkline.ErrInvalidInterval)
+
+	_, err = NewAuxiliary(gctkline.OneHour, nil)
+	assert.ErrorIs(t, err, ErrEmptySlice)
+
+	_, err = NewAuxiliary(gctkline.OneHour, Events{nil})
+	assert.ErrorIs(t, err, gctcommon.ErrNilPointer)
+
+	_, err = NewAuxiliary(gctkline.OneHour, Events{&fakeEvent{Base: &event.Base{}}})
+	assert.ErrorIs(t, err, ErrInvalidEventSupplied)
+
+	tt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	_, err = NewAuxiliary(gctkline.OneHour, Events{
+		&fakeEvent{Base: &event.Base{Exchange: exch, AssetType: a, CurrencyPair: p, Time: tt}},
+		&fakeEvent{Base: &event.Base{Exchange: "bitstamp", AssetType: a, CurrencyPair: p, Time: tt.Add(time.Hour)}},
+	})
+	assert.ErrorIs(t, err, errMismatchedEvent)
+
+	aux, err := NewAuxiliary(gctkline.OneHour, Events{
+		&fakeEvent{Base: &event.Base{Exchange: exch, AssetType: a, CurrencyPair: p, Time: tt.Add(time.Hour)}},
+		&fakeEvent{Base: &event.Base{Exchange: exch, AssetType: a, CurrencyPair: p, Time: tt}},
+	})
+	require.NoError(t, err, "NewAuxiliary must not error")
+	assert.Equal(t, exch, aux.Exchange, "Exchange should be set from the events")
+	assert.Equal(t, a, aux.Asset, "Asset should be set from the events")
+	assert.Equal(t, p, aux.Pair, "Pair should be set from the events")
+	assert.Equal(t, tt, aux.stream[0].GetTime(), "stream should be sorted by time")
+}
+
+func TestAuxiliaryData(t *testing.T) {
+	t.Parallel()
+	var b *Base
+	assert.ErrorIs(t, b.AddAuxiliaryData(), gctcommon.ErrNilPointer)
+	_, err := b.GetAuxiliaryData(exch, a, p, gctkline.OneHour)
+	assert.ErrorIs(t, err, gctcommon.ErrNilPointer)
+
+	tt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	b = &Base{}
+	var primary Events
+	for i := range 15 {
+		primary = append(primary, &fakeEvent{Base: &event.Base{Exchange: exch, AssetType: a, CurrencyPair: p, Interval: gctkline.OneMin, Time: tt.Add(time.Duration(i) * time.Minute)}})
+	}
+	require.NoError(t, b.SetStream(primary), "SetStream must not error")
+
+	var aggregated Events
+	for i := range 3 {
+		aggregated = append(aggregated, &fakeEvent{Base: &event.Base{Exchange: exch, AssetType: a, CurrencyPair: p, Interval: gctkline.FiveMin, Time: tt.Add(time.Duration(i) * gctkline.FiveMin.Duration())}})
+	}
+	aux, err := NewAuxiliary(gctkline.FiveMin, aggregated)
+	require.NoError(t, err, "NewAuxiliary must not error")
+
+	assert.ErrorIs(t, b.AddAuxiliaryData(nil), gctcommon.ErrNilPointer)
+	require.NoError(t, b.AddAuxiliaryData(aux), "AddAuxiliaryData must not error")
+	assert.ErrorIs(t, b.AddAuxiliaryData(aux), errAuxiliaryExists)
+
+	_, err = b.GetAuxiliaryData(exch, a, p, gctkline.OneHour)
+	assert.ErrorIs(t, err, ErrAuxiliaryDataNotFound)
+
+	resp, err := b.GetAuxiliaryData("", asset.Empty, currency.EMPTYPAIR, gctkline.FiveMin)
+	require.NoError(t, err, "GetAuxiliaryData must not error")
+	assert.Empty(t, resp, "GetAuxiliaryData should not return data before the first event")
+
+	for i := range 15 {
+		_, err = b.Next()
+		require.NoError(t, err, "Next must not error")
+		resp, err = b.GetAuxiliaryData(strings.ToUpper(exch), a, p, gctkline.FiveMin)
+		require.NoError(t, err, "GetAuxiliaryData must not error")
+		// a five minute candle only closes alongside the fifth one minute candle it spans
+		assert.Lenf(t, resp, (i+1)/5, "GetAuxiliaryData should only return closed candles at primary event %v", i)
+	}
+
+	require.NoError(t, b.Reset(), "Reset must not error")
+	_, err = b.GetAuxiliaryData(exch, a, p, gctkline.FiveMin)
+	assert.ErrorIs(t, err, ErrAuxiliaryDataNotFound)
+}
+
 func (f fakeEvent) GetOffset() int64 {
 	if f.secretID > 0 {
 		return f.secretID
@@ -737,3 +816,11 @@ func (f fakeHandler) Reset() error {
 func (f fakeHandler) GetDetails() (string, asset.Item, currency.Pair, error) {
 	return "", asset.Empty, currency.EMPTYPAIR, nil
 }
+
+func (f fakeHandler) AddAuxiliaryData(...*Auxiliary) error {
+	return nil
+}
+
+func (f fakeHandler) GetAuxiliaryData(string, asset.Item, currency.Pair, gctkline.Interval) (Events, error) {
+	return nil, nil
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common/key"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 )
 
 var (
@@ -21,9 +22,12 @@ var (
 	ErrEmptySlice = errors.New("empty slice")
 	// ErrEndOfData is returned when attempting to load the next offset when there is no more
 	ErrEndOfData = errors.New("no more data to retrieve")
+	// ErrAuxiliaryDataNotFound is returned when auxiliary data has not been added for the requested details
+	ErrAuxiliaryDataNotFound = errors.New("auxiliary data not found")
 
 	errNothingToAdd    = errors.New("cannot append empty event to stream")
 	errMismatchedEvent = errors.New("cannot add event to stream, does not match")
+	errAuxiliaryExists = errors.New("auxiliary data already added")
 )
 
 // HandlerHolder stores an event handler per exchange asset pair
@@ -48,12 +52,26 @@ type Base struct {
 	stream     []Event
 	offset     int64
 	isLiveData bool
+	auxiliary  []*Auxiliary
+}
+
+// Auxiliary holds data for an exchange, asset, pair and interval which is
+// attached to a Handler to be used as a signal only. It is never traded and
+// its events are only made available once they have closed relative to the
+// latest event of the Handler
+type Auxiliary struct {
+	Exchange string
+	Asset    asset.Item
+	Pair     currency.Pair
+	Interval kline.Interval
+	stream   Events
 }
 
 // Handler interface for Loading and Streaming Data
 type Handler interface {
 	Loader
 	Streamer
+	AuxiliaryStreamer
 	GetDetails() (string, asset.Item, currency.Pair, error)
 	Reset() error
 }
@@ -83,6 +101,13 @@ type Streamer interface {
 	HasDataAtTime(time.Time) (bool, error)
 }
 
+// AuxiliaryStreamer allows strategies to read additional timeframes or pairs
+// alongside the data being traded without looking ahead
+type AuxiliaryStreamer interface {
+	AddAuxiliaryData(...*Auxiliary) error
+	GetAuxiliaryData(exch string, a asset.Item, p currency.Pair, interval kline.Interval) (Events, error)
+}
+
 // Event interface used for loading and interacting with Data
 type Event interface {
 	common.Event
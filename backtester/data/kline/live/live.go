@@ -87,3 +87,25 @@ func LoadData(ctx context.Context, timeToRetrieve time.Time, exch exchange.IBotE
 	candles.UnderlyingPair = underlyingPair
 	return candles, nil
 }
+
+// UpdateIndicators pushes candles retrieved by LoadData into streaming
+// indicators so a strategy can keep indicator state between live intervals
+// rather than recalculating over the entire data history. Repeat retrievals of
+// the same interval amend the latest candle processed by each indicator.
+func UpdateIndicators(candles *kline.Item, indicators ...kline.StreamingIndicator) error {
+	if candles == nil {
+		return fmt.Errorf("%w kline item", gctcommon.ErrNilPointer)
+	}
+	candles.SortCandlesByTimestamp(false)
+	for _, ind := range indicators {
+		if ind == nil {
+			return fmt.Errorf("%w streaming indicator", gctcommon.ErrNilPointer)
+		}
+		for i := range candles.Candles {
+			if err := ind.Update(&candles.Candles[i]); err != nil {
+				return fmt.Errorf("could not update indicator for %v %v %v, %w", candles.Exchange, candles.Asset, candles.Pair, err)
+			}
+		}
+	}
+	return nil
+}
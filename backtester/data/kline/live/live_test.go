@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/engine"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
@@ -64,3 +65,30 @@ func TestLoadTrades(t *testing.T) {
 	require.NoError(t, err, "LoadData must not error")
 	assert.NotEmpty(t, data.Candles, "Candles should not be empty")
 }
+
+func TestUpdateIndicators(t *testing.T) {
+	t.Parallel()
+	err := UpdateIndicators(nil)
+	assert.ErrorIs(t, err, gctcommon.ErrNilPointer)
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	item := &gctkline.Item{
+		Candles: []gctkline.Candle{
+			{Time: start.Add(time.Minute), Close: 3},
+			{Time: start, Close: 1},
+		},
+	}
+	err = UpdateIndicators(item, nil)
+	assert.ErrorIs(t, err, gctcommon.ErrNilPointer)
+
+	sma, err := gctkline.NewStreamingSMA(2)
+	require.NoError(t, err, "NewStreamingSMA must not error")
+	require.NoError(t, UpdateIndicators(item, sma), "UpdateIndicators must not error")
+	assert.Equal(t, 2.0, sma.Value(), "indicator should process candles in order")
+
+	err = UpdateIndicators(&gctkline.Item{Candles: []gctkline.Candle{{Time: start, Close: 1}}}, sma)
+	assert.ErrorIs(t, err, gctkline.ErrCandleOutOfOrder)
+
+	require.NoError(t, UpdateIndicators(&gctkline.Item{Candles: []gctkline.Candle{{Time: start.Add(time.Minute), Close: 5}}}, sma), "UpdateIndicators must not error")
+	assert.Equal(t, 3.0, sma.Value(), "repeat retrievals should amend the latest candle")
+}
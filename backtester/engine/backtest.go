@@ -434,10 +434,18 @@ func (bt *BackTest) updateStatsForDataEvent(ev data.Event, funds funding.IFundRe
 			return nil
 		}
 		if bt.LiveDataHandler == nil || (bt.LiveDataHandler != nil && !bt.LiveDataHandler.IsRealOrders()) {
+			err = bt.settleFundingPayment(ev)
+			if err != nil {
+				return err
+			}
 			err = bt.Portfolio.CheckLiquidationStatus(ev, cr, pnl)
 			if err != nil {
 				if errors.Is(err, futures.ErrPositionLiquidated) {
-					liquidErr := bt.triggerLiquidationsForExchange(ev, pnl)
+					orders, liquidErr := bt.triggerLiquidationsForExchange(ev, pnl)
+					if liquidErr != nil {
+						return liquidErr
+					}
+					liquidErr = bt.settleLiquidations(orders)
 					if liquidErr != nil {
 						return liquidErr
 					}
@@ -631,16 +639,81 @@ func (bt *BackTest) Stop() error {
 	return nil
 }
 
-func (bt *BackTest) triggerLiquidationsForExchange(ev data.Event, pnl *portfolio.PNLSummary) error {
+// settleFundingPayment applies any funding payments due on the event's open
+// futures position to the currency which receives realised PNL
+func (bt *BackTest) settleFundingPayment(ev data.Event) error {
+	payment, err := bt.Portfolio.CalculateFundingPayment(ev)
+	if err != nil {
+		return fmt.Errorf("CalculateFundingPayment %v %v %v %w", ev.GetExchange(), ev.GetAssetType(), ev.Pair(), err)
+	}
+	if payment.IsZero() {
+		return nil
+	}
+	receivingCurrency, receivingAsset, err := bt.getCurrencyForRealisedPNL(ev)
+	if err != nil {
+		return err
+	}
+	err = bt.Funding.SettleFundingPayment(ev.GetExchange(), receivingAsset, receivingCurrency, payment)
+	if err != nil {
+		return fmt.Errorf("SettleFundingPayment %v %v %v %w", ev.GetExchange(), ev.GetAssetType(), ev.Pair(), err)
+	}
+	return bt.Funding.UpdateCollateralForEvent(ev, false)
+}
+
+// settleLiquidations settles every futures position closed by a liquidation,
+// as all positions on the exchange are liquidated together
+func (bt *BackTest) settleLiquidations(orders []order.Event) error {
+	for i := range orders {
+		if !orders[i].GetAssetType().IsFutures() {
+			continue
+		}
+		if err := bt.settleLiquidation(orders[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// settleLiquidation returns any margin remaining after a maintenance margin
+// liquidation and its fee to the currency which receives realised PNL
+func (bt *BackTest) settleLiquidation(ev common.Event) error {
+	details, err := bt.Portfolio.GetLiquidationDetails(ev)
+	if err != nil {
+		return fmt.Errorf("GetLiquidationDetails %v %v %v %w", ev.GetExchange(), ev.GetAssetType(), ev.Pair(), err)
+	}
+	receivingCurrency, receivingAsset, err := bt.getCurrencyForRealisedPNL(ev)
+	if err != nil {
+		return err
+	}
+	err = bt.Funding.SettleLiquidation(ev.GetExchange(), receivingAsset, receivingCurrency, details)
+	if err != nil {
+		return fmt.Errorf("SettleLiquidation %v %v %v %w", ev.GetExchange(), ev.GetAssetType(), ev.Pair(), err)
+	}
+	return nil
+}
+
+func (bt *BackTest) getCurrencyForRealisedPNL(ev common.Event) (currency.Code, asset.Item, error) {
+	exch, err := bt.exchangeManager.GetExchangeByName(ev.GetExchange())
+	if err != nil {
+		return currency.EMPTYCODE, asset.Empty, fmt.Errorf("GetExchangeByName %v %v %v %w", ev.GetExchange(), ev.GetAssetType(), ev.Pair(), err)
+	}
+	receivingCurrency, receivingAsset, err := exch.GetCurrencyForRealisedPNL(ev.GetAssetType(), ev.Pair())
+	if err != nil {
+		return currency.EMPTYCODE, asset.Empty, fmt.Errorf("GetCurrencyForRealisedPNL %v %v %v %w", ev.GetExchange(), ev.GetAssetType(), ev.Pair(), err)
+	}
+	return receivingCurrency, receivingAsset, nil
+}
+
+func (bt *BackTest) triggerLiquidationsForExchange(ev data.Event, pnl *portfolio.PNLSummary) ([]order.Event, error) {
 	if ev == nil {
-		return common.ErrNilEvent
+		return nil, common.ErrNilEvent
 	}
 	if pnl == nil {
-		return fmt.Errorf("%w pnl summary", gctcommon.ErrNilPointer)
+		return nil, fmt.Errorf("%w pnl summary", gctcommon.ErrNilPointer)
 	}
 	orders, err := bt.Portfolio.CreateLiquidationOrdersForExchange(ev, bt.Funding)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	for i := range orders {
 		// these orders are raising events for event offsets
@@ -650,16 +723,16 @@ func (bt *BackTest) triggerLiquidationsForExchange(ev data.Event, pnl *portfolio
 		var datas data.Handler
 		datas, err = bt.DataHolder.GetDataForCurrency(orders[i])
 		if err != nil {
-			return err
+			return nil, err
 		}
 		var latest data.Event
 		latest, err = datas.Latest()
 		if err != nil {
-			return err
+			return nil, err
 		}
 		err = bt.Statistic.SetEventForOffset(latest)
 		if err != nil && !errors.Is(err, statistics.ErrAlreadyProcessed) {
-			return err
+			return nil, err
 		}
 		bt.EventQueue.AppendEvent(orders[i])
 		err = bt.Statistic.SetEventForOffset(orders[i])
@@ -668,12 +741,16 @@ func (bt *BackTest) triggerLiquidationsForExchange(ev data.Event, pnl *portfolio
 		}
 		err = bt.Funding.Liquidate(orders[i])
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 	pnl.Result.IsLiquidated = true
 	pnl.Result.Status = gctorder.Liquidated
-	return bt.Statistic.AddPNLForTime(pnl)
+	err = bt.Statistic.AddPNLForTime(pnl)
+	if err != nil {
+		return nil, err
+	}
+	return orders, nil
 }
 
 // CloseAllPositions will close sell any positions held on closure
@@ -234,6 +234,71 @@ func TestLoadDataCSV(t *testing.T) {
 	}
 }
 
+func TestLoadAuxiliaryData(t *testing.T) {
+	t.Parallel()
+	bt := BackTest{
+		Reports:         &report.Data{},
+		exchangeManager: engine.NewExchangeManager(),
+	}
+	csvPath := filepath.Join("..", "..", "testdata", "binance_BTCUSDT_24h_2019_01_01_2020_01_01.csv")
+	cp := currency.NewBTCUSDT()
+	auxPair := currency.NewPair(currency.ETH, currency.USDT)
+	cfg := &config.Config{
+		DataSettings: config.DataSettings{
+			DataType: common.CandleStr,
+			Interval: gctkline.OneDay,
+			CSVData: &config.CSVData{
+				FullPath: csvPath,
+			},
+		},
+	}
+	cs := &config.CurrencySettings{
+		ExchangeName: testExchange,
+		Asset:        asset.Spot,
+		Base:         cp.Base,
+		Quote:        cp.Quote,
+	}
+	require.NoError(t, bt.loadAuxiliaryData(cfg, cs, nil), "loadAuxiliaryData must not error without auxiliary data")
+
+	cs.AuxiliaryData = []config.AuxiliaryData{{
+		ExchangeName: testExchange,
+		Asset:        asset.Spot,
+		Base:         auxPair.Base,
+		Quote:        auxPair.Quote,
+		Interval:     gctkline.OneDay,
+		CSVFullPath:  csvPath,
+	}}
+	err := bt.loadAuxiliaryData(cfg, cs, nil)
+	assert.ErrorIs(t, err, gctcommon.ErrNilPointer)
+
+	exch, err := bt.exchangeManager.NewExchangeByName(testExchange)
+	require.NoError(t, err, "NewExchangeByName must not error")
+	exch.SetDefaults()
+	b := exch.GetBase()
+	b.CurrencyPairs.Pairs = make(map[asset.Item]*currency.PairStore)
+	b.CurrencyPairs.Pairs[asset.Spot] = &currency.PairStore{
+		Available:     currency.Pairs{cp, auxPair},
+		Enabled:       currency.Pairs{cp},
+		AssetEnabled:  true,
+		ConfigFormat:  &currency.PairFormat{Uppercase: true},
+		RequestFormat: &currency.PairFormat{Uppercase: true},
+	}
+	require.NoError(t, bt.exchangeManager.Add(exch), "Add must not error")
+
+	primary, err := bt.loadKlineData(cfg, exch, cp, asset.Spot, false)
+	require.NoError(t, err, "loadKlineData must not error")
+	require.NoError(t, bt.loadAuxiliaryData(cfg, cs, primary), "loadAuxiliaryData must not error")
+	assert.Equal(t, csvPath, cfg.DataSettings.CSVData.FullPath, "loading auxiliary data should not modify the traded data settings")
+
+	_, err = primary.Next()
+	require.NoError(t, err, "Next must not error")
+	resp, err := primary.GetAuxiliaryData(testExchange, asset.Spot, auxPair, gctkline.OneDay)
+	require.NoError(t, err, "GetAuxiliaryData must not error")
+	require.Len(t, resp, 1, "GetAuxiliaryData must return the closed auxiliary candle")
+	assert.True(t, resp[0].Pair().Equal(auxPair), "auxiliary data should be for the auxiliary pair")
+	assert.Empty(t, bt.Reports.(*report.Data).OriginalCandles, "auxiliary data should not be added to reports")
+}
+
 func TestLoadDataDatabase(t *testing.T) {
 	t.Parallel()
 	bt := BackTest{
@@ -654,7 +719,7 @@ func TestTriggerLiquidationsForExchange(t *testing.T) {
 	bt := BackTest{
 		shutdown: make(chan struct{}),
 	}
-	err := bt.triggerLiquidationsForExchange(nil, nil)
+	_, err := bt.triggerLiquidationsForExchange(nil, nil)
 	assert.ErrorIs(t, err, common.ErrNilEvent)
 
 	cp := currency.NewBTCUSDT()
@@ -666,7 +731,7 @@ func TestTriggerLiquidationsForExchange(t *testing.T) {
 			CurrencyPair: cp,
 		},
 	}
-	err = bt.triggerLiquidationsForExchange(ev, nil)
+	_, err = bt.triggerLiquidationsForExchange(ev, nil)
 	assert.ErrorIs(t, err, gctcommon.ErrNilPointer)
 
 	bt.Portfolio = &portfolioOverride{}
@@ -714,8 +779,9 @@ func TestTriggerLiquidationsForExchange(t *testing.T) {
 	pnl.Exchange = ev.Exchange
 	pnl.Asset = ev.AssetType
 	pnl.Pair = ev.CurrencyPair
-	err = bt.triggerLiquidationsForExchange(ev, pnl)
+	orders, err := bt.triggerLiquidationsForExchange(ev, pnl)
 	assert.NoError(t, err, "triggerLiquidationsForExchange should not error")
+	assert.Len(t, orders, 1, "triggerLiquidationsForExchange should return the liquidation orders")
 
 	ev2 := bt.EventQueue.NextEvent()
 	ev2o, ok := ev2.(order.Event)
@@ -1400,6 +1466,72 @@ func TestSetExchangeCredentials(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestGetFundingRates(t *testing.T) {
+	t.Parallel()
+	cfg := &config.Config{}
+	_, err := getFundingRates(t.Context(), cfg, nil, asset.USDTMarginedFutures, currency.NewBTCUSDT())
+	assert.ErrorIs(t, err, gctcommon.ErrNilPointer)
+
+	f := &binance.Exchange{}
+	f.SetDefaults()
+	_, err = getFundingRates(t.Context(), cfg, f, asset.USDTMarginedFutures, currency.NewBTCUSDT())
+	assert.ErrorIs(t, err, errNoDataSource)
+}
+
+func TestLoadFXRatesFromDatabase(t *testing.T) {
+	t.Parallel()
+	bt := &BackTest{}
+	cfg := &config.Config{
+		CurrencySettings: []config.CurrencySettings{
+			{ExchangeName: testExchange, Asset: asset.Spot, Base: currency.BTC, Quote: currency.EUR},
+		},
+	}
+	assert.NoError(t, bt.loadFXRatesFromDatabase(cfg), "loadFXRatesFromDatabase should not error without a database data source")
+
+	cfg.DataSettings.DatabaseData = &config.DatabaseData{}
+	cfg.CurrencySettings[0].Quote = currency.USDT
+	assert.NoError(t, bt.loadFXRatesFromDatabase(cfg), "loadFXRatesFromDatabase should not connect without fiat currencies to track")
+}
+
+func TestSettleFundingPayment(t *testing.T) {
+	t.Parallel()
+	bt := &BackTest{Portfolio: &fakeFolio{}}
+	ev := &evkline.Kline{
+		Base: &event.Base{
+			Exchange:     testExchange,
+			AssetType:    asset.USDTMarginedFutures,
+			CurrencyPair: currency.NewBTCUSDT(),
+		},
+	}
+	assert.NoError(t, bt.settleFundingPayment(ev), "settleFundingPayment should not error when no payment is due")
+}
+
+func TestSettleLiquidations(t *testing.T) {
+	t.Parallel()
+	bt := &BackTest{
+		Portfolio:       &fakeFolio{},
+		exchangeManager: engine.NewExchangeManager(),
+	}
+	spot := &order.Order{
+		Base: &event.Base{
+			Exchange:     testExchange,
+			AssetType:    asset.Spot,
+			CurrencyPair: currency.NewBTCUSDT(),
+		},
+	}
+	assert.NoError(t, bt.settleLiquidations([]order.Event{spot}), "settleLiquidations should skip spot liquidations")
+
+	futuresOrder := &order.Order{
+		Base: &event.Base{
+			Exchange:     testExchange,
+			AssetType:    asset.USDTMarginedFutures,
+			CurrencyPair: currency.NewPair(currency.ETH, currency.USDT),
+		},
+	}
+	err := bt.settleLiquidations([]order.Event{spot, futuresOrder})
+	assert.ErrorIs(t, err, engine.ErrExchangeNotFound, "settleLiquidations should settle every futures liquidation")
+}
+
 func TestGetFees(t *testing.T) {
 	t.Parallel()
 	_, _, err := getFees(t.Context(), nil, currency.EMPTYPAIR)
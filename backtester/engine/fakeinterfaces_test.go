@@ -96,6 +96,14 @@ func (f fakeFolio) CheckLiquidationStatus(data.Event, funding.ICollateralReader,
 	return nil
 }
 
+func (f fakeFolio) GetLiquidationDetails(common.Event) (*funding.LiquidationDetails, error) {
+	return &funding.LiquidationDetails{}, nil
+}
+
+func (f fakeFolio) CalculateFundingPayment(data.Event) (decimal.Decimal, error) {
+	return decimal.Zero, nil
+}
+
 func (f fakeFolio) CreateLiquidationOrdersForExchange(data.Event, funding.IFundingManager) ([]order.Event, error) {
 	return nil, nil
 }
@@ -279,6 +287,14 @@ func (f fakeFunding) RealisePNL(string, asset.Item, currency.Code, decimal.Decim
 	return nil
 }
 
+func (f fakeFunding) SettleFundingPayment(string, asset.Item, currency.Code, decimal.Decimal) error {
+	return nil
+}
+
+func (f fakeFunding) SettleLiquidation(string, asset.Item, currency.Code, *funding.LiquidationDetails) error {
+	return nil
+}
+
 type fakeStrat struct{}
 
 func (f fakeStrat) Name() string {
@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"path/filepath"
 	"runtime"
+	"slices"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
@@ -34,12 +36,14 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common/key"
 	gctconfig "github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/currency/fxhistory"
 	gctdatabase "github.com/thrasher-corp/gocryptotrader/database"
 	"github.com/thrasher-corp/gocryptotrader/engine"
 	"github.com/thrasher-corp/gocryptotrader/exchange/order/limits"
 	gctexchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/currencystate"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
 	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/log"
 )
@@ -402,6 +406,18 @@ func (bt *BackTest) SetupFromConfig(cfg *config.Config, templatePath, output str
 	reports.Statistics = stats
 
 	if !cfg.StrategySettings.DisableUSDTracking {
+		if cfg.StrategySettings.FXRatesPath != "" {
+			var loaded int
+			loaded, err = fxhistory.LoadCSVFile(cfg.StrategySettings.FXRatesPath)
+			if err != nil {
+				return err
+			}
+			log.Infof(common.Setup, "Loaded %d historical FX rates from %s", loaded, cfg.StrategySettings.FXRatesPath)
+		}
+		err = bt.loadFXRatesFromDatabase(cfg)
+		if err != nil {
+			return err
+		}
 		var trackingPairs []trackingcurrencies.TrackingPair
 		for i := range cfg.CurrencySettings {
 			trackingPairs = append(trackingPairs, trackingcurrencies.TrackingPair{
@@ -508,6 +524,11 @@ func (bt *BackTest) setupExchangeSettings(cfg *config.Config) (*exchange.Exchang
 				continue
 			}
 
+			err = bt.loadAuxiliaryData(cfg, &cfg.CurrencySettings[i], klineData)
+			if err != nil {
+				return nil, err
+			}
+
 			err = bt.DataHolder.SetDataForCurrency(exchangeName, a, pair, klineData)
 			if err != nil {
 				return nil, err
@@ -602,12 +623,22 @@ func (bt *BackTest) setupExchangeSettings(cfg *config.Config) (*exchange.Exchang
 			}
 		}
 		var lev exchange.Leverage
+		var maintenanceMarginRate, liquidationFeeRate decimal.Decimal
+		var fundingRates []fundingrate.Rate
 		if cfg.CurrencySettings[i].FuturesDetails != nil {
 			lev = exchange.Leverage{
 				CanUseLeverage:                 cfg.CurrencySettings[i].FuturesDetails.Leverage.CanUseLeverage,
 				MaximumLeverageRate:            cfg.CurrencySettings[i].FuturesDetails.Leverage.MaximumOrderLeverageRate,
 				MaximumOrdersWithLeverageRatio: cfg.CurrencySettings[i].FuturesDetails.Leverage.MaximumOrdersWithLeverageRatio,
 			}
+			maintenanceMarginRate = cfg.CurrencySettings[i].FuturesDetails.MaintenanceMarginRate
+			liquidationFeeRate = cfg.CurrencySettings[i].FuturesDetails.LiquidationFeeRate
+			if cfg.CurrencySettings[i].FuturesDetails.ApplyFundingRates {
+				fundingRates, err = getFundingRates(context.TODO(), cfg, exch, a, pair)
+				if err != nil {
+					return nil, err
+				}
+			}
 		}
 		resp.CurrencySettings = append(resp.CurrencySettings, exchange.Settings{
 			Exchange:                  exch,
@@ -621,6 +652,9 @@ func (bt *BackTest) setupExchangeSettings(cfg *config.Config) (*exchange.Exchang
 			BuySide:                   buyRule,
 			SellSide:                  sellRule,
 			Leverage:                  lev,
+			MaintenanceMarginRate:     maintenanceMarginRate,
+			LiquidationFeeRate:        liquidationFeeRate,
+			FundingRates:              fundingRates,
 			Limits:                    l,
 			SkipCandleVolumeFitting:   cfg.CurrencySettings[i].SkipCandleVolumeFitting,
 			CanUseExchangeLimits:      cfg.CurrencySettings[i].CanUseExchangeLimits,
@@ -648,6 +682,89 @@ func (bt *BackTest) loadExchangePairAssetBase(exchName string, baseCode, quoteCo
 	return e, fPair, a, nil
 }
 
+// loadFXRatesFromDatabase loads the historical FX rates against USD of the
+// fiat currencies being traded from the database when it is the data source.
+// Rates from before the start date are included so the first candles can
+// still be valued
+func (bt *BackTest) loadFXRatesFromDatabase(cfg *config.Config) error {
+	dbData := cfg.DataSettings.DatabaseData
+	if dbData == nil {
+		return nil
+	}
+	var codes []currency.Code
+	for i := range cfg.CurrencySettings {
+		for _, c := range []currency.Code{cfg.CurrencySettings[i].Base, cfg.CurrencySettings[i].Quote} {
+			if c.IsFiatCurrency() && !trackingcurrencies.CurrencyIsUSDTracked(c) && !slices.ContainsFunc(codes, c.Equal) {
+				codes = append(codes, c)
+			}
+		}
+	}
+	if len(codes) == 0 {
+		return nil
+	}
+	if dbData.Path == "" {
+		dbData.Path = filepath.Join(gctcommon.GetDefaultDataDir(runtime.GOOS), "database")
+	}
+	gctdatabase.DB.DataPath = dbData.Path
+	err := gctdatabase.DB.SetConfig(&dbData.Config)
+	if err != nil {
+		return err
+	}
+	err = bt.databaseManager.Start(&sync.WaitGroup{})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		stopErr := bt.databaseManager.Stop()
+		if stopErr != nil {
+			log.Errorln(common.Setup, stopErr)
+		}
+	}()
+	start := dbData.StartDate.Add(-fxhistory.DefaultMaxRateAge)
+	for i := range codes {
+		for _, p := range [][2]currency.Code{{codes[i], currency.USD}, {currency.USD, codes[i]}} {
+			loaded, err := fxhistory.LoadFromDatabase(context.TODO(), p[0], p[1], start, dbData.EndDate)
+			if err != nil {
+				if errors.Is(err, fxhistory.ErrRateNotFound) {
+					continue
+				}
+				return err
+			}
+			log.Infof(common.Setup, "Loaded %d historical %s-%s FX rates from the database", loaded, p[0], p[1])
+		}
+	}
+	return nil
+}
+
+// getFundingRates retrieves the historical funding rates for a contract over
+// the configured data range
+func getFundingRates(ctx context.Context, cfg *config.Config, exch gctexchange.IBotExchange, a asset.Item, fPair currency.Pair) ([]fundingrate.Rate, error) {
+	if exch == nil {
+		return nil, fmt.Errorf("exchange %w", gctcommon.ErrNilPointer)
+	}
+	var start, end time.Time
+	switch {
+	case cfg.DataSettings.APIData != nil:
+		start, end = cfg.DataSettings.APIData.StartDate, cfg.DataSettings.APIData.EndDate
+	case cfg.DataSettings.DatabaseData != nil:
+		start, end = cfg.DataSettings.DatabaseData.StartDate, cfg.DataSettings.DatabaseData.EndDate
+	default:
+		return nil, fmt.Errorf("%w to retrieve funding rates for %v %v %v", errNoDataSource, exch.GetName(), a, fPair)
+	}
+	rates, err := exch.GetHistoricalFundingRates(ctx, &fundingrate.HistoricalRatesRequest{
+		Asset:                a,
+		Pair:                 fPair,
+		StartDate:            start,
+		EndDate:              end,
+		RespectHistoryLimits: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve funding rates for %v %v %v: %w", exch.GetName(), a, fPair, err)
+	}
+	log.Infof(common.Setup, "Loaded %v funding rates for %v %v %v", len(rates.FundingRates), exch.GetName(), a, fPair)
+	return rates.FundingRates, nil
+}
+
 // getFees will return an exchange's fee rate from GCT's wrapper function
 func getFees(ctx context.Context, exch gctexchange.IBotExchange, fPair currency.Pair) (makerFee, takerFee decimal.Decimal, err error) {
 	if exch == nil {
@@ -686,6 +803,70 @@ func getFees(ctx context.Context, exch gctexchange.IBotExchange, fPair currency.
 // loadData will create kline data from the sources defined in start config files. It can exist from databases, csv or API endpoints
 // it can also be generated from trade data which will be converted into kline data
 func (bt *BackTest) loadData(cfg *config.Config, exch gctexchange.IBotExchange, fPair currency.Pair, a asset.Item, isUSDTrackingPair bool) (*kline.DataFromKline, error) {
+	resp, err := bt.loadKlineData(cfg, exch, fPair, a, isUSDTrackingPair)
+	if err != nil || resp == nil {
+		return resp, err
+	}
+	err = bt.Reports.SetKlineData(resp.Item)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// loadAuxiliaryData loads the signal only data defined in currency settings and
+// attaches it to the data handler of the traded currency. Auxiliary data is not
+// added to reports as it is never traded
+func (bt *BackTest) loadAuxiliaryData(cfg *config.Config, cs *config.CurrencySettings, primary *kline.DataFromKline) error {
+	if len(cs.AuxiliaryData) == 0 {
+		return nil
+	}
+	if primary == nil {
+		return fmt.Errorf("%w primary data", gctcommon.ErrNilPointer)
+	}
+	for i := range cs.AuxiliaryData {
+		aux := &cs.AuxiliaryData[i]
+		exch, pair, a, err := bt.loadExchangePairAssetBase(aux.ExchangeName, aux.Base, aux.Quote, aux.Asset)
+		if err != nil {
+			return err
+		}
+		// the config is copied so that interval and date adjustments made while
+		// loading do not leak into the traded data settings
+		auxCfg := *cfg
+		auxCfg.DataSettings.Interval = aux.Interval
+		if cfg.DataSettings.APIData != nil {
+			apiData := *cfg.DataSettings.APIData
+			auxCfg.DataSettings.APIData = &apiData
+		}
+		if cfg.DataSettings.DatabaseData != nil {
+			databaseData := *cfg.DataSettings.DatabaseData
+			auxCfg.DataSettings.DatabaseData = &databaseData
+		}
+		if cfg.DataSettings.CSVData != nil {
+			auxCfg.DataSettings.CSVData = &config.CSVData{FullPath: aux.CSVFullPath}
+		}
+		auxData, err := bt.loadKlineData(&auxCfg, exch, pair, a, false)
+		if err != nil {
+			return fmt.Errorf("auxiliary data %v %v %v %v: %w", aux.ExchangeName, a, pair, aux.Interval, err)
+		}
+		stream, err := auxData.GetStream()
+		if err != nil {
+			return err
+		}
+		auxiliary, err := data.NewAuxiliary(aux.Interval, stream)
+		if err != nil {
+			return err
+		}
+		err = primary.AddAuxiliaryData(auxiliary)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// loadKlineData retrieves and loads kline data from the configured data source
+func (bt *BackTest) loadKlineData(cfg *config.Config, exch gctexchange.IBotExchange, fPair currency.Pair, a asset.Item, isUSDTrackingPair bool) (*kline.DataFromKline, error) {
 	if exch == nil {
 		return nil, engine.ErrExchangeNotFound
 	}
@@ -856,10 +1037,6 @@ func (bt *BackTest) loadData(cfg *config.Config, exch gctexchange.IBotExchange,
 	if err != nil {
 		return nil, err
 	}
-	err = bt.Reports.SetKlineData(resp.Item)
-	if err != nil {
-		return nil, err
-	}
 	return resp, nil
 }
 
@@ -13,6 +13,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchange/order/limits"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
 )
 
 var (
@@ -54,6 +55,10 @@ type Settings struct {
 
 	Leverage Leverage
 
+	MaintenanceMarginRate decimal.Decimal
+	LiquidationFeeRate    decimal.Decimal
+	FundingRates          []fundingrate.Rate
+
 	MinimumSlippageRate decimal.Decimal
 	MaximumSlippageRate decimal.Decimal
 
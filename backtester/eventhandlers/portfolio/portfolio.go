@@ -475,18 +475,153 @@ func (p *Portfolio) CheckLiquidationStatus(ev data.Event, collateralReader fundi
 	if pnl == nil {
 		return fmt.Errorf("%w pnl summary missing", gctcommon.ErrNilPointer)
 	}
+	settings, err := p.getFuturesSettingsFromEvent(ev)
+	if err != nil {
+		return err
+	}
 	availableFunds := collateralReader.AvailableFunds()
 	position, err := p.GetLatestPosition(ev)
 	if err != nil {
 		return err
 	}
-	if !position.Status.IsInactive() &&
-		pnl.Result.UnrealisedPNL.IsNegative() &&
-		pnl.Result.UnrealisedPNL.Abs().GreaterThan(availableFunds) {
-		return futures.ErrPositionLiquidated
+	if settings.MaintenanceMarginRate.IsZero() {
+		if !position.Status.IsInactive() &&
+			pnl.Result.UnrealisedPNL.IsNegative() &&
+			pnl.Result.UnrealisedPNL.Abs().GreaterThan(availableFunds) {
+			settings.liquidation = settings.liquidationDetails(ev.GetTime(), ev.GetClosePrice(), position, availableFunds.Add(pnl.Result.UnrealisedPNL), ev.GetClosePrice())
+			return futures.ErrPositionLiquidated
+		}
+		return nil
+	}
+	if position.Status.IsInactive() || !position.LatestSize.IsPositive() {
+		return nil
+	}
+	equity := availableFunds.Add(pnl.Result.UnrealisedPNL)
+	price, liquidated := liquidationPrice(ev, position, equity, settings.MaintenanceMarginRate)
+	if !liquidated {
+		return nil
+	}
+	settings.liquidation = settings.liquidationDetails(ev.GetTime(), ev.GetClosePrice(), position, equity, price)
+	return futures.ErrPositionLiquidated
+}
+
+// liquidationPrice returns the price at which a position's equity falls to its
+// maintenance margin and whether the event's candle traded through it. Equity
+// is measured at the event's close price. Where the candle opened beyond the
+// liquidation price, the open price is used as the position could not have been
+// closed any sooner
+func liquidationPrice(ev data.Event, position *futures.Position, equity, maintenanceMarginRate decimal.Decimal) (decimal.Decimal, bool) {
+	size, closePrice := position.LatestSize, ev.GetClosePrice()
+	one := decimal.NewFromInt(1)
+	if position.LatestDirection.IsShort() {
+		// equity + size*(close-price) = rate*size*price
+		price := equity.Add(size.Mul(closePrice)).Div(size.Mul(one.Add(maintenanceMarginRate)))
+		high, open := ev.GetHighPrice(), ev.GetOpenPrice()
+		if high.IsZero() {
+			high = closePrice
+		}
+		if high.LessThan(price) {
+			return decimal.Zero, false
+		}
+		if open.GreaterThan(price) {
+			return open, true
+		}
+		return price, true
+	}
+	// equity + size*(price-close) = rate*size*price
+	price := size.Mul(closePrice).Sub(equity).Div(size.Mul(one.Sub(maintenanceMarginRate)))
+	low, open := ev.GetLowPrice(), ev.GetOpenPrice()
+	if low.IsZero() {
+		low = closePrice
+	}
+	if !price.IsPositive() || low.GreaterThan(price) {
+		return decimal.Zero, false
+	}
+	if open.IsPositive() && open.LessThan(price) {
+		return open, true
 	}
+	return price, true
+}
 
-	return nil
+// liquidationDetails calculates the fee charged and margin remaining when a
+// position is liquidated at the supplied price. Equity is measured at the
+// close price
+func (s *Settings) liquidationDetails(t time.Time, closePrice decimal.Decimal, position *futures.Position, equity, price decimal.Decimal) *funding.LiquidationDetails {
+	size := position.LatestSize
+	movement := price.Sub(closePrice).Mul(size)
+	if position.LatestDirection.IsShort() {
+		movement = movement.Neg()
+	}
+	notional := size.Mul(price)
+	fee := notional.Mul(s.LiquidationFeeRate)
+	remaining := equity.Add(movement).Sub(fee)
+	if remaining.IsNegative() {
+		remaining = decimal.Zero
+	}
+	return &funding.LiquidationDetails{
+		Time:            t,
+		Exchange:        s.exchangeName,
+		Asset:           s.assetType,
+		Pair:            s.pair,
+		Price:           price,
+		Notional:        notional,
+		Fee:             fee,
+		RemainingMargin: remaining,
+	}
+}
+
+// GetLiquidationDetails returns the details of the latest liquidation for the
+// event's exchange, asset and pair
+func (p *Portfolio) GetLiquidationDetails(e common.Event) (*funding.LiquidationDetails, error) {
+	settings, err := p.getFuturesSettingsFromEvent(e)
+	if err != nil {
+		return nil, err
+	}
+	if settings.liquidation == nil {
+		return nil, fmt.Errorf("%w for %v %v %v", errNoLiquidationDetails, e.GetExchange(), e.GetAssetType(), e.Pair())
+	}
+	return settings.liquidation, nil
+}
+
+// CalculateFundingPayment returns the sum of funding payments due for the open
+// position from any funding rates settled since the previous event, priced at
+// the event's close. Longs pay and shorts receive positive funding rates
+func (p *Portfolio) CalculateFundingPayment(ev data.Event) (decimal.Decimal, error) {
+	settings, err := p.getFuturesSettingsFromEvent(ev)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	if len(settings.fundingRates) == 0 {
+		return decimal.Zero, nil
+	}
+	var position *futures.Position
+	position, err = p.GetLatestPosition(ev)
+	if err != nil && !errors.Is(err, futures.ErrPositionNotFound) {
+		return decimal.Zero, err
+	}
+	var payment decimal.Decimal
+	for i := range settings.fundingRates {
+		rate := &settings.fundingRates[i]
+		if !rate.Time.After(settings.lastFundingTime) {
+			continue
+		}
+		if rate.Time.After(ev.GetTime()) {
+			break
+		}
+		settings.lastFundingTime = rate.Time
+		if position == nil ||
+			position.Status.IsInactive() ||
+			!position.LatestSize.IsPositive() ||
+			!rate.Time.After(position.OpeningDate) {
+			continue
+		}
+		amount := rate.Rate.Mul(position.LatestSize).Mul(ev.GetClosePrice())
+		if position.LatestDirection.IsLong() {
+			amount = amount.Neg()
+		}
+		payment = payment.Add(amount)
+	}
+	return payment, nil
 }
 
 // CreateLiquidationOrdersForExchange creates liquidation orders, for any that exist on the same exchange where a liquidation is occurring
@@ -516,6 +651,18 @@ func (p *Portfolio) CreateLiquidationOrdersForExchange(ev data.Event, funds fund
 			if pos.LatestDirection == gctorder.Short {
 				direction = gctorder.Long
 			}
+			closePrice := ev.GetClosePrice()
+			if settings.liquidation != nil && settings.liquidation.Time.Equal(ev.GetTime()) {
+				closePrice = settings.liquidation.Price
+			} else {
+				// positions closed alongside the one which breached its margin
+				// are closed at their own latest price, keeping their PNL
+				closePrice = pos.LatestPrice
+				if !closePrice.IsPositive() {
+					closePrice = pos.OpeningPrice
+				}
+				settings.liquidation = settings.liquidationDetails(ev.GetTime(), closePrice, &pos, pos.UnrealisedPNL, closePrice)
+			}
 			closingOrders = append(closingOrders, &order.Order{
 				Base: &event.Base{
 					Offset:         ev.GetOffset(),
@@ -529,7 +676,7 @@ func (p *Portfolio) CreateLiquidationOrdersForExchange(ev data.Event, funds fund
 				},
 				Direction:           direction,
 				Status:              gctorder.Liquidated,
-				ClosePrice:          ev.GetClosePrice(),
+				ClosePrice:          closePrice,
 				Amount:              pos.LatestSize,
 				AllocatedFunds:      pos.LatestSize,
 				OrderType:           gctorder.Market,
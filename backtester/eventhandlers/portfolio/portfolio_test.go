@@ -24,6 +24,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/binance"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
@@ -1368,6 +1369,204 @@ func TestCheckLiquidationStatus(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+// newMarginTestSettings returns a portfolio tracking a single futures position
+// opened at tt with a collateral reader holding the available collateral
+func newMarginTestSettings(t *testing.T, side gctorder.Side, available decimal.Decimal, tt time.Time) (*Portfolio, *Settings, funding.ICollateralReader) {
+	t.Helper()
+	pair := currency.NewBTCUSDT()
+	mpt, err := futures.SetupMultiPositionTracker(&futures.MultiPositionTrackerSetup{
+		Exchange:           testExchange,
+		Asset:              asset.Futures,
+		Pair:               pair,
+		Underlying:         currency.BTC,
+		CollateralCurrency: currency.USDT,
+		OfflineCalculation: true,
+	})
+	require.NoError(t, err, "SetupMultiPositionTracker must not error")
+	err = mpt.TrackNewOrder(&gctorder.Detail{
+		Price:     1000,
+		Amount:    1,
+		Exchange:  testExchange,
+		Side:      side,
+		AssetType: asset.Futures,
+		Date:      tt,
+		Pair:      pair,
+		OrderID:   "1337",
+	})
+	require.NoError(t, err, "TrackNewOrder must not error")
+	settings := &Settings{
+		exchangeName:   testExchange,
+		assetType:      asset.Futures,
+		pair:           pair,
+		FuturesTracker: mpt,
+	}
+	p := &Portfolio{
+		exchangeAssetPairPortfolioSettings: map[key.ExchangeAssetPair]*Settings{
+			key.NewExchangeAssetPair(testExchange, asset.Futures, pair): settings,
+		},
+	}
+	collateral, err := funding.CreateItem(testExchange, asset.Futures, currency.USDT, available, decimal.Zero)
+	require.NoError(t, err, "CreateItem must not error")
+	contract, err := funding.CreateItem(testExchange, asset.Futures, currency.BTC, decimal.Zero, decimal.Zero)
+	require.NoError(t, err, "CreateItem must not error")
+	collat, err := funding.CreateCollateral(contract, collateral)
+	require.NoError(t, err, "CreateCollateral must not error")
+	cr, err := collat.GetCollateralReader()
+	require.NoError(t, err, "GetCollateralReader must not error")
+	return p, settings, cr
+}
+
+func newMarginTestEvent(tt time.Time, open, high, low, closePrice int64) *kline.Kline {
+	return &kline.Kline{
+		Base: &event.Base{
+			Exchange:     testExchange,
+			Time:         tt,
+			AssetType:    asset.Futures,
+			CurrencyPair: currency.NewBTCUSDT(),
+		},
+		Open:  decimal.NewFromInt(open),
+		High:  decimal.NewFromInt(high),
+		Low:   decimal.NewFromInt(low),
+		Close: decimal.NewFromInt(closePrice),
+	}
+}
+
+func TestCheckLiquidationStatusMaintenanceMargin(t *testing.T) {
+	t.Parallel()
+	tt := time.Now().Truncate(time.Hour)
+	p, settings, cr := newMarginTestSettings(t, gctorder.Long, decimal.NewFromInt(190), tt)
+	settings.MaintenanceMarginRate = decimal.NewFromFloat(0.1)
+	settings.LiquidationFeeRate = decimal.NewFromFloat(0.02)
+	pnl := &PNLSummary{}
+
+	ev := newMarginTestEvent(tt, 1000, 1000, 950, 1000)
+	require.NoError(t, p.CheckLiquidationStatus(ev, cr, pnl), "CheckLiquidationStatus must not error above the liquidation price")
+	_, err := p.GetLiquidationDetails(ev)
+	assert.ErrorIs(t, err, errNoLiquidationDetails)
+
+	ev = newMarginTestEvent(tt, 1000, 1000, 850, 1000)
+	err = p.CheckLiquidationStatus(ev, cr, pnl)
+	require.ErrorIs(t, err, futures.ErrPositionLiquidated)
+	details, err := p.GetLiquidationDetails(ev)
+	require.NoError(t, err, "GetLiquidationDetails must not error")
+	assert.Equal(t, "900", details.Price.String(), "long should be liquidated where equity meets maintenance margin")
+	assert.Equal(t, "18", details.Fee.String(), "fee should be charged on the liquidation notional")
+	assert.Equal(t, "72", details.RemainingMargin.String(), "remaining margin should be maintenance margin less fees")
+
+	orders, err := p.CreateLiquidationOrdersForExchange(ev, &funding.FundManager{})
+	require.NoError(t, err, "CreateLiquidationOrdersForExchange must not error")
+	require.Len(t, orders, 1, "CreateLiquidationOrdersForExchange must return the futures position")
+	assert.Equal(t, "900", orders[0].GetClosePrice().String(), "liquidation order should close at the liquidation price")
+
+	p, settings, cr = newMarginTestSettings(t, gctorder.Short, decimal.NewFromInt(210), tt)
+	settings.MaintenanceMarginRate = decimal.NewFromFloat(0.1)
+	ev = newMarginTestEvent(tt, 1000, 1050, 1000, 1000)
+	require.NoError(t, p.CheckLiquidationStatus(ev, cr, pnl), "CheckLiquidationStatus must not error below the liquidation price")
+
+	ev = newMarginTestEvent(tt, 1000, 1150, 1000, 1000)
+	err = p.CheckLiquidationStatus(ev, cr, pnl)
+	require.ErrorIs(t, err, futures.ErrPositionLiquidated)
+	details, err = p.GetLiquidationDetails(ev)
+	require.NoError(t, err, "GetLiquidationDetails must not error")
+	assert.Equal(t, "1100", details.Price.String(), "short should be liquidated where equity meets maintenance margin")
+	assert.Equal(t, "110", details.RemainingMargin.String(), "remaining margin should be maintenance margin without fees")
+
+	ev = newMarginTestEvent(tt, 1120, 1150, 1000, 1000)
+	err = p.CheckLiquidationStatus(ev, cr, pnl)
+	require.ErrorIs(t, err, futures.ErrPositionLiquidated)
+	details, err = p.GetLiquidationDetails(ev)
+	require.NoError(t, err, "GetLiquidationDetails must not error")
+	assert.Equal(t, "1120", details.Price.String(), "short should be liquidated at the open when the candle gaps through the liquidation price")
+}
+
+func TestCreateLiquidationOrdersForExchangeAllPairs(t *testing.T) {
+	t.Parallel()
+	tt := time.Now().Truncate(time.Hour)
+	p, settings, cr := newMarginTestSettings(t, gctorder.Long, decimal.NewFromInt(190), tt)
+	settings.MaintenanceMarginRate = decimal.NewFromFloat(0.1)
+
+	ethPair := currency.NewPair(currency.ETH, currency.USDT)
+	mpt, err := futures.SetupMultiPositionTracker(&futures.MultiPositionTrackerSetup{
+		Exchange:           testExchange,
+		Asset:              asset.Futures,
+		Pair:               ethPair,
+		Underlying:         currency.ETH,
+		CollateralCurrency: currency.USDT,
+		OfflineCalculation: true,
+	})
+	require.NoError(t, err, "SetupMultiPositionTracker must not error")
+	err = mpt.TrackNewOrder(&gctorder.Detail{
+		Price:     100,
+		Amount:    2,
+		Exchange:  testExchange,
+		Side:      gctorder.Short,
+		AssetType: asset.Futures,
+		Date:      tt,
+		Pair:      ethPair,
+		OrderID:   "1338",
+	})
+	require.NoError(t, err, "TrackNewOrder must not error")
+	_, err = mpt.UpdateOpenPositionUnrealisedPNL(110, tt)
+	require.NoError(t, err, "UpdateOpenPositionUnrealisedPNL must not error")
+	p.exchangeAssetPairPortfolioSettings[key.NewExchangeAssetPair(testExchange, asset.Futures, ethPair)] = &Settings{
+		exchangeName:       testExchange,
+		assetType:          asset.Futures,
+		pair:               ethPair,
+		FuturesTracker:     mpt,
+		LiquidationFeeRate: decimal.NewFromFloat(0.02),
+	}
+
+	ev := newMarginTestEvent(tt, 1000, 1000, 850, 1000)
+	require.ErrorIs(t, p.CheckLiquidationStatus(ev, cr, &PNLSummary{}), futures.ErrPositionLiquidated)
+	orders, err := p.CreateLiquidationOrdersForExchange(ev, &funding.FundManager{})
+	require.NoError(t, err, "CreateLiquidationOrdersForExchange must not error")
+	require.Len(t, orders, 2, "CreateLiquidationOrdersForExchange must return every futures position on the exchange")
+
+	for _, o := range orders {
+		details, err := p.GetLiquidationDetails(o)
+		require.NoErrorf(t, err, "GetLiquidationDetails must not error for %v", o.Pair())
+		assert.Equal(t, o.GetClosePrice().String(), details.Price.String(), "liquidation details should match the closing order price")
+		if o.Pair().Equal(ethPair) {
+			assert.Equal(t, "110", details.Price.String(), "position closed alongside should close at its own latest price")
+			assert.Equal(t, "4.4", details.Fee.String(), "position closed alongside should be charged the liquidation fee")
+		}
+	}
+}
+
+func TestCalculateFundingPayment(t *testing.T) {
+	t.Parallel()
+	tt := time.Now().Truncate(time.Hour)
+	p, settings, _ := newMarginTestSettings(t, gctorder.Long, decimal.NewFromInt(1000), tt)
+
+	ev := newMarginTestEvent(tt.Add(time.Hour*10), 1000, 1000, 1000, 1000)
+	payment, err := p.CalculateFundingPayment(ev)
+	require.NoError(t, err, "CalculateFundingPayment must not error")
+	assert.True(t, payment.IsZero(), "payment should be zero without funding rates")
+
+	settings.fundingRates = []fundingrate.Rate{
+		{Time: tt.Add(-time.Hour), Rate: decimal.NewFromFloat(0.01)},
+		{Time: tt.Add(time.Hour), Rate: decimal.NewFromFloat(0.0001)},
+		{Time: tt.Add(time.Hour * 9), Rate: decimal.NewFromFloat(0.0002)},
+		{Time: tt.Add(time.Hour * 17), Rate: decimal.NewFromFloat(0.0003)},
+	}
+	payment, err = p.CalculateFundingPayment(ev)
+	require.NoError(t, err, "CalculateFundingPayment must not error")
+	assert.Equal(t, "-0.3", payment.String(), "long should pay positive funding rates since the position opened")
+
+	payment, err = p.CalculateFundingPayment(ev)
+	require.NoError(t, err, "CalculateFundingPayment must not error")
+	assert.True(t, payment.IsZero(), "funding rates should only be settled once")
+
+	p, settings, _ = newMarginTestSettings(t, gctorder.Short, decimal.NewFromInt(1000), tt)
+	settings.fundingRates = []fundingrate.Rate{{Time: tt.Add(time.Hour), Rate: decimal.NewFromFloat(0.0001)}}
+	payment, err = p.CalculateFundingPayment(ev)
+	require.NoError(t, err, "CalculateFundingPayment must not error")
+	assert.Equal(t, "0.1", payment.String(), "short should receive positive funding rates")
+
+	_, err = p.CalculateFundingPayment(&kline.Kline{Base: &event.Base{AssetType: asset.Spot}})
+	assert.ErrorIs(t, err, futures.ErrNotFuturesAsset)
+}
+
 func TestSetHoldingsForEvent(t *testing.T) {
 	t.Parallel()
 	p := &Portfolio{}
@@ -19,6 +19,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	gctexchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
 )
@@ -37,6 +38,7 @@ var (
 	errNoHoldings           = errors.New("no holdings found")
 	errHoldingsNoTimestamp  = errors.New("holding with unset timestamp received")
 	errUnsetFuturesTracker  = errors.New("portfolio settings futures tracker unset")
+	errNoLiquidationDetails = errors.New("no liquidation details")
 )
 
 // Portfolio stores all holdings and rules to assess orders, allowing the portfolio manager to
@@ -62,6 +64,8 @@ type Handler interface {
 	UpdatePNL(common.Event, decimal.Decimal) error
 	GetLatestPNLForEvent(common.Event) (*PNLSummary, error)
 	CheckLiquidationStatus(data.Event, funding.ICollateralReader, *PNLSummary) error
+	GetLiquidationDetails(common.Event) (*funding.LiquidationDetails, error)
+	CalculateFundingPayment(data.Event) (decimal.Decimal, error)
 	CreateLiquidationOrdersForExchange(data.Event, funding.IFundingManager) ([]order.Event, error)
 	GetLatestHoldingsForAllCurrencies() []holdings.Holding
 	Reset() error
@@ -88,6 +92,12 @@ type Settings struct {
 	ComplianceManager compliance.Manager
 	Exchange          gctexchange.IBotExchange
 	FuturesTracker    *futures.MultiPositionTracker
+
+	MaintenanceMarginRate decimal.Decimal
+	LiquidationFeeRate    decimal.Decimal
+	fundingRates          []fundingrate.Rate
+	lastFundingTime       time.Time
+	liquidation           *funding.LiquidationDetails
 }
 
 // PNLSummary holds a PNL result along with
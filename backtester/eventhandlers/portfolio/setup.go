@@ -1,6 +1,7 @@
 package portfolio
 
 import (
+	"slices"
 	"strings"
 
 	"github.com/shopspring/decimal"
@@ -10,6 +11,7 @@ import (
 	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/common/key"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 )
 
@@ -73,7 +75,14 @@ func (p *Portfolio) SetCurrencySettingsMap(setup *exchange.Settings) error {
 		SellSideSizing:    setup.SellSide,
 		Leverage:          setup.Leverage,
 		HoldingsSnapshots: make(map[int64]*holdings.Holding),
+
+		MaintenanceMarginRate: setup.MaintenanceMarginRate,
+		LiquidationFeeRate:    setup.LiquidationFeeRate,
+		fundingRates:          slices.Clone(setup.FundingRates),
 	}
+	slices.SortFunc(settings.fundingRates, func(a, b fundingrate.Rate) int {
+		return a.Time.Compare(b.Time)
+	})
 	if setup.Asset.IsFutures() {
 		collateralCurrency, _, err := setup.Exchange.GetCollateralCurrencyForContract(setup.Asset, setup.Pair)
 		if err != nil {
@@ -162,7 +162,10 @@ func CalculateIndividualFundingStatistics(disableUSDTracking bool, reportItem *f
 	}
 
 	item := &FundingItemStatistics{
-		ReportItem: reportItem,
+		ReportItem:          reportItem,
+		FundingPayments:     reportItem.FundingPayments,
+		FundingPaymentCount: reportItem.FundingPaymentCount,
+		Liquidation:         reportItem.Liquidation,
 	}
 	if disableUSDTracking || reportItem.AppendedViaAPI {
 		return item, nil
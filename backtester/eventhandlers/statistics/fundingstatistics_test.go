@@ -101,8 +101,16 @@ func TestCalculateIndividualFundingStatistics(t *testing.T) {
 	_, err := CalculateIndividualFundingStatistics(true, nil, nil)
 	assert.ErrorIs(t, err, common.ErrNilPointer)
 
-	_, err = CalculateIndividualFundingStatistics(true, &funding.ReportItem{}, nil)
-	assert.NoError(t, err)
+	liquidation := &funding.LiquidationDetails{Price: decimal.NewFromInt(1337)}
+	item, err := CalculateIndividualFundingStatistics(true, &funding.ReportItem{
+		FundingPayments:     decimal.NewFromInt(-3),
+		FundingPaymentCount: 2,
+		Liquidation:         liquidation,
+	}, nil)
+	require.NoError(t, err, "CalculateIndividualFundingStatistics must not error")
+	assert.Equal(t, decimal.NewFromInt(-3), item.FundingPayments, "FundingPayments should be set from the report item")
+	assert.Equal(t, int64(2), item.FundingPaymentCount, "FundingPaymentCount should be set from the report item")
+	assert.Equal(t, liquidation, item.Liquidation, "Liquidation should be set from the report item")
 
 	_, err = CalculateIndividualFundingStatistics(false, &funding.ReportItem{}, nil)
 	assert.ErrorIs(t, err, errMissingSnapshots)
@@ -246,6 +246,10 @@ type FundingItemStatistics struct {
 	HighestHoldings ValueAtTime `json:"highest-holdings"`
 	InitialHoldings ValueAtTime `json:"initial-holdings"`
 	FinalHoldings   ValueAtTime `json:"final-holdings"`
+	// Futures cashflows
+	FundingPayments     decimal.Decimal             `json:"funding-payments"`
+	FundingPaymentCount int64                       `json:"funding-payment-count"`
+	Liquidation         *funding.LiquidationDetails `json:"liquidation,omitempty"`
 }
 
 // TotalFundingStatistics holds values for overall statistics for funding items
@@ -177,15 +177,23 @@ func (f *FundManager) AddUSDTrackingData(k *kline.DataFromKline) error {
 		if strings.EqualFold(f.items[i].exchange, k.Item.Exchange) &&
 			f.items[i].asset == k.Item.Asset {
 			if f.items[i].currency.Equal(k.Item.Pair.Base) {
-				if trackingcurrencies.CurrencyIsUSDTracked(k.Item.Pair.Quote) {
+				quoteIsUSD := trackingcurrencies.CurrencyIsUSDTracked(k.Item.Pair.Quote)
+				if quoteIsUSD {
 					f.items[i].trackingCandles = k
-					if f.items[i].pairedWith != nil {
-						basePairedWith = f.items[i].pairedWith.currency
+				} else if trackingcurrencies.CurrencyIsFXTracked(k.Item.Pair.Quote) {
+					err := f.setFXConvertedCandles(k, f.items[i])
+					if err != nil {
+						return err
 					}
+					quoteIsUSD = true
+				}
+				if quoteIsUSD && f.items[i].pairedWith != nil {
+					basePairedWith = f.items[i].pairedWith.currency
 				}
 				baseSet = true
 			}
-			if trackingcurrencies.CurrencyIsUSDTracked(f.items[i].currency) {
+			if trackingcurrencies.CurrencyIsUSDTracked(f.items[i].currency) ||
+				trackingcurrencies.CurrencyIsFXTracked(f.items[i].currency) {
 				if f.items[i].pairedWith != nil && !f.items[i].currency.Equal(basePairedWith) {
 					continue
 				}
@@ -206,7 +214,8 @@ func (f *FundManager) AddUSDTrackingData(k *kline.DataFromKline) error {
 // setUSDCandles sets usd tracking candles
 // usd stablecoins do not always match in value,
 // this is a simplified implementation that can allow
-// USD tracking for many currencies across many exchanges
+// USD tracking for many currencies across many exchanges.
+// Fiat currencies with historical fx rates use the rate of each candle
 func (f *FundManager) setUSDCandles(k *kline.DataFromKline, i *Item) error {
 	usdCandles := gctkline.Item{
 		Exchange: k.Item.Exchange,
@@ -216,16 +225,52 @@ func (f *FundManager) setUSDCandles(k *kline.DataFromKline, i *Item) error {
 		Candles:  make([]gctkline.Candle, len(k.Item.Candles)),
 	}
 	for x := range usdCandles.Candles {
+		rate, err := trackingcurrencies.USDRateAt(i.currency, k.Item.Candles[x].Time)
+		if err != nil {
+			return err
+		}
 		usdCandles.Candles[x] = gctkline.Candle{
 			Time:  k.Item.Candles[x].Time,
-			Open:  1,
-			High:  1,
-			Low:   1,
-			Close: 1,
+			Open:  rate,
+			High:  rate,
+			Low:   rate,
+			Close: rate,
+		}
+	}
+	return setTrackingCandles(k, &usdCandles, i)
+}
+
+// setFXConvertedCandles sets usd tracking candles for a base currency
+// quoted in a fiat currency by converting each candle with the fx rate
+// of its time
+func (f *FundManager) setFXConvertedCandles(k *kline.DataFromKline, i *Item) error {
+	usdCandles := gctkline.Item{
+		Exchange: k.Item.Exchange,
+		Pair:     currency.Pair{Delimiter: k.Item.Pair.Delimiter, Base: i.currency, Quote: currency.USD},
+		Asset:    k.Item.Asset,
+		Interval: k.Item.Interval,
+		Candles:  make([]gctkline.Candle, len(k.Item.Candles)),
+	}
+	for x := range usdCandles.Candles {
+		rate, err := trackingcurrencies.USDRateAt(k.Item.Pair.Quote, k.Item.Candles[x].Time)
+		if err != nil {
+			return err
+		}
+		usdCandles.Candles[x] = gctkline.Candle{
+			Time:   k.Item.Candles[x].Time,
+			Open:   k.Item.Candles[x].Open * rate,
+			High:   k.Item.Candles[x].High * rate,
+			Low:    k.Item.Candles[x].Low * rate,
+			Close:  k.Item.Candles[x].Close * rate,
+			Volume: k.Item.Candles[x].Volume,
 		}
 	}
+	return setTrackingCandles(k, &usdCandles, i)
+}
+
+func setTrackingCandles(k *kline.DataFromKline, usdCandles *gctkline.Item, i *Item) error {
 	cpy := *k
-	cpy.Item = &usdCandles
+	cpy.Item = usdCandles
 	cpy.Base = &data.Base{}
 	if err := cpy.Load(); err != nil {
 		return err
@@ -306,6 +351,10 @@ func (f *FundManager) GenerateReport() (*Report, error) {
 			FinalFunds:     f.items[x].available,
 			IsCollateral:   f.items[x].isCollateral,
 			AppendedViaAPI: f.items[x].appendedViaAPI,
+
+			FundingPayments:     f.items[x].fundingPayments,
+			FundingPaymentCount: f.items[x].fundingPaymentCnt,
+			Liquidation:         f.items[x].liquidation,
 		}
 
 		if !f.disableUSDTracking &&
@@ -773,6 +822,48 @@ func (f *FundManager) RealisePNL(receivingExchange string, receivingAsset asset.
 	return fmt.Errorf("%w to allocate %v to %v %v %v", ErrFundsNotFound, realisedPNL, receivingExchange, receivingAsset, receivingCurrency)
 }
 
+// SettleFundingPayment applies a futures funding payment to the currency which
+// receives realised PNL. Positive payments are received, negative payments are
+// paid
+func (f *FundManager) SettleFundingPayment(receivingExchange string, receivingAsset asset.Item, receivingCurrency currency.Code, payment decimal.Decimal) error {
+	for i := range f.items {
+		if f.items[i].exchange == receivingExchange &&
+			f.items[i].asset == receivingAsset &&
+			f.items[i].currency.Equal(receivingCurrency) {
+			if err := f.items[i].TakeProfit(payment); err != nil {
+				return err
+			}
+			f.items[i].fundingPayments = f.items[i].fundingPayments.Add(payment)
+			f.items[i].fundingPaymentCnt++
+			return nil
+		}
+	}
+	return fmt.Errorf("%w to settle funding payment %v to %v %v %v", ErrFundsNotFound, payment, receivingExchange, receivingAsset, receivingCurrency)
+}
+
+// SettleLiquidation records a maintenance margin liquidation against the
+// currency which receives realised PNL and returns any margin remaining after
+// the liquidation fee. It is expected to be called after Liquidate
+func (f *FundManager) SettleLiquidation(receivingExchange string, receivingAsset asset.Item, receivingCurrency currency.Code, details *LiquidationDetails) error {
+	if details == nil {
+		return fmt.Errorf("%w liquidation details", gctcommon.ErrNilPointer)
+	}
+	for i := range f.items {
+		if f.items[i].exchange == receivingExchange &&
+			f.items[i].asset == receivingAsset &&
+			f.items[i].currency.Equal(receivingCurrency) {
+			if details.RemainingMargin.IsPositive() {
+				if err := f.items[i].TakeProfit(details.RemainingMargin); err != nil {
+					return err
+				}
+			}
+			f.items[i].liquidation = details
+			return nil
+		}
+	}
+	return fmt.Errorf("%w to settle liquidation for %v %v %v", ErrFundsNotFound, receivingExchange, receivingAsset, receivingCurrency)
+}
+
 // HasExchangeBeenLiquidated checks for any items with a matching exchange
 // and returns whether it has been liquidated
 func (f *FundManager) HasExchangeBeenLiquidated(ev common.Event) bool {
@@ -14,6 +14,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/signal"
 	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/currency/fxhistory"
 	"github.com/thrasher-corp/gocryptotrader/engine"
 	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
@@ -469,6 +470,48 @@ func TestAddUSDTrackingData(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestAddUSDTrackingDataFXQuote(t *testing.T) {
+	t.Parallel()
+	tt := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	require.NoError(t, fxhistory.Add(
+		fxhistory.Rate{From: currency.SEK, To: currency.USD, Rate: 0.1, Time: tt},
+		fxhistory.Rate{From: currency.SEK, To: currency.USD, Rate: 0.2, Time: tt.Add(time.Hour)},
+	), "Add must not error")
+
+	f := FundManager{}
+	baseItem, err := CreateItem(exchName, a, currency.BTC, elite, decimal.Zero)
+	require.NoError(t, err, "CreateItem must not error")
+	quoteItem, err := CreateItem(exchName, a, currency.SEK, elite, decimal.Zero)
+	require.NoError(t, err, "CreateItem must not error")
+	require.NoError(t, f.AddItem(baseItem), "AddItem must not error")
+	require.NoError(t, f.AddItem(quoteItem), "AddItem must not error")
+
+	dfk := &kline.DataFromKline{
+		Base: &data.Base{},
+		Item: &gctkline.Item{
+			Exchange: exchName,
+			Pair:     currency.NewPair(currency.BTC, currency.SEK),
+			Asset:    a,
+			Interval: gctkline.OneHour,
+			Candles: []gctkline.Candle{
+				{Time: tt, Open: 100, High: 100, Low: 100, Close: 100},
+				{Time: tt.Add(time.Hour), Open: 100, High: 110, Low: 90, Close: 105},
+			},
+		},
+	}
+	require.NoError(t, dfk.Load(), "Load must not error")
+	require.NoError(t, f.AddUSDTrackingData(dfk), "AddUSDTrackingData must not error")
+
+	require.NotNil(t, baseItem.trackingCandles, "base item must have tracking candles")
+	assert.Equal(t, currency.USD, baseItem.trackingCandles.Item.Pair.Quote, "base tracking candles should be quoted in USD")
+	assert.Equal(t, 10.0, baseItem.trackingCandles.Item.Candles[0].Close, "base tracking candles should be converted at the fx rate")
+	assert.Equal(t, 21.0, baseItem.trackingCandles.Item.Candles[1].Close, "base tracking candles should use the fx rate of each candle")
+
+	require.NotNil(t, quoteItem.trackingCandles, "quote item must have tracking candles")
+	assert.Equal(t, 0.1, quoteItem.trackingCandles.Item.Candles[0].Close, "quote tracking candles should be the fx rate")
+	assert.Equal(t, 0.2, quoteItem.trackingCandles.Item.Candles[1].Close, "quote tracking candles should use the fx rate of each candle")
+}
+
 func TestUSDTrackingDisabled(t *testing.T) {
 	t.Parallel()
 	f := FundManager{}
@@ -600,6 +643,61 @@ func TestRealisePNL(t *testing.T) {
 	assert.ErrorIs(t, err, ErrFundsNotFound)
 }
 
+func TestSettleFundingPayment(t *testing.T) {
+	t.Parallel()
+	f := FundManager{}
+	f.items = append(f.items, &Item{
+		exchange:  "test",
+		asset:     asset.Spot,
+		currency:  currency.USDT,
+		available: decimal.NewFromInt(1000),
+	})
+
+	err := f.SettleFundingPayment("test", asset.Spot, currency.USDT, decimal.NewFromInt(-10))
+	require.NoError(t, err, "SettleFundingPayment must not error")
+	err = f.SettleFundingPayment("test", asset.Spot, currency.USDT, decimal.NewFromInt(3))
+	require.NoError(t, err, "SettleFundingPayment must not error")
+	assert.Equal(t, decimal.NewFromInt(993), f.items[0].available, "available should include funding payments")
+
+	report, err := f.GenerateReport()
+	require.NoError(t, err, "GenerateReport must not error")
+	require.Len(t, report.Items, 1, "GenerateReport must return the item")
+	assert.Equal(t, decimal.NewFromInt(-7), report.Items[0].FundingPayments, "funding payments should be summed")
+	assert.Equal(t, int64(2), report.Items[0].FundingPaymentCount, "funding payments should be counted")
+
+	err = f.SettleFundingPayment("test2", asset.Spot, currency.USDT, decimal.NewFromInt(1))
+	assert.ErrorIs(t, err, ErrFundsNotFound)
+}
+
+func TestSettleLiquidation(t *testing.T) {
+	t.Parallel()
+	f := FundManager{}
+	f.items = append(f.items, &Item{
+		exchange: "test",
+		asset:    asset.Spot,
+		currency: currency.USDT,
+	})
+
+	err := f.SettleLiquidation("test", asset.Spot, currency.USDT, nil)
+	assert.ErrorIs(t, err, gctcommon.ErrNilPointer)
+
+	details := &LiquidationDetails{
+		Exchange:        "test",
+		Asset:           asset.USDTMarginedFutures,
+		Pair:            currency.NewBTCUSDT(),
+		Price:           decimal.NewFromInt(900),
+		Fee:             decimal.NewFromInt(5),
+		RemainingMargin: decimal.NewFromInt(4),
+	}
+	err = f.SettleLiquidation("test2", asset.Spot, currency.USDT, details)
+	assert.ErrorIs(t, err, ErrFundsNotFound)
+
+	err = f.SettleLiquidation("test", asset.Spot, currency.USDT, details)
+	require.NoError(t, err, "SettleLiquidation must not error")
+	assert.Equal(t, decimal.NewFromInt(4), f.items[0].available, "remaining margin should be returned")
+	assert.Equal(t, details, f.items[0].liquidation, "liquidation details should be stored")
+}
+
 func TestCreateCollateral(t *testing.T) {
 	t.Parallel()
 	collat := &Item{
@@ -51,6 +51,8 @@ type IFundingManager interface {
 	HasFutures() bool
 	HasExchangeBeenLiquidated(handler common.Event) bool
 	RealisePNL(receivingExchange string, receivingAsset asset.Item, receivingCurrency currency.Code, realisedPNL decimal.Decimal) error
+	SettleFundingPayment(receivingExchange string, receivingAsset asset.Item, receivingCurrency currency.Code, payment decimal.Decimal) error
+	SettleLiquidation(receivingExchange string, receivingAsset asset.Item, receivingCurrency currency.Code, details *LiquidationDetails) error
 	SetFunding(string, asset.Item, *accounts.Balance, bool) error
 }
 
@@ -163,6 +165,9 @@ type Item struct {
 	isLiquidated      bool
 	appendedViaAPI    bool
 	collateralCandles map[currency.Code]kline.DataFromKline
+	fundingPayments   decimal.Decimal
+	fundingPaymentCnt int64
+	liquidation       *LiquidationDetails
 }
 
 // SpotPair holds two currencies that are associated with each other
@@ -219,6 +224,23 @@ type ReportItem struct {
 	IsCollateral         bool
 	AppendedViaAPI       bool
 	PairedWith           currency.Code
+	FundingPayments      decimal.Decimal
+	FundingPaymentCount  int64
+	Liquidation          *LiquidationDetails
+}
+
+// LiquidationDetails records a futures position liquidated by maintenance
+// margin requirements and what remained of its margin once the liquidation
+// fee was charged
+type LiquidationDetails struct {
+	Time            time.Time
+	Exchange        string
+	Asset           asset.Item
+	Pair            currency.Pair
+	Price           decimal.Decimal
+	Notional        decimal.Decimal
+	Fee             decimal.Decimal
+	RemainingMargin decimal.Decimal
 }
 
 // ItemSnapshot holds USD values to allow for tracking
@@ -1,11 +1,14 @@
 package trackingcurrencies
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"slices"
+	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/currency/fxhistory"
 	"github.com/thrasher-corp/gocryptotrader/engine"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 )
@@ -107,10 +110,33 @@ func CurrencyIsUSDTracked(code currency.Code) bool {
 	})
 }
 
+// CurrencyIsFXTracked checks if the currency passed in is a fiat
+// currency which is not USD, but has historical fx rates against USD
+// loaded to track its value
+func CurrencyIsFXTracked(code currency.Code) bool {
+	return code.IsFiatCurrency() &&
+		!CurrencyIsUSDTracked(code) &&
+		fxhistory.HasRates(code, currency.USD)
+}
+
+// USDRateAt returns the USD value of one unit of the currency at the time.
+// USD tracked currencies are treated as one USD, fx tracked currencies
+// use the historical fx rate of the time
+func USDRateAt(code currency.Code, at time.Time) (float64, error) {
+	if CurrencyIsUSDTracked(code) {
+		return 1, nil
+	}
+	if !CurrencyIsFXTracked(code) {
+		return 0, fmt.Errorf("%v %w", code, ErrCurrencyDoesNotContainUSD)
+	}
+	return fxhistory.GetRateAt(context.TODO(), code, currency.USD, at)
+}
+
 // pairContainsUSD is a simple check to ensure that the currency pair
-// has some sort of matching USD currency
+// has some sort of matching USD currency, or is quoted in a fiat currency
+// which can be converted to USD
 func pairContainsUSD(pair currency.Pair) bool {
-	return CurrencyIsUSDTracked(pair.Base) || CurrencyIsUSDTracked(pair.Quote)
+	return CurrencyIsUSDTracked(pair.Base) || CurrencyIsUSDTracked(pair.Quote) || CurrencyIsFXTracked(pair.Quote)
 }
 
 // findMatchingUSDPairs will return a USD pair for both the base and quote currency provided
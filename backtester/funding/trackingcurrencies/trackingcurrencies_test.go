@@ -2,10 +2,12 @@ package trackingcurrencies
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/currency/fxhistory"
 	"github.com/thrasher-corp/gocryptotrader/engine"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 )
@@ -221,3 +223,25 @@ func TestPairContainsUSD(t *testing.T) {
 		})
 	}
 }
+
+func TestUSDRateAt(t *testing.T) {
+	t.Parallel()
+	tt := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	rate, err := USDRateAt(currency.USDT, tt)
+	require.NoError(t, err, "USDRateAt must not error")
+	assert.Equal(t, 1.0, rate, "USDRateAt should value USD tracked currencies at 1")
+
+	assert.False(t, CurrencyIsFXTracked(currency.CHF), "CurrencyIsFXTracked should return false without fx rates")
+	_, err = USDRateAt(currency.CHF, tt)
+	assert.ErrorIs(t, err, ErrCurrencyDoesNotContainUSD)
+	assert.False(t, pairContainsUSD(currency.NewPair(currency.BTC, currency.CHF)), "pairContainsUSD should return false without fx rates")
+
+	require.NoError(t, fxhistory.Add(fxhistory.Rate{From: currency.CHF, To: currency.USD, Rate: 0.7, Time: tt}), "Add must not error")
+	assert.True(t, CurrencyIsFXTracked(currency.CHF), "CurrencyIsFXTracked should return true with fx rates")
+	assert.False(t, CurrencyIsFXTracked(currency.USD), "CurrencyIsFXTracked should return false for USD tracked currencies")
+	assert.True(t, pairContainsUSD(currency.NewPair(currency.BTC, currency.CHF)), "pairContainsUSD should return true for fx tracked quotes")
+
+	rate, err = USDRateAt(currency.CHF, tt.Add(time.Hour))
+	require.NoError(t, err, "USDRateAt must not error")
+	assert.Equal(t, 0.7, rate, "USDRateAt should return the historical fx rate")
+}
@@ -230,6 +230,16 @@ func TestGenerateReport(t *testing.T) {
 					{
 						ReportItem: &funding.ReportItem{Snapshots: []funding.ItemSnapshot{{Time: time.Now()}}},
 					},
+					{
+						ReportItem:          &funding.ReportItem{IsCollateral: true, Snapshots: []funding.ItemSnapshot{{Time: time.Now()}}},
+						FundingPayments:     decimal.NewFromInt(-2),
+						FundingPaymentCount: 3,
+						Liquidation: &funding.LiquidationDetails{
+							Time:  time.Now(),
+							Price: decimal.NewFromInt(1337),
+							Fee:   decimal.NewFromInt(1),
+						},
+					},
 				},
 				TotalUSDStatistics: &statistics.TotalFundingStatistics{},
 			},
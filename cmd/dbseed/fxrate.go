@@ -0,0 +1,150 @@
+package main
+
+import (
+	"errors"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/currency/forexprovider"
+	"github.com/thrasher-corp/gocryptotrader/currency/forexprovider/base"
+	"github.com/thrasher-corp/gocryptotrader/currency/fxhistory"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/fxrate"
+	"github.com/urfave/cli/v2"
+)
+
+var errFXRateFlagsNotSet = errors.New("base, symbols, start and end must be set")
+
+var seedFXRateCommand = &cli.Command{
+	Name:  "fxrate",
+	Usage: "seed historical foreign exchange rates",
+	Subcommands: []*cli.Command{
+		{
+			Name:  "file",
+			Usage: "seed fx rates from a file",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:      "filename",
+					Usage:     "csv file to load fx rates from (see readme for formatting details)",
+					TakesFile: true,
+					FilePath:  workingDir,
+				},
+			},
+			Action: seedFXRateFromFile,
+		},
+		{
+			Name:  "provider",
+			Usage: "seed daily fx rates from the forex providers enabled in the config",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "base",
+					Usage: "base currency of the rates",
+				},
+				&cli.StringFlag{
+					Name:  "symbols",
+					Usage: "comma separated currencies to fetch rates of",
+				},
+				&cli.StringFlag{
+					Name:  "start",
+					Usage: "first date to fetch rates of, formatted as " + time.DateOnly,
+				},
+				&cli.StringFlag{
+					Name:  "end",
+					Usage: "last date to fetch rates of, formatted as " + time.DateOnly,
+				},
+			},
+			Action: seedFXRateFromProvider,
+		},
+	},
+}
+
+func seedFXRateFromFile(c *cli.Context) error {
+	if c.NumFlags() == 0 && c.NArg() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var fileName string
+	if c.IsSet("filename") {
+		fileName = c.String("filename")
+	} else {
+		fileName = c.Args().First()
+	}
+
+	_, err := os.Stat(fileName)
+	if err != nil {
+		return err
+	}
+
+	err = load(c)
+	if err != nil {
+		return err
+	}
+
+	totalInserted, err := fxrate.InsertFromCSV(c.Context, fileName)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Inserted: %v records", totalInserted)
+	return nil
+}
+
+func seedFXRateFromProvider(c *cli.Context) error {
+	if c.NumFlags() == 0 && c.NArg() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	baseCurrency := c.String("base")
+	symbols := c.String("symbols")
+	if baseCurrency == "" || symbols == "" || c.String("start") == "" || c.String("end") == "" {
+		return errFXRateFlagsNotSet
+	}
+	start, err := time.Parse(time.DateOnly, c.String("start"))
+	if err != nil {
+		return err
+	}
+	end, err := time.Parse(time.DateOnly, c.String("end"))
+	if err != nil {
+		return err
+	}
+
+	var conf config.Config
+	err = conf.LoadConfig(c.String("config"), true)
+	if err != nil {
+		return err
+	}
+	fxSettings := make([]base.Settings, 0, len(conf.Currency.ForexProviders))
+	for i := range conf.Currency.ForexProviders {
+		if conf.Currency.ForexProviders[i].Enabled {
+			fxSettings = append(fxSettings, base.Settings(conf.Currency.ForexProviders[i]))
+		}
+	}
+	fx, err := forexprovider.StartFXService(fxSettings)
+	if err != nil {
+		return err
+	}
+
+	rates, err := fxhistory.NewStore(0).FetchFromProvider(fx,
+		currency.NewCode(baseCurrency),
+		currency.NewCurrenciesFromStringArray(strings.Split(symbols, ",")),
+		start, end)
+	if err != nil {
+		return err
+	}
+
+	err = load(c)
+	if err != nil {
+		return err
+	}
+
+	err = fxhistory.SaveToDatabase(c.Context, rates...)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Inserted: %v records", len(rates))
+	return nil
+}
@@ -591,6 +591,10 @@ var getAccountBalancesCommand = &cli.Command{
 			Usage:    "the asset type to get the account balances for",
 			Required: true,
 		},
+		&cli.StringFlag{
+			Name:  "valuation_currency",
+			Usage: "the currency to value balances in, defaults to the fiat display currency",
+		},
 	},
 }
 
@@ -625,8 +629,9 @@ func getAccountBalances(c *cli.Context) error {
 	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
 	result, err := client.GetAccountBalances(c.Context,
 		&gctrpc.GetAccountBalancesRequest{
-			Exchange:  exchange,
-			AssetType: assetType,
+			Exchange:          exchange,
+			AssetType:         assetType,
+			ValuationCurrency: c.String("valuation_currency"),
 		},
 	)
 	if err != nil {
@@ -722,6 +727,10 @@ var updateAccountBalancesCommand = &cli.Command{
 			Name:  "asset",
 			Usage: "the asset type to get the account balances for",
 		},
+		&cli.StringFlag{
+			Name:  "valuation_currency",
+			Usage: "the currency to value balances in, defaults to the fiat display currency",
+		},
 	},
 }
 
@@ -757,8 +766,9 @@ func updateAccountBalances(c *cli.Context) error {
 	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
 	result, err := client.UpdateAccountBalances(c.Context,
 		&gctrpc.GetAccountBalancesRequest{
-			Exchange:  exchange,
-			AssetType: assetType,
+			Exchange:          exchange,
+			AssetType:         assetType,
+			ValuationCurrency: c.String("valuation_currency"),
 		},
 	)
 	if err != nil {
@@ -819,6 +829,12 @@ var getPortfolioSummaryCommand = &cli.Command{
 	Name:   "getportfoliosummary",
 	Usage:  "gets the portfolio summary",
 	Action: getPortfolioSummary,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "valuation_currency",
+			Usage: "the currency to value holdings in, defaults to the fiat display currency",
+		},
+	},
 }
 
 func getPortfolioSummary(c *cli.Context) error {
@@ -829,7 +845,9 @@ func getPortfolioSummary(c *cli.Context) error {
 	defer closeConn(conn, cancel)
 
 	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
-	result, err := client.GetPortfolioSummary(c.Context, &gctrpc.GetPortfolioSummaryRequest{})
+	result, err := client.GetPortfolioSummary(c.Context, &gctrpc.GetPortfolioSummaryRequest{
+		ValuationCurrency: c.String("valuation_currency"),
+	})
 	if err != nil {
 		return err
 	}
@@ -841,7 +859,7 @@ func getPortfolioSummary(c *cli.Context) error {
 var addPortfolioAddressCommand = &cli.Command{
 	Name:      "addportfolioaddress",
 	Usage:     "adds an address to the portfolio",
-	ArgsUsage: "<address> <coin_type> <description> <balance> <cold_storage> <supported_exchanges> ",
+	ArgsUsage: "<address> <coin_type> <description> <balance> <cold_storage> <supported_exchanges> <chain>",
 	Action:    addPortfolioAddress,
 	Flags: []cli.Flag{
 		&cli.StringFlag{
@@ -868,6 +886,10 @@ var addPortfolioAddressCommand = &cli.Command{
 			Name:  "supported_exchanges",
 			Usage: "common separated list of exchanges supported by this address for withdrawals",
 		},
+		&cli.StringFlag{
+			Name:  "chain",
+			Usage: "the chain of the address, picks the on-chain provider which fetches its balance e.g ('tron')",
+		},
 	},
 }
 
@@ -882,6 +904,7 @@ func addPortfolioAddress(c *cli.Context) error {
 	var balance float64
 	var supportedExchanges string
 	var coldstorage bool
+	var chain string
 
 	if c.IsSet("address") {
 		address = c.String("address")
@@ -925,6 +948,12 @@ func addPortfolioAddress(c *cli.Context) error {
 		supportedExchanges = c.Args().Get(5)
 	}
 
+	if c.IsSet("chain") {
+		chain = c.String("chain")
+	} else {
+		chain = c.Args().Get(6)
+	}
+
 	conn, cancel, err := setupClient(c)
 	if err != nil {
 		return err
@@ -940,6 +969,7 @@ func addPortfolioAddress(c *cli.Context) error {
 			Balance:            balance,
 			SupportedExchanges: supportedExchanges,
 			ColdStorage:        coldstorage,
+			Chain:              chain,
 		},
 	)
 	if err != nil {
@@ -2549,6 +2579,14 @@ var withdrawCryptocurrencyFundsCommand = &cli.Command{
 			Name:  "chain",
 			Usage: "chain to use for the withdrawal",
 		},
+		&cli.StringFlag{
+			Name:  "requestedby",
+			Usage: "the approver requesting the withdrawal, used when withdrawals require approval",
+		},
+		&cli.StringFlag{
+			Name:  "requestedbytoken",
+			Usage: "the token of the approver requesting the withdrawal",
+		},
 	},
 }
 
@@ -2624,14 +2662,16 @@ func withdrawCryptocurrencyFunds(c *cli.Context) error {
 
 	result, err := client.WithdrawCryptocurrencyFunds(c.Context,
 		&gctrpc.WithdrawCryptoRequest{
-			Exchange:    exchange,
-			Currency:    cur,
-			Address:     address,
-			AddressTag:  addressTag,
-			Amount:      amount,
-			Fee:         fee,
-			Description: description,
-			Chain:       chain,
+			Exchange:         exchange,
+			Currency:         cur,
+			Address:          address,
+			AddressTag:       addressTag,
+			Amount:           amount,
+			Fee:              fee,
+			Description:      description,
+			Chain:            chain,
+			RequestedBy:      c.String("requestedby"),
+			RequestedByToken: c.String("requestedbytoken"),
 		},
 	)
 	if err != nil {
@@ -2667,6 +2707,14 @@ var withdrawFiatFundsCommand = &cli.Command{
 			Name:  "description",
 			Usage: "description to submit with request",
 		},
+		&cli.StringFlag{
+			Name:  "requestedby",
+			Usage: "the approver requesting the withdrawal, used when withdrawals require approval",
+		},
+		&cli.StringFlag{
+			Name:  "requestedbytoken",
+			Usage: "the token of the approver requesting the withdrawal",
+		},
 	},
 }
 
@@ -2720,11 +2768,13 @@ func withdrawFiatFunds(c *cli.Context) error {
 	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
 	result, err := client.WithdrawFiatFunds(c.Context,
 		&gctrpc.WithdrawFiatRequest{
-			Exchange:      exchange,
-			Currency:      cur,
-			Amount:        amount,
-			Description:   description,
-			BankAccountId: bankAccountID,
+			Exchange:         exchange,
+			Currency:         cur,
+			Amount:           amount,
+			Description:      description,
+			BankAccountId:    bankAccountID,
+			RequestedBy:      c.String("requestedby"),
+			RequestedByToken: c.String("requestedbytoken"),
 		},
 	)
 	if err != nil {
@@ -3009,33 +3059,100 @@ func withdrawalRequestByDate(c *cli.Context) error {
 	return nil
 }
 
-var getLoggerDetailsCommand = &cli.Command{
-	Name:      "getloggerdetails",
-	Usage:     "gets an individual loggers details",
-	ArgsUsage: "<logger>",
-	Action:    getLoggerDetails,
-	Flags: []cli.Flag{
-		&cli.StringFlag{
-			Name:  "logger",
-			Usage: "logger to get level details of",
+var withdrawalAddressCommand = &cli.Command{
+	Name:      "withdrawaladdress",
+	Usage:     "manages the address book of approved withdrawal destinations",
+	ArgsUsage: "<command> <args>",
+	Subcommands: []*cli.Command{
+		{
+			Name:      "add",
+			Usage:     "adds an approved withdrawal destination",
+			ArgsUsage: "<currency> <address> <addresstag> <chain> <label>",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "currency",
+					Usage: "the cryptocurrency the address receives",
+				},
+				&cli.StringFlag{
+					Name:  "address",
+					Usage: "the destination address",
+				},
+				&cli.StringFlag{
+					Name:  "addresstag",
+					Usage: "address tag/memo",
+				},
+				&cli.StringFlag{
+					Name:  "chain",
+					Usage: "the chain the address is on",
+				},
+				&cli.StringFlag{
+					Name:  "label",
+					Usage: "a label to identify the address",
+				},
+			},
+			Action: addWithdrawalAddress,
+		},
+		{
+			Name:      "remove",
+			Usage:     "removes a withdrawal destination by id",
+			ArgsUsage: "<id>",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "id",
+					Usage: "withdrawal address id",
+				},
+			},
+			Action: removeWithdrawalAddress,
+		},
+		{
+			Name:      "get",
+			Usage:     "returns the approved withdrawal destinations",
+			ArgsUsage: "<currency>",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "currency",
+					Usage: "only return addresses for this currency",
+				},
+			},
+			Action: getWithdrawalAddresses,
 		},
 	},
 }
 
-func getLoggerDetails(c *cli.Context) error {
+func addWithdrawalAddress(c *cli.Context) error {
 	if c.NArg() == 0 && c.NumFlags() == 0 {
 		return cli.ShowSubcommandHelp(c)
 	}
 
-	var logger string
-	if c.IsSet("logger") {
-		logger = c.String("logger")
+	var cur, address, addressTag, chain, label string
+	if c.IsSet("currency") {
+		cur = c.String("currency")
 	} else {
-		logger = c.Args().First()
+		cur = c.Args().First()
 	}
 
-	if logger == "" {
-		return errors.New("a logger must be specified")
+	if c.IsSet("address") {
+		address = c.String("address")
+	} else {
+		address = c.Args().Get(1)
+	}
+
+	if c.IsSet("addresstag") {
+		addressTag = c.String("addresstag")
+	} else {
+		addressTag = c.Args().Get(2)
+	}
+
+	if c.IsSet("chain") {
+		chain = c.String("chain")
+	} else {
+		chain = c.Args().Get(3)
+	}
+
+	if c.IsSet("label") {
+		label = c.String("label")
+	} else {
+		label = c.Args().Get(4)
 	}
 
 	conn, cancel, err := setupClient(c)
@@ -3045,10 +3162,13 @@ func getLoggerDetails(c *cli.Context) error {
 	defer closeConn(conn, cancel)
 
 	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
-
-	result, err := client.GetLoggerDetails(c.Context,
-		&gctrpc.GetLoggerDetailsRequest{
-			Logger: logger,
+	result, err := client.AddWithdrawalAddress(c.Context,
+		&gctrpc.AddWithdrawalAddressRequest{
+			Currency:   cur,
+			Address:    address,
+			AddressTag: addressTag,
+			Chain:      chain,
+			Label:      label,
 		},
 	)
 	if err != nil {
@@ -3058,49 +3178,47 @@ func getLoggerDetails(c *cli.Context) error {
 	return nil
 }
 
-var setLoggerDetailsCommand = &cli.Command{
-	Name:      "setloggerdetails",
-	Usage:     "sets an individual loggers details",
-	ArgsUsage: "<logger> <flags>",
-	Action:    setLoggerDetails,
-	Flags: []cli.Flag{
-		&cli.StringFlag{
-			Name:  "logger",
-			Usage: "logger to get level details of",
-		},
-		&cli.StringFlag{
-			Name:  "flags",
-			Usage: "pipe separated value of loggers e.g INFO|WARN",
-		},
-	},
-}
-
-func setLoggerDetails(c *cli.Context) error {
+func removeWithdrawalAddress(c *cli.Context) error {
 	if c.NArg() == 0 && c.NumFlags() == 0 {
 		return cli.ShowSubcommandHelp(c)
 	}
 
-	var logger string
-	var level string
-
-	if c.IsSet("logger") {
-		logger = c.String("logger")
+	var ID string
+	if c.IsSet("id") {
+		ID = c.String("id")
 	} else {
-		logger = c.Args().First()
+		ID = c.Args().First()
 	}
 
-	if logger == "" {
-		return errors.New("a logger must be specified")
+	if ID == "" {
+		return errors.New("an ID must be specified")
 	}
 
-	if c.IsSet("level") {
-		level = c.String("level")
-	} else {
-		level = c.Args().Get(1)
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
 	}
+	defer closeConn(conn, cancel)
 
-	if level == "" {
-		return errors.New("level must be specified")
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.RemoveWithdrawalAddress(c.Context,
+		&gctrpc.RemoveWithdrawalAddressRequest{
+			Id: ID,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
+
+func getWithdrawalAddresses(c *cli.Context) error {
+	var cur string
+	if c.IsSet("currency") {
+		cur = c.String("currency")
+	} else {
+		cur = c.Args().First()
 	}
 
 	conn, cancel, err := setupClient(c)
@@ -3110,11 +3228,9 @@ func setLoggerDetails(c *cli.Context) error {
 	defer closeConn(conn, cancel)
 
 	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
-
-	result, err := client.SetLoggerDetails(c.Context,
-		&gctrpc.SetLoggerDetailsRequest{
-			Logger: logger,
-			Level:  level,
+	result, err := client.GetWithdrawalAddresses(c.Context,
+		&gctrpc.GetWithdrawalAddressesRequest{
+			Currency: cur,
 		},
 	)
 	if err != nil {
@@ -3124,67 +3240,334 @@ func setLoggerDetails(c *cli.Context) error {
 	return nil
 }
 
-var getTickerStreamCommand = &cli.Command{
-	Name:      "gettickerstream",
-	Usage:     "gets the ticker stream for a specific currency pair and exchange",
-	ArgsUsage: "<exchange> <pair> <asset>",
-	Action:    getTickerStream,
-	Flags: []cli.Flag{
-		&cli.StringFlag{
-			Name:  "exchange",
-			Usage: "the exchange to get the ticker from",
+var pendingWithdrawalCommand = &cli.Command{
+	Name:      "pendingwithdrawal",
+	Usage:     "manages withdrawals awaiting approval",
+	ArgsUsage: "<command> <args>",
+	Subcommands: []*cli.Command{
+		{
+			Name:   "get",
+			Usage:  "returns the withdrawals awaiting approval",
+			Action: getPendingWithdrawals,
 		},
-		&cli.StringFlag{
-			Name:  "pair",
-			Usage: "currency pair",
+		{
+			Name:      "approve",
+			Usage:     "approves a pending withdrawal, submitting it once it has enough approvals",
+			ArgsUsage: "<id> <approver> <token>",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "id",
+					Usage: "pending withdrawal id",
+				},
+				&cli.StringFlag{
+					Name:  "approver",
+					Usage: "who is approving the withdrawal",
+				},
+				&cli.StringFlag{
+					Name:  "token",
+					Usage: "the approver's token from the withdraw manager config",
+				},
+			},
+			Action: approveWithdrawal,
 		},
-		&cli.StringFlag{
-			Name:  "asset",
-			Usage: "the asset type of the currency pair",
+		{
+			Name:      "reject",
+			Usage:     "rejects and discards a pending withdrawal",
+			ArgsUsage: "<id> <approver> <token>",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "id",
+					Usage: "pending withdrawal id",
+				},
+				&cli.StringFlag{
+					Name:  "approver",
+					Usage: "who is rejecting the withdrawal",
+				},
+				&cli.StringFlag{
+					Name:  "token",
+					Usage: "the approver's token from the withdraw manager config",
+				},
+			},
+			Action: rejectWithdrawal,
 		},
 	},
 }
 
-func getTickerStream(c *cli.Context) error {
-	if c.NArg() == 0 && c.NumFlags() == 0 {
-		return cli.ShowSubcommandHelp(c)
+func getPendingWithdrawals(c *cli.Context) error {
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
 	}
+	defer closeConn(conn, cancel)
 
-	var exchangeName string
-	var pair string
-	var assetType string
-
-	if c.IsSet("exchange") {
-		exchangeName = c.String("exchange")
-	} else {
-		exchangeName = c.Args().First()
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetPendingWithdrawals(c.Context, &gctrpc.GetPendingWithdrawalsRequest{})
+	if err != nil {
+		return err
 	}
+	jsonOutput(result)
+	return nil
+}
 
-	if c.IsSet("pair") {
-		pair = c.String("pair")
-	} else {
-		pair = c.Args().Get(1)
+func approveWithdrawal(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
 	}
 
-	if !validPair(pair) {
-		return errInvalidPair
+	ID, approver, token := pendingWithdrawalArgs(c)
+	if ID == "" {
+		return errors.New("an ID must be specified")
 	}
 
-	if c.IsSet("asset") {
-		assetType = c.String("asset")
-	} else {
-		assetType = c.Args().Get(2)
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
 	}
+	defer closeConn(conn, cancel)
 
-	assetType = strings.ToLower(assetType)
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.ApproveWithdrawal(c.Context,
+		&gctrpc.ApproveWithdrawalRequest{
+			Id:            ID,
+			Approver:      approver,
+			ApproverToken: token,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
 
-	if !validAsset(assetType) {
-		return errInvalidAsset
+func rejectWithdrawal(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
 	}
 
-	p, err := currency.NewPairDelimiter(pair, pairDelimiter)
-	if err != nil {
-		return err
+	ID, approver, token := pendingWithdrawalArgs(c)
+	if ID == "" {
+		return errors.New("an ID must be specified")
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.RejectWithdrawal(c.Context,
+		&gctrpc.RejectWithdrawalRequest{
+			Id:            ID,
+			Approver:      approver,
+			ApproverToken: token,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
+
+func pendingWithdrawalArgs(c *cli.Context) (id, approver, token string) {
+	if c.IsSet("id") {
+		id = c.String("id")
+	} else {
+		id = c.Args().First()
+	}
+
+	if c.IsSet("approver") {
+		approver = c.String("approver")
+	} else {
+		approver = c.Args().Get(1)
+	}
+
+	if c.IsSet("token") {
+		token = c.String("token")
+	} else {
+		token = c.Args().Get(2)
+	}
+	return id, approver, token
+}
+
+var getLoggerDetailsCommand = &cli.Command{
+	Name:      "getloggerdetails",
+	Usage:     "gets an individual loggers details",
+	ArgsUsage: "<logger>",
+	Action:    getLoggerDetails,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "logger",
+			Usage: "logger to get level details of",
+		},
+	},
+}
+
+func getLoggerDetails(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var logger string
+	if c.IsSet("logger") {
+		logger = c.String("logger")
+	} else {
+		logger = c.Args().First()
+	}
+
+	if logger == "" {
+		return errors.New("a logger must be specified")
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+
+	result, err := client.GetLoggerDetails(c.Context,
+		&gctrpc.GetLoggerDetailsRequest{
+			Logger: logger,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
+
+var setLoggerDetailsCommand = &cli.Command{
+	Name:      "setloggerdetails",
+	Usage:     "sets an individual loggers details",
+	ArgsUsage: "<logger> <flags>",
+	Action:    setLoggerDetails,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "logger",
+			Usage: "logger to get level details of",
+		},
+		&cli.StringFlag{
+			Name:  "flags",
+			Usage: "pipe separated value of loggers e.g INFO|WARN",
+		},
+	},
+}
+
+func setLoggerDetails(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var logger string
+	var level string
+
+	if c.IsSet("logger") {
+		logger = c.String("logger")
+	} else {
+		logger = c.Args().First()
+	}
+
+	if logger == "" {
+		return errors.New("a logger must be specified")
+	}
+
+	if c.IsSet("level") {
+		level = c.String("level")
+	} else {
+		level = c.Args().Get(1)
+	}
+
+	if level == "" {
+		return errors.New("level must be specified")
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+
+	result, err := client.SetLoggerDetails(c.Context,
+		&gctrpc.SetLoggerDetailsRequest{
+			Logger: logger,
+			Level:  level,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
+
+var getTickerStreamCommand = &cli.Command{
+	Name:      "gettickerstream",
+	Usage:     "gets the ticker stream for a specific currency pair and exchange",
+	ArgsUsage: "<exchange> <pair> <asset>",
+	Action:    getTickerStream,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to get the ticker from",
+		},
+		&cli.StringFlag{
+			Name:  "pair",
+			Usage: "currency pair",
+		},
+		&cli.StringFlag{
+			Name:  "asset",
+			Usage: "the asset type of the currency pair",
+		},
+	},
+}
+
+func getTickerStream(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var exchangeName string
+	var pair string
+	var assetType string
+
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	if c.IsSet("pair") {
+		pair = c.String("pair")
+	} else {
+		pair = c.Args().Get(1)
+	}
+
+	if !validPair(pair) {
+		return errInvalidPair
+	}
+
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(2)
+	}
+
+	assetType = strings.ToLower(assetType)
+
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	p, err := currency.NewPairDelimiter(pair, pairDelimiter)
+	if err != nil {
+		return err
 	}
 
 	conn, cancel, err := setupClient(c)
@@ -4606,3 +4989,415 @@ func getCurrencyTradeURL(c *cli.Context) error {
 	jsonOutput(result)
 	return nil
 }
+
+var getCandleStreamCommand = &cli.Command{
+	Name:      "getcandlestream",
+	Usage:     "streams live candles aggregated from websocket trades for a specific currency pair and exchange",
+	ArgsUsage: "<exchange> <pair> <asset> <granularity>",
+	Action:    getCandleStream,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:    "exchange",
+			Aliases: []string{"e"},
+			Usage:   "the exchange to stream candles from",
+		},
+		&cli.StringFlag{
+			Name:  "pair",
+			Usage: "the currency pair to stream candles for",
+		},
+		&cli.StringFlag{
+			Name:  "asset",
+			Usage: "the asset type of the currency pair",
+		},
+		&cli.Int64Flag{
+			Name:    "granularity",
+			Aliases: []string{"g"},
+			Usage:   klineMessage,
+			Value:   60,
+		},
+	},
+}
+
+func getCandleStream(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	var pair string
+	if c.IsSet("pair") {
+		pair = c.String("pair")
+	} else {
+		pair = c.Args().Get(1)
+	}
+
+	if !validPair(pair) {
+		return errInvalidPair
+	}
+
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(2)
+	}
+
+	assetType = strings.ToLower(assetType)
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	granularity := c.Int64("granularity")
+	if !c.IsSet("granularity") && c.Args().Get(3) != "" {
+		var err error
+		granularity, err = strconv.ParseInt(c.Args().Get(3), 10, 64)
+		if err != nil {
+			return err
+		}
+	}
+
+	p, err := currency.NewPairDelimiter(pair, pairDelimiter)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	candleInterval := time.Duration(granularity) * time.Second
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetCandleStream(c.Context,
+		&gctrpc.GetCandleStreamRequest{
+			Exchange: exchangeName,
+			Pair: &gctrpc.CurrencyPair{
+				Base:      p.Base.String(),
+				Quote:     p.Quote.String(),
+				Delimiter: p.Delimiter,
+			},
+			AssetType:    assetType,
+			TimeInterval: int64(candleInterval),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	for {
+		resp, err := result.Recv()
+		if err != nil {
+			return err
+		}
+
+		err = clearScreen()
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("%s candle stream for %s %s %s:\n", resp.Interval, resp.Exchange, resp.Pair, resp.Asset)
+		fmt.Println()
+
+		fmt.Printf("TIME: %s\n OPEN: %f\n HIGH: %f\n LOW: %f\n CLOSE: %f\n VOLUME: %f\n PARTIAL: %t\n",
+			resp.Candle.Time,
+			resp.Candle.Open,
+			resp.Candle.High,
+			resp.Candle.Low,
+			resp.Candle.Close,
+			resp.Candle.Volume,
+			resp.Candle.IsPartial)
+	}
+}
+
+var getRateLimitUsageCommand = &cli.Command{
+	Name:      "getratelimitusage",
+	Usage:     "gets the rate limit usage last reported by an exchange in its response headers",
+	ArgsUsage: "<exchange>",
+	Action:    getRateLimitUsage,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:    "exchange",
+			Aliases: []string{"e"},
+			Usage:   "the exchange to get the rate limit usage for",
+		},
+	},
+}
+
+func getRateLimitUsage(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetRateLimitUsage(c.Context,
+		&gctrpc.GetRateLimitUsageRequest{
+			Exchange: exchangeName,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var getMicrostructureStreamCommand = &cli.Command{
+	Name:      "getmicrostructurestream",
+	Usage:     "streams market microstructure signals derived from orderbook updates for a specific currency pair and exchange",
+	ArgsUsage: "<exchange> <pair> <asset>",
+	Action:    getMicrostructureStream,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:    "exchange",
+			Aliases: []string{"e"},
+			Usage:   "the exchange to stream microstructure signals from",
+		},
+		&cli.StringFlag{
+			Name:  "pair",
+			Usage: "the currency pair to stream microstructure signals for",
+		},
+		&cli.StringFlag{
+			Name:  "asset",
+			Usage: "the asset type of the currency pair",
+		},
+	},
+}
+
+func getMicrostructureStream(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	var pair string
+	if c.IsSet("pair") {
+		pair = c.String("pair")
+	} else {
+		pair = c.Args().Get(1)
+	}
+
+	if !validPair(pair) {
+		return errInvalidPair
+	}
+
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(2)
+	}
+
+	assetType = strings.ToLower(assetType)
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	p, err := currency.NewPairDelimiter(pair, pairDelimiter)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetMicrostructureStream(c.Context,
+		&gctrpc.GetMicrostructureStreamRequest{
+			Exchange: exchangeName,
+			Pair: &gctrpc.CurrencyPair{
+				Base:      p.Base.String(),
+				Quote:     p.Quote.String(),
+				Delimiter: p.Delimiter,
+			},
+			AssetType: assetType,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	for {
+		resp, err := result.Recv()
+		if err != nil {
+			return err
+		}
+
+		err = clearScreen()
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Microstructure stream for %s %s %s:\n", resp.Exchange, resp.Pair, resp.Asset)
+		fmt.Println()
+
+		fmt.Printf("UPDATED: %s\n MID: %f\n MICROPRICE: %f\n OFI: %f\n CUMULATIVE OFI: %f\n RESILIENCE: %s\n RECOVERING: %t\n REALISED SPREAD (BPS): %f\n",
+			resp.UpdateTime.AsTime(),
+			resp.MidPrice,
+			resp.Microprice,
+			resp.OrderFlowImbalance,
+			resp.CumulativeOrderFlowImbalance,
+			time.Duration(resp.ResilienceMicros)*time.Microsecond,
+			resp.Recovering,
+			resp.RealisedSpreadBps)
+		for _, d := range resp.Depth {
+			fmt.Printf(" DEPTH @ %g BPS: BIDS %f ASKS %f\n", d.BasisPoints, d.BidAmount, d.AskAmount)
+		}
+	}
+}
+
+var reloadConfigCommand = &cli.Command{
+	Name:   "reloadconfig",
+	Usage:  "reloads the config file into the running engine",
+	Action: reloadConfig,
+}
+
+func reloadConfig(c *cli.Context) error {
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.ReloadConfig(c.Context, &gctrpc.ReloadConfigRequest{})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var treasuryCommand = &cli.Command{
+	Name:      "treasury",
+	Usage:     "manages transfers between exchanges made by the treasury manager",
+	ArgsUsage: "<command> <args>",
+	Subcommands: []*cli.Command{
+		{
+			Name:   "transfers",
+			Usage:  "returns the transfers made by the treasury manager",
+			Action: getTreasuryTransfers,
+		},
+		{
+			Name:  "rebalance",
+			Usage: "submits the transfers needed to bring each currency back within its target allocations",
+			Flags: []cli.Flag{
+				&cli.BoolFlag{
+					Name:  "planonly",
+					Usage: "returns the transfers which would be made without submitting them",
+				},
+			},
+			Action: rebalanceTreasury,
+		},
+	},
+}
+
+func getTreasuryTransfers(c *cli.Context) error {
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetTreasuryTransfers(c.Context, &gctrpc.GetTreasuryTransfersRequest{})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func rebalanceTreasury(c *cli.Context) error {
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.RebalanceTreasury(c.Context, &gctrpc.RebalanceTreasuryRequest{
+		PlanOnly: c.Bool("planonly"),
+	})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var getFundingTransfersCommand = &cli.Command{
+	Name:      "getfundingtransfers",
+	Usage:     "returns the deposits and withdrawals followed by the funding tracker",
+	ArgsUsage: "<exchange>",
+	Action:    getFundingTransfers,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to return transfers for, or all exchanges when empty",
+		},
+		&cli.BoolFlag{
+			Name:  "activeonly",
+			Usage: "returns only the transfers which have not been credited or failed",
+		},
+	},
+}
+
+func getFundingTransfers(c *cli.Context) error {
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetFundingTransfers(c.Context, &gctrpc.GetFundingTransfersRequest{
+		Exchange:   exchangeName,
+		ActiveOnly: c.Bool("activeonly"),
+	})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
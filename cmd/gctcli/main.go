@@ -29,6 +29,7 @@ var (
 	certPath      string
 	timeout       time.Duration
 	exchangeCreds accounts.Credentials
+	account       string
 	verbose       bool
 	ignoreTimeout bool
 )
@@ -65,6 +66,9 @@ func setupClient(c *cli.Context) (*grpc.ClientConn, context.CancelFunc, error) {
 		flag, values := exchangeCreds.GetMetaData()
 		c.Context = metadata.AppendToOutgoingContext(c.Context, flag, values)
 	}
+	if account != "" {
+		c.Context = metadata.AppendToOutgoingContext(c.Context, string(accounts.ContextAccountFlag), account)
+	}
 	if verbose {
 		c.Context = metadata.AppendToOutgoingContext(c.Context, "verbose", "true")
 	}
@@ -145,6 +149,11 @@ func main() {
 			Usage:       "override config API One Time Password (OTP) for request",
 			Destination: &exchangeCreds.OneTimePassword,
 		},
+		&cli.StringFlag{
+			Name:        "account",
+			Usage:       "use the named account profile credentials from the exchange config for request",
+			Destination: &account,
+		},
 		&cli.BoolFlag{
 			Name:        "verbose",
 			Usage:       "allows the request to generate a more verbose outputs server side",
@@ -200,6 +209,8 @@ func main() {
 		withdrawCryptocurrencyFundsCommand,
 		withdrawFiatFundsCommand,
 		withdrawalRequestCommand,
+		withdrawalAddressCommand,
+		pendingWithdrawalCommand,
 		getLoggerDetailsCommand,
 		setLoggerDetailsCommand,
 		exchangePairManagerCommand,
@@ -220,6 +231,12 @@ func main() {
 		getMarginRatesHistoryCommand,
 		orderbookCommand,
 		getCurrencyTradeURLCommand,
+		getCandleStreamCommand,
+		getRateLimitUsageCommand,
+		getMicrostructureStreamCommand,
+		reloadConfigCommand,
+		treasuryCommand,
+		getFundingTransfersCommand,
 	}
 
 	ctx, cancel := context.WithCancel(context.Background())
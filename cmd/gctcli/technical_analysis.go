@@ -24,6 +24,14 @@ var (
 	taMovingAverageType string
 	taStdDevUp          float64
 	taStdDevDown        float64
+	taSmoothingPeriod   int64
+	taSignalPeriod      int64
+	taSecondaryPeriod   int64
+	taMultiplier        float64
+	taAccelerationStep  float64
+	taAccelerationMax   float64
+	taDisplacement      int64
+	taSessionInterval   int64
 )
 
 var commonFlag = []cli.Flag{
@@ -91,6 +99,78 @@ var (
 		Value:       1.5,
 		Destination: &taStdDevDown,
 	}
+	smoothingFlag = &cli.Int64Flag{
+		Name:        "smoothingperiod",
+		Usage:       "denotes the %K smoothing period for stochastic generation",
+		Value:       3,
+		Destination: &taSmoothingPeriod,
+	}
+	signalFlag = &cli.Int64Flag{
+		Name:        "signalperiod",
+		Usage:       "denotes the %D signal period for stochastic generation",
+		Value:       3,
+		Destination: &taSignalPeriod,
+	}
+	stochasticPeriodFlag = &cli.Int64Flag{
+		Name:        "stochasticperiod",
+		Usage:       "denotes the stochastic lookback period applied to the rsi",
+		Value:       14,
+		Destination: &taSecondaryPeriod,
+	}
+	atrPeriodFlag = &cli.Int64Flag{
+		Name:        "atrperiod",
+		Usage:       "denotes the average true range period for the channel width",
+		Value:       10,
+		Destination: &taSecondaryPeriod,
+	}
+	conversionFlag = &cli.Int64Flag{
+		Name:        "conversionperiod",
+		Usage:       "denotes the ichimoku conversion line (tenkan-sen) period",
+		Value:       9,
+		Destination: &taFastPeriod,
+	}
+	baseFlag = &cli.Int64Flag{
+		Name:        "baseperiod",
+		Usage:       "denotes the ichimoku base line (kijun-sen) period",
+		Value:       26,
+		Destination: &taSlowPeriod,
+	}
+	spanBFlag = &cli.Int64Flag{
+		Name:        "spanbperiod",
+		Usage:       "denotes the ichimoku leading span b (senkou span b) period",
+		Value:       52,
+		Destination: &taSecondaryPeriod,
+	}
+	displacementFlag = &cli.Int64Flag{
+		Name:        "displacement",
+		Usage:       "denotes the ichimoku displacement period for the leading and lagging spans",
+		Value:       26,
+		Destination: &taDisplacement,
+	}
+	multiplierFlag = &cli.Float64Flag{
+		Name:        "multiplier",
+		Usage:       "the average true range multiplier for band width",
+		Value:       2,
+		Destination: &taMultiplier,
+	}
+	accelerationStepFlag = &cli.Float64Flag{
+		Name:        "accelerationstep",
+		Usage:       "the parabolic sar acceleration factor step",
+		Value:       0.02,
+		Destination: &taAccelerationStep,
+	}
+	accelerationMaxFlag = &cli.Float64Flag{
+		Name:        "accelerationmax",
+		Usage:       "the parabolic sar maximum acceleration factor",
+		Value:       0.2,
+		Destination: &taAccelerationMax,
+	}
+	sessionFlag = &cli.Int64Flag{
+		Name:        "session",
+		Usage:       "the anchored vwap session length in seconds, cumulative totals reset at the start of each session",
+		Value:       86400,
+		Destination: &taSessionInterval,
+	}
 	maTypeFlag = &cli.StringFlag{
 		Name:        "movingaveragetype",
 		Usage:       "defines the moving average type for underlying calculation ('ema'/'sma')",
@@ -200,6 +280,83 @@ var technicalAnalysisCommand = &cli.Command{
 			Flags:     append(commonFlag, periodFlag),
 			Action:    getRSI,
 		},
+		{
+			Name:      "stoch",
+			Usage:     "returns the stochastic oscillator",
+			ArgsUsage: "<exchange> <pair> <asset> <granularity> <start> <end> <period>",
+			Flags:     append(commonFlag, periodFlag, smoothingFlag, signalFlag),
+			Action:    getStochastic,
+		},
+		{
+			Name:      "stochrsi",
+			Usage:     "returns the stochastic relative strength index",
+			ArgsUsage: "<exchange> <pair> <asset> <granularity> <start> <end> <period>",
+			Flags:     append(commonFlag, periodFlag, stochasticPeriodFlag, smoothingFlag, signalFlag),
+			Action:    getStochasticRSI,
+		},
+		{
+			Name:      "adx",
+			Usage:     "returns the average directional index with the directional movement indicators",
+			ArgsUsage: "<exchange> <pair> <asset> <granularity> <start> <end> <period>",
+			Flags:     append(commonFlag, periodFlag),
+			Action:    getADX,
+		},
+		{
+			Name:      "ichimoku",
+			Usage:     "returns the ichimoku cloud",
+			ArgsUsage: "<exchange> <pair> <asset> <granularity> <start> <end>",
+			Flags:     append(commonFlag, conversionFlag, baseFlag, spanBFlag, displacementFlag),
+			Action:    getIchimoku,
+		},
+		{
+			Name:      "avwap",
+			Usage:     "returns the volume weighted average price anchored to each session",
+			ArgsUsage: "<exchange> <pair> <asset> <granularity> <start> <end>",
+			Flags:     append(commonFlag, sessionFlag),
+			Action:    getAnchoredVWAP,
+		},
+		{
+			Name:      "keltner",
+			Usage:     "returns the keltner channels",
+			ArgsUsage: "<exchange> <pair> <asset> <granularity> <start> <end> <period>",
+			Flags:     append(commonFlag, periodFlag, atrPeriodFlag, multiplierFlag),
+			Action:    getKeltner,
+		},
+		{
+			Name:      "donchian",
+			Usage:     "returns the donchian channels",
+			ArgsUsage: "<exchange> <pair> <asset> <granularity> <start> <end> <period>",
+			Flags:     append(commonFlag, periodFlag),
+			Action:    getDonchian,
+		},
+		{
+			Name:      "supertrend",
+			Usage:     "returns the supertrend and its direction",
+			ArgsUsage: "<exchange> <pair> <asset> <granularity> <start> <end> <period>",
+			Flags:     append(commonFlag, periodFlag, multiplierFlag),
+			Action:    getSupertrend,
+		},
+		{
+			Name:      "psar",
+			Usage:     "returns the parabolic stop and reverse",
+			ArgsUsage: "<exchange> <pair> <asset> <granularity> <start> <end>",
+			Flags:     append(commonFlag, accelerationStepFlag, accelerationMaxFlag),
+			Action:    getParabolicSAR,
+		},
+		{
+			Name:      "cci",
+			Usage:     "returns the commodity channel index",
+			ArgsUsage: "<exchange> <pair> <asset> <granularity> <start> <end> <period>",
+			Flags:     append(commonFlag, periodFlag),
+			Action:    getCCI,
+		},
+		{
+			Name:      "willr",
+			Usage:     "returns the williams percent range",
+			ArgsUsage: "<exchange> <pair> <asset> <granularity> <start> <end> <period>",
+			Flags:     append(commonFlag, periodFlag),
+			Action:    getWilliamsR,
+		},
 	},
 }
 
@@ -235,6 +392,50 @@ func getRSI(c *cli.Context) error {
 	return getTecnicalAnalysis(c, "RSI")
 }
 
+func getStochastic(c *cli.Context) error {
+	return getTecnicalAnalysis(c, "STOCH")
+}
+
+func getStochasticRSI(c *cli.Context) error {
+	return getTecnicalAnalysis(c, "STOCHRSI")
+}
+
+func getADX(c *cli.Context) error {
+	return getTecnicalAnalysis(c, "ADX")
+}
+
+func getIchimoku(c *cli.Context) error {
+	return getTecnicalAnalysis(c, "ICHIMOKU")
+}
+
+func getAnchoredVWAP(c *cli.Context) error {
+	return getTecnicalAnalysis(c, "AVWAP")
+}
+
+func getKeltner(c *cli.Context) error {
+	return getTecnicalAnalysis(c, "KELTNER")
+}
+
+func getDonchian(c *cli.Context) error {
+	return getTecnicalAnalysis(c, "DONCHIAN")
+}
+
+func getSupertrend(c *cli.Context) error {
+	return getTecnicalAnalysis(c, "SUPERTREND")
+}
+
+func getParabolicSAR(c *cli.Context) error {
+	return getTecnicalAnalysis(c, "PSAR")
+}
+
+func getCCI(c *cli.Context) error {
+	return getTecnicalAnalysis(c, "CCI")
+}
+
+func getWilliamsR(c *cli.Context) error {
+	return getTecnicalAnalysis(c, "WILLR")
+}
+
 func getTecnicalAnalysis(c *cli.Context, algo string) error {
 	if c.NArg() == 0 && c.NumFlags() == 0 {
 		return cli.ShowSubcommandHelp(c)
@@ -332,12 +533,22 @@ func getTecnicalAnalysis(c *cli.Context, algo string) error {
 			Base:  pair.Base.String(),
 			Quote: pair.Quote.String(),
 		},
-		AssetType:     asset,
-		AlgorithmType: algo,
-		Interval:      taGranularity * int64(time.Second),
-		Start:         timestamppb.New(s),
-		End:           timestamppb.New(e),
-		Period:        taPeriod,
+		AssetType:        asset,
+		AlgorithmType:    algo,
+		Interval:         taGranularity * int64(time.Second),
+		Start:            timestamppb.New(s),
+		End:              timestamppb.New(e),
+		Period:           taPeriod,
+		FastPeriod:       taFastPeriod,
+		SlowPeriod:       taSlowPeriod,
+		SmoothingPeriod:  taSmoothingPeriod,
+		SignalPeriod:     taSignalPeriod,
+		SecondaryPeriod:  taSecondaryPeriod,
+		Multiplier:       taMultiplier,
+		AccelerationStep: taAccelerationStep,
+		AccelerationMax:  taAccelerationMax,
+		Displacement:     taDisplacement,
+		SessionInterval:  taSessionInterval * int64(time.Second),
 	}
 
 	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
@@ -78,7 +78,7 @@ func main() {
 
 	displayCurrency = cfg.Currency.FiatDisplayCurrency
 	port := cfg.Portfolio
-	result := port.GetPortfolioSummary()
+	result := port.GetPortfolioSummary(context.Background(), currency.EMPTYCODE)
 
 	log.Println("Fetched portfolio data.")
 
@@ -1,9 +1,20 @@
 package base
 
 import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
 	"time"
 )
 
+var (
+	errCommandNameNotSet = errors.New("command name not set")
+	errNilCommandHandler = errors.New("nil command handler")
+	errCommandUserNotSet = errors.New("command user not set")
+)
+
 // Base enforces standard variables across communication packages
 type Base struct {
 	Name           string
@@ -11,8 +22,16 @@ type Base struct {
 	Verbose        bool
 	Connected      bool
 	ServiceStarted time.Time
+
+	commandsMu sync.RWMutex
+	commands   map[string]CommandHandler
 }
 
+// CommandHandler handles a command sent to a relayer by an authorised user.
+// The user is prefixed by the relayer name, e.g. "telegram:satoshi", and the
+// returned string is sent back as the reply
+type CommandHandler func(user string, args []string) (string, error)
+
 // Event is a generalise event type
 type Event struct {
 	Type    string
@@ -123,3 +142,49 @@ type TelegramConfig struct {
 	VerificationToken string           `json:"verificationToken"`
 	AuthorisedClients map[string]int64 `json:"authorisedClients"`
 }
+
+// RegisterCommand adds a command which authorised users can send to the
+// relayer, replacing any command registered under the same name
+func (b *Base) RegisterCommand(name string, h CommandHandler) error {
+	if name == "" {
+		return errCommandNameNotSet
+	}
+	if h == nil {
+		return errNilCommandHandler
+	}
+	b.commandsMu.Lock()
+	defer b.commandsMu.Unlock()
+	if b.commands == nil {
+		b.commands = make(map[string]CommandHandler)
+	}
+	b.commands[strings.ToLower(name)] = h
+	return nil
+}
+
+// HandleCommand runs the command registered under the name for the user,
+// returning false when no command is registered
+func (b *Base) HandleCommand(user, name string, args []string) (reply string, ok bool, err error) {
+	b.commandsMu.RLock()
+	h, ok := b.commands[strings.ToLower(name)]
+	b.commandsMu.RUnlock()
+	if !ok {
+		return "", false, nil
+	}
+	if user == "" {
+		return "", true, errCommandUserNotSet
+	}
+	reply, err = h(fmt.Sprintf("%s:%s", strings.ToLower(b.Name), user), args)
+	return reply, true, err
+}
+
+// GetCommands returns the names of the registered commands in order
+func (b *Base) GetCommands() []string {
+	b.commandsMu.RLock()
+	defer b.commandsMu.RUnlock()
+	names := make([]string, 0, len(b.commands))
+	for name := range b.commands {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
@@ -2,6 +2,7 @@ package base
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/log"
@@ -19,6 +20,7 @@ type ICommunicate interface {
 	IsConnected() bool
 	GetName() string
 	SetServiceStarted(time.Time)
+	RegisterCommand(name string, h CommandHandler) error
 }
 
 // Setup sets up communication variables and initiates a connection to the
@@ -50,6 +52,17 @@ func (c IComm) PushEvent(event Event) {
 	}
 }
 
+// RegisterCommand adds a command to every relayer, so that authorised users
+// of relayers which accept commands can run it
+func (c IComm) RegisterCommand(name string, h CommandHandler) error {
+	for i := range c {
+		if err := c[i].RegisterCommand(name, h); err != nil {
+			return fmt.Errorf("%s: %w", c[i].GetName(), err)
+		}
+	}
+	return nil
+}
+
 // GetStatus returns the status of the comms relayers
 func (c IComm) GetStatus() map[string]CommsStatus {
 	result := make(map[string]CommsStatus)
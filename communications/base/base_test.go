@@ -1,8 +1,12 @@
 package base
 
 import (
+	"errors"
 	"testing"
 	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var b Base
@@ -151,3 +155,35 @@ func TestPushEvent(t *testing.T) {
 		}
 	}
 }
+
+func TestRegisterCommand(t *testing.T) {
+	t.Parallel()
+	c := Base{Name: "Telegram"}
+	assert.ErrorIs(t, c.RegisterCommand("", nil), errCommandNameNotSet)
+	assert.ErrorIs(t, c.RegisterCommand("approve", nil), errNilCommandHandler)
+
+	errHandler := errors.New("handler error")
+	require.NoError(t, c.RegisterCommand("Approve", func(user string, args []string) (string, error) {
+		if len(args) == 0 {
+			return "", errHandler
+		}
+		return user + " " + args[0], nil
+	}), "RegisterCommand must not error")
+	assert.Equal(t, []string{"approve"}, c.GetCommands(), "GetCommands should return the registered commands")
+
+	_, ok, err := c.HandleCommand("satoshi", "missing", nil)
+	assert.False(t, ok, "HandleCommand should return false for an unknown command")
+	assert.NoError(t, err, "HandleCommand should not error for an unknown command")
+
+	_, ok, err = c.HandleCommand("", "approve", []string{"1"})
+	assert.True(t, ok, "HandleCommand should return true for a registered command")
+	assert.ErrorIs(t, err, errCommandUserNotSet)
+
+	_, _, err = c.HandleCommand("satoshi", "approve", nil)
+	assert.ErrorIs(t, err, errHandler)
+
+	reply, ok, err := c.HandleCommand("satoshi", "APPROVE", []string{"1"})
+	require.NoError(t, err, "HandleCommand must not error")
+	assert.True(t, ok, "HandleCommand should match commands regardless of case")
+	assert.Equal(t, "telegram:satoshi 1", reply, "HandleCommand should prefix the user with the relayer name")
+}
@@ -198,9 +198,25 @@ func (t *Telegram) HandleMessages(text string, chatID int64) error {
 		log.Debugf(log.CommunicationMgr, "Telegram: Received message: %s\n", text)
 	}
 
+	if fields := strings.Fields(text); len(fields) > 0 {
+		// Commands may be addressed to the bot, e.g. /approvewithdrawal@gctbot
+		name, _, _ := strings.Cut(strings.TrimPrefix(fields[0], "/"), "@")
+		reply, ok, err := t.HandleCommand(t.usernameByID(chatID), name, fields[1:])
+		if ok {
+			if err != nil {
+				reply = fmt.Sprintf("Command %s failed: %s", name, err)
+			}
+			return t.SendMessage(fmt.Sprintf("%s: %s", talkRoot, reply), chatID)
+		}
+	}
+
 	switch {
 	case strings.Contains(text, cmdHelp):
-		return t.SendMessage(fmt.Sprintf("%s: %s", talkRoot, cmdHelpReply), chatID)
+		help := cmdHelpReply
+		for _, name := range t.GetCommands() {
+			help += "\n\t/" + name
+		}
+		return t.SendMessage(fmt.Sprintf("%s: %s", talkRoot, help), chatID)
 
 	case strings.Contains(text, cmdStart):
 		return t.SendMessage(talkRoot+": START COMMANDS HERE", chatID)
@@ -213,6 +229,16 @@ func (t *Telegram) HandleMessages(text string, chatID int64) error {
 	}
 }
 
+// usernameByID returns the name of the authorised client with the chat ID
+func (t *Telegram) usernameByID(chatID int64) string {
+	for username, id := range t.AuthorisedClients {
+		if id == chatID {
+			return username
+		}
+	}
+	return ""
+}
+
 // GetUpdates gets new updates via a long poll connection
 func (t *Telegram) GetUpdates() (GetUpdateResponse, error) {
 	var newUpdates GetUpdateResponse
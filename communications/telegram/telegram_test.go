@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/thrasher-corp/gocryptotrader/communications/base"
 	"github.com/thrasher-corp/gocryptotrader/config"
 )
@@ -68,6 +69,23 @@ func TestHandleMessages(t *testing.T) {
 	}
 }
 
+func TestHandleMessagesCommand(t *testing.T) {
+	t.Parallel()
+	var T Telegram
+	T.Name = "Telegram"
+	T.AuthorisedClients = map[string]int64{"sender": 1337}
+	var gotUser string
+	var gotArgs []string
+	require.NoError(t, T.RegisterCommand("approve", func(user string, args []string) (string, error) {
+		gotUser, gotArgs = user, args
+		return "approved", nil
+	}), "RegisterCommand must not error")
+
+	assert.Error(t, T.HandleMessages("/approve@gctbot 42", 1337), "HandleMessages should error sending the reply without a token")
+	assert.Equal(t, "telegram:sender", gotUser, "HandleMessages should pass the authorised username")
+	assert.Equal(t, []string{"42"}, gotArgs, "HandleMessages should pass the command arguments")
+}
+
 func TestGetUpdates(t *testing.T) {
 	t.Parallel()
 	var T Telegram
@@ -24,7 +24,9 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency/forexprovider"
 	"github.com/thrasher-corp/gocryptotrader/database"
 	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	gctscript "github.com/thrasher-corp/gocryptotrader/gctscript/vm"
 	"github.com/thrasher-corp/gocryptotrader/log"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/banking"
@@ -34,6 +36,12 @@ var (
 	errExchangeConfigIsNil = errors.New("exchange config is nil")
 	errPairsManagerIsNil   = errors.New("currency pairs manager is nil")
 	errDecryptFailed       = errors.New("failed to decrypt config after 3 attempts")
+	errNoEncryptionKey     = errors.New("encrypted config cannot be read without an encryption key provider")
+	errAccountNameEmpty    = errors.New("account name is empty")
+	errDuplicateAccount    = errors.New("duplicate account name")
+
+	errInvalidRequiredApprovals = errors.New("invalid withdraw manager required approvals")
+	errInvalidWithdrawLimit     = errors.New("invalid withdraw manager limit")
 )
 
 // GetCurrencyConfig returns currency configurations
@@ -144,6 +152,18 @@ func (c *Config) CheckClientBankAccounts() {
 	}
 }
 
+// MarshalJSON omits the credential values when they are resolved from a secret
+// provider, so resolved secrets are never written to the config file
+func (a APICredentialsConfig) MarshalJSON() ([]byte, error) {
+	if a.SecretRef != nil {
+		return json.Marshal(struct {
+			SecretRef *SecretRef `json:"secretRef"`
+		}{a.SecretRef})
+	}
+	type alias APICredentialsConfig
+	return json.Marshal(alias(a))
+}
+
 // PurgeExchangeAPICredentials purges the stored API credentials
 func (c *Config) PurgeExchangeAPICredentials() {
 	m.Lock()
@@ -818,6 +838,61 @@ func (c *Config) GetExchangeConfig(name string) (*Exchange, error) {
 	return nil, fmt.Errorf("%s %w", name, ErrExchangeNotFound)
 }
 
+// SetExchangeCredentials replaces the credentials of an exchange config with
+// values resolved from its secret provider, retaining the secret reference.
+// Returns true if the credentials changed
+func (c *Config) SetExchangeCredentials(name string, creds *APICredentialsConfig) (bool, error) {
+	if creds == nil {
+		return false, fmt.Errorf("%w: *APICredentialsConfig", common.ErrNilPointer)
+	}
+	m.Lock()
+	defer m.Unlock()
+	for i := range c.Exchanges {
+		if !strings.EqualFold(c.Exchanges[i].Name, name) {
+			continue
+		}
+		resolved := *creds
+		resolved.SecretRef = c.Exchanges[i].API.Credentials.SecretRef
+		if c.Exchanges[i].API.Credentials == resolved {
+			return false, nil
+		}
+		c.Exchanges[i].API.Credentials = resolved
+		return true, nil
+	}
+	return false, fmt.Errorf("%s %w", name, ErrExchangeNotFound)
+}
+
+// SetExchangeAccountCredentials replaces the resolved credentials of a named
+// account profile of an exchange, keeping its secret reference. It reports
+// whether the credentials changed
+func (c *Config) SetExchangeAccountCredentials(name, account string, creds *APICredentialsConfig) (bool, error) {
+	if creds == nil {
+		return false, fmt.Errorf("%w: *APICredentialsConfig", common.ErrNilPointer)
+	}
+	m.Lock()
+	defer m.Unlock()
+	for i := range c.Exchanges {
+		if !strings.EqualFold(c.Exchanges[i].Name, name) {
+			continue
+		}
+		accts := c.Exchanges[i].API.Accounts
+		for j := range accts {
+			if accts[j].Name != account {
+				continue
+			}
+			resolved := *creds
+			resolved.SecretRef = accts[j].Credentials.SecretRef
+			if accts[j].Credentials == resolved {
+				return false, nil
+			}
+			accts[j].Credentials = resolved
+			return true, nil
+		}
+		return false, fmt.Errorf("%s %w: %q", name, accounts.ErrAccountNotFound, account)
+	}
+	return false, fmt.Errorf("%s %w", name, ErrExchangeNotFound)
+}
+
 // UpdateExchangeConfig updates exchange configurations
 func (c *Config) UpdateExchangeConfig(e *Exchange) error {
 	m.Lock()
@@ -919,8 +994,11 @@ func (c *Config) CheckExchangeConfigValues() error {
 			e.Enabled = false
 			continue
 		}
+		if err := e.API.checkAccounts(); err != nil {
+			return fmt.Errorf("%s: %w", e.Name, err)
+		}
 		if (e.API.AuthenticatedSupport || e.API.AuthenticatedWebsocketSupport) &&
-			e.API.CredentialsValidator != nil {
+			e.API.CredentialsValidator != nil && e.API.Credentials.SecretRef == nil {
 			var failed bool
 			if e.API.CredentialsValidator.RequiresKey &&
 				(e.API.Credentials.Key == "" || e.API.Credentials.Key == DefaultAPIKey) {
@@ -1021,8 +1099,15 @@ func (c *Config) CheckExchangeConfigValues() error {
 	return nil
 }
 
-// CheckBankAccountConfig checks all bank accounts to see if they are valid
+// CheckBankAccountConfig checks all bank accounts to see if they are valid and
+// sets them as the bank accounts available for withdrawals
 func (c *Config) CheckBankAccountConfig() {
+	c.checkBankAccounts()
+	banking.SetAccounts(c.BankAccounts...)
+}
+
+// checkBankAccounts disables any enabled bank account which is invalid
+func (c *Config) checkBankAccounts() {
 	for x := range c.BankAccounts {
 		if c.BankAccounts[x].Enabled {
 			err := c.BankAccounts[x].Validate()
@@ -1032,7 +1117,6 @@ func (c *Config) CheckBankAccountConfig() {
 			}
 		}
 	}
-	banking.SetAccounts(c.BankAccounts...)
 }
 
 // GetForexProviders returns a list of available forex providers
@@ -1214,7 +1298,9 @@ func (c *Config) checkGCTScriptConfig() error {
 	return nil
 }
 
-func (c *Config) checkDatabaseConfig() error {
+// checkDatabaseConfig checks the database config, setting it on the global
+// database instance when setGlobal is true
+func (c *Config) checkDatabaseConfig(setGlobal bool) error {
 	m.Lock()
 	defer m.Unlock()
 
@@ -1232,6 +1318,10 @@ func (c *Config) checkDatabaseConfig() error {
 		return fmt.Errorf("unsupported database driver %v, database disabled", c.Database.Driver)
 	}
 
+	if !setGlobal {
+		return nil
+	}
+
 	if c.Database.Driver == database.DBSQLite || c.Database.Driver == database.DBSQLite3 {
 		databaseDir := c.GetDataPath("database")
 		err := common.CreateDir(databaseDir)
@@ -1329,6 +1419,181 @@ func (c *Config) CheckCurrencyStateManager() {
 	}
 }
 
+// CheckMetricsConfig ensures the metrics exporter config is valid, or sets
+// default values
+func (c *Config) CheckMetricsConfig() {
+	m.Lock()
+	defer m.Unlock()
+	if c.Metrics.ListenAddress == "" {
+		c.Metrics.ListenAddress = defaultMetricsListenAddress
+	}
+	if c.Metrics.Path == "" {
+		c.Metrics.Path = defaultMetricsPath
+	} else if !strings.HasPrefix(c.Metrics.Path, "/") {
+		c.Metrics.Path = "/" + c.Metrics.Path
+	}
+}
+
+// CheckCandleAggregatorConfig ensures the candle aggregator config is valid, or
+// sets default values
+func (c *Config) CheckCandleAggregatorConfig() {
+	m.Lock()
+	defer m.Unlock()
+	if c.CandleAggregator.MaxCandles <= 0 {
+		c.CandleAggregator.MaxCandles = defaultCandleAggregatorMaxCandles
+	}
+	c.CandleAggregator.Intervals = slices.DeleteFunc(c.CandleAggregator.Intervals, func(i kline.Interval) bool {
+		if i > 0 {
+			return false
+		}
+		log.Warnf(log.ConfigMgr, "Candle aggregator interval %v is invalid and has been removed", i)
+		return true
+	})
+}
+
+// CheckMicrostructureConfig ensures the microstructure config is valid, or sets
+// default values
+func (c *Config) CheckMicrostructureConfig() {
+	m.Lock()
+	defer m.Unlock()
+	c.Microstructure.DepthBasisPoints = slices.DeleteFunc(c.Microstructure.DepthBasisPoints, func(bps float64) bool {
+		if bps > 0 {
+			return false
+		}
+		log.Warnf(log.ConfigMgr, "Microstructure depth basis points %v is invalid and has been removed", bps)
+		return true
+	})
+	if len(c.Microstructure.DepthBasisPoints) == 0 {
+		c.Microstructure.DepthBasisPoints = []float64{10, 25, 50, 100}
+	}
+	if c.Microstructure.LargeTradeRatio <= 0 {
+		c.Microstructure.LargeTradeRatio = defaultMicrostructureLargeTradeRatio
+	}
+	if c.Microstructure.RealisedSpreadHorizon <= 0 {
+		c.Microstructure.RealisedSpreadHorizon = defaultMicrostructureSpreadHorizon
+	}
+}
+
+// CheckConfigReloadConfig checks and sets default values for the config
+// reloader
+func (c *Config) CheckConfigReloadConfig() {
+	m.Lock()
+	defer m.Unlock()
+
+	if c.ConfigReload.CheckInterval <= 0 {
+		c.ConfigReload.CheckInterval = defaultConfigReloadCheckInterval
+	}
+}
+
+// CheckWithdrawManagerConfig checks and sets default values for the withdraw
+// manager. Invalid required approvals and limits are returned as errors rather
+// than dropped, so a mistake cannot quietly remove a withdrawal safeguard
+func (c *Config) CheckWithdrawManagerConfig() error {
+	m.Lock()
+	defer m.Unlock()
+
+	if c.WithdrawManager.ApprovalTimeout <= 0 {
+		c.WithdrawManager.ApprovalTimeout = defaultWithdrawApprovalTimeout
+	}
+	c.WithdrawManager.Approvers = slices.DeleteFunc(c.WithdrawManager.Approvers, func(a WithdrawApprover) bool {
+		if a.Name != "" {
+			return false
+		}
+		log.Warnln(log.ConfigMgr, "Withdraw manager approver without a name has been removed")
+		return true
+	})
+	var errs error
+	if c.WithdrawManager.RequiredApprovals < 0 {
+		errs = common.AppendError(errs, fmt.Errorf("%w: %d cannot be negative", errInvalidRequiredApprovals, c.WithdrawManager.RequiredApprovals))
+	}
+	if c.WithdrawManager.RequiredApprovals > len(c.WithdrawManager.Approvers) {
+		errs = common.AppendError(errs, fmt.Errorf("%w: %d approvals required but only %d approvers configured", errInvalidRequiredApprovals, c.WithdrawManager.RequiredApprovals, len(c.WithdrawManager.Approvers)))
+	}
+	for _, l := range c.WithdrawManager.Limits {
+		if l.Currency.IsEmpty() || l.PerTransaction < 0 || l.Daily < 0 {
+			errs = common.AppendError(errs, fmt.Errorf("%w: currency %q per transaction %v daily %v", errInvalidWithdrawLimit, l.Currency, l.PerTransaction, l.Daily))
+		}
+	}
+	return errs
+}
+
+// CheckTreasuryManagerConfig ensures the treasury manager config is valid, or
+// sets default values. Invalid allocations are removed, along with targets left
+// with fewer than two exchanges to move funds between
+func (c *Config) CheckTreasuryManagerConfig() {
+	m.Lock()
+	defer m.Unlock()
+
+	if c.TreasuryManager.CheckInterval <= 0 {
+		c.TreasuryManager.CheckInterval = defaultTreasuryCheckInterval
+	}
+	if c.TreasuryManager.SettlementTimeout <= 0 {
+		c.TreasuryManager.SettlementTimeout = defaultTreasurySettlementTimeout
+	}
+	c.TreasuryManager.Targets = slices.DeleteFunc(c.TreasuryManager.Targets, func(t TreasuryTarget) bool {
+		if t.Currency.IsEmpty() || t.MinimumTransfer < 0 || t.WithdrawalPrecision < 0 {
+			log.Warnf(log.ConfigMgr, "Treasury manager target %s is invalid and has been removed", t.Currency)
+			return true
+		}
+		return false
+	})
+	for i := range c.TreasuryManager.Targets {
+		t := &c.TreasuryManager.Targets[i]
+		t.Allocations = slices.DeleteFunc(t.Allocations, func(a TreasuryAllocation) bool {
+			if a.Exchange != "" && a.Target >= 0 && a.Band >= 0 {
+				return false
+			}
+			log.Warnf(log.ConfigMgr, "Treasury manager %s allocation %+v is invalid and has been removed", t.Currency, a)
+			return true
+		})
+	}
+	c.TreasuryManager.Targets = slices.DeleteFunc(c.TreasuryManager.Targets, func(t TreasuryTarget) bool {
+		var weight float64
+		for i := range t.Allocations {
+			weight += t.Allocations[i].Target
+		}
+		if len(t.Allocations) >= 2 && weight > 0 {
+			return false
+		}
+		log.Warnf(log.ConfigMgr, "Treasury manager target %s needs at least two exchanges with a target allocation and has been removed", t.Currency)
+		return true
+	})
+}
+
+// CheckFundingTrackerConfig ensures the funding tracker config is valid, or
+// sets default values. Status mappings to states the tracker does not know are
+// removed
+func (c *Config) CheckFundingTrackerConfig() {
+	m.Lock()
+	defer m.Unlock()
+
+	if c.FundingTracker.PollInterval <= 0 {
+		c.FundingTracker.PollInterval = defaultFundingPollInterval
+	}
+	if c.FundingTracker.StuckTimeout <= 0 {
+		c.FundingTracker.StuckTimeout = defaultFundingStuckTimeout
+	}
+	if c.FundingTracker.Retention <= 0 {
+		c.FundingTracker.Retention = defaultFundingRetention
+	}
+	for exch, statuses := range c.FundingTracker.Statuses {
+		removeUnknownFundingStates(exch+" deposit", statuses.Deposit)
+		removeUnknownFundingStates(exch+" withdrawal", statuses.Withdrawal)
+		if len(statuses.Deposit) == 0 && len(statuses.Withdrawal) == 0 {
+			delete(c.FundingTracker.Statuses, exch)
+		}
+	}
+}
+
+func removeUnknownFundingStates(name string, statuses map[string]string) {
+	for status, state := range statuses {
+		if !slices.Contains(fundingStates, strings.ToLower(state)) {
+			log.Warnf(log.ConfigMgr, "Funding tracker %s status %q maps to unknown state %q and has been removed", name, status, state)
+			delete(statuses, status)
+		}
+	}
+}
+
 // CheckConnectionMonitorConfig checks and if zero value assigns default values
 func (c *Config) CheckConnectionMonitorConfig() {
 	m.Lock()
@@ -1623,11 +1888,18 @@ func (c *Config) CheckRemoteControlConfig() {
 
 // CheckConfig checks all config settings
 func (c *Config) CheckConfig() error {
+	return c.checkConfig(true)
+}
+
+// checkConfig checks all config settings. The database and bank account
+// globals are only set when setGlobals is true, so a config can be checked
+// without affecting the running engine
+func (c *Config) checkConfig(setGlobals bool) error {
 	if err := c.CheckLoggerConfig(); err != nil {
 		log.Errorf(log.ConfigMgr, "Failed to configure logger, some logging features unavailable: %s\n", err)
 	}
 
-	if err := c.checkDatabaseConfig(); err != nil {
+	if err := c.checkDatabaseConfig(setGlobals); err != nil {
 		log.Errorf(log.DatabaseMgr, "Failed to configure database: %v", err)
 	}
 
@@ -1642,9 +1914,22 @@ func (c *Config) CheckConfig() error {
 	c.CheckConnectionMonitorConfig()
 	c.CheckDataHistoryMonitorConfig()
 	c.CheckCurrencyStateManager()
+	c.CheckCandleAggregatorConfig()
+	c.CheckMetricsConfig()
+	c.CheckMicrostructureConfig()
+	c.CheckConfigReloadConfig()
+	if err := c.CheckWithdrawManagerConfig(); err != nil {
+		return err
+	}
+	c.CheckTreasuryManagerConfig()
+	c.CheckFundingTrackerConfig()
 	c.CheckCommunicationsConfig()
 	c.CheckClientBankAccounts()
-	c.CheckBankAccountConfig()
+	if setGlobals {
+		c.CheckBankAccountConfig()
+	} else {
+		c.checkBankAccounts()
+	}
 	c.CheckRemoteControlConfig()
 	c.CheckSyncManagerConfig()
 
@@ -1673,6 +1958,34 @@ func (c *Config) LoadConfig(configPath string, dryrun bool) error {
 	return c.CheckConfig()
 }
 
+// ReadReloadConfig reads and checks the config file at path as a replacement
+// for the running config. Logging, the data directory and scripting are carried
+// over from the running config as they configure process wide state when
+// checked and cannot be reloaded. The database and bank account globals are
+// left untouched, so a rejected reload does not change them
+func (c *Config) ReadReloadConfig(path string) (*Config, error) {
+	path, _, err := GetFilePath(path)
+	if err != nil {
+		return nil, err
+	}
+	if IsFileEncrypted(path) && c.EncryptionKeyProvider == nil {
+		return nil, errNoEncryptionKey
+	}
+	n := &Config{EncryptionKeyProvider: c.EncryptionKeyProvider}
+	if err := n.ReadConfigFromFile(path, true); err != nil {
+		return nil, fmt.Errorf("%w (%s): %w", ErrFailureOpeningConfig, path, err)
+	}
+	m.Lock()
+	n.Logging = c.Logging
+	n.DataDirectory = c.DataDirectory
+	n.GCTScript = c.GCTScript
+	m.Unlock()
+	if err := n.checkConfig(false); err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
 // UpdateConfig updates the config with a supplied config file
 func (c *Config) UpdateConfig(configPath string, newCfg *Config, dryrun bool) error {
 	err := newCfg.CheckConfig()
@@ -1751,6 +2064,22 @@ func (c *Config) GetDataPath(elem ...string) string {
 	return filepath.Join(append([]string{baseDir}, elem...)...)
 }
 
+// checkAccounts ensures every account profile has a unique name
+func (a *APIConfig) checkAccounts() error {
+	names := make(map[string]struct{}, len(a.Accounts))
+	for i := range a.Accounts {
+		name := a.Accounts[i].Name
+		if name == "" {
+			return fmt.Errorf("%w: account #%d", errAccountNameEmpty, i)
+		}
+		if _, ok := names[name]; ok {
+			return fmt.Errorf("%w: %q", errDuplicateAccount, name)
+		}
+		names[name] = struct{}{}
+	}
+	return nil
+}
+
 // Validate checks if exchange config is valid
 func (c *Exchange) Validate() error {
 	if c == nil {
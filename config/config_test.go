@@ -25,7 +25,9 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/database"
 	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	gctscript "github.com/thrasher-corp/gocryptotrader/gctscript/vm"
 	"github.com/thrasher-corp/gocryptotrader/log"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/banking"
@@ -1164,6 +1166,97 @@ func TestGetExchangeConfig(t *testing.T) {
 	assert.ErrorIs(t, err, ErrExchangeNotFound)
 }
 
+func TestSetExchangeCredentials(t *testing.T) {
+	t.Parallel()
+	ref := &SecretRef{Provider: "env", Path: "bitfinex"}
+	cfg := &Config{Exchanges: []Exchange{{Name: bfx, API: APIConfig{Credentials: APICredentialsConfig{SecretRef: ref}}}}}
+
+	_, err := cfg.SetExchangeCredentials(bfx, nil)
+	require.ErrorIs(t, err, common.ErrNilPointer)
+
+	_, err = cfg.SetExchangeCredentials("Testy", &APICredentialsConfig{})
+	require.ErrorIs(t, err, ErrExchangeNotFound)
+
+	changed, err := cfg.SetExchangeCredentials(strings.ToLower(bfx), &APICredentialsConfig{Key: "k", Secret: "s"})
+	require.NoError(t, err, "SetExchangeCredentials must not error")
+	assert.True(t, changed, "SetExchangeCredentials should report new credentials as changed")
+	assert.Equal(t, "k", cfg.Exchanges[0].API.Credentials.Key, "SetExchangeCredentials should set the key")
+	assert.Same(t, ref, cfg.Exchanges[0].API.Credentials.SecretRef, "SetExchangeCredentials should retain the secret reference")
+
+	changed, err = cfg.SetExchangeCredentials(bfx, &APICredentialsConfig{Key: "k", Secret: "s"})
+	require.NoError(t, err, "SetExchangeCredentials must not error")
+	assert.False(t, changed, "SetExchangeCredentials should not report unchanged credentials as changed")
+}
+
+func TestSetExchangeAccountCredentials(t *testing.T) {
+	t.Parallel()
+	ref := &SecretRef{Provider: "env", Path: "bitfinex-desk"}
+	cfg := &Config{Exchanges: []Exchange{{Name: bfx, API: APIConfig{Accounts: []AccountConfig{{Name: "desk", Credentials: APICredentialsConfig{SecretRef: ref}}}}}}}
+
+	_, err := cfg.SetExchangeAccountCredentials(bfx, "desk", nil)
+	require.ErrorIs(t, err, common.ErrNilPointer)
+
+	_, err = cfg.SetExchangeAccountCredentials("Testy", "desk", &APICredentialsConfig{})
+	require.ErrorIs(t, err, ErrExchangeNotFound)
+
+	_, err = cfg.SetExchangeAccountCredentials(bfx, "treasury", &APICredentialsConfig{})
+	require.ErrorIs(t, err, accounts.ErrAccountNotFound)
+
+	changed, err := cfg.SetExchangeAccountCredentials(bfx, "desk", &APICredentialsConfig{Key: "k", Secret: "s"})
+	require.NoError(t, err, "SetExchangeAccountCredentials must not error")
+	assert.True(t, changed, "SetExchangeAccountCredentials should report new credentials as changed")
+	assert.Equal(t, "k", cfg.Exchanges[0].API.Accounts[0].Credentials.Key, "SetExchangeAccountCredentials should set the key")
+	assert.Same(t, ref, cfg.Exchanges[0].API.Accounts[0].Credentials.SecretRef, "SetExchangeAccountCredentials should retain the secret reference")
+
+	changed, err = cfg.SetExchangeAccountCredentials(bfx, "desk", &APICredentialsConfig{Key: "k", Secret: "s"})
+	require.NoError(t, err, "SetExchangeAccountCredentials must not error")
+	assert.False(t, changed, "SetExchangeAccountCredentials should not report unchanged credentials as changed")
+}
+
+func TestAPICredentialsConfigMarshalJSON(t *testing.T) {
+	t.Parallel()
+	data, err := json.Marshal(APICredentialsConfig{Key: "k", Secret: "s"})
+	require.NoError(t, err, "Marshal must not error")
+	assert.Contains(t, string(data), `"key":"k"`, "Marshal should include the key")
+	assert.NotContains(t, string(data), "secretRef", "Marshal should omit an unset secret reference")
+
+	data, err = json.Marshal(APICredentialsConfig{Key: "k", Secret: "s", SecretRef: &SecretRef{Provider: "env", Path: "bitfinex"}})
+	require.NoError(t, err, "Marshal must not error")
+	assert.JSONEq(t, `{"secretRef":{"provider":"env","path":"bitfinex"}}`, string(data), "Marshal should only include the secret reference")
+}
+
+func TestAPIConfigAccessControl(t *testing.T) {
+	t.Parallel()
+	var a APIConfig
+	err := json.Unmarshal([]byte(`{"accessControl":"readOnly","subAccountAccessControl":{"main":"trade"}}`), &a)
+	require.NoError(t, err, "Unmarshal must not error")
+	assert.Equal(t, accounts.ReadOnlyAccess, a.AccessControl, "Unmarshal should parse the access control level")
+	assert.Equal(t, accounts.TradeAccess, a.SubAccountAccessControl["main"], "Unmarshal should parse sub-account levels")
+
+	data, err := json.Marshal(a)
+	require.NoError(t, err, "Marshal must not error")
+	assert.Contains(t, string(data), `"accessControl":"readOnly"`, "Marshal should write the level name")
+
+	data, err = json.Marshal(APIConfig{})
+	require.NoError(t, err, "Marshal must not error")
+	assert.NotContains(t, string(data), "accessControl", "Marshal should omit unrestricted access")
+
+	err = json.Unmarshal([]byte(`{"accessControl":"admin"}`), &a)
+	require.Error(t, err, "Unmarshal must error on an unknown level")
+}
+
+func TestCheckAccounts(t *testing.T) {
+	t.Parallel()
+	a := APIConfig{Accounts: []AccountConfig{{Name: "desk1"}, {Name: "desk2"}}}
+	require.NoError(t, a.checkAccounts(), "checkAccounts must not error")
+
+	a.Accounts = append(a.Accounts, AccountConfig{})
+	assert.ErrorIs(t, a.checkAccounts(), errAccountNameEmpty)
+
+	a.Accounts[2].Name = "desk1"
+	assert.ErrorIs(t, a.checkAccounts(), errDuplicateAccount)
+}
+
 func TestGetForexProviders(t *testing.T) {
 	t.Parallel()
 	fxr := "Fixer"
@@ -1818,6 +1911,185 @@ func TestCheckConnectionMonitorConfig(t *testing.T) {
 	assert.Equal(t, connchecker.DefaultDomainList, c.ConnectionMonitor.PublicDomainList)
 }
 
+func TestCheckCandleAggregatorConfig(t *testing.T) {
+	t.Parallel()
+
+	c := Config{CandleAggregator: CandleAggregator{Intervals: []kline.Interval{kline.OneMin, 0, -1, kline.OneHour}}}
+	c.CheckCandleAggregatorConfig()
+
+	assert.Equal(t, defaultCandleAggregatorMaxCandles, c.CandleAggregator.MaxCandles, "MaxCandles should be defaulted")
+	assert.Equal(t, []kline.Interval{kline.OneMin, kline.OneHour}, c.CandleAggregator.Intervals, "invalid intervals should be removed")
+}
+
+func TestCheckMicrostructureConfig(t *testing.T) {
+	t.Parallel()
+
+	c := Config{Microstructure: Microstructure{DepthBasisPoints: []float64{5, 0, -1, 20}}}
+	c.CheckMicrostructureConfig()
+	assert.Equal(t, []float64{5, 20}, c.Microstructure.DepthBasisPoints, "invalid basis points should be removed")
+	assert.Equal(t, defaultMicrostructureLargeTradeRatio, c.Microstructure.LargeTradeRatio, "LargeTradeRatio should be defaulted")
+	assert.Equal(t, defaultMicrostructureSpreadHorizon, c.Microstructure.RealisedSpreadHorizon, "RealisedSpreadHorizon should be defaulted")
+
+	c.Microstructure.DepthBasisPoints = []float64{-1}
+	c.CheckMicrostructureConfig()
+	assert.NotEmpty(t, c.Microstructure.DepthBasisPoints, "DepthBasisPoints should be defaulted when none are valid")
+}
+
+func TestCheckConfigReloadConfig(t *testing.T) {
+	t.Parallel()
+
+	c := Config{}
+	c.CheckConfigReloadConfig()
+	assert.Equal(t, defaultConfigReloadCheckInterval, c.ConfigReload.CheckInterval, "CheckInterval should be defaulted")
+
+	c.ConfigReload.CheckInterval = time.Minute
+	c.CheckConfigReloadConfig()
+	assert.Equal(t, time.Minute, c.ConfigReload.CheckInterval, "CheckInterval should not be changed")
+}
+
+func TestCheckWithdrawManagerConfig(t *testing.T) {
+	t.Parallel()
+
+	c := Config{WithdrawManager: WithdrawManager{
+		RequiredApprovals: 1,
+		Approvers: []WithdrawApprover{
+			{Name: "alice", Token: "secret"},
+			{Token: "orphan"},
+		},
+		Limits: []WithdrawLimit{
+			{Currency: currency.BTC, PerTransaction: 1, Daily: 5},
+		},
+	}}
+	require.NoError(t, c.CheckWithdrawManagerConfig(), "CheckWithdrawManagerConfig must not error")
+	assert.Equal(t, defaultWithdrawApprovalTimeout, c.WithdrawManager.ApprovalTimeout, "ApprovalTimeout should be defaulted")
+	require.Len(t, c.WithdrawManager.Approvers, 1, "Approvers without a name must be removed")
+	assert.Equal(t, "alice", c.WithdrawManager.Approvers[0].Name, "Named approvers should be kept")
+	require.Len(t, c.WithdrawManager.Limits, 1, "Valid limits must be kept")
+
+	c.WithdrawManager.ApprovalTimeout = time.Minute
+	require.NoError(t, c.CheckWithdrawManagerConfig(), "CheckWithdrawManagerConfig must not error")
+	assert.Equal(t, time.Minute, c.WithdrawManager.ApprovalTimeout, "ApprovalTimeout should not be changed")
+
+	c.WithdrawManager.RequiredApprovals = -1
+	assert.ErrorIs(t, c.CheckWithdrawManagerConfig(), errInvalidRequiredApprovals, "CheckWithdrawManagerConfig should error on negative required approvals")
+	c.WithdrawManager.RequiredApprovals = 2
+	assert.ErrorIs(t, c.CheckWithdrawManagerConfig(), errInvalidRequiredApprovals, "CheckWithdrawManagerConfig should error when fewer approvers than required approvals are configured")
+	c.WithdrawManager.RequiredApprovals = 1
+
+	for _, l := range []WithdrawLimit{
+		{PerTransaction: 1},
+		{Currency: currency.ETH, PerTransaction: -1},
+		{Currency: currency.ETH, Daily: -1},
+	} {
+		c.WithdrawManager.Limits = []WithdrawLimit{l}
+		assert.ErrorIsf(t, c.CheckWithdrawManagerConfig(), errInvalidWithdrawLimit, "CheckWithdrawManagerConfig should error on limit %+v", l)
+		assert.Len(t, c.WithdrawManager.Limits, 1, "CheckWithdrawManagerConfig should not remove invalid limits")
+	}
+}
+
+func TestCheckTreasuryManagerConfig(t *testing.T) {
+	t.Parallel()
+
+	c := Config{TreasuryManager: TreasuryManager{
+		Targets: []TreasuryTarget{
+			{Currency: currency.BTC, Allocations: []TreasuryAllocation{
+				{Exchange: "Binance", Target: 0.5, Band: 0.1},
+				{Exchange: "Kraken", Target: 0.5, Band: 0.1},
+				{Exchange: "Bitstamp", Target: -1},
+			}},
+			{Currency: currency.ETH, Allocations: []TreasuryAllocation{
+				{Exchange: "Binance", Target: 1},
+				{Target: 1},
+			}},
+			{Currency: currency.USDT, Allocations: []TreasuryAllocation{
+				{Exchange: "Binance"},
+				{Exchange: "Kraken"},
+			}},
+			{Allocations: []TreasuryAllocation{
+				{Exchange: "Binance", Target: 1},
+				{Exchange: "Kraken", Target: 1},
+			}},
+			{Currency: currency.LTC, WithdrawalPrecision: -1, Allocations: []TreasuryAllocation{
+				{Exchange: "Binance", Target: 1},
+				{Exchange: "Kraken", Target: 1},
+			}},
+		},
+	}}
+	c.CheckTreasuryManagerConfig()
+	assert.Equal(t, defaultTreasuryCheckInterval, c.TreasuryManager.CheckInterval, "CheckInterval should be defaulted")
+	assert.Equal(t, defaultTreasurySettlementTimeout, c.TreasuryManager.SettlementTimeout, "SettlementTimeout should be defaulted")
+	require.Len(t, c.TreasuryManager.Targets, 1, "Invalid targets must be removed")
+	assert.Equal(t, currency.BTC, c.TreasuryManager.Targets[0].Currency, "Valid targets should be kept")
+	assert.Len(t, c.TreasuryManager.Targets[0].Allocations, 2, "Invalid allocations should be removed")
+
+	c.TreasuryManager.CheckInterval = time.Minute
+	c.CheckTreasuryManagerConfig()
+	assert.Equal(t, time.Minute, c.TreasuryManager.CheckInterval, "CheckInterval should not be changed")
+}
+
+func TestCheckFundingTrackerConfig(t *testing.T) {
+	t.Parallel()
+
+	c := Config{FundingTracker: FundingTracker{
+		Statuses: map[string]FundingStatuses{
+			"OKX": {
+				Deposit:    map[string]string{"1": "Credited", "11": "lost"},
+				Withdrawal: map[string]string{"1": "pending"},
+			},
+			"Kraken": {Deposit: map[string]string{"0": "unknown"}},
+		},
+	}}
+	c.CheckFundingTrackerConfig()
+	assert.Equal(t, defaultFundingPollInterval, c.FundingTracker.PollInterval, "PollInterval should be defaulted")
+	assert.Equal(t, defaultFundingStuckTimeout, c.FundingTracker.StuckTimeout, "StuckTimeout should be defaulted")
+	assert.Equal(t, defaultFundingRetention, c.FundingTracker.Retention, "Retention should be defaulted")
+	assert.Equal(t, map[string]FundingStatuses{
+		"OKX": {
+			Deposit:    map[string]string{"1": "Credited"},
+			Withdrawal: map[string]string{"1": "pending"},
+		},
+	}, c.FundingTracker.Statuses, "Mappings to unknown states should be removed")
+
+	c.FundingTracker.StuckTimeout = time.Minute
+	c.CheckFundingTrackerConfig()
+	assert.Equal(t, time.Minute, c.FundingTracker.StuckTimeout, "StuckTimeout should not be changed")
+}
+
+func TestReadReloadConfig(t *testing.T) {
+	t.Parallel()
+
+	running := &Config{}
+	require.NoError(t, running.ReadConfigFromFile(TestFile, true), "ReadConfigFromFile must not error")
+	running.DataDirectory = t.TempDir()
+
+	_, err := running.ReadReloadConfig(filepath.Join(t.TempDir(), "missing.json"))
+	assert.ErrorIs(t, err, ErrFailureOpeningConfig, "ReadReloadConfig should error on a missing file")
+
+	c, err := running.ReadReloadConfig(TestFile)
+	require.NoError(t, err, "ReadReloadConfig must not error")
+	assert.NotSame(t, running, c, "ReadReloadConfig should return a new config")
+	assert.Equal(t, running.DataDirectory, c.DataDirectory, "DataDirectory should be carried over from the running config")
+	assert.Equal(t, defaultConfigReloadCheckInterval, c.ConfigReload.CheckInterval, "ReadReloadConfig should check the config")
+
+	encrypted := filepath.Join(t.TempDir(), "encrypted.json")
+	require.NoError(t, os.WriteFile(encrypted, append(slices.Clone(encryptionPrefix), "data"...), 0o600), "WriteFile must not error")
+	_, err = running.ReadReloadConfig(encrypted)
+	assert.ErrorIs(t, err, errNoEncryptionKey, "ReadReloadConfig should error on an encrypted file without a key provider")
+}
+
+func TestCheckMetricsConfig(t *testing.T) {
+	t.Parallel()
+
+	c := Config{}
+	c.CheckMetricsConfig()
+	assert.Equal(t, defaultMetricsListenAddress, c.Metrics.ListenAddress, "ListenAddress should be defaulted")
+	assert.Equal(t, defaultMetricsPath, c.Metrics.Path, "Path should be defaulted")
+
+	c.Metrics.Path = "stats"
+	c.CheckMetricsConfig()
+	assert.Equal(t, "/stats", c.Metrics.Path, "Path should be prefixed with a slash")
+}
+
 func TestDefaultFilePath(t *testing.T) {
 	// This is tricky to test because we're dealing with a config file stored
 	// in a persons default directory and to properly test it, it would
@@ -2026,7 +2298,7 @@ func TestCheckDatabaseConfig(t *testing.T) {
 	t.Parallel()
 
 	var c Config
-	if err := c.checkDatabaseConfig(); err != nil {
+	if err := c.checkDatabaseConfig(true); err != nil {
 		t.Error(err)
 	}
 
@@ -2038,13 +2310,13 @@ func TestCheckDatabaseConfig(t *testing.T) {
 
 	c.Database.Enabled = true
 	c.Database.Driver = "mssqlisthebest"
-	if err := c.checkDatabaseConfig(); err == nil {
+	if err := c.checkDatabaseConfig(true); err == nil {
 		t.Error("unexpected result")
 	}
 
 	c.Database.Driver = database.DBSQLite3
 	c.Database.Enabled = true
-	if err := c.checkDatabaseConfig(); err != nil {
+	if err := c.checkDatabaseConfig(true); err != nil {
 		t.Error(err)
 	}
 }
@@ -8,6 +8,9 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/communications/base"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/subscription"
 	gctscript "github.com/thrasher-corp/gocryptotrader/gctscript/vm"
@@ -39,6 +42,18 @@ const (
 	defaultDataHistoryMonitorCheckTimer  = time.Minute
 	defaultCurrencyStateManagerDelay     = time.Minute
 	defaultMaxJobsPerCycle               = 5
+	defaultCandleAggregatorMaxCandles    = 100
+	defaultMetricsListenAddress          = "localhost:9091"
+	defaultMetricsPath                   = "/metrics"
+	defaultMicrostructureLargeTradeRatio = 0.5
+	defaultMicrostructureSpreadHorizon   = 5 * time.Second
+	defaultConfigReloadCheckInterval     = 5 * time.Second
+	defaultWithdrawApprovalTimeout       = time.Hour
+	defaultTreasuryCheckInterval         = 5 * time.Minute
+	defaultTreasurySettlementTimeout     = 6 * time.Hour
+	defaultFundingPollInterval           = time.Minute
+	defaultFundingStuckTimeout           = 2 * time.Hour
+	defaultFundingRetention              = 7 * 24 * time.Hour
 	// DefaultSyncerWorkers limits the number of sync workers
 	DefaultSyncerWorkers = 15
 	// DefaultSyncerTimeoutREST the default time to switch from REST to websocket protocols without a response
@@ -86,6 +101,9 @@ var (
 
 	errNoEnabledExchanges   = errors.New("no exchanges enabled")
 	errCheckingConfigValues = errors.New("fatal error checking config values")
+
+	// fundingStates are the transfer states known to the funding tracker
+	fundingStates = []string{"pending", "broadcast", "confirming", "credited", "failed"}
 )
 
 // Config is the overarching object that holds all the information for
@@ -103,6 +121,15 @@ type Config struct {
 	OrderManager         OrderManager              `json:"orderManager"`
 	DataHistoryManager   DataHistoryManager        `json:"dataHistoryManager"`
 	CurrencyStateManager CurrencyStateManager      `json:"currencyStateManager"`
+	CandleAggregator     CandleAggregator          `json:"candleAggregator"`
+	Metrics              Metrics                   `json:"metrics"`
+	Microstructure       Microstructure            `json:"microstructure"`
+	SecretProviders      SecretProviders           `json:"secretProviders"`
+	ConfigReload         ConfigReload              `json:"configReload"`
+	WithdrawManager      WithdrawManager           `json:"withdrawManager"`
+	TreasuryManager      TreasuryManager           `json:"treasuryManager"`
+	FundingTracker       FundingTracker            `json:"fundingTracker"`
+	SharedRateLimiter    SharedRateLimiter         `json:"sharedRateLimiter"`
 	Profiler             Profiler                  `json:"profiler"`
 	NTPClient            NTPClientConfig           `json:"ntpclient"`
 	GCTScript            gctscript.Config          `json:"gctscript"`
@@ -135,6 +162,30 @@ type OrderManager struct {
 	FuturesTrackingSeekDuration   time.Duration `json:"futuresTrackingSeekDuration"`
 	RespectOrderHistoryLimits     bool          `json:"respectOrderHistoryLimits"`
 	CancelOrdersOnShutdown        bool          `json:"cancelOrdersOnShutdown"`
+	PaperTrading                  *PaperTrading `json:"paperTrading,omitempty"`
+	// EnforceLimits rejects order submissions outside of the limits below
+	EnforceLimits     bool           `json:"enforceLimits,omitempty"`
+	AllowMarketOrders bool           `json:"allowMarketOrders,omitempty"`
+	LimitAmount       float64        `json:"limitAmount,omitempty"`
+	AllowedPairs      currency.Pairs `json:"allowedPairs,omitempty"`
+	AllowedExchanges  []string       `json:"allowedExchanges,omitempty"`
+}
+
+// PaperTrading holds settings for routing order manager submissions to the
+// in-process simulated matching engine instead of live exchanges
+type PaperTrading struct {
+	Enabled         bool                  `json:"enabled"`
+	FeeRate         float64               `json:"feeRate"`
+	InitialBalances []PaperTradingBalance `json:"initialBalances"`
+}
+
+// PaperTradingBalance defines a simulated starting balance for an exchange
+// asset and currency
+type PaperTradingBalance struct {
+	Exchange string        `json:"exchange"`
+	Asset    asset.Item    `json:"asset"`
+	Currency currency.Code `json:"currency"`
+	Amount   float64       `json:"amount"`
 }
 
 // DataHistoryManager holds all information required for the data history manager
@@ -146,6 +197,179 @@ type DataHistoryManager struct {
 	Verbose             bool          `json:"verbose"`
 }
 
+// Metrics defines the configuration for the Prometheus metrics exporter
+type Metrics struct {
+	Enabled       bool   `json:"enabled"`
+	ListenAddress string `json:"listenAddress"`
+	Path          string `json:"path"`
+}
+
+// SharedRateLimiter defines a rate limiter backend shared by GoCryptoTrader
+// instances on the same host, so that several instances trading on one account
+// stay within a single exchange rate limit budget. Instances only share limits
+// when they use the same directory and namespace
+type SharedRateLimiter struct {
+	Enabled   bool   `json:"enabled"`
+	Directory string `json:"directory"`
+	Namespace string `json:"namespace"`
+}
+
+// CandleAggregator defines a set of configuration options for the live candle
+// aggregation manager
+type CandleAggregator struct {
+	Enabled        bool             `json:"enabled"`
+	Intervals      []kline.Interval `json:"intervals"`
+	MaxCandles     int              `json:"maxCandles"`
+	SaveToDatabase bool             `json:"saveToDatabase"`
+	Verbose        bool             `json:"verbose"`
+}
+
+// SecretProviders defines the external secret providers exchange API
+// credentials can be resolved from. Credentials are resolved again every
+// RefreshInterval so keys can be rotated without restarting, with 0 disabling
+// rotation
+type SecretProviders struct {
+	RefreshInterval time.Duration    `json:"refreshInterval"`
+	Providers       []SecretProvider `json:"providers"`
+}
+
+// SecretProvider defines a named secret provider. Type is one of env, file,
+// keyring or http. Path is the base directory for file providers and the
+// encrypted store for keyring providers. URL is the base URL of a http secret
+// service. KeyEnvVar names the environment variable holding the keyring
+// passphrase or the bearer token sent to a http secret service
+type SecretProvider struct {
+	Name      string        `json:"name"`
+	Type      string        `json:"type"`
+	Path      string        `json:"path,omitempty"`
+	URL       string        `json:"url,omitempty"`
+	KeyEnvVar string        `json:"keyEnvVar,omitempty"`
+	Timeout   time.Duration `json:"timeout,omitempty"`
+}
+
+// Microstructure defines a set of configuration options for the orderbook
+// microstructure analytics manager. DepthBasisPoints sets the distances from
+// the mid price that depth is measured at, LargeTradeRatio sets the share of
+// the depth at the tightest distance a trade must take to be tracked for book
+// resilience and RealisedSpreadHorizon sets how long after a trade the mid
+// price is sampled to measure its realised spread
+type Microstructure struct {
+	Enabled               bool          `json:"enabled"`
+	DepthBasisPoints      []float64     `json:"depthBasisPoints"`
+	LargeTradeRatio       float64       `json:"largeTradeRatio"`
+	RealisedSpreadHorizon time.Duration `json:"realisedSpreadHorizon"`
+	Verbose               bool          `json:"verbose"`
+}
+
+// ConfigReload defines a set of configuration options for the config reloader,
+// which checks the config file for changes every CheckInterval and applies them
+// to the running engine
+type ConfigReload struct {
+	Enabled       bool          `json:"enabled"`
+	CheckInterval time.Duration `json:"checkInterval"`
+}
+
+// WithdrawManager defines the safeguards applied to withdrawal requests
+// before they are submitted to an exchange
+type WithdrawManager struct {
+	// EnforceAddressBook rejects crypto withdrawals to addresses which are not
+	// in the database address book
+	EnforceAddressBook bool `json:"enforceAddressBook"`
+	// RequiredApprovals is the number of approvals a withdrawal needs after
+	// being requested before it is submitted. Zero submits it immediately
+	RequiredApprovals int `json:"requiredApprovals"`
+	// ApprovalTimeout discards pending withdrawals which are not approved in
+	// time
+	ApprovalTimeout time.Duration `json:"approvalTimeout"`
+	// Approvers is the allowlist of users able to approve or reject pending
+	// withdrawals
+	Approvers []WithdrawApprover `json:"approvers"`
+	Limits    []WithdrawLimit    `json:"limits"`
+}
+
+// WithdrawApprover is a user allowed to approve pending withdrawals. Token
+// authenticates the approver over gRPC, whereas communication relayer users
+// are authenticated by their platform
+type WithdrawApprover struct {
+	Name  string `json:"name"`
+	Token string `json:"token"`
+}
+
+// WithdrawLimit caps the amount of a currency which can be withdrawn. A zero
+// limit is not enforced
+type WithdrawLimit struct {
+	Currency       currency.Code `json:"currency"`
+	PerTransaction float64       `json:"perTransaction"`
+	// Daily caps the amount withdrawn over any rolling 24 hours
+	Daily float64 `json:"daily"`
+}
+
+// TreasuryManager defines a set of configuration options for the treasury
+// manager, which checks exchange balances against their target allocations
+// every CheckInterval and transfers funds between exchanges to bring them back
+// within their bands
+type TreasuryManager struct {
+	Enabled       bool          `json:"enabled"`
+	CheckInterval time.Duration `json:"checkInterval"`
+	// SettlementTimeout stops tracking a transfer which has not arrived at
+	// its destination in time
+	SettlementTimeout time.Duration    `json:"settlementTimeout"`
+	Targets           []TreasuryTarget `json:"targets"`
+	Verbose           bool             `json:"verbose"`
+}
+
+// TreasuryTarget defines how holdings of a currency are spread across
+// exchanges. ChainFees are the estimated withdrawal fees of each transfer
+// chain, used to pick the cheapest chain both exchanges support
+type TreasuryTarget struct {
+	Currency currency.Code `json:"currency"`
+	// MinimumTransfer skips transfers too small to be worth their fees
+	MinimumTransfer float64 `json:"minimumTransfer"`
+	// WithdrawalPrecision is the smallest increment of the currency which can
+	// be withdrawn, transfer amounts are rounded down to it. Zero does not
+	// round amounts
+	WithdrawalPrecision float64 `json:"withdrawalPrecision,omitempty"`
+	// ChainFees are withdrawal fee estimates of each chain, used in place of
+	// the fee reported by the exchange
+	ChainFees   map[string]float64   `json:"chainFees,omitempty"`
+	Allocations []TreasuryAllocation `json:"allocations"`
+}
+
+// FundingTracker defines a set of configuration options for the funding
+// tracker, which polls the funding history of each exchange every PollInterval
+// and follows deposits and withdrawals until they are credited or fail
+type FundingTracker struct {
+	Enabled      bool          `json:"enabled"`
+	PollInterval time.Duration `json:"pollInterval"`
+	// StuckTimeout is how long a transfer may take before communication
+	// relayers are warned that it is stuck
+	StuckTimeout time.Duration `json:"stuckTimeout"`
+	// Retention is how long a transfer which has ended is kept after it was
+	// last updated and last listed by the exchange
+	Retention time.Duration `json:"retention"`
+	// Statuses maps the funding statuses an exchange reports, such as numeric
+	// codes, to the transfer state they stand for. Keyed by exchange name
+	Statuses map[string]FundingStatuses `json:"statuses,omitempty"`
+	Verbose  bool                       `json:"verbose"`
+}
+
+// FundingStatuses maps the deposit and withdrawal statuses of an exchange to
+// transfer states. They are kept apart as exchanges reuse the same codes for
+// both with different meanings
+type FundingStatuses struct {
+	Deposit    map[string]string `json:"deposit,omitempty"`
+	Withdrawal map[string]string `json:"withdrawal,omitempty"`
+}
+
+// TreasuryAllocation is the share of a currency held on an exchange. Target is
+// weighted against the targets of the other exchanges holding the currency and
+// funds are moved once the share held drifts further than Band from it
+type TreasuryAllocation struct {
+	Exchange string  `json:"exchange"`
+	Target   float64 `json:"target"`
+	Band     float64 `json:"band"`
+}
+
 // CurrencyStateManager defines a set of configuration options for the currency
 // state manager
 type CurrencyStateManager struct {
@@ -311,6 +535,15 @@ type APICredentialsConfig struct {
 	OTPSecret     string `json:"otpSecret,omitempty"`
 	TradePassword string `json:"tradePassword,omitempty"`
 	PIN           string `json:"pin,omitempty"`
+	// SecretRef resolves the credentials from a secret provider rather than
+	// the values above, which are then never saved to the config file
+	SecretRef *SecretRef `json:"secretRef,omitempty"`
+}
+
+// SecretRef references exchange API credentials held by a secret provider
+type SecretRef struct {
+	Provider string `json:"provider"`
+	Path     string `json:"path"`
 }
 
 // APICredentialsValidatorConfig stores the API credentials validator settings
@@ -330,15 +563,36 @@ type APIConfig struct {
 	AuthenticatedWebsocketSupport bool `json:"authenticatedWebsocketApiSupport"`
 	PEMKeySupport                 bool `json:"pemKeySupport,omitempty"`
 
+	// AccessControl declares the operations the credentials may perform,
+	// either readOnly, trade or withdraw. SubAccountAccessControl declares a
+	// level per sub-account, restricted to no more than the credentials level
+	AccessControl           accounts.AccessControl            `json:"accessControl,omitempty"`
+	SubAccountAccessControl map[string]accounts.AccessControl `json:"subAccountAccessControl,omitempty"`
+
 	Credentials          APICredentialsConfig           `json:"credentials"`
+	Accounts             []AccountConfig                `json:"accounts,omitempty"`
 	CredentialsValidator *APICredentialsValidatorConfig `json:"credentialsValidator,omitempty"`
 	OldEndPoints         *APIEndpointsConfig            `json:"endpoints,omitempty"`
 	Endpoints            map[string]string              `json:"urlEndpoints"`
 }
 
+// AccountConfig defines a named account profile with its own API credentials,
+// allowing several independent accounts to be traded on the same exchange.
+// SubAccountAccessControl declares a level per sub-account used with the
+// profile, restricted to no more than the profile level
+type AccountConfig struct {
+	Name                    string                            `json:"name"`
+	AccessControl           accounts.AccessControl            `json:"accessControl,omitempty"`
+	SubAccountAccessControl map[string]accounts.AccessControl `json:"subAccountAccessControl,omitempty"`
+	Credentials             APICredentialsConfig              `json:"credentials"`
+}
+
 // Orderbook stores the orderbook configuration variables
 type Orderbook struct {
 	VerificationBypass     bool `json:"verificationBypass"`
 	WebsocketBufferLimit   int  `json:"websocketBufferLimit"`
 	WebsocketBufferEnabled bool `json:"websocketBufferEnabled"`
+	// WebsocketRedundancy carries orderbook subscriptions on a hot standby
+	// connection, where supported by the exchange, and drops duplicate updates
+	WebsocketRedundancy bool `json:"websocketRedundancy,omitempty"`
 }
@@ -0,0 +1,76 @@
+package secrets
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+)
+
+// HTTP resolves credentials from a secret service which returns a JSON object
+// of credential values named as in the config file for a GET of the base URL
+// joined with the path, e.g. {"key":"...","secret":"..."}
+type HTTP struct {
+	url    string
+	token  string
+	client *http.Client
+}
+
+// NewHTTP returns a provider for the secret service at baseURL. A non-empty
+// token is sent as a bearer token with every request
+func NewHTTP(baseURL, token string, timeout time.Duration) (*HTTP, error) {
+	if baseURL == "" {
+		return nil, errURLNotSet
+	}
+	if timeout <= 0 {
+		timeout = defaultHTTPTimeout
+	}
+	return &HTTP{
+		url:    strings.TrimSuffix(baseURL, "/"),
+		token:  token,
+		client: common.NewHTTPClientWithTimeout(timeout),
+	}, nil
+}
+
+// GetCredentials returns the credentials the secret service holds for path
+func (h *HTTP) GetCredentials(ctx context.Context, path string) (*config.APICredentialsConfig, error) {
+	if path == "" {
+		return nil, errPathNotSet
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, h.url+"/"+strings.TrimPrefix(path, "/"), http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	if h.token != "" {
+		req.Header.Set("Authorization", "Bearer "+h.token)
+	}
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, fmt.Errorf("%w: %q", ErrSecretNotFound, path)
+	default:
+		return nil, fmt.Errorf("%w: %s for %q", errUnexpectedStatus, resp.Status, path)
+	}
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	var creds config.APICredentialsConfig
+	if err := json.Unmarshal(data, &creds); err != nil {
+		return nil, fmt.Errorf("unable to read secret %q: %w", path, err)
+	}
+	creds.SecretRef = nil
+	return &creds, nil
+}
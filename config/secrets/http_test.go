@@ -0,0 +1,53 @@
+package secrets
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPGetCredentials(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/v1/exchanges/binance":
+			_, _ = w.Write([]byte(`{"key":"k","secret":"s","otpSecret":"otp"}`))
+		case "/v1/exchanges/broken":
+			_, _ = w.Write([]byte(`{`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	h, err := NewHTTP(srv.URL+"/v1/", "token", 0)
+	require.NoError(t, err, "NewHTTP must not error")
+	assert.Equal(t, defaultHTTPTimeout, h.client.Timeout, "NewHTTP should apply the default timeout")
+
+	_, err = h.GetCredentials(t.Context(), "")
+	require.ErrorIs(t, err, errPathNotSet)
+
+	_, err = h.GetCredentials(t.Context(), "exchanges/kraken")
+	require.ErrorIs(t, err, ErrSecretNotFound)
+
+	_, err = h.GetCredentials(t.Context(), "exchanges/broken")
+	require.Error(t, err, "GetCredentials must error on an invalid response")
+
+	creds, err := h.GetCredentials(t.Context(), "/exchanges/binance")
+	require.NoError(t, err, "GetCredentials must not error")
+	assert.Equal(t, "k", creds.Key, "GetCredentials should return the key")
+	assert.Equal(t, "s", creds.Secret, "GetCredentials should return the secret")
+	assert.Equal(t, "otp", creds.OTPSecret, "GetCredentials should return the OTP secret")
+
+	unauthorised, err := NewHTTP(srv.URL+"/v1", "", 0)
+	require.NoError(t, err, "NewHTTP must not error")
+	_, err = unauthorised.GetCredentials(t.Context(), "exchanges/binance")
+	require.ErrorIs(t, err, errUnexpectedStatus)
+}
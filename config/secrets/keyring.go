@@ -0,0 +1,127 @@
+package secrets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/file"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+)
+
+// Keyring is an encrypted local credential store keyed by path. The store is
+// encrypted in the same way as the config file and is read on every lookup, so
+// credentials updated by another process are picked up on the next refresh
+type Keyring struct {
+	file string
+	key  []byte
+	m    sync.Mutex
+}
+
+// NewKeyring returns a keyring for the store file, which is created on the
+// first Set if it does not exist
+func NewKeyring(storeFile string, key []byte) (*Keyring, error) {
+	if storeFile == "" {
+		return nil, fmt.Errorf("keyring %w", errPathNotSet)
+	}
+	if len(key) == 0 {
+		return nil, fmt.Errorf("keyring %w", errKeyNotSet)
+	}
+	return &Keyring{file: storeFile, key: key}, nil
+}
+
+// GetCredentials returns the credentials stored under path
+func (k *Keyring) GetCredentials(_ context.Context, path string) (*config.APICredentialsConfig, error) {
+	if path == "" {
+		return nil, errPathNotSet
+	}
+	k.m.Lock()
+	defer k.m.Unlock()
+	store, err := k.load()
+	if err != nil {
+		return nil, err
+	}
+	creds, ok := store[path]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q in keyring %s", ErrSecretNotFound, path, k.file)
+	}
+	return &creds, nil
+}
+
+// Set stores credentials under path, replacing any already stored
+func (k *Keyring) Set(path string, creds *config.APICredentialsConfig) error {
+	if path == "" {
+		return errPathNotSet
+	}
+	if creds == nil {
+		return fmt.Errorf("%w: *config.APICredentialsConfig", common.ErrNilPointer)
+	}
+	k.m.Lock()
+	defer k.m.Unlock()
+	store, err := k.load()
+	if err != nil {
+		return err
+	}
+	stored := *creds
+	stored.SecretRef = nil
+	store[path] = stored
+	return k.save(store)
+}
+
+// Delete removes the credentials stored under path
+func (k *Keyring) Delete(path string) error {
+	k.m.Lock()
+	defer k.m.Unlock()
+	store, err := k.load()
+	if err != nil {
+		return err
+	}
+	if _, ok := store[path]; !ok {
+		return fmt.Errorf("%w: %q in keyring %s", ErrSecretNotFound, path, k.file)
+	}
+	delete(store, path)
+	return k.save(store)
+}
+
+// keyringStore is the decrypted keyring file. Credentials are held under their
+// own field as encryption adds a marker field to the top level object
+type keyringStore struct {
+	Credentials map[string]config.APICredentialsConfig `json:"credentials"`
+}
+
+// load decrypts the store, returning an empty store if the file does not exist
+func (k *Keyring) load() (map[string]config.APICredentialsConfig, error) {
+	store := keyringStore{Credentials: make(map[string]config.APICredentialsConfig)}
+	data, err := os.ReadFile(k.file)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return store.Credentials, nil
+		}
+		return nil, err
+	}
+	if data, err = config.DecryptConfigData(data, k.key); err != nil {
+		return nil, fmt.Errorf("unable to decrypt keyring %s: %w", k.file, err)
+	}
+	if err := json.Unmarshal(data, &store); err != nil {
+		return nil, fmt.Errorf("unable to read keyring %s: %w", k.file, err)
+	}
+	if store.Credentials == nil {
+		store.Credentials = make(map[string]config.APICredentialsConfig)
+	}
+	return store.Credentials, nil
+}
+
+func (k *Keyring) save(store map[string]config.APICredentialsConfig) error {
+	data, err := json.Marshal(keyringStore{Credentials: store})
+	if err != nil {
+		return err
+	}
+	if data, err = config.EncryptConfigData(data, k.key); err != nil {
+		return err
+	}
+	return file.Write(k.file, data)
+}
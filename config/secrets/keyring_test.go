@@ -0,0 +1,56 @@
+package secrets
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+)
+
+func TestNewKeyring(t *testing.T) {
+	t.Parallel()
+	_, err := NewKeyring("", []byte("key"))
+	require.ErrorIs(t, err, errPathNotSet)
+
+	_, err = NewKeyring("keyring.dat", nil)
+	require.ErrorIs(t, err, errKeyNotSet)
+
+	k, err := NewKeyring("keyring.dat", []byte("key"))
+	require.NoError(t, err, "NewKeyring must not error")
+	assert.Equal(t, "keyring.dat", k.file, "NewKeyring should set the store file")
+}
+
+func TestKeyring(t *testing.T) {
+	t.Parallel()
+	storeFile := filepath.Join(t.TempDir(), "secrets", "keyring.dat")
+	k, err := NewKeyring(storeFile, []byte("correct horse"))
+	require.NoError(t, err, "NewKeyring must not error")
+
+	_, err = k.GetCredentials(t.Context(), "binance")
+	require.ErrorIs(t, err, ErrSecretNotFound)
+
+	require.ErrorIs(t, k.Set("", &config.APICredentialsConfig{}), errPathNotSet)
+	require.ErrorIs(t, k.Set("binance", nil), common.ErrNilPointer)
+
+	err = k.Set("binance", &config.APICredentialsConfig{Key: "k", Secret: "s", SecretRef: &config.SecretRef{Provider: "keyring", Path: "binance"}})
+	require.NoError(t, err, "Set must not error")
+
+	creds, err := k.GetCredentials(t.Context(), "binance")
+	require.NoError(t, err, "GetCredentials must not error")
+	assert.Equal(t, "k", creds.Key, "GetCredentials should return the stored key")
+	assert.Equal(t, "s", creds.Secret, "GetCredentials should return the stored secret")
+	assert.Nil(t, creds.SecretRef, "Set should not store the secret reference")
+
+	wrongKey, err := NewKeyring(storeFile, []byte("battery staple"))
+	require.NoError(t, err, "NewKeyring must not error")
+	_, err = wrongKey.GetCredentials(t.Context(), "binance")
+	require.Error(t, err, "GetCredentials must error with the wrong key")
+
+	require.NoError(t, k.Delete("binance"), "Delete must not error")
+	require.ErrorIs(t, k.Delete("binance"), ErrSecretNotFound)
+	_, err = k.GetCredentials(t.Context(), "binance")
+	require.ErrorIs(t, err, ErrSecretNotFound)
+}
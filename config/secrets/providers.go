@@ -0,0 +1,71 @@
+package secrets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+)
+
+// Env resolves credentials from environment variables named by the path as a
+// prefix, e.g. a path of binance reads BINANCE_KEY and BINANCE_SECRET
+type Env struct{}
+
+// GetCredentials returns the credentials held in environment variables
+func (e *Env) GetCredentials(_ context.Context, path string) (*config.APICredentialsConfig, error) {
+	if path == "" {
+		return nil, errPathNotSet
+	}
+	prefix := strings.ToUpper(path) + "_"
+	var creds config.APICredentialsConfig
+	var found bool
+	for _, f := range credentialFields {
+		if v, ok := os.LookupEnv(prefix + f.envVar); ok {
+			*f.value(&creds) = v
+			found = true
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("%w: no %s* environment variables", ErrSecretNotFound, prefix)
+	}
+	return &creds, nil
+}
+
+// File resolves credentials from a directory holding a file per credential
+// value named as in the config file, e.g. key and secret. This matches the
+// layout of mounted Kubernetes secrets. Relative paths are resolved against
+// Directory when set
+type File struct {
+	Directory string
+}
+
+// GetCredentials returns the credentials held in the directory at path
+func (f *File) GetCredentials(_ context.Context, path string) (*config.APICredentialsConfig, error) {
+	if path == "" {
+		return nil, errPathNotSet
+	}
+	if f.Directory != "" && !filepath.IsAbs(path) {
+		path = filepath.Join(f.Directory, path)
+	}
+	var creds config.APICredentialsConfig
+	var found bool
+	for _, field := range credentialFields {
+		data, err := os.ReadFile(filepath.Join(path, field.name))
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			return nil, err
+		}
+		*field.value(&creds) = strings.TrimRight(string(data), "\r\n")
+		found = true
+	}
+	if !found {
+		return nil, fmt.Errorf("%w: no credential files in %s", ErrSecretNotFound, path)
+	}
+	return &creds, nil
+}
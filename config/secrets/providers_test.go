@@ -0,0 +1,61 @@
+package secrets
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeSecret(t *testing.T, dir, path, name, value string) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, path), 0o700), "MkdirAll must not error")
+	require.NoError(t, os.WriteFile(filepath.Join(dir, path, name), []byte(value), 0o600), "WriteFile must not error")
+}
+
+func TestEnvGetCredentials(t *testing.T) {
+	t.Setenv("GCTSECRETSTEST_KEY", "k")
+	t.Setenv("GCTSECRETSTEST_SECRET", "s")
+	t.Setenv("GCTSECRETSTEST_OTP_SECRET", "otp")
+
+	e := &Env{}
+	_, err := e.GetCredentials(t.Context(), "")
+	require.ErrorIs(t, err, errPathNotSet)
+
+	_, err = e.GetCredentials(t.Context(), "gctsecretsmissing")
+	require.ErrorIs(t, err, ErrSecretNotFound)
+
+	creds, err := e.GetCredentials(t.Context(), "gctSecretsTest")
+	require.NoError(t, err, "GetCredentials must not error")
+	assert.Equal(t, "k", creds.Key, "GetCredentials should read the key")
+	assert.Equal(t, "s", creds.Secret, "GetCredentials should read the secret")
+	assert.Equal(t, "otp", creds.OTPSecret, "GetCredentials should read the OTP secret")
+	assert.Empty(t, creds.ClientID, "GetCredentials should leave unset values empty")
+}
+
+func TestFileGetCredentials(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	writeSecret(t, dir, "binance", "key", "k\n")
+	writeSecret(t, dir, "binance", "secret", "s\r\n")
+	writeSecret(t, dir, "binance", "clientID", "c")
+
+	f := &File{Directory: dir}
+	_, err := f.GetCredentials(t.Context(), "")
+	require.ErrorIs(t, err, errPathNotSet)
+
+	_, err = f.GetCredentials(t.Context(), "kraken")
+	require.ErrorIs(t, err, ErrSecretNotFound)
+
+	creds, err := f.GetCredentials(t.Context(), "binance")
+	require.NoError(t, err, "GetCredentials must not error")
+	assert.Equal(t, "k", creds.Key, "GetCredentials should trim the trailing newline")
+	assert.Equal(t, "s", creds.Secret, "GetCredentials should trim the trailing carriage return")
+	assert.Equal(t, "c", creds.ClientID, "GetCredentials should read the client ID")
+
+	creds, err = (&File{}).GetCredentials(t.Context(), filepath.Join(dir, "binance"))
+	require.NoError(t, err, "GetCredentials must not error for an absolute path")
+	assert.Equal(t, "k", creds.Key, "GetCredentials should read the key")
+}
@@ -0,0 +1,153 @@
+// Package secrets resolves exchange API credentials from external secret
+// providers so they do not need to be stored in the config file
+package secrets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+)
+
+// Supported provider types
+const (
+	EnvProvider     = "env"
+	FileProvider    = "file"
+	KeyringProvider = "keyring"
+	HTTPProvider    = "http"
+)
+
+const defaultHTTPTimeout = 15 * time.Second
+
+// Public errors
+var (
+	ErrProviderNotFound = errors.New("secret provider not found")
+	ErrSecretNotFound   = errors.New("secret not found")
+)
+
+var (
+	errUnsupportedProviderType = errors.New("unsupported secret provider type")
+	errProviderNameNotSet      = errors.New("secret provider name not set")
+	errDuplicateProvider       = errors.New("duplicate secret provider")
+	errPathNotSet              = errors.New("secret path not set")
+	errURLNotSet               = errors.New("secret service URL not set")
+	errKeyNotSet               = errors.New("secret provider key not set")
+	errUnexpectedStatus        = errors.New("unexpected secret service response status")
+)
+
+// Provider resolves exchange API credentials held in an external secret store
+type Provider interface {
+	GetCredentials(ctx context.Context, path string) (*config.APICredentialsConfig, error)
+}
+
+// credentialField maps a credential value to its name within a secret store
+type credentialField struct {
+	name   string
+	envVar string
+	value  func(*config.APICredentialsConfig) *string
+}
+
+// credentialFields lists every credential value in the form providers store
+// them; names match the config file and env vars are appended to a prefix
+var credentialFields = []credentialField{
+	{name: "key", envVar: "KEY", value: func(c *config.APICredentialsConfig) *string { return &c.Key }},
+	{name: "secret", envVar: "SECRET", value: func(c *config.APICredentialsConfig) *string { return &c.Secret }},
+	{name: "clientID", envVar: "CLIENT_ID", value: func(c *config.APICredentialsConfig) *string { return &c.ClientID }},
+	{name: "subaccount", envVar: "SUBACCOUNT", value: func(c *config.APICredentialsConfig) *string { return &c.Subaccount }},
+	{name: "pemKey", envVar: "PEM_KEY", value: func(c *config.APICredentialsConfig) *string { return &c.PEMKey }},
+	{name: "otpSecret", envVar: "OTP_SECRET", value: func(c *config.APICredentialsConfig) *string { return &c.OTPSecret }},
+	{name: "tradePassword", envVar: "TRADE_PASSWORD", value: func(c *config.APICredentialsConfig) *string { return &c.TradePassword }},
+	{name: "pin", envVar: "PIN", value: func(c *config.APICredentialsConfig) *string { return &c.PIN }},
+}
+
+// NewProvider returns a provider for the config
+func NewProvider(cfg *config.SecretProvider) (Provider, error) {
+	if cfg == nil {
+		return nil, fmt.Errorf("%w: *config.SecretProvider", common.ErrNilPointer)
+	}
+	switch strings.ToLower(cfg.Type) {
+	case EnvProvider:
+		return &Env{}, nil
+	case FileProvider:
+		return &File{Directory: cfg.Path}, nil
+	case KeyringProvider:
+		key, err := lookupKey(cfg)
+		if err != nil {
+			return nil, err
+		}
+		return NewKeyring(cfg.Path, []byte(key))
+	case HTTPProvider:
+		var token string
+		if cfg.KeyEnvVar != "" {
+			var err error
+			if token, err = lookupKey(cfg); err != nil {
+				return nil, err
+			}
+		}
+		return NewHTTP(cfg.URL, token, cfg.Timeout)
+	}
+	return nil, fmt.Errorf("%w: %q", errUnsupportedProviderType, cfg.Type)
+}
+
+// lookupKey returns the value of the environment variable named by KeyEnvVar
+func lookupKey(cfg *config.SecretProvider) (string, error) {
+	if cfg.KeyEnvVar == "" {
+		return "", fmt.Errorf("%s %w: keyEnvVar must be set", cfg.Name, errKeyNotSet)
+	}
+	key := os.Getenv(cfg.KeyEnvVar)
+	if key == "" {
+		return "", fmt.Errorf("%s %w: %s is empty", cfg.Name, errKeyNotSet, cfg.KeyEnvVar)
+	}
+	return key, nil
+}
+
+// Resolver resolves secret references against a set of named providers. env
+// and file providers are always available under their type names
+type Resolver struct {
+	providers map[string]Provider
+}
+
+// NewResolver returns a resolver for the configured providers
+func NewResolver(cfgs []config.SecretProvider) (*Resolver, error) {
+	r := &Resolver{providers: map[string]Provider{
+		EnvProvider:  &Env{},
+		FileProvider: &File{},
+	}}
+	configured := make(map[string]bool, len(cfgs))
+	for i := range cfgs {
+		if cfgs[i].Name == "" {
+			return nil, fmt.Errorf("%w: #%d", errProviderNameNotSet, i)
+		}
+		name := strings.ToLower(cfgs[i].Name)
+		if configured[name] {
+			return nil, fmt.Errorf("%w: %s", errDuplicateProvider, cfgs[i].Name)
+		}
+		p, err := NewProvider(&cfgs[i])
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", cfgs[i].Name, err)
+		}
+		configured[name] = true
+		r.providers[name] = p
+	}
+	return r, nil
+}
+
+// Resolve returns the credentials referenced by ref
+func (r *Resolver) Resolve(ctx context.Context, ref *config.SecretRef) (*config.APICredentialsConfig, error) {
+	if ref == nil {
+		return nil, fmt.Errorf("%w: *config.SecretRef", common.ErrNilPointer)
+	}
+	if ref.Path == "" {
+		return nil, errPathNotSet
+	}
+	p, ok := r.providers[strings.ToLower(ref.Provider)]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrProviderNotFound, ref.Provider)
+	}
+	return p.GetCredentials(ctx, ref.Path)
+}
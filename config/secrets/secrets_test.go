@@ -0,0 +1,84 @@
+package secrets
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+)
+
+func TestNewProvider(t *testing.T) {
+	t.Parallel()
+	_, err := NewProvider(nil)
+	require.ErrorIs(t, err, common.ErrNilPointer)
+
+	_, err = NewProvider(&config.SecretProvider{Type: "vault"})
+	require.ErrorIs(t, err, errUnsupportedProviderType)
+
+	p, err := NewProvider(&config.SecretProvider{Type: "ENV"})
+	require.NoError(t, err, "NewProvider must not error")
+	assert.IsType(t, &Env{}, p, "NewProvider should return an env provider")
+
+	p, err = NewProvider(&config.SecretProvider{Type: FileProvider, Path: "/run/secrets"})
+	require.NoError(t, err, "NewProvider must not error")
+	require.IsType(t, &File{}, p, "NewProvider must return a file provider")
+	assert.Equal(t, "/run/secrets", p.(*File).Directory, "NewProvider should set the directory")
+
+	_, err = NewProvider(&config.SecretProvider{Type: KeyringProvider, Path: "keyring.dat"})
+	require.ErrorIs(t, err, errKeyNotSet)
+
+	_, err = NewProvider(&config.SecretProvider{Type: KeyringProvider, Path: "keyring.dat", KeyEnvVar: "GCT_TEST_SECRETS_UNSET_KEY"})
+	require.ErrorIs(t, err, errKeyNotSet)
+
+	_, err = NewProvider(&config.SecretProvider{Type: HTTPProvider})
+	require.ErrorIs(t, err, errURLNotSet)
+
+	p, err = NewProvider(&config.SecretProvider{Type: HTTPProvider, URL: "http://localhost:8200/"})
+	require.NoError(t, err, "NewProvider must not error")
+	require.IsType(t, &HTTP{}, p, "NewProvider must return an http provider")
+	assert.Equal(t, "http://localhost:8200", p.(*HTTP).url, "NewProvider should trim the trailing slash")
+}
+
+func TestNewResolver(t *testing.T) {
+	t.Parallel()
+	_, err := NewResolver([]config.SecretProvider{{Type: EnvProvider}})
+	require.ErrorIs(t, err, errProviderNameNotSet)
+
+	_, err = NewResolver([]config.SecretProvider{{Name: "a", Type: EnvProvider}, {Name: "A", Type: FileProvider}})
+	require.ErrorIs(t, err, errDuplicateProvider)
+
+	_, err = NewResolver([]config.SecretProvider{{Name: "a", Type: "vault"}})
+	require.ErrorIs(t, err, errUnsupportedProviderType)
+
+	r, err := NewResolver([]config.SecretProvider{{Name: "Mounted", Type: FileProvider, Path: "/run/secrets"}})
+	require.NoError(t, err, "NewResolver must not error")
+	assert.Len(t, r.providers, 3, "NewResolver should include the default providers")
+	assert.Contains(t, r.providers, "mounted", "NewResolver should key providers by lowercase name")
+}
+
+func TestResolve(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	writeSecret(t, dir, "binance", "key", "k")
+
+	r, err := NewResolver([]config.SecretProvider{{Name: "k8s", Type: FileProvider, Path: dir}})
+	require.NoError(t, err, "NewResolver must not error")
+
+	_, err = r.Resolve(t.Context(), nil)
+	require.ErrorIs(t, err, common.ErrNilPointer)
+
+	_, err = r.Resolve(t.Context(), &config.SecretRef{Provider: "k8s"})
+	require.ErrorIs(t, err, errPathNotSet)
+
+	_, err = r.Resolve(t.Context(), &config.SecretRef{Provider: "vault", Path: "binance"})
+	require.ErrorIs(t, err, ErrProviderNotFound)
+
+	_, err = r.Resolve(t.Context(), &config.SecretRef{Provider: "k8s", Path: "kraken"})
+	require.ErrorIs(t, err, ErrSecretNotFound)
+
+	creds, err := r.Resolve(t.Context(), &config.SecretRef{Provider: "K8S", Path: "binance"})
+	require.NoError(t, err, "Resolve must not error")
+	assert.Equal(t, "k", creds.Key, "Resolve should return the stored key")
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/log"
 )
@@ -11,8 +12,9 @@ import (
 // ConversionRates defines protected conversion rate map for concurrent updating
 // and retrieval of foreign exchange rates for mainly fiat currencies
 type ConversionRates struct {
-	m   map[*Item]map[*Item]*float64
-	mtx sync.Mutex
+	m       map[*Item]map[*Item]*float64
+	updated time.Time
+	mtx     sync.Mutex
 }
 
 // HasData returns if conversion rates are present
@@ -219,9 +221,17 @@ func (c *ConversionRates) Update(m map[string]float64) error {
 			}
 		}
 	}
+	c.updated = time.Now()
 	return nil
 }
 
+// LastUpdated returns when the conversion rates were last updated
+func (c *ConversionRates) LastUpdated() time.Time {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	return c.updated
+}
+
 // GetFullRates returns the full conversion list
 func (c *ConversionRates) GetFullRates() Conversions {
 	var conversions Conversions
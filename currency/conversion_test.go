@@ -129,10 +129,12 @@ func TestConversionsRatesSystem(t *testing.T) {
 		"USDZAR": 14.138070887,
 	}
 
+	assert.True(t, SuperDuperConversionSystem.LastUpdated().IsZero(), "LastUpdated should be zero before an update")
 	err := SuperDuperConversionSystem.Update(testmap)
 	if err != nil {
 		t.Fatal(err)
 	}
+	assert.False(t, SuperDuperConversionSystem.LastUpdated().IsZero(), "LastUpdated should be set by an update")
 
 	err = SuperDuperConversionSystem.Update(nil)
 	if err == nil {
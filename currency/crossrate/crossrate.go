@@ -0,0 +1,340 @@
+// Package crossrate values an amount of any currency in another by finding
+// the best conversion path through the tickers cached from enabled exchanges
+// and the foreign exchange rates of the forex providers, for example
+// ALT->USDT->USD->EUR
+package crossrate
+
+import (
+	"cmp"
+	"container/heap"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+)
+
+// Hop costs used to rank paths. Fewer hops are preferred and a peg is only
+// used when no market or forex rate links the same currencies as cheaply
+const (
+	marketCost = 1.0
+	forexCost  = 1.0
+	pegCost    = 1.5
+)
+
+// usdPegs are currencies treated as worth one USD when pegs are enabled
+var usdPegs = []currency.Code{
+	currency.USDT,
+	currency.USDC,
+	currency.BUSD,
+	currency.DAI,
+	currency.TUSD,
+	currency.PAX,
+	currency.ZUSD,
+}
+
+var defaultConverter = &Converter{CacheDuration: DefaultCacheDuration}
+
+// FindPath returns the best conversion path between the currencies using the
+// default converter
+func FindPath(from, to currency.Code) (*Path, error) {
+	return defaultConverter.Graph().FindPath(from, to)
+}
+
+// Convert values an amount of from in to using the default converter
+func Convert(amount float64, from, to currency.Code) (float64, *Path, error) {
+	return defaultConverter.Graph().Convert(amount, from, to)
+}
+
+// GetGraph returns the default converter's graph, which should be used when
+// valuing many currencies at once
+func GetGraph() *Graph {
+	return defaultConverter.Graph()
+}
+
+// Graph returns a conversion graph built from the latest tickers, reusing a
+// cached graph within the converter's cache duration
+func (c *Converter) Graph() *Graph {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := time.Now()
+	if c.cached != nil && c.CacheDuration > 0 && now.Sub(c.cachedAt) < c.CacheDuration {
+		return c.cached
+	}
+	c.cached = c.build(now)
+	c.cachedAt = now
+	return c.cached
+}
+
+func (c *Converter) build(now time.Time) *Graph {
+	getTickers := c.tickers
+	if getTickers == nil {
+		getTickers = ticker.GetAllTickers
+	}
+	forexRate := c.forexRate
+	if forexRate == nil {
+		forexRate = defaultForexRate
+	}
+
+	type direction struct {
+		from *currency.Item
+		to   *currency.Item
+	}
+	freshest := make(map[direction]Hop)
+	add := func(h Hop) {
+		d := direction{from: h.From.Item, to: h.To.Item}
+		if current, ok := freshest[d]; ok && !h.Updated.After(current.Updated) {
+			return
+		}
+		freshest[d] = h
+	}
+
+	for _, t := range getTickers() {
+		if t.AssetType != asset.Spot || t.Pair.Base.IsEmpty() || t.Pair.Quote.IsEmpty() || t.Pair.Base.Equal(t.Pair.Quote) {
+			continue
+		}
+		if len(c.Exchanges) > 0 && !slices.ContainsFunc(c.Exchanges, func(e string) bool { return strings.EqualFold(e, t.ExchangeName) }) {
+			continue
+		}
+		if c.MaxStaleness > 0 && now.Sub(t.LastUpdated) > c.MaxStaleness {
+			continue
+		}
+		price := tickerPrice(t)
+		if price <= 0 {
+			continue
+		}
+		hop := Hop{
+			From:    t.Pair.Base,
+			To:      t.Pair.Quote,
+			Rate:    price,
+			Source:  t.ExchangeName,
+			Pair:    t.Pair,
+			Asset:   t.AssetType,
+			Updated: t.LastUpdated,
+		}
+		add(hop)
+		hop.From, hop.To, hop.Rate = t.Pair.Quote, t.Pair.Base, 1/price
+		add(hop)
+	}
+
+	g := &Graph{
+		edges:     make(map[*currency.Item][]edge),
+		forexRate: forexRate,
+	}
+	for _, h := range freshest {
+		g.addEdge(edge{to: h.To, hop: h, cost: marketCost})
+	}
+	if !c.DisablePegs {
+		for _, peg := range usdPegs {
+			pair := currency.NewPair(peg, currency.USD)
+			g.addEdge(edge{to: currency.USD, hop: Hop{From: peg, To: currency.USD, Rate: 1, Source: PegSource, Pair: pair}, cost: pegCost})
+			g.addEdge(edge{to: peg, hop: Hop{From: currency.USD, To: peg, Rate: 1, Source: PegSource, Pair: pair}, cost: pegCost})
+		}
+	}
+	for _, edges := range g.edges {
+		slices.SortFunc(edges, func(a, b edge) int {
+			return cmp.Or(
+				cmp.Compare(a.to.String(), b.to.String()),
+				cmp.Compare(a.cost, b.cost),
+				cmp.Compare(a.hop.Source, b.hop.Source),
+			)
+		})
+	}
+	slices.SortFunc(g.fiat, func(a, b currency.Code) int {
+		return cmp.Compare(a.String(), b.String())
+	})
+	return g
+}
+
+// FindPath returns the cheapest conversion path between the currencies.
+// Market hops use the freshest ticker of the pair across exchanges, fiat
+// currencies are linked by forex rates
+func (g *Graph) FindPath(from, to currency.Code) (*Path, error) {
+	if from.IsEmpty() || to.IsEmpty() {
+		return nil, errCurrencyNotSet
+	}
+	if from.Equal(to) {
+		return &Path{From: from, To: to, Rate: 1}, nil
+	}
+
+	dist := map[*currency.Item]float64{from.Item: 0}
+	prev := make(map[*currency.Item]edge)
+	visited := make(map[*currency.Item]bool)
+	q := &queue{{code: from}}
+	for q.Len() > 0 {
+		n := heap.Pop(q).(node) //nolint:forcetypeassert // queue only holds nodes
+		if visited[n.code.Item] {
+			continue
+		}
+		visited[n.code.Item] = true
+		if n.code.Equal(to) {
+			break
+		}
+		for _, e := range g.neighbours(n.code, to) {
+			if visited[e.to.Item] {
+				continue
+			}
+			cost := n.cost + e.cost
+			if d, ok := dist[e.to.Item]; ok && d <= cost {
+				continue
+			}
+			dist[e.to.Item] = cost
+			prev[e.to.Item] = e
+			heap.Push(q, node{code: e.to, cost: cost})
+		}
+	}
+	if !visited[to.Item] {
+		return nil, fmt.Errorf("%w from %s to %s", ErrNoPath, from, to)
+	}
+
+	var hops []Hop
+	for c := to; !c.Equal(from); {
+		e := prev[c.Item]
+		hops = append(hops, e.hop)
+		c = e.hop.From
+	}
+	slices.Reverse(hops)
+
+	now := time.Now()
+	path := &Path{From: from, To: to, Rate: 1, Hops: hops}
+	for i := range hops {
+		path.Rate *= hops[i].Rate
+		if !hops[i].Updated.IsZero() {
+			hops[i].Staleness = now.Sub(hops[i].Updated)
+		}
+	}
+	return path, nil
+}
+
+// Convert values an amount of from in to, returning the path used
+func (g *Graph) Convert(amount float64, from, to currency.Code) (float64, *Path, error) {
+	path, err := g.FindPath(from, to)
+	if err != nil {
+		return 0, nil, err
+	}
+	return amount * path.Rate, path, nil
+}
+
+// MaxStaleness returns the age of the stalest hop of the path
+func (p *Path) MaxStaleness() time.Duration {
+	var stalest time.Duration
+	for i := range p.Hops {
+		stalest = max(stalest, p.Hops[i].Staleness)
+	}
+	return stalest
+}
+
+// String returns the hops of the path with their source and staleness
+func (p *Path) String() string {
+	if len(p.Hops) == 0 {
+		return p.From.String()
+	}
+	hops := make([]string, len(p.Hops))
+	for i := range p.Hops {
+		hops[i] = fmt.Sprintf("%s->%s (%s", p.Hops[i].From, p.Hops[i].To, p.Hops[i].Source)
+		if !p.Hops[i].Updated.IsZero() {
+			hops[i] += " " + p.Hops[i].Staleness.Round(time.Second).String()
+		}
+		hops[i] += ")"
+	}
+	return strings.Join(hops, " ")
+}
+
+func (g *Graph) addEdge(e edge) {
+	g.edges[e.hop.From.Item] = append(g.edges[e.hop.From.Item], e)
+	for _, c := range []currency.Code{e.hop.From, e.hop.To} {
+		if c.IsFiatCurrency() && !slices.ContainsFunc(g.fiat, c.Equal) {
+			g.fiat = append(g.fiat, c)
+		}
+	}
+}
+
+// neighbours returns the edges leaving the currency, adding forex edges to
+// the other fiat currencies of the graph and to a fiat target
+func (g *Graph) neighbours(c, target currency.Code) []edge {
+	edges := g.edges[c.Item]
+	if !c.IsFiatCurrency() || g.forexRate == nil {
+		return edges
+	}
+	fiat := g.fiat
+	if target.IsFiatCurrency() && !slices.ContainsFunc(fiat, target.Equal) {
+		fiat = append(slices.Clip(fiat), target)
+	}
+	edges = slices.Clip(edges)
+	for _, f := range fiat {
+		if f.Equal(c) {
+			continue
+		}
+		rate, updated, err := g.forexRate(c, f)
+		if err != nil || rate <= 0 {
+			continue
+		}
+		edges = append(edges, edge{
+			to: f,
+			hop: Hop{
+				From:    c,
+				To:      f,
+				Rate:    rate,
+				Source:  ForexSource,
+				Pair:    currency.NewPair(c, f),
+				Updated: updated,
+			},
+			cost: forexCost,
+		})
+	}
+	return edges
+}
+
+// tickerPrice returns the last price of a ticker, or the mid price when no
+// trade price is set
+func tickerPrice(t *ticker.Price) float64 {
+	if t.Last > 0 {
+		return t.Last
+	}
+	if t.Bid > 0 && t.Ask > 0 {
+		return (t.Bid + t.Ask) / 2
+	}
+	return 0
+}
+
+func defaultForexRate(from, to currency.Code) (float64, time.Time, error) {
+	if !currency.ForexEnabled() {
+		return 0, time.Time{}, errForexDisabled
+	}
+	rate, err := currency.ConvertFiat(1, from, to)
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+	return rate, currency.ForeignExchangeRatesUpdated(), nil
+}
+
+type node struct {
+	code currency.Code
+	cost float64
+}
+
+// queue is a min heap of nodes ordered by cost
+type queue []node
+
+func (q queue) Len() int { return len(q) }
+
+func (q queue) Less(i, j int) bool {
+	if q[i].cost != q[j].cost {
+		return q[i].cost < q[j].cost
+	}
+	return q[i].code.String() < q[j].code.String()
+}
+
+func (q queue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+
+func (q *queue) Push(x any) { *q = append(*q, x.(node)) } //nolint:forcetypeassert // queue only holds nodes
+
+func (q *queue) Pop() any {
+	old := *q
+	n := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return n
+}
@@ -0,0 +1,121 @@
+package crossrate
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+)
+
+var errNoForexRate = errors.New("no forex rate")
+
+func testConverter(now time.Time, tickers ...*ticker.Price) *Converter {
+	return &Converter{
+		tickers: func() []*ticker.Price { return tickers },
+		forexRate: func(from, to currency.Code) (float64, time.Time, error) {
+			switch {
+			case from.Equal(currency.USD) && to.Equal(currency.EUR):
+				return 0.8, now.Add(-time.Hour), nil
+			case from.Equal(currency.EUR) && to.Equal(currency.USD):
+				return 1.25, now.Add(-time.Hour), nil
+			}
+			return 0, time.Time{}, errNoForexRate
+		},
+	}
+}
+
+func TestFindPath(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	c := testConverter(now,
+		&ticker.Price{ExchangeName: "binance", Pair: currency.NewPair(currency.XRP, currency.USDT), AssetType: asset.Spot, Last: 2, LastUpdated: now.Add(-time.Minute)},
+		&ticker.Price{ExchangeName: "bybit", Pair: currency.NewPair(currency.XRP, currency.USDT), AssetType: asset.Spot, Last: 2.5, LastUpdated: now.Add(-time.Second)},
+		&ticker.Price{ExchangeName: "binance", Pair: currency.NewPair(currency.XRP, currency.BTC), AssetType: asset.Futures, Last: 1, LastUpdated: now},
+		&ticker.Price{ExchangeName: "kraken", Pair: currency.NewPair(currency.BTC, currency.GBP), AssetType: asset.Spot, Bid: 99, Ask: 101, LastUpdated: now},
+	)
+	g := c.Graph()
+
+	_, err := g.FindPath(currency.EMPTYCODE, currency.EUR)
+	assert.ErrorIs(t, err, errCurrencyNotSet)
+
+	p, err := g.FindPath(currency.XRP, currency.XRP)
+	require.NoError(t, err, "FindPath must not error")
+	assert.Equal(t, 1.0, p.Rate, "FindPath should return a rate of 1 for the same currency")
+	assert.Empty(t, p.Hops, "FindPath should return no hops for the same currency")
+
+	p, err = g.FindPath(currency.XRP, currency.EUR)
+	require.NoError(t, err, "FindPath must not error")
+	require.Len(t, p.Hops, 3, "FindPath must route through a stablecoin and forex")
+	assert.Equal(t, "bybit", p.Hops[0].Source, "FindPath should use the freshest ticker of a pair")
+	assert.Equal(t, PegSource, p.Hops[1].Source, "FindPath should peg the stablecoin to USD")
+	assert.Equal(t, ForexSource, p.Hops[2].Source, "FindPath should use forex between fiat currencies")
+	assert.InDelta(t, 2.0, p.Rate, 1e-9, "FindPath should multiply the rates of each hop")
+	assert.InDelta(t, time.Hour, p.MaxStaleness(), float64(time.Second), "MaxStaleness should return the stalest hop")
+	assert.Equal(t, "XRP->USDT (bybit 1s) USDT->USD (peg) USD->EUR (forex 1h0m0s)", p.String())
+
+	amount, p, err := g.Convert(10, currency.EUR, currency.XRP)
+	require.NoError(t, err, "Convert must not error")
+	require.Len(t, p.Hops, 3, "Convert must return the path used")
+	assert.InDelta(t, 5.0, amount, 1e-9, "Convert should invert ticker prices")
+
+	_, err = g.FindPath(currency.BTC, currency.XRP)
+	assert.ErrorIs(t, err, ErrNoPath, "FindPath should ignore non spot tickers")
+
+	p, err = g.FindPath(currency.BTC, currency.GBP)
+	require.NoError(t, err, "FindPath must not error")
+	assert.Equal(t, 100.0, p.Rate, "FindPath should use the mid price without a last price")
+
+	c.DisablePegs = true
+	_, err = c.Graph().FindPath(currency.XRP, currency.EUR)
+	assert.ErrorIs(t, err, ErrNoPath, "FindPath should not peg stablecoins when disabled")
+}
+
+func TestConverterFilters(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	c := testConverter(now,
+		&ticker.Price{ExchangeName: "binance", Pair: currency.NewPair(currency.XRP, currency.USD), AssetType: asset.Spot, Last: 2, LastUpdated: now.Add(-time.Hour)},
+		&ticker.Price{ExchangeName: "kraken", Pair: currency.NewPair(currency.LTC, currency.USD), AssetType: asset.Spot, Last: 50, LastUpdated: now},
+	)
+	c.MaxStaleness = time.Minute
+	g := c.Graph()
+	_, err := g.FindPath(currency.XRP, currency.USD)
+	assert.ErrorIs(t, err, ErrNoPath, "FindPath should ignore stale tickers")
+	_, err = g.FindPath(currency.LTC, currency.USD)
+	assert.NoError(t, err, "FindPath should use fresh tickers")
+
+	c.MaxStaleness = 0
+	c.Exchanges = []string{"Binance"}
+	g = c.Graph()
+	_, err = g.FindPath(currency.XRP, currency.USD)
+	assert.NoError(t, err, "FindPath should use tickers of listed exchanges")
+	_, err = g.FindPath(currency.LTC, currency.USD)
+	assert.ErrorIs(t, err, ErrNoPath, "FindPath should ignore exchanges not listed")
+}
+
+func TestGraphCache(t *testing.T) {
+	t.Parallel()
+	c := testConverter(time.Now())
+	g := c.Graph()
+	assert.NotSame(t, g, c.Graph(), "Graph should rebuild without a cache duration")
+
+	c.CacheDuration = time.Hour
+	g = c.Graph()
+	assert.Same(t, g, c.Graph(), "Graph should reuse a cached graph")
+}
+
+func TestDefaultConverter(t *testing.T) {
+	t.Parallel()
+	assert.NotNil(t, GetGraph(), "GetGraph should return a graph")
+	p, err := FindPath(currency.USDT, currency.USD)
+	require.NoError(t, err, "FindPath must not error")
+	assert.Equal(t, 1.0, p.Rate, "FindPath should peg stablecoins to USD")
+	amount, _, err := Convert(5, currency.USD, currency.USDC)
+	require.NoError(t, err, "Convert must not error")
+	assert.Equal(t, 5.0, amount, "Convert should peg stablecoins to USD")
+}
@@ -0,0 +1,95 @@
+package crossrate
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+)
+
+// Hop sources which are not an exchange ticker
+const (
+	ForexSource = "forex"
+	PegSource   = "peg"
+)
+
+// DefaultCacheDuration is how long the default converter reuses a built graph
+// before rebuilding it from the latest tickers
+const DefaultCacheDuration = 5 * time.Second
+
+// Public errors
+var (
+	ErrNoPath = errors.New("no conversion path found")
+)
+
+var (
+	errCurrencyNotSet = errors.New("currency not set")
+	errForexDisabled  = errors.New("foreign exchange providers not enabled")
+)
+
+// Converter builds conversion graphs from cached exchange tickers and forex
+// rates
+type Converter struct {
+	// MaxStaleness ignores tickers last updated longer ago than it. Zero
+	// allows tickers of any age
+	MaxStaleness time.Duration
+	// Exchanges limits the tickers used to the named exchanges. All exchanges
+	// are used when empty
+	Exchanges []string
+	// DisablePegs stops USD stablecoins being valued at one USD when no
+	// market links them
+	DisablePegs bool
+	// CacheDuration reuses a built graph until it is older than it. Zero
+	// builds a new graph on every request
+	CacheDuration time.Duration
+
+	tickers   func() []*ticker.Price
+	forexRate func(from, to currency.Code) (float64, time.Time, error)
+
+	mu       sync.Mutex
+	cached   *Graph
+	cachedAt time.Time
+}
+
+// Graph is a snapshot of conversion rates between currencies. Each market
+// pair links both of its currencies, keeping the freshest ticker across
+// exchanges
+type Graph struct {
+	edges     map[*currency.Item][]edge
+	fiat      []currency.Code
+	forexRate func(from, to currency.Code) (float64, time.Time, error)
+}
+
+type edge struct {
+	to   currency.Code
+	hop  Hop
+	cost float64
+}
+
+// Hop is a single conversion step of a path
+type Hop struct {
+	From currency.Code
+	To   currency.Code
+	// Rate is how many units of To one unit of From converts to
+	Rate float64
+	// Source is the exchange name of the ticker used, ForexSource or
+	// PegSource
+	Source string
+	Pair   currency.Pair
+	Asset  asset.Item
+	// Updated is when the rate was last updated, zero when unknown
+	Updated time.Time
+	// Staleness is the age of the rate when the path was found
+	Staleness time.Duration
+}
+
+// Path is the conversion route between two currencies
+type Path struct {
+	From currency.Code
+	To   currency.Code
+	Rate float64
+	Hops []Hop
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 )
 
 var errEmptyPairString = errors.New("empty pair string")
@@ -74,6 +75,12 @@ func GetForeignExchangeRate(quotation Pair) (float64, error) {
 	return storage.ConvertCurrency(1, quotation.Base, quotation.Quote)
 }
 
+// ForeignExchangeRatesUpdated returns when the foreign exchange rates were last
+// updated by the forex providers
+func ForeignExchangeRatesUpdated() time.Time {
+	return storage.fxRates.LastUpdated()
+}
+
 // SeedForeignExchangeData seeds FX data with the currencies supplied
 func SeedForeignExchangeData(c Currencies) error {
 	return storage.SeedForeignExchangeRatesByCurrencies(c)
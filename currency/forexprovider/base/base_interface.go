@@ -7,6 +7,7 @@ import (
 	"slices"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/log"
@@ -15,6 +16,7 @@ import (
 var (
 	errNoProvider            = errors.New("no supporting foreign exchange providers set")
 	errUnsupportedCurrencies = errors.New("currencies not supported by provider")
+	errNoHistoricalProvider  = errors.New("no foreign exchange provider supports historical rates")
 )
 
 // IFXProvider enforces standard functions for all foreign exchange providers
@@ -28,6 +30,13 @@ type IFXProvider interface {
 	GetSupportedCurrencies() ([]string, error)
 }
 
+// IFXHistoricalProvider is implemented by foreign exchange providers which can
+// return the rates of a past date. Rates are keyed by the base currency joined
+// with each symbol, as returned by GetRates
+type IFXHistoricalProvider interface {
+	GetRatesAt(date time.Time, baseCurrency, symbols string) (map[string]float64, error)
+}
+
 // FXHandler defines a full suite of FX data providers with failure backup with
 // unsupported currency shunt procedure
 type FXHandler struct {
@@ -136,3 +145,25 @@ func (f *FXHandler) backupGetRate(base string, currencies []string) (map[string]
 	}
 	return nil, fmt.Errorf("%w: %s", errUnsupportedCurrencies, currencies)
 }
+
+// GetHistoricalCurrencyData returns the currency data of a past date from the
+// first enabled FX provider which supports historical rates, starting with the
+// primary provider
+func (f *FXHandler) GetHistoricalCurrencyData(date time.Time, baseCurrency string, currencies []string) (map[string]float64, error) {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+
+	providers := make([]Provider, 0, len(f.Support)+1)
+	if f.Primary.Provider != nil {
+		providers = append(providers, f.Primary)
+	}
+	providers = append(providers, f.Support...)
+	for i := range providers {
+		h, ok := providers[i].Provider.(IFXHistoricalProvider)
+		if !ok || !providers[i].Provider.IsEnabled() {
+			continue
+		}
+		return h.GetRatesAt(date, baseCurrency, strings.Join(currencies, ","))
+	}
+	return nil, errNoHistoricalProvider
+}
@@ -5,6 +5,7 @@ import (
 	"math/rand/v2"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -39,6 +40,27 @@ func (m *MockProvider) GetRates(baseCurrency, symbols string) (map[string]float6
 	return c, nil
 }
 
+type MockHistoricalProvider struct {
+	MockProvider
+}
+
+func (m *MockHistoricalProvider) GetRatesAt(_ time.Time, baseCurrency, symbols string) (map[string]float64, error) {
+	return map[string]float64{baseCurrency + symbols: m.value}, nil
+}
+
+func TestGetHistoricalCurrencyData(t *testing.T) {
+	t.Parallel()
+	var f FXHandler
+	_, err := f.GetHistoricalCurrencyData(time.Now(), "USD", []string{"EUR"})
+	assert.ErrorIs(t, err, errNoHistoricalProvider)
+
+	f.Primary = Provider{Provider: &MockProvider{value: 1}}
+	f.Support = append(f.Support, Provider{Provider: &MockHistoricalProvider{MockProvider{value: 0.9}}})
+	r, err := f.GetHistoricalCurrencyData(time.Now(), "USD", []string{"EUR"})
+	assert.NoError(t, err, "GetHistoricalCurrencyData should not error")
+	assert.Equal(t, map[string]float64{"USDEUR": 0.9}, r, "GetHistoricalCurrencyData should use the support provider with historical rates")
+}
+
 func TestBackupGetRate(t *testing.T) {
 	var f FXHandler
 	_, err := f.backupGetRate("", nil)
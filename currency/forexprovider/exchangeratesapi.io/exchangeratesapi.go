@@ -238,6 +238,25 @@ func (e *ExchangeRates) GetRates(baseCurrency, symbols string) (map[string]float
 	return standardisedRates, nil
 }
 
+// GetRatesAt is a wrapper function to return the forex rates of a past date
+func (e *ExchangeRates) GetRatesAt(date time.Time, baseCurrency, symbols string) (map[string]float64, error) {
+	var s []string
+	if symbols != "" {
+		s = strings.Split(symbols, ",")
+	}
+	result, err := e.GetHistoricalRates(date, baseCurrency, s)
+	if err != nil {
+		return nil, err
+	}
+
+	standardisedRates := make(map[string]float64)
+	for k, v := range result.Rates.Rates {
+		standardisedRates[baseCurrency+k] = v
+	}
+
+	return standardisedRates, nil
+}
+
 // GetSupportedCurrencies returns the supported currency list
 func (e *ExchangeRates) GetSupportedCurrencies() ([]string, error) {
 	symbols, err := e.GetSymbols()
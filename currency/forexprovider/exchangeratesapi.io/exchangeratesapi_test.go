@@ -207,6 +207,20 @@ func TestGetRates(t *testing.T) {
 	}
 }
 
+func TestGetRatesAt(t *testing.T) {
+	if !isAPIKeySet() {
+		t.Skip("API key not set, skipping test")
+	}
+
+	r, err := e.GetRatesAt(time.Now().AddDate(0, 0, -7), "EUR", "AUD")
+	if err != nil {
+		t.Fatalf("failed to GetRatesAt. Err: %s", err)
+	}
+	if _, ok := r["EURAUD"]; !ok {
+		t.Error("GetRatesAt should return standardised rates")
+	}
+}
+
 func TestGetSupportedCurrencies(t *testing.T) {
 	if !isAPIKeySet() {
 		t.Skip("API key not set, skipping test")
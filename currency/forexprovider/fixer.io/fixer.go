@@ -15,6 +15,7 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency/forexprovider/base"
@@ -83,6 +84,27 @@ func (f *Fixer) GetRates(baseCurrency, symbols string) (map[string]float64, erro
 	return standardisedRates, nil
 }
 
+// GetRatesAt is a wrapper function to return the rates of a past date
+func (f *Fixer) GetRatesAt(date time.Time, baseCurrency, symbols string) (map[string]float64, error) {
+	requestBase := baseCurrency
+	if f.APIKeyLvl == fixerAPIFree {
+		requestBase = ""
+		baseCurrency = "EUR"
+	}
+
+	rates, err := f.GetHistoricalRates(date.UTC().Format(time.DateOnly), requestBase, []string{symbols})
+	if err != nil {
+		return nil, err
+	}
+
+	standardisedRates := make(map[string]float64)
+	for k, v := range rates {
+		standardisedRates[baseCurrency+k] = v
+	}
+
+	return standardisedRates, nil
+}
+
 // GetLatestRates returns real-time exchange rate data for all available or a
 // specific set of currencies. NOTE DEFAULT BASE CURRENCY IS EUR
 func (f *Fixer) GetLatestRates(baseCurrency, symbols string) (map[string]float64, error) {
@@ -2,6 +2,7 @@ package fixer
 
 import (
 	"testing"
+	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/currency/forexprovider/base"
 )
@@ -48,6 +49,14 @@ func TestGetHistoricalRates(t *testing.T) {
 	}
 }
 
+func TestGetRatesAt(t *testing.T) {
+	setup(t)
+	_, err := f.GetRatesAt(time.Date(2013, 12, 24, 0, 0, 0, 0, time.UTC), "EUR", "AUD")
+	if err == nil {
+		t.Error("fixer GetRatesAt() Expected error")
+	}
+}
+
 func TestConvertCurrency(t *testing.T) {
 	setup(t)
 	_, err := f.ConvertCurrency("AUD", "EUR", "", 1337)
@@ -16,6 +16,7 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency/forexprovider/base"
@@ -59,6 +60,21 @@ func (o *OXR) GetRates(baseCurrency, symbols string) (map[string]float64, error)
 	return standardisedRates, nil
 }
 
+// GetRatesAt is a wrapper function to return the rates of a past date
+func (o *OXR) GetRatesAt(date time.Time, baseCurrency, symbols string) (map[string]float64, error) {
+	rates, err := o.GetHistoricalRates(date.UTC().Format(time.DateOnly), baseCurrency, []string{symbols}, false, false)
+	if err != nil {
+		return nil, err
+	}
+
+	standardisedRates := make(map[string]float64)
+	for k, v := range rates {
+		standardisedRates[baseCurrency+k] = v
+	}
+
+	return standardisedRates, nil
+}
+
 // GetLatest returns the latest exchange rates available from the Open Exchange
 // Rates
 func (o *OXR) GetLatest(baseCurrency, symbols string, prettyPrint, showAlternative bool) (map[string]float64, error) {
@@ -4,6 +4,7 @@ import (
 	"log"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/currency/forexprovider/base"
 )
@@ -54,6 +55,14 @@ func TestGetHistoricalRates(t *testing.T) {
 	}
 }
 
+func TestGetRatesAt(t *testing.T) {
+	t.Parallel()
+	_, err := o.GetRatesAt(time.Date(2017, 12, 1, 0, 0, 0, 0, time.UTC), "USD", "AUD")
+	if err == nil {
+		t.Error("GetRatesAt() Expected error")
+	}
+}
+
 func TestGetCurrencies(t *testing.T) {
 	t.Parallel()
 	_, err := o.GetCurrencies(true, true, true)
@@ -0,0 +1,309 @@
+// Package fxhistory stores historical foreign exchange rates so amounts held in
+// different fiat currencies can be valued at the rate of the time they relate
+// to, rather than the latest rate
+package fxhistory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/fxrate"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+const providerSource = "forexprovider"
+
+var defaultStore = NewStore(DefaultMaxRateAge)
+
+// NewStore returns an empty store which ignores rates older than maxAge when
+// valuing an amount. A maxAge of zero uses DefaultMaxRateAge
+func NewStore(maxAge time.Duration) *Store {
+	if maxAge <= 0 {
+		maxAge = DefaultMaxRateAge
+	}
+	return &Store{
+		maxAge: maxAge,
+		rates:  make(map[rateKey][]point),
+	}
+}
+
+// Add stores rates, replacing any rate already held for the same currencies
+// and time
+func (s *Store) Add(rates ...Rate) error {
+	for i := range rates {
+		if err := rates[i].validate(); err != nil {
+			return err
+		}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := range rates {
+		k := rateKey{from: rates[i].From.Item, to: rates[i].To.Item}
+		p := point{time: rates[i].Time.UTC(), rate: rates[i].Rate}
+		pts := s.rates[k]
+		idx, found := slices.BinarySearchFunc(pts, p.time, func(e point, t time.Time) int {
+			return e.time.Compare(t)
+		})
+		if found {
+			pts[idx] = p
+			continue
+		}
+		s.rates[k] = slices.Insert(pts, idx, p)
+	}
+	return nil
+}
+
+// HasRates returns whether rates between the currencies are held in memory in
+// either direction
+func (s *Store) HasRates(from, to currency.Code) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.rates[rateKey{from: from.Item, to: to.Item}]) > 0 ||
+		len(s.rates[rateKey{from: to.Item, to: from.Item}]) > 0
+}
+
+// GetRateAt returns how many units of to one unit of from was worth at the
+// time, using the latest rate at or before it. The inverse rate is used when
+// only the opposite direction is held. When the database is connected, rates
+// not held in memory are read from it and cached
+func (s *Store) GetRateAt(ctx context.Context, from, to currency.Code, at time.Time) (float64, error) {
+	if from.IsEmpty() || to.IsEmpty() {
+		return 0, errCurrencyNotSet
+	}
+	if at.IsZero() {
+		return 0, errTimeNotSet
+	}
+	if from.Equal(to) {
+		return 1, nil
+	}
+	if rate, ok := s.rateAt(from, to, at); ok {
+		return rate, nil
+	}
+	if rate, ok := s.rateAt(to, from, at); ok {
+		return 1 / rate, nil
+	}
+	if database.DB.IsConnected() {
+		if rate, err := s.databaseRateAt(ctx, from, to, at); err == nil {
+			return rate, nil
+		}
+		if rate, err := s.databaseRateAt(ctx, to, from, at); err == nil {
+			return 1 / rate, nil
+		}
+	}
+	return 0, fmt.Errorf("%w for %s-%s at %s", ErrRateNotFound, from, to, at)
+}
+
+// Convert values an amount of from in to at the time
+func (s *Store) Convert(ctx context.Context, amount float64, from, to currency.Code, at time.Time) (float64, error) {
+	rate, err := s.GetRateAt(ctx, from, to, at)
+	if err != nil {
+		return 0, err
+	}
+	return amount * rate, nil
+}
+
+// LoadCSV stores rates read from csv rows of unix timestamp, base currency,
+// quote currency and rate
+func (s *Store) LoadCSV(r io.Reader) (int, error) {
+	data, err := fxrate.ReadCSV(r)
+	if err != nil {
+		return 0, err
+	}
+	rates := make([]Rate, len(data))
+	for i := range data {
+		rates[i] = rateFromData(&data[i])
+	}
+	if err := s.Add(rates...); err != nil {
+		return 0, err
+	}
+	return len(rates), nil
+}
+
+// LoadCSVFile stores the rates of a csv file, see LoadCSV for its format
+func (s *Store) LoadCSVFile(path string) (int, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if errClose := f.Close(); errClose != nil {
+			log.Errorln(log.Currency, errClose)
+		}
+	}()
+	return s.LoadCSV(f)
+}
+
+// LoadFromDatabase stores the rates saved to the database between the start
+// and end times
+func (s *Store) LoadFromDatabase(ctx context.Context, from, to currency.Code, start, end time.Time) (int, error) {
+	data, err := fxrate.GetInRange(ctx, from.String(), to.String(), start, end)
+	if err != nil {
+		if errors.Is(err, fxrate.ErrRateNotFound) {
+			return 0, fmt.Errorf("%w: %w", ErrRateNotFound, err)
+		}
+		return 0, err
+	}
+	rates := make([]Rate, len(data))
+	for i := range data {
+		rates[i] = rateFromData(&data[i])
+	}
+	if err := s.Add(rates...); err != nil {
+		return 0, err
+	}
+	return len(rates), nil
+}
+
+// FetchFromProvider requests the daily rates of the currencies against from
+// for every day between the start and end times and stores them. The fetched
+// rates are returned so they can be saved
+func (s *Store) FetchFromProvider(f HistoricalRatesFetcher, from currency.Code, to currency.Currencies, start, end time.Time) ([]Rate, error) {
+	if f == nil {
+		return nil, errNilFetcher
+	}
+	if from.IsEmpty() || len(to) == 0 {
+		return nil, errCurrencyNotSet
+	}
+	start = start.UTC().Truncate(24 * time.Hour)
+	end = end.UTC().Truncate(24 * time.Hour)
+	if start.IsZero() || end.Before(start) {
+		return nil, fmt.Errorf("%w %s to %s", errInvalidTimeRange, start, end)
+	}
+
+	symbols := to.Strings()
+	var rates []Rate
+	for day := start; !day.After(end); day = day.AddDate(0, 0, 1) {
+		resp, err := f.GetHistoricalCurrencyData(day, from.String(), symbols)
+		if err != nil {
+			return nil, fmt.Errorf("%s %s: %w", from, day.Format(time.DateOnly), err)
+		}
+		for i := range to {
+			rate, ok := resp[from.String()+to[i].String()]
+			if !ok || rate <= 0 {
+				continue
+			}
+			rates = append(rates, Rate{
+				From:   from,
+				To:     to[i],
+				Rate:   rate,
+				Time:   day,
+				Source: providerSource,
+			})
+		}
+	}
+	if len(rates) == 0 {
+		return nil, fmt.Errorf("%w for %s between %s and %s", ErrRateNotFound, from, start, end)
+	}
+	if err := s.Add(rates...); err != nil {
+		return nil, err
+	}
+	return rates, nil
+}
+
+// SaveToDatabase saves rates to the database so they are available offline
+func SaveToDatabase(ctx context.Context, rates ...Rate) error {
+	data := make([]fxrate.Data, len(rates))
+	for i := range rates {
+		data[i] = fxrate.Data{
+			Base:      rates[i].From.String(),
+			Quote:     rates[i].To.String(),
+			Rate:      rates[i].Rate,
+			Source:    rates[i].Source,
+			Timestamp: rates[i].Time,
+		}
+	}
+	return fxrate.Insert(ctx, data...)
+}
+
+// Add stores rates in the default store
+func Add(rates ...Rate) error {
+	return defaultStore.Add(rates...)
+}
+
+// HasRates returns whether the default store holds rates between the
+// currencies
+func HasRates(from, to currency.Code) bool {
+	return defaultStore.HasRates(from, to)
+}
+
+// GetRateAt returns the rate between the currencies at the time from the
+// default store
+func GetRateAt(ctx context.Context, from, to currency.Code, at time.Time) (float64, error) {
+	return defaultStore.GetRateAt(ctx, from, to, at)
+}
+
+// Convert values an amount using the default store
+func Convert(ctx context.Context, amount float64, from, to currency.Code, at time.Time) (float64, error) {
+	return defaultStore.Convert(ctx, amount, from, to, at)
+}
+
+// LoadCSVFile stores the rates of a csv file in the default store
+func LoadCSVFile(path string) (int, error) {
+	return defaultStore.LoadCSVFile(path)
+}
+
+// LoadFromDatabase stores the rates saved to the database between the start
+// and end times in the default store
+func LoadFromDatabase(ctx context.Context, from, to currency.Code, start, end time.Time) (int, error) {
+	return defaultStore.LoadFromDatabase(ctx, from, to, start, end)
+}
+
+// rateAt returns the latest rate held at or before the time which is not
+// older than the store's max age
+func (s *Store) rateAt(from, to currency.Code, at time.Time) (float64, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	pts := s.rates[rateKey{from: from.Item, to: to.Item}]
+	idx := sort.Search(len(pts), func(i int) bool {
+		return pts[i].time.After(at)
+	})
+	if idx == 0 || at.Sub(pts[idx-1].time) > s.maxAge {
+		return 0, false
+	}
+	return pts[idx-1].rate, true
+}
+
+func (s *Store) databaseRateAt(ctx context.Context, from, to currency.Code, at time.Time) (float64, error) {
+	d, err := fxrate.GetRateAt(ctx, from.String(), to.String(), at)
+	if err != nil {
+		return 0, err
+	}
+	if at.Sub(d.Timestamp) > s.maxAge {
+		return 0, ErrRateNotFound
+	}
+	r := rateFromData(d)
+	if err := s.Add(r); err != nil {
+		return 0, err
+	}
+	return r.Rate, nil
+}
+
+func rateFromData(d *fxrate.Data) Rate {
+	return Rate{
+		From:   currency.NewCode(d.Base),
+		To:     currency.NewCode(d.Quote),
+		Rate:   d.Rate,
+		Time:   d.Timestamp.UTC(),
+		Source: d.Source,
+	}
+}
+
+func (r *Rate) validate() error {
+	if r.From.IsEmpty() || r.To.IsEmpty() {
+		return errCurrencyNotSet
+	}
+	if r.Rate <= 0 {
+		return fmt.Errorf("%w: %v for %s-%s", errInvalidRate, r.Rate, r.From, r.To)
+	}
+	if r.Time.IsZero() {
+		return errTimeNotSet
+	}
+	return nil
+}
@@ -0,0 +1,144 @@
+package fxhistory
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+)
+
+var (
+	firstDay    = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	errFetchErr = errors.New("fetch error")
+)
+
+type fakeFetcher struct {
+	rates map[string]float64
+	err   error
+	calls []time.Time
+}
+
+func (f *fakeFetcher) GetHistoricalCurrencyData(date time.Time, _ string, _ []string) (map[string]float64, error) {
+	f.calls = append(f.calls, date)
+	return f.rates, f.err
+}
+
+func TestAdd(t *testing.T) {
+	t.Parallel()
+	s := NewStore(0)
+	assert.Equal(t, DefaultMaxRateAge, s.maxAge, "NewStore should default the max rate age")
+
+	assert.ErrorIs(t, s.Add(Rate{To: currency.USD, Rate: 1, Time: firstDay}), errCurrencyNotSet)
+	assert.ErrorIs(t, s.Add(Rate{From: currency.EUR, To: currency.USD, Time: firstDay}), errInvalidRate)
+	assert.ErrorIs(t, s.Add(Rate{From: currency.EUR, To: currency.USD, Rate: 1}), errTimeNotSet)
+
+	require.NoError(t, s.Add(
+		Rate{From: currency.EUR, To: currency.USD, Rate: 1.12, Time: firstDay.AddDate(0, 0, 2)},
+		Rate{From: currency.EUR, To: currency.USD, Rate: 1.1, Time: firstDay},
+		Rate{From: currency.EUR, To: currency.USD, Rate: 1.11, Time: firstDay.AddDate(0, 0, 1)},
+	), "Add must not error")
+	require.NoError(t, s.Add(Rate{From: currency.EUR, To: currency.USD, Rate: 1.2, Time: firstDay}), "Add must not error")
+
+	pts := s.rates[rateKey{from: currency.EUR.Item, to: currency.USD.Item}]
+	require.Len(t, pts, 3, "Add must replace a rate held for the same time")
+	assert.Equal(t, []float64{1.2, 1.11, 1.12}, []float64{pts[0].rate, pts[1].rate, pts[2].rate}, "Add should order rates by time")
+	assert.True(t, s.HasRates(currency.USD, currency.EUR), "HasRates should check the inverse direction")
+	assert.False(t, s.HasRates(currency.GBP, currency.USD), "HasRates should return false for unknown currencies")
+}
+
+func TestGetRateAt(t *testing.T) {
+	t.Parallel()
+	s := NewStore(48 * time.Hour)
+	require.NoError(t, s.Add(
+		Rate{From: currency.EUR, To: currency.USD, Rate: 1.1, Time: firstDay},
+		Rate{From: currency.EUR, To: currency.USD, Rate: 1.25, Time: firstDay.AddDate(0, 0, 1)},
+	), "Add must not error")
+
+	_, err := s.GetRateAt(t.Context(), currency.EMPTYCODE, currency.USD, firstDay)
+	assert.ErrorIs(t, err, errCurrencyNotSet)
+	_, err = s.GetRateAt(t.Context(), currency.EUR, currency.USD, time.Time{})
+	assert.ErrorIs(t, err, errTimeNotSet)
+
+	rate, err := s.GetRateAt(t.Context(), currency.GBP, currency.GBP, firstDay)
+	require.NoError(t, err, "GetRateAt must not error")
+	assert.Equal(t, 1.0, rate, "GetRateAt should return 1 for the same currency")
+
+	rate, err = s.GetRateAt(t.Context(), currency.EUR, currency.USD, firstDay.Add(12*time.Hour))
+	require.NoError(t, err, "GetRateAt must not error")
+	assert.Equal(t, 1.1, rate, "GetRateAt should return the latest rate before the time")
+
+	rate, err = s.GetRateAt(t.Context(), currency.USD, currency.EUR, firstDay.AddDate(0, 0, 1))
+	require.NoError(t, err, "GetRateAt must not error")
+	assert.Equal(t, 0.8, rate, "GetRateAt should invert the opposite direction")
+
+	_, err = s.GetRateAt(t.Context(), currency.EUR, currency.USD, firstDay.Add(-time.Second))
+	assert.ErrorIs(t, err, ErrRateNotFound)
+
+	_, err = s.GetRateAt(t.Context(), currency.EUR, currency.USD, firstDay.AddDate(0, 0, 4))
+	assert.ErrorIs(t, err, ErrRateNotFound, "GetRateAt should not use a rate older than the max age")
+
+	amount, err := s.Convert(t.Context(), 100, currency.EUR, currency.USD, firstDay)
+	require.NoError(t, err, "Convert must not error")
+	assert.InDelta(t, 110.0, amount, 1e-9, "Convert should value the amount at the rate")
+	_, err = s.Convert(t.Context(), 100, currency.GBP, currency.USD, firstDay)
+	assert.ErrorIs(t, err, ErrRateNotFound)
+}
+
+func TestLoadCSV(t *testing.T) {
+	t.Parallel()
+	s := NewStore(0)
+	_, err := s.LoadCSV(strings.NewReader("bad,EUR,USD,1.1\n"))
+	require.Error(t, err, "LoadCSV must error on an invalid row")
+
+	n, err := s.LoadCSV(strings.NewReader("1577836800,EUR,USD,1.1\n1577923200,EUR,USD,1.12\n"))
+	require.NoError(t, err, "LoadCSV must not error")
+	assert.Equal(t, 2, n, "LoadCSV should store every row")
+	rate, err := s.GetRateAt(t.Context(), currency.EUR, currency.USD, firstDay.AddDate(0, 0, 1))
+	require.NoError(t, err, "GetRateAt must not error")
+	assert.Equal(t, 1.12, rate, "GetRateAt should return a loaded rate")
+
+	path := filepath.Join(t.TempDir(), "fx.csv")
+	require.NoError(t, os.WriteFile(path, []byte("1577836800,GBP,USD,1.32\n"), 0o600), "WriteFile must not error")
+	n, err = s.LoadCSVFile(path)
+	require.NoError(t, err, "LoadCSVFile must not error")
+	assert.Equal(t, 1, n, "LoadCSVFile should store every row")
+	assert.True(t, s.HasRates(currency.GBP, currency.USD), "LoadCSVFile should store the rates")
+
+	_, err = s.LoadCSVFile(filepath.Join(t.TempDir(), "missing.csv"))
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestFetchFromProvider(t *testing.T) {
+	t.Parallel()
+	s := NewStore(0)
+	_, err := s.FetchFromProvider(nil, currency.USD, currency.Currencies{currency.EUR}, firstDay, firstDay)
+	assert.ErrorIs(t, err, errNilFetcher)
+
+	f := &fakeFetcher{rates: map[string]float64{"USDEUR": 0.9, "USDGBP": 0.75}}
+	_, err = s.FetchFromProvider(f, currency.USD, nil, firstDay, firstDay)
+	assert.ErrorIs(t, err, errCurrencyNotSet)
+	_, err = s.FetchFromProvider(f, currency.USD, currency.Currencies{currency.EUR}, firstDay, firstDay.AddDate(0, 0, -1))
+	assert.ErrorIs(t, err, errInvalidTimeRange)
+
+	rates, err := s.FetchFromProvider(f, currency.USD, currency.Currencies{currency.EUR, currency.GBP, currency.JPY}, firstDay.Add(time.Hour), firstDay.AddDate(0, 0, 2))
+	require.NoError(t, err, "FetchFromProvider must not error")
+	assert.Len(t, f.calls, 3, "FetchFromProvider should request each day in the range")
+	assert.Len(t, rates, 6, "FetchFromProvider should skip currencies without a rate")
+	assert.Equal(t, firstDay, rates[0].Time, "FetchFromProvider should time rates at the start of the day")
+	assert.Equal(t, providerSource, rates[0].Source, "FetchFromProvider should record the rate source")
+
+	rate, err := s.GetRateAt(t.Context(), currency.EUR, currency.USD, firstDay.AddDate(0, 0, 1))
+	require.NoError(t, err, "GetRateAt must not error")
+	assert.InDelta(t, 1/0.9, rate, 1e-9, "GetRateAt should use fetched rates")
+
+	_, err = s.FetchFromProvider(&fakeFetcher{err: errFetchErr}, currency.USD, currency.Currencies{currency.EUR}, firstDay, firstDay)
+	assert.ErrorIs(t, err, errFetchErr)
+	_, err = s.FetchFromProvider(&fakeFetcher{}, currency.USD, currency.Currencies{currency.EUR}, firstDay, firstDay)
+	assert.ErrorIs(t, err, ErrRateNotFound)
+}
@@ -0,0 +1,61 @@
+package fxhistory
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+)
+
+// DefaultMaxRateAge is how old a rate can be and still value an amount. Fiat
+// providers publish no rates on weekends or public holidays so a rate is
+// carried forward until a newer one exists
+const DefaultMaxRateAge = 7 * 24 * time.Hour
+
+// Public errors
+var (
+	ErrRateNotFound = errors.New("historical fx rate not found")
+)
+
+var (
+	errInvalidRate      = errors.New("fx rate must be positive")
+	errCurrencyNotSet   = errors.New("fx rate currency not set")
+	errTimeNotSet       = errors.New("fx rate time not set")
+	errNilFetcher       = errors.New("nil historical rates fetcher")
+	errInvalidTimeRange = errors.New("invalid time range")
+)
+
+// Rate is a foreign exchange rate at a point in time, where one unit of From
+// is worth Rate units of To
+type Rate struct {
+	From   currency.Code
+	To     currency.Code
+	Rate   float64
+	Time   time.Time
+	Source string
+}
+
+// HistoricalRatesFetcher returns the rates of currencies against a base
+// currency on a date, keyed by the base currency followed by the symbol
+type HistoricalRatesFetcher interface {
+	GetHistoricalCurrencyData(date time.Time, baseCurrency string, currencies []string) (map[string]float64, error)
+}
+
+// Store holds historical fx rates in memory ordered by time for each
+// currency pair
+type Store struct {
+	maxAge time.Duration
+	mu     sync.RWMutex
+	rates  map[rateKey][]point
+}
+
+type rateKey struct {
+	from *currency.Item
+	to   *currency.Item
+}
+
+type point struct {
+	time time.Time
+	rate float64
+}
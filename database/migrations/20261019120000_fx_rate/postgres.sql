@@ -0,0 +1,14 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS fx_rate
+(
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    base varchar(30) NOT NULL,
+    quote varchar(30) NOT NULL,
+    rate DOUBLE PRECISION NOT NULL,
+    source varchar NOT NULL,
+    timestamp TIMESTAMPTZ NOT NULL,
+    CONSTRAINT uniquefxrate
+        unique(base, quote, timestamp)
+);
+-- +goose Down
+DROP TABLE fx_rate;
@@ -0,0 +1,14 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS fx_rate
+(
+    id text not null primary key,
+    base text NOT NULL,
+    quote text NOT NULL,
+    rate REAL NOT NULL,
+    source TEXT NOT NULL,
+    timestamp TIMESTAMP NOT NULL,
+    CONSTRAINT uniquefxrate
+        unique(base, quote, timestamp)
+);
+-- +goose Down
+DROP TABLE fx_rate;
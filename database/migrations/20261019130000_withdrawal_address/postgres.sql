@@ -0,0 +1,15 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS withdrawal_address
+(
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    currency varchar(30) NOT NULL,
+    chain varchar NOT NULL DEFAULT '',
+    address varchar NOT NULL,
+    address_tag varchar NOT NULL DEFAULT '',
+    label varchar NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uniquewithdrawaladdress
+        unique(currency, chain, address, address_tag)
+);
+-- +goose Down
+DROP TABLE withdrawal_address;
@@ -0,0 +1,15 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS withdrawal_address
+(
+    id text not null primary key,
+    currency text NOT NULL,
+    chain text NOT NULL DEFAULT '',
+    address text NOT NULL,
+    address_tag text NOT NULL DEFAULT '',
+    label text NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uniquewithdrawaladdress
+        unique(currency, chain, address, address_tag)
+);
+-- +goose Down
+DROP TABLE withdrawal_address;
@@ -0,0 +1,19 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS funding_transition
+(
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    exchange varchar NOT NULL,
+    transfer_id varchar NOT NULL,
+    transfer_type varchar NOT NULL DEFAULT '',
+    currency varchar(30) NOT NULL,
+    amount DOUBLE PRECISION NOT NULL DEFAULT 0,
+    chain varchar NOT NULL DEFAULT '',
+    tx_id varchar NOT NULL DEFAULT '',
+    from_state varchar NOT NULL DEFAULT '',
+    to_state varchar NOT NULL,
+    status varchar NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS funding_transition_transfer ON funding_transition (exchange, transfer_id);
+-- +goose Down
+DROP TABLE funding_transition;
@@ -0,0 +1,19 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS funding_transition
+(
+    id text not null primary key,
+    exchange text NOT NULL,
+    transfer_id text NOT NULL,
+    transfer_type text NOT NULL DEFAULT '',
+    currency text NOT NULL,
+    amount real NOT NULL DEFAULT 0,
+    chain text NOT NULL DEFAULT '',
+    tx_id text NOT NULL DEFAULT '',
+    from_state text NOT NULL DEFAULT '',
+    to_state text NOT NULL,
+    status text NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS funding_transition_transfer ON funding_transition (exchange, transfer_id);
+-- +goose Down
+DROP TABLE funding_transition;
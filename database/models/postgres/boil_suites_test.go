@@ -14,67 +14,100 @@ import "testing"
 func TestParent(t *testing.T) {
 	t.Run("AuditEvents", testAuditEvents)
 	t.Run("Exchanges", testExchanges)
+	t.Run("FundingTransitions", testFundingTransitions)
+	t.Run("FXRates", testFXRates)
 	t.Run("Scripts", testScripts)
+	t.Run("WithdrawalAddresses", testWithdrawalAddresses)
 }
 
 func TestDelete(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsDelete)
 	t.Run("Exchanges", testExchangesDelete)
+	t.Run("FundingTransitions", testFundingTransitionsDelete)
+	t.Run("FXRates", testFXRatesDelete)
 	t.Run("Scripts", testScriptsDelete)
+	t.Run("WithdrawalAddresses", testWithdrawalAddressesDelete)
 }
 
 func TestQueryDeleteAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsQueryDeleteAll)
 	t.Run("Exchanges", testExchangesQueryDeleteAll)
+	t.Run("FundingTransitions", testFundingTransitionsQueryDeleteAll)
+	t.Run("FXRates", testFXRatesQueryDeleteAll)
 	t.Run("Scripts", testScriptsQueryDeleteAll)
+	t.Run("WithdrawalAddresses", testWithdrawalAddressesQueryDeleteAll)
 }
 
 func TestSliceDeleteAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsSliceDeleteAll)
 	t.Run("Exchanges", testExchangesSliceDeleteAll)
+	t.Run("FundingTransitions", testFundingTransitionsSliceDeleteAll)
+	t.Run("FXRates", testFXRatesSliceDeleteAll)
 	t.Run("Scripts", testScriptsSliceDeleteAll)
+	t.Run("WithdrawalAddresses", testWithdrawalAddressesSliceDeleteAll)
 }
 
 func TestExists(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsExists)
 	t.Run("Exchanges", testExchangesExists)
+	t.Run("FundingTransitions", testFundingTransitionsExists)
+	t.Run("FXRates", testFXRatesExists)
 	t.Run("Scripts", testScriptsExists)
+	t.Run("WithdrawalAddresses", testWithdrawalAddressesExists)
 }
 
 func TestFind(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsFind)
 	t.Run("Exchanges", testExchangesFind)
+	t.Run("FundingTransitions", testFundingTransitionsFind)
+	t.Run("FXRates", testFXRatesFind)
 	t.Run("Scripts", testScriptsFind)
+	t.Run("WithdrawalAddresses", testWithdrawalAddressesFind)
 }
 
 func TestBind(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsBind)
 	t.Run("Exchanges", testExchangesBind)
+	t.Run("FundingTransitions", testFundingTransitionsBind)
+	t.Run("FXRates", testFXRatesBind)
 	t.Run("Scripts", testScriptsBind)
+	t.Run("WithdrawalAddresses", testWithdrawalAddressesBind)
 }
 
 func TestOne(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsOne)
 	t.Run("Exchanges", testExchangesOne)
+	t.Run("FundingTransitions", testFundingTransitionsOne)
+	t.Run("FXRates", testFXRatesOne)
 	t.Run("Scripts", testScriptsOne)
+	t.Run("WithdrawalAddresses", testWithdrawalAddressesOne)
 }
 
 func TestAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsAll)
 	t.Run("Exchanges", testExchangesAll)
+	t.Run("FundingTransitions", testFundingTransitionsAll)
+	t.Run("FXRates", testFXRatesAll)
 	t.Run("Scripts", testScriptsAll)
+	t.Run("WithdrawalAddresses", testWithdrawalAddressesAll)
 }
 
 func TestCount(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsCount)
 	t.Run("Exchanges", testExchangesCount)
+	t.Run("FundingTransitions", testFundingTransitionsCount)
+	t.Run("FXRates", testFXRatesCount)
 	t.Run("Scripts", testScriptsCount)
+	t.Run("WithdrawalAddresses", testWithdrawalAddressesCount)
 }
 
 func TestHooks(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsHooks)
 	t.Run("Exchanges", testExchangesHooks)
+	t.Run("FundingTransitions", testFundingTransitionsHooks)
+	t.Run("FXRates", testFXRatesHooks)
 	t.Run("Scripts", testScriptsHooks)
+	t.Run("WithdrawalAddresses", testWithdrawalAddressesHooks)
 }
 
 func TestInsert(t *testing.T) {
@@ -82,8 +115,14 @@ func TestInsert(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsInsertWhitelist)
 	t.Run("Exchanges", testExchangesInsert)
 	t.Run("Exchanges", testExchangesInsertWhitelist)
+	t.Run("FundingTransitions", testFundingTransitionsInsert)
+	t.Run("FundingTransitions", testFundingTransitionsInsertWhitelist)
+	t.Run("FXRates", testFXRatesInsert)
+	t.Run("FXRates", testFXRatesInsertWhitelist)
 	t.Run("Scripts", testScriptsInsert)
 	t.Run("Scripts", testScriptsInsertWhitelist)
+	t.Run("WithdrawalAddresses", testWithdrawalAddressesInsert)
+	t.Run("WithdrawalAddresses", testWithdrawalAddressesInsertWhitelist)
 }
 
 // TestToOne tests cannot be run in parallel
@@ -129,28 +168,43 @@ func TestToManyRemove(t *testing.T) {}
 func TestReload(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsReload)
 	t.Run("Exchanges", testExchangesReload)
+	t.Run("FundingTransitions", testFundingTransitionsReload)
+	t.Run("FXRates", testFXRatesReload)
+	t.Run("WithdrawalAddresses", testWithdrawalAddressesReload)
 }
 
 func TestReloadAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsReloadAll)
 	t.Run("Exchanges", testExchangesReloadAll)
+	t.Run("FundingTransitions", testFundingTransitionsReloadAll)
+	t.Run("FXRates", testFXRatesReloadAll)
 	t.Run("Scripts", testScriptsReloadAll)
+	t.Run("WithdrawalAddresses", testWithdrawalAddressesReloadAll)
 }
 
 func TestSelect(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsSelect)
 	t.Run("Exchanges", testExchangesSelect)
+	t.Run("FundingTransitions", testFundingTransitionsSelect)
+	t.Run("FXRates", testFXRatesSelect)
 	t.Run("Scripts", testScriptsSelect)
+	t.Run("WithdrawalAddresses", testWithdrawalAddressesSelect)
 }
 
 func TestUpdate(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsUpdate)
 	t.Run("Exchanges", testExchangesUpdate)
+	t.Run("FundingTransitions", testFundingTransitionsUpdate)
+	t.Run("FXRates", testFXRatesUpdate)
 	t.Run("Scripts", testScriptsUpdate)
+	t.Run("WithdrawalAddresses", testWithdrawalAddressesUpdate)
 }
 
 func TestSliceUpdateAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsSliceUpdateAll)
 	t.Run("Exchanges", testExchangesSliceUpdateAll)
+	t.Run("FundingTransitions", testFundingTransitionsSliceUpdateAll)
+	t.Run("FXRates", testFXRatesSliceUpdateAll)
 	t.Run("Scripts", testScriptsSliceUpdateAll)
+	t.Run("WithdrawalAddresses", testWithdrawalAddressesSliceUpdateAll)
 }
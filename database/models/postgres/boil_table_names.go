@@ -10,9 +10,12 @@ var TableNames = struct {
 	Datahistoryjobrelations string
 	Datahistoryjobresult    string
 	Exchange                string
+	FundingTransition       string
+	FXRate                  string
 	Script                  string
 	ScriptExecution         string
 	Trade                   string
+	WithdrawalAddress       string
 	WithdrawalCrypto        string
 	WithdrawalFiat          string
 	WithdrawalHistory       string
@@ -23,9 +26,12 @@ var TableNames = struct {
 	Datahistoryjobrelations: "datahistoryjobrelations",
 	Datahistoryjobresult:    "datahistoryjobresult",
 	Exchange:                "exchange",
+	FundingTransition:       "funding_transition",
+	FXRate:                  "fx_rate",
 	Script:                  "script",
 	ScriptExecution:         "script_execution",
 	Trade:                   "trade",
+	WithdrawalAddress:       "withdrawal_address",
 	WithdrawalCrypto:        "withdrawal_crypto",
 	WithdrawalFiat:          "withdrawal_fiat",
 	WithdrawalHistory:       "withdrawal_history",
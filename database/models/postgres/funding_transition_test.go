@@ -0,0 +1,732 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package postgres
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/randomize"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testFundingTransitions(t *testing.T) {
+	t.Parallel()
+
+	query := FundingTransitions()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testFundingTransitionsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FundingTransition{}
+	if err = randomize.Struct(seed, o, fundingTransitionDBTypes, true, fundingTransitionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FundingTransition struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := FundingTransitions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testFundingTransitionsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FundingTransition{}
+	if err = randomize.Struct(seed, o, fundingTransitionDBTypes, true, fundingTransitionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FundingTransition struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := FundingTransitions().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := FundingTransitions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testFundingTransitionsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FundingTransition{}
+	if err = randomize.Struct(seed, o, fundingTransitionDBTypes, true, fundingTransitionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FundingTransition struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := FundingTransitionSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := FundingTransitions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testFundingTransitionsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FundingTransition{}
+	if err = randomize.Struct(seed, o, fundingTransitionDBTypes, true, fundingTransitionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FundingTransition struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := FundingTransitionExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if FundingTransition exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected FundingTransitionExists to return true, but got false.")
+	}
+}
+
+func testFundingTransitionsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FundingTransition{}
+	if err = randomize.Struct(seed, o, fundingTransitionDBTypes, true, fundingTransitionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FundingTransition struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	fundingTransitionFound, err := FindFundingTransition(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if fundingTransitionFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testFundingTransitionsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FundingTransition{}
+	if err = randomize.Struct(seed, o, fundingTransitionDBTypes, true, fundingTransitionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FundingTransition struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = FundingTransitions().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testFundingTransitionsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FundingTransition{}
+	if err = randomize.Struct(seed, o, fundingTransitionDBTypes, true, fundingTransitionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FundingTransition struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := FundingTransitions().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testFundingTransitionsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	fundingTransitionOne := &FundingTransition{}
+	fundingTransitionTwo := &FundingTransition{}
+	if err = randomize.Struct(seed, fundingTransitionOne, fundingTransitionDBTypes, false, fundingTransitionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FundingTransition struct: %s", err)
+	}
+	if err = randomize.Struct(seed, fundingTransitionTwo, fundingTransitionDBTypes, false, fundingTransitionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FundingTransition struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = fundingTransitionOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = fundingTransitionTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := FundingTransitions().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testFundingTransitionsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	fundingTransitionOne := &FundingTransition{}
+	fundingTransitionTwo := &FundingTransition{}
+	if err = randomize.Struct(seed, fundingTransitionOne, fundingTransitionDBTypes, false, fundingTransitionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FundingTransition struct: %s", err)
+	}
+	if err = randomize.Struct(seed, fundingTransitionTwo, fundingTransitionDBTypes, false, fundingTransitionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FundingTransition struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = fundingTransitionOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = fundingTransitionTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := FundingTransitions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func fundingTransitionBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *FundingTransition) error {
+	*o = FundingTransition{}
+	return nil
+}
+
+func fundingTransitionAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *FundingTransition) error {
+	*o = FundingTransition{}
+	return nil
+}
+
+func fundingTransitionAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *FundingTransition) error {
+	*o = FundingTransition{}
+	return nil
+}
+
+func fundingTransitionBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *FundingTransition) error {
+	*o = FundingTransition{}
+	return nil
+}
+
+func fundingTransitionAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *FundingTransition) error {
+	*o = FundingTransition{}
+	return nil
+}
+
+func fundingTransitionBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *FundingTransition) error {
+	*o = FundingTransition{}
+	return nil
+}
+
+func fundingTransitionAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *FundingTransition) error {
+	*o = FundingTransition{}
+	return nil
+}
+
+func fundingTransitionBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *FundingTransition) error {
+	*o = FundingTransition{}
+	return nil
+}
+
+func fundingTransitionAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *FundingTransition) error {
+	*o = FundingTransition{}
+	return nil
+}
+
+func testFundingTransitionsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &FundingTransition{}
+	o := &FundingTransition{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, fundingTransitionDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize FundingTransition object: %s", err)
+	}
+
+	AddFundingTransitionHook(boil.BeforeInsertHook, fundingTransitionBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	fundingTransitionBeforeInsertHooks = []FundingTransitionHook{}
+
+	AddFundingTransitionHook(boil.AfterInsertHook, fundingTransitionAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	fundingTransitionAfterInsertHooks = []FundingTransitionHook{}
+
+	AddFundingTransitionHook(boil.AfterSelectHook, fundingTransitionAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	fundingTransitionAfterSelectHooks = []FundingTransitionHook{}
+
+	AddFundingTransitionHook(boil.BeforeUpdateHook, fundingTransitionBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	fundingTransitionBeforeUpdateHooks = []FundingTransitionHook{}
+
+	AddFundingTransitionHook(boil.AfterUpdateHook, fundingTransitionAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	fundingTransitionAfterUpdateHooks = []FundingTransitionHook{}
+
+	AddFundingTransitionHook(boil.BeforeDeleteHook, fundingTransitionBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	fundingTransitionBeforeDeleteHooks = []FundingTransitionHook{}
+
+	AddFundingTransitionHook(boil.AfterDeleteHook, fundingTransitionAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	fundingTransitionAfterDeleteHooks = []FundingTransitionHook{}
+
+	AddFundingTransitionHook(boil.BeforeUpsertHook, fundingTransitionBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	fundingTransitionBeforeUpsertHooks = []FundingTransitionHook{}
+
+	AddFundingTransitionHook(boil.AfterUpsertHook, fundingTransitionAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	fundingTransitionAfterUpsertHooks = []FundingTransitionHook{}
+}
+
+func testFundingTransitionsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FundingTransition{}
+	if err = randomize.Struct(seed, o, fundingTransitionDBTypes, true, fundingTransitionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FundingTransition struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := FundingTransitions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testFundingTransitionsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FundingTransition{}
+	if err = randomize.Struct(seed, o, fundingTransitionDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize FundingTransition struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(fundingTransitionColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := FundingTransitions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testFundingTransitionsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FundingTransition{}
+	if err = randomize.Struct(seed, o, fundingTransitionDBTypes, true, fundingTransitionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FundingTransition struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testFundingTransitionsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FundingTransition{}
+	if err = randomize.Struct(seed, o, fundingTransitionDBTypes, true, fundingTransitionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FundingTransition struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := FundingTransitionSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testFundingTransitionsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FundingTransition{}
+	if err = randomize.Struct(seed, o, fundingTransitionDBTypes, true, fundingTransitionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FundingTransition struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := FundingTransitions().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	fundingTransitionDBTypes = map[string]string{`ID`: `uuid`, `Exchange`: `character varying`, `TransferID`: `character varying`, `TransferType`: `character varying`, `Currency`: `character varying`, `Amount`: `double precision`, `Chain`: `character varying`, `TXID`: `character varying`, `FromState`: `character varying`, `ToState`: `character varying`, `Status`: `character varying`, `CreatedAt`: `timestamp with time zone`}
+	_                        = bytes.MinRead
+)
+
+func testFundingTransitionsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(fundingTransitionPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(fundingTransitionAllColumns) == len(fundingTransitionPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FundingTransition{}
+	if err = randomize.Struct(seed, o, fundingTransitionDBTypes, true, fundingTransitionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FundingTransition struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := FundingTransitions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, fundingTransitionDBTypes, true, fundingTransitionPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize FundingTransition struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testFundingTransitionsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(fundingTransitionAllColumns) == len(fundingTransitionPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FundingTransition{}
+	if err = randomize.Struct(seed, o, fundingTransitionDBTypes, true, fundingTransitionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FundingTransition struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := FundingTransitions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, fundingTransitionDBTypes, true, fundingTransitionPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize FundingTransition struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(fundingTransitionAllColumns, fundingTransitionPrimaryKeyColumns) {
+		fields = fundingTransitionAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			fundingTransitionAllColumns,
+			fundingTransitionPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := FundingTransitionSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testFundingTransitionsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(fundingTransitionAllColumns) == len(fundingTransitionPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := FundingTransition{}
+	if err = randomize.Struct(seed, &o, fundingTransitionDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize FundingTransition struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert FundingTransition: %s", err)
+	}
+
+	count, err := FundingTransitions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, fundingTransitionDBTypes, false, fundingTransitionPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize FundingTransition struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert FundingTransition: %s", err)
+	}
+
+	count, err = FundingTransitions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
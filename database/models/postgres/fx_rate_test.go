@@ -0,0 +1,732 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package postgres
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/randomize"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testFXRates(t *testing.T) {
+	t.Parallel()
+
+	query := FXRates()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testFXRatesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FXRate{}
+	if err = randomize.Struct(seed, o, fxRateDBTypes, true, fxRateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FXRate struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := FXRates().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testFXRatesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FXRate{}
+	if err = randomize.Struct(seed, o, fxRateDBTypes, true, fxRateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FXRate struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := FXRates().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := FXRates().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testFXRatesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FXRate{}
+	if err = randomize.Struct(seed, o, fxRateDBTypes, true, fxRateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FXRate struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := FXRateSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := FXRates().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testFXRatesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FXRate{}
+	if err = randomize.Struct(seed, o, fxRateDBTypes, true, fxRateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FXRate struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := FXRateExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if FXRate exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected FXRateExists to return true, but got false.")
+	}
+}
+
+func testFXRatesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FXRate{}
+	if err = randomize.Struct(seed, o, fxRateDBTypes, true, fxRateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FXRate struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	fxRateFound, err := FindFXRate(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if fxRateFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testFXRatesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FXRate{}
+	if err = randomize.Struct(seed, o, fxRateDBTypes, true, fxRateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FXRate struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = FXRates().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testFXRatesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FXRate{}
+	if err = randomize.Struct(seed, o, fxRateDBTypes, true, fxRateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FXRate struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := FXRates().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testFXRatesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	fxRateOne := &FXRate{}
+	fxRateTwo := &FXRate{}
+	if err = randomize.Struct(seed, fxRateOne, fxRateDBTypes, false, fxRateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FXRate struct: %s", err)
+	}
+	if err = randomize.Struct(seed, fxRateTwo, fxRateDBTypes, false, fxRateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FXRate struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = fxRateOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = fxRateTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := FXRates().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testFXRatesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	fxRateOne := &FXRate{}
+	fxRateTwo := &FXRate{}
+	if err = randomize.Struct(seed, fxRateOne, fxRateDBTypes, false, fxRateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FXRate struct: %s", err)
+	}
+	if err = randomize.Struct(seed, fxRateTwo, fxRateDBTypes, false, fxRateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FXRate struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = fxRateOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = fxRateTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := FXRates().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func fxRateBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *FXRate) error {
+	*o = FXRate{}
+	return nil
+}
+
+func fxRateAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *FXRate) error {
+	*o = FXRate{}
+	return nil
+}
+
+func fxRateAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *FXRate) error {
+	*o = FXRate{}
+	return nil
+}
+
+func fxRateBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *FXRate) error {
+	*o = FXRate{}
+	return nil
+}
+
+func fxRateAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *FXRate) error {
+	*o = FXRate{}
+	return nil
+}
+
+func fxRateBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *FXRate) error {
+	*o = FXRate{}
+	return nil
+}
+
+func fxRateAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *FXRate) error {
+	*o = FXRate{}
+	return nil
+}
+
+func fxRateBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *FXRate) error {
+	*o = FXRate{}
+	return nil
+}
+
+func fxRateAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *FXRate) error {
+	*o = FXRate{}
+	return nil
+}
+
+func testFXRatesHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &FXRate{}
+	o := &FXRate{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, fxRateDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize FXRate object: %s", err)
+	}
+
+	AddFXRateHook(boil.BeforeInsertHook, fxRateBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	fxRateBeforeInsertHooks = []FXRateHook{}
+
+	AddFXRateHook(boil.AfterInsertHook, fxRateAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	fxRateAfterInsertHooks = []FXRateHook{}
+
+	AddFXRateHook(boil.AfterSelectHook, fxRateAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	fxRateAfterSelectHooks = []FXRateHook{}
+
+	AddFXRateHook(boil.BeforeUpdateHook, fxRateBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	fxRateBeforeUpdateHooks = []FXRateHook{}
+
+	AddFXRateHook(boil.AfterUpdateHook, fxRateAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	fxRateAfterUpdateHooks = []FXRateHook{}
+
+	AddFXRateHook(boil.BeforeDeleteHook, fxRateBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	fxRateBeforeDeleteHooks = []FXRateHook{}
+
+	AddFXRateHook(boil.AfterDeleteHook, fxRateAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	fxRateAfterDeleteHooks = []FXRateHook{}
+
+	AddFXRateHook(boil.BeforeUpsertHook, fxRateBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	fxRateBeforeUpsertHooks = []FXRateHook{}
+
+	AddFXRateHook(boil.AfterUpsertHook, fxRateAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	fxRateAfterUpsertHooks = []FXRateHook{}
+}
+
+func testFXRatesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FXRate{}
+	if err = randomize.Struct(seed, o, fxRateDBTypes, true, fxRateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FXRate struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := FXRates().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testFXRatesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FXRate{}
+	if err = randomize.Struct(seed, o, fxRateDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize FXRate struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(fxRateColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := FXRates().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testFXRatesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FXRate{}
+	if err = randomize.Struct(seed, o, fxRateDBTypes, true, fxRateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FXRate struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testFXRatesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FXRate{}
+	if err = randomize.Struct(seed, o, fxRateDBTypes, true, fxRateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FXRate struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := FXRateSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testFXRatesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FXRate{}
+	if err = randomize.Struct(seed, o, fxRateDBTypes, true, fxRateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FXRate struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := FXRates().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	fxRateDBTypes = map[string]string{`ID`: `uuid`, `Base`: `character varying`, `Quote`: `character varying`, `Rate`: `double precision`, `Source`: `character varying`, `Timestamp`: `timestamp with time zone`}
+	_             = bytes.MinRead
+)
+
+func testFXRatesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(fxRatePrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(fxRateAllColumns) == len(fxRatePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FXRate{}
+	if err = randomize.Struct(seed, o, fxRateDBTypes, true, fxRateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FXRate struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := FXRates().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, fxRateDBTypes, true, fxRatePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize FXRate struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testFXRatesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(fxRateAllColumns) == len(fxRatePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FXRate{}
+	if err = randomize.Struct(seed, o, fxRateDBTypes, true, fxRateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FXRate struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := FXRates().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, fxRateDBTypes, true, fxRatePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize FXRate struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(fxRateAllColumns, fxRatePrimaryKeyColumns) {
+		fields = fxRateAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			fxRateAllColumns,
+			fxRatePrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := FXRateSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testFXRatesUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(fxRateAllColumns) == len(fxRatePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := FXRate{}
+	if err = randomize.Struct(seed, &o, fxRateDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize FXRate struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert FXRate: %s", err)
+	}
+
+	count, err := FXRates().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, fxRateDBTypes, false, fxRatePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize FXRate struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert FXRate: %s", err)
+	}
+
+	count, err = FXRates().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
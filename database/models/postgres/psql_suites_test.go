@@ -8,5 +8,8 @@ import "testing"
 func TestUpsert(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsUpsert)
 	t.Run("Exchanges", testExchangesUpsert)
+	t.Run("FundingTransitions", testFundingTransitionsUpsert)
+	t.Run("FXRates", testFXRatesUpsert)
 	t.Run("Scripts", testScriptsUpsert)
+	t.Run("WithdrawalAddresses", testWithdrawalAddressesUpsert)
 }
@@ -17,9 +17,12 @@ func TestParent(t *testing.T) {
 	t.Run("Datahistoryjobs", testDatahistoryjobs)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresults)
 	t.Run("Exchanges", testExchanges)
+	t.Run("FundingTransitions", testFundingTransitions)
+	t.Run("FXRates", testFXRates)
 	t.Run("Scripts", testScripts)
 	t.Run("ScriptExecutions", testScriptExecutions)
 	t.Run("Trades", testTrades)
+	t.Run("WithdrawalAddresses", testWithdrawalAddresses)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptos)
 	t.Run("WithdrawalFiats", testWithdrawalFiats)
 	t.Run("WithdrawalHistories", testWithdrawalHistories)
@@ -31,9 +34,12 @@ func TestDelete(t *testing.T) {
 	t.Run("Datahistoryjobs", testDatahistoryjobsDelete)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsDelete)
 	t.Run("Exchanges", testExchangesDelete)
+	t.Run("FundingTransitions", testFundingTransitionsDelete)
+	t.Run("FXRates", testFXRatesDelete)
 	t.Run("Scripts", testScriptsDelete)
 	t.Run("ScriptExecutions", testScriptExecutionsDelete)
 	t.Run("Trades", testTradesDelete)
+	t.Run("WithdrawalAddresses", testWithdrawalAddressesDelete)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosDelete)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsDelete)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesDelete)
@@ -45,9 +51,12 @@ func TestQueryDeleteAll(t *testing.T) {
 	t.Run("Datahistoryjobs", testDatahistoryjobsQueryDeleteAll)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsQueryDeleteAll)
 	t.Run("Exchanges", testExchangesQueryDeleteAll)
+	t.Run("FundingTransitions", testFundingTransitionsQueryDeleteAll)
+	t.Run("FXRates", testFXRatesQueryDeleteAll)
 	t.Run("Scripts", testScriptsQueryDeleteAll)
 	t.Run("ScriptExecutions", testScriptExecutionsQueryDeleteAll)
 	t.Run("Trades", testTradesQueryDeleteAll)
+	t.Run("WithdrawalAddresses", testWithdrawalAddressesQueryDeleteAll)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosQueryDeleteAll)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsQueryDeleteAll)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesQueryDeleteAll)
@@ -59,9 +68,12 @@ func TestSliceDeleteAll(t *testing.T) {
 	t.Run("Datahistoryjobs", testDatahistoryjobsSliceDeleteAll)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsSliceDeleteAll)
 	t.Run("Exchanges", testExchangesSliceDeleteAll)
+	t.Run("FundingTransitions", testFundingTransitionsSliceDeleteAll)
+	t.Run("FXRates", testFXRatesSliceDeleteAll)
 	t.Run("Scripts", testScriptsSliceDeleteAll)
 	t.Run("ScriptExecutions", testScriptExecutionsSliceDeleteAll)
 	t.Run("Trades", testTradesSliceDeleteAll)
+	t.Run("WithdrawalAddresses", testWithdrawalAddressesSliceDeleteAll)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosSliceDeleteAll)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsSliceDeleteAll)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesSliceDeleteAll)
@@ -73,9 +85,12 @@ func TestExists(t *testing.T) {
 	t.Run("Datahistoryjobs", testDatahistoryjobsExists)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsExists)
 	t.Run("Exchanges", testExchangesExists)
+	t.Run("FundingTransitions", testFundingTransitionsExists)
+	t.Run("FXRates", testFXRatesExists)
 	t.Run("Scripts", testScriptsExists)
 	t.Run("ScriptExecutions", testScriptExecutionsExists)
 	t.Run("Trades", testTradesExists)
+	t.Run("WithdrawalAddresses", testWithdrawalAddressesExists)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosExists)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsExists)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesExists)
@@ -87,9 +102,12 @@ func TestFind(t *testing.T) {
 	t.Run("Datahistoryjobs", testDatahistoryjobsFind)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsFind)
 	t.Run("Exchanges", testExchangesFind)
+	t.Run("FundingTransitions", testFundingTransitionsFind)
+	t.Run("FXRates", testFXRatesFind)
 	t.Run("Scripts", testScriptsFind)
 	t.Run("ScriptExecutions", testScriptExecutionsFind)
 	t.Run("Trades", testTradesFind)
+	t.Run("WithdrawalAddresses", testWithdrawalAddressesFind)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosFind)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsFind)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesFind)
@@ -101,9 +119,12 @@ func TestBind(t *testing.T) {
 	t.Run("Datahistoryjobs", testDatahistoryjobsBind)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsBind)
 	t.Run("Exchanges", testExchangesBind)
+	t.Run("FundingTransitions", testFundingTransitionsBind)
+	t.Run("FXRates", testFXRatesBind)
 	t.Run("Scripts", testScriptsBind)
 	t.Run("ScriptExecutions", testScriptExecutionsBind)
 	t.Run("Trades", testTradesBind)
+	t.Run("WithdrawalAddresses", testWithdrawalAddressesBind)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosBind)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsBind)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesBind)
@@ -115,9 +136,12 @@ func TestOne(t *testing.T) {
 	t.Run("Datahistoryjobs", testDatahistoryjobsOne)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsOne)
 	t.Run("Exchanges", testExchangesOne)
+	t.Run("FundingTransitions", testFundingTransitionsOne)
+	t.Run("FXRates", testFXRatesOne)
 	t.Run("Scripts", testScriptsOne)
 	t.Run("ScriptExecutions", testScriptExecutionsOne)
 	t.Run("Trades", testTradesOne)
+	t.Run("WithdrawalAddresses", testWithdrawalAddressesOne)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosOne)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsOne)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesOne)
@@ -129,9 +153,12 @@ func TestAll(t *testing.T) {
 	t.Run("Datahistoryjobs", testDatahistoryjobsAll)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsAll)
 	t.Run("Exchanges", testExchangesAll)
+	t.Run("FundingTransitions", testFundingTransitionsAll)
+	t.Run("FXRates", testFXRatesAll)
 	t.Run("Scripts", testScriptsAll)
 	t.Run("ScriptExecutions", testScriptExecutionsAll)
 	t.Run("Trades", testTradesAll)
+	t.Run("WithdrawalAddresses", testWithdrawalAddressesAll)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosAll)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsAll)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesAll)
@@ -143,9 +170,12 @@ func TestCount(t *testing.T) {
 	t.Run("Datahistoryjobs", testDatahistoryjobsCount)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsCount)
 	t.Run("Exchanges", testExchangesCount)
+	t.Run("FundingTransitions", testFundingTransitionsCount)
+	t.Run("FXRates", testFXRatesCount)
 	t.Run("Scripts", testScriptsCount)
 	t.Run("ScriptExecutions", testScriptExecutionsCount)
 	t.Run("Trades", testTradesCount)
+	t.Run("WithdrawalAddresses", testWithdrawalAddressesCount)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosCount)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsCount)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesCount)
@@ -157,9 +187,12 @@ func TestHooks(t *testing.T) {
 	t.Run("Datahistoryjobs", testDatahistoryjobsHooks)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsHooks)
 	t.Run("Exchanges", testExchangesHooks)
+	t.Run("FundingTransitions", testFundingTransitionsHooks)
+	t.Run("FXRates", testFXRatesHooks)
 	t.Run("Scripts", testScriptsHooks)
 	t.Run("ScriptExecutions", testScriptExecutionsHooks)
 	t.Run("Trades", testTradesHooks)
+	t.Run("WithdrawalAddresses", testWithdrawalAddressesHooks)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosHooks)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsHooks)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesHooks)
@@ -176,12 +209,18 @@ func TestInsert(t *testing.T) {
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsInsertWhitelist)
 	t.Run("Exchanges", testExchangesInsert)
 	t.Run("Exchanges", testExchangesInsertWhitelist)
+	t.Run("FundingTransitions", testFundingTransitionsInsert)
+	t.Run("FundingTransitions", testFundingTransitionsInsertWhitelist)
+	t.Run("FXRates", testFXRatesInsert)
+	t.Run("FXRates", testFXRatesInsertWhitelist)
 	t.Run("Scripts", testScriptsInsert)
 	t.Run("Scripts", testScriptsInsertWhitelist)
 	t.Run("ScriptExecutions", testScriptExecutionsInsert)
 	t.Run("ScriptExecutions", testScriptExecutionsInsertWhitelist)
 	t.Run("Trades", testTradesInsert)
 	t.Run("Trades", testTradesInsertWhitelist)
+	t.Run("WithdrawalAddresses", testWithdrawalAddressesInsert)
+	t.Run("WithdrawalAddresses", testWithdrawalAddressesInsertWhitelist)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosInsert)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosInsertWhitelist)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsInsert)
@@ -306,9 +345,12 @@ func TestReload(t *testing.T) {
 	t.Run("Datahistoryjobs", testDatahistoryjobsReload)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsReload)
 	t.Run("Exchanges", testExchangesReload)
+	t.Run("FundingTransitions", testFundingTransitionsReload)
+	t.Run("FXRates", testFXRatesReload)
 	t.Run("Scripts", testScriptsReload)
 	t.Run("ScriptExecutions", testScriptExecutionsReload)
 	t.Run("Trades", testTradesReload)
+	t.Run("WithdrawalAddresses", testWithdrawalAddressesReload)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosReload)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsReload)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesReload)
@@ -320,9 +362,12 @@ func TestReloadAll(t *testing.T) {
 	t.Run("Datahistoryjobs", testDatahistoryjobsReloadAll)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsReloadAll)
 	t.Run("Exchanges", testExchangesReloadAll)
+	t.Run("FundingTransitions", testFundingTransitionsReloadAll)
+	t.Run("FXRates", testFXRatesReloadAll)
 	t.Run("Scripts", testScriptsReloadAll)
 	t.Run("ScriptExecutions", testScriptExecutionsReloadAll)
 	t.Run("Trades", testTradesReloadAll)
+	t.Run("WithdrawalAddresses", testWithdrawalAddressesReloadAll)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosReloadAll)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsReloadAll)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesReloadAll)
@@ -334,9 +379,12 @@ func TestSelect(t *testing.T) {
 	t.Run("Datahistoryjobs", testDatahistoryjobsSelect)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsSelect)
 	t.Run("Exchanges", testExchangesSelect)
+	t.Run("FundingTransitions", testFundingTransitionsSelect)
+	t.Run("FXRates", testFXRatesSelect)
 	t.Run("Scripts", testScriptsSelect)
 	t.Run("ScriptExecutions", testScriptExecutionsSelect)
 	t.Run("Trades", testTradesSelect)
+	t.Run("WithdrawalAddresses", testWithdrawalAddressesSelect)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosSelect)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsSelect)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesSelect)
@@ -348,9 +396,12 @@ func TestUpdate(t *testing.T) {
 	t.Run("Datahistoryjobs", testDatahistoryjobsUpdate)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsUpdate)
 	t.Run("Exchanges", testExchangesUpdate)
+	t.Run("FundingTransitions", testFundingTransitionsUpdate)
+	t.Run("FXRates", testFXRatesUpdate)
 	t.Run("Scripts", testScriptsUpdate)
 	t.Run("ScriptExecutions", testScriptExecutionsUpdate)
 	t.Run("Trades", testTradesUpdate)
+	t.Run("WithdrawalAddresses", testWithdrawalAddressesUpdate)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosUpdate)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsUpdate)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesUpdate)
@@ -362,9 +413,12 @@ func TestSliceUpdateAll(t *testing.T) {
 	t.Run("Datahistoryjobs", testDatahistoryjobsSliceUpdateAll)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsSliceUpdateAll)
 	t.Run("Exchanges", testExchangesSliceUpdateAll)
+	t.Run("FundingTransitions", testFundingTransitionsSliceUpdateAll)
+	t.Run("FXRates", testFXRatesSliceUpdateAll)
 	t.Run("Scripts", testScriptsSliceUpdateAll)
 	t.Run("ScriptExecutions", testScriptExecutionsSliceUpdateAll)
 	t.Run("Trades", testTradesSliceUpdateAll)
+	t.Run("WithdrawalAddresses", testWithdrawalAddressesSliceUpdateAll)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosSliceUpdateAll)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsSliceUpdateAll)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesSliceUpdateAll)
@@ -0,0 +1,815 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package sqlite3
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/thrasher-corp/sqlboiler/queries/qmhelper"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+)
+
+// FundingTransition is an object representing the database table.
+type FundingTransition struct {
+	ID           string  `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Exchange     string  `boil:"exchange" json:"exchange" toml:"exchange" yaml:"exchange"`
+	TransferID   string  `boil:"transfer_id" json:"transfer_id" toml:"transfer_id" yaml:"transfer_id"`
+	TransferType string  `boil:"transfer_type" json:"transfer_type" toml:"transfer_type" yaml:"transfer_type"`
+	Currency     string  `boil:"currency" json:"currency" toml:"currency" yaml:"currency"`
+	Amount       float64 `boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
+	Chain        string  `boil:"chain" json:"chain" toml:"chain" yaml:"chain"`
+	TXID         string  `boil:"tx_id" json:"tx_id" toml:"tx_id" yaml:"tx_id"`
+	FromState    string  `boil:"from_state" json:"from_state" toml:"from_state" yaml:"from_state"`
+	ToState      string  `boil:"to_state" json:"to_state" toml:"to_state" yaml:"to_state"`
+	Status       string  `boil:"status" json:"status" toml:"status" yaml:"status"`
+	CreatedAt    string  `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *fundingTransitionR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L fundingTransitionL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var FundingTransitionColumns = struct {
+	ID           string
+	Exchange     string
+	TransferID   string
+	TransferType string
+	Currency     string
+	Amount       string
+	Chain        string
+	TXID         string
+	FromState    string
+	ToState      string
+	Status       string
+	CreatedAt    string
+}{
+	ID:           "id",
+	Exchange:     "exchange",
+	TransferID:   "transfer_id",
+	TransferType: "transfer_type",
+	Currency:     "currency",
+	Amount:       "amount",
+	Chain:        "chain",
+	TXID:         "tx_id",
+	FromState:    "from_state",
+	ToState:      "to_state",
+	Status:       "status",
+	CreatedAt:    "created_at",
+}
+
+// Generated where
+
+var FundingTransitionWhere = struct {
+	ID           whereHelperstring
+	Exchange     whereHelperstring
+	TransferID   whereHelperstring
+	TransferType whereHelperstring
+	Currency     whereHelperstring
+	Amount       whereHelperfloat64
+	Chain        whereHelperstring
+	TXID         whereHelperstring
+	FromState    whereHelperstring
+	ToState      whereHelperstring
+	Status       whereHelperstring
+	CreatedAt    whereHelperstring
+}{
+	ID:           whereHelperstring{field: "\"funding_transition\".\"id\""},
+	Exchange:     whereHelperstring{field: "\"funding_transition\".\"exchange\""},
+	TransferID:   whereHelperstring{field: "\"funding_transition\".\"transfer_id\""},
+	TransferType: whereHelperstring{field: "\"funding_transition\".\"transfer_type\""},
+	Currency:     whereHelperstring{field: "\"funding_transition\".\"currency\""},
+	Amount:       whereHelperfloat64{field: "\"funding_transition\".\"amount\""},
+	Chain:        whereHelperstring{field: "\"funding_transition\".\"chain\""},
+	TXID:         whereHelperstring{field: "\"funding_transition\".\"tx_id\""},
+	FromState:    whereHelperstring{field: "\"funding_transition\".\"from_state\""},
+	ToState:      whereHelperstring{field: "\"funding_transition\".\"to_state\""},
+	Status:       whereHelperstring{field: "\"funding_transition\".\"status\""},
+	CreatedAt:    whereHelperstring{field: "\"funding_transition\".\"created_at\""},
+}
+
+// FundingTransitionRels is where relationship names are stored.
+var FundingTransitionRels = struct {
+}{}
+
+// fundingTransitionR is where relationships are stored.
+type fundingTransitionR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*fundingTransitionR) NewStruct() *fundingTransitionR {
+	return &fundingTransitionR{}
+}
+
+// fundingTransitionL is where Load methods for each relationship are stored.
+type fundingTransitionL struct{}
+
+var (
+	fundingTransitionAllColumns            = []string{"id", "exchange", "transfer_id", "transfer_type", "currency", "amount", "chain", "tx_id", "from_state", "to_state", "status", "created_at"}
+	fundingTransitionColumnsWithoutDefault = []string{"id", "exchange", "transfer_id", "currency", "to_state"}
+	fundingTransitionColumnsWithDefault    = []string{"transfer_type", "amount", "chain", "tx_id", "from_state", "status", "created_at"}
+	fundingTransitionPrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// FundingTransitionSlice is an alias for a slice of pointers to FundingTransition.
+	// This should generally be used opposed to []FundingTransition.
+	FundingTransitionSlice []*FundingTransition
+	// FundingTransitionHook is the signature for custom FundingTransition hook methods
+	FundingTransitionHook func(context.Context, boil.ContextExecutor, *FundingTransition) error
+
+	fundingTransitionQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	fundingTransitionType                 = reflect.TypeOf(&FundingTransition{})
+	fundingTransitionMapping              = queries.MakeStructMapping(fundingTransitionType)
+	fundingTransitionPrimaryKeyMapping, _ = queries.BindMapping(fundingTransitionType, fundingTransitionMapping, fundingTransitionPrimaryKeyColumns)
+	fundingTransitionInsertCacheMut       sync.RWMutex
+	fundingTransitionInsertCache          = make(map[string]insertCache)
+	fundingTransitionUpdateCacheMut       sync.RWMutex
+	fundingTransitionUpdateCache          = make(map[string]updateCache)
+	fundingTransitionUpsertCacheMut       sync.RWMutex
+	fundingTransitionUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var fundingTransitionBeforeInsertHooks []FundingTransitionHook
+var fundingTransitionBeforeUpdateHooks []FundingTransitionHook
+var fundingTransitionBeforeDeleteHooks []FundingTransitionHook
+var fundingTransitionBeforeUpsertHooks []FundingTransitionHook
+
+var fundingTransitionAfterInsertHooks []FundingTransitionHook
+var fundingTransitionAfterSelectHooks []FundingTransitionHook
+var fundingTransitionAfterUpdateHooks []FundingTransitionHook
+var fundingTransitionAfterDeleteHooks []FundingTransitionHook
+var fundingTransitionAfterUpsertHooks []FundingTransitionHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *FundingTransition) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range fundingTransitionBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *FundingTransition) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range fundingTransitionBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *FundingTransition) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range fundingTransitionBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *FundingTransition) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range fundingTransitionBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *FundingTransition) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range fundingTransitionAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *FundingTransition) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range fundingTransitionAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *FundingTransition) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range fundingTransitionAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *FundingTransition) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range fundingTransitionAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *FundingTransition) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range fundingTransitionAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddFundingTransitionHook registers your hook function for all future operations.
+func AddFundingTransitionHook(hookPoint boil.HookPoint, fundingTransitionHook FundingTransitionHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		fundingTransitionBeforeInsertHooks = append(fundingTransitionBeforeInsertHooks, fundingTransitionHook)
+	case boil.BeforeUpdateHook:
+		fundingTransitionBeforeUpdateHooks = append(fundingTransitionBeforeUpdateHooks, fundingTransitionHook)
+	case boil.BeforeDeleteHook:
+		fundingTransitionBeforeDeleteHooks = append(fundingTransitionBeforeDeleteHooks, fundingTransitionHook)
+	case boil.BeforeUpsertHook:
+		fundingTransitionBeforeUpsertHooks = append(fundingTransitionBeforeUpsertHooks, fundingTransitionHook)
+	case boil.AfterInsertHook:
+		fundingTransitionAfterInsertHooks = append(fundingTransitionAfterInsertHooks, fundingTransitionHook)
+	case boil.AfterSelectHook:
+		fundingTransitionAfterSelectHooks = append(fundingTransitionAfterSelectHooks, fundingTransitionHook)
+	case boil.AfterUpdateHook:
+		fundingTransitionAfterUpdateHooks = append(fundingTransitionAfterUpdateHooks, fundingTransitionHook)
+	case boil.AfterDeleteHook:
+		fundingTransitionAfterDeleteHooks = append(fundingTransitionAfterDeleteHooks, fundingTransitionHook)
+	case boil.AfterUpsertHook:
+		fundingTransitionAfterUpsertHooks = append(fundingTransitionAfterUpsertHooks, fundingTransitionHook)
+	}
+}
+
+// One returns a single fundingTransition record from the query.
+func (q fundingTransitionQuery) One(ctx context.Context, exec boil.ContextExecutor) (*FundingTransition, error) {
+	o := &FundingTransition{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "sqlite3: failed to execute a one query for funding_transition")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all FundingTransition records from the query.
+func (q fundingTransitionQuery) All(ctx context.Context, exec boil.ContextExecutor) (FundingTransitionSlice, error) {
+	var o []*FundingTransition
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "sqlite3: failed to assign all query results to FundingTransition slice")
+	}
+
+	if len(fundingTransitionAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all FundingTransition records in the query.
+func (q fundingTransitionQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to count funding_transition rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q fundingTransitionQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "sqlite3: failed to check if funding_transition exists")
+	}
+
+	return count > 0, nil
+}
+
+// FundingTransitions retrieves all the records using an executor.
+func FundingTransitions(mods ...qm.QueryMod) fundingTransitionQuery {
+	mods = append(mods, qm.From("\"funding_transition\""))
+	return fundingTransitionQuery{NewQuery(mods...)}
+}
+
+// FindFundingTransition retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindFundingTransition(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*FundingTransition, error) {
+	fundingTransitionObj := &FundingTransition{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"funding_transition\" where \"id\"=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, fundingTransitionObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "sqlite3: unable to select from funding_transition")
+	}
+
+	return fundingTransitionObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *FundingTransition) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("sqlite3: no funding_transition provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(fundingTransitionColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	fundingTransitionInsertCacheMut.RLock()
+	cache, cached := fundingTransitionInsertCache[key]
+	fundingTransitionInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			fundingTransitionAllColumns,
+			fundingTransitionColumnsWithDefault,
+			fundingTransitionColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(fundingTransitionType, fundingTransitionMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(fundingTransitionType, fundingTransitionMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"funding_transition\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"funding_transition\" () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT \"%s\" FROM \"funding_transition\" WHERE %s", strings.Join(returnColumns, "\",\""), strmangle.WhereClause("\"", "\"", 0, fundingTransitionPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "sqlite3: unable to insert into funding_transition")
+	}
+
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.retQuery)
+		fmt.Fprintln(boil.DebugWriter, identifierCols...)
+	}
+
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "sqlite3: unable to populate default values for funding_transition")
+	}
+
+CacheNoHooks:
+	if !cached {
+		fundingTransitionInsertCacheMut.Lock()
+		fundingTransitionInsertCache[key] = cache
+		fundingTransitionInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the FundingTransition.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *FundingTransition) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	fundingTransitionUpdateCacheMut.RLock()
+	cache, cached := fundingTransitionUpdateCache[key]
+	fundingTransitionUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			fundingTransitionAllColumns,
+			fundingTransitionPrimaryKeyColumns,
+		)
+
+		if len(wl) == 0 {
+			return 0, errors.New("sqlite3: unable to update funding_transition, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"funding_transition\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, fundingTransitionPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(fundingTransitionType, fundingTransitionMapping, append(wl, fundingTransitionPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to update funding_transition row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by update for funding_transition")
+	}
+
+	if !cached {
+		fundingTransitionUpdateCacheMut.Lock()
+		fundingTransitionUpdateCache[key] = cache
+		fundingTransitionUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q fundingTransitionQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to update all for funding_transition")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to retrieve rows affected for funding_transition")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o FundingTransitionSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("sqlite3: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), fundingTransitionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"funding_transition\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, fundingTransitionPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to update all in fundingTransition slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to retrieve rows affected all in update all fundingTransition")
+	}
+	return rowsAff, nil
+}
+
+// Delete deletes a single FundingTransition record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *FundingTransition) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("sqlite3: no FundingTransition provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), fundingTransitionPrimaryKeyMapping)
+	sql := "DELETE FROM \"funding_transition\" WHERE \"id\"=?"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to delete from funding_transition")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by delete for funding_transition")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q fundingTransitionQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("sqlite3: no fundingTransitionQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to delete all from funding_transition")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by deleteall for funding_transition")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o FundingTransitionSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(fundingTransitionBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), fundingTransitionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"funding_transition\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, fundingTransitionPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to delete all from fundingTransition slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by deleteall for funding_transition")
+	}
+
+	if len(fundingTransitionAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *FundingTransition) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindFundingTransition(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *FundingTransitionSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := FundingTransitionSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), fundingTransitionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"funding_transition\".* FROM \"funding_transition\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, fundingTransitionPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "sqlite3: unable to reload all in FundingTransitionSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// FundingTransitionExists checks if the FundingTransition row exists.
+func FundingTransitionExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"funding_transition\" where \"id\"=? limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "sqlite3: unable to check if funding_transition exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,785 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package sqlite3
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/thrasher-corp/sqlboiler/queries/qmhelper"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+)
+
+// FXRate is an object representing the database table.
+type FXRate struct {
+	ID        string  `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Base      string  `boil:"base" json:"base" toml:"base" yaml:"base"`
+	Quote     string  `boil:"quote" json:"quote" toml:"quote" yaml:"quote"`
+	Rate      float64 `boil:"rate" json:"rate" toml:"rate" yaml:"rate"`
+	Source    string  `boil:"source" json:"source" toml:"source" yaml:"source"`
+	Timestamp string  `boil:"timestamp" json:"timestamp" toml:"timestamp" yaml:"timestamp"`
+
+	R *fxRateR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L fxRateL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var FXRateColumns = struct {
+	ID        string
+	Base      string
+	Quote     string
+	Rate      string
+	Source    string
+	Timestamp string
+}{
+	ID:        "id",
+	Base:      "base",
+	Quote:     "quote",
+	Rate:      "rate",
+	Source:    "source",
+	Timestamp: "timestamp",
+}
+
+// Generated where
+
+var FXRateWhere = struct {
+	ID        whereHelperstring
+	Base      whereHelperstring
+	Quote     whereHelperstring
+	Rate      whereHelperfloat64
+	Source    whereHelperstring
+	Timestamp whereHelperstring
+}{
+	ID:        whereHelperstring{field: "\"fx_rate\".\"id\""},
+	Base:      whereHelperstring{field: "\"fx_rate\".\"base\""},
+	Quote:     whereHelperstring{field: "\"fx_rate\".\"quote\""},
+	Rate:      whereHelperfloat64{field: "\"fx_rate\".\"rate\""},
+	Source:    whereHelperstring{field: "\"fx_rate\".\"source\""},
+	Timestamp: whereHelperstring{field: "\"fx_rate\".\"timestamp\""},
+}
+
+// FXRateRels is where relationship names are stored.
+var FXRateRels = struct {
+}{}
+
+// fxRateR is where relationships are stored.
+type fxRateR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*fxRateR) NewStruct() *fxRateR {
+	return &fxRateR{}
+}
+
+// fxRateL is where Load methods for each relationship are stored.
+type fxRateL struct{}
+
+var (
+	fxRateAllColumns            = []string{"id", "base", "quote", "rate", "source", "timestamp"}
+	fxRateColumnsWithoutDefault = []string{"id", "base", "quote", "rate", "source", "timestamp"}
+	fxRateColumnsWithDefault    = []string{}
+	fxRatePrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// FXRateSlice is an alias for a slice of pointers to FXRate.
+	// This should generally be used opposed to []FXRate.
+	FXRateSlice []*FXRate
+	// FXRateHook is the signature for custom FXRate hook methods
+	FXRateHook func(context.Context, boil.ContextExecutor, *FXRate) error
+
+	fxRateQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	fxRateType                 = reflect.TypeOf(&FXRate{})
+	fxRateMapping              = queries.MakeStructMapping(fxRateType)
+	fxRatePrimaryKeyMapping, _ = queries.BindMapping(fxRateType, fxRateMapping, fxRatePrimaryKeyColumns)
+	fxRateInsertCacheMut       sync.RWMutex
+	fxRateInsertCache          = make(map[string]insertCache)
+	fxRateUpdateCacheMut       sync.RWMutex
+	fxRateUpdateCache          = make(map[string]updateCache)
+	fxRateUpsertCacheMut       sync.RWMutex
+	fxRateUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var fxRateBeforeInsertHooks []FXRateHook
+var fxRateBeforeUpdateHooks []FXRateHook
+var fxRateBeforeDeleteHooks []FXRateHook
+var fxRateBeforeUpsertHooks []FXRateHook
+
+var fxRateAfterInsertHooks []FXRateHook
+var fxRateAfterSelectHooks []FXRateHook
+var fxRateAfterUpdateHooks []FXRateHook
+var fxRateAfterDeleteHooks []FXRateHook
+var fxRateAfterUpsertHooks []FXRateHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *FXRate) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range fxRateBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *FXRate) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range fxRateBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *FXRate) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range fxRateBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *FXRate) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range fxRateBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *FXRate) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range fxRateAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *FXRate) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range fxRateAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *FXRate) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range fxRateAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *FXRate) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range fxRateAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *FXRate) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range fxRateAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddFXRateHook registers your hook function for all future operations.
+func AddFXRateHook(hookPoint boil.HookPoint, fxRateHook FXRateHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		fxRateBeforeInsertHooks = append(fxRateBeforeInsertHooks, fxRateHook)
+	case boil.BeforeUpdateHook:
+		fxRateBeforeUpdateHooks = append(fxRateBeforeUpdateHooks, fxRateHook)
+	case boil.BeforeDeleteHook:
+		fxRateBeforeDeleteHooks = append(fxRateBeforeDeleteHooks, fxRateHook)
+	case boil.BeforeUpsertHook:
+		fxRateBeforeUpsertHooks = append(fxRateBeforeUpsertHooks, fxRateHook)
+	case boil.AfterInsertHook:
+		fxRateAfterInsertHooks = append(fxRateAfterInsertHooks, fxRateHook)
+	case boil.AfterSelectHook:
+		fxRateAfterSelectHooks = append(fxRateAfterSelectHooks, fxRateHook)
+	case boil.AfterUpdateHook:
+		fxRateAfterUpdateHooks = append(fxRateAfterUpdateHooks, fxRateHook)
+	case boil.AfterDeleteHook:
+		fxRateAfterDeleteHooks = append(fxRateAfterDeleteHooks, fxRateHook)
+	case boil.AfterUpsertHook:
+		fxRateAfterUpsertHooks = append(fxRateAfterUpsertHooks, fxRateHook)
+	}
+}
+
+// One returns a single fxRate record from the query.
+func (q fxRateQuery) One(ctx context.Context, exec boil.ContextExecutor) (*FXRate, error) {
+	o := &FXRate{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "sqlite3: failed to execute a one query for fx_rate")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all FXRate records from the query.
+func (q fxRateQuery) All(ctx context.Context, exec boil.ContextExecutor) (FXRateSlice, error) {
+	var o []*FXRate
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "sqlite3: failed to assign all query results to FXRate slice")
+	}
+
+	if len(fxRateAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all FXRate records in the query.
+func (q fxRateQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to count fx_rate rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q fxRateQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "sqlite3: failed to check if fx_rate exists")
+	}
+
+	return count > 0, nil
+}
+
+// FXRates retrieves all the records using an executor.
+func FXRates(mods ...qm.QueryMod) fxRateQuery {
+	mods = append(mods, qm.From("\"fx_rate\""))
+	return fxRateQuery{NewQuery(mods...)}
+}
+
+// FindFXRate retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindFXRate(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*FXRate, error) {
+	fxRateObj := &FXRate{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"fx_rate\" where \"id\"=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, fxRateObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "sqlite3: unable to select from fx_rate")
+	}
+
+	return fxRateObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *FXRate) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("sqlite3: no fx_rate provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(fxRateColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	fxRateInsertCacheMut.RLock()
+	cache, cached := fxRateInsertCache[key]
+	fxRateInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			fxRateAllColumns,
+			fxRateColumnsWithDefault,
+			fxRateColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(fxRateType, fxRateMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(fxRateType, fxRateMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"fx_rate\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"fx_rate\" () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT \"%s\" FROM \"fx_rate\" WHERE %s", strings.Join(returnColumns, "\",\""), strmangle.WhereClause("\"", "\"", 0, fxRatePrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "sqlite3: unable to insert into fx_rate")
+	}
+
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.retQuery)
+		fmt.Fprintln(boil.DebugWriter, identifierCols...)
+	}
+
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "sqlite3: unable to populate default values for fx_rate")
+	}
+
+CacheNoHooks:
+	if !cached {
+		fxRateInsertCacheMut.Lock()
+		fxRateInsertCache[key] = cache
+		fxRateInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the FXRate.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *FXRate) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	fxRateUpdateCacheMut.RLock()
+	cache, cached := fxRateUpdateCache[key]
+	fxRateUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			fxRateAllColumns,
+			fxRatePrimaryKeyColumns,
+		)
+
+		if len(wl) == 0 {
+			return 0, errors.New("sqlite3: unable to update fx_rate, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"fx_rate\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, fxRatePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(fxRateType, fxRateMapping, append(wl, fxRatePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to update fx_rate row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by update for fx_rate")
+	}
+
+	if !cached {
+		fxRateUpdateCacheMut.Lock()
+		fxRateUpdateCache[key] = cache
+		fxRateUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q fxRateQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to update all for fx_rate")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to retrieve rows affected for fx_rate")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o FXRateSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("sqlite3: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), fxRatePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"fx_rate\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, fxRatePrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to update all in fxRate slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to retrieve rows affected all in update all fxRate")
+	}
+	return rowsAff, nil
+}
+
+// Delete deletes a single FXRate record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *FXRate) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("sqlite3: no FXRate provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), fxRatePrimaryKeyMapping)
+	sql := "DELETE FROM \"fx_rate\" WHERE \"id\"=?"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to delete from fx_rate")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by delete for fx_rate")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q fxRateQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("sqlite3: no fxRateQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to delete all from fx_rate")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by deleteall for fx_rate")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o FXRateSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(fxRateBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), fxRatePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"fx_rate\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, fxRatePrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to delete all from fxRate slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by deleteall for fx_rate")
+	}
+
+	if len(fxRateAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *FXRate) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindFXRate(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *FXRateSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := FXRateSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), fxRatePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"fx_rate\".* FROM \"fx_rate\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, fxRatePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "sqlite3: unable to reload all in FXRateSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// FXRateExists checks if the FXRate row exists.
+func FXRateExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"fx_rate\" where \"id\"=? limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "sqlite3: unable to check if fx_rate exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,790 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package sqlite3
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/thrasher-corp/sqlboiler/queries/qmhelper"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+)
+
+// WithdrawalAddress is an object representing the database table.
+type WithdrawalAddress struct {
+	ID         string `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Currency   string `boil:"currency" json:"currency" toml:"currency" yaml:"currency"`
+	Chain      string `boil:"chain" json:"chain" toml:"chain" yaml:"chain"`
+	Address    string `boil:"address" json:"address" toml:"address" yaml:"address"`
+	AddressTag string `boil:"address_tag" json:"address_tag" toml:"address_tag" yaml:"address_tag"`
+	Label      string `boil:"label" json:"label" toml:"label" yaml:"label"`
+	CreatedAt  string `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *withdrawalAddressR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L withdrawalAddressL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var WithdrawalAddressColumns = struct {
+	ID         string
+	Currency   string
+	Chain      string
+	Address    string
+	AddressTag string
+	Label      string
+	CreatedAt  string
+}{
+	ID:         "id",
+	Currency:   "currency",
+	Chain:      "chain",
+	Address:    "address",
+	AddressTag: "address_tag",
+	Label:      "label",
+	CreatedAt:  "created_at",
+}
+
+// Generated where
+
+var WithdrawalAddressWhere = struct {
+	ID         whereHelperstring
+	Currency   whereHelperstring
+	Chain      whereHelperstring
+	Address    whereHelperstring
+	AddressTag whereHelperstring
+	Label      whereHelperstring
+	CreatedAt  whereHelperstring
+}{
+	ID:         whereHelperstring{field: "\"withdrawal_address\".\"id\""},
+	Currency:   whereHelperstring{field: "\"withdrawal_address\".\"currency\""},
+	Chain:      whereHelperstring{field: "\"withdrawal_address\".\"chain\""},
+	Address:    whereHelperstring{field: "\"withdrawal_address\".\"address\""},
+	AddressTag: whereHelperstring{field: "\"withdrawal_address\".\"address_tag\""},
+	Label:      whereHelperstring{field: "\"withdrawal_address\".\"label\""},
+	CreatedAt:  whereHelperstring{field: "\"withdrawal_address\".\"created_at\""},
+}
+
+// WithdrawalAddressRels is where relationship names are stored.
+var WithdrawalAddressRels = struct {
+}{}
+
+// withdrawalAddressR is where relationships are stored.
+type withdrawalAddressR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*withdrawalAddressR) NewStruct() *withdrawalAddressR {
+	return &withdrawalAddressR{}
+}
+
+// withdrawalAddressL is where Load methods for each relationship are stored.
+type withdrawalAddressL struct{}
+
+var (
+	withdrawalAddressAllColumns            = []string{"id", "currency", "chain", "address", "address_tag", "label", "created_at"}
+	withdrawalAddressColumnsWithoutDefault = []string{"id", "currency", "address"}
+	withdrawalAddressColumnsWithDefault    = []string{"chain", "address_tag", "label", "created_at"}
+	withdrawalAddressPrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// WithdrawalAddressSlice is an alias for a slice of pointers to WithdrawalAddress.
+	// This should generally be used opposed to []WithdrawalAddress.
+	WithdrawalAddressSlice []*WithdrawalAddress
+	// WithdrawalAddressHook is the signature for custom WithdrawalAddress hook methods
+	WithdrawalAddressHook func(context.Context, boil.ContextExecutor, *WithdrawalAddress) error
+
+	withdrawalAddressQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	withdrawalAddressType                 = reflect.TypeOf(&WithdrawalAddress{})
+	withdrawalAddressMapping              = queries.MakeStructMapping(withdrawalAddressType)
+	withdrawalAddressPrimaryKeyMapping, _ = queries.BindMapping(withdrawalAddressType, withdrawalAddressMapping, withdrawalAddressPrimaryKeyColumns)
+	withdrawalAddressInsertCacheMut       sync.RWMutex
+	withdrawalAddressInsertCache          = make(map[string]insertCache)
+	withdrawalAddressUpdateCacheMut       sync.RWMutex
+	withdrawalAddressUpdateCache          = make(map[string]updateCache)
+	withdrawalAddressUpsertCacheMut       sync.RWMutex
+	withdrawalAddressUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var withdrawalAddressBeforeInsertHooks []WithdrawalAddressHook
+var withdrawalAddressBeforeUpdateHooks []WithdrawalAddressHook
+var withdrawalAddressBeforeDeleteHooks []WithdrawalAddressHook
+var withdrawalAddressBeforeUpsertHooks []WithdrawalAddressHook
+
+var withdrawalAddressAfterInsertHooks []WithdrawalAddressHook
+var withdrawalAddressAfterSelectHooks []WithdrawalAddressHook
+var withdrawalAddressAfterUpdateHooks []WithdrawalAddressHook
+var withdrawalAddressAfterDeleteHooks []WithdrawalAddressHook
+var withdrawalAddressAfterUpsertHooks []WithdrawalAddressHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *WithdrawalAddress) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range withdrawalAddressBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *WithdrawalAddress) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range withdrawalAddressBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *WithdrawalAddress) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range withdrawalAddressBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *WithdrawalAddress) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range withdrawalAddressBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *WithdrawalAddress) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range withdrawalAddressAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *WithdrawalAddress) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range withdrawalAddressAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *WithdrawalAddress) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range withdrawalAddressAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *WithdrawalAddress) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range withdrawalAddressAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *WithdrawalAddress) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range withdrawalAddressAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddWithdrawalAddressHook registers your hook function for all future operations.
+func AddWithdrawalAddressHook(hookPoint boil.HookPoint, withdrawalAddressHook WithdrawalAddressHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		withdrawalAddressBeforeInsertHooks = append(withdrawalAddressBeforeInsertHooks, withdrawalAddressHook)
+	case boil.BeforeUpdateHook:
+		withdrawalAddressBeforeUpdateHooks = append(withdrawalAddressBeforeUpdateHooks, withdrawalAddressHook)
+	case boil.BeforeDeleteHook:
+		withdrawalAddressBeforeDeleteHooks = append(withdrawalAddressBeforeDeleteHooks, withdrawalAddressHook)
+	case boil.BeforeUpsertHook:
+		withdrawalAddressBeforeUpsertHooks = append(withdrawalAddressBeforeUpsertHooks, withdrawalAddressHook)
+	case boil.AfterInsertHook:
+		withdrawalAddressAfterInsertHooks = append(withdrawalAddressAfterInsertHooks, withdrawalAddressHook)
+	case boil.AfterSelectHook:
+		withdrawalAddressAfterSelectHooks = append(withdrawalAddressAfterSelectHooks, withdrawalAddressHook)
+	case boil.AfterUpdateHook:
+		withdrawalAddressAfterUpdateHooks = append(withdrawalAddressAfterUpdateHooks, withdrawalAddressHook)
+	case boil.AfterDeleteHook:
+		withdrawalAddressAfterDeleteHooks = append(withdrawalAddressAfterDeleteHooks, withdrawalAddressHook)
+	case boil.AfterUpsertHook:
+		withdrawalAddressAfterUpsertHooks = append(withdrawalAddressAfterUpsertHooks, withdrawalAddressHook)
+	}
+}
+
+// One returns a single withdrawalAddress record from the query.
+func (q withdrawalAddressQuery) One(ctx context.Context, exec boil.ContextExecutor) (*WithdrawalAddress, error) {
+	o := &WithdrawalAddress{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "sqlite3: failed to execute a one query for withdrawal_address")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all WithdrawalAddress records from the query.
+func (q withdrawalAddressQuery) All(ctx context.Context, exec boil.ContextExecutor) (WithdrawalAddressSlice, error) {
+	var o []*WithdrawalAddress
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "sqlite3: failed to assign all query results to WithdrawalAddress slice")
+	}
+
+	if len(withdrawalAddressAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all WithdrawalAddress records in the query.
+func (q withdrawalAddressQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to count withdrawal_address rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q withdrawalAddressQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "sqlite3: failed to check if withdrawal_address exists")
+	}
+
+	return count > 0, nil
+}
+
+// WithdrawalAddresses retrieves all the records using an executor.
+func WithdrawalAddresses(mods ...qm.QueryMod) withdrawalAddressQuery {
+	mods = append(mods, qm.From("\"withdrawal_address\""))
+	return withdrawalAddressQuery{NewQuery(mods...)}
+}
+
+// FindWithdrawalAddress retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindWithdrawalAddress(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*WithdrawalAddress, error) {
+	withdrawalAddressObj := &WithdrawalAddress{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"withdrawal_address\" where \"id\"=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, withdrawalAddressObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "sqlite3: unable to select from withdrawal_address")
+	}
+
+	return withdrawalAddressObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *WithdrawalAddress) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("sqlite3: no withdrawal_address provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(withdrawalAddressColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	withdrawalAddressInsertCacheMut.RLock()
+	cache, cached := withdrawalAddressInsertCache[key]
+	withdrawalAddressInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			withdrawalAddressAllColumns,
+			withdrawalAddressColumnsWithDefault,
+			withdrawalAddressColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(withdrawalAddressType, withdrawalAddressMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(withdrawalAddressType, withdrawalAddressMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"withdrawal_address\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"withdrawal_address\" () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT \"%s\" FROM \"withdrawal_address\" WHERE %s", strings.Join(returnColumns, "\",\""), strmangle.WhereClause("\"", "\"", 0, withdrawalAddressPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "sqlite3: unable to insert into withdrawal_address")
+	}
+
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.retQuery)
+		fmt.Fprintln(boil.DebugWriter, identifierCols...)
+	}
+
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "sqlite3: unable to populate default values for withdrawal_address")
+	}
+
+CacheNoHooks:
+	if !cached {
+		withdrawalAddressInsertCacheMut.Lock()
+		withdrawalAddressInsertCache[key] = cache
+		withdrawalAddressInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the WithdrawalAddress.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *WithdrawalAddress) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	withdrawalAddressUpdateCacheMut.RLock()
+	cache, cached := withdrawalAddressUpdateCache[key]
+	withdrawalAddressUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			withdrawalAddressAllColumns,
+			withdrawalAddressPrimaryKeyColumns,
+		)
+
+		if len(wl) == 0 {
+			return 0, errors.New("sqlite3: unable to update withdrawal_address, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"withdrawal_address\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, withdrawalAddressPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(withdrawalAddressType, withdrawalAddressMapping, append(wl, withdrawalAddressPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to update withdrawal_address row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by update for withdrawal_address")
+	}
+
+	if !cached {
+		withdrawalAddressUpdateCacheMut.Lock()
+		withdrawalAddressUpdateCache[key] = cache
+		withdrawalAddressUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q withdrawalAddressQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to update all for withdrawal_address")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to retrieve rows affected for withdrawal_address")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o WithdrawalAddressSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("sqlite3: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), withdrawalAddressPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"withdrawal_address\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, withdrawalAddressPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to update all in withdrawalAddress slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to retrieve rows affected all in update all withdrawalAddress")
+	}
+	return rowsAff, nil
+}
+
+// Delete deletes a single WithdrawalAddress record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *WithdrawalAddress) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("sqlite3: no WithdrawalAddress provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), withdrawalAddressPrimaryKeyMapping)
+	sql := "DELETE FROM \"withdrawal_address\" WHERE \"id\"=?"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to delete from withdrawal_address")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by delete for withdrawal_address")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q withdrawalAddressQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("sqlite3: no withdrawalAddressQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to delete all from withdrawal_address")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by deleteall for withdrawal_address")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o WithdrawalAddressSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(withdrawalAddressBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), withdrawalAddressPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"withdrawal_address\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, withdrawalAddressPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to delete all from withdrawalAddress slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by deleteall for withdrawal_address")
+	}
+
+	if len(withdrawalAddressAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *WithdrawalAddress) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindWithdrawalAddress(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *WithdrawalAddressSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := WithdrawalAddressSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), withdrawalAddressPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"withdrawal_address\".* FROM \"withdrawal_address\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, withdrawalAddressPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "sqlite3: unable to reload all in WithdrawalAddressSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// WithdrawalAddressExists checks if the WithdrawalAddress row exists.
+func WithdrawalAddressExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"withdrawal_address\" where \"id\"=? limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "sqlite3: unable to check if withdrawal_address exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,684 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package sqlite3
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/randomize"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testWithdrawalAddresses(t *testing.T) {
+	t.Parallel()
+
+	query := WithdrawalAddresses()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testWithdrawalAddressesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WithdrawalAddress{}
+	if err = randomize.Struct(seed, o, withdrawalAddressDBTypes, true, withdrawalAddressColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WithdrawalAddress struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := WithdrawalAddresses().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testWithdrawalAddressesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WithdrawalAddress{}
+	if err = randomize.Struct(seed, o, withdrawalAddressDBTypes, true, withdrawalAddressColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WithdrawalAddress struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := WithdrawalAddresses().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := WithdrawalAddresses().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testWithdrawalAddressesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WithdrawalAddress{}
+	if err = randomize.Struct(seed, o, withdrawalAddressDBTypes, true, withdrawalAddressColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WithdrawalAddress struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := WithdrawalAddressSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := WithdrawalAddresses().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testWithdrawalAddressesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WithdrawalAddress{}
+	if err = randomize.Struct(seed, o, withdrawalAddressDBTypes, true, withdrawalAddressColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WithdrawalAddress struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := WithdrawalAddressExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if WithdrawalAddress exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected WithdrawalAddressExists to return true, but got false.")
+	}
+}
+
+func testWithdrawalAddressesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WithdrawalAddress{}
+	if err = randomize.Struct(seed, o, withdrawalAddressDBTypes, true, withdrawalAddressColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WithdrawalAddress struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	withdrawalAddressFound, err := FindWithdrawalAddress(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if withdrawalAddressFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testWithdrawalAddressesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WithdrawalAddress{}
+	if err = randomize.Struct(seed, o, withdrawalAddressDBTypes, true, withdrawalAddressColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WithdrawalAddress struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = WithdrawalAddresses().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testWithdrawalAddressesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WithdrawalAddress{}
+	if err = randomize.Struct(seed, o, withdrawalAddressDBTypes, true, withdrawalAddressColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WithdrawalAddress struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := WithdrawalAddresses().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testWithdrawalAddressesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	withdrawalAddressOne := &WithdrawalAddress{}
+	withdrawalAddressTwo := &WithdrawalAddress{}
+	if err = randomize.Struct(seed, withdrawalAddressOne, withdrawalAddressDBTypes, false, withdrawalAddressColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WithdrawalAddress struct: %s", err)
+	}
+	if err = randomize.Struct(seed, withdrawalAddressTwo, withdrawalAddressDBTypes, false, withdrawalAddressColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WithdrawalAddress struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = withdrawalAddressOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = withdrawalAddressTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := WithdrawalAddresses().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testWithdrawalAddressesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	withdrawalAddressOne := &WithdrawalAddress{}
+	withdrawalAddressTwo := &WithdrawalAddress{}
+	if err = randomize.Struct(seed, withdrawalAddressOne, withdrawalAddressDBTypes, false, withdrawalAddressColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WithdrawalAddress struct: %s", err)
+	}
+	if err = randomize.Struct(seed, withdrawalAddressTwo, withdrawalAddressDBTypes, false, withdrawalAddressColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WithdrawalAddress struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = withdrawalAddressOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = withdrawalAddressTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := WithdrawalAddresses().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func withdrawalAddressBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *WithdrawalAddress) error {
+	*o = WithdrawalAddress{}
+	return nil
+}
+
+func withdrawalAddressAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *WithdrawalAddress) error {
+	*o = WithdrawalAddress{}
+	return nil
+}
+
+func withdrawalAddressAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *WithdrawalAddress) error {
+	*o = WithdrawalAddress{}
+	return nil
+}
+
+func withdrawalAddressBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *WithdrawalAddress) error {
+	*o = WithdrawalAddress{}
+	return nil
+}
+
+func withdrawalAddressAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *WithdrawalAddress) error {
+	*o = WithdrawalAddress{}
+	return nil
+}
+
+func withdrawalAddressBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *WithdrawalAddress) error {
+	*o = WithdrawalAddress{}
+	return nil
+}
+
+func withdrawalAddressAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *WithdrawalAddress) error {
+	*o = WithdrawalAddress{}
+	return nil
+}
+
+func withdrawalAddressBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *WithdrawalAddress) error {
+	*o = WithdrawalAddress{}
+	return nil
+}
+
+func withdrawalAddressAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *WithdrawalAddress) error {
+	*o = WithdrawalAddress{}
+	return nil
+}
+
+func testWithdrawalAddressesHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &WithdrawalAddress{}
+	o := &WithdrawalAddress{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, withdrawalAddressDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize WithdrawalAddress object: %s", err)
+	}
+
+	AddWithdrawalAddressHook(boil.BeforeInsertHook, withdrawalAddressBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	withdrawalAddressBeforeInsertHooks = []WithdrawalAddressHook{}
+
+	AddWithdrawalAddressHook(boil.AfterInsertHook, withdrawalAddressAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	withdrawalAddressAfterInsertHooks = []WithdrawalAddressHook{}
+
+	AddWithdrawalAddressHook(boil.AfterSelectHook, withdrawalAddressAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	withdrawalAddressAfterSelectHooks = []WithdrawalAddressHook{}
+
+	AddWithdrawalAddressHook(boil.BeforeUpdateHook, withdrawalAddressBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	withdrawalAddressBeforeUpdateHooks = []WithdrawalAddressHook{}
+
+	AddWithdrawalAddressHook(boil.AfterUpdateHook, withdrawalAddressAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	withdrawalAddressAfterUpdateHooks = []WithdrawalAddressHook{}
+
+	AddWithdrawalAddressHook(boil.BeforeDeleteHook, withdrawalAddressBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	withdrawalAddressBeforeDeleteHooks = []WithdrawalAddressHook{}
+
+	AddWithdrawalAddressHook(boil.AfterDeleteHook, withdrawalAddressAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	withdrawalAddressAfterDeleteHooks = []WithdrawalAddressHook{}
+
+	AddWithdrawalAddressHook(boil.BeforeUpsertHook, withdrawalAddressBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	withdrawalAddressBeforeUpsertHooks = []WithdrawalAddressHook{}
+
+	AddWithdrawalAddressHook(boil.AfterUpsertHook, withdrawalAddressAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	withdrawalAddressAfterUpsertHooks = []WithdrawalAddressHook{}
+}
+
+func testWithdrawalAddressesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WithdrawalAddress{}
+	if err = randomize.Struct(seed, o, withdrawalAddressDBTypes, true, withdrawalAddressColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WithdrawalAddress struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := WithdrawalAddresses().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testWithdrawalAddressesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WithdrawalAddress{}
+	if err = randomize.Struct(seed, o, withdrawalAddressDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize WithdrawalAddress struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(withdrawalAddressColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := WithdrawalAddresses().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testWithdrawalAddressesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WithdrawalAddress{}
+	if err = randomize.Struct(seed, o, withdrawalAddressDBTypes, true, withdrawalAddressColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WithdrawalAddress struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testWithdrawalAddressesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WithdrawalAddress{}
+	if err = randomize.Struct(seed, o, withdrawalAddressDBTypes, true, withdrawalAddressColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WithdrawalAddress struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := WithdrawalAddressSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testWithdrawalAddressesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WithdrawalAddress{}
+	if err = randomize.Struct(seed, o, withdrawalAddressDBTypes, true, withdrawalAddressColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WithdrawalAddress struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := WithdrawalAddresses().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	withdrawalAddressDBTypes = map[string]string{`ID`: `TEXT`, `Currency`: `TEXT`, `Chain`: `TEXT`, `Address`: `TEXT`, `AddressTag`: `TEXT`, `Label`: `TEXT`, `CreatedAt`: `TIMESTAMP`}
+	_                        = bytes.MinRead
+)
+
+func testWithdrawalAddressesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(withdrawalAddressPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(withdrawalAddressAllColumns) == len(withdrawalAddressPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WithdrawalAddress{}
+	if err = randomize.Struct(seed, o, withdrawalAddressDBTypes, true, withdrawalAddressColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WithdrawalAddress struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := WithdrawalAddresses().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, withdrawalAddressDBTypes, true, withdrawalAddressPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize WithdrawalAddress struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testWithdrawalAddressesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(withdrawalAddressAllColumns) == len(withdrawalAddressPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WithdrawalAddress{}
+	if err = randomize.Struct(seed, o, withdrawalAddressDBTypes, true, withdrawalAddressColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WithdrawalAddress struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := WithdrawalAddresses().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, withdrawalAddressDBTypes, true, withdrawalAddressPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize WithdrawalAddress struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(withdrawalAddressAllColumns, withdrawalAddressPrimaryKeyColumns) {
+		fields = withdrawalAddressAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			withdrawalAddressAllColumns,
+			withdrawalAddressPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := WithdrawalAddressSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
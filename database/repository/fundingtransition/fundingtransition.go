@@ -0,0 +1,205 @@
+package fundingtransition
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	modelPSQL "github.com/thrasher-corp/gocryptotrader/database/models/postgres"
+	modelSQLite "github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+// Insert stores a state transition of a transfer and returns it
+func Insert(ctx context.Context, d Data) (*Data, error) {
+	if !database.DB.IsConnected() {
+		return nil, database.ErrDatabaseNotConnected
+	}
+	d.Exchange = strings.ToLower(d.Exchange)
+	d.Currency = strings.ToUpper(d.Currency)
+	if err := d.validate(); err != nil {
+		return nil, err
+	}
+	if d.ID == "" {
+		id, err := uuid.NewV4()
+		if err != nil {
+			return nil, err
+		}
+		d.ID = id.String()
+	}
+	if d.CreatedAt.IsZero() {
+		d.CreatedAt = time.Now()
+	}
+	d.CreatedAt = d.CreatedAt.UTC().Truncate(time.Second)
+
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		t := modelSQLite.FundingTransition{
+			ID:           d.ID,
+			Exchange:     d.Exchange,
+			TransferID:   d.TransferID,
+			TransferType: d.TransferType,
+			Currency:     d.Currency,
+			Amount:       d.Amount,
+			Chain:        d.Chain,
+			TXID:         d.TxID,
+			FromState:    d.FromState,
+			ToState:      d.ToState,
+			Status:       d.Status,
+			CreatedAt:    d.CreatedAt.Format(time.RFC3339),
+		}
+		if err := t.Insert(ctx, database.DB.SQL, boil.Infer()); err != nil {
+			return nil, err
+		}
+		return &d, nil
+	}
+	t := modelPSQL.FundingTransition{
+		ID:           d.ID,
+		Exchange:     d.Exchange,
+		TransferID:   d.TransferID,
+		TransferType: d.TransferType,
+		Currency:     d.Currency,
+		Amount:       d.Amount,
+		Chain:        d.Chain,
+		TXID:         d.TxID,
+		FromState:    d.FromState,
+		ToState:      d.ToState,
+		Status:       d.Status,
+		CreatedAt:    d.CreatedAt,
+	}
+	if err := t.Insert(ctx, database.DB.SQL, boil.Infer()); err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+// GetByTransfer returns the transitions of a transfer, oldest first
+func GetByTransfer(ctx context.Context, exchangeName, transferID string) ([]Data, error) {
+	if transferID == "" {
+		return nil, errTransferIDNotSet
+	}
+	resp, err := GetByTransfers(ctx, exchangeName, []string{transferID})
+	if err != nil {
+		return nil, err
+	}
+	return resp[transferID], nil
+}
+
+// GetByTransfers returns the transitions of each transfer of an exchange,
+// keyed by transfer ID and oldest first. Transfers without transitions are
+// left out
+func GetByTransfers(ctx context.Context, exchangeName string, transferIDs []string) (map[string][]Data, error) {
+	if exchangeName == "" {
+		return nil, errExchangeNotSet
+	}
+	if len(transferIDs) == 0 || slices.Contains(transferIDs, "") {
+		return nil, errTransferIDNotSet
+	}
+	if !database.DB.IsConnected() {
+		return nil, database.ErrDatabaseNotConnected
+	}
+	ids := make([]any, len(transferIDs))
+	for i := range transferIDs {
+		ids[i] = transferIDs[i]
+	}
+	queries := []qm.QueryMod{
+		qm.Where("exchange = ?", strings.ToLower(exchangeName)),
+		qm.WhereIn("transfer_id in ?", ids...),
+		qm.OrderBy("created_at"),
+	}
+	resp := make(map[string][]Data)
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		transitions, err := modelSQLite.FundingTransitions(queries...).All(ctx, database.DB.SQL)
+		if err != nil {
+			return nil, err
+		}
+		for i := range transitions {
+			d, err := fromSQLite(transitions[i])
+			if err != nil {
+				return nil, err
+			}
+			resp[d.TransferID] = append(resp[d.TransferID], *d)
+		}
+	} else {
+		transitions, err := modelPSQL.FundingTransitions(queries...).All(ctx, database.DB.SQL)
+		if err != nil {
+			return nil, err
+		}
+		for i := range transitions {
+			d := fromPostgres(transitions[i])
+			resp[d.TransferID] = append(resp[d.TransferID], *d)
+		}
+	}
+	if len(resp) == 0 {
+		return nil, fmt.Errorf("%w: %s %s", ErrTransitionNotFound, exchangeName, strings.Join(transferIDs, ", "))
+	}
+	return resp, nil
+}
+
+// GetLatest returns the most recent transition of a transfer
+func GetLatest(ctx context.Context, exchangeName, transferID string) (*Data, error) {
+	resp, err := GetByTransfer(ctx, exchangeName, transferID)
+	if err != nil {
+		return nil, err
+	}
+	return &resp[len(resp)-1], nil
+}
+
+func (d *Data) validate() error {
+	if d.Exchange == "" {
+		return errExchangeNotSet
+	}
+	if d.TransferID == "" {
+		return errTransferIDNotSet
+	}
+	if d.Currency == "" {
+		return errCurrencyNotSet
+	}
+	if d.ToState == "" {
+		return errStateNotSet
+	}
+	return nil
+}
+
+func fromSQLite(t *modelSQLite.FundingTransition) (*Data, error) {
+	createdAt, err := time.Parse(time.RFC3339, t.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &Data{
+		ID:           t.ID,
+		Exchange:     t.Exchange,
+		TransferID:   t.TransferID,
+		TransferType: t.TransferType,
+		Currency:     t.Currency,
+		Amount:       t.Amount,
+		Chain:        t.Chain,
+		TxID:         t.TXID,
+		FromState:    t.FromState,
+		ToState:      t.ToState,
+		Status:       t.Status,
+		CreatedAt:    createdAt,
+	}, nil
+}
+
+func fromPostgres(t *modelPSQL.FundingTransition) *Data {
+	return &Data{
+		ID:           t.ID,
+		Exchange:     t.Exchange,
+		TransferID:   t.TransferID,
+		TransferType: t.TransferType,
+		Currency:     t.Currency,
+		Amount:       t.Amount,
+		Chain:        t.Chain,
+		TxID:         t.TXID,
+		FromState:    t.FromState,
+		ToState:      t.ToState,
+		Status:       t.Status,
+		CreatedAt:    t.CreatedAt.UTC(),
+	}
+}
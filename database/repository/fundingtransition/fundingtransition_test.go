@@ -0,0 +1,123 @@
+package fundingtransition
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/testhelpers"
+)
+
+var verbose = false
+
+func TestMain(m *testing.M) {
+	if verbose {
+		err := testhelpers.EnableVerboseTestOutput()
+		if err != nil {
+			fmt.Printf("failed to enable verbose test output: %v", err)
+			os.Exit(1)
+		}
+	}
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.TempDir, err = os.MkdirTemp("", "gct-temp")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	exitCode := m.Run()
+	if err = os.RemoveAll(testhelpers.TempDir); err != nil {
+		fmt.Printf("failed to remove temp dir: %s", err)
+	}
+	os.Exit(exitCode)
+}
+
+func TestFundingTransitions(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		name   string
+		config *database.Config
+	}{
+		{
+			name:   "postgresql",
+			config: testhelpers.PostgresTestDatabase,
+		},
+		{
+			name: "SQLite",
+			config: &database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			if !testhelpers.CheckValidConfig(&tc.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := testhelpers.ConnectToDatabase(tc.config)
+			require.NoError(t, err, "ConnectToDatabase must not error")
+
+			transitionSQLTester(t)
+			assert.NoError(t, testhelpers.CloseDatabase(dbConn), "CloseDatabase should not error")
+		})
+	}
+}
+
+func transitionSQLTester(t *testing.T) {
+	t.Helper()
+	_, err := Insert(t.Context(), Data{TransferID: "1", Currency: "BTC", ToState: "pending"})
+	assert.ErrorIs(t, err, errExchangeNotSet)
+	_, err = Insert(t.Context(), Data{Exchange: "Binance", Currency: "BTC", ToState: "pending"})
+	assert.ErrorIs(t, err, errTransferIDNotSet)
+	_, err = Insert(t.Context(), Data{Exchange: "Binance", TransferID: "1", ToState: "pending"})
+	assert.ErrorIs(t, err, errCurrencyNotSet)
+	_, err = Insert(t.Context(), Data{Exchange: "Binance", TransferID: "1", Currency: "BTC"})
+	assert.ErrorIs(t, err, errStateNotSet)
+
+	start := time.Now().Truncate(time.Second)
+	first, err := Insert(t.Context(), Data{Exchange: "Binance", TransferID: "1", TransferType: "withdrawal", Currency: "btc", Amount: 0.5, ToState: "pending", Status: "4", CreatedAt: start})
+	require.NoError(t, err, "Insert must not error")
+	assert.NotEmpty(t, first.ID, "Insert should return the stored id")
+	assert.Equal(t, "binance", first.Exchange, "Insert should store the exchange in lower case")
+	assert.Equal(t, "BTC", first.Currency, "Insert should store the currency in upper case")
+	_, err = Insert(t.Context(), Data{Exchange: "binance", TransferID: "1", TransferType: "withdrawal", Currency: "BTC", Amount: 0.5, TxID: "0xabc", FromState: "pending", ToState: "credited", Status: "6", CreatedAt: start.Add(time.Minute)})
+	require.NoError(t, err, "Insert must not error")
+	_, err = Insert(t.Context(), Data{Exchange: "binance", TransferID: "2", Currency: "ETH", ToState: "pending"})
+	require.NoError(t, err, "Insert must not error")
+
+	history, err := GetByTransfer(t.Context(), "BINANCE", "1")
+	require.NoError(t, err, "GetByTransfer must not error")
+	require.Len(t, history, 2, "GetByTransfer must return every transition of the transfer")
+	assert.Equal(t, "pending", history[0].ToState, "GetByTransfer should return the oldest transition first")
+	assert.Equal(t, "0xabc", history[1].TxID, "GetByTransfer should return the tx id")
+
+	latest, err := GetLatest(t.Context(), "binance", "1")
+	require.NoError(t, err, "GetLatest must not error")
+	assert.Equal(t, "pending", latest.FromState, "GetLatest should return the previous state")
+	assert.Equal(t, "credited", latest.ToState, "GetLatest should return the latest state")
+
+	batch, err := GetByTransfers(t.Context(), "Binance", []string{"1", "2", "3"})
+	require.NoError(t, err, "GetByTransfers must not error")
+	require.Len(t, batch, 2, "GetByTransfers must only return transfers with transitions")
+	assert.Len(t, batch["1"], 2, "GetByTransfers should return every transition of a transfer")
+	assert.Equal(t, "ETH", batch["2"][0].Currency, "GetByTransfers should return each transfer's transitions")
+	_, err = GetByTransfers(t.Context(), "binance", []string{"3"})
+	assert.ErrorIs(t, err, ErrTransitionNotFound)
+	_, err = GetByTransfers(t.Context(), "binance", nil)
+	assert.ErrorIs(t, err, errTransferIDNotSet)
+
+	_, err = GetLatest(t.Context(), "binance", "3")
+	assert.ErrorIs(t, err, ErrTransitionNotFound)
+	_, err = GetByTransfer(t.Context(), "", "1")
+	assert.ErrorIs(t, err, errExchangeNotSet)
+	_, err = GetByTransfer(t.Context(), "binance", "")
+	assert.ErrorIs(t, err, errTransferIDNotSet)
+}
@@ -0,0 +1,33 @@
+package fundingtransition
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	// ErrTransitionNotFound is returned when a transfer has no stored transitions
+	ErrTransitionNotFound = errors.New("funding transition not found")
+
+	errExchangeNotSet   = errors.New("funding transition exchange not set")
+	errTransferIDNotSet = errors.New("funding transition transfer id not set")
+	errCurrencyNotSet   = errors.New("funding transition currency not set")
+	errStateNotSet      = errors.New("funding transition state not set")
+)
+
+// Data defines a change in state of a deposit or withdrawal in its simplest db
+// friendly form. FromState is empty for the first state a transfer is seen in
+type Data struct {
+	ID           string
+	Exchange     string
+	TransferID   string
+	TransferType string
+	Currency     string
+	Amount       float64
+	Chain        string
+	TxID         string
+	FromState    string
+	ToState      string
+	Status       string
+	CreatedAt    time.Time
+}
@@ -0,0 +1,306 @@
+package fxrate
+
+import (
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	modelPSQL "github.com/thrasher-corp/gocryptotrader/database/models/postgres"
+	modelSQLite "github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+// csvSource is the source recorded for rates imported from a csv file
+const csvSource = "csv"
+
+// Insert saves fx rates to the database, replacing any rate already stored for
+// the same currencies and time
+func Insert(ctx context.Context, rates ...Data) error {
+	if len(rates) == 0 {
+		return errNoRates
+	}
+	if !database.DB.IsConnected() {
+		return database.ErrDatabaseNotConnected
+	}
+	for i := range rates {
+		if err := rates[i].validate(); err != nil {
+			return err
+		}
+	}
+
+	tx, err := database.DB.SQL.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginTx %w", err)
+	}
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		err = upsertSQLite(ctx, tx, rates)
+	} else {
+		err = upsertPostgres(ctx, tx, rates)
+	}
+	if err != nil {
+		errRB := tx.Rollback()
+		if errRB != nil {
+			log.Errorf(log.DatabaseMgr, "Insert tx.Rollback %v", errRB)
+		}
+		return err
+	}
+	return tx.Commit()
+}
+
+func upsertSQLite(ctx context.Context, tx *sql.Tx, rates []Data) error {
+	for i := range rates {
+		ts := rates[i].Timestamp.UTC().Truncate(time.Second).Format(time.RFC3339)
+		existing, err := modelSQLite.FXRates(
+			qm.Where("base = ?", strings.ToUpper(rates[i].Base)),
+			qm.Where("quote = ?", strings.ToUpper(rates[i].Quote)),
+			qm.Where("timestamp = ?", ts),
+		).One(ctx, tx)
+		switch {
+		case err == nil:
+			existing.Rate = rates[i].Rate
+			existing.Source = rates[i].Source
+			if _, err = existing.Update(ctx, tx, boil.Whitelist(modelSQLite.FXRateColumns.Rate, modelSQLite.FXRateColumns.Source)); err != nil {
+				return err
+			}
+			continue
+		case !errors.Is(err, sql.ErrNoRows):
+			return err
+		}
+		r := modelSQLite.FXRate{
+			ID:        rates[i].ID,
+			Base:      strings.ToUpper(rates[i].Base),
+			Quote:     strings.ToUpper(rates[i].Quote),
+			Rate:      rates[i].Rate,
+			Source:    rates[i].Source,
+			Timestamp: ts,
+		}
+		if r.ID == "" {
+			newID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			r.ID = newID.String()
+		}
+		if err := r.Insert(ctx, tx, boil.Infer()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func upsertPostgres(ctx context.Context, tx *sql.Tx, rates []Data) error {
+	for i := range rates {
+		r := modelPSQL.FXRate{
+			ID:        rates[i].ID,
+			Base:      strings.ToUpper(rates[i].Base),
+			Quote:     strings.ToUpper(rates[i].Quote),
+			Rate:      rates[i].Rate,
+			Source:    rates[i].Source,
+			Timestamp: rates[i].Timestamp.UTC().Truncate(time.Second),
+		}
+		err := r.Upsert(ctx, tx, true,
+			[]string{modelPSQL.FXRateColumns.Base, modelPSQL.FXRateColumns.Quote, modelPSQL.FXRateColumns.Timestamp},
+			boil.Whitelist(modelPSQL.FXRateColumns.Rate, modelPSQL.FXRateColumns.Source),
+			boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetRateAt returns the most recent rate stored for the currencies at or
+// before the time
+func GetRateAt(ctx context.Context, base, quote string, at time.Time) (*Data, error) {
+	if !database.DB.IsConnected() {
+		return nil, database.ErrDatabaseNotConnected
+	}
+	queries := []qm.QueryMod{
+		qm.Where("base = ?", strings.ToUpper(base)),
+		qm.Where("quote = ?", strings.ToUpper(quote)),
+		qm.OrderBy("timestamp DESC"),
+	}
+	var resp *Data
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		queries = append(queries, qm.Where("timestamp <= ?", at.UTC().Truncate(time.Second).Format(time.RFC3339)))
+		r, err := modelSQLite.FXRates(queries...).One(ctx, database.DB.SQL)
+		if err != nil {
+			return nil, rateNotFound(err, base, quote, at)
+		}
+		if resp, err = fromSQLite(r); err != nil {
+			return nil, err
+		}
+	} else {
+		queries = append(queries, qm.Where("timestamp <= ?", at.UTC().Truncate(time.Second)))
+		r, err := modelPSQL.FXRates(queries...).One(ctx, database.DB.SQL)
+		if err != nil {
+			return nil, rateNotFound(err, base, quote, at)
+		}
+		resp = fromPostgres(r)
+	}
+	return resp, nil
+}
+
+// GetInRange returns the rates stored for the currencies between the start and
+// end times, ordered by time
+func GetInRange(ctx context.Context, base, quote string, start, end time.Time) ([]Data, error) {
+	if !database.DB.IsConnected() {
+		return nil, database.ErrDatabaseNotConnected
+	}
+	queries := []qm.QueryMod{
+		qm.Where("base = ?", strings.ToUpper(base)),
+		qm.Where("quote = ?", strings.ToUpper(quote)),
+		qm.OrderBy("timestamp"),
+	}
+	var resp []Data
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		queries = append(queries, qm.Where("timestamp between ? and ?", start.UTC().Truncate(time.Second).Format(time.RFC3339), end.UTC().Truncate(time.Second).Format(time.RFC3339)))
+		rates, err := modelSQLite.FXRates(queries...).All(ctx, database.DB.SQL)
+		if err != nil {
+			return nil, err
+		}
+		resp = make([]Data, len(rates))
+		for i := range rates {
+			d, err := fromSQLite(rates[i])
+			if err != nil {
+				return nil, err
+			}
+			resp[i] = *d
+		}
+	} else {
+		queries = append(queries, qm.Where("timestamp between ? and ?", start.UTC().Truncate(time.Second), end.UTC().Truncate(time.Second)))
+		rates, err := modelPSQL.FXRates(queries...).All(ctx, database.DB.SQL)
+		if err != nil {
+			return nil, err
+		}
+		resp = make([]Data, len(rates))
+		for i := range rates {
+			resp[i] = *fromPostgres(rates[i])
+		}
+	}
+	if len(resp) == 0 {
+		return nil, fmt.Errorf("%w for %s-%s between %s and %s", ErrRateNotFound, base, quote, start, end)
+	}
+	return resp, nil
+}
+
+func rateNotFound(err error, base, quote string, at time.Time) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("%w for %s-%s at %s", ErrRateNotFound, base, quote, at)
+	}
+	return err
+}
+
+func fromSQLite(r *modelSQLite.FXRate) (*Data, error) {
+	ts, err := time.Parse(time.RFC3339, r.Timestamp)
+	if err != nil {
+		return nil, err
+	}
+	return &Data{
+		ID:        r.ID,
+		Base:      r.Base,
+		Quote:     r.Quote,
+		Rate:      r.Rate,
+		Source:    r.Source,
+		Timestamp: ts,
+	}, nil
+}
+
+func fromPostgres(r *modelPSQL.FXRate) *Data {
+	return &Data{
+		ID:        r.ID,
+		Base:      r.Base,
+		Quote:     r.Quote,
+		Rate:      r.Rate,
+		Source:    r.Source,
+		Timestamp: r.Timestamp.UTC(),
+	}
+}
+
+// InsertFromCSV inserts the fx rates of a csv file with rows of unix
+// timestamp, base currency, quote currency and rate
+func InsertFromCSV(ctx context.Context, file string) (uint64, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if errClose := f.Close(); errClose != nil {
+			log.Errorln(log.Global, errClose)
+		}
+	}()
+
+	rates, err := ReadCSV(f)
+	if err != nil {
+		return 0, err
+	}
+	if err := Insert(ctx, rates...); err != nil {
+		return 0, err
+	}
+	return uint64(len(rates)), nil
+}
+
+// ReadCSV reads fx rates from csv rows of unix timestamp, base currency, quote
+// currency and rate
+func ReadCSV(r io.Reader) ([]Data, error) {
+	csvData := csv.NewReader(r)
+	csvData.FieldsPerRecord = 4
+	var rates []Data
+	for {
+		row, err := csvData.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, err
+		}
+		ts, err := strconv.ParseInt(row[0], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w %v: %w", errInvalidCSVRow, row, err)
+		}
+		rate, err := strconv.ParseFloat(row[3], 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w %v: %w", errInvalidCSVRow, row, err)
+		}
+		d := Data{
+			Base:      row[1],
+			Quote:     row[2],
+			Rate:      rate,
+			Source:    csvSource,
+			Timestamp: time.Unix(ts, 0).UTC(),
+		}
+		if err := d.validate(); err != nil {
+			return nil, fmt.Errorf("%w %v: %w", errInvalidCSVRow, row, err)
+		}
+		rates = append(rates, d)
+	}
+	if len(rates) == 0 {
+		return nil, errNoRates
+	}
+	return rates, nil
+}
+
+func (d *Data) validate() error {
+	if d.Base == "" || d.Quote == "" {
+		return errCurrencyNotSet
+	}
+	if d.Rate <= 0 {
+		return fmt.Errorf("%w %v for %s-%s", errInvalidRate, d.Rate, d.Base, d.Quote)
+	}
+	if d.Timestamp.IsZero() {
+		return errTimestampNotSet
+	}
+	return nil
+}
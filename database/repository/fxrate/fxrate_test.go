@@ -0,0 +1,146 @@
+package fxrate
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/testhelpers"
+)
+
+var verbose = false
+
+func TestMain(m *testing.M) {
+	if verbose {
+		err := testhelpers.EnableVerboseTestOutput()
+		if err != nil {
+			fmt.Printf("failed to enable verbose test output: %v", err)
+			os.Exit(1)
+		}
+	}
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.TempDir, err = os.MkdirTemp("", "gct-temp")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	exitCode := m.Run()
+	if err = os.RemoveAll(testhelpers.TempDir); err != nil {
+		fmt.Printf("failed to remove temp dir: %s", err)
+	}
+	os.Exit(exitCode)
+}
+
+func TestFXRates(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		name   string
+		config *database.Config
+	}{
+		{
+			name:   "postgresql",
+			config: testhelpers.PostgresTestDatabase,
+		},
+		{
+			name: "SQLite",
+			config: &database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			if !testhelpers.CheckValidConfig(&tc.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := testhelpers.ConnectToDatabase(tc.config)
+			require.NoError(t, err, "ConnectToDatabase must not error")
+
+			fxRateSQLTester(t)
+			assert.NoError(t, testhelpers.CloseDatabase(dbConn), "CloseDatabase should not error")
+		})
+	}
+}
+
+func fxRateSQLTester(t *testing.T) {
+	t.Helper()
+	firstTime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	rates := make([]Data, 10)
+	for i := range rates {
+		rates[i] = Data{
+			Base:      "eur",
+			Quote:     "usd",
+			Rate:      1.1 + float64(i)/100,
+			Source:    "test",
+			Timestamp: firstTime.AddDate(0, 0, i),
+		}
+	}
+	require.NoError(t, Insert(t.Context(), rates...), "Insert must not error")
+
+	// Inserting a rate for a stored time replaces it
+	require.NoError(t, Insert(t.Context(), Data{Base: "EUR", Quote: "USD", Rate: 1.5, Source: "test", Timestamp: firstTime}), "Insert must not error")
+
+	resp, err := GetInRange(t.Context(), "EUR", "USD", firstTime, firstTime.AddDate(0, 0, 9))
+	require.NoError(t, err, "GetInRange must not error")
+	require.Len(t, resp, 10, "GetInRange must return one rate per time")
+	assert.Equal(t, 1.5, resp[0].Rate, "Insert should replace the rate stored for the same time")
+	assert.True(t, resp[9].Timestamp.Equal(firstTime.AddDate(0, 0, 9)), "GetInRange should order rates by time")
+
+	r, err := GetRateAt(t.Context(), "eur", "usd", firstTime.AddDate(0, 0, 3).Add(time.Hour))
+	require.NoError(t, err, "GetRateAt must not error")
+	assert.Equal(t, rates[3].Rate, r.Rate, "GetRateAt should return the latest rate before the time")
+	assert.Equal(t, "EUR", r.Base, "GetRateAt should return the stored base")
+
+	_, err = GetRateAt(t.Context(), "EUR", "USD", firstTime.Add(-time.Second))
+	assert.ErrorIs(t, err, ErrRateNotFound)
+
+	_, err = GetInRange(t.Context(), "GBP", "USD", firstTime, firstTime.AddDate(0, 0, 9))
+	assert.ErrorIs(t, err, ErrRateNotFound)
+
+	path := filepath.Join(t.TempDir(), "fx.csv")
+	require.NoError(t, os.WriteFile(path, []byte("1577836800,GBP,USD,1.32\n1577923200,GBP,USD,1.31\n"), 0o600), "WriteFile must not error")
+	inserted, err := InsertFromCSV(t.Context(), path)
+	require.NoError(t, err, "InsertFromCSV must not error")
+	assert.Equal(t, uint64(2), inserted, "InsertFromCSV should insert every row")
+	r, err = GetRateAt(t.Context(), "GBP", "USD", firstTime.AddDate(0, 0, 5))
+	require.NoError(t, err, "GetRateAt must not error")
+	assert.Equal(t, 1.31, r.Rate, "GetRateAt should return an imported rate")
+	assert.Equal(t, csvSource, r.Source, "imported rates should record their source")
+}
+
+func TestReadCSV(t *testing.T) {
+	t.Parallel()
+	_, err := ReadCSV(strings.NewReader(""))
+	assert.ErrorIs(t, err, errNoRates)
+
+	_, err = ReadCSV(strings.NewReader("notatime,EUR,USD,1.1\n"))
+	assert.ErrorIs(t, err, errInvalidCSVRow)
+
+	_, err = ReadCSV(strings.NewReader("1577836800,EUR,USD,-1\n"))
+	assert.ErrorIs(t, err, errInvalidRate)
+
+	_, err = ReadCSV(strings.NewReader("1577836800,,USD,1.1\n"))
+	assert.ErrorIs(t, err, errCurrencyNotSet)
+
+	rates, err := ReadCSV(strings.NewReader("1577836800,EUR,USD,1.1\n"))
+	require.NoError(t, err, "ReadCSV must not error")
+	require.Len(t, rates, 1, "ReadCSV must return every row")
+	assert.Equal(t, Data{Base: "EUR", Quote: "USD", Rate: 1.1, Source: csvSource, Timestamp: time.Unix(1577836800, 0).UTC()}, rates[0])
+}
+
+func TestInsertValidation(t *testing.T) {
+	t.Parallel()
+	assert.ErrorIs(t, Insert(t.Context()), errNoRates)
+	assert.ErrorIs(t, (&Data{Base: "EUR", Quote: "USD", Rate: 1}).validate(), errTimestampNotSet)
+}
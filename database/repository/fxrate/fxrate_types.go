@@ -0,0 +1,28 @@
+package fxrate
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	// ErrRateNotFound is returned when no rate is stored for a currency pair
+	ErrRateNotFound = errors.New("fx rate not found")
+
+	errNoRates         = errors.New("no fx rates received")
+	errInvalidRate     = errors.New("invalid fx rate")
+	errInvalidCSVRow   = errors.New("invalid fx rate csv row")
+	errCurrencyNotSet  = errors.New("fx rate currency not set")
+	errTimestampNotSet = errors.New("fx rate timestamp not set")
+)
+
+// Data defines a foreign exchange rate in its simplest db friendly form, the
+// Rate being the amount of Quote one unit of Base is worth
+type Data struct {
+	ID        string
+	Base      string
+	Quote     string
+	Rate      float64
+	Source    string
+	Timestamp time.Time
+}
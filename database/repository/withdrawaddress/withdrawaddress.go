@@ -0,0 +1,263 @@
+package withdrawaddress
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	modelPSQL "github.com/thrasher-corp/gocryptotrader/database/models/postgres"
+	modelSQLite "github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+// Insert adds an address to the address book, updating the label of an
+// address which is already stored, and returns the stored address
+func Insert(ctx context.Context, d Data) (*Data, error) {
+	if !database.DB.IsConnected() {
+		return nil, database.ErrDatabaseNotConnected
+	}
+	d.normalise()
+	if err := d.validate(); err != nil {
+		return nil, err
+	}
+
+	tx, err := database.DB.SQL.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("beginTx %w", err)
+	}
+	var resp *Data
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		resp, err = upsertSQLite(ctx, tx, &d)
+	} else {
+		resp, err = upsertPostgres(ctx, tx, &d)
+	}
+	if err != nil {
+		errRB := tx.Rollback()
+		if errRB != nil {
+			log.Errorf(log.DatabaseMgr, "Insert tx.Rollback %v", errRB)
+		}
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func upsertSQLite(ctx context.Context, tx *sql.Tx, d *Data) (*Data, error) {
+	a, err := modelSQLite.WithdrawalAddresses(matching(d)...).One(ctx, tx)
+	switch {
+	case err == nil:
+		a.Label = d.Label
+		if _, err := a.Update(ctx, tx, boil.Whitelist(modelSQLite.WithdrawalAddressColumns.Label)); err != nil {
+			return nil, err
+		}
+		return fromSQLite(a)
+	case !errors.Is(err, sql.ErrNoRows):
+		return nil, err
+	}
+	a = &modelSQLite.WithdrawalAddress{
+		ID:         d.ID,
+		Currency:   d.Currency,
+		Chain:      d.Chain,
+		Address:    d.Address,
+		AddressTag: d.AddressTag,
+		Label:      d.Label,
+		CreatedAt:  time.Now().UTC().Format(time.RFC3339),
+	}
+	if a.ID == "" {
+		id, err := uuid.NewV4()
+		if err != nil {
+			return nil, err
+		}
+		a.ID = id.String()
+	}
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		return nil, err
+	}
+	return fromSQLite(a)
+}
+
+func upsertPostgres(ctx context.Context, tx *sql.Tx, d *Data) (*Data, error) {
+	a, err := modelPSQL.WithdrawalAddresses(matching(d)...).One(ctx, tx)
+	switch {
+	case err == nil:
+		a.Label = d.Label
+		if _, err := a.Update(ctx, tx, boil.Whitelist(modelPSQL.WithdrawalAddressColumns.Label)); err != nil {
+			return nil, err
+		}
+		return fromPostgres(a), nil
+	case !errors.Is(err, sql.ErrNoRows):
+		return nil, err
+	}
+	a = &modelPSQL.WithdrawalAddress{
+		ID:         d.ID,
+		Currency:   d.Currency,
+		Chain:      d.Chain,
+		Address:    d.Address,
+		AddressTag: d.AddressTag,
+		Label:      d.Label,
+		CreatedAt:  time.Now().UTC().Truncate(time.Second),
+	}
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		return nil, err
+	}
+	return fromPostgres(a), nil
+}
+
+// Get returns the address book entry matching the currency, chain, address
+// and address tag
+func Get(ctx context.Context, currencyCode, chain, address, addressTag string) (*Data, error) {
+	if !database.DB.IsConnected() {
+		return nil, database.ErrDatabaseNotConnected
+	}
+	d := Data{Currency: currencyCode, Chain: chain, Address: address, AddressTag: addressTag}
+	d.normalise()
+	if err := d.validate(); err != nil {
+		return nil, err
+	}
+
+	var resp *Data
+	var err error
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		var a *modelSQLite.WithdrawalAddress
+		if a, err = modelSQLite.WithdrawalAddresses(matching(&d)...).One(ctx, database.DB.SQL); err == nil {
+			resp, err = fromSQLite(a)
+		}
+	} else {
+		var a *modelPSQL.WithdrawalAddress
+		if a, err = modelPSQL.WithdrawalAddresses(matching(&d)...).One(ctx, database.DB.SQL); err == nil {
+			resp = fromPostgres(a)
+		}
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: %s %s on chain %q", ErrAddressNotFound, d.Currency, d.Address, d.Chain)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetAll returns the address book entries of a currency, or every entry when
+// the currency is empty
+func GetAll(ctx context.Context, currencyCode string) ([]Data, error) {
+	if !database.DB.IsConnected() {
+		return nil, database.ErrDatabaseNotConnected
+	}
+	queries := []qm.QueryMod{qm.OrderBy("currency, chain, address")}
+	if currencyCode != "" {
+		queries = append(queries, qm.Where("currency = ?", strings.ToUpper(currencyCode)))
+	}
+
+	var resp []Data
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		addresses, err := modelSQLite.WithdrawalAddresses(queries...).All(ctx, database.DB.SQL)
+		if err != nil {
+			return nil, err
+		}
+		resp = make([]Data, len(addresses))
+		for i := range addresses {
+			d, err := fromSQLite(addresses[i])
+			if err != nil {
+				return nil, err
+			}
+			resp[i] = *d
+		}
+	} else {
+		addresses, err := modelPSQL.WithdrawalAddresses(queries...).All(ctx, database.DB.SQL)
+		if err != nil {
+			return nil, err
+		}
+		resp = make([]Data, len(addresses))
+		for i := range addresses {
+			resp[i] = *fromPostgres(addresses[i])
+		}
+	}
+	return resp, nil
+}
+
+// Delete removes an address from the address book by its id
+func Delete(ctx context.Context, id string) error {
+	if id == "" {
+		return errIDNotSet
+	}
+	if !database.DB.IsConnected() {
+		return database.ErrDatabaseNotConnected
+	}
+
+	var n int64
+	var err error
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		n, err = modelSQLite.WithdrawalAddresses(qm.Where("id = ?", id)).DeleteAll(ctx, database.DB.SQL)
+	} else {
+		n, err = modelPSQL.WithdrawalAddresses(qm.Where("id = ?", id)).DeleteAll(ctx, database.DB.SQL)
+	}
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return fmt.Errorf("%w: %s", ErrAddressNotFound, id)
+	}
+	return nil
+}
+
+func matching(d *Data) []qm.QueryMod {
+	return []qm.QueryMod{
+		qm.Where("currency = ?", d.Currency),
+		qm.Where("chain = ?", d.Chain),
+		qm.Where("address = ?", d.Address),
+		qm.Where("address_tag = ?", d.AddressTag),
+	}
+}
+
+func fromSQLite(a *modelSQLite.WithdrawalAddress) (*Data, error) {
+	createdAt, err := time.Parse(time.RFC3339, a.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &Data{
+		ID:         a.ID,
+		Currency:   a.Currency,
+		Chain:      a.Chain,
+		Address:    a.Address,
+		AddressTag: a.AddressTag,
+		Label:      a.Label,
+		CreatedAt:  createdAt,
+	}, nil
+}
+
+func fromPostgres(a *modelPSQL.WithdrawalAddress) *Data {
+	return &Data{
+		ID:         a.ID,
+		Currency:   a.Currency,
+		Chain:      a.Chain,
+		Address:    a.Address,
+		AddressTag: a.AddressTag,
+		Label:      a.Label,
+		CreatedAt:  a.CreatedAt.UTC(),
+	}
+}
+
+func (d *Data) normalise() {
+	d.Currency = strings.ToUpper(d.Currency)
+	d.Chain = strings.ToLower(d.Chain)
+}
+
+func (d *Data) validate() error {
+	if d.Currency == "" {
+		return errCurrencyNotSet
+	}
+	if d.Address == "" {
+		return errAddressNotSet
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+package withdrawaddress
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/testhelpers"
+)
+
+var verbose = false
+
+func TestMain(m *testing.M) {
+	if verbose {
+		err := testhelpers.EnableVerboseTestOutput()
+		if err != nil {
+			fmt.Printf("failed to enable verbose test output: %v", err)
+			os.Exit(1)
+		}
+	}
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.TempDir, err = os.MkdirTemp("", "gct-temp")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	exitCode := m.Run()
+	if err = os.RemoveAll(testhelpers.TempDir); err != nil {
+		fmt.Printf("failed to remove temp dir: %s", err)
+	}
+	os.Exit(exitCode)
+}
+
+func TestWithdrawalAddresses(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		name   string
+		config *database.Config
+	}{
+		{
+			name:   "postgresql",
+			config: testhelpers.PostgresTestDatabase,
+		},
+		{
+			name: "SQLite",
+			config: &database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			if !testhelpers.CheckValidConfig(&tc.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := testhelpers.ConnectToDatabase(tc.config)
+			require.NoError(t, err, "ConnectToDatabase must not error")
+
+			addressSQLTester(t)
+			assert.NoError(t, testhelpers.CloseDatabase(dbConn), "CloseDatabase should not error")
+		})
+	}
+}
+
+func addressSQLTester(t *testing.T) {
+	t.Helper()
+	_, err := Insert(t.Context(), Data{Currency: "btc"})
+	assert.ErrorIs(t, err, errAddressNotSet)
+	_, err = Insert(t.Context(), Data{Address: "addr"})
+	assert.ErrorIs(t, err, errCurrencyNotSet)
+
+	d, err := Insert(t.Context(), Data{Currency: "btc", Chain: "BTC", Address: "bc1qtest", Label: "cold"})
+	require.NoError(t, err, "Insert must not error")
+	assert.NotEmpty(t, d.ID, "Insert should return the stored id")
+	assert.Equal(t, "BTC", d.Currency, "Insert should store the currency in upper case")
+	assert.Equal(t, "btc", d.Chain, "Insert should store the chain in lower case")
+
+	updated, err := Insert(t.Context(), Data{Currency: "BTC", Chain: "btc", Address: "bc1qtest", Label: "vault"})
+	require.NoError(t, err, "Insert must not error")
+	assert.Equal(t, d.ID, updated.ID, "Insert should update a stored address")
+	assert.Equal(t, "vault", updated.Label, "Insert should update the label of a stored address")
+
+	_, err = Insert(t.Context(), Data{Currency: "XRP", Address: "rTest", AddressTag: "1337"})
+	require.NoError(t, err, "Insert must not error")
+
+	got, err := Get(t.Context(), "btc", "BTC", "bc1qtest", "")
+	require.NoError(t, err, "Get must not error")
+	assert.Equal(t, d.ID, got.ID, "Get should match the chain regardless of case")
+	_, err = Get(t.Context(), "XRP", "", "rTest", "")
+	assert.ErrorIs(t, err, ErrAddressNotFound, "Get should match the address tag")
+	_, err = Get(t.Context(), "BTC", "", "bc1qtest", "")
+	assert.ErrorIs(t, err, ErrAddressNotFound, "Get should match the chain")
+
+	all, err := GetAll(t.Context(), "")
+	require.NoError(t, err, "GetAll must not error")
+	assert.Len(t, all, 2, "GetAll should return every address")
+	all, err = GetAll(t.Context(), "xrp")
+	require.NoError(t, err, "GetAll must not error")
+	require.Len(t, all, 1, "GetAll must return the addresses of the currency")
+	assert.Equal(t, "1337", all[0].AddressTag, "GetAll should return the address tag")
+
+	assert.ErrorIs(t, Delete(t.Context(), ""), errIDNotSet)
+	require.NoError(t, Delete(t.Context(), d.ID), "Delete must not error")
+	assert.ErrorIs(t, Delete(t.Context(), d.ID), ErrAddressNotFound, "Delete should error for a removed address")
+	_, err = Get(t.Context(), "BTC", "btc", "bc1qtest", "")
+	assert.ErrorIs(t, err, ErrAddressNotFound, "Get should not return a removed address")
+}
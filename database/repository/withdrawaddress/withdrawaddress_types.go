@@ -0,0 +1,28 @@
+package withdrawaddress
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	// ErrAddressNotFound is returned when an address is not in the address book
+	ErrAddressNotFound = errors.New("withdrawal address not found")
+
+	errCurrencyNotSet = errors.New("withdrawal address currency not set")
+	errAddressNotSet  = errors.New("withdrawal address not set")
+	errIDNotSet       = errors.New("withdrawal address id not set")
+)
+
+// Data defines an approved withdrawal destination in its simplest db friendly
+// form. Chain is stored in lower case so it matches regardless of how an
+// exchange spells it
+type Data struct {
+	ID         string
+	Currency   string
+	Chain      string
+	Address    string
+	AddressTag string
+	Label      string
+	CreatedAt  time.Time
+}
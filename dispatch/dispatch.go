@@ -76,6 +76,25 @@ func IsRunning() bool {
 	return dispatcher.isRunning()
 }
 
+// QueueStats returns the number of jobs waiting to be relayed and the job
+// channel capacity of the global dispatcher
+func QueueStats() (depth, capacity int) {
+	return dispatcher.queueStats()
+}
+
+// queueStats returns the current job queue depth and capacity
+func (d *Dispatcher) queueStats() (depth, capacity int) {
+	if d == nil {
+		return 0, 0
+	}
+	d.m.RLock()
+	defer d.m.RUnlock()
+	if !d.running {
+		return 0, 0
+	}
+	return len(d.jobs), cap(d.jobs)
+}
+
 // start sets defaults and config and spawns workers.
 // Does not provide locking protection.
 func (d *Dispatcher) start(workers, channelCapacity int) error {
@@ -81,6 +81,24 @@ func TestStartStop(t *testing.T) {
 	assert.False(t, d.isRunning(), "IsRunning should return false")
 }
 
+func TestQueueStats(t *testing.T) {
+	t.Parallel()
+	var d *Dispatcher
+	depth, capacity := d.queueStats()
+	assert.Zero(t, depth, "queueStats should return zero depth on nil dispatcher")
+	assert.Zero(t, capacity, "queueStats should return zero capacity on nil dispatcher")
+
+	d = NewDispatcher()
+	_, capacity = d.queueStats()
+	assert.Zero(t, capacity, "queueStats should return zero capacity when not running")
+
+	require.NoError(t, d.start(1, 50), "start must not error")
+	depth, capacity = d.queueStats()
+	assert.Zero(t, depth, "queueStats should return zero depth with no jobs")
+	assert.Equal(t, 50, capacity, "queueStats should return the job channel capacity")
+	require.NoError(t, d.stop(), "stop must not error")
+}
+
 func TestSubscribe(t *testing.T) {
 	t.Parallel()
 	var d *Dispatcher
@@ -0,0 +1,240 @@
+package engine
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/key"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// SetupCandleAggregationManager applies configuration parameters before running
+func SetupCandleAggregationManager(cfg *config.CandleAggregator) (*CandleAggregationManager, error) {
+	if cfg == nil {
+		return nil, errNilCandleAggregatorConfig
+	}
+	intervals := slices.Clone(cfg.Intervals)
+	for i := range intervals {
+		if intervals[i] <= 0 {
+			return nil, fmt.Errorf("%s %w: %v", CandleAggregationManagerName, kline.ErrInvalidInterval, intervals[i])
+		}
+	}
+	slices.Sort(intervals)
+	return &CandleAggregationManager{
+		verbose:        cfg.Verbose,
+		saveToDatabase: cfg.SaveToDatabase,
+		maxCandles:     cfg.MaxCandles,
+		intervals:      slices.Compact(intervals),
+		mux:            dispatch.GetNewMux(nil),
+		series:         make(map[key.ExchangeAssetPair]map[kline.Interval]*candleSeries),
+		candleSaver:    kline.StoreInDatabase,
+	}, nil
+}
+
+// Start runs the subsystem
+func (m *CandleAggregationManager) Start() error {
+	if m == nil {
+		return fmt.Errorf("%s %w", CandleAggregationManagerName, ErrNilSubsystem)
+	}
+	if !m.started.CompareAndSwap(false, true) {
+		return fmt.Errorf("%s %w", CandleAggregationManagerName, ErrSubSystemAlreadyStarted)
+	}
+	log.Debugf(log.Trade, "%s %s", CandleAggregationManagerName, MsgSubSystemStarting)
+	m.m.Lock()
+	m.shutdown = make(chan struct{})
+	if m.saveToDatabase {
+		queue, shutdown := make(chan *kline.Item, defaultCandleSaveBuffer), m.shutdown
+		m.saveQueue = queue
+		m.wg.Go(func() { m.saveCandles(queue, shutdown) })
+	}
+	m.m.Unlock()
+	log.Debugf(log.Trade, "%s %s", CandleAggregationManagerName, MsgSubSystemStarted)
+	return nil
+}
+
+// Stop stops the subsystem
+func (m *CandleAggregationManager) Stop() error {
+	if m == nil {
+		return fmt.Errorf("%s %w", CandleAggregationManagerName, ErrNilSubsystem)
+	}
+	if !m.started.CompareAndSwap(true, false) {
+		return fmt.Errorf("%s %w", CandleAggregationManagerName, ErrSubSystemNotStarted)
+	}
+	log.Debugf(log.Trade, "%s %s", CandleAggregationManagerName, MsgSubSystemShuttingDown)
+	m.m.Lock()
+	close(m.shutdown)
+	m.m.Unlock()
+	m.wg.Wait()
+	m.m.Lock()
+	m.saveQueue = nil
+	m.m.Unlock()
+	log.Debugf(log.Trade, "%s %s", CandleAggregationManagerName, MsgSubSystemShutdown)
+	return nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (m *CandleAggregationManager) IsRunning() bool {
+	return m != nil && m.started.Load()
+}
+
+// ProcessTrades folds trades into every candle series tracked for their
+// exchange, asset and pair. Series are created on demand for each configured
+// interval.
+func (m *CandleAggregationManager) ProcessTrades(trades ...trade.Data) error {
+	if !m.IsRunning() {
+		return fmt.Errorf("%s %w", CandleAggregationManagerName, ErrSubSystemNotStarted)
+	}
+	m.m.Lock()
+	defer m.m.Unlock()
+	var errs error
+	for i := range trades {
+		k := key.NewExchangeAssetPair(strings.ToLower(trades[i].Exchange), trades[i].AssetType, trades[i].CurrencyPair)
+		for _, interval := range m.intervals {
+			if _, err := m.getSeries(k, trades[i].CurrencyPair, interval); err != nil {
+				errs = common.AppendError(errs, err)
+			}
+		}
+		for _, s := range m.series[k] {
+			if err := s.aggregator.Process(trades[i]); err != nil {
+				errs = common.AppendError(errs, err)
+			}
+		}
+	}
+	return errs
+}
+
+// Subscribe returns a dispatch pipe which receives every revision of candles
+// for the exchange, pair, asset and interval as a *LiveCandle. The candle
+// series is created if it is not already being aggregated.
+func (m *CandleAggregationManager) Subscribe(exchName string, p currency.Pair, a asset.Item, interval kline.Interval) (dispatch.Pipe, error) {
+	if !m.IsRunning() {
+		return dispatch.Pipe{}, fmt.Errorf("%s %w", CandleAggregationManagerName, ErrSubSystemNotStarted)
+	}
+	m.m.Lock()
+	s, err := m.getSeries(key.NewExchangeAssetPair(strings.ToLower(exchName), a, p), p, interval)
+	m.m.Unlock()
+	if err != nil {
+		return dispatch.Pipe{}, err
+	}
+	return m.mux.Subscribe(s.id)
+}
+
+// GetCandles returns the retained closed candles and the forming candle for the
+// exchange, pair, asset and interval
+func (m *CandleAggregationManager) GetCandles(exchName string, p currency.Pair, a asset.Item, interval kline.Interval) (*kline.Item, error) {
+	if !m.IsRunning() {
+		return nil, fmt.Errorf("%s %w", CandleAggregationManagerName, ErrSubSystemNotStarted)
+	}
+	m.m.RLock()
+	s, ok := m.series[key.NewExchangeAssetPair(strings.ToLower(exchName), a, p)][interval]
+	m.m.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("%w for %s %s %s %s", errCandleSeriesNotFound, exchName, a, p, interval)
+	}
+	return s.aggregator.Candles(), nil
+}
+
+// websocketDataHandler receives websocket data from the websocket routine
+// manager and processes any trades
+func (m *CandleAggregationManager) websocketDataHandler(_ string, data any) error {
+	if !m.IsRunning() {
+		return nil
+	}
+	switch d := data.(type) {
+	case trade.Data:
+		return m.ProcessTrades(d)
+	case []trade.Data:
+		return m.ProcessTrades(d...)
+	}
+	return nil
+}
+
+// getSeries returns the candle series for the key and interval, creating it if
+// required. Must be called with the write lock held.
+func (m *CandleAggregationManager) getSeries(k key.ExchangeAssetPair, p currency.Pair, interval kline.Interval) (*candleSeries, error) {
+	if s, ok := m.series[k][interval]; ok {
+		return s, nil
+	}
+	agg, err := trade.NewCandleAggregator(k.Exchange, k.Asset, p, interval)
+	if err != nil {
+		return nil, err
+	}
+	id, err := m.mux.GetID()
+	if err != nil {
+		return nil, err
+	}
+	agg.SetMaxCandles(m.maxCandles)
+	s := &candleSeries{aggregator: agg, id: id}
+	agg.SetCandleHandler(func(c kline.Candle, closed bool) {
+		m.publish(s, k, p, interval, c, closed)
+	})
+	if m.series[k] == nil {
+		m.series[k] = make(map[kline.Interval]*candleSeries)
+	}
+	m.series[k][interval] = s
+	if m.verbose {
+		log.Debugf(log.Trade, "%s aggregating %s %s %s %s candles", CandleAggregationManagerName, k.Exchange, k.Asset, p, interval)
+	}
+	return s, nil
+}
+
+func (m *CandleAggregationManager) publish(s *candleSeries, k key.ExchangeAssetPair, p currency.Pair, interval kline.Interval, c kline.Candle, closed bool) {
+	if err := m.mux.Publish(&LiveCandle{
+		Exchange: k.Exchange,
+		Pair:     p,
+		Asset:    k.Asset,
+		Interval: interval,
+		Closed:   closed,
+		Candle:   c,
+	}, s.id); err != nil {
+		log.Errorf(log.Trade, "%s unable to publish %s %s %s %s candle: %v", CandleAggregationManagerName, k.Exchange, k.Asset, p, interval, err)
+	}
+	if !closed || m.saveQueue == nil {
+		return
+	}
+	select {
+	case m.saveQueue <- &kline.Item{
+		Exchange: k.Exchange,
+		Pair:     p,
+		Asset:    k.Asset,
+		Interval: interval,
+		Candles:  []kline.Candle{c},
+	}:
+	default:
+		log.Warnf(log.Trade, "%s save queue full, dropping %s %s %s %s candle at %v", CandleAggregationManagerName, k.Exchange, k.Asset, p, interval, c.Time)
+	}
+}
+
+// saveCandles persists closed candles until shutdown, flushing any candles
+// still queued on exit
+func (m *CandleAggregationManager) saveCandles(queue <-chan *kline.Item, shutdown <-chan struct{}) {
+	for {
+		select {
+		case <-shutdown:
+			for {
+				select {
+				case item := <-queue:
+					m.saveCandle(item)
+				default:
+					return
+				}
+			}
+		case item := <-queue:
+			m.saveCandle(item)
+		}
+	}
+}
+
+func (m *CandleAggregationManager) saveCandle(item *kline.Item) {
+	if _, err := m.candleSaver(item, false); err != nil {
+		log.Errorf(log.Trade, "%s unable to save %s %s %s %s candle: %v", CandleAggregationManagerName, item.Exchange, item.Asset, item.Pair, item.Interval, err)
+	}
+}
@@ -0,0 +1,143 @@
+package engine
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+)
+
+func TestSetupCandleAggregationManager(t *testing.T) {
+	t.Parallel()
+	_, err := SetupCandleAggregationManager(nil)
+	assert.ErrorIs(t, err, errNilCandleAggregatorConfig)
+
+	_, err = SetupCandleAggregationManager(&config.CandleAggregator{Intervals: []kline.Interval{kline.OneMin, 0}})
+	assert.ErrorIs(t, err, kline.ErrInvalidInterval)
+
+	m, err := SetupCandleAggregationManager(&config.CandleAggregator{Intervals: []kline.Interval{kline.FiveMin, kline.OneMin, kline.FiveMin}})
+	require.NoError(t, err, "SetupCandleAggregationManager must not error")
+	assert.Equal(t, []kline.Interval{kline.OneMin, kline.FiveMin}, m.intervals, "intervals should be sorted and de-duplicated")
+}
+
+func TestCandleAggregationManagerStartStop(t *testing.T) {
+	t.Parallel()
+	var m *CandleAggregationManager
+	assert.ErrorIs(t, m.Start(), ErrNilSubsystem)
+	assert.ErrorIs(t, m.Stop(), ErrNilSubsystem)
+	assert.False(t, m.IsRunning(), "IsRunning should return false on a nil manager")
+
+	m, err := SetupCandleAggregationManager(&config.CandleAggregator{SaveToDatabase: true})
+	require.NoError(t, err, "SetupCandleAggregationManager must not error")
+	assert.ErrorIs(t, m.Stop(), ErrSubSystemNotStarted)
+	require.NoError(t, m.Start(), "Start must not error")
+	assert.True(t, m.IsRunning(), "IsRunning should return true")
+	assert.ErrorIs(t, m.Start(), ErrSubSystemAlreadyStarted)
+	require.NoError(t, m.Stop(), "Stop must not error")
+	assert.False(t, m.IsRunning(), "IsRunning should return false")
+	require.NoError(t, m.Start(), "Start must not error after Stop")
+	require.NoError(t, m.Stop(), "Stop must not error")
+}
+
+func TestCandleAggregationManagerProcessTrades(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, dispatch.EnsureRunning(dispatch.DefaultMaxWorkers, dispatch.DefaultJobsLimit), "dispatch.EnsureRunning must not error")
+
+	m, err := SetupCandleAggregationManager(&config.CandleAggregator{
+		Intervals:      []kline.Interval{kline.OneMin},
+		MaxCandles:     10,
+		SaveToDatabase: true,
+	})
+	require.NoError(t, err, "SetupCandleAggregationManager must not error")
+
+	var saved []*kline.Item
+	var mtx sync.Mutex
+	m.candleSaver = func(item *kline.Item, _ bool) (uint64, error) {
+		mtx.Lock()
+		saved = append(saved, item)
+		mtx.Unlock()
+		return 1, nil
+	}
+
+	p := currency.NewBTCUSD()
+	assert.ErrorIs(t, m.ProcessTrades(), ErrSubSystemNotStarted)
+	_, err = m.Subscribe("test", p, asset.Spot, kline.OneMin)
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+	_, err = m.GetCandles("test", p, asset.Spot, kline.OneMin)
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+
+	require.NoError(t, m.Start(), "Start must not error")
+
+	_, err = m.GetCandles("test", p, asset.Spot, kline.OneMin)
+	assert.ErrorIs(t, err, errCandleSeriesNotFound)
+	_, err = m.Subscribe("test", p, asset.Spot, 0)
+	assert.ErrorIs(t, err, kline.ErrInvalidInterval)
+
+	pipe, err := m.Subscribe("Test", p, asset.Spot, kline.FiveMin)
+	require.NoError(t, err, "Subscribe must not error")
+	defer func() { assert.NoError(t, pipe.Release(), "Release should not error") }()
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	err = m.ProcessTrades(
+		trade.Data{Exchange: "TEST", CurrencyPair: p, AssetType: asset.Spot, Price: 100, Amount: 1, Timestamp: start},
+		trade.Data{Exchange: "test", CurrencyPair: p, AssetType: asset.Spot, Price: 101, Amount: 1, Timestamp: start.Add(time.Minute)},
+	)
+	require.NoError(t, err, "ProcessTrades must not error")
+
+	select {
+	case data := <-pipe.Channel():
+		c, ok := data.(*LiveCandle)
+		require.True(t, ok, "pipe must receive a *LiveCandle")
+		assert.Equal(t, "test", c.Exchange, "exchange should be lower case")
+		assert.Equal(t, kline.FiveMin, c.Interval, "interval should match the subscription")
+		assert.False(t, c.Closed, "candle should be forming")
+		assert.Equal(t, 100.0, c.Open, "open should be set from the first trade")
+	case <-time.After(time.Second * 5):
+		require.Fail(t, "pipe must receive a candle")
+	}
+
+	item, err := m.GetCandles("test", p, asset.Spot, kline.OneMin)
+	require.NoError(t, err, "GetCandles must not error")
+	require.Len(t, item.Candles, 2, "GetCandles must return the closed and forming candles")
+
+	item, err = m.GetCandles("test", p, asset.Spot, kline.FiveMin)
+	require.NoError(t, err, "GetCandles must not error")
+	require.Len(t, item.Candles, 1, "GetCandles must return the forming candle")
+	assert.Equal(t, 2.0, item.Candles[0].Volume, "subscribed series should receive trades")
+
+	require.NoError(t, m.Stop(), "Stop must not error")
+	mtx.Lock()
+	defer mtx.Unlock()
+	require.Len(t, saved, 1, "closed candle must be saved")
+	assert.Equal(t, start, saved[0].Candles[0].Time, "saved candle should be the closed candle")
+}
+
+func TestCandleAggregationManagerWebsocketDataHandler(t *testing.T) {
+	t.Parallel()
+	m, err := SetupCandleAggregationManager(&config.CandleAggregator{Intervals: []kline.Interval{kline.OneMin}})
+	require.NoError(t, err, "SetupCandleAggregationManager must not error")
+
+	td := trade.Data{Exchange: "test", CurrencyPair: currency.NewBTCUSD(), AssetType: asset.Spot, Price: 1, Amount: 1, Timestamp: time.Now()}
+	assert.NoError(t, m.websocketDataHandler("test", td), "websocketDataHandler should not error when not running")
+
+	require.NoError(t, m.Start(), "Start must not error")
+	assert.NoError(t, m.websocketDataHandler("test", "not a trade"), "websocketDataHandler should ignore unrelated data")
+	require.NoError(t, m.websocketDataHandler("test", td), "websocketDataHandler must not error")
+	require.NoError(t, m.websocketDataHandler("test", []trade.Data{td}), "websocketDataHandler must not error")
+
+	item, err := m.GetCandles("test", currency.NewBTCUSD(), asset.Spot, kline.OneMin)
+	require.NoError(t, err, "GetCandles must not error")
+	require.Len(t, item.Candles, 1, "GetCandles must return the forming candle")
+	assert.Equal(t, 2.0, item.Candles[0].Volume, "both trades should be aggregated")
+
+	td.AssetType = asset.Empty
+	assert.ErrorIs(t, m.websocketDataHandler("test", td), asset.ErrNotSupported)
+}
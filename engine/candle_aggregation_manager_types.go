@@ -0,0 +1,60 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/common/key"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+)
+
+// CandleAggregationManagerName is an exported subsystem name
+const CandleAggregationManagerName = "candle_aggregation_manager"
+
+const defaultCandleSaveBuffer = 1000
+
+var (
+	errNilCandleAggregatorConfig = errors.New("nil candle aggregator config received")
+	errCandleSeriesNotFound      = errors.New("candle series not found")
+)
+
+// CandleAggregationManager builds rolling candles from websocket trade data for
+// any kline interval, publishing each revision via dispatch and optionally
+// persisting closed candles to the database
+type CandleAggregationManager struct {
+	started        atomic.Bool
+	verbose        bool
+	saveToDatabase bool
+	maxCandles     int
+	intervals      []kline.Interval
+	mux            *dispatch.Mux
+	series         map[key.ExchangeAssetPair]map[kline.Interval]*candleSeries
+	m              sync.RWMutex
+	saveQueue      chan *kline.Item
+	candleSaver    func(*kline.Item, bool) (uint64, error)
+	shutdown       chan struct{}
+	wg             sync.WaitGroup
+}
+
+// candleSeries links a trade candle aggregator to its dispatch ID
+type candleSeries struct {
+	aggregator *trade.CandleAggregator
+	id         uuid.UUID
+}
+
+// LiveCandle is a candle revision published by the candle aggregation manager.
+// Closed is set once a trade in a later interval has finalised the candle.
+type LiveCandle struct {
+	Exchange string
+	Pair     currency.Pair
+	Asset    asset.Item
+	Interval kline.Interval
+	Closed   bool
+	kline.Candle
+}
@@ -2,6 +2,7 @@ package engine
 
 import (
 	"fmt"
+	"sync"
 	"sync/atomic"
 
 	"github.com/thrasher-corp/gocryptotrader/communications"
@@ -18,6 +19,8 @@ type CommunicationManager struct {
 	shutdown chan struct{}
 	relayMsg chan base.Event
 	comms    *communications.Communications
+	commands map[string]base.CommandHandler
+	commsMu  sync.RWMutex
 }
 
 // SetupCommunicationManager creates a communications manager
@@ -64,9 +67,71 @@ func (m *CommunicationManager) GetStatus() (map[string]base.CommsStatus, error)
 	if !m.IsRunning() {
 		return nil, fmt.Errorf("communications manager %w", ErrSubSystemNotStarted)
 	}
+	m.commsMu.RLock()
+	defer m.commsMu.RUnlock()
 	return m.comms.GetStatus(), nil
 }
 
+// UpdateConfig replaces the communication relayers with those enabled by the
+// config. Events pushed after the update are relayed by the new relayers
+func (m *CommunicationManager) UpdateConfig(cfg *base.CommunicationsConfig) error {
+	if m == nil {
+		return fmt.Errorf("communications manager server %w", ErrNilSubsystem)
+	}
+	comms, err := m.newRelayers(cfg)
+	if err != nil {
+		return err
+	}
+	m.setRelayers(comms)
+	return nil
+}
+
+// newRelayers builds the relayers enabled by the config with every registered
+// command, without replacing the running relayers
+func (m *CommunicationManager) newRelayers(cfg *base.CommunicationsConfig) (*communications.Communications, error) {
+	if cfg == nil {
+		return nil, errNilConfig
+	}
+	comms, err := communications.NewComm(cfg)
+	if err != nil {
+		return nil, err
+	}
+	m.commsMu.RLock()
+	defer m.commsMu.RUnlock()
+	for name, h := range m.commands {
+		if err := comms.RegisterCommand(name, h); err != nil {
+			return nil, err
+		}
+	}
+	return comms, nil
+}
+
+// setRelayers replaces the running relayers with those built by newRelayers
+func (m *CommunicationManager) setRelayers(comms *communications.Communications) {
+	m.commsMu.Lock()
+	m.comms = comms
+	m.commsMu.Unlock()
+}
+
+// RegisterCommand adds a command which authorised users can send to the
+// relayers. Commands are kept when the relayers are replaced by a config
+// update
+func (m *CommunicationManager) RegisterCommand(name string, h base.CommandHandler) error {
+	if m == nil {
+		return fmt.Errorf("communications manager server %w", ErrNilSubsystem)
+	}
+	m.commsMu.Lock()
+	defer m.commsMu.Unlock()
+	if err := m.comms.RegisterCommand(name, h); err != nil {
+		return err
+	}
+	if m.commands == nil {
+		m.commands = make(map[string]base.CommandHandler)
+	}
+	m.commands[name] = h
+	return nil
+}
+
 // Stop attempts to shutdown the subsystem
 func (m *CommunicationManager) Stop() error {
 	if m == nil {
@@ -106,7 +171,9 @@ func (m *CommunicationManager) run() {
 	for {
 		select {
 		case msg := <-m.relayMsg:
+			m.commsMu.RLock()
 			m.comms.PushEvent(msg)
+			m.commsMu.RUnlock()
 		case <-m.shutdown:
 			return
 		}
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/thrasher-corp/gocryptotrader/communications"
 	"github.com/thrasher-corp/gocryptotrader/communications/base"
 )
@@ -130,3 +131,33 @@ func TestPushEvent(t *testing.T) {
 	m = nil
 	m.PushEvent(base.Event{})
 }
+
+func TestCommunicationManagerUpdateConfig(t *testing.T) {
+	t.Parallel()
+	var m *CommunicationManager
+	assert.ErrorIs(t, m.UpdateConfig(&base.CommunicationsConfig{}), ErrNilSubsystem)
+
+	m, err := SetupCommunicationManager(&base.CommunicationsConfig{SlackConfig: base.SlackConfig{Enabled: true}})
+	require.NoError(t, err, "SetupCommunicationManager must not error")
+	assert.ErrorIs(t, m.UpdateConfig(nil), errNilConfig)
+	assert.ErrorIs(t, m.UpdateConfig(&base.CommunicationsConfig{}), communications.ErrNoRelayersEnabled)
+
+	require.NoError(t, m.UpdateConfig(&base.CommunicationsConfig{SMSGlobalConfig: base.SMSGlobalConfig{Name: "SMSGlobal", Enabled: true}}), "UpdateConfig must not error")
+	require.Len(t, m.comms.IComm, 1, "UpdateConfig must replace the relayers")
+	assert.Equal(t, "SMSGlobal", m.comms.IComm[0].GetName(), "UpdateConfig should relay via the updated config")
+}
+
+func TestCommunicationManagerRegisterCommand(t *testing.T) {
+	t.Parallel()
+	var m *CommunicationManager
+	assert.ErrorIs(t, m.RegisterCommand("test", nil), ErrNilSubsystem)
+
+	m, err := SetupCommunicationManager(&base.CommunicationsConfig{SMSGlobalConfig: base.SMSGlobalConfig{Name: "SMSGlobal", Enabled: true}})
+	require.NoError(t, err, "SetupCommunicationManager must not error")
+	require.NoError(t, m.RegisterCommand("test", func(string, []string) (string, error) { return "ok", nil }), "RegisterCommand must not error")
+
+	require.NoError(t, m.UpdateConfig(&base.CommunicationsConfig{SMSGlobalConfig: base.SMSGlobalConfig{Name: "SMSGlobal", Enabled: true}}), "UpdateConfig must not error")
+	sms, ok := m.comms.IComm[0].(interface{ GetCommands() []string })
+	require.True(t, ok, "relayer must expose its commands")
+	assert.Equal(t, []string{"test"}, sms.GetCommands(), "UpdateConfig should register commands with the new relayers")
+}
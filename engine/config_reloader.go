@@ -0,0 +1,371 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"reflect"
+	"slices"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// SetupConfigReloader creates a config reloader which calls reload each time
+// the config file at path is modified
+func SetupConfigReloader(cfg *config.ConfigReload, path string, reload func(context.Context) (*ConfigReloadResult, error)) (*ConfigReloader, error) {
+	if cfg == nil {
+		return nil, errNilConfigReloadConfig
+	}
+	if path == "" {
+		return nil, errConfigFileNotSet
+	}
+	if reload == nil {
+		return nil, errNilReloadFunc
+	}
+	if cfg.CheckInterval <= 0 {
+		return nil, fmt.Errorf("%w: %v", errInvalidCheckInterval, cfg.CheckInterval)
+	}
+	return &ConfigReloader{
+		path:     path,
+		interval: cfg.CheckInterval,
+		reload:   reload,
+		shutdown: make(chan struct{}),
+	}, nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (m *ConfigReloader) IsRunning() bool {
+	return m != nil && m.started.Load()
+}
+
+// Start runs the subsystem
+func (m *ConfigReloader) Start(ctx context.Context) error {
+	if m == nil {
+		return fmt.Errorf("%s %w", ConfigReloaderName, ErrNilSubsystem)
+	}
+	if !m.started.CompareAndSwap(false, true) {
+		return fmt.Errorf("%s %w", ConfigReloaderName, ErrSubSystemAlreadyStarted)
+	}
+	fi, err := os.Stat(m.path)
+	if err != nil {
+		m.started.Store(false)
+		return err
+	}
+	m.modTime = fi.ModTime()
+	m.shutdown = make(chan struct{})
+	m.wg.Add(1)
+	go m.run(ctx)
+	log.Debugf(log.Global, "%s started, watching %s", ConfigReloaderName, m.path)
+	return nil
+}
+
+// Stop attempts to shutdown the subsystem
+func (m *ConfigReloader) Stop() error {
+	if m == nil {
+		return fmt.Errorf("%s %w", ConfigReloaderName, ErrNilSubsystem)
+	}
+	if !m.started.CompareAndSwap(true, false) {
+		return fmt.Errorf("%s %w", ConfigReloaderName, ErrSubSystemNotStarted)
+	}
+	close(m.shutdown)
+	m.wg.Wait()
+	log.Debugf(log.Global, "%s stopped", ConfigReloaderName)
+	return nil
+}
+
+func (m *ConfigReloader) run(ctx context.Context) {
+	defer m.wg.Done()
+	t := time.NewTicker(m.interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-m.shutdown:
+			return
+		case <-ctx.Done():
+			return
+		case <-t.C:
+			m.check(ctx)
+		}
+	}
+}
+
+// check reloads the config if the config file has been modified since it was
+// last checked
+func (m *ConfigReloader) check(ctx context.Context) {
+	fi, err := os.Stat(m.path)
+	if err != nil {
+		log.Errorf(log.Global, "%s unable to stat %s: %v", ConfigReloaderName, m.path, err)
+		return
+	}
+	if fi.ModTime().Equal(m.modTime) {
+		return
+	}
+	m.modTime = fi.ModTime()
+	result, err := m.reload(ctx)
+	if err != nil {
+		log.Errorf(log.Global, "%s unable to reload %s: %v", ConfigReloaderName, m.path, err)
+		return
+	}
+	logConfigReloadResult(result)
+}
+
+func logConfigReloadResult(r *ConfigReloadResult) {
+	if len(r.Exchanges) == 0 && len(r.Subsystems) == 0 && len(r.Ignored) == 0 {
+		log.Infoln(log.Global, "Config reloaded, no changes found")
+		return
+	}
+	for _, name := range r.Exchanges {
+		log.Infof(log.Global, "Config reloaded, %s enabled pairs updated", name)
+	}
+	for _, name := range r.Subsystems {
+		log.Infof(log.Global, "Config reloaded, %s reconfigured", name)
+	}
+	for _, change := range r.Ignored {
+		log.Warnf(log.Global, "Config reloaded, %s requires a restart to apply", change)
+	}
+}
+
+// ReloadConfig reads the config file and applies the changes it holds for
+// exchange enabled pairs, the sync manager, communications and order manager
+// limits to the running engine. Each change is validated before any is
+// applied, so a rejected reload leaves the engine unchanged. Changes which
+// require an engine restart are reported but not applied
+func (bot *Engine) ReloadConfig(ctx context.Context) (*ConfigReloadResult, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	bot.reloadMu.Lock()
+	defer bot.reloadMu.Unlock()
+
+	candidate, err := bot.Config.ReadReloadConfig(bot.Settings.ConfigFile)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errConfigReloadRejected, err)
+	}
+	plan, err := bot.planConfigReload(candidate)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errConfigReloadRejected, err)
+	}
+	bot.applyConfigReload(plan)
+	return &plan.result, nil
+}
+
+// planConfigReload diffs the candidate config against the running config and
+// validates every change which can be applied without a restart
+func (bot *Engine) planConfigReload(candidate *config.Config) (*configReloadPlan, error) {
+	plan := &configReloadPlan{candidate: candidate, settings: bot.Settings}
+
+	if err := bot.planExchangePairs(plan); err != nil {
+		return nil, err
+	}
+
+	syncChanged := !reflect.DeepEqual(bot.Config.SyncManagerConfig, candidate.SyncManagerConfig)
+	if syncChanged {
+		bot.flagSet.WithBool("tickersync", &plan.settings.EnableTickerSyncing, candidate.SyncManagerConfig.SynchronizeTicker)
+		bot.flagSet.WithBool("orderbooksync", &plan.settings.EnableOrderbookSyncing, candidate.SyncManagerConfig.SynchronizeOrderbook)
+		bot.flagSet.WithBool("tradesync", &plan.settings.EnableTradeSyncing, candidate.SyncManagerConfig.SynchronizeTrades)
+		bot.flagSet.WithBool("synccontinuously", &plan.settings.SyncContinuously, candidate.SyncManagerConfig.SynchronizeContinuously)
+	}
+	if bot.currencyPairSyncer != nil && (syncChanged || len(plan.pairs) > 0) {
+		cfg := syncManagerConfig(candidate.SyncManagerConfig, &plan.settings)
+		if err := checkSyncManagerConfig(&cfg); err != nil {
+			return nil, fmt.Errorf("%s: %w", SyncManagerName, err)
+		}
+		plan.syncManager = &cfg
+		plan.result.Subsystems = append(plan.result.Subsystems, SyncManagerName)
+	}
+
+	if !reflect.DeepEqual(bot.Config.Communications, candidate.Communications) {
+		plan.communications = true
+		if bot.CommunicationsManager.IsRunning() {
+			relayers, err := bot.CommunicationsManager.newRelayers(&candidate.Communications)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", CommunicationsManagerName, err)
+			}
+			plan.relayers = relayers
+			plan.result.Subsystems = append(plan.result.Subsystems, CommunicationsManagerName)
+		}
+	}
+
+	running, err := newOrderManagerConfig(&bot.Config.OrderManager)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", OrderManagerName, err)
+	}
+	updated, err := newOrderManagerConfig(&candidate.OrderManager)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", OrderManagerName, err)
+	}
+	if !reflect.DeepEqual(running, updated) {
+		plan.orderManager = true
+		plan.orderManagerConfig = updated
+		if bot.OrderManager != nil {
+			plan.result.Subsystems = append(plan.result.Subsystems, OrderManagerName)
+		}
+	}
+
+	if !reflect.DeepEqual(bot.Config.WithdrawManager, candidate.WithdrawManager) {
+		plan.withdrawManager = true
+		if bot.WithdrawManager != nil {
+			plan.result.Subsystems = append(plan.result.Subsystems, WithdrawManagerName)
+		}
+	}
+
+	if !reflect.DeepEqual(bot.Config.RemoteControl, candidate.RemoteControl) {
+		plan.result.Ignored = append(plan.result.Ignored, "remoteControl")
+	}
+	if !reflect.DeepEqual(bot.Config.Database, candidate.Database) {
+		plan.result.Ignored = append(plan.result.Ignored, "database")
+	}
+	if !reflect.DeepEqual(bot.Config.BankAccounts, candidate.BankAccounts) {
+		plan.result.Ignored = append(plan.result.Ignored, "bankAccounts")
+	}
+	if !reflect.DeepEqual(bot.Config.Currency, candidate.Currency) {
+		plan.result.Ignored = append(plan.result.Ignored, "currencyConfig")
+	}
+	return plan, nil
+}
+
+// planExchangePairs finds the loaded exchanges whose enabled pairs differ from
+// the candidate config and checks the new pairs are available
+func (bot *Engine) planExchangePairs(plan *configReloadPlan) error {
+	var loaded []string
+	if bot.ExchangeManager != nil {
+		exchanges, err := bot.ExchangeManager.GetExchanges()
+		if err != nil {
+			return err
+		}
+		for _, exch := range exchanges {
+			name := exch.GetName()
+			loaded = append(loaded, name)
+			exchCfg, err := bot.Config.GetExchangeConfig(name)
+			if err != nil {
+				return err
+			}
+			newCfg, err := plan.candidate.GetExchangeConfig(name)
+			if err != nil {
+				plan.result.Ignored = append(plan.result.Ignored, "removal of exchange "+name)
+				continue
+			}
+			if !newCfg.Enabled {
+				plan.result.Ignored = append(plan.result.Ignored, "disabling exchange "+name)
+				continue
+			}
+			reload := exchangePairsReload{exch: exch, cfg: exchCfg, pairs: make(map[asset.Item]currency.Pairs)}
+			base := exch.GetBase()
+			for _, a := range exchCfg.CurrencyPairs.GetAssetTypes(false) {
+				oldPairs, err := exchCfg.CurrencyPairs.GetPairs(a, true)
+				if err != nil {
+					return err
+				}
+				newPairs, err := newCfg.CurrencyPairs.GetPairs(a, true)
+				if err != nil {
+					return fmt.Errorf("%s %s: %w", name, a, err)
+				}
+				if oldPairs.Equal(newPairs) {
+					continue
+				}
+				if !a.IsValid() {
+					return fmt.Errorf("%s %s: %w", name, a, asset.ErrNotSupported)
+				}
+				if len(newPairs) > 0 {
+					avail, err := base.CurrencyPairs.GetPairs(a, false)
+					if err != nil {
+						return fmt.Errorf("%s %s: %w", name, a, err)
+					}
+					if err := avail.ContainsAll(newPairs, true); err != nil {
+						return fmt.Errorf("%s %s: %w", name, a, err)
+					}
+				}
+				reload.pairs[a] = newPairs
+			}
+			if len(reload.pairs) > 0 {
+				plan.pairs = append(plan.pairs, reload)
+				plan.result.Exchanges = append(plan.result.Exchanges, name)
+			}
+		}
+	}
+	for i := range plan.candidate.Exchanges {
+		e := &plan.candidate.Exchanges[i]
+		if !e.Enabled || slices.Contains(loaded, e.Name) {
+			continue
+		}
+		if exchCfg, err := bot.Config.GetExchangeConfig(e.Name); err != nil || !exchCfg.Enabled {
+			plan.result.Ignored = append(plan.result.Ignored, "enabling exchange "+e.Name)
+		}
+	}
+	return nil
+}
+
+// applyConfigReload applies a validated reload plan to the running engine.
+// Every change has been validated and built while planning, so only runtime
+// side effects such as refreshing websocket subscriptions or restarting the
+// sync manager can fail, and those are logged rather than undoing the reload
+func (bot *Engine) applyConfigReload(plan *configReloadPlan) {
+	for i := range plan.pairs {
+		applyExchangePairs(&plan.pairs[i])
+	}
+
+	bot.Config.SyncManagerConfig = plan.candidate.SyncManagerConfig
+	bot.Settings.ExchangeSyncerSettings = plan.settings.ExchangeSyncerSettings
+	if plan.syncManager != nil {
+		wasRunning := bot.currencyPairSyncer.IsRunning()
+		if wasRunning {
+			if err := bot.currencyPairSyncer.Stop(); err != nil {
+				log.Errorf(log.Global, "%s unable to stop %s: %v", ConfigReloaderName, SyncManagerName, err)
+			}
+		}
+		bot.currencyPairSyncer.setConfig(plan.syncManager)
+		if wasRunning {
+			if err := bot.currencyPairSyncer.Start(bot.EnsureRuntimeContext()); err != nil {
+				log.Errorf(log.Global, "%s unable to restart %s: %v", ConfigReloaderName, SyncManagerName, err)
+			}
+		}
+	}
+
+	if plan.communications {
+		bot.Config.Communications = plan.candidate.Communications
+		if plan.relayers != nil {
+			bot.CommunicationsManager.setRelayers(plan.relayers)
+		}
+	}
+
+	if plan.orderManager {
+		om := &bot.Config.OrderManager
+		om.EnforceLimits = plan.candidate.OrderManager.EnforceLimits
+		om.AllowMarketOrders = plan.candidate.OrderManager.AllowMarketOrders
+		om.LimitAmount = plan.candidate.OrderManager.LimitAmount
+		om.AllowedPairs = plan.candidate.OrderManager.AllowedPairs
+		om.AllowedExchanges = plan.candidate.OrderManager.AllowedExchanges
+		om.CancelOrdersOnShutdown = plan.candidate.OrderManager.CancelOrdersOnShutdown
+		if bot.OrderManager != nil {
+			bot.OrderManager.setConfig(plan.orderManagerConfig)
+		}
+	}
+
+	if plan.withdrawManager {
+		bot.Config.WithdrawManager = plan.candidate.WithdrawManager
+		if bot.WithdrawManager != nil {
+			bot.WithdrawManager.setConfig(&bot.Config.WithdrawManager)
+		}
+	}
+}
+
+// applyExchangePairs stores the new enabled pairs of an exchange in both the
+// config and the exchange, then refreshes its websocket subscriptions
+func applyExchangePairs(r *exchangePairsReload) {
+	name := r.exch.GetName()
+	base := r.exch.GetBase()
+	for a, pairs := range r.pairs {
+		// Assets are validated while planning, so storing the pairs cannot fail
+		_ = r.cfg.CurrencyPairs.StorePairs(a, pairs, true)
+		_ = base.CurrencyPairs.StorePairs(a, pairs, true)
+	}
+	if r.exch.IsWebsocketEnabled() && base.Websocket.IsConnected() {
+		if err := r.exch.FlushWebsocketChannels(); err != nil {
+			log.Errorf(log.Global, "%s unable to refresh %s websocket subscriptions: %v", ConfigReloaderName, name, err)
+		}
+	}
+}
@@ -0,0 +1,272 @@
+package engine
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/communications"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/banking"
+)
+
+func TestSetupConfigReloader(t *testing.T) {
+	t.Parallel()
+	reload := func(context.Context) (*ConfigReloadResult, error) { return &ConfigReloadResult{}, nil }
+
+	_, err := SetupConfigReloader(nil, "", nil)
+	assert.ErrorIs(t, err, errNilConfigReloadConfig)
+
+	_, err = SetupConfigReloader(&config.ConfigReload{}, "", nil)
+	assert.ErrorIs(t, err, errConfigFileNotSet)
+
+	_, err = SetupConfigReloader(&config.ConfigReload{}, config.TestFile, nil)
+	assert.ErrorIs(t, err, errNilReloadFunc)
+
+	_, err = SetupConfigReloader(&config.ConfigReload{}, config.TestFile, reload)
+	assert.ErrorIs(t, err, errInvalidCheckInterval)
+
+	m, err := SetupConfigReloader(&config.ConfigReload{CheckInterval: time.Second}, config.TestFile, reload)
+	require.NoError(t, err, "SetupConfigReloader must not error")
+	assert.Equal(t, time.Second, m.interval, "interval should be set")
+}
+
+func TestConfigReloaderStartStop(t *testing.T) {
+	t.Parallel()
+	var m *ConfigReloader
+	assert.ErrorIs(t, m.Start(t.Context()), ErrNilSubsystem)
+	assert.ErrorIs(t, m.Stop(), ErrNilSubsystem)
+	assert.False(t, m.IsRunning(), "IsRunning should return false for a nil subsystem")
+
+	reload := func(context.Context) (*ConfigReloadResult, error) { return &ConfigReloadResult{}, nil }
+	m, err := SetupConfigReloader(&config.ConfigReload{CheckInterval: time.Hour}, filepath.Join(t.TempDir(), "missing.json"), reload)
+	require.NoError(t, err, "SetupConfigReloader must not error")
+	assert.ErrorIs(t, m.Start(t.Context()), os.ErrNotExist)
+	assert.False(t, m.IsRunning(), "IsRunning should return false after a failed start")
+
+	m.path = config.TestFile
+	require.NoError(t, m.Start(t.Context()), "Start must not error")
+	assert.True(t, m.IsRunning(), "IsRunning should return true")
+	assert.ErrorIs(t, m.Start(t.Context()), ErrSubSystemAlreadyStarted)
+
+	require.NoError(t, m.Stop(), "Stop must not error")
+	assert.ErrorIs(t, m.Stop(), ErrSubSystemNotStarted)
+}
+
+func TestConfigReloaderCheck(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "config.json")
+	require.NoError(t, os.WriteFile(path, []byte("{}"), 0o600), "WriteFile must not error")
+
+	var calls int
+	reload := func(context.Context) (*ConfigReloadResult, error) {
+		calls++
+		return &ConfigReloadResult{}, nil
+	}
+	m, err := SetupConfigReloader(&config.ConfigReload{CheckInterval: time.Hour}, path, reload)
+	require.NoError(t, err, "SetupConfigReloader must not error")
+	require.NoError(t, m.Start(t.Context()), "Start must not error")
+	t.Cleanup(func() { assert.NoError(t, m.Stop(), "Stop should not error") })
+
+	m.check(t.Context())
+	assert.Zero(t, calls, "check should not reload an unmodified config")
+
+	modified := time.Now().Add(time.Minute)
+	require.NoError(t, os.Chtimes(path, modified, modified), "Chtimes must not error")
+	m.check(t.Context())
+	assert.Equal(t, 1, calls, "check should reload a modified config")
+
+	m.check(t.Context())
+	assert.Equal(t, 1, calls, "check should only reload once per modification")
+}
+
+// configReloadTestEngine returns an engine running from a copy of the test
+// config with Bitstamp loaded and the order and sync managers set up
+func configReloadTestEngine(t *testing.T) (bot *Engine, path string) {
+	t.Helper()
+	path = filepath.Join(t.TempDir(), "config.json")
+	data, err := os.ReadFile(config.TestFile)
+	require.NoError(t, err, "ReadFile must not error")
+	require.NoError(t, os.WriteFile(path, data, 0o600), "WriteFile must not error")
+
+	bot = &Engine{
+		Config: &config.Config{},
+		Settings: Settings{
+			ConfigFile: path,
+			ExchangeSyncerSettings: ExchangeSyncerSettings{
+				EnableTickerSyncing:  true,
+				SyncWorkersCount:     config.DefaultSyncerWorkers,
+				SyncTimeoutREST:      config.DefaultSyncerTimeoutREST,
+				SyncTimeoutWebsocket: config.DefaultSyncerTimeoutWebsocket,
+			},
+		},
+	}
+	require.NoError(t, bot.Config.ReadConfigFromFile(path, true), "ReadConfigFromFile must not error")
+	bot.Config.DataDirectory = t.TempDir()
+	require.NoError(t, bot.Config.CheckConfig(), "CheckConfig must not error")
+
+	bot.ExchangeManager = NewExchangeManager()
+	exch, err := bot.ExchangeManager.NewExchangeByName(testExchange)
+	require.NoError(t, err, "NewExchangeByName must not error")
+	exch.SetDefaults()
+	exchCfg, err := bot.Config.GetExchangeConfig(testExchange)
+	require.NoError(t, err, "GetExchangeConfig must not error")
+	exch.GetBase().CurrencyPairs.Load(exchCfg.CurrencyPairs)
+	require.NoError(t, bot.ExchangeManager.Add(exch), "Add must not error")
+
+	var wg sync.WaitGroup
+	bot.OrderManager, err = SetupOrderManager(bot.ExchangeManager, &CommunicationManager{}, &wg, &bot.Config.OrderManager)
+	require.NoError(t, err, "SetupOrderManager must not error")
+
+	bot.WithdrawManager, err = SetupWithdrawManager(bot.ExchangeManager, nil, false)
+	require.NoError(t, err, "SetupWithdrawManager must not error")
+	require.NoError(t, bot.WithdrawManager.UpdateConfig(&bot.Config.WithdrawManager), "UpdateConfig must not error")
+
+	cfg := syncManagerConfig(bot.Config.SyncManagerConfig, &bot.Settings)
+	bot.currencyPairSyncer, err = SetupSyncManager(&cfg, bot.ExchangeManager, &bot.Config.RemoteControl, false)
+	require.NoError(t, err, "SetupSyncManager must not error")
+	return bot, path
+}
+
+func TestReloadConfig(t *testing.T) {
+	t.Parallel()
+	bot, path := configReloadTestEngine(t)
+
+	result, err := bot.ReloadConfig(t.Context())
+	require.NoError(t, err, "ReloadConfig must not error")
+	assert.Equal(t, &ConfigReloadResult{}, result, "ReloadConfig should find no changes to an unmodified config")
+
+	update := &config.Config{}
+	require.NoError(t, update.ReadConfigFromFile(path, true), "ReadConfigFromFile must not error")
+	exchCfg, err := update.GetExchangeConfig(testExchange)
+	require.NoError(t, err, "GetExchangeConfig must not error")
+	pairs := currency.Pairs{currency.NewPairWithDelimiter("BTC", "USD", "/"), currency.NewPairWithDelimiter("ETH", "USD", "/")}
+	require.NoError(t, exchCfg.CurrencyPairs.StorePairs(asset.Spot, pairs, true), "StorePairs must not error")
+	update.OrderManager.EnforceLimits = true
+	update.OrderManager.LimitAmount = 5
+	update.SyncManagerConfig.NumWorkers = 7
+	update.WithdrawManager.RequiredApprovals = 2
+	update.WithdrawManager.Approvers = []config.WithdrawApprover{{Name: "alice", Token: "a"}, {Name: "bob", Token: "b"}}
+	update.Database.Verbose = true
+	require.NoError(t, update.SaveConfigToFile(path), "SaveConfigToFile must not error")
+
+	result, err = bot.ReloadConfig(t.Context())
+	require.NoError(t, err, "ReloadConfig must not error")
+	assert.Equal(t, []string{testExchange}, result.Exchanges, "Exchanges should list the exchange with updated pairs")
+	assert.ElementsMatch(t, []string{SyncManagerName, OrderManagerName, WithdrawManagerName}, result.Subsystems, "Subsystems should list the reconfigured subsystems")
+	assert.Equal(t, []string{"database"}, result.Ignored, "Ignored should list the changes requiring a restart")
+
+	exch, err := bot.ExchangeManager.GetExchangeByName(testExchange)
+	require.NoError(t, err, "GetExchangeByName must not error")
+	enabled, err := exch.GetEnabledPairs(asset.Spot)
+	require.NoError(t, err, "GetEnabledPairs must not error")
+	assert.True(t, enabled.Equal(pairs), "exchange enabled pairs should be updated")
+	assert.Equal(t, 7, bot.Config.SyncManagerConfig.NumWorkers, "running config should be updated")
+	assert.Equal(t, 7, bot.currencyPairSyncer.config.NumWorkers, "sync manager config should be updated")
+	assert.True(t, bot.OrderManager.getConfig().EnforceLimitConfig, "order manager limits should be updated")
+	assert.Equal(t, 2, bot.WithdrawManager.cfg.RequiredApprovals, "withdraw manager safeguards should be updated")
+	assert.False(t, bot.Config.Database.Verbose, "changes requiring a restart should not be applied")
+
+	require.NoError(t, exchCfg.CurrencyPairs.StorePairs(asset.Spot, pairs[:1], true), "StorePairs must not error")
+	update.OrderManager.LimitAmount = -1
+	require.NoError(t, update.SaveConfigToFile(path), "SaveConfigToFile must not error")
+
+	_, err = bot.ReloadConfig(t.Context())
+	assert.ErrorIs(t, err, errConfigReloadRejected)
+	assert.ErrorIs(t, err, errInvalidOrderLimitAmount)
+	enabled, err = exch.GetEnabledPairs(asset.Spot)
+	require.NoError(t, err, "GetEnabledPairs must not error")
+	assert.True(t, enabled.Equal(pairs), "a rejected reload should not update exchange pairs")
+	assert.Equal(t, 5.0, bot.OrderManager.getConfig().LimitAmount, "a rejected reload should not update order manager limits")
+
+	update.OrderManager.LimitAmount = 5
+	update.WithdrawManager.Limits = []config.WithdrawLimit{{Currency: currency.BTC, Daily: -1}}
+	require.NoError(t, update.SaveConfigToFile(path), "SaveConfigToFile must not error")
+	_, err = bot.ReloadConfig(t.Context())
+	assert.ErrorIs(t, err, errConfigReloadRejected, "ReloadConfig should reject an invalid withdrawal limit")
+	assert.Empty(t, bot.WithdrawManager.cfg.Limits, "a rejected reload should not update withdrawal limits")
+}
+
+// TestReloadConfigGlobals is not parallel as it checks the global database
+// config and bank accounts, which other tests set when checking configs
+func TestReloadConfigGlobals(t *testing.T) {
+	bot, path := configReloadTestEngine(t)
+	dbCfg := database.DB.GetConfig()
+	t.Cleanup(func() {
+		assert.NoError(t, database.DB.SetConfig(dbCfg), "SetConfig should not error")
+		banking.SetAccounts(bot.Config.BankAccounts...)
+	})
+	running := &database.Config{Driver: database.DBSQLite3, ConnectionDetails: drivers.ConnectionDetails{Database: "running.db"}}
+	require.NoError(t, database.DB.SetConfig(running), "SetConfig must not error")
+	banking.SetAccounts(banking.Account{ID: "running", Enabled: true})
+
+	update := &config.Config{}
+	require.NoError(t, update.ReadConfigFromFile(path, true), "ReadConfigFromFile must not error")
+	update.Database = database.Config{Enabled: true, Driver: database.DBSQLite3, ConnectionDetails: drivers.ConnectionDetails{Database: "candidate.db"}}
+	update.BankAccounts = []banking.Account{{ID: "candidate"}}
+	update.OrderManager.LimitAmount = -1
+	require.NoError(t, update.SaveConfigToFile(path), "SaveConfigToFile must not error")
+
+	_, err := bot.ReloadConfig(t.Context())
+	require.ErrorIs(t, err, errConfigReloadRejected, "ReloadConfig must reject the invalid order manager limit")
+	assert.Same(t, running, database.DB.GetConfig(), "a rejected reload should not change the database config")
+	_, err = banking.GetBankAccountByID("running")
+	assert.NoError(t, err, "a rejected reload should keep the running bank accounts")
+	_, err = banking.GetBankAccountByID("candidate")
+	assert.Error(t, err, "a rejected reload should not set the candidate bank accounts")
+
+	update.OrderManager.LimitAmount = 0
+	require.NoError(t, update.SaveConfigToFile(path), "SaveConfigToFile must not error")
+	result, err := bot.ReloadConfig(t.Context())
+	require.NoError(t, err, "ReloadConfig must not error")
+	assert.ElementsMatch(t, []string{"database", "bankAccounts"}, result.Ignored, "Ignored should list the database and bank account changes")
+	assert.Same(t, running, database.DB.GetConfig(), "database changes requiring a restart should not be applied")
+	_, err = banking.GetBankAccountByID("candidate")
+	assert.Error(t, err, "bank account changes requiring a restart should not be applied")
+}
+
+func TestReloadConfigCommunications(t *testing.T) {
+	t.Parallel()
+	bot, path := configReloadTestEngine(t)
+	var err error
+	bot.CommunicationsManager, err = SetupCommunicationManager(&base.CommunicationsConfig{SMSGlobalConfig: base.SMSGlobalConfig{Name: "SMSGlobal", Enabled: true}})
+	require.NoError(t, err, "SetupCommunicationManager must not error")
+	bot.CommunicationsManager.started.Store(true)
+
+	update := &config.Config{}
+	require.NoError(t, update.ReadConfigFromFile(path, true), "ReadConfigFromFile must not error")
+	update.Communications = base.CommunicationsConfig{SlackConfig: base.SlackConfig{Name: "Slack", Enabled: true, TargetChannel: "general", VerificationToken: "token"}}
+	require.NoError(t, update.SaveConfigToFile(path), "SaveConfigToFile must not error")
+
+	result, err := bot.ReloadConfig(t.Context())
+	require.NoError(t, err, "ReloadConfig must not error")
+	assert.Equal(t, []string{CommunicationsManagerName}, result.Subsystems, "Subsystems should list the communications manager")
+	require.Len(t, bot.CommunicationsManager.comms.IComm, 1, "ReloadConfig must replace the relayers")
+	assert.Equal(t, "Slack", bot.CommunicationsManager.comms.IComm[0].GetName(), "ReloadConfig should relay via the updated config")
+
+	exchCfg, err := update.GetExchangeConfig(testExchange)
+	require.NoError(t, err, "GetExchangeConfig must not error")
+	require.NoError(t, exchCfg.CurrencyPairs.StorePairs(asset.Spot, currency.Pairs{currency.NewPairWithDelimiter("ETH", "USD", "/")}, true), "StorePairs must not error")
+	update.Communications = base.CommunicationsConfig{}
+	require.NoError(t, update.SaveConfigToFile(path), "SaveConfigToFile must not error")
+
+	_, err = bot.ReloadConfig(t.Context())
+	assert.ErrorIs(t, err, errConfigReloadRejected)
+	assert.ErrorIs(t, err, communications.ErrNoRelayersEnabled)
+	assert.Equal(t, "Slack", bot.CommunicationsManager.comms.IComm[0].GetName(), "a rejected reload should not replace the relayers")
+	exch, err := bot.ExchangeManager.GetExchangeByName(testExchange)
+	require.NoError(t, err, "GetExchangeByName must not error")
+	enabled, err := exch.GetEnabledPairs(asset.Spot)
+	require.NoError(t, err, "GetEnabledPairs must not error")
+	assert.False(t, enabled.Contains(currency.NewPairWithDelimiter("ETH", "USD", "/"), true), "a rejected reload should not update exchange pairs")
+}
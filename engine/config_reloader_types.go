@@ -0,0 +1,71 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/communications"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+// ConfigReloaderName is an exported subsystem name
+const ConfigReloaderName = "config_reloader"
+
+var (
+	errNilConfigReloadConfig = errors.New("nil config reload config received")
+	errConfigFileNotSet      = errors.New("config file not set")
+	errNilReloadFunc         = errors.New("nil config reload func received")
+	errInvalidCheckInterval  = errors.New("invalid config reload check interval")
+	errConfigReloadRejected  = errors.New("config reload rejected")
+)
+
+// ConfigReloader watches the config file and reloads it into the running
+// engine whenever it is modified
+type ConfigReloader struct {
+	started  atomic.Bool
+	shutdown chan struct{}
+	wg       sync.WaitGroup
+	path     string
+	interval time.Duration
+	modTime  time.Time
+	reload   func(context.Context) (*ConfigReloadResult, error)
+}
+
+// ConfigReloadResult lists what a config reload changed in the running engine
+type ConfigReloadResult struct {
+	// Exchanges holds the exchanges whose enabled pairs were updated
+	Exchanges []string
+	// Subsystems holds the subsystems which were reconfigured
+	Subsystems []string
+	// Ignored holds the changes which require an engine restart to apply
+	Ignored []string
+}
+
+// configReloadPlan holds the validated changes of a config reload, so that
+// nothing is applied unless every change is accepted. Anything which can fail,
+// such as building the new communication relayers, is done while planning
+type configReloadPlan struct {
+	candidate          *config.Config
+	pairs              []exchangePairsReload
+	settings           Settings
+	syncManager        *config.SyncManagerConfig
+	communications     bool
+	relayers           *communications.Communications
+	orderManager       bool
+	orderManagerConfig orderManagerConfig
+	withdrawManager    bool
+	result             ConfigReloadResult
+}
+
+// exchangePairsReload holds the newly enabled pairs of a loaded exchange
+type exchangePairsReload struct {
+	exch  exchange.IBotExchange
+	cfg   *config.Exchange
+	pairs map[asset.Item]currency.Pairs
+}
@@ -15,6 +15,7 @@ import (
 
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/config/secrets"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/database"
 	"github.com/thrasher-corp/gocryptotrader/dispatch"
@@ -48,6 +49,16 @@ type Engine struct {
 	WithdrawManager          *WithdrawManager
 	dataHistoryManager       *DataHistoryManager
 	currencyStateManager     *CurrencyStateManager
+	candleAggregator         *CandleAggregationManager
+	metricsManager           *MetricsManager
+	microstructureManager    *MicrostructureManager
+	treasuryManager          *TreasuryManager
+	fundingTracker           *FundingTracker
+	rateLimitBackend         request.LimiterBackend
+	secretResolver           *secrets.Resolver
+	configReloader           *ConfigReloader
+	reloadMu                 sync.Mutex
+	flagSet                  FlagSet
 	Settings                 Settings
 	uptime                   time.Time
 	GRPCShutdownSignal       chan struct{}
@@ -166,9 +177,11 @@ func (f FlagSet) WithBool(key string, flagValue *bool, configValue bool) {
 // validateSettings validates and sets all bot settings
 func validateSettings(b *Engine, s *Settings, flagSet FlagSet) {
 	b.Settings = *s
+	b.flagSet = flagSet
 
 	flagSet.WithBool("coinmarketcap", &b.Settings.EnableCoinmarketcapAnalysis, b.Config.Currency.CryptocurrencyProvider.Enabled)
 	flagSet.WithBool("ordermanager", &b.Settings.EnableOrderManager, b.Config.OrderManager.Enabled)
+	flagSet.WithBool("papertrading", &b.Settings.EnablePaperTrading, b.Config.OrderManager.PaperTrading != nil && b.Config.OrderManager.PaperTrading.Enabled)
 
 	flagSet.WithBool("currencyconverter", &b.Settings.EnableCurrencyConverter, b.Config.Currency.ForexProviders.IsEnabled("currencyconverter"))
 
@@ -180,6 +193,12 @@ func validateSettings(b *Engine, s *Settings, flagSet FlagSet) {
 	flagSet.WithBool("datahistorymanager", &b.Settings.EnableDataHistoryManager, b.Config.DataHistoryManager.Enabled)
 	flagSet.WithBool("currencystatemanager", &b.Settings.EnableCurrencyStateManager, b.Config.CurrencyStateManager.Enabled != nil && *b.Config.CurrencyStateManager.Enabled)
 	flagSet.WithBool("gctscriptmanager", &b.Settings.EnableGCTScriptManager, b.Config.GCTScript.Enabled)
+	flagSet.WithBool("candleaggregator", &b.Settings.EnableCandleAggregator, b.Config.CandleAggregator.Enabled)
+	flagSet.WithBool("metrics", &b.Settings.EnableMetricsManager, b.Config.Metrics.Enabled)
+	flagSet.WithBool("microstructure", &b.Settings.EnableMicrostructureManager, b.Config.Microstructure.Enabled)
+	flagSet.WithBool("configreloader", &b.Settings.EnableConfigReloader, b.Config.ConfigReload.Enabled)
+	flagSet.WithBool("treasurymanager", &b.Settings.EnableTreasuryManager, b.Config.TreasuryManager.Enabled)
+	flagSet.WithBool("fundingtracker", &b.Settings.EnableFundingTracker, b.Config.FundingTracker.Enabled)
 
 	flagSet.WithBool("tickersync", &b.Settings.EnableTickerSyncing, b.Config.SyncManagerConfig.SynchronizeTicker)
 	flagSet.WithBool("orderbooksync", &b.Settings.EnableOrderbookSyncing, b.Config.SyncManagerConfig.SynchronizeOrderbook)
@@ -337,6 +356,16 @@ func (bot *Engine) Start() error {
 		}
 	}
 
+	// The metrics manager installs the global request and websocket reporters
+	// so must be running before exchanges are set up
+	if bot.Settings.EnableMetricsManager {
+		if err := bot.setupMetricsManager(); err != nil {
+			gctlog.Errorf(gctlog.Global, "%s unable to setup: %s", MetricsManagerName, err)
+		} else if err := bot.metricsManager.Start(); err != nil {
+			gctlog.Errorf(gctlog.Global, "%s unable to start: %s", MetricsManagerName, err)
+		}
+	}
+
 	// Sets up internet connectivity monitor
 	if bot.Settings.EnableConnectivityMonitor {
 		if c, err := setupConnectionManager(&bot.Config.ConnectionMonitor); err != nil {
@@ -390,12 +419,15 @@ func (bot *Engine) Start() error {
 	if bot.Settings.ExchangePurgeCredentials {
 		gctlog.Debugln(gctlog.Global, "Purging exchange API credentials.")
 		bot.Config.PurgeExchangeAPICredentials()
+	} else if err := bot.setupSecretResolver(runtimeCtx); err != nil {
+		return err
 	}
 
 	gctlog.Debugln(gctlog.Global, "Setting up exchanges..")
 	if err := bot.SetupExchanges(); err != nil {
 		return err
 	}
+	bot.warnReadOnlyCredentials()
 
 	if bot.Settings.EnableCommsRelayer {
 		if c, err := SetupCommunicationManager(&bot.Config.Communications); err != nil {
@@ -457,6 +489,22 @@ func (bot *Engine) Start() error {
 		return err
 	} else { //nolint:revive // TODO: revive false positive, see https://github.com/mgechev/revive/pull/832 for more information
 		bot.WithdrawManager = w
+		if err := bot.WithdrawManager.UpdateConfig(&bot.Config.WithdrawManager); err != nil {
+			return err
+		}
+		bot.WithdrawManager.prepareRequest = func(req *withdraw.Request) error {
+			return setWithdrawalSecrets(bot.Config, req)
+		}
+		if database.DB.IsConnected() {
+			if err := bot.WithdrawManager.loadWithdrawn(time.Now()); err != nil {
+				gctlog.Errorf(gctlog.Global, "Withdraw manager unable to load stored withdrawals, daily limits will not include them: %s", err)
+			}
+		}
+		if bot.CommunicationsManager != nil {
+			if err := bot.WithdrawManager.RegisterCommands(bot.CommunicationsManager); err != nil {
+				gctlog.Errorf(gctlog.Global, "Withdraw manager unable to register chat commands: %s", err)
+			}
+		}
 	}
 
 	if bot.Settings.EnableDepositAddressManager {
@@ -469,6 +517,12 @@ func (bot *Engine) Start() error {
 	}
 
 	if bot.Settings.EnableOrderManager {
+		if bot.Settings.EnablePaperTrading && bot.Config.OrderManager.PaperTrading == nil {
+			bot.Config.OrderManager.PaperTrading = &config.PaperTrading{}
+		}
+		if bot.Config.OrderManager.PaperTrading != nil {
+			bot.Config.OrderManager.PaperTrading.Enabled = bot.Settings.EnablePaperTrading
+		}
 		if o, err := SetupOrderManager(
 			bot.ExchangeManager,
 			bot.CommunicationsManager,
@@ -477,6 +531,9 @@ func (bot *Engine) Start() error {
 			gctlog.Errorf(gctlog.Global, "Order manager unable to setup: %s", err)
 		} else {
 			bot.OrderManager = o
+			if bot.metricsManager != nil {
+				bot.OrderManager.reporter = bot.metricsManager
+			}
 			if err = bot.OrderManager.Start(runtimeCtx); err != nil {
 				gctlog.Errorf(gctlog.Global, "Order manager unable to start: %s", err)
 			}
@@ -484,25 +541,7 @@ func (bot *Engine) Start() error {
 	}
 
 	if bot.Settings.EnableExchangeSyncManager {
-		cfg := bot.Config.SyncManagerConfig
-		cfg.SynchronizeTicker = bot.Settings.EnableTickerSyncing
-		cfg.SynchronizeOrderbook = bot.Settings.EnableOrderbookSyncing
-		cfg.SynchronizeContinuously = bot.Settings.SyncContinuously
-		cfg.SynchronizeTrades = bot.Settings.EnableTradeSyncing
-		cfg.Verbose = bot.Settings.Verbose || cfg.Verbose
-
-		if cfg.TimeoutREST != bot.Settings.SyncTimeoutREST &&
-			bot.Settings.SyncTimeoutREST != config.DefaultSyncerTimeoutREST {
-			cfg.TimeoutREST = bot.Settings.SyncTimeoutREST
-		}
-		if cfg.TimeoutWebsocket != bot.Settings.SyncTimeoutWebsocket &&
-			bot.Settings.SyncTimeoutWebsocket != config.DefaultSyncerTimeoutWebsocket {
-			cfg.TimeoutWebsocket = bot.Settings.SyncTimeoutWebsocket
-		}
-		if cfg.NumWorkers != bot.Settings.SyncWorkersCount &&
-			bot.Settings.SyncWorkersCount != config.DefaultSyncerWorkers {
-			cfg.NumWorkers = bot.Settings.SyncWorkersCount
-		}
+		cfg := syncManagerConfig(bot.Config.SyncManagerConfig, &bot.Settings)
 		if s, err := SetupSyncManager(
 			&cfg,
 			bot.ExchangeManager,
@@ -542,6 +581,30 @@ func (bot *Engine) Start() error {
 		}
 	}
 
+	if bot.Settings.EnableCandleAggregator {
+		if err := bot.setupCandleAggregationManager(); err != nil {
+			gctlog.Errorf(gctlog.Global, "%s unable to setup: %s", CandleAggregationManagerName, err)
+		} else if err := bot.candleAggregator.Start(); err != nil {
+			gctlog.Errorf(gctlog.Global, "%s unable to start: %s", CandleAggregationManagerName, err)
+		}
+	}
+
+	if bot.Settings.EnableMicrostructureManager {
+		if err := bot.setupMicrostructureManager(); err != nil {
+			gctlog.Errorf(gctlog.Global, "%s unable to setup: %s", MicrostructureManagerName, err)
+		} else if err := bot.microstructureManager.Start(); err != nil {
+			gctlog.Errorf(gctlog.Global, "%s unable to start: %s", MicrostructureManagerName, err)
+		}
+	}
+
+	if bot.Settings.EnableConfigReloader {
+		if err := bot.setupConfigReloader(); err != nil {
+			gctlog.Errorf(gctlog.Global, "%s unable to setup: %s", ConfigReloaderName, err)
+		} else if err := bot.configReloader.Start(runtimeCtx); err != nil {
+			gctlog.Errorf(gctlog.Global, "%s unable to start: %s", ConfigReloaderName, err)
+		}
+	}
+
 	if bot.Settings.EnableGCTScriptManager {
 		if g, err := gctscript.NewManager(&bot.Config.GCTScript); err != nil {
 			gctlog.Errorf(gctlog.Global, "failed to create script manager. Err: %s", err)
@@ -573,6 +636,22 @@ func (bot *Engine) Start() error {
 		}
 	}
 
+	if bot.Settings.EnableTreasuryManager {
+		if err := bot.setupTreasuryManager(); err != nil {
+			gctlog.Errorf(gctlog.Global, "%s unable to setup: %s", TreasuryManagerName, err)
+		} else if err := bot.treasuryManager.Start(runtimeCtx); err != nil {
+			gctlog.Errorf(gctlog.Global, "%s unable to start: %s", TreasuryManagerName, err)
+		}
+	}
+
+	if bot.Settings.EnableFundingTracker {
+		if err := bot.setupFundingTracker(); err != nil {
+			gctlog.Errorf(gctlog.Global, "%s unable to setup: %s", FundingTrackerName, err)
+		} else if err := bot.fundingTracker.Start(runtimeCtx); err != nil {
+			gctlog.Errorf(gctlog.Global, "%s unable to start: %s", FundingTrackerName, err)
+		}
+	}
+
 	startSuccessful = true
 	return nil
 }
@@ -750,6 +829,36 @@ func (bot *Engine) Stop() {
 				err)
 		}
 	}
+	if bot.candleAggregator.IsRunning() {
+		if err := bot.candleAggregator.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "candle aggregation manager unable to stop. Error: %v", err)
+		}
+	}
+	if bot.microstructureManager.IsRunning() {
+		if err := bot.microstructureManager.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "microstructure manager unable to stop. Error: %v", err)
+		}
+	}
+	if bot.configReloader.IsRunning() {
+		if err := bot.configReloader.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "config reloader unable to stop. Error: %v", err)
+		}
+	}
+	if bot.treasuryManager.IsRunning() {
+		if err := bot.treasuryManager.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "treasury manager unable to stop. Error: %v", err)
+		}
+	}
+	if bot.fundingTracker.IsRunning() {
+		if err := bot.fundingTracker.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "funding tracker unable to stop. Error: %v", err)
+		}
+	}
+	if bot.metricsManager.IsRunning() {
+		if err := bot.metricsManager.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "metrics manager unable to stop. Error: %v", err)
+		}
+	}
 
 	err := bot.ExchangeManager.Shutdown(bot.Settings.ExchangeShutdownTimeout)
 	if err != nil {
@@ -883,6 +992,16 @@ func (bot *Engine) LoadExchange(name string) error {
 		return err
 	}
 
+	if bot.rateLimitBackend != nil {
+		namespace := exch.GetName()
+		if ns := bot.Config.SharedRateLimiter.Namespace; ns != "" {
+			namespace = ns + "/" + namespace
+		}
+		if err := exch.GetBase().GetRateLimiterDefinitions().SetBackend(bot.rateLimitBackend, namespace); err != nil {
+			return fmt.Errorf("%s unable to share rate limits: %w", exch.GetName(), err)
+		}
+	}
+
 	if err := bot.ExchangeManager.Add(exch); err != nil {
 		return err
 	}
@@ -933,6 +1052,22 @@ func (bot *Engine) dryRunParamInteraction(param string) {
 	}
 }
 
+// setupSharedRateLimiter creates the backend used to share exchange rate limits
+// with other instances, defaulting to a directory within the data directory
+func (bot *Engine) setupSharedRateLimiter() error {
+	dir := bot.Config.SharedRateLimiter.Directory
+	if dir == "" {
+		dir = filepath.Join(bot.Settings.DataDir, "ratelimits")
+	}
+	b, err := request.NewFileLimiterBackend(dir)
+	if err != nil {
+		return fmt.Errorf("unable to set up shared rate limiter: %w", err)
+	}
+	bot.rateLimitBackend = b
+	gctlog.Debugf(gctlog.Global, "Sharing exchange rate limits with other instances via %s", dir)
+	return nil
+}
+
 // SetupExchanges sets up the exchanges used by the Bot
 func (bot *Engine) SetupExchanges() error {
 	configs := bot.Config.GetAllExchangeConfigs()
@@ -982,6 +1117,12 @@ func (bot *Engine) SetupExchanges() error {
 		return errors.New("cannot enable all exchanges and specific exchanges concurrently")
 	}
 
+	if bot.Config.SharedRateLimiter.Enabled && bot.rateLimitBackend == nil {
+		if err := bot.setupSharedRateLimiter(); err != nil {
+			return err
+		}
+	}
+
 	var wg sync.WaitGroup
 	for x := range configs {
 		shouldLoad := false
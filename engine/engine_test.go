@@ -2,7 +2,9 @@ package engine
 
 import (
 	"context"
+	"net/url"
 	"os"
+	"path/filepath"
 	"slices"
 	"strings"
 	"testing"
@@ -14,6 +16,7 @@ import (
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/bitfinex"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/bitstamp"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 )
 
 // blockedCIExchanges are exchanges that are not able to be tested on CI
@@ -659,4 +662,36 @@ func TestSetupExchanges(t *testing.T) {
 			assert.ElementsMatch(t, []string{"Bitstamp", "Bitfinex"}, exchangeNames)
 		})
 	})
+
+	t.Run("Shared rate limiter", func(t *testing.T) {
+		t.Parallel()
+		dir := t.TempDir()
+		e := &Engine{
+			Config:   &config.Config{SharedRateLimiter: config.SharedRateLimiter{Enabled: true, Directory: dir, Namespace: "acct"}},
+			Settings: Settings{ExchangeTuningSettings: ExchangeTuningSettings{EnableExchangeHTTPRateLimiter: true}},
+		}
+		exch := new(bitstamp.Exchange)
+		exch.SetDefaults()
+		exch.GetBase().Features.Supports.RESTCapabilities.AutoPairUpdates = false
+		cfg, err := exchange.GetDefaultConfig(t.Context(), exch)
+		require.NoError(t, err, "GetDefaultConfig must not error")
+		e.Config.Exchanges = append(e.Config.Exchanges, *cfg)
+		e.ExchangeManager = NewExchangeManager()
+
+		require.NoError(t, e.SetupExchanges(), "SetupExchanges must not error")
+		require.NotNil(t, e.rateLimitBackend, "shared rate limiter backend must be set up")
+		loaded, err := e.ExchangeManager.GetExchangeByName("Bitstamp")
+		require.NoError(t, err, "GetExchangeByName must not error")
+		require.NoError(t, loaded.GetBase().InitiateRateLimit(t.Context(), request.Auth), "InitiateRateLimit must not error")
+		files, err := os.ReadDir(dir)
+		require.NoError(t, err, "ReadDir must not error")
+		require.Len(t, files, 1, "rate limiter state must be written to the shared directory")
+		assert.True(t, strings.HasPrefix(files[0].Name(), url.QueryEscape("acct/Bitstamp/")), "state should be keyed by namespace and exchange")
+
+		e.Config.SharedRateLimiter.Directory = ""
+		e.Settings.DataDir = filepath.Join(dir, "data")
+		e.rateLimitBackend = nil
+		require.NoError(t, e.setupSharedRateLimiter(), "setupSharedRateLimiter must not error")
+		assert.DirExists(t, filepath.Join(dir, "data", "ratelimits"), "default directory should be within the data directory")
+	})
 }
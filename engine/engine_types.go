@@ -32,6 +32,7 @@ type Settings struct {
 // CoreSettings defines settings related to core engine operations
 type CoreSettings struct {
 	EnableDryRun                bool
+	EnablePaperTrading          bool
 	EnableAllExchanges          bool
 	EnableAllPairs              bool
 	EnableCoinmarketcapAnalysis bool
@@ -52,6 +53,12 @@ type CoreSettings struct {
 	EnableNTPClient             bool
 	EnableWebsocketRoutine      bool
 	EnableCurrencyStateManager  bool
+	EnableCandleAggregator      bool
+	EnableMicrostructureManager bool
+	EnableMetricsManager        bool
+	EnableConfigReloader        bool
+	EnableTreasuryManager       bool
+	EnableFundingTracker        bool
 	EventManagerDelay           time.Duration
 	EnableFuturesTracking       bool
 	Verbose                     bool
@@ -0,0 +1,154 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/config/secrets"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	gctlog "github.com/thrasher-corp/gocryptotrader/log"
+)
+
+var errSecretResolverNotSetup = errors.New("secret resolver not setup")
+
+// setupSecretResolver creates the resolver for exchange credentials held by
+// secret providers and resolves them ahead of the exchanges being loaded. If
+// a refresh interval is configured, credentials are re-resolved until the
+// runtime context is cancelled so keys can be rotated without a restart
+func (bot *Engine) setupSecretResolver(ctx context.Context) error {
+	if !hasSecretRefs(bot.Config.GetAllExchangeConfigs()) {
+		return nil
+	}
+	r, err := secrets.NewResolver(bot.Config.SecretProviders.Providers)
+	if err != nil {
+		return err
+	}
+	bot.secretResolver = r
+	if err := bot.RefreshExchangeCredentials(ctx); err != nil {
+		gctlog.Errorf(gctlog.Global, "Unable to resolve exchange credentials: %v", err)
+	}
+	if interval := bot.Config.SecretProviders.RefreshInterval; interval > 0 {
+		bot.ServicesWG.Add(1)
+		go bot.refreshExchangeCredentials(ctx, interval, &bot.ServicesWG)
+	}
+	return nil
+}
+
+// RefreshExchangeCredentials resolves the credentials of every exchange and
+// account profile which references a secret provider. Changed credentials
+// replace those held by the config and by any loaded exchange
+func (bot *Engine) RefreshExchangeCredentials(ctx context.Context) error {
+	if bot.secretResolver == nil {
+		return errSecretResolverNotSetup
+	}
+	var errs error
+	configs := bot.Config.GetAllExchangeConfigs()
+	for i := range configs {
+		name := configs[i].Name
+		if ref := configs[i].API.Credentials.SecretRef; ref != nil {
+			if err := bot.refreshDefaultCredentials(ctx, name, ref); err != nil {
+				errs = errors.Join(errs, err)
+			}
+		}
+		for j := range configs[i].API.Accounts {
+			acct := &configs[i].API.Accounts[j]
+			if acct.Credentials.SecretRef == nil {
+				continue
+			}
+			if err := bot.refreshAccountCredentials(ctx, name, acct.Name, acct.Credentials.SecretRef); err != nil {
+				errs = errors.Join(errs, err)
+			}
+		}
+	}
+	return errs
+}
+
+// refreshDefaultCredentials resolves the default credentials of an exchange
+// and rotates them on the exchange when loaded
+func (bot *Engine) refreshDefaultCredentials(ctx context.Context, name string, ref *config.SecretRef) error {
+	creds, err := bot.secretResolver.Resolve(ctx, ref)
+	if err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+	changed, err := bot.Config.SetExchangeCredentials(name, creds)
+	if err != nil || !changed {
+		return err
+	}
+	b := bot.loadedExchangeBase(name)
+	if b == nil || (!b.API.AuthenticatedSupport && !b.API.AuthenticatedWebsocketSupport) {
+		return nil
+	}
+	b.SetCredentials(creds.Key, creds.Secret, creds.ClientID, creds.Subaccount, creds.PEMKey, creds.OTPSecret)
+	gctlog.Infof(gctlog.Global, "%s API credentials rotated from secret provider %s", name, ref.Provider)
+	return nil
+}
+
+// refreshAccountCredentials resolves the credentials of a named account
+// profile of an exchange and rotates them on the exchange when loaded
+func (bot *Engine) refreshAccountCredentials(ctx context.Context, name, account string, ref *config.SecretRef) error {
+	creds, err := bot.secretResolver.Resolve(ctx, ref)
+	if err != nil {
+		return fmt.Errorf("%s account %q: %w", name, account, err)
+	}
+	changed, err := bot.Config.SetExchangeAccountCredentials(name, account, creds)
+	if err != nil || !changed {
+		return err
+	}
+	b := bot.loadedExchangeBase(name)
+	if b == nil || (!b.API.AuthenticatedSupport && !b.API.AuthenticatedWebsocketSupport) {
+		return nil
+	}
+	if err := b.SetAccountCredentials(account, creds); err != nil {
+		return err
+	}
+	gctlog.Infof(gctlog.Global, "%s account %q API credentials rotated from secret provider %s", name, account, ref.Provider)
+	return nil
+}
+
+// loadedExchangeBase returns the base of a loaded exchange, or nil when the
+// exchange is not loaded and the config holds the credentials it will be
+// loaded with
+func (bot *Engine) loadedExchangeBase(name string) *exchange.Base {
+	if bot.ExchangeManager == nil {
+		return nil
+	}
+	exch, err := bot.ExchangeManager.GetExchangeByName(name)
+	if err != nil {
+		return nil
+	}
+	return exch.GetBase()
+}
+
+func (bot *Engine) refreshExchangeCredentials(ctx context.Context, interval time.Duration, wg *sync.WaitGroup) {
+	defer wg.Done()
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+			if err := bot.RefreshExchangeCredentials(ctx); err != nil {
+				gctlog.Errorf(gctlog.Global, "Unable to refresh exchange credentials: %v", err)
+			}
+		}
+	}
+}
+
+func hasSecretRefs(configs []config.Exchange) bool {
+	for i := range configs {
+		if configs[i].API.Credentials.SecretRef != nil {
+			return true
+		}
+		for j := range configs[i].API.Accounts {
+			if configs[i].API.Accounts[j].Credentials.SecretRef != nil {
+				return true
+			}
+		}
+	}
+	return false
+}
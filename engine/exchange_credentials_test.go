@@ -0,0 +1,67 @@
+package engine
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/config/secrets"
+	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
+)
+
+func TestRefreshExchangeCredentials(t *testing.T) {
+	t.Parallel()
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	require.NoError(t, err, "NewExchangeByName must not error")
+	exch.SetDefaults()
+	require.NoError(t, em.Add(exch), "Add must not error")
+	e := &Engine{
+		ExchangeManager: em,
+		Config:          &config.Config{Exchanges: []config.Exchange{{Name: testExchange}, {Name: "Binance"}}},
+	}
+	require.ErrorIs(t, e.RefreshExchangeCredentials(t.Context()), errSecretResolverNotSetup)
+
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, testExchange), 0o700), "MkdirAll must not error")
+	writeSecret := func(name, value string) {
+		t.Helper()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, testExchange, name), []byte(value), 0o600), "WriteFile must not error")
+	}
+	writeSecret("key", "k1")
+	writeSecret("secret", "s1")
+
+	e.Config.SecretProviders.Providers = []config.SecretProvider{{Name: "mounted", Type: secrets.FileProvider, Path: dir}}
+	e.Config.Exchanges[0].API.Credentials.SecretRef = &config.SecretRef{Provider: "mounted", Path: testExchange}
+	require.NoError(t, e.setupSecretResolver(t.Context()), "setupSecretResolver must not error")
+	assert.Equal(t, "k1", e.Config.Exchanges[0].API.Credentials.Key, "setupSecretResolver should resolve the key into the config")
+
+	b := exch.GetBase()
+	b.API.AuthenticatedSupport = true
+
+	writeSecret("key", "k2")
+	require.NoError(t, e.RefreshExchangeCredentials(t.Context()), "RefreshExchangeCredentials must not error")
+	assert.Equal(t, "k2", e.Config.Exchanges[0].API.Credentials.Key, "RefreshExchangeCredentials should update the config")
+	assert.Equal(t, "k2", b.GetDefaultCredentials().Key, "RefreshExchangeCredentials should rotate the exchange credentials")
+	assert.Equal(t, "s1", b.GetDefaultCredentials().Secret, "RefreshExchangeCredentials should keep the unchanged secret")
+
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "desk"), 0o700), "MkdirAll must not error")
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "desk", "key"), []byte("dk1"), 0o600), "WriteFile must not error")
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "desk", "secret"), []byte("ds1"), 0o600), "WriteFile must not error")
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "desk", "clientID"), []byte("dc1"), 0o600), "WriteFile must not error")
+	e.Config.Exchanges[0].API.Accounts = []config.AccountConfig{{Name: "desk", AccessControl: accounts.ReadOnlyAccess, Credentials: config.APICredentialsConfig{SecretRef: &config.SecretRef{Provider: "mounted", Path: "desk"}}}}
+	b.SetAccounts(e.Config.Exchanges[0].API.Accounts)
+	require.NoError(t, e.RefreshExchangeCredentials(t.Context()), "RefreshExchangeCredentials must not error")
+	assert.Equal(t, "dk1", e.Config.Exchanges[0].API.Accounts[0].Credentials.Key, "RefreshExchangeCredentials should resolve the account key into the config")
+	creds, err := b.GetCredentials(accounts.DeployAccountToContext(t.Context(), "desk"))
+	require.NoError(t, err, "GetCredentials must not error")
+	assert.Equal(t, "dk1", creds.Key, "RefreshExchangeCredentials should set the account key on the exchange")
+	assert.Equal(t, "ds1", creds.Secret, "RefreshExchangeCredentials should set the account secret on the exchange")
+	assert.Equal(t, accounts.ReadOnlyAccess, creds.AccessControl, "RefreshExchangeCredentials should keep the account access control")
+
+	e.Config.Exchanges[1].API.Credentials.SecretRef = &config.SecretRef{Provider: "vault", Path: testExchange}
+	require.ErrorIs(t, e.RefreshExchangeCredentials(t.Context()), secrets.ErrProviderNotFound)
+}
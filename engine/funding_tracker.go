@@ -0,0 +1,533 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/fundingtransition"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// SetupFundingTracker applies configuration parameters before running
+func SetupFundingTracker(cfg *config.FundingTracker, em iExchangeManager, cm iCommsManager) (*FundingTracker, error) {
+	if cfg == nil {
+		return nil, errNilFundingTrackerConfig
+	}
+	if em == nil {
+		return nil, errNilExchangeManager
+	}
+	if cm == nil {
+		return nil, errNilCommunicationsManager
+	}
+	if cfg.PollInterval <= 0 {
+		return nil, fmt.Errorf("%s %w: %v", FundingTrackerName, errInvalidCheckInterval, cfg.PollInterval)
+	}
+	if cfg.StuckTimeout <= 0 {
+		return nil, fmt.Errorf("%s %w: %v", FundingTrackerName, errInvalidStuckTimeout, cfg.StuckTimeout)
+	}
+	if cfg.Retention <= 0 {
+		return nil, fmt.Errorf("%s %w: %v", FundingTrackerName, errInvalidFundingRetention, cfg.Retention)
+	}
+	statuses := make(map[string]map[string]string, len(cfg.Statuses)*2)
+	for exch, s := range cfg.Statuses {
+		for transferType, mapping := range map[string]map[string]string{
+			FundingTypeDeposit:    s.Deposit,
+			FundingTypeWithdrawal: s.Withdrawal,
+		} {
+			lower := make(map[string]string, len(mapping))
+			for status, state := range mapping {
+				state = strings.ToLower(state)
+				if fundingStateRank(state) < 0 {
+					return nil, fmt.Errorf("%s %w: %q for %s %s status %q", FundingTrackerName, errUnknownFundingState, state, exch, transferType, status)
+				}
+				lower[strings.ToLower(status)] = state
+			}
+			statuses[fundingStatusesKey(exch, transferType)] = lower
+		}
+	}
+	return &FundingTracker{
+		verbose:         cfg.Verbose,
+		interval:        cfg.PollInterval,
+		stuckAfter:      cfg.StuckTimeout,
+		retention:       cfg.Retention,
+		statuses:        statuses,
+		exchangeManager: em,
+		commsManager:    cm,
+		transfers:       make(map[string]*FundingTransfer),
+		shutdown:        make(chan struct{}),
+	}, nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (m *FundingTracker) IsRunning() bool {
+	return m != nil && m.started.Load()
+}
+
+// Start runs the subsystem
+func (m *FundingTracker) Start(ctx context.Context) error {
+	if m == nil {
+		return fmt.Errorf("%s %w", FundingTrackerName, ErrNilSubsystem)
+	}
+	if !m.started.CompareAndSwap(false, true) {
+		return fmt.Errorf("%s %w", FundingTrackerName, ErrSubSystemAlreadyStarted)
+	}
+	log.Debugf(log.Global, "%s %s", FundingTrackerName, MsgSubSystemStarting)
+	m.shutdown = make(chan struct{})
+	m.wg.Add(1)
+	go m.run(ctx)
+	log.Debugf(log.Global, "%s %s", FundingTrackerName, MsgSubSystemStarted)
+	return nil
+}
+
+// Stop stops the subsystem
+func (m *FundingTracker) Stop() error {
+	if m == nil {
+		return fmt.Errorf("%s %w", FundingTrackerName, ErrNilSubsystem)
+	}
+	if !m.started.CompareAndSwap(true, false) {
+		return fmt.Errorf("%s %w", FundingTrackerName, ErrSubSystemNotStarted)
+	}
+	log.Debugf(log.Global, "%s %s", FundingTrackerName, MsgSubSystemShuttingDown)
+	close(m.shutdown)
+	m.wg.Wait()
+	log.Debugf(log.Global, "%s %s", FundingTrackerName, MsgSubSystemShutdown)
+	return nil
+}
+
+func (m *FundingTracker) run(ctx context.Context) {
+	defer m.wg.Done()
+	t := time.NewTicker(m.interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-m.shutdown:
+			return
+		case <-ctx.Done():
+			return
+		case <-t.C:
+			if err := m.Poll(ctx); err != nil {
+				log.Errorf(log.Global, "%s %v", FundingTrackerName, err)
+			}
+		}
+	}
+}
+
+// Poll fetches the funding history of every exchange with authenticated
+// support, records each change in state of the transfers listed and warns
+// about transfers which have been stuck for longer than the stuck timeout.
+// Exchanges which do not support funding history are polled for the
+// withdrawals of each enabled asset instead, and are skipped when they support
+// neither. Transfers which ended longer than the retention ago are forgotten
+func (m *FundingTracker) Poll(ctx context.Context) error {
+	if !m.IsRunning() {
+		return fmt.Errorf("%s %w", FundingTrackerName, ErrSubSystemNotStarted)
+	}
+	exchanges, err := m.exchangeManager.GetExchanges()
+	if err != nil {
+		return err
+	}
+	var errs error
+	for _, exch := range exchanges {
+		if !exch.IsRESTAuthenticationSupported() {
+			continue
+		}
+		history, err := fundingHistory(ctx, exch)
+		if err != nil && !isFundingUnsupported(err) {
+			errs = common.AppendError(errs, fmt.Errorf("%s %w", exch.GetName(), err))
+		}
+		if len(history) == 0 {
+			continue
+		}
+		now := time.Now()
+		m.m.Lock()
+		stored := m.loadTransitions(ctx, exch.GetName(), history)
+		for i := range history {
+			m.update(ctx, exch.GetName(), &history[i], stored, now)
+		}
+		m.m.Unlock()
+	}
+	now := time.Now()
+	m.m.Lock()
+	m.checkStuck(now)
+	m.prune(now)
+	m.m.Unlock()
+	return errs
+}
+
+// GetTransfers returns the transfers followed, optionally limited to an
+// exchange and to those which have not yet ended, newest first
+func (m *FundingTracker) GetTransfers(exchangeName string, activeOnly bool) ([]FundingTransfer, error) {
+	if !m.IsRunning() {
+		return nil, fmt.Errorf("%s %w", FundingTrackerName, ErrSubSystemNotStarted)
+	}
+	m.m.RLock()
+	defer m.m.RUnlock()
+	resp := make([]FundingTransfer, 0, len(m.transfers))
+	for _, t := range m.transfers {
+		if exchangeName != "" && !strings.EqualFold(t.Exchange, exchangeName) {
+			continue
+		}
+		if activeOnly && isFundingStateFinal(t.State) {
+			continue
+		}
+		cpy := *t
+		cpy.Transitions = slices.Clone(t.Transitions)
+		resp = append(resp, cpy)
+	}
+	slices.SortFunc(resp, func(a, b FundingTransfer) int {
+		if c := b.FirstSeen.Compare(a.FirstSeen); c != 0 {
+			return c
+		}
+		return b.Timestamp.Compare(a.Timestamp)
+	})
+	return resp, nil
+}
+
+// update applies a funding history entry to the transfer it belongs to. A
+// transfer which had already ended before it was first seen is remembered
+// without being recorded, unless its stored transitions show it was followed
+// before a restart. m.m must be held
+func (m *FundingTracker) update(ctx context.Context, exchangeName string, f *exchange.FundingHistory, stored map[string][]fundingtransition.Data, now time.Time) {
+	id := fundingTransferID(f)
+	if id == "" {
+		return
+	}
+	transferType := fundingTransferType(f.TransferType)
+	key := fundingTransferKey(exchangeName, transferType, id)
+	state := m.classify(exchangeName, transferType, f)
+	t, ok := m.transfers[key]
+	if !ok {
+		t = &FundingTransfer{
+			Exchange:  exchangeName,
+			ID:        id,
+			Type:      transferType,
+			FirstSeen: now,
+		}
+		m.transfers[key] = t
+		t.restore(stored[id])
+		if t.State == "" && isFundingStateFinal(state) {
+			t.apply(f)
+			t.State = state
+			t.UpdatedAt = now
+			return
+		}
+	}
+	t.lastSeen = now
+	t.apply(f)
+	if !fundingStateAdvances(t.State, state) {
+		return
+	}
+	m.transition(ctx, t, state, now)
+}
+
+// classify returns the state of a funding history entry, using the status
+// mappings of the exchange and transfer type before recognising the status by
+// its words. An unrecognised status counts as broadcast once the transaction
+// ID is known
+func (m *FundingTracker) classify(exchangeName, transferType string, f *exchange.FundingHistory) string {
+	status := strings.ToLower(strings.TrimSpace(f.Status))
+	if state, ok := m.statuses[fundingStatusesKey(exchangeName, transferType)][status]; ok {
+		return state
+	}
+	if state := fundingStateFromStatus(status); state != "" {
+		return state
+	}
+	if f.CryptoTxID != "" {
+		return FundingStateBroadcast
+	}
+	return FundingStatePending
+}
+
+// transition moves a transfer to a new state, storing the change when the
+// database is connected and telling communication relayers when the transfer
+// has ended. m.m must be held
+func (m *FundingTracker) transition(ctx context.Context, t *FundingTransfer, state string, now time.Time) {
+	tr := FundingTransition{From: t.State, To: state, Status: t.Status, Time: now}
+	t.Transitions = append(t.Transitions, tr)
+	t.State = state
+	t.UpdatedAt = now
+	if m.verbose {
+		log.Debugf(log.Global, "%s %s %s %s %s moved from %q to %q", FundingTrackerName, t.Exchange, t.Type, t.ID, t.Currency, tr.From, tr.To)
+	}
+	if database.DB.IsConnected() {
+		if _, err := fundingtransition.Insert(ctx, fundingtransition.Data{
+			Exchange:     t.Exchange,
+			TransferID:   t.ID,
+			TransferType: t.Type,
+			Currency:     t.Currency.String(),
+			Amount:       t.Amount,
+			Chain:        t.Chain,
+			TxID:         t.TxID,
+			FromState:    tr.From,
+			ToState:      tr.To,
+			Status:       tr.Status,
+			CreatedAt:    now,
+		}); err != nil {
+			log.Errorf(log.Global, "%s unable to store %s transfer %s transition: %v", FundingTrackerName, t.Exchange, t.ID, err)
+		}
+	}
+	if isFundingStateFinal(state) {
+		m.notify(t, fmt.Sprintf("%s %s %s of %v %s has %s", t.Exchange, t.Type, t.ID, t.Amount, t.Currency, state))
+	}
+}
+
+// loadTransitions returns the stored transitions of the transfers in a funding
+// history which are not yet followed, keyed by transfer ID and loaded in a
+// single query. m.m must be held
+func (m *FundingTracker) loadTransitions(ctx context.Context, exchangeName string, history []exchange.FundingHistory) map[string][]fundingtransition.Data {
+	if !database.DB.IsConnected() {
+		return nil
+	}
+	seen := make(map[string]bool, len(history))
+	var ids []string
+	for i := range history {
+		id := fundingTransferID(&history[i])
+		if id == "" || seen[id] {
+			continue
+		}
+		if _, ok := m.transfers[fundingTransferKey(exchangeName, fundingTransferType(history[i].TransferType), id)]; ok {
+			continue
+		}
+		seen[id] = true
+		ids = append(ids, id)
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	stored, err := fundingtransition.GetByTransfers(ctx, exchangeName, ids)
+	if err != nil {
+		if !errors.Is(err, fundingtransition.ErrTransitionNotFound) {
+			log.Errorf(log.Global, "%s unable to load %s transfer transitions: %v", FundingTrackerName, exchangeName, err)
+		}
+		return nil
+	}
+	return stored
+}
+
+// prune forgets the transfers which ended and have been neither updated nor
+// listed by their exchange for longer than the retention. m.m must be held
+func (m *FundingTracker) prune(now time.Time) {
+	cutoff := now.Add(-m.retention)
+	for k, t := range m.transfers {
+		if isFundingStateFinal(t.State) && t.UpdatedAt.Before(cutoff) && t.lastSeen.Before(cutoff) {
+			delete(m.transfers, k)
+		}
+	}
+}
+
+// restore sets the transitions and state of a transfer followed before a
+// restart from its stored transitions
+func (t *FundingTransfer) restore(stored []fundingtransition.Data) {
+	for i := range stored {
+		if stored[i].TransferType != t.Type {
+			continue
+		}
+		t.Transitions = append(t.Transitions, FundingTransition{
+			From:   stored[i].FromState,
+			To:     stored[i].ToState,
+			Status: stored[i].Status,
+			Time:   stored[i].CreatedAt,
+		})
+	}
+	if len(t.Transitions) > 0 {
+		t.FirstSeen = t.Transitions[0].Time
+		t.State = t.Transitions[len(t.Transitions)-1].To
+		t.UpdatedAt = t.Transitions[len(t.Transitions)-1].Time
+	}
+}
+
+// checkStuck warns once about each transfer which has not ended within the
+// stuck timeout of it being made, or of it first being seen when the exchange
+// does not say when it was made. m.m must be held
+func (m *FundingTracker) checkStuck(now time.Time) {
+	for _, t := range m.transfers {
+		if t.Stuck || isFundingStateFinal(t.State) {
+			continue
+		}
+		since := t.Timestamp
+		if since.IsZero() {
+			since = t.FirstSeen
+		}
+		if now.Sub(since) < m.stuckAfter {
+			continue
+		}
+		t.Stuck = true
+		m.notify(t, fmt.Sprintf("%s %s %s of %v %s has been %s since %s", t.Exchange, t.Type, t.ID, t.Amount, t.Currency, t.State, since.UTC().Format(time.RFC3339)))
+	}
+}
+
+func (m *FundingTracker) notify(t *FundingTransfer, msg string) {
+	log.Infof(log.Global, "%s %s", FundingTrackerName, msg)
+	m.commsManager.PushEvent(base.Event{Type: "funding", Message: msg})
+}
+
+// apply copies the details of a funding history entry onto the transfer,
+// keeping details already known when the entry leaves them out
+func (t *FundingTransfer) apply(f *exchange.FundingHistory) {
+	if c := currency.NewCode(f.Currency); !c.IsEmpty() {
+		t.Currency = c
+	}
+	if f.Amount != 0 {
+		t.Amount = f.Amount
+	}
+	if f.Fee != 0 {
+		t.Fee = f.Fee
+	}
+	if f.CryptoChain != "" {
+		t.Chain = f.CryptoChain
+	}
+	address := f.CryptoToAddress
+	if t.Type == FundingTypeDeposit && f.CryptoFromAddress != "" {
+		address = f.CryptoFromAddress
+	}
+	if address != "" {
+		t.Address = address
+	}
+	if f.CryptoTxID != "" {
+		t.TxID = f.CryptoTxID
+	}
+	if !f.Timestamp.IsZero() {
+		t.Timestamp = f.Timestamp
+	}
+	t.Status = f.Status
+}
+
+// fundingHistory returns the funding history of an exchange, or the
+// withdrawals of each enabled asset when the exchange does not support funding
+// history
+func fundingHistory(ctx context.Context, exch exchange.IBotExchange) ([]exchange.FundingHistory, error) {
+	history, err := exch.GetAccountFundingHistory(ctx)
+	if !isFundingUnsupported(err) {
+		return history, err
+	}
+	var errs error
+	supported := false
+	for _, a := range exch.GetAssetTypes(true) {
+		withdrawals, wErr := exch.GetWithdrawalsHistory(ctx, currency.EMPTYCODE, a)
+		if wErr != nil {
+			if !isFundingUnsupported(wErr) {
+				errs = common.AppendError(errs, fmt.Errorf("%s %w", a, wErr))
+			}
+			continue
+		}
+		supported = true
+		for i := range withdrawals {
+			w := &withdrawals[i]
+			history = append(history, exchange.FundingHistory{
+				Status:          w.Status,
+				TransferID:      w.TransferID,
+				Description:     w.Description,
+				Timestamp:       w.Timestamp,
+				Currency:        w.Currency,
+				Amount:          w.Amount,
+				Fee:             w.Fee,
+				TransferType:    FundingTypeWithdrawal,
+				CryptoToAddress: w.CryptoToAddress,
+				CryptoTxID:      w.CryptoTxID,
+				CryptoChain:     w.CryptoChain,
+				BankTo:          w.BankTo,
+			})
+		}
+	}
+	if !supported && errs == nil {
+		return nil, err
+	}
+	return history, errs
+}
+
+func isFundingUnsupported(err error) bool {
+	return errors.Is(err, common.ErrFunctionNotSupported) || errors.Is(err, common.ErrNotYetImplemented)
+}
+
+// fundingTransferID returns the transfer ID of a funding history entry, or its
+// transaction ID when the exchange does not give one
+func fundingTransferID(f *exchange.FundingHistory) string {
+	if f.TransferID != "" {
+		return f.TransferID
+	}
+	return f.CryptoTxID
+}
+
+// fundingTransferKey returns the key of a transfer followed
+func fundingTransferKey(exchangeName, transferType, id string) string {
+	return fundingStatusesKey(exchangeName, transferType) + "/" + id
+}
+
+// fundingTransferType returns whether an exchange transfer type is a deposit
+// or withdrawal, keeping any other type as reported
+func fundingTransferType(transferType string) string {
+	lower := strings.ToLower(transferType)
+	switch {
+	case strings.Contains(lower, "withdraw"):
+		return FundingTypeWithdrawal
+	case strings.Contains(lower, "deposit"):
+		return FundingTypeDeposit
+	}
+	return lower
+}
+
+// fundingStatusesKey returns the key of the status mappings of an exchange and
+// transfer type, which also prefixes the keys of the transfers followed
+func fundingStatusesKey(exchangeName, transferType string) string {
+	return strings.ToLower(exchangeName) + "/" + transferType
+}
+
+// fundingStateFromStatus recognises the state of a lower case status by its
+// words, returning an empty string when no word is recognised
+func fundingStateFromStatus(status string) string {
+	words := strings.FieldsFunc(status, func(r rune) bool {
+		return !unicode.IsLetter(r)
+	})
+	for _, k := range fundingStateKeywords {
+		for _, w := range words {
+			for _, p := range k.prefixes {
+				if strings.HasPrefix(w, p) {
+					return k.state
+				}
+			}
+		}
+	}
+	return ""
+}
+
+// fundingStateRank returns the position of a state in the lifecycle, or -1
+// for an unknown state
+func fundingStateRank(state string) int {
+	switch state {
+	case FundingStatePending:
+		return 0
+	case FundingStateBroadcast:
+		return 1
+	case FundingStateConfirming:
+		return 2
+	case FundingStateCredited, FundingStateFailed:
+		return 3
+	}
+	return -1
+}
+
+func isFundingStateFinal(state string) bool {
+	return state == FundingStateCredited || state == FundingStateFailed
+}
+
+// fundingStateAdvances reports whether a transfer may move from its current
+// state to the next one. Transfers only move forward and stay in the state
+// they ended in, so a status which flaps between exchange specific values
+// does not record the same transition twice
+func fundingStateAdvances(current, next string) bool {
+	if current == next || isFundingStateFinal(current) {
+		return false
+	}
+	return fundingStateRank(next) > fundingStateRank(current)
+}
@@ -0,0 +1,268 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+var errFundingTest = errors.New("funding test error")
+
+// fundingTestExchange is an offline exchange reporting a fixed funding history
+// and spot withdrawal history
+type fundingTestExchange struct {
+	exchange.IBotExchange
+	name           string
+	history        []exchange.FundingHistory
+	err            error
+	withdrawals    []exchange.WithdrawalHistory
+	withdrawalsErr error
+}
+
+func (e *fundingTestExchange) GetName() string { return e.name }
+
+func (e *fundingTestExchange) IsRESTAuthenticationSupported() bool { return true }
+
+func (e *fundingTestExchange) GetAccountFundingHistory(context.Context) ([]exchange.FundingHistory, error) {
+	return e.history, e.err
+}
+
+func (e *fundingTestExchange) GetAssetTypes(bool) asset.Items { return asset.Items{asset.Spot} }
+
+func (e *fundingTestExchange) GetWithdrawalsHistory(context.Context, currency.Code, asset.Item) ([]exchange.WithdrawalHistory, error) {
+	return e.withdrawals, e.withdrawalsErr
+}
+
+// fundingTestExchangeManager returns a single funding test exchange
+type fundingTestExchangeManager struct {
+	exch *fundingTestExchange
+}
+
+func (m *fundingTestExchangeManager) GetExchanges() ([]exchange.IBotExchange, error) {
+	return []exchange.IBotExchange{m.exch}, nil
+}
+
+func (m *fundingTestExchangeManager) GetExchangeByName(string) (exchange.IBotExchange, error) {
+	return m.exch, nil
+}
+
+func fundingTestConfig() *config.FundingTracker {
+	return &config.FundingTracker{
+		PollInterval: time.Hour,
+		StuckTimeout: time.Hour,
+		Retention:    time.Hour,
+		Statuses: map[string]config.FundingStatuses{
+			"Alpha": {
+				Deposit:    map[string]string{"6": "failed"},
+				Withdrawal: map[string]string{"6": "Credited"},
+			},
+		},
+	}
+}
+
+func TestSetupFundingTracker(t *testing.T) {
+	t.Parallel()
+	em := &fundingTestExchangeManager{}
+	cm := &testCommsManager{}
+	_, err := SetupFundingTracker(nil, em, cm)
+	assert.ErrorIs(t, err, errNilFundingTrackerConfig)
+	_, err = SetupFundingTracker(fundingTestConfig(), nil, cm)
+	assert.ErrorIs(t, err, errNilExchangeManager)
+	_, err = SetupFundingTracker(fundingTestConfig(), em, nil)
+	assert.ErrorIs(t, err, errNilCommunicationsManager)
+
+	cfg := fundingTestConfig()
+	cfg.PollInterval = 0
+	_, err = SetupFundingTracker(cfg, em, cm)
+	assert.ErrorIs(t, err, errInvalidCheckInterval)
+	cfg = fundingTestConfig()
+	cfg.StuckTimeout = 0
+	_, err = SetupFundingTracker(cfg, em, cm)
+	assert.ErrorIs(t, err, errInvalidStuckTimeout)
+	cfg = fundingTestConfig()
+	cfg.Retention = 0
+	_, err = SetupFundingTracker(cfg, em, cm)
+	assert.ErrorIs(t, err, errInvalidFundingRetention)
+	cfg = fundingTestConfig()
+	cfg.Statuses["alpha"] = config.FundingStatuses{Withdrawal: map[string]string{"1": "lost"}}
+	_, err = SetupFundingTracker(cfg, em, cm)
+	assert.ErrorIs(t, err, errUnknownFundingState)
+
+	m, err := SetupFundingTracker(fundingTestConfig(), em, cm)
+	require.NoError(t, err, "SetupFundingTracker must not error")
+	assert.Equal(t, FundingStateCredited, m.statuses["alpha/withdrawal"]["6"], "SetupFundingTracker should store status mappings in lower case")
+	assert.Equal(t, FundingStateFailed, m.statuses["alpha/deposit"]["6"], "SetupFundingTracker should keep deposit and withdrawal mappings apart")
+}
+
+func TestFundingTrackerStartStop(t *testing.T) {
+	t.Parallel()
+	var m *FundingTracker
+	assert.False(t, m.IsRunning(), "IsRunning should return false on a nil tracker")
+	assert.ErrorIs(t, m.Start(t.Context()), ErrNilSubsystem)
+	assert.ErrorIs(t, m.Stop(), ErrNilSubsystem)
+
+	m, err := SetupFundingTracker(fundingTestConfig(), &fundingTestExchangeManager{}, &testCommsManager{})
+	require.NoError(t, err, "SetupFundingTracker must not error")
+	assert.ErrorIs(t, m.Stop(), ErrSubSystemNotStarted)
+	assert.ErrorIs(t, m.Poll(t.Context()), ErrSubSystemNotStarted)
+	_, err = m.GetTransfers("", false)
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+	require.NoError(t, m.Start(t.Context()), "Start must not error")
+	assert.ErrorIs(t, m.Start(t.Context()), ErrSubSystemAlreadyStarted)
+	assert.True(t, m.IsRunning(), "IsRunning should return true once started")
+	require.NoError(t, m.Stop(), "Stop must not error")
+	assert.False(t, m.IsRunning(), "IsRunning should return false once stopped")
+}
+
+func TestFundingStateFromStatus(t *testing.T) {
+	t.Parallel()
+	for status, state := range map[string]string{
+		"pending":                   FundingStatePending,
+		"awaiting approval":         FundingStatePending,
+		"processing":                FundingStatePending,
+		"sent":                      FundingStateBroadcast,
+		"unconfirmed":               FundingStateConfirming,
+		"waiting for confirmations": FundingStateConfirming,
+		"confirmed":                 FundingStateCredited,
+		"completed":                 FundingStateCredited,
+		"success":                   FundingStateCredited,
+		"cancelled":                 FundingStateFailed,
+		"failed to confirm":         FundingStateFailed,
+		"6":                         "",
+		"":                          "",
+	} {
+		assert.Equalf(t, state, fundingStateFromStatus(status), "fundingStateFromStatus should recognise %q", status)
+	}
+}
+
+func TestFundingStateAdvances(t *testing.T) {
+	t.Parallel()
+	assert.True(t, fundingStateAdvances("", FundingStatePending), "fundingStateAdvances should allow the first state")
+	assert.True(t, fundingStateAdvances(FundingStatePending, FundingStateConfirming), "fundingStateAdvances should allow skipping states")
+	assert.True(t, fundingStateAdvances(FundingStateBroadcast, FundingStateFailed), "fundingStateAdvances should allow failing")
+	assert.False(t, fundingStateAdvances(FundingStateConfirming, FundingStatePending), "fundingStateAdvances should not move backwards")
+	assert.False(t, fundingStateAdvances(FundingStatePending, FundingStatePending), "fundingStateAdvances should not repeat a state")
+	assert.False(t, fundingStateAdvances(FundingStateCredited, FundingStateFailed), "fundingStateAdvances should not leave an ended state")
+}
+
+func TestFundingTrackerPoll(t *testing.T) {
+	t.Parallel()
+	exch := &fundingTestExchange{name: "Alpha"}
+	cm := &testCommsManager{}
+	m, err := SetupFundingTracker(fundingTestConfig(), &fundingTestExchangeManager{exch: exch}, cm)
+	require.NoError(t, err, "SetupFundingTracker must not error")
+	require.NoError(t, m.Start(t.Context()), "Start must not error")
+	t.Cleanup(func() { assert.NoError(t, m.Stop(), "Stop should not error") })
+
+	now := time.Now()
+	exch.history = []exchange.FundingHistory{
+		{TransferID: "w1", TransferType: "withdrawal", Currency: "BTC", Amount: 1, Status: "processing", Timestamp: now},
+		{TransferID: "d1", TransferType: "deposit", Currency: "ETH", Amount: 2, Status: "completed", Timestamp: now.Add(-time.Hour * 24)},
+		{TransferID: "w2", TransferType: "withdrawal", Currency: "XRP", Amount: 3, Status: "4", Timestamp: now.Add(-time.Hour * 2)},
+		{Currency: "LTC", Status: "pending"},
+	}
+	require.NoError(t, m.Poll(t.Context()), "Poll must not error")
+	transfers, err := m.GetTransfers("", false)
+	require.NoError(t, err, "GetTransfers must not error")
+	require.Len(t, transfers, 3, "GetTransfers must return each transfer with an ID")
+	active, err := m.GetTransfers("alpha", true)
+	require.NoError(t, err, "GetTransfers must not error")
+	assert.Len(t, active, 2, "GetTransfers should leave out transfers which ended before they were seen")
+	empty, err := m.GetTransfers("beta", false)
+	require.NoError(t, err, "GetTransfers must not error")
+	assert.Empty(t, empty, "GetTransfers should filter by exchange")
+	require.Len(t, cm.events, 1, "Poll must warn about a stuck transfer")
+	assert.Contains(t, cm.events[0].Message, "w2", "Poll should warn about the transfer made before the stuck timeout")
+
+	exch.history[0].Status = "sent"
+	exch.history[0].CryptoTxID = "0xabc"
+	exch.history[2].Status = "6"
+	require.NoError(t, m.Poll(t.Context()), "Poll must not error")
+	exch.history[0].Status = "processing"
+	require.NoError(t, m.Poll(t.Context()), "Poll must not error")
+	exch.history[0].Status = "completed"
+	require.NoError(t, m.Poll(t.Context()), "Poll must not error")
+	exch.history[0].Status = "failed"
+	require.NoError(t, m.Poll(t.Context()), "Poll must not error")
+
+	active, err = m.GetTransfers("", true)
+	require.NoError(t, err, "GetTransfers must not error")
+	assert.Empty(t, active, "GetTransfers should not return ended transfers as active")
+	transfers, err = m.GetTransfers("", false)
+	require.NoError(t, err, "GetTransfers must not error")
+	for i := range transfers {
+		switch transfers[i].ID {
+		case "w1":
+			assert.Equal(t, FundingStateCredited, transfers[i].State, "Poll should not leave an ended state")
+			assert.Equal(t, "0xabc", transfers[i].TxID, "Poll should store the tx id")
+			assert.Equal(t, currency.BTC, transfers[i].Currency, "Poll should store the currency")
+			require.Len(t, transfers[i].Transitions, 3, "Poll must record each change in state once")
+			assert.Equal(t, FundingStatePending, transfers[i].Transitions[0].To, "Poll should record the first state seen")
+			assert.Equal(t, FundingStateBroadcast, transfers[i].Transitions[1].To, "Poll should record the broadcast")
+			assert.Equal(t, FundingStateBroadcast, transfers[i].Transitions[2].From, "Poll should not move backwards")
+		case "w2":
+			assert.Equal(t, FundingStateCredited, transfers[i].State, "Poll should use the status mappings of the exchange")
+			assert.True(t, transfers[i].Stuck, "Poll should mark a stuck transfer")
+		case "d1":
+			assert.Equal(t, FundingStateCredited, transfers[i].State, "Poll should remember transfers which ended before they were seen")
+			assert.Empty(t, transfers[i].Transitions, "Poll should not record transfers which ended before they were seen")
+		}
+	}
+	assert.Len(t, cm.events, 3, "Poll should notify once as each transfer ends")
+
+	exch.err, exch.withdrawalsErr = common.ErrFunctionNotSupported, common.ErrNotYetImplemented
+	assert.NoError(t, m.Poll(t.Context()), "Poll should skip exchanges without funding or withdrawal history")
+	exch.err = errFundingTest
+	assert.ErrorIs(t, m.Poll(t.Context()), errFundingTest, "Poll should return funding history errors")
+}
+
+func TestFundingTrackerWithdrawalsFallback(t *testing.T) {
+	t.Parallel()
+	exch := &fundingTestExchange{name: "Alpha", err: common.ErrFunctionNotSupported}
+	m, err := SetupFundingTracker(fundingTestConfig(), &fundingTestExchangeManager{exch: exch}, &testCommsManager{})
+	require.NoError(t, err, "SetupFundingTracker must not error")
+	require.NoError(t, m.Start(t.Context()), "Start must not error")
+	t.Cleanup(func() { assert.NoError(t, m.Stop(), "Stop should not error") })
+
+	exch.withdrawals = []exchange.WithdrawalHistory{
+		{TransferID: "w1", Currency: "BTC", Amount: 1, Status: "processing", CryptoTxID: "0xabc", CryptoChain: "btc", Timestamp: time.Now()},
+	}
+	require.NoError(t, m.Poll(t.Context()), "Poll must not error")
+	transfers, err := m.GetTransfers("", false)
+	require.NoError(t, err, "GetTransfers must not error")
+	require.Len(t, transfers, 1, "Poll must follow the withdrawals of exchanges without funding history")
+	assert.Equal(t, FundingTypeWithdrawal, transfers[0].Type, "Poll should follow the withdrawal history as withdrawals")
+	assert.Equal(t, FundingStatePending, transfers[0].State, "Poll should classify the withdrawal status")
+	assert.Equal(t, "btc", transfers[0].Chain, "Poll should store the withdrawal chain")
+
+	exch.withdrawalsErr = errFundingTest
+	assert.ErrorIs(t, m.Poll(t.Context()), errFundingTest, "Poll should return withdrawal history errors")
+}
+
+func TestFundingTrackerPrune(t *testing.T) {
+	t.Parallel()
+	m, err := SetupFundingTracker(fundingTestConfig(), &fundingTestExchangeManager{}, &testCommsManager{})
+	require.NoError(t, err, "SetupFundingTracker must not error")
+	now := time.Now()
+	old := now.Add(-m.retention - time.Minute)
+	m.transfers = map[string]*FundingTransfer{
+		"ended":    {State: FundingStateCredited, UpdatedAt: old, lastSeen: old},
+		"listed":   {State: FundingStateFailed, UpdatedAt: old, lastSeen: now},
+		"recent":   {State: FundingStateCredited, UpdatedAt: now, lastSeen: old},
+		"inflight": {State: FundingStateConfirming, UpdatedAt: old, lastSeen: old},
+	}
+	m.prune(now)
+	assert.NotContains(t, m.transfers, "ended", "prune should forget ended transfers older than the retention")
+	assert.Contains(t, m.transfers, "listed", "prune should keep ended transfers still listed by the exchange")
+	assert.Contains(t, m.transfers, "recent", "prune should keep recently ended transfers")
+	assert.Contains(t, m.transfers, "inflight", "prune should keep transfers which have not ended")
+}
@@ -0,0 +1,106 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+)
+
+// FundingTrackerName is an exported subsystem name
+const FundingTrackerName = "funding_tracker"
+
+// Funding transfer states, in the order a transfer moves through them. A
+// transfer ends once it is credited or has failed
+const (
+	FundingStatePending    = "pending"
+	FundingStateBroadcast  = "broadcast"
+	FundingStateConfirming = "confirming"
+	FundingStateCredited   = "credited"
+	FundingStateFailed     = "failed"
+)
+
+// Funding transfer types
+const (
+	FundingTypeDeposit    = "deposit"
+	FundingTypeWithdrawal = "withdrawal"
+)
+
+var (
+	errNilFundingTrackerConfig = errors.New("nil funding tracker config received")
+	errInvalidStuckTimeout     = errors.New("invalid stuck timeout")
+	errInvalidFundingRetention = errors.New("invalid funding retention")
+	errUnknownFundingState     = errors.New("unknown funding state")
+)
+
+// fundingStateKeywords recognises the state of a transfer from the words of
+// the status an exchange reports. The word prefixes are checked in order, so
+// failures win over everything and unconfirmed is caught before confirmed
+var fundingStateKeywords = []struct {
+	state    string
+	prefixes []string
+}{
+	{FundingStateFailed, []string{"fail", "reject", "cancel", "error", "invalid", "expire", "refund", "declin", "revert"}},
+	{FundingStateConfirming, []string{"unconfirm", "confirming", "confirmation"}},
+	{FundingStateCredited, []string{"credit", "complete", "success", "confirmed", "done", "finish", "settle", "ok"}},
+	{FundingStateBroadcast, []string{"broadcast", "sent", "sending", "onchain"}},
+	{FundingStatePending, []string{"pend", "wait", "await", "new", "creat", "queue", "process", "review", "approv", "submit"}},
+}
+
+// FundingTracker follows deposits and withdrawals on each exchange from the
+// moment they appear in the funding history until they are credited or fail,
+// storing each change of state and telling communication relayers when a
+// transfer ends or has been stuck for too long
+type FundingTracker struct {
+	started    atomic.Bool
+	verbose    bool
+	interval   time.Duration
+	stuckAfter time.Duration
+	retention  time.Duration
+	// statuses maps the lower case status of each exchange and transfer type
+	// to a state
+	statuses        map[string]map[string]string
+	exchangeManager iExchangeManager
+	commsManager    iCommsManager
+	transfers       map[string]*FundingTransfer
+	m               sync.RWMutex
+	shutdown        chan struct{}
+	wg              sync.WaitGroup
+}
+
+// FundingTransfer is a deposit or withdrawal followed by the funding tracker.
+// ID is the transfer ID given by the exchange, or the transaction ID when the
+// exchange does not give one
+type FundingTransfer struct {
+	Exchange string
+	ID       string
+	Type     string
+	Currency currency.Code
+	Amount   float64
+	Fee      float64
+	Chain    string
+	Address  string
+	TxID     string
+	// Status is the latest status reported by the exchange
+	Status      string
+	State       string
+	Stuck       bool
+	Timestamp   time.Time
+	FirstSeen   time.Time
+	UpdatedAt   time.Time
+	Transitions []FundingTransition
+
+	// lastSeen is when the exchange last listed the transfer
+	lastSeen time.Time
+}
+
+// FundingTransition is a change in state of a transfer. From is empty for the
+// state a transfer was first seen in
+type FundingTransition struct {
+	From   string
+	To     string
+	Status string
+	Time   time.Time
+}
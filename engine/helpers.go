@@ -17,6 +17,7 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -27,6 +28,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/binance"
@@ -57,6 +59,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/yobit"
 	"github.com/thrasher-corp/gocryptotrader/gctscript/vm"
 	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
 )
 
 var (
@@ -89,6 +92,12 @@ func (bot *Engine) GetSubsystemsStatus() map[string]bool {
 		dispatch.Name:                 dispatch.IsRunning(),
 		dataHistoryManagerName:        bot.dataHistoryManager.IsRunning(),
 		CurrencyStateManagementName:   bot.currencyStateManager.IsRunning(),
+		CandleAggregationManagerName:  bot.candleAggregator.IsRunning(),
+		MetricsManagerName:            bot.metricsManager.IsRunning(),
+		MicrostructureManagerName:     bot.microstructureManager.IsRunning(),
+		ConfigReloaderName:            bot.configReloader.IsRunning(),
+		TreasuryManagerName:           bot.treasuryManager.IsRunning(),
+		FundingTrackerName:            bot.fundingTracker.IsRunning(),
 	}
 }
 
@@ -166,6 +175,9 @@ func (bot *Engine) SetSubsystem(subSystemName string, enable bool) error {
 				if err != nil {
 					return err
 				}
+				if bot.metricsManager != nil {
+					bot.OrderManager.reporter = bot.metricsManager
+				}
 			}
 			return bot.OrderManager.Start(runtimeCtx)
 		}
@@ -208,25 +220,7 @@ func (bot *Engine) SetSubsystem(subSystemName string, enable bool) error {
 	case SyncManagerName:
 		if enable {
 			if bot.currencyPairSyncer == nil {
-				cfg := bot.Config.SyncManagerConfig
-				cfg.SynchronizeTicker = bot.Settings.EnableTickerSyncing
-				cfg.SynchronizeOrderbook = bot.Settings.EnableOrderbookSyncing
-				cfg.SynchronizeContinuously = bot.Settings.SyncContinuously
-				cfg.SynchronizeTrades = bot.Settings.EnableTradeSyncing
-				cfg.Verbose = bot.Settings.Verbose || cfg.Verbose
-
-				if cfg.TimeoutREST != bot.Settings.SyncTimeoutREST &&
-					bot.Settings.SyncTimeoutREST != config.DefaultSyncerTimeoutREST {
-					cfg.TimeoutREST = bot.Settings.SyncTimeoutREST
-				}
-				if cfg.TimeoutWebsocket != bot.Settings.SyncTimeoutWebsocket &&
-					bot.Settings.SyncTimeoutWebsocket != config.DefaultSyncerTimeoutWebsocket {
-					cfg.TimeoutWebsocket = bot.Settings.SyncTimeoutWebsocket
-				}
-				if cfg.NumWorkers != bot.Settings.SyncWorkersCount &&
-					bot.Settings.SyncWorkersCount != config.DefaultSyncerWorkers {
-					cfg.NumWorkers = bot.Settings.SyncWorkersCount
-				}
+				cfg := syncManagerConfig(bot.Config.SyncManagerConfig, &bot.Settings)
 				bot.currencyPairSyncer, err = SetupSyncManager(
 					&cfg,
 					bot.ExchangeManager,
@@ -281,10 +275,185 @@ func (bot *Engine) SetSubsystem(subSystemName string, enable bool) error {
 			return bot.currencyStateManager.Start(runtimeCtx)
 		}
 		return bot.currencyStateManager.Stop()
+	case CandleAggregationManagerName:
+		if enable {
+			if bot.candleAggregator == nil {
+				if err = bot.setupCandleAggregationManager(); err != nil {
+					return err
+				}
+			}
+			return bot.candleAggregator.Start()
+		}
+		return bot.candleAggregator.Stop()
+	case MetricsManagerName:
+		if enable {
+			if bot.metricsManager == nil {
+				if err = bot.setupMetricsManager(); err != nil {
+					return err
+				}
+			}
+			return bot.metricsManager.Start()
+		}
+		return bot.metricsManager.Stop()
+	case MicrostructureManagerName:
+		if enable {
+			if bot.microstructureManager == nil {
+				if err = bot.setupMicrostructureManager(); err != nil {
+					return err
+				}
+			}
+			return bot.microstructureManager.Start()
+		}
+		return bot.microstructureManager.Stop()
+	case ConfigReloaderName:
+		if enable {
+			if bot.configReloader == nil {
+				if err = bot.setupConfigReloader(); err != nil {
+					return err
+				}
+			}
+			return bot.configReloader.Start(runtimeCtx)
+		}
+		return bot.configReloader.Stop()
+	case TreasuryManagerName:
+		if enable {
+			if bot.treasuryManager == nil {
+				if err = bot.setupTreasuryManager(); err != nil {
+					return err
+				}
+			}
+			return bot.treasuryManager.Start(runtimeCtx)
+		}
+		return bot.treasuryManager.Stop()
+	case FundingTrackerName:
+		if enable {
+			if bot.fundingTracker == nil {
+				if err = bot.setupFundingTracker(); err != nil {
+					return err
+				}
+			}
+			return bot.fundingTracker.Start(runtimeCtx)
+		}
+		return bot.fundingTracker.Stop()
 	}
 	return fmt.Errorf("%s: %w", subSystemName, errSubsystemNotFound)
 }
 
+// setupMetricsManager sets up the metrics manager, registers the sync manager
+// orderbook staleness collector and attaches order submission reporting
+func (bot *Engine) setupMetricsManager() error {
+	m, err := SetupMetricsManager(&bot.Config.Metrics)
+	if err != nil {
+		return err
+	}
+	if err := m.registerCollector(func() {
+		if !bot.currencyPairSyncer.IsRunning() {
+			m.collectOrderbookStaleness(nil)
+			return
+		}
+		m.collectOrderbookStaleness(bot.currencyPairSyncer.orderbookStaleness())
+	}); err != nil {
+		return err
+	}
+	if bot.OrderManager != nil {
+		bot.OrderManager.reporter = m
+	}
+	bot.metricsManager = m
+	return nil
+}
+
+// setupCandleAggregationManager sets up the candle aggregation manager and
+// registers it to receive trades from the websocket routine manager
+func (bot *Engine) setupCandleAggregationManager() error {
+	c, err := SetupCandleAggregationManager(&bot.Config.CandleAggregator)
+	if err != nil {
+		return err
+	}
+	if err := bot.WebsocketRoutineManager.registerWebsocketDataHandler(c.websocketDataHandler, false); err != nil {
+		return fmt.Errorf("%s requires the websocket routine manager: %w", CandleAggregationManagerName, err)
+	}
+	bot.candleAggregator = c
+	return nil
+}
+
+// syncManagerConfig returns the sync manager config with the engine sync
+// settings applied
+func syncManagerConfig(cfg config.SyncManagerConfig, s *Settings) config.SyncManagerConfig {
+	cfg.SynchronizeTicker = s.EnableTickerSyncing
+	cfg.SynchronizeOrderbook = s.EnableOrderbookSyncing
+	cfg.SynchronizeContinuously = s.SyncContinuously
+	cfg.SynchronizeTrades = s.EnableTradeSyncing
+	cfg.Verbose = s.Verbose || cfg.Verbose
+
+	if cfg.TimeoutREST != s.SyncTimeoutREST &&
+		s.SyncTimeoutREST != config.DefaultSyncerTimeoutREST {
+		cfg.TimeoutREST = s.SyncTimeoutREST
+	}
+	if cfg.TimeoutWebsocket != s.SyncTimeoutWebsocket &&
+		s.SyncTimeoutWebsocket != config.DefaultSyncerTimeoutWebsocket {
+		cfg.TimeoutWebsocket = s.SyncTimeoutWebsocket
+	}
+	if cfg.NumWorkers != s.SyncWorkersCount &&
+		s.SyncWorkersCount != config.DefaultSyncerWorkers {
+		cfg.NumWorkers = s.SyncWorkersCount
+	}
+	return cfg
+}
+
+// setupMicrostructureManager sets up the microstructure manager and registers it
+// to receive orderbook updates and trades from the websocket routine manager
+func (bot *Engine) setupMicrostructureManager() error {
+	m, err := SetupMicrostructureManager(&bot.Config.Microstructure)
+	if err != nil {
+		return err
+	}
+	if err := bot.WebsocketRoutineManager.registerWebsocketDataHandler(m.websocketDataHandler, false); err != nil {
+		return fmt.Errorf("%s requires the websocket routine manager: %w", MicrostructureManagerName, err)
+	}
+	bot.microstructureManager = m
+	return nil
+}
+
+// setupTreasuryManager sets up the treasury manager to submit its transfers
+// through the withdraw manager safeguards
+func (bot *Engine) setupTreasuryManager() error {
+	if bot.WithdrawManager == nil {
+		return fmt.Errorf("%s %w", TreasuryManagerName, errNilWithdrawManager)
+	}
+	m, err := SetupTreasuryManager(&bot.Config.TreasuryManager, bot.ExchangeManager, bot.WithdrawManager)
+	if err != nil {
+		return err
+	}
+	m.prepareRequest = func(req *withdraw.Request) error {
+		return setWithdrawalSecrets(bot.Config, req)
+	}
+	bot.treasuryManager = m
+	return nil
+}
+
+// setupFundingTracker sets up the funding tracker. Transfers are still
+// followed and stored when communications are disabled, as the communications
+// manager drops events while it is not running
+func (bot *Engine) setupFundingTracker() error {
+	t, err := SetupFundingTracker(&bot.Config.FundingTracker, bot.ExchangeManager, bot.CommunicationsManager)
+	if err != nil {
+		return err
+	}
+	bot.fundingTracker = t
+	return nil
+}
+
+// setupConfigReloader sets up the config reloader to watch the config file the
+// engine was loaded from
+func (bot *Engine) setupConfigReloader() error {
+	r, err := SetupConfigReloader(&bot.Config.ConfigReload, bot.Settings.ConfigFile, bot.ReloadConfig)
+	if err != nil {
+		return err
+	}
+	bot.configReloader = r
+	return nil
+}
+
 // GetExchangeOTPs returns OTP codes for all exchanges which have a otpsecret
 // stored
 func (bot *Engine) GetExchangeOTPs() (map[string]string, error) {
@@ -338,6 +507,87 @@ func (bot *Engine) GetAuthAPISupportedExchanges() []string {
 	return exchangeNames
 }
 
+// CheckCredentialAccess returns an error if the credentials the exchange would
+// use for the context are not permitted operations requiring the access control
+// level. If no credentials are available the exchange will reject the request
+func CheckCredentialAccess(ctx context.Context, exch exchange.IBotExchange, required accounts.AccessControl) error {
+	if exch == nil {
+		return fmt.Errorf("%w: exchange.IBotExchange", common.ErrNilPointer)
+	}
+	creds, err := exch.GetCredentials(ctx)
+	if err != nil {
+		return nil //nolint:nilerr // Authentication errors are returned by the exchange request
+	}
+	if err := creds.CheckAccess(required); err != nil {
+		return fmt.Errorf("%s %w", exch.GetName(), err)
+	}
+	return nil
+}
+
+// setWithdrawalSecrets sets the one time password, PIN and trade password of
+// the exchange's configured credentials on a withdrawal request
+func setWithdrawalSecrets(cfg *config.Config, req *withdraw.Request) error {
+	exchCfg, err := cfg.GetExchangeConfig(req.Exchange)
+	if err != nil {
+		return err
+	}
+
+	if exchCfg.API.Credentials.OTPSecret != "" {
+		code, err := totp.GenerateCode(exchCfg.API.Credentials.OTPSecret, time.Now())
+		if err != nil {
+			return err
+		}
+
+		codeNum, err := strconv.ParseInt(code, 10, 64)
+		if err != nil {
+			return err
+		}
+		req.OneTimePassword = codeNum
+	}
+
+	if exchCfg.API.Credentials.PIN != "" {
+		pinCode, err := strconv.ParseInt(exchCfg.API.Credentials.PIN, 10, 64)
+		if err != nil {
+			return err
+		}
+		req.PIN = pinCode
+	}
+
+	req.TradePassword = exchCfg.API.Credentials.TradePassword
+	return nil
+}
+
+// exchangeAccounts returns the account profiles an exchange can be queried
+// with. The default config credentials are named by an empty string and are
+// omitted when only named account profiles are configured
+func exchangeAccounts(exch exchange.IBotExchange) []string {
+	b := exch.GetBase()
+	names := b.GetAccountNames()
+	if len(names) != 0 && b.GetDefaultCredentials() == nil {
+		return names
+	}
+	return append([]string{""}, names...)
+}
+
+// warnReadOnlyCredentials warns when exchanges with read-only credentials are
+// loaded alongside subsystems which trade
+func (bot *Engine) warnReadOnlyCredentials() {
+	if (!bot.Settings.EnableOrderManager || bot.Settings.EnablePaperTrading) && !bot.Settings.EnableGCTScriptManager {
+		return
+	}
+	exchanges := bot.GetExchanges()
+	for x := range exchanges {
+		if !exchanges[x].IsRESTAuthenticationSupported() && !exchanges[x].IsWebsocketAuthenticationSupported() {
+			continue
+		}
+		creds, err := exchanges[x].GetCredentials(context.Background())
+		if err != nil || creds.AccessControl != accounts.ReadOnlyAccess {
+			continue
+		}
+		log.Warnf(log.Global, "%s credentials are read-only, orders and withdrawals from the order manager and scripts will be refused", exchanges[x].GetName())
+	}
+}
+
 // IsOnline returns whether or not the engine has Internet connectivity
 func (bot *Engine) IsOnline() bool {
 	return bot.connectionManager.IsOnline()
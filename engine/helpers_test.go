@@ -21,6 +21,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/common/convert"
 	"github.com/thrasher-corp/gocryptotrader/common/file"
 	"github.com/thrasher-corp/gocryptotrader/communications"
@@ -28,8 +29,10 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/database"
 	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/currencystate"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/deposit"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/stats"
@@ -99,7 +102,7 @@ func CreateTestBot(tb testing.TB) *Engine {
 }
 
 func TestGetSubsystemsStatus(t *testing.T) {
-	assert.Len(t, (&Engine{}).GetSubsystemsStatus(), 13, "GetSubsystemStatus should return the correct number of subsystems")
+	assert.Len(t, (&Engine{}).GetSubsystemsStatus(), 19, "GetSubsystemStatus should return the correct number of subsystems")
 }
 
 func TestGetRPCEndpoints(t *testing.T) {
@@ -303,6 +306,53 @@ func TestGetAuthAPISupportedExchanges(t *testing.T) {
 	}
 }
 
+// accessControlTestExchange returns an exchange manager holding an exchange
+// with credentials limited to the access control level, without network setup
+func accessControlTestExchange(t *testing.T, level accounts.AccessControl) (*ExchangeManager, exchange.IBotExchange) {
+	t.Helper()
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	require.NoError(t, err, "NewExchangeByName must not error")
+	exch.SetDefaults()
+	b := exch.GetBase()
+	b.States = currencystate.NewCurrencyStates()
+	b.SetCredentials("test", "test", "test", "", "", "")
+	b.API.SetAccessControl(level, nil)
+	require.NoError(t, em.Add(exch), "Add must not error")
+	return em, exch
+}
+
+func TestCheckCredentialAccess(t *testing.T) {
+	t.Parallel()
+	require.ErrorIs(t, CheckCredentialAccess(t.Context(), nil, accounts.TradeAccess), common.ErrNilPointer)
+
+	_, exch := accessControlTestExchange(t, accounts.ReadOnlyAccess)
+	require.NoError(t, CheckCredentialAccess(t.Context(), exch, accounts.ReadOnlyAccess), "CheckCredentialAccess must not error for reads")
+	require.ErrorIs(t, CheckCredentialAccess(t.Context(), exch, accounts.TradeAccess), accounts.ErrAccessDenied)
+
+	ctx := accounts.DeployCredentialsToContext(t.Context(), &accounts.Credentials{Key: "ctx", Secret: "ctx"})
+	require.NoError(t, CheckCredentialAccess(ctx, exch, accounts.WithdrawAccess), "CheckCredentialAccess must not error for context credentials")
+
+	exch.GetBase().SetCredentials("", "", "", "", "", "")
+	require.NoError(t, CheckCredentialAccess(t.Context(), exch, accounts.WithdrawAccess), "CheckCredentialAccess must defer to the exchange without credentials")
+}
+
+func TestExchangeAccounts(t *testing.T) {
+	t.Parallel()
+	_, exch := accessControlTestExchange(t, accounts.ReadOnlyAccess)
+	assert.Equal(t, []string{""}, exchangeAccounts(exch), "exchangeAccounts should return the default credentials")
+
+	b := exch.GetBase()
+	b.SetAccounts([]config.AccountConfig{{Name: "beta"}, {Name: "alpha"}})
+	assert.Equal(t, []string{"", "alpha", "beta"}, exchangeAccounts(exch), "exchangeAccounts should return the default credentials and sorted account profiles")
+
+	exch, err := NewExchangeManager().NewExchangeByName(testExchange)
+	require.NoError(t, err, "NewExchangeByName must not error")
+	exch.SetDefaults()
+	exch.GetBase().SetAccounts([]config.AccountConfig{{Name: "alpha"}})
+	assert.Equal(t, []string{"alpha"}, exchangeAccounts(exch), "exchangeAccounts should omit empty default credentials")
+}
+
 func TestIsOnline(t *testing.T) {
 	t.Parallel()
 	e := CreateTestBot(t)
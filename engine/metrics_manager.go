@@ -0,0 +1,264 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common/key"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchange/websocket"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
+	gctscript "github.com/thrasher-corp/gocryptotrader/gctscript/vm"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// SetupMetricsManager applies configuration parameters before running
+func SetupMetricsManager(cfg *config.Metrics) (*MetricsManager, error) {
+	if cfg == nil {
+		return nil, errNilMetricsConfig
+	}
+	if cfg.ListenAddress == "" {
+		return nil, errMetricsListenAddressEmpty
+	}
+	if !strings.HasPrefix(cfg.Path, "/") {
+		return nil, fmt.Errorf("%w: %q", errMetricsPathInvalid, cfg.Path)
+	}
+	r := &metricsRegistry{}
+	m := &MetricsManager{
+		listenAddress:      cfg.ListenAddress,
+		path:               cfg.Path,
+		registry:           r,
+		requestLatency:     r.newHistogram("gct_exchange_request_duration_seconds", "Exchange REST request round trip latency.", defaultLatencyBuckets, "exchange", "method", "endpoint"),
+		requestRetries:     r.newCounter("gct_exchange_request_retries_total", "Exchange REST request retries.", "exchange", "method", "endpoint"),
+		rateLimitWait:      r.newHistogram("gct_exchange_rate_limit_wait_seconds", "Time spent waiting on the exchange rate limiter before sending a request.", defaultLatencyBuckets, "exchange", "method", "endpoint"),
+		websocketLatency:   r.newHistogram("gct_websocket_request_duration_seconds", "Websocket request and response round trip latency.", defaultLatencyBuckets, "exchange"),
+		websocketMessages:  r.newCounter("gct_websocket_messages_received_total", "Websocket messages read from exchange connections.", "exchange"),
+		websocketReconnect: r.newCounter("gct_websocket_reconnects_total", "Websocket connections reset due to a fault or traffic timeout.", "exchange"),
+		orderSubmissions:   r.newCounter("gct_order_submissions_total", "Order submissions by outcome.", "exchange", "asset", "outcome"),
+		dispatchQueueDepth: r.newGauge("gct_dispatch_queue_depth", "Jobs waiting to be relayed by the dispatch system."),
+		dispatchQueueCap:   r.newGauge("gct_dispatch_queue_capacity", "Maximum jobs the dispatch system can queue."),
+		orderbookStaleness: r.newGauge("gct_orderbook_staleness_seconds", "Time since each synced orderbook was last updated.", "exchange", "asset", "pair"),
+		orderbookChecksums: r.newCounter("gct_orderbook_checksum_failures_total", "Orderbook updates which failed checksum verification.", "exchange", "asset", "pair"),
+		gctScriptVMs:       r.newGauge("gct_gctscript_virtual_machines", "Running gctscript virtual machines."),
+	}
+	m.collectors = []func(){m.collectDispatch, m.collectGCTScript, m.collectOrderbookChecksumFailures}
+	return m, nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (m *MetricsManager) IsRunning() bool {
+	return m != nil && m.started.Load()
+}
+
+// Start runs the subsystem
+func (m *MetricsManager) Start() error {
+	if m == nil {
+		return fmt.Errorf("%s %w", MetricsManagerName, ErrNilSubsystem)
+	}
+	if !m.started.CompareAndSwap(false, true) {
+		return fmt.Errorf("%s %w", MetricsManagerName, ErrSubSystemAlreadyStarted)
+	}
+	log.Debugf(log.Global, "%s %s", MetricsManagerName, MsgSubSystemStarting)
+	ln, err := net.Listen("tcp", m.listenAddress)
+	if err != nil {
+		m.started.Store(false)
+		return fmt.Errorf("%s %w", MetricsManagerName, err)
+	}
+	mux := http.NewServeMux()
+	mux.Handle(m.path, m)
+	m.m.Lock()
+	m.server = &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+		WriteTimeout:      10 * time.Second,
+	}
+	srv := m.server
+	m.m.Unlock()
+	m.wg.Go(func() {
+		if err := srv.Serve(ln); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Errorf(log.Global, "%s serve error: %s", MetricsManagerName, err)
+		}
+	})
+	request.SetupGlobalReporter(&requestMetricsReporter{m: m})
+	websocket.SetupGlobalReporter(&websocketMetricsReporter{m: m})
+	log.Infof(log.Global, "%s listening on http://%s%s", MetricsManagerName, ln.Addr(), m.path)
+	log.Debugf(log.Global, "%s %s", MetricsManagerName, MsgSubSystemStarted)
+	return nil
+}
+
+// Stop stops the subsystem
+func (m *MetricsManager) Stop() error {
+	if m == nil {
+		return fmt.Errorf("%s %w", MetricsManagerName, ErrNilSubsystem)
+	}
+	if !m.started.CompareAndSwap(true, false) {
+		return fmt.Errorf("%s %w", MetricsManagerName, ErrSubSystemNotStarted)
+	}
+	log.Debugf(log.Global, "%s %s", MetricsManagerName, MsgSubSystemShuttingDown)
+	// Requesters and connections already created retain their reporter, only
+	// new ones stop reporting
+	request.SetupGlobalReporter(nil)
+	websocket.SetupGlobalReporter(nil)
+	m.m.Lock()
+	srv := m.server
+	m.server = nil
+	m.m.Unlock()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	err := srv.Shutdown(ctx)
+	m.wg.Wait()
+	if err != nil {
+		return fmt.Errorf("%s %w", MetricsManagerName, err)
+	}
+	log.Debugf(log.Global, "%s %s", MetricsManagerName, MsgSubSystemShutdown)
+	return nil
+}
+
+// ServeHTTP runs all scrape time collectors and writes every metric in the
+// Prometheus text exposition format
+func (m *MetricsManager) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	m.m.Lock()
+	collectors := m.collectors
+	m.m.Unlock()
+	for _, collect := range collectors {
+		collect()
+	}
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	if err := m.registry.write(w); err != nil {
+		log.Errorf(log.Global, "%s failed to write metrics: %s", MetricsManagerName, err)
+	}
+}
+
+// registerCollector adds a function which refreshes gauges on each scrape
+func (m *MetricsManager) registerCollector(fn func()) error {
+	if m == nil {
+		return fmt.Errorf("%s %w", MetricsManagerName, ErrNilSubsystem)
+	}
+	if fn == nil {
+		return errNilMetricsCollector
+	}
+	m.m.Lock()
+	m.collectors = append(m.collectors, fn)
+	m.m.Unlock()
+	return nil
+}
+
+// collectOrderbookStaleness rebuilds the orderbook staleness gauge from the
+// supplied sync manager state
+func (m *MetricsManager) collectOrderbookStaleness(staleness map[key.ExchangeAssetPair]time.Duration) {
+	m.orderbookStaleness.reset()
+	for k, d := range staleness {
+		m.orderbookStaleness.set(d.Seconds(), k.Exchange, k.Asset.String(), k.Pair().String())
+	}
+}
+
+// collectOrderbookChecksumFailures rebuilds the checksum failure counter from
+// the totals held by each orderbook depth
+func (m *MetricsManager) collectOrderbookChecksumFailures() {
+	m.orderbookChecksums.reset()
+	for k, n := range orderbook.ChecksumFailures() {
+		m.orderbookChecksums.set(float64(n), k.Exchange, k.Asset.String(), k.Pair().String())
+	}
+}
+
+func (m *MetricsManager) collectDispatch() {
+	depth, capacity := dispatch.QueueStats()
+	m.dispatchQueueDepth.set(float64(depth))
+	m.dispatchQueueCap.set(float64(capacity))
+}
+
+func (m *MetricsManager) collectGCTScript() {
+	m.gctScriptVMs.set(float64(gctscript.VMSCount.Len()))
+}
+
+// OrderSubmitted records the outcome of an order submission
+func (m *MetricsManager) OrderSubmitted(exchName string, a asset.Item, err error) {
+	outcome := "success"
+	if err != nil {
+		outcome = "failure"
+	}
+	m.orderSubmissions.add(1, exchName, a.String(), outcome)
+}
+
+// Latency records the round trip time of an exchange REST request
+func (r *requestMetricsReporter) Latency(name, method, path string, t time.Duration) {
+	r.m.requestLatency.observe(t.Seconds(), name, method, metricsEndpoint(path))
+}
+
+// Retry records an exchange REST request retry
+func (r *requestMetricsReporter) Retry(name, method, path string) {
+	r.m.requestRetries.add(1, name, method, metricsEndpoint(path))
+}
+
+// RateLimitWait records the time a request waited on the rate limiter
+func (r *requestMetricsReporter) RateLimitWait(name, method, path string, t time.Duration) {
+	r.m.rateLimitWait.observe(t.Seconds(), name, method, metricsEndpoint(path))
+}
+
+// Latency records the round trip time of a websocket request
+func (r *websocketMetricsReporter) Latency(name string, _ []byte, t time.Duration) {
+	r.m.websocketLatency.observe(t.Seconds(), name)
+}
+
+// Message records an inbound websocket message
+func (r *websocketMetricsReporter) Message(name string) {
+	r.m.websocketMessages.add(1, name)
+}
+
+// Reconnect records a websocket connection reset
+func (r *websocketMetricsReporter) Reconnect(name string) {
+	r.m.websocketReconnect.add(1, name)
+}
+
+// metricsEndpoint strips the scheme, host and query string from a request
+// path and collapses segments holding IDs, so parameters such as timestamps,
+// signatures and order IDs do not create a new series for every request
+func metricsEndpoint(path string) string {
+	endpoint, _, _ := strings.Cut(path, "?")
+	if u, err := url.Parse(path); err == nil && u.Path != "" {
+		endpoint = u.Path
+	}
+	segments := strings.Split(endpoint, "/")
+	for i := range segments {
+		if isMetricsIDSegment(segments[i]) {
+			segments[i] = metricsIDPlaceholder
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// isMetricsIDSegment returns whether a path segment is a numeric, UUID or long
+// hex ID rather than part of the endpoint name
+func isMetricsIDSegment(segment string) bool {
+	if segment == "" {
+		return false
+	}
+	if strings.IndexFunc(segment, func(r rune) bool { return r < '0' || r > '9' }) == -1 {
+		return true
+	}
+	if len(segment) == 36 && strings.Count(segment, "-") == 4 {
+		segment = strings.ReplaceAll(segment, "-", "")
+	} else {
+		segment = strings.TrimPrefix(strings.TrimPrefix(segment, "0x"), "0X")
+		if len(segment) < metricsMinHexIDLength {
+			return false
+		}
+	}
+	return strings.IndexFunc(segment, func(r rune) bool {
+		return (r < '0' || r > '9') && (r < 'a' || r > 'f') && (r < 'A' || r > 'F')
+	}) == -1
+}
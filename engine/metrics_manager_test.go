@@ -0,0 +1,143 @@
+package engine
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common/key"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+func TestSetupMetricsManager(t *testing.T) {
+	t.Parallel()
+	_, err := SetupMetricsManager(nil)
+	assert.ErrorIs(t, err, errNilMetricsConfig)
+
+	_, err = SetupMetricsManager(&config.Metrics{Path: "/metrics"})
+	assert.ErrorIs(t, err, errMetricsListenAddressEmpty)
+
+	_, err = SetupMetricsManager(&config.Metrics{ListenAddress: "localhost:0", Path: "metrics"})
+	assert.ErrorIs(t, err, errMetricsPathInvalid)
+
+	m, err := SetupMetricsManager(&config.Metrics{ListenAddress: "localhost:0", Path: "/metrics"})
+	require.NoError(t, err, "SetupMetricsManager must not error")
+	assert.Len(t, m.collectors, 3, "SetupMetricsManager should register the dispatch, gctscript and orderbook checksum collectors")
+}
+
+// TestMetricsManagerStartStop is not run in parallel as it replaces the global
+// request and websocket reporters
+func TestMetricsManagerStartStop(t *testing.T) {
+	var m *MetricsManager
+	assert.ErrorIs(t, m.Start(), ErrNilSubsystem)
+	assert.ErrorIs(t, m.Stop(), ErrNilSubsystem)
+	assert.False(t, m.IsRunning(), "IsRunning should return false on a nil manager")
+
+	m, err := SetupMetricsManager(&config.Metrics{ListenAddress: "localhost:0", Path: "/metrics"})
+	require.NoError(t, err, "SetupMetricsManager must not error")
+	assert.ErrorIs(t, m.Stop(), ErrSubSystemNotStarted)
+	require.NoError(t, m.Start(), "Start must not error")
+	assert.True(t, m.IsRunning(), "IsRunning should return true")
+	assert.ErrorIs(t, m.Start(), ErrSubSystemAlreadyStarted)
+	require.NoError(t, m.Stop(), "Stop must not error")
+	assert.False(t, m.IsRunning(), "IsRunning should return false")
+
+	m.listenAddress = "invalid:address:port"
+	assert.Error(t, m.Start(), "Start should error on an invalid listen address")
+	assert.False(t, m.IsRunning(), "IsRunning should return false when Start fails")
+}
+
+func TestMetricsManagerServeHTTP(t *testing.T) {
+	t.Parallel()
+	m, err := SetupMetricsManager(&config.Metrics{ListenAddress: "localhost:0", Path: "/metrics"})
+	require.NoError(t, err, "SetupMetricsManager must not error")
+
+	assert.ErrorIs(t, m.registerCollector(nil), errNilMetricsCollector)
+	require.NoError(t, m.registerCollector(func() {
+		m.collectOrderbookStaleness(map[key.ExchangeAssetPair]time.Duration{
+			key.NewExchangeAssetPair("Bitstamp", asset.Spot, currency.NewBTCUSD()): 1500 * time.Millisecond,
+		})
+	}), "registerCollector must not error")
+
+	req := &requestMetricsReporter{m: m}
+	req.Latency("Bitstamp", http.MethodGet, "https://www.bitstamp.net/api/v2/ticker/btcusd?nonce=1", 30*time.Millisecond)
+	req.Retry("Bitstamp", http.MethodGet, "https://www.bitstamp.net/api/v2/ticker/btcusd?nonce=2")
+	req.RateLimitWait("Bitstamp", http.MethodGet, "https://www.bitstamp.net/api/v2/ticker/btcusd", 0)
+	ws := &websocketMetricsReporter{m: m}
+	ws.Message("Bitstamp")
+	ws.Message("Bitstamp")
+	ws.Reconnect("Bitstamp")
+	ws.Latency("Bitstamp", nil, time.Second)
+	m.OrderSubmitted("Bitstamp", asset.Spot, nil)
+	m.OrderSubmitted("Bitstamp", asset.Spot, errors.New("rejected"))
+
+	pair := currency.NewPair(currency.ETH, currency.BTC)
+	d, err := orderbook.DeployDepth("MetricsChecksum", pair, asset.Spot)
+	require.NoError(t, err, "DeployDepth must not error")
+	b := &orderbook.Book{Exchange: "MetricsChecksum", Pair: pair, Asset: asset.Spot, LastUpdated: time.Now(), ValidateOrderbook: true}
+	d.AssignOptions(b)
+	require.NoError(t, d.LoadSnapshot(b), "LoadSnapshot must not error")
+	err = d.ProcessUpdate(&orderbook.Update{
+		UpdateTime:       time.Now(),
+		Bids:             orderbook.Levels{{Price: 1, Amount: 1}},
+		ExpectedChecksum: 1,
+		GenerateChecksum: func(*orderbook.Book) uint32 { return 2 },
+	})
+	require.ErrorIs(t, err, orderbook.ErrChecksumMismatch, "ProcessUpdate must error on checksum mismatch")
+
+	rec := httptest.NewRecorder()
+	m.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", http.NoBody))
+	require.Equal(t, http.StatusOK, rec.Code, "ServeHTTP must return OK")
+	assert.Contains(t, rec.Header().Get("Content-Type"), "text/plain", "ServeHTTP should set the text exposition content type")
+	body, err := io.ReadAll(rec.Body)
+	require.NoError(t, err, "ReadAll must not error")
+	for _, line := range []string{
+		`# TYPE gct_exchange_request_duration_seconds histogram`,
+		`gct_exchange_request_duration_seconds_bucket{exchange="Bitstamp",method="GET",endpoint="/api/v2/ticker/btcusd",le="0.05"} 1`,
+		`gct_exchange_request_duration_seconds_bucket{exchange="Bitstamp",method="GET",endpoint="/api/v2/ticker/btcusd",le="0.025"} 0`,
+		`gct_exchange_request_duration_seconds_count{exchange="Bitstamp",method="GET",endpoint="/api/v2/ticker/btcusd"} 1`,
+		`gct_exchange_request_retries_total{exchange="Bitstamp",method="GET",endpoint="/api/v2/ticker/btcusd"} 1`,
+		`gct_exchange_rate_limit_wait_seconds_count{exchange="Bitstamp",method="GET",endpoint="/api/v2/ticker/btcusd"} 1`,
+		`gct_websocket_messages_received_total{exchange="Bitstamp"} 2`,
+		`gct_websocket_reconnects_total{exchange="Bitstamp"} 1`,
+		`gct_websocket_request_duration_seconds_bucket{exchange="Bitstamp",le="+Inf"} 1`,
+		`gct_order_submissions_total{exchange="Bitstamp",asset="spot",outcome="success"} 1`,
+		`gct_order_submissions_total{exchange="Bitstamp",asset="spot",outcome="failure"} 1`,
+		`gct_orderbook_staleness_seconds{exchange="Bitstamp",asset="spot",pair="BTCUSD"} 1.5`,
+		`gct_orderbook_checksum_failures_total{exchange="MetricsChecksum",asset="spot",pair="ETHBTC"} 1`,
+		`# TYPE gct_dispatch_queue_depth gauge`,
+		`gct_gctscript_virtual_machines 0`,
+	} {
+		assert.Contains(t, string(body), line+"\n", "ServeHTTP output should contain the expected line")
+	}
+
+	rec = httptest.NewRecorder()
+	m.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/metrics", http.NoBody))
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code, "ServeHTTP should reject non GET requests")
+}
+
+func TestMetricsEndpoint(t *testing.T) {
+	t.Parallel()
+	for in, exp := range map[string]string{
+		"https://api.exchange.com/v1/orders?symbol=BTCUSD&timestamp=1": "/v1/orders",
+		"/v1/orders?symbol=BTCUSD":                                     "/v1/orders",
+		"/v1/orders":                                                   "/v1/orders",
+		"%zz?bad=1":                                                    "%zz",
+		"/v1/orders/1234567890":                                        "/v1/orders/{id}",
+		"/api/v3/withdrawals/6ba7b810-9dad-11d1-80b4-00c04fd430c8/status":           "/api/v3/withdrawals/{id}/status",
+		"/v2/tx/0x5c504ed432cb51138bcf09aa5e8a410dd4a1e204ef84bfed1be16dfba1b22060": "/v2/tx/{id}",
+		"/v5/order/5f8a3c2b1d4e6f7a": "/v5/order/{id}",
+		"/v1/accounts/feed/add":      "/v1/accounts/feed/add",
+		"/v2/BTCUSD/deadbeef":        "/v2/BTCUSD/deadbeef",
+	} {
+		assert.Equalf(t, exp, metricsEndpoint(in), "metricsEndpoint should return the correct endpoint for %q", in)
+	}
+}
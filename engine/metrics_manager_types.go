@@ -0,0 +1,64 @@
+package engine
+
+import (
+	"errors"
+	"net/http"
+	"sync"
+	"sync/atomic"
+)
+
+// MetricsManagerName is an exported subsystem name
+const MetricsManagerName = "metrics_manager"
+
+const (
+	// metricsIDPlaceholder replaces ID segments of request paths
+	metricsIDPlaceholder = "{id}"
+	// metricsMinHexIDLength is the shortest hex segment treated as an ID, so
+	// short words made of hex letters are kept
+	metricsMinHexIDLength = 16
+)
+
+var (
+	errNilMetricsConfig          = errors.New("nil metrics config received")
+	errMetricsListenAddressEmpty = errors.New("metrics listen address cannot be empty")
+	errMetricsPathInvalid        = errors.New("metrics path must begin with a forward slash")
+	errNilMetricsCollector       = errors.New("metrics collector cannot be nil")
+)
+
+// MetricsManager exposes engine and exchange telemetry on an HTTP endpoint in
+// the Prometheus text exposition format
+type MetricsManager struct {
+	started       atomic.Bool
+	listenAddress string
+	path          string
+	registry      *metricsRegistry
+	server        *http.Server
+	wg            sync.WaitGroup
+	m             sync.Mutex
+	collectors    []func()
+
+	requestLatency     *metricFamily
+	requestRetries     *metricFamily
+	rateLimitWait      *metricFamily
+	websocketLatency   *metricFamily
+	websocketMessages  *metricFamily
+	websocketReconnect *metricFamily
+	orderSubmissions   *metricFamily
+	dispatchQueueDepth *metricFamily
+	dispatchQueueCap   *metricFamily
+	orderbookStaleness *metricFamily
+	orderbookChecksums *metricFamily
+	gctScriptVMs       *metricFamily
+}
+
+// requestMetricsReporter adapts the metrics manager to the exchange REST
+// requester Reporter interfaces
+type requestMetricsReporter struct {
+	m *MetricsManager
+}
+
+// websocketMetricsReporter adapts the metrics manager to the websocket
+// Reporter interfaces
+type websocketMetricsReporter struct {
+	m *MetricsManager
+}
@@ -0,0 +1,233 @@
+package engine
+
+import (
+	"io"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// metricKind defines the Prometheus metric type of a family
+type metricKind uint8
+
+const (
+	counterMetric metricKind = iota
+	gaugeMetric
+	histogramMetric
+)
+
+// defaultLatencyBuckets are histogram upper bounds in seconds suited to
+// network round trips and rate limiter waits
+var defaultLatencyBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// metricsRegistry holds metric families and renders them in the Prometheus
+// text exposition format
+type metricsRegistry struct {
+	m        sync.Mutex
+	families []*metricFamily
+}
+
+// metricFamily is a named metric with a fixed set of label names, holding one
+// series per distinct set of label values
+type metricFamily struct {
+	m       sync.Mutex
+	name    string
+	help    string
+	kind    metricKind
+	labels  []string
+	buckets []float64
+	series  map[string]*metricSeries
+}
+
+// metricSeries is a single labelled time series
+type metricSeries struct {
+	labelValues []string
+	value       float64
+	// histogram fields, bucketCounts are non-cumulative
+	bucketCounts []uint64
+	sum          float64
+	count        uint64
+}
+
+func (r *metricsRegistry) newCounter(name, help string, labels ...string) *metricFamily {
+	return r.register(&metricFamily{name: name, help: help, kind: counterMetric, labels: labels})
+}
+
+func (r *metricsRegistry) newGauge(name, help string, labels ...string) *metricFamily {
+	return r.register(&metricFamily{name: name, help: help, kind: gaugeMetric, labels: labels})
+}
+
+func (r *metricsRegistry) newHistogram(name, help string, buckets []float64, labels ...string) *metricFamily {
+	return r.register(&metricFamily{name: name, help: help, kind: histogramMetric, labels: labels, buckets: buckets})
+}
+
+func (r *metricsRegistry) register(f *metricFamily) *metricFamily {
+	f.series = make(map[string]*metricSeries)
+	r.m.Lock()
+	r.families = append(r.families, f)
+	r.m.Unlock()
+	return f
+}
+
+// get returns the series for the label values, creating it if required. Label
+// values beyond the family's label names are ignored and missing values are
+// left empty. Calling function must hold the family lock
+func (f *metricFamily) get(labelValues []string) *metricSeries {
+	values := make([]string, len(f.labels))
+	copy(values, labelValues)
+	k := strings.Join(values, "\xff")
+	s, ok := f.series[k]
+	if !ok {
+		s = &metricSeries{labelValues: values}
+		if f.kind == histogramMetric {
+			s.bucketCounts = make([]uint64, len(f.buckets))
+		}
+		f.series[k] = s
+	}
+	return s
+}
+
+// add increments a counter or gauge series by v
+func (f *metricFamily) add(v float64, labelValues ...string) {
+	f.m.Lock()
+	f.get(labelValues).value += v
+	f.m.Unlock()
+}
+
+// set sets a gauge series to v
+func (f *metricFamily) set(v float64, labelValues ...string) {
+	f.m.Lock()
+	f.get(labelValues).value = v
+	f.m.Unlock()
+}
+
+// observe records v against a histogram series
+func (f *metricFamily) observe(v float64, labelValues ...string) {
+	f.m.Lock()
+	s := f.get(labelValues)
+	if i, _ := slices.BinarySearch(f.buckets, v); i < len(f.buckets) {
+		s.bucketCounts[i]++
+	}
+	s.sum += v
+	s.count++
+	f.m.Unlock()
+}
+
+// reset removes all series, used by gauges which are rebuilt on each scrape
+func (f *metricFamily) reset() {
+	f.m.Lock()
+	clear(f.series)
+	f.m.Unlock()
+}
+
+// value returns the current value of a counter or gauge series
+func (f *metricFamily) value(labelValues ...string) float64 {
+	f.m.Lock()
+	defer f.m.Unlock()
+	return f.get(labelValues).value
+}
+
+// write renders all registered families in the Prometheus text format
+func (r *metricsRegistry) write(w io.Writer) error {
+	r.m.Lock()
+	families := slices.Clone(r.families)
+	r.m.Unlock()
+	var sb strings.Builder
+	for _, f := range families {
+		f.write(&sb)
+	}
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+func (f *metricFamily) write(w *strings.Builder) {
+	f.m.Lock()
+	defer f.m.Unlock()
+	w.WriteString("# HELP " + f.name + " " + escapeMetricHelp(f.help) + "\n")
+	w.WriteString("# TYPE " + f.name + " " + f.kind.String() + "\n")
+	keys := make([]string, 0, len(f.series))
+	for k := range f.series {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	for _, k := range keys {
+		s := f.series[k]
+		if f.kind != histogramMetric {
+			w.WriteString(f.name + formatMetricLabels(f.labels, s.labelValues, "", "") + " " + formatMetricValue(s.value) + "\n")
+			continue
+		}
+		var cumulative uint64
+		for i, upper := range f.buckets {
+			cumulative += s.bucketCounts[i]
+			w.WriteString(f.name + "_bucket" + formatMetricLabels(f.labels, s.labelValues, "le", formatMetricValue(upper)) + " " + strconv.FormatUint(cumulative, 10) + "\n")
+		}
+		w.WriteString(f.name + "_bucket" + formatMetricLabels(f.labels, s.labelValues, "le", "+Inf") + " " + strconv.FormatUint(s.count, 10) + "\n")
+		w.WriteString(f.name + "_sum" + formatMetricLabels(f.labels, s.labelValues, "", "") + " " + formatMetricValue(s.sum) + "\n")
+		w.WriteString(f.name + "_count" + formatMetricLabels(f.labels, s.labelValues, "", "") + " " + strconv.FormatUint(s.count, 10) + "\n")
+	}
+}
+
+// String returns the Prometheus type name of the metric kind
+func (k metricKind) String() string {
+	switch k {
+	case counterMetric:
+		return "counter"
+	case gaugeMetric:
+		return "gauge"
+	case histogramMetric:
+		return "histogram"
+	default:
+		return "untyped"
+	}
+}
+
+// formatMetricLabels renders a label set, appending an extra label such as a
+// histogram bucket bound when extraName is set
+func formatMetricLabels(names, values []string, extraName, extraValue string) string {
+	if len(names) == 0 && extraName == "" {
+		return ""
+	}
+	var sb strings.Builder
+	sb.WriteByte('{')
+	for i := range names {
+		if i > 0 {
+			sb.WriteByte(',')
+		}
+		sb.WriteString(names[i] + `="` + escapeMetricLabelValue(values[i]) + `"`)
+	}
+	if extraName != "" {
+		if len(names) > 0 {
+			sb.WriteByte(',')
+		}
+		sb.WriteString(extraName + `="` + extraValue + `"`)
+	}
+	sb.WriteByte('}')
+	return sb.String()
+}
+
+func formatMetricValue(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	case math.IsNaN(v):
+		return "NaN"
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+var (
+	metricLabelEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+	metricHelpEscaper  = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+)
+
+func escapeMetricLabelValue(s string) string {
+	return metricLabelEscaper.Replace(s)
+}
+
+func escapeMetricHelp(s string) string {
+	return metricHelpEscaper.Replace(s)
+}
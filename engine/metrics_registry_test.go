@@ -0,0 +1,51 @@
+package engine
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetricsRegistryWrite(t *testing.T) {
+	t.Parallel()
+	r := &metricsRegistry{}
+	c := r.newCounter("test_total", "A test\ncounter.", "name")
+	g := r.newGauge("test_gauge", "A test gauge.")
+	h := r.newHistogram("test_seconds", "A test histogram.", []float64{1, 5}, "name")
+
+	c.add(1, `b"\`)
+	c.add(2, "a")
+	c.add(1, "a", "ignored")
+	g.set(math.Inf(1))
+	h.observe(1, "x")
+	h.observe(3, "x")
+	h.observe(10, "x")
+
+	assert.Equal(t, 3.0, c.value("a"), "value should return the accumulated counter value")
+
+	var sb strings.Builder
+	require.NoError(t, r.write(&sb), "write must not error")
+	assert.Equal(t, `# HELP test_total A test\ncounter.
+# TYPE test_total counter
+test_total{name="a"} 3
+test_total{name="b\"\\"} 1
+# HELP test_gauge A test gauge.
+# TYPE test_gauge gauge
+test_gauge +Inf
+# HELP test_seconds A test histogram.
+# TYPE test_seconds histogram
+test_seconds_bucket{name="x",le="1"} 1
+test_seconds_bucket{name="x",le="5"} 2
+test_seconds_bucket{name="x",le="+Inf"} 3
+test_seconds_sum{name="x"} 14
+test_seconds_count{name="x"} 3
+`, sb.String(), "write should render the Prometheus text exposition format")
+
+	g.reset()
+	sb.Reset()
+	require.NoError(t, r.write(&sb), "write must not error")
+	assert.NotContains(t, sb.String(), "test_gauge +Inf", "reset should remove all gauge series")
+}
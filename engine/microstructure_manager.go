@@ -0,0 +1,342 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/key"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// SetupMicrostructureManager applies configuration parameters before running
+func SetupMicrostructureManager(cfg *config.Microstructure) (*MicrostructureManager, error) {
+	if cfg == nil {
+		return nil, errNilMicrostructureConfig
+	}
+	basisPoints := slices.Clone(cfg.DepthBasisPoints)
+	for i := range basisPoints {
+		if basisPoints[i] <= 0 {
+			return nil, fmt.Errorf("%s %w: %v", MicrostructureManagerName, errInvalidBasisPoints, basisPoints[i])
+		}
+	}
+	if cfg.LargeTradeRatio <= 0 {
+		return nil, fmt.Errorf("%s %w: %v", MicrostructureManagerName, errInvalidLargeTradeRatio, cfg.LargeTradeRatio)
+	}
+	if cfg.RealisedSpreadHorizon <= 0 {
+		return nil, fmt.Errorf("%s %w: %v", MicrostructureManagerName, errInvalidSpreadHorizon, cfg.RealisedSpreadHorizon)
+	}
+	slices.Sort(basisPoints)
+	return &MicrostructureManager{
+		verbose:         cfg.Verbose,
+		basisPoints:     slices.Compact(basisPoints),
+		largeTradeRatio: cfg.LargeTradeRatio,
+		horizon:         cfg.RealisedSpreadHorizon,
+		mux:             dispatch.GetNewMux(nil),
+		books:           make(map[key.ExchangeAssetPair]*microstructureBook),
+	}, nil
+}
+
+// Start runs the subsystem
+func (m *MicrostructureManager) Start() error {
+	if m == nil {
+		return fmt.Errorf("%s %w", MicrostructureManagerName, ErrNilSubsystem)
+	}
+	if !m.started.CompareAndSwap(false, true) {
+		return fmt.Errorf("%s %w", MicrostructureManagerName, ErrSubSystemAlreadyStarted)
+	}
+	log.Debugf(log.OrderBook, "%s %s", MicrostructureManagerName, MsgSubSystemStarted)
+	return nil
+}
+
+// Stop stops the subsystem
+func (m *MicrostructureManager) Stop() error {
+	if m == nil {
+		return fmt.Errorf("%s %w", MicrostructureManagerName, ErrNilSubsystem)
+	}
+	if !m.started.CompareAndSwap(true, false) {
+		return fmt.Errorf("%s %w", MicrostructureManagerName, ErrSubSystemNotStarted)
+	}
+	log.Debugf(log.OrderBook, "%s %s", MicrostructureManagerName, MsgSubSystemShutdown)
+	return nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (m *MicrostructureManager) IsRunning() bool {
+	return m != nil && m.started.Load()
+}
+
+// ProcessDepth computes and publishes the microstructure signals for the
+// current state of an orderbook. Invalidated books are skipped and order flow
+// is measured afresh once the book has been restored.
+func (m *MicrostructureManager) ProcessDepth(d *orderbook.Depth) error {
+	if !m.IsRunning() {
+		return fmt.Errorf("%s %w", MicrostructureManagerName, ErrSubSystemNotStarted)
+	}
+	if d == nil {
+		return fmt.Errorf("%w: *orderbook.Depth", common.ErrNilPointer)
+	}
+	b, err := d.Retrieve()
+	if err != nil {
+		if !errors.Is(err, orderbook.ErrOrderbookInvalid) {
+			return err
+		}
+		k := d.Key()
+		k.Exchange = strings.ToLower(k.Exchange)
+		m.m.Lock()
+		if s, ok := m.books[k]; ok {
+			s.top = nil
+		}
+		m.m.Unlock()
+		return nil
+	}
+	if len(b.Bids) == 0 || len(b.Asks) == 0 {
+		return nil
+	}
+	m.m.Lock()
+	defer m.m.Unlock()
+	s, err := m.getBook(strings.ToLower(b.Exchange), b.Pair, b.Asset)
+	if err != nil {
+		return err
+	}
+	s.latest = s.update(b, m.basisPoints, m.horizon)
+	return m.mux.Publish(s.latest, s.id)
+}
+
+// ProcessTrades records trades against the orderbooks being tracked for their
+// exchange, asset and pair, for book resilience and realised spread
+// measurement. Trades for untracked orderbooks are ignored.
+func (m *MicrostructureManager) ProcessTrades(trades ...trade.Data) error {
+	if !m.IsRunning() {
+		return fmt.Errorf("%s %w", MicrostructureManagerName, ErrSubSystemNotStarted)
+	}
+	m.m.Lock()
+	defer m.m.Unlock()
+	for i := range trades {
+		if s, ok := m.books[key.NewExchangeAssetPair(strings.ToLower(trades[i].Exchange), trades[i].AssetType, trades[i].CurrencyPair)]; ok {
+			s.addTrade(&trades[i], m.largeTradeRatio)
+		}
+	}
+	return nil
+}
+
+// Subscribe returns a dispatch pipe which receives the *MicrostructureSignals
+// computed on every orderbook update for the exchange, pair and asset
+func (m *MicrostructureManager) Subscribe(exchName string, p currency.Pair, a asset.Item) (dispatch.Pipe, error) {
+	if !m.IsRunning() {
+		return dispatch.Pipe{}, fmt.Errorf("%s %w", MicrostructureManagerName, ErrSubSystemNotStarted)
+	}
+	m.m.Lock()
+	s, err := m.getBook(strings.ToLower(exchName), p, a)
+	m.m.Unlock()
+	if err != nil {
+		return dispatch.Pipe{}, err
+	}
+	return m.mux.Subscribe(s.id)
+}
+
+// GetMicrostructure returns the most recent signals computed for the exchange,
+// pair and asset
+func (m *MicrostructureManager) GetMicrostructure(exchName string, p currency.Pair, a asset.Item) (*MicrostructureSignals, error) {
+	if !m.IsRunning() {
+		return nil, fmt.Errorf("%s %w", MicrostructureManagerName, ErrSubSystemNotStarted)
+	}
+	m.m.Lock()
+	defer m.m.Unlock()
+	s, ok := m.books[key.NewExchangeAssetPair(strings.ToLower(exchName), a, p)]
+	if !ok || s.latest == nil {
+		return nil, fmt.Errorf("%w for %s %s %s", errMicrostructureNotTracked, exchName, a, p)
+	}
+	return s.latest, nil
+}
+
+// websocketDataHandler receives websocket data from the websocket routine
+// manager and processes any orderbook updates and trades
+func (m *MicrostructureManager) websocketDataHandler(_ string, data any) error {
+	if !m.IsRunning() {
+		return nil
+	}
+	switch d := data.(type) {
+	case *orderbook.Depth:
+		return m.ProcessDepth(d)
+	case trade.Data:
+		return m.ProcessTrades(d)
+	case []trade.Data:
+		return m.ProcessTrades(d...)
+	}
+	return nil
+}
+
+// getBook returns the tracked state for the exchange, pair and asset, creating
+// it if required. Must be called with the lock held.
+func (m *MicrostructureManager) getBook(exchName string, p currency.Pair, a asset.Item) (*microstructureBook, error) {
+	k := key.NewExchangeAssetPair(exchName, a, p)
+	if s, ok := m.books[k]; ok {
+		return s, nil
+	}
+	id, err := m.mux.GetID()
+	if err != nil {
+		return nil, err
+	}
+	s := &microstructureBook{id: id}
+	m.books[k] = s
+	if m.verbose {
+		log.Debugf(log.OrderBook, "%s tracking %s %s %s", MicrostructureManagerName, exchName, a, p)
+	}
+	return s, nil
+}
+
+// update computes the signals for a non-empty orderbook snapshot, resolving
+// any large trade the book has recovered from and any trades which have
+// passed the realised spread horizon
+func (s *microstructureBook) update(b *orderbook.Book, basisPoints []float64, horizon time.Duration) *MicrostructureSignals {
+	top := &topOfBook{
+		bidPrice:  b.Bids[0].Price,
+		bidAmount: b.Bids[0].Amount,
+		askPrice:  b.Asks[0].Price,
+		askAmount: b.Asks[0].Amount,
+	}
+	mid := (top.bidPrice + top.askPrice) / 2
+	signals := &MicrostructureSignals{
+		Exchange:   strings.ToLower(b.Exchange),
+		Pair:       b.Pair,
+		Asset:      b.Asset,
+		UpdateTime: b.LastUpdated,
+		MidPrice:   mid,
+		Microprice: mid,
+	}
+	if total := top.bidAmount + top.askAmount; total > 0 {
+		signals.Microprice = (top.bidPrice*top.askAmount + top.askPrice*top.bidAmount) / total
+	}
+	if s.top != nil {
+		signals.OrderFlowImbalance = orderFlowImbalance(s.top, top)
+		s.cumulativeOFI += signals.OrderFlowImbalance
+	}
+	s.top = top
+	s.mid = mid
+	signals.CumulativeOrderFlowImbalance = s.cumulativeOFI
+
+	s.depth = depthAtBasisPoints(b, mid, basisPoints)
+	signals.Depth = s.depth
+
+	if s.shock != nil && b.LastUpdated.After(s.shock.at) && len(s.depth) != 0 && sideDepth(s.depth[0], s.shock.side) >= s.shock.baseline {
+		s.resilience = b.LastUpdated.Sub(s.shock.at)
+		s.shock = nil
+	}
+	signals.Resilience = s.resilience
+	signals.Recovering = s.shock != nil
+
+	var sum float64
+	var resolved int
+	s.pending = slices.DeleteFunc(s.pending, func(t spreadTrade) bool {
+		if b.LastUpdated.Sub(t.at) < horizon {
+			return false
+		}
+		sum += 2 * t.sign * (t.price - mid) / t.mid * 10000
+		resolved++
+		return true
+	})
+	if resolved != 0 {
+		s.realisedSpread = sum / float64(resolved)
+	}
+	signals.RealisedSpread = s.realisedSpread
+	return signals
+}
+
+// addTrade queues a trade with a known side for realised spread measurement and
+// starts measuring book resilience if it took a large share of the depth at the
+// tightest distance from the mid price
+func (s *microstructureBook) addTrade(t *trade.Data, largeTradeRatio float64) {
+	if s.mid == 0 {
+		return
+	}
+	var sign float64
+	switch {
+	case t.Side.IsLong():
+		sign = 1
+	case t.Side.IsShort():
+		sign = -1
+	}
+	if sign != 0 {
+		if len(s.pending) >= maxPendingSpreadTrades {
+			s.pending = slices.Delete(s.pending, 0, 1)
+		}
+		s.pending = append(s.pending, spreadTrade{price: t.Price, sign: sign, mid: s.mid, at: t.Timestamp})
+	}
+	if s.shock != nil || len(s.depth) == 0 {
+		return
+	}
+	if available := sideDepth(s.depth[0], t.Side); available > 0 && t.Amount >= available*largeTradeRatio {
+		s.shock = &resilienceShock{side: t.Side, baseline: available, at: t.Timestamp}
+	}
+}
+
+// orderFlowImbalance returns the net change in resting size at the top of the
+// book between two updates. A bid price improvement counts the new bid size as
+// inflow while a bid price drop counts the previous bid size as outflow, with
+// the ask side mirrored and subtracted.
+func orderFlowImbalance(prev, curr *topOfBook) float64 {
+	var bidFlow, askFlow float64
+	switch {
+	case curr.bidPrice > prev.bidPrice:
+		bidFlow = curr.bidAmount
+	case curr.bidPrice == prev.bidPrice:
+		bidFlow = curr.bidAmount - prev.bidAmount
+	default:
+		bidFlow = -prev.bidAmount
+	}
+	switch {
+	case curr.askPrice < prev.askPrice:
+		askFlow = curr.askAmount
+	case curr.askPrice == prev.askPrice:
+		askFlow = curr.askAmount - prev.askAmount
+	default:
+		askFlow = -prev.askAmount
+	}
+	return bidFlow - askFlow
+}
+
+// depthAtBasisPoints sums the resting amount on each side of the book within
+// each distance of the mid price
+func depthAtBasisPoints(b *orderbook.Book, mid float64, basisPoints []float64) []DepthAtBasisPoints {
+	depth := make([]DepthAtBasisPoints, len(basisPoints))
+	for i, bps := range basisPoints {
+		depth[i].BasisPoints = bps
+		floor, ceiling := mid*(1-bps/10000), mid*(1+bps/10000)
+		for j := range b.Bids {
+			if b.Bids[j].Price < floor {
+				break
+			}
+			depth[i].BidAmount += b.Bids[j].Amount
+		}
+		for j := range b.Asks {
+			if b.Asks[j].Price > ceiling {
+				break
+			}
+			depth[i].AskAmount += b.Asks[j].Amount
+		}
+	}
+	return depth
+}
+
+// sideDepth returns the depth a trade on side takes liquidity from; buys lift
+// asks, sells hit bids and trades without a side are measured against both
+func sideDepth(d DepthAtBasisPoints, side order.Side) float64 {
+	switch {
+	case side.IsLong():
+		return d.AskAmount
+	case side.IsShort():
+		return d.BidAmount
+	}
+	return d.BidAmount + d.AskAmount
+}
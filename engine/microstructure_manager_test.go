@@ -0,0 +1,207 @@
+package engine
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+)
+
+func newTestMicrostructureManager(t *testing.T) *MicrostructureManager {
+	t.Helper()
+	m, err := SetupMicrostructureManager(&config.Microstructure{
+		DepthBasisPoints:      []float64{100, 10},
+		LargeTradeRatio:       0.5,
+		RealisedSpreadHorizon: 5 * time.Second,
+	})
+	require.NoError(t, err, "SetupMicrostructureManager must not error")
+	return m
+}
+
+func newTestMicrostructureDepth(t *testing.T) *orderbook.Depth {
+	t.Helper()
+	d := orderbook.NewDepth(uuid.Must(uuid.NewV4()))
+	d.AssignOptions(&orderbook.Book{Exchange: "Test", Pair: currency.NewBTCUSD(), Asset: asset.Spot})
+	return d
+}
+
+func TestSetupMicrostructureManager(t *testing.T) {
+	t.Parallel()
+	_, err := SetupMicrostructureManager(nil)
+	assert.ErrorIs(t, err, errNilMicrostructureConfig)
+
+	cfg := &config.Microstructure{DepthBasisPoints: []float64{10, 0}, LargeTradeRatio: 1, RealisedSpreadHorizon: time.Second}
+	_, err = SetupMicrostructureManager(cfg)
+	assert.ErrorIs(t, err, errInvalidBasisPoints)
+
+	cfg.DepthBasisPoints = []float64{50, 10, 50}
+	cfg.LargeTradeRatio = 0
+	_, err = SetupMicrostructureManager(cfg)
+	assert.ErrorIs(t, err, errInvalidLargeTradeRatio)
+
+	cfg.LargeTradeRatio = 1
+	cfg.RealisedSpreadHorizon = 0
+	_, err = SetupMicrostructureManager(cfg)
+	assert.ErrorIs(t, err, errInvalidSpreadHorizon)
+
+	cfg.RealisedSpreadHorizon = time.Second
+	m, err := SetupMicrostructureManager(cfg)
+	require.NoError(t, err, "SetupMicrostructureManager must not error")
+	assert.Equal(t, []float64{10, 50}, m.basisPoints, "basis points should be sorted and de-duplicated")
+}
+
+func TestMicrostructureManagerStartStop(t *testing.T) {
+	t.Parallel()
+	var m *MicrostructureManager
+	assert.ErrorIs(t, m.Start(), ErrNilSubsystem)
+	assert.ErrorIs(t, m.Stop(), ErrNilSubsystem)
+	assert.False(t, m.IsRunning(), "IsRunning should return false on a nil manager")
+
+	m = newTestMicrostructureManager(t)
+	assert.ErrorIs(t, m.Stop(), ErrSubSystemNotStarted)
+	require.NoError(t, m.Start(), "Start must not error")
+	assert.True(t, m.IsRunning(), "IsRunning should return true")
+	assert.ErrorIs(t, m.Start(), ErrSubSystemAlreadyStarted)
+	require.NoError(t, m.Stop(), "Stop must not error")
+	assert.False(t, m.IsRunning(), "IsRunning should return false")
+}
+
+func TestMicrostructureManagerProcessDepth(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, dispatch.EnsureRunning(dispatch.DefaultMaxWorkers, dispatch.DefaultJobsLimit), "dispatch.EnsureRunning must not error")
+
+	m := newTestMicrostructureManager(t)
+	d := newTestMicrostructureDepth(t)
+	p := currency.NewBTCUSD()
+	assert.ErrorIs(t, m.ProcessDepth(d), ErrSubSystemNotStarted)
+	assert.ErrorIs(t, m.ProcessTrades(), ErrSubSystemNotStarted)
+	_, err := m.Subscribe("test", p, asset.Spot)
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+	_, err = m.GetMicrostructure("test", p, asset.Spot)
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+
+	require.NoError(t, m.Start(), "Start must not error")
+	assert.ErrorIs(t, m.ProcessDepth(nil), common.ErrNilPointer)
+	_, err = m.GetMicrostructure("test", p, asset.Spot)
+	assert.ErrorIs(t, err, errMicrostructureNotTracked)
+
+	pipe, err := m.Subscribe("TEST", p, asset.Spot)
+	require.NoError(t, err, "Subscribe must not error")
+	defer func() { assert.NoError(t, pipe.Release(), "Release should not error") }()
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	bids := orderbook.Levels{{Price: 99.95, Amount: 2}, {Price: 99.9, Amount: 3}, {Price: 99, Amount: 5}}
+	asks := orderbook.Levels{{Price: 100.05, Amount: 1}, {Price: 100.1, Amount: 4}, {Price: 101, Amount: 5}}
+	require.NoError(t, d.LoadSnapshot(&orderbook.Book{Bids: bids, Asks: asks, LastUpdated: start}), "LoadSnapshot must not error")
+	require.NoError(t, m.ProcessDepth(d), "ProcessDepth must not error")
+
+	select {
+	case data := <-pipe.Channel():
+		s, ok := data.(*MicrostructureSignals)
+		require.True(t, ok, "pipe must receive *MicrostructureSignals")
+		assert.Equal(t, "test", s.Exchange, "exchange should be lower case")
+		assert.Equal(t, start, s.UpdateTime, "update time should be set from the book")
+		assert.InDelta(t, 100.0, s.MidPrice, 1e-9, "mid price should be correct")
+		assert.InDelta(t, 300.05/3, s.Microprice, 1e-9, "microprice should weight towards the thinner side")
+		assert.Zero(t, s.OrderFlowImbalance, "order flow imbalance should be zero on the first update")
+		assert.Equal(t, []DepthAtBasisPoints{{BasisPoints: 10, BidAmount: 5, AskAmount: 5}, {BasisPoints: 100, BidAmount: 10, AskAmount: 10}}, s.Depth, "depth should be measured at each distance")
+	case <-time.After(time.Second * 5):
+		require.Fail(t, "pipe must receive microstructure signals")
+	}
+
+	require.NoError(t, m.ProcessTrades(
+		trade.Data{Exchange: "test", CurrencyPair: p, AssetType: asset.Spot, Side: order.Buy, Price: 100.05, Amount: 3, Timestamp: start.Add(time.Second)},
+		trade.Data{Exchange: "test", CurrencyPair: p, AssetType: asset.Futures, Side: order.Buy, Price: 100.05, Amount: 3, Timestamp: start.Add(time.Second)},
+	), "ProcessTrades must not error")
+
+	require.NoError(t, d.LoadSnapshot(&orderbook.Book{Bids: bids, Asks: asks[1:], LastUpdated: start.Add(2 * time.Second)}), "LoadSnapshot must not error")
+	require.NoError(t, m.ProcessDepth(d), "ProcessDepth must not error")
+	s, err := m.GetMicrostructure("test", p, asset.Spot)
+	require.NoError(t, err, "GetMicrostructure must not error")
+	assert.InDelta(t, 1.0, s.OrderFlowImbalance, 1e-9, "ask withdrawal should count as bid pressure")
+	assert.InDelta(t, 1.0, s.CumulativeOrderFlowImbalance, 1e-9, "cumulative order flow imbalance should be correct")
+	assert.True(t, s.Recovering, "depth should be recovering after a large trade")
+	assert.Zero(t, s.RealisedSpread, "realised spread should not be measured before the horizon")
+
+	require.NoError(t, d.LoadSnapshot(&orderbook.Book{Bids: bids, Asks: asks, LastUpdated: start.Add(6 * time.Second)}), "LoadSnapshot must not error")
+	require.NoError(t, m.ProcessDepth(d), "ProcessDepth must not error")
+	s, err = m.GetMicrostructure("test", p, asset.Spot)
+	require.NoError(t, err, "GetMicrostructure must not error")
+	assert.InDelta(t, -1.0, s.OrderFlowImbalance, 1e-9, "ask improvement should count as ask pressure")
+	assert.InDelta(t, 0.0, s.CumulativeOrderFlowImbalance, 1e-9, "cumulative order flow imbalance should be correct")
+	assert.False(t, s.Recovering, "depth should have recovered")
+	assert.Equal(t, 5*time.Second, s.Resilience, "resilience should be the time taken to recover")
+	assert.InDelta(t, 10.0, s.RealisedSpread, 1e-9, "realised spread should be measured against the mid after the horizon")
+
+	require.ErrorIs(t, d.Invalidate(errors.New("test")), orderbook.ErrOrderbookInvalid, "Invalidate must return an invalid book error")
+	require.NoError(t, m.ProcessDepth(d), "ProcessDepth must not error on an invalid book")
+	require.NoError(t, d.LoadSnapshot(&orderbook.Book{Bids: bids[1:], Asks: asks, LastUpdated: start.Add(7 * time.Second)}), "LoadSnapshot must not error")
+	require.NoError(t, m.ProcessDepth(d), "ProcessDepth must not error")
+	s, err = m.GetMicrostructure("test", p, asset.Spot)
+	require.NoError(t, err, "GetMicrostructure must not error")
+	assert.Zero(t, s.OrderFlowImbalance, "order flow imbalance should not be measured across an invalidated book")
+	assert.Equal(t, 5*time.Second, s.Resilience, "resilience should be carried forward")
+	assert.InDelta(t, 10.0, s.RealisedSpread, 1e-9, "realised spread should be carried forward")
+}
+
+func TestMicrostructureManagerWebsocketDataHandler(t *testing.T) {
+	t.Parallel()
+	m := newTestMicrostructureManager(t)
+	d := newTestMicrostructureDepth(t)
+	require.NoError(t, d.LoadSnapshot(&orderbook.Book{
+		Bids:        orderbook.Levels{{Price: 99.95, Amount: 1}},
+		Asks:        orderbook.Levels{{Price: 100.05, Amount: 1}},
+		LastUpdated: time.Now(),
+	}), "LoadSnapshot must not error")
+	assert.NoError(t, m.websocketDataHandler("test", d), "websocketDataHandler should not error when not running")
+
+	require.NoError(t, m.Start(), "Start must not error")
+	assert.NoError(t, m.websocketDataHandler("test", "not a book"), "websocketDataHandler should ignore unrelated data")
+	require.NoError(t, m.websocketDataHandler("test", d), "websocketDataHandler must not error")
+	td := trade.Data{Exchange: "test", CurrencyPair: currency.NewBTCUSD(), AssetType: asset.Spot, Side: order.Sell, Price: 99.95, Amount: 1, Timestamp: time.Now()}
+	require.NoError(t, m.websocketDataHandler("test", td), "websocketDataHandler must not error")
+	require.NoError(t, m.websocketDataHandler("test", []trade.Data{td}), "websocketDataHandler must not error")
+
+	s, err := m.GetMicrostructure("test", currency.NewBTCUSD(), asset.Spot)
+	require.NoError(t, err, "GetMicrostructure must not error")
+	assert.InDelta(t, 100.0, s.MidPrice, 1e-9, "mid price should be correct")
+	require.Len(t, m.books, 1, "must track a single book")
+	for _, b := range m.books {
+		assert.Len(t, b.pending, 2, "both trades should await the realised spread horizon")
+		assert.NotNil(t, b.shock, "a sell taking the bids should be tracked for resilience")
+	}
+}
+
+func TestOrderFlowImbalance(t *testing.T) {
+	t.Parallel()
+	prev := &topOfBook{bidPrice: 100, bidAmount: 2, askPrice: 101, askAmount: 3}
+	for _, tc := range []struct {
+		name string
+		curr *topOfBook
+		ofi  float64
+	}{
+		{"unchanged", &topOfBook{bidPrice: 100, bidAmount: 2, askPrice: 101, askAmount: 3}, 0},
+		{"bid size increase", &topOfBook{bidPrice: 100, bidAmount: 5, askPrice: 101, askAmount: 3}, 3},
+		{"bid price improvement", &topOfBook{bidPrice: 100.5, bidAmount: 1, askPrice: 101, askAmount: 3}, 1},
+		{"bid price drop", &topOfBook{bidPrice: 99.5, bidAmount: 4, askPrice: 101, askAmount: 3}, -2},
+		{"ask size increase", &topOfBook{bidPrice: 100, bidAmount: 2, askPrice: 101, askAmount: 4}, -1},
+		{"ask price improvement", &topOfBook{bidPrice: 100, bidAmount: 2, askPrice: 100.5, askAmount: 1}, -1},
+		{"ask price rise", &topOfBook{bidPrice: 100, bidAmount: 2, askPrice: 101.5, askAmount: 6}, 3},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.InDelta(t, tc.ofi, orderFlowImbalance(prev, tc.curr), 1e-9, "orderFlowImbalance should return the correct value")
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/common/key"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// MicrostructureManagerName is an exported subsystem name
+const MicrostructureManagerName = "microstructure_manager"
+
+// maxPendingSpreadTrades bounds the trades held per pair while they wait for
+// the realised spread horizon to pass
+const maxPendingSpreadTrades = 1000
+
+var (
+	errNilMicrostructureConfig  = errors.New("nil microstructure config received")
+	errInvalidBasisPoints       = errors.New("invalid depth basis points")
+	errInvalidLargeTradeRatio   = errors.New("invalid large trade ratio")
+	errInvalidSpreadHorizon     = errors.New("invalid realised spread horizon")
+	errMicrostructureNotTracked = errors.New("microstructure not tracked")
+)
+
+// MicrostructureManager derives market microstructure signals from each
+// orderbook depth update and publishes them via dispatch, so execution
+// consumers do not need to recompute them from the book
+type MicrostructureManager struct {
+	started         atomic.Bool
+	verbose         bool
+	basisPoints     []float64
+	largeTradeRatio float64
+	horizon         time.Duration
+	mux             *dispatch.Mux
+	books           map[key.ExchangeAssetPair]*microstructureBook
+	m               sync.Mutex
+}
+
+// microstructureBook holds the state carried between updates for a single
+// exchange, asset and pair
+type microstructureBook struct {
+	id            uuid.UUID
+	top           *topOfBook
+	cumulativeOFI float64
+	depth         []DepthAtBasisPoints
+	mid           float64
+	shock         *resilienceShock
+	pending       []spreadTrade
+	latest        *MicrostructureSignals
+	// Carried forward so every update reports the last measured values
+	resilience     time.Duration
+	realisedSpread float64
+}
+
+// topOfBook is the best bid and ask from the previous update, used to measure
+// order flow imbalance
+type topOfBook struct {
+	bidPrice, bidAmount float64
+	askPrice, askAmount float64
+}
+
+// resilienceShock records the depth on the side of the book taken by a large
+// trade, so the time taken for it to be replenished can be measured
+type resilienceShock struct {
+	side     order.Side
+	baseline float64
+	at       time.Time
+}
+
+// spreadTrade is a trade awaiting the realised spread horizon
+type spreadTrade struct {
+	price float64
+	sign  float64
+	mid   float64
+	at    time.Time
+}
+
+// DepthAtBasisPoints is the resting amount on each side of the book within a
+// distance of the mid price
+type DepthAtBasisPoints struct {
+	BasisPoints float64
+	BidAmount   float64
+	AskAmount   float64
+}
+
+// MicrostructureSignals are the signals published by the microstructure manager
+// for each orderbook update.
+// OrderFlowImbalance is the net change in resting size at the top of the book
+// since the previous update, positive when bid pressure increased.
+// Resilience is the time taken for depth to recover from the most recent large
+// trade and Recovering is set while depth remains below its pre-trade level.
+// RealisedSpread is the mean realised spread in basis points of trades whose
+// horizon most recently passed.
+type MicrostructureSignals struct {
+	Exchange                     string
+	Pair                         currency.Pair
+	Asset                        asset.Item
+	UpdateTime                   time.Time
+	MidPrice                     float64
+	Microprice                   float64
+	OrderFlowImbalance           float64
+	CumulativeOrderFlowImbalance float64
+	Depth                        []DepthAtBasisPoints
+	Resilience                   time.Duration
+	Recovering                   bool
+	RealisedSpread               float64
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"sort"
 	"strings"
 	"sync"
@@ -15,9 +16,11 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/communications/base"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/currencystate"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fill"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
@@ -55,13 +58,69 @@ func SetupOrderManager(exchangeManager iExchangeManager, communicationsManager i
 			futuresPositionController: futures.SetupPositionController(),
 		},
 		verbose: cfg.Verbose,
-		cfg: orderManagerConfig{
-			CancelOrdersOnShutdown: cfg.CancelOrdersOnShutdown,
-		},
+	}
+	var err error
+	if om.cfg, err = newOrderManagerConfig(cfg); err != nil {
+		return nil, err
+	}
+	if cfg.PaperTrading != nil && cfg.PaperTrading.Enabled {
+		pt, err := setupPaperTrader(cfg.PaperTrading)
+		if err != nil {
+			return nil, err
+		}
+		om.paperTrader = pt
 	}
 	return om, nil
 }
 
+// newOrderManagerConfig returns the order manager settings for the config
+func newOrderManagerConfig(cfg *config.OrderManager) (orderManagerConfig, error) {
+	if cfg.LimitAmount < 0 {
+		return orderManagerConfig{}, fmt.Errorf("%w: %v", errInvalidOrderLimitAmount, cfg.LimitAmount)
+	}
+	return orderManagerConfig{
+		EnforceLimitConfig:     cfg.EnforceLimits,
+		AllowMarketOrders:      cfg.AllowMarketOrders,
+		CancelOrdersOnShutdown: cfg.CancelOrdersOnShutdown,
+		LimitAmount:            cfg.LimitAmount,
+		AllowedPairs:           slices.Clone(cfg.AllowedPairs),
+		AllowedExchanges:       slices.Clone(cfg.AllowedExchanges),
+	}, nil
+}
+
+// UpdateConfig replaces the order submission limits and shutdown behaviour of
+// the order manager without interrupting order tracking
+func (m *OrderManager) UpdateConfig(cfg *config.OrderManager) error {
+	if m == nil {
+		return fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	if cfg == nil {
+		return fmt.Errorf("%w OrderManager", errNilConfig)
+	}
+	c, err := newOrderManagerConfig(cfg)
+	if err != nil {
+		return err
+	}
+	m.setConfig(c)
+	return nil
+}
+
+// setConfig replaces the order manager settings with a validated config,
+// keeping the order submission retries it was set up with
+func (m *OrderManager) setConfig(c orderManagerConfig) {
+	m.cfgMu.Lock()
+	c.OrderSubmissionRetries = m.cfg.OrderSubmissionRetries
+	m.cfg = c
+	m.cfgMu.Unlock()
+}
+
+// getConfig returns a copy of the order manager settings
+func (m *OrderManager) getConfig() orderManagerConfig {
+	m.cfgMu.RLock()
+	defer m.cfgMu.RUnlock()
+	return m.cfg
+}
+
 // IsRunning safely checks whether the subsystem is running
 func (m *OrderManager) IsRunning() bool {
 	return m != nil && m.started.Load()
@@ -98,7 +157,7 @@ func (m *OrderManager) Stop() error {
 
 // gracefulShutdown cancels all orders (if enabled) before shutting down
 func (m *OrderManager) gracefulShutdown(ctx context.Context) {
-	if !m.cfg.CancelOrdersOnShutdown {
+	if !m.getConfig().CancelOrdersOnShutdown {
 		return
 	}
 	log.Debugln(log.OrderMgr, "Cancelling any open orders...")
@@ -227,7 +286,19 @@ func (m *OrderManager) cancel(ctx context.Context, cancel *order.Cancel, require
 	log.Debugf(log.OrderMgr, "Cancelling order ID %v [%+v]",
 		cancel.OrderID, cancel)
 
-	err = exch.CancelOrder(ctx, cancel)
+	// Cancel with the account profile the order was placed with unless the
+	// caller has chosen one
+	if accounts.AccountFromContext(ctx) == "" {
+		if od, odErr := m.orderStore.getByExchangeAndID(cancel.Exchange, cancel.OrderID); odErr == nil && od.Account != "" {
+			ctx = accounts.DeployAccountToContext(ctx, od.Account)
+		}
+	}
+
+	if m.paperTrader != nil {
+		err = m.paperTrader.cancel(ctx, cancel)
+	} else if err = CheckCredentialAccess(ctx, exch, accounts.TradeAccess); err == nil {
+		err = exch.CancelOrder(ctx, cancel)
+	}
 	if err != nil {
 		err = fmt.Errorf("%v - Failed to cancel order: %w", cancel.Exchange, err)
 		return err
@@ -253,8 +324,9 @@ func (m *OrderManager) cancel(ctx context.Context, cancel *order.Cancel, require
 }
 
 // GetFuturesPositionsForExchange returns futures positions stored within
-// the order manager's futures position tracker that match the provided params
-func (m *OrderManager) GetFuturesPositionsForExchange(exch string, item asset.Item, pair currency.Pair) ([]futures.Position, error) {
+// the order manager's futures position tracker that match the provided params.
+// An empty account returns the positions of the default credentials
+func (m *OrderManager) GetFuturesPositionsForExchange(exch, account string, item asset.Item, pair currency.Pair) ([]futures.Position, error) {
 	if m == nil {
 		return nil, fmt.Errorf("order manager %w", ErrNilSubsystem)
 	}
@@ -265,12 +337,13 @@ func (m *OrderManager) GetFuturesPositionsForExchange(exch string, item asset.It
 		return nil, fmt.Errorf("%v %w", item, futures.ErrNotFuturesAsset)
 	}
 
-	return m.orderStore.futuresPositionController.GetPositionsForExchange(exch, item, pair)
+	return m.orderStore.positionController(account).GetPositionsForExchange(exch, item, pair)
 }
 
 // GetOpenFuturesPosition returns an open futures position stored within
-// the order manager's futures position tracker that match the provided params
-func (m *OrderManager) GetOpenFuturesPosition(exch string, item asset.Item, pair currency.Pair) (*futures.Position, error) {
+// the order manager's futures position tracker that match the provided params.
+// An empty account returns the position of the default credentials
+func (m *OrderManager) GetOpenFuturesPosition(exch, account string, item asset.Item, pair currency.Pair) (*futures.Position, error) {
 	if m == nil {
 		return nil, fmt.Errorf("order manager %w", ErrNilSubsystem)
 	}
@@ -283,12 +356,13 @@ func (m *OrderManager) GetOpenFuturesPosition(exch string, item asset.Item, pair
 	if !m.activelyTrackFuturesPositions {
 		return nil, errFuturesTrackingDisabled
 	}
-	return m.orderStore.futuresPositionController.GetOpenPosition(exch, item, pair)
+	return m.orderStore.positionController(account).GetOpenPosition(exch, item, pair)
 }
 
-// GetAllOpenFuturesPositions returns all open futures positions stored within
-// the order manager's futures position tracker that match the provided params
-func (m *OrderManager) GetAllOpenFuturesPositions() ([]futures.Position, error) {
+// GetAllOpenFuturesPositions returns all open futures positions of an account
+// stored within the order manager's futures position tracker. An empty account
+// returns the positions of the default credentials
+func (m *OrderManager) GetAllOpenFuturesPositions(account string) ([]futures.Position, error) {
 	if m == nil {
 		return nil, fmt.Errorf("order manager %w", ErrNilSubsystem)
 	}
@@ -298,12 +372,12 @@ func (m *OrderManager) GetAllOpenFuturesPositions() ([]futures.Position, error)
 	if !m.activelyTrackFuturesPositions {
 		return nil, errFuturesTrackingDisabled
 	}
-	return m.orderStore.futuresPositionController.GetAllOpenPositions()
+	return m.orderStore.positionController(account).GetAllOpenPositions()
 }
 
 // ClearFuturesTracking will clear existing futures positions for a given exchange,
-// asset, pair for the event that positions have not been tracked accurately
-func (m *OrderManager) ClearFuturesTracking(exch string, item asset.Item, pair currency.Pair) error {
+// account, asset, pair for the event that positions have not been tracked accurately
+func (m *OrderManager) ClearFuturesTracking(exch, account string, item asset.Item, pair currency.Pair) error {
 	if m == nil {
 		return fmt.Errorf("order manager %w", ErrNilSubsystem)
 	}
@@ -314,13 +388,13 @@ func (m *OrderManager) ClearFuturesTracking(exch string, item asset.Item, pair c
 		return fmt.Errorf("%v %w", item, futures.ErrNotFuturesAsset)
 	}
 
-	return m.orderStore.futuresPositionController.ClearPositionsForExchange(exch, item, pair)
+	return m.orderStore.positionController(account).ClearPositionsForExchange(exch, item, pair)
 }
 
 // UpdateOpenPositionUnrealisedPNL finds an open position from
-// an exchange asset pair, then calculates the unrealisedPNL
+// an exchange account asset pair, then calculates the unrealisedPNL
 // using the latest ticker data
-func (m *OrderManager) UpdateOpenPositionUnrealisedPNL(e string, item asset.Item, pair currency.Pair, last float64, updated time.Time) (decimal.Decimal, error) {
+func (m *OrderManager) UpdateOpenPositionUnrealisedPNL(e, account string, item asset.Item, pair currency.Pair, last float64, updated time.Time) (decimal.Decimal, error) {
 	if m == nil {
 		return decimal.Zero, fmt.Errorf("order manager %w", ErrNilSubsystem)
 	}
@@ -331,7 +405,7 @@ func (m *OrderManager) UpdateOpenPositionUnrealisedPNL(e string, item asset.Item
 		return decimal.Zero, fmt.Errorf("%v %w", item, futures.ErrNotFuturesAsset)
 	}
 
-	return m.orderStore.futuresPositionController.UpdateOpenPositionUnrealisedPNL(e, item, pair, last, updated)
+	return m.orderStore.positionController(account).UpdateOpenPositionUnrealisedPNL(e, item, pair, last, updated)
 }
 
 // GetOrderInfo calls the exchange's wrapper GetOrderInfo function
@@ -356,6 +430,7 @@ func (m *OrderManager) GetOrderInfo(ctx context.Context, exchangeName, orderID s
 	if err != nil {
 		return order.Detail{}, err
 	}
+	result.Account = accounts.AccountFromContext(ctx)
 
 	upsertResponse, err := m.orderStore.upsert(result)
 	if err != nil {
@@ -379,21 +454,21 @@ func (m *OrderManager) validate(exch exchange.IBotExchange, newOrder *order.Subm
 		return fmt.Errorf("order manager: %w", err)
 	}
 
-	if m.cfg.EnforceLimitConfig {
-		if !m.cfg.AllowMarketOrders && newOrder.Type == order.Market {
+	if cfg := m.getConfig(); cfg.EnforceLimitConfig {
+		if !cfg.AllowMarketOrders && newOrder.Type == order.Market {
 			return errors.New("order market type is not allowed")
 		}
 
-		if m.cfg.LimitAmount > 0 && newOrder.Amount > m.cfg.LimitAmount {
+		if cfg.LimitAmount > 0 && newOrder.Amount > cfg.LimitAmount {
 			return errors.New("order limit exceeds allowed limit")
 		}
 
-		if len(m.cfg.AllowedExchanges) > 0 &&
-			!common.StringSliceCompareInsensitive(m.cfg.AllowedExchanges, newOrder.Exchange) {
+		if len(cfg.AllowedExchanges) > 0 &&
+			!common.StringSliceCompareInsensitive(cfg.AllowedExchanges, newOrder.Exchange) {
 			return errors.New("order exchange not found in allowed list")
 		}
 
-		if len(m.cfg.AllowedPairs) > 0 && !m.cfg.AllowedPairs.Contains(newOrder.Pair, true) {
+		if len(cfg.AllowedPairs) > 0 && !cfg.AllowedPairs.Contains(newOrder.Pair, true) {
 			return errors.New("order pair not found in allowed list")
 		}
 	}
@@ -436,7 +511,15 @@ func (m *OrderManager) Modify(ctx context.Context, mod *order.Modify) (*order.Mo
 	if err != nil {
 		return nil, err
 	}
-	res, err := exch.ModifyOrder(ctx, mod)
+	if det.Account != "" && accounts.AccountFromContext(ctx) == "" {
+		ctx = accounts.DeployAccountToContext(ctx, det.Account)
+	}
+	var res *order.ModifyResponse
+	if m.paperTrader != nil {
+		res, err = m.paperTrader.modify(ctx, mod)
+	} else if err = CheckCredentialAccess(ctx, exch, accounts.TradeAccess); err == nil {
+		res, err = exch.ModifyOrder(ctx, mod)
+	}
 	if err != nil {
 		message := fmt.Sprintf(
 			"Exchange %s order ID=%v: failed to modify",
@@ -467,12 +550,24 @@ func (m *OrderManager) Modify(ctx context.Context, mod *order.Modify) (*order.Mo
 		Type:    "order",
 		Message: fmt.Sprintf(message, mod.Exchange, res.OrderID),
 	})
+	if m.paperTrader != nil {
+		// A modified price may now cross the book so match straight away
+		m.processPaperOrders(ctx)
+	}
 	return &order.ModifyResponse{OrderID: res.OrderID}, err
 }
 
 // Submit will take in an order struct, send it to the exchange and
 // populate it in the OrderManager if successful
 func (m *OrderManager) Submit(ctx context.Context, newOrder *order.Submit) (*OrderSubmitResponse, error) {
+	resp, err := m.submit(ctx, newOrder)
+	if m != nil && m.reporter != nil && newOrder != nil {
+		m.reporter.OrderSubmitted(newOrder.Exchange, newOrder.AssetType, err)
+	}
+	return resp, err
+}
+
+func (m *OrderManager) submit(ctx context.Context, newOrder *order.Submit) (*OrderSubmitResponse, error) {
 	if m == nil {
 		return nil, fmt.Errorf("order manager %w", ErrNilSubsystem)
 	}
@@ -513,12 +608,20 @@ func (m *OrderManager) Submit(ctx context.Context, newOrder *order.Submit) (*Ord
 			err)
 	}
 
+	if m.paperTrader != nil {
+		return m.submitPaperOrder(ctx, newOrder)
+	}
+
+	if err := CheckCredentialAccess(ctx, exch, accounts.TradeAccess); err != nil {
+		return nil, err
+	}
+
 	result, err := exch.SubmitOrder(ctx, newOrder)
 	if err != nil {
 		return nil, err
 	}
 
-	return m.processSubmittedOrder(result)
+	return m.processSubmittedOrder(result, accounts.AccountFromContext(ctx))
 }
 
 // SubmitFakeOrder runs through the same process as order submission
@@ -555,7 +658,106 @@ func (m *OrderManager) SubmitFakeOrder(newOrder *order.Submit, resultingOrder *o
 				err)
 		}
 	}
-	return m.processSubmittedOrder(resultingOrder)
+	return m.processSubmittedOrder(resultingOrder, "")
+}
+
+// IsPaperTrading returns whether orders are routed to the simulated matching
+// engine instead of live exchanges
+func (m *OrderManager) IsPaperTrading() bool {
+	return m != nil && m.paperTrader != nil
+}
+
+// cancelPaperOrders cancels simulated orders, returning the status of each
+// by order ID
+func (m *OrderManager) cancelPaperOrders(ctx context.Context, cancels []order.Cancel) map[string]string {
+	status := make(map[string]string, len(cancels))
+	for i := range cancels {
+		if err := m.Cancel(ctx, &cancels[i]); err != nil {
+			status[cancels[i].OrderID] = err.Error()
+			continue
+		}
+		status[cancels[i].OrderID] = order.Cancelled.String()
+	}
+	return status
+}
+
+// GetPaperTradingBalances returns the simulated balances held for an exchange
+// asset when paper trading is enabled
+func (m *OrderManager) GetPaperTradingBalances(exchName string, a asset.Item) (accounts.CurrencyBalances, error) {
+	if m == nil {
+		return nil, fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	if m.paperTrader == nil {
+		return nil, errPaperTradingDisabled
+	}
+	return m.paperTrader.balances(exchName, a)
+}
+
+// submitPaperOrder fills an order through the simulated matching engine and
+// stores the result as though it was returned by the exchange
+func (m *OrderManager) submitPaperOrder(ctx context.Context, newOrder *order.Submit) (*OrderSubmitResponse, error) {
+	result, fills, err := m.paperTrader.submit(ctx, newOrder)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := m.processSubmittedOrder(result, accounts.AccountFromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	// DeriveDetail does not carry execution amounts, so apply them here
+	resp.ExecutedAmount = result.Amount - result.RemainingAmount
+	resp.RemainingAmount = result.RemainingAmount
+	resp.AverageExecutedPrice = result.AverageExecutedPrice
+	if err := m.orderStore.updatePaperExecution(resp.Detail); err != nil {
+		return nil, err
+	}
+	m.emitPaperFills(fills)
+	return resp, nil
+}
+
+// processPaperOrders matches resting paper orders against the latest
+// orderbook depth and updates the order store with any fills
+func (m *OrderManager) processPaperOrders(ctx context.Context) {
+	updated, fills, err := m.paperTrader.match(ctx)
+	if err != nil {
+		log.Errorf(log.OrderMgr, "Paper trading unable to match resting orders: %v", err)
+	}
+	for i := range updated {
+		if err := m.orderStore.updatePaperExecution(&updated[i]); err != nil {
+			log.Errorf(log.OrderMgr, "Unable to update %v paper order %v: %v", updated[i].Exchange, updated[i].OrderID, err)
+			continue
+		}
+		msg := fmt.Sprintf("Exchange %s paper order ID=%v %v executed=%v remaining=%v average price=%v.",
+			updated[i].Exchange,
+			updated[i].OrderID,
+			updated[i].Status,
+			updated[i].ExecutedAmount,
+			updated[i].RemainingAmount,
+			updated[i].AverageExecutedPrice)
+		log.Debugln(log.OrderMgr, msg)
+		m.orderStore.commsManager.PushEvent(base.Event{Type: "order", Message: msg})
+	}
+	m.emitPaperFills(fills)
+}
+
+// emitPaperFills sends simulated fills through the exchange fill feed so
+// they are handled the same way as live websocket fills
+func (m *OrderManager) emitPaperFills(fills []fill.Data) {
+	for i := range fills {
+		exch, err := m.orderStore.exchangeManager.GetExchangeByName(fills[i].Exchange)
+		if err != nil {
+			log.Errorf(log.OrderMgr, "Unable to emit paper fill: %v", err)
+			continue
+		}
+		ws, err := exch.GetWebsocket()
+		if err != nil {
+			// No websocket means there is no fill feed to send to
+			continue
+		}
+		if err := ws.Fills.Update(fills[i]); err != nil && !errors.Is(err, fill.ErrFeedDisabled) {
+			log.Errorf(log.OrderMgr, "Unable to emit %v paper fill for order %v: %v", fills[i].Exchange, fills[i].OrderID, err)
+		}
+	}
 }
 
 // GetOrdersSnapshot returns a snapshot of all orders in the orderstore. It optionally filters any orders that do not match the status
@@ -602,8 +804,9 @@ func (m *OrderManager) GetOrdersActive(f *order.Filter) ([]order.Detail, error)
 	return m.orderStore.getActiveOrders(f), nil
 }
 
-// processSubmittedOrder adds a new order to the manager
-func (m *OrderManager) processSubmittedOrder(newOrderResp *order.SubmitResponse) (*OrderSubmitResponse, error) {
+// processSubmittedOrder adds a new order to the manager, tagged with the
+// account profile it was submitted with
+func (m *OrderManager) processSubmittedOrder(newOrderResp *order.SubmitResponse, account string) (*OrderSubmitResponse, error) {
 	if newOrderResp == nil {
 		return nil, order.ErrOrderDetailIsNil
 	}
@@ -617,6 +820,7 @@ func (m *OrderManager) processSubmittedOrder(newOrderResp *order.SubmitResponse)
 	if err != nil {
 		return nil, err
 	}
+	detail.Account = account
 
 	if err := m.orderStore.add(detail.CopyToPointer()); errors.Is(err, ErrOrdersAlreadyExists) {
 		// Streamed by ws before we got here. Details from ws supersede since they are more recent.
@@ -653,6 +857,10 @@ func (m *OrderManager) processOrders(ctx context.Context) {
 		return
 	}
 	defer m.processingOrders.Store(false)
+	if m.paperTrader != nil {
+		m.processPaperOrders(ctx)
+		return
+	}
 	exchanges, err := m.orderStore.exchangeManager.GetExchanges()
 	if err != nil {
 		log.Errorf(log.OrderMgr, "order manager cannot get exchanges: %v", err)
@@ -668,109 +876,124 @@ func (m *OrderManager) processOrders(ctx context.Context) {
 				"Processing orders for exchange %v",
 				exchanges[x].GetName())
 		}
-		enabledAssets := exchanges[x].GetAssetTypes(true)
-		for y := range enabledAssets {
-			var pairs currency.Pairs
-			pairs, err = exchanges[x].GetEnabledPairs(enabledAssets[y])
-			if err != nil {
-				log.Errorf(log.OrderMgr,
-					"Unable to get enabled pairs for %s and asset type %s: %s",
-					exchanges[x].GetName(),
-					enabledAssets[y],
-					err)
-				continue
-			}
+		for _, account := range exchangeAccounts(exchanges[x]) {
+			m.processAccountOrders(ctx, exchanges[x], account, &wg)
+		}
+	}
+	wg.Wait()
+	if m.verbose {
+		log.Debugf(log.OrderMgr, "Finished processing orders")
+	}
+}
 
-			if len(pairs) == 0 {
-				if m.verbose {
-					log.Debugf(log.OrderMgr,
-						"No pairs enabled for %s and asset type %s, skipping...",
-						exchanges[x].GetName(),
-						enabledAssets[y])
-				}
-				continue
+// processAccountOrders fetches the active orders of an exchange account profile
+// and adds them to the internal order store. Futures positions are only
+// tracked for the default credentials
+func (m *OrderManager) processAccountOrders(ctx context.Context, exch exchange.IBotExchange, account string, wg *sync.WaitGroup) {
+	var err error
+	if account != "" {
+		ctx = accounts.DeployAccountToContext(ctx, account)
+	}
+	enabledAssets := exch.GetAssetTypes(true)
+	for y := range enabledAssets {
+		var pairs currency.Pairs
+		pairs, err = exch.GetEnabledPairs(enabledAssets[y])
+		if err != nil {
+			log.Errorf(log.OrderMgr,
+				"Unable to get enabled pairs for %s and asset type %s: %s",
+				exch.GetName(),
+				enabledAssets[y],
+				err)
+			continue
+		}
+
+		if len(pairs) == 0 {
+			if m.verbose {
+				log.Debugf(log.OrderMgr,
+					"No pairs enabled for %s and asset type %s, skipping...",
+					exch.GetName(),
+					enabledAssets[y])
 			}
+			continue
+		}
 
-			filter := &order.Filter{Exchange: exchanges[x].GetName()}
-			orders := m.orderStore.getActiveOrders(filter)
-			order.FilterOrdersByPairs(&orders, pairs)
-			var result []order.Detail
-			result, err = exchanges[x].GetActiveOrders(ctx, &order.MultiOrderRequest{
-				Side:      order.AnySide,
-				Type:      order.AnyType,
-				Pairs:     pairs,
-				AssetType: enabledAssets[y],
-			})
+		filter := &order.Filter{Exchange: exch.GetName()}
+		orders := m.orderStore.getActiveOrders(filter)
+		orders = slices.DeleteFunc(orders, func(d order.Detail) bool { return d.Account != account })
+		order.FilterOrdersByPairs(&orders, pairs)
+		var result []order.Detail
+		result, err = exch.GetActiveOrders(ctx, &order.MultiOrderRequest{
+			Side:      order.AnySide,
+			Type:      order.AnyType,
+			Pairs:     pairs,
+			AssetType: enabledAssets[y],
+		})
+		if err != nil {
+			log.Errorf(log.OrderMgr,
+				"Unable to get active orders for %s and asset type %s: %s",
+				exch.GetName(),
+				enabledAssets[y],
+				err)
+			continue
+		}
+		for z := range result {
+			result[z].Account = account
+			var upsertResponse *OrderUpsertResponse
+			upsertResponse, err = m.UpsertOrder(&result[z])
 			if err != nil {
-				log.Errorf(log.OrderMgr,
-					"Unable to get active orders for %s and asset type %s: %s",
-					exchanges[x].GetName(),
-					enabledAssets[y],
-					err)
+				log.Errorln(log.OrderMgr, err)
 				continue
 			}
-			for z := range result {
-				var upsertResponse *OrderUpsertResponse
-				upsertResponse, err = m.UpsertOrder(&result[z])
-				if err != nil {
-					log.Errorln(log.OrderMgr, err)
+			for i := range orders {
+				if orders[i].InternalOrderID != upsertResponse.OrderDetails.InternalOrderID {
 					continue
 				}
-				for i := range orders {
-					if orders[i].InternalOrderID != upsertResponse.OrderDetails.InternalOrderID {
-						continue
-					}
-					orders[i] = orders[len(orders)-1]
-					orders = orders[:len(orders)-1]
-					break
-				}
+				orders[i] = orders[len(orders)-1]
+				orders = orders[:len(orders)-1]
+				break
 			}
+		}
 
-			if exchanges[x].GetBase().GetSupportedFeatures().RESTCapabilities.GetOrder {
-				wg.Add(1)
-				go m.processMatchingOrders(ctx, exchanges[x], orders, &wg)
-			}
+		if exch.GetBase().GetSupportedFeatures().RESTCapabilities.GetOrder {
+			wg.Add(1)
+			go m.processMatchingOrders(ctx, exch, orders, wg)
+		}
 
-			supportedFeatures := exchanges[x].GetSupportedFeatures()
-			if m.activelyTrackFuturesPositions && enabledAssets[y].IsFutures() && supportedFeatures.FuturesCapabilities.OrderManagerPositionTracking {
-				var positions []futures.PositionResponse
-				var sd time.Time
-				sd, err = m.orderStore.futuresPositionController.LastUpdated()
-				if err != nil {
+		supportedFeatures := exch.GetSupportedFeatures()
+		if m.activelyTrackFuturesPositions && enabledAssets[y].IsFutures() && supportedFeatures.FuturesCapabilities.OrderManagerPositionTracking {
+			var positions []futures.PositionResponse
+			var sd time.Time
+			sd, err = m.orderStore.positionController(account).LastUpdated()
+			if err != nil {
+				log.Errorln(log.OrderMgr, err)
+				return
+			}
+			if sd.IsZero() {
+				sd = time.Now().Add(-m.futuresPositionSeekDuration)
+			}
+			positions, err = exch.GetFuturesPositionOrders(ctx, &futures.PositionsRequest{
+				Asset:                     enabledAssets[y],
+				Pairs:                     pairs,
+				StartDate:                 sd,
+				RespectOrderHistoryLimits: m.respectOrderHistoryLimits,
+			})
+			if err != nil {
+				if !errors.Is(err, common.ErrNotYetImplemented) {
 					log.Errorln(log.OrderMgr, err)
-					return
 				}
-				if sd.IsZero() {
-					sd = time.Now().Add(-m.futuresPositionSeekDuration)
+				return
+			}
+			for z := range positions {
+				if len(positions[z].Orders) == 0 {
+					continue
 				}
-				positions, err = exchanges[x].GetFuturesPositionOrders(ctx, &futures.PositionsRequest{
-					Asset:                     enabledAssets[y],
-					Pairs:                     pairs,
-					StartDate:                 sd,
-					RespectOrderHistoryLimits: m.respectOrderHistoryLimits,
-				})
+				err = m.processFuturesPositions(ctx, exch, &positions[z])
 				if err != nil {
-					if !errors.Is(err, common.ErrNotYetImplemented) {
-						log.Errorln(log.OrderMgr, err)
-					}
-					return
-				}
-				for z := range positions {
-					if len(positions[z].Orders) == 0 {
-						continue
-					}
-					err = m.processFuturesPositions(ctx, exchanges[x], &positions[z])
-					if err != nil {
-						log.Errorf(log.OrderMgr, "unable to process future positions for %v %v %v. err: %v", exchanges[x].GetName(), positions[z].Asset, positions[z].Pair, err)
-					}
+					log.Errorf(log.OrderMgr, "unable to process future positions for %v %v %v. err: %v", exch.GetName(), positions[z].Asset, positions[z].Pair, err)
 				}
 			}
 		}
 	}
-	wg.Wait()
-	if m.verbose {
-		log.Debugf(log.OrderMgr, "Finished processing orders")
-	}
 }
 
 // processFuturesPositions ensures any open position found is kept up to date in the order manager
@@ -791,14 +1014,17 @@ func (m *OrderManager) processFuturesPositions(ctx context.Context, exch exchang
 		return position.Orders[i].Date.Before(position.Orders[j].Date)
 	})
 	feat := exch.GetSupportedFeatures()
+	account := accounts.AccountFromContext(ctx)
+	controller := m.orderStore.positionController(account)
 	var err error
 	for i := range position.Orders {
-		err = m.orderStore.futuresPositionController.TrackNewOrder(&position.Orders[i])
+		position.Orders[i].Account = account
+		err = controller.TrackNewOrder(&position.Orders[i])
 		if err != nil {
 			return err
 		}
 	}
-	_, err = m.orderStore.futuresPositionController.GetOpenPosition(exch.GetName(), position.Asset, position.Pair)
+	_, err = controller.GetOpenPosition(exch.GetName(), position.Asset, position.Pair)
 	if err != nil {
 		if errors.Is(err, futures.ErrPositionNotFound) {
 			return nil
@@ -809,7 +1035,7 @@ func (m *OrderManager) processFuturesPositions(ctx context.Context, exch exchang
 	if err != nil {
 		return fmt.Errorf("%w when fetching ticker data for %v %v %v", err, exch.GetName(), position.Asset, position.Pair)
 	}
-	_, err = m.UpdateOpenPositionUnrealisedPNL(exch.GetName(), position.Asset, position.Pair, tick.Last, tick.LastUpdated)
+	_, err = m.UpdateOpenPositionUnrealisedPNL(exch.GetName(), account, position.Asset, position.Pair, tick.Last, tick.LastUpdated)
 	if err != nil {
 		return fmt.Errorf("%w when updating unrealised PNL for %v %v %v", err, exch.GetName(), position.Asset, position.Pair)
 	}
@@ -835,7 +1061,7 @@ func (m *OrderManager) processFuturesPositions(ctx context.Context, exch exchang
 		return err
 	}
 
-	return m.orderStore.futuresPositionController.TrackFundingDetails(frp)
+	return controller.TrackFundingDetails(frp)
 }
 
 func (m *OrderManager) processMatchingOrders(ctx context.Context, exch exchange.IBotExchange, orders []order.Detail, wg *sync.WaitGroup) {
@@ -858,11 +1084,15 @@ func (m *OrderManager) FetchAndUpdateExchangeOrder(ctx context.Context, exch exc
 	if ord == nil {
 		return errors.New("order manager: Order is nil")
 	}
+	if ord.Account != "" {
+		ctx = accounts.DeployAccountToContext(ctx, ord.Account)
+	}
 	fetchedOrder, err := exch.GetOrderInfo(ctx, ord.OrderID, ord.Pair, assetType)
 	if err != nil {
 		ord.Status = order.UnknownStatus
 		return err
 	}
+	fetchedOrder.Account = ord.Account
 	fetchedOrder.LastUpdated = time.Now()
 	_, err = m.UpsertOrder(fetchedOrder)
 	return err
@@ -1005,7 +1235,7 @@ func (s *store) updateExisting(od *order.Detail) error {
 		if !r[x].AssetType.IsFutures() {
 			return nil
 		}
-		err = s.futuresPositionController.TrackNewOrder(r[x])
+		err = s.positionController(r[x].Account).TrackNewOrder(r[x])
 		if err != nil && !errors.Is(err, futures.ErrPositionClosed) {
 			return err
 		}
@@ -1014,6 +1244,29 @@ func (s *store) updateExisting(od *order.Detail) error {
 	return ErrOrderNotFound
 }
 
+// updatePaperExecution updates an existing order with simulated execution
+// details. The executed and remaining amounts are set directly as the paper
+// trader is the source of truth and a remaining amount of zero is valid.
+func (s *store) updatePaperExecution(od *order.Detail) error {
+	if od == nil {
+		return errNilOrder
+	}
+	executed, remaining := od.ExecutedAmount, od.RemainingAmount
+	if err := s.updateExisting(od); err != nil {
+		return err
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+	for _, o := range s.Orders[strings.ToLower(od.Exchange)] {
+		if o.OrderID == od.OrderID {
+			o.ExecutedAmount = executed
+			o.RemainingAmount = remaining
+			return nil
+		}
+	}
+	return ErrOrderNotFound
+}
+
 // modifyExisting depends on mod.Exchange and given ID to uniquely identify an order and
 // modify it.
 func (s *store) modifyExisting(id string, mod *order.ModifyResponse) error {
@@ -1031,7 +1284,7 @@ func (s *store) modifyExisting(id string, mod *order.ModifyResponse) error {
 		if !r[x].AssetType.IsFutures() {
 			return nil
 		}
-		err := s.futuresPositionController.TrackNewOrder(r[x])
+		err := s.positionController(r[x].Account).TrackNewOrder(r[x])
 		if err != nil && !errors.Is(err, futures.ErrPositionClosed) {
 			return err
 		}
@@ -1054,7 +1307,7 @@ func (s *store) upsert(od *order.Detail) (*OrderUpsertResponse, error) {
 	s.m.Lock()
 	defer s.m.Unlock()
 	if od.AssetType.IsFutures() {
-		err = s.futuresPositionController.TrackNewOrder(od)
+		err = s.positionController(od.Account).TrackNewOrder(od)
 		if err != nil && !errors.Is(err, futures.ErrPositionClosed) {
 			return nil, err
 		}
@@ -1130,7 +1383,28 @@ func (s *store) add(det *order.Detail) error {
 	if !det.AssetType.IsFutures() {
 		return nil
 	}
-	return s.futuresPositionController.TrackNewOrder(det)
+	return s.positionController(det.Account).TrackNewOrder(det)
+}
+
+// positionController returns the futures position controller of an account,
+// setting one up on first use. An empty account uses the controller of the
+// default credentials
+func (s *store) positionController(account string) *futures.PositionController {
+	if account == "" {
+		return &s.futuresPositionController
+	}
+	s.positionsMu.Lock()
+	defer s.positionsMu.Unlock()
+	c, ok := s.accountPositionControllers[account]
+	if !ok {
+		pc := futures.SetupPositionController()
+		c = &pc
+		if s.accountPositionControllers == nil {
+			s.accountPositionControllers = make(map[string]*futures.PositionController)
+		}
+		s.accountPositionControllers[account] = c
+	}
+	return c
 }
 
 // getFilteredOrders returns a filtered copy of the orders
@@ -15,6 +15,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
@@ -196,6 +197,37 @@ func TestSetupOrderManager(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestOrderManagerUpdateConfig(t *testing.T) {
+	t.Parallel()
+	var m *OrderManager
+	assert.ErrorIs(t, m.UpdateConfig(&config.OrderManager{}), ErrNilSubsystem)
+
+	var wg sync.WaitGroup
+	m, err := SetupOrderManager(NewExchangeManager(), &CommunicationManager{}, &wg, &config.OrderManager{})
+	require.NoError(t, err, "SetupOrderManager must not error")
+	m.cfg.OrderSubmissionRetries = 3
+
+	assert.ErrorIs(t, m.UpdateConfig(nil), errNilConfig)
+	assert.ErrorIs(t, m.UpdateConfig(&config.OrderManager{LimitAmount: -1}), errInvalidOrderLimitAmount)
+
+	err = m.UpdateConfig(&config.OrderManager{
+		EnforceLimits:          true,
+		LimitAmount:            10,
+		AllowedExchanges:       []string{testExchange},
+		AllowedPairs:           currency.Pairs{currency.NewBTCUSD()},
+		CancelOrdersOnShutdown: true,
+	})
+	require.NoError(t, err, "UpdateConfig must not error")
+	assert.Equal(t, orderManagerConfig{
+		EnforceLimitConfig:     true,
+		CancelOrdersOnShutdown: true,
+		LimitAmount:            10,
+		AllowedPairs:           currency.Pairs{currency.NewBTCUSD()},
+		AllowedExchanges:       []string{testExchange},
+		OrderSubmissionRetries: 3,
+	}, m.getConfig(), "UpdateConfig should replace the limits and keep the submission retries")
+}
+
 func TestOrderManagerStart(t *testing.T) {
 	var m *OrderManager
 	err := m.Start(t.Context())
@@ -722,6 +754,29 @@ func TestSubmit(t *testing.T) {
 	}
 }
 
+func TestSubmitAccessControl(t *testing.T) {
+	t.Parallel()
+	em, _ := accessControlTestExchange(t, accounts.ReadOnlyAccess)
+	m, err := SetupOrderManager(em, &CommunicationManager{}, &sync.WaitGroup{}, &config.OrderManager{})
+	require.NoError(t, err, "SetupOrderManager must not error")
+	m.started.Store(true)
+
+	o := &order.Submit{
+		Exchange:  testExchange,
+		Type:      order.Limit,
+		Pair:      btcusdPair,
+		AssetType: asset.Spot,
+		Side:      order.Buy,
+		Amount:    1,
+		Price:     1,
+	}
+	_, err = m.Submit(t.Context(), o)
+	require.ErrorIs(t, err, accounts.ErrAccessDenied)
+
+	err = m.Cancel(t.Context(), &order.Cancel{Exchange: testExchange, OrderID: "1", AssetType: asset.Spot})
+	require.ErrorIs(t, err, accounts.ErrAccessDenied)
+}
+
 // TestSubmitOrderAlreadyInStore ensures that if an order is submitted, but the WS sees the conf before processSubmittedOrder
 // then we don't error that it was there already
 func TestSubmitOrderAlreadyInStore(t *testing.T) {
@@ -1021,6 +1076,89 @@ func TestProcessOrders(t *testing.T) {
 	}
 }
 
+// accountOrdersSetup returns a started order manager holding the fake exchange
+// with BTC-USD spot enabled, without network setup
+func accountOrdersSetup(t *testing.T) (*OrderManager, exchange.IBotExchange) {
+	t.Helper()
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	require.NoError(t, err, "NewExchangeByName must not error")
+	exch.SetDefaults()
+	b := exch.GetBase()
+	b.Features.Supports.RESTCapabilities.GetOrder = true
+	b.CurrencyPairs = currency.PairsManager{
+		UseGlobalFormat: true,
+		RequestFormat:   &currency.PairFormat{Uppercase: true},
+		ConfigFormat:    &currency.PairFormat{Delimiter: "-", Uppercase: true},
+		Pairs: map[asset.Item]*currency.PairStore{
+			asset.Spot: {AssetEnabled: true, Enabled: currency.Pairs{btcusdPair}, Available: currency.Pairs{btcusdPair}},
+		},
+	}
+	fakeExchange := omfExchange{IBotExchange: exch}
+	require.NoError(t, em.Add(fakeExchange), "Add must not error")
+	m, err := SetupOrderManager(em, &CommunicationManager{}, &sync.WaitGroup{}, &config.OrderManager{})
+	require.NoError(t, err, "SetupOrderManager must not error")
+	m.started.Store(true)
+	return m, fakeExchange
+}
+
+func TestProcessAccountOrders(t *testing.T) {
+	t.Parallel()
+	m, exch := accountOrdersSetup(t)
+	pairs := currency.Pairs{btcusdPair}
+
+	require.NoError(t, m.orderStore.add(&order.Detail{
+		Exchange:    testExchange,
+		Pair:        pairs[0],
+		AssetType:   asset.Spot,
+		Amount:      1,
+		Side:        order.Buy,
+		Status:      order.Active,
+		LastUpdated: time.Now(),
+		OrderID:     "default-account-order",
+	}), "add must not error")
+
+	var wg sync.WaitGroup
+	m.processAccountOrders(t.Context(), exch, "alt", &wg)
+	wg.Wait()
+
+	res, err := m.GetOrdersFiltered(&order.Filter{Exchange: testExchange, Account: "alt"})
+	require.NoError(t, err, "GetOrdersFiltered must not error")
+	require.Len(t, res, 1, "GetOrdersFiltered must return the account orders")
+	assert.Equal(t, "Order3-unknown-to-active", res[0].OrderID, "fetched active orders should be tagged with the account")
+
+	res, err = m.GetOrdersFiltered(&order.Filter{OrderID: "default-account-order"})
+	require.NoError(t, err, "GetOrdersFiltered must not error")
+	require.Len(t, res, 1, "GetOrdersFiltered must return the default account order")
+	assert.Empty(t, res[0].Account, "default account orders should not be processed with another account")
+	assert.Equal(t, order.Active, res[0].Status, "default account orders should not be updated by another account")
+}
+
+func TestProcessSubmittedOrderAccount(t *testing.T) {
+	t.Parallel()
+	m, exch := accountOrdersSetup(t)
+	submit := &order.Submit{
+		Exchange:  testExchange,
+		Type:      order.Limit,
+		Pair:      btcusdPair,
+		AssetType: asset.Spot,
+		Side:      order.Buy,
+		Amount:    1,
+		Price:     1,
+	}
+	resp, err := submit.DeriveSubmitResponse("alt-order")
+	require.NoError(t, err, "DeriveSubmitResponse must not error")
+	result, err := m.processSubmittedOrder(resp, "alt")
+	require.NoError(t, err, "processSubmittedOrder must not error")
+	assert.Equal(t, "alt", result.Account, "processSubmittedOrder should tag the order with the account")
+
+	require.NoError(t, m.FetchAndUpdateExchangeOrder(t.Context(), exch, result.Detail, asset.Spot), "FetchAndUpdateExchangeOrder must not error")
+	od, err := m.GetByExchangeAndID(testExchange, "alt-order")
+	require.NoError(t, err, "GetByExchangeAndID must not error")
+	assert.Equal(t, "alt", od.Account, "FetchAndUpdateExchangeOrder should keep the order account")
+	assert.Equal(t, order.Cancelled, od.Status, "FetchAndUpdateExchangeOrder should update the order")
+}
+
 func TestGetOrdersFiltered(t *testing.T) {
 	m := OrdersSetup(t)
 	_, err := m.GetOrdersFiltered(nil)
@@ -1252,15 +1390,15 @@ func TestGetFuturesPositionsForExchange(t *testing.T) {
 	t.Parallel()
 	o := &OrderManager{}
 	cp := currency.NewBTCUSDT()
-	_, err := o.GetFuturesPositionsForExchange("test", asset.Spot, cp)
+	_, err := o.GetFuturesPositionsForExchange("test", "", asset.Spot, cp)
 	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
 
 	o.started.Store(true)
 	o.orderStore.futuresPositionController = futures.SetupPositionController()
-	_, err = o.GetFuturesPositionsForExchange("test", asset.Spot, cp)
+	_, err = o.GetFuturesPositionsForExchange("test", "", asset.Spot, cp)
 	assert.ErrorIs(t, err, futures.ErrNotFuturesAsset)
 
-	_, err = o.GetFuturesPositionsForExchange("test", asset.Futures, cp)
+	_, err = o.GetFuturesPositionsForExchange("test", "", asset.Futures, cp)
 	assert.ErrorIs(t, err, futures.ErrPositionNotFound)
 
 	err = o.orderStore.futuresPositionController.TrackNewOrder(&order.Detail{
@@ -1275,7 +1413,7 @@ func TestGetFuturesPositionsForExchange(t *testing.T) {
 	})
 	assert.NoError(t, err)
 
-	resp, err := o.GetFuturesPositionsForExchange("test", asset.Futures, cp)
+	resp, err := o.GetFuturesPositionsForExchange("test", "", asset.Futures, cp)
 	assert.NoError(t, err)
 
 	if len(resp) != 1 {
@@ -1283,7 +1421,7 @@ func TestGetFuturesPositionsForExchange(t *testing.T) {
 	}
 
 	o = nil
-	_, err = o.GetFuturesPositionsForExchange("test", asset.Futures, cp)
+	_, err = o.GetFuturesPositionsForExchange("test", "", asset.Futures, cp)
 	assert.ErrorIs(t, err, ErrNilSubsystem)
 }
 
@@ -1291,15 +1429,15 @@ func TestClearFuturesPositionsForExchange(t *testing.T) {
 	t.Parallel()
 	o := &OrderManager{}
 	cp := currency.NewBTCUSDT()
-	err := o.ClearFuturesTracking("test", asset.Spot, cp)
+	err := o.ClearFuturesTracking("test", "", asset.Spot, cp)
 	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
 
 	o.started.Store(true)
 	o.orderStore.futuresPositionController = futures.SetupPositionController()
-	err = o.ClearFuturesTracking("test", asset.Spot, cp)
+	err = o.ClearFuturesTracking("test", "", asset.Spot, cp)
 	assert.ErrorIs(t, err, futures.ErrNotFuturesAsset)
 
-	err = o.ClearFuturesTracking("test", asset.Futures, cp)
+	err = o.ClearFuturesTracking("test", "", asset.Futures, cp)
 	assert.ErrorIs(t, err, futures.ErrPositionNotFound)
 
 	err = o.orderStore.futuresPositionController.TrackNewOrder(&order.Detail{
@@ -1314,10 +1452,10 @@ func TestClearFuturesPositionsForExchange(t *testing.T) {
 	})
 	assert.NoError(t, err)
 
-	err = o.ClearFuturesTracking("test", asset.Futures, cp)
+	err = o.ClearFuturesTracking("test", "", asset.Futures, cp)
 	assert.NoError(t, err)
 
-	resp, err := o.GetFuturesPositionsForExchange("test", asset.Futures, cp)
+	resp, err := o.GetFuturesPositionsForExchange("test", "", asset.Futures, cp)
 	assert.NoError(t, err)
 
 	if len(resp) != 0 {
@@ -1325,7 +1463,7 @@ func TestClearFuturesPositionsForExchange(t *testing.T) {
 	}
 
 	o = nil
-	err = o.ClearFuturesTracking("test", asset.Futures, cp)
+	err = o.ClearFuturesTracking("test", "", asset.Futures, cp)
 	assert.ErrorIs(t, err, ErrNilSubsystem)
 }
 
@@ -1333,15 +1471,15 @@ func TestUpdateOpenPositionUnrealisedPNL(t *testing.T) {
 	t.Parallel()
 	o := &OrderManager{}
 	cp := currency.NewBTCUSDT()
-	_, err := o.UpdateOpenPositionUnrealisedPNL("test", asset.Spot, cp, 1, time.Now())
+	_, err := o.UpdateOpenPositionUnrealisedPNL("test", "", asset.Spot, cp, 1, time.Now())
 	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
 
 	o.started.Store(true)
 	o.orderStore.futuresPositionController = futures.SetupPositionController()
-	_, err = o.UpdateOpenPositionUnrealisedPNL("test", asset.Spot, cp, 1, time.Now())
+	_, err = o.UpdateOpenPositionUnrealisedPNL("test", "", asset.Spot, cp, 1, time.Now())
 	assert.ErrorIs(t, err, futures.ErrNotFuturesAsset)
 
-	_, err = o.UpdateOpenPositionUnrealisedPNL("test", asset.Futures, cp, 1, time.Now())
+	_, err = o.UpdateOpenPositionUnrealisedPNL("test", "", asset.Futures, cp, 1, time.Now())
 	assert.ErrorIs(t, err, futures.ErrPositionNotFound)
 
 	err = o.orderStore.futuresPositionController.TrackNewOrder(&order.Detail{
@@ -1356,7 +1494,7 @@ func TestUpdateOpenPositionUnrealisedPNL(t *testing.T) {
 	})
 	assert.NoError(t, err)
 
-	unrealised, err := o.UpdateOpenPositionUnrealisedPNL("test", asset.Futures, cp, 2, time.Now())
+	unrealised, err := o.UpdateOpenPositionUnrealisedPNL("test", "", asset.Futures, cp, 2, time.Now())
 	assert.NoError(t, err)
 
 	if !unrealised.Equal(decimal.NewFromInt(1)) {
@@ -1364,7 +1502,7 @@ func TestUpdateOpenPositionUnrealisedPNL(t *testing.T) {
 	}
 
 	o = nil
-	_, err = o.UpdateOpenPositionUnrealisedPNL("test", asset.Spot, cp, 1, time.Now())
+	_, err = o.UpdateOpenPositionUnrealisedPNL("test", "", asset.Spot, cp, 1, time.Now())
 	assert.ErrorIs(t, err, ErrNilSubsystem)
 }
 
@@ -1514,17 +1652,17 @@ func TestGetAllOpenFuturesPositions(t *testing.T) {
 	assert.NoError(t, err)
 
 	o.started.Store(false)
-	_, err = o.GetAllOpenFuturesPositions()
+	_, err = o.GetAllOpenFuturesPositions("")
 	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
 
 	o.started.Store(true)
 	o.activelyTrackFuturesPositions = true
 	o.orderStore.futuresPositionController = futures.SetupPositionController()
-	_, err = o.GetAllOpenFuturesPositions()
+	_, err = o.GetAllOpenFuturesPositions("")
 	assert.ErrorIs(t, err, futures.ErrNoPositionsFound)
 
 	o = nil
-	_, err = o.GetAllOpenFuturesPositions()
+	_, err = o.GetAllOpenFuturesPositions("")
 	assert.ErrorIs(t, err, ErrNilSubsystem)
 }
 
@@ -1536,11 +1674,11 @@ func TestGetOpenFuturesPosition(t *testing.T) {
 
 	o.started.Store(false)
 	cp := currency.NewPair(currency.BTC, currency.PERP)
-	_, err = o.GetOpenFuturesPosition(testExchange, asset.Spot, cp)
+	_, err = o.GetOpenFuturesPosition(testExchange, "", asset.Spot, cp)
 	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
 
 	o.started.Store(true)
-	_, err = o.GetOpenFuturesPosition(testExchange, asset.Spot, cp)
+	_, err = o.GetOpenFuturesPosition(testExchange, "", asset.Spot, cp)
 	assert.ErrorIs(t, err, futures.ErrNotFuturesAsset)
 
 	em := NewExchangeManager()
@@ -1583,10 +1721,10 @@ func TestGetOpenFuturesPosition(t *testing.T) {
 
 	o.started.Store(true)
 
-	_, err = o.GetOpenFuturesPosition(testExchange, asset.Spot, cp)
+	_, err = o.GetOpenFuturesPosition(testExchange, "", asset.Spot, cp)
 	assert.ErrorIs(t, err, futures.ErrNotFuturesAsset)
 
-	_, err = o.GetOpenFuturesPosition(testExchange, asset.Futures, cp)
+	_, err = o.GetOpenFuturesPosition(testExchange, "", asset.Futures, cp)
 	assert.ErrorIs(t, err, futures.ErrPositionNotFound)
 
 	err = o.orderStore.futuresPositionController.TrackNewOrder(&order.Detail{
@@ -1601,11 +1739,11 @@ func TestGetOpenFuturesPosition(t *testing.T) {
 	})
 	assert.NoError(t, err)
 
-	_, err = o.GetOpenFuturesPosition(testExchange, asset.Futures, cp)
+	_, err = o.GetOpenFuturesPosition(testExchange, "", asset.Futures, cp)
 	assert.NoError(t, err)
 
 	o = nil
-	_, err = o.GetOpenFuturesPosition(testExchange, asset.Spot, cp)
+	_, err = o.GetOpenFuturesPosition(testExchange, "", asset.Spot, cp)
 	assert.ErrorIs(t, err, ErrNilSubsystem)
 }
 
@@ -1696,6 +1834,31 @@ func TestProcessFuturesPositions(t *testing.T) {
 	b.Features.Supports.FuturesCapabilities.FundingRates = true
 	err = o.processFuturesPositions(t.Context(), fakeExchange, position)
 	assert.NoError(t, err)
+
+	accountPosition := &futures.PositionResponse{
+		Asset:  asset.Futures,
+		Pair:   cp,
+		Orders: []order.Detail{{AssetType: asset.Futures, OrderID: "456", Pair: cp, Side: order.Sell, Type: order.Market, Date: time.Now().Add(-time.Hour), Amount: 5, Exchange: b.Name}},
+	}
+	err = o.processFuturesPositions(accounts.DeployAccountToContext(t.Context(), "desk1"), fakeExchange, accountPosition)
+	require.NoError(t, err, "processFuturesPositions must not error for a named account")
+
+	positions, err := o.GetFuturesPositionsForExchange(b.Name, "desk1", asset.Futures, cp)
+	require.NoError(t, err, "GetFuturesPositionsForExchange must not error")
+	require.Len(t, positions, 1, "GetFuturesPositionsForExchange must return the account position")
+	require.Len(t, positions[0].Orders, 1, "account position must only hold the account orders")
+	assert.Equal(t, "456", positions[0].Orders[0].OrderID, "account position should hold the account order")
+	assert.Equal(t, "desk1", positions[0].Orders[0].Account, "account position orders should be tagged with the account")
+
+	positions, err = o.GetFuturesPositionsForExchange(b.Name, "", asset.Futures, cp)
+	require.NoError(t, err, "GetFuturesPositionsForExchange must not error")
+	require.Len(t, positions, 1, "GetFuturesPositionsForExchange must return the default position")
+	for i := range positions[0].Orders {
+		assert.NotEqual(t, "456", positions[0].Orders[i].OrderID, "default position should not hold orders of a named account")
+	}
+
+	_, err = o.GetFuturesPositionsForExchange(b.Name, "desk2", asset.Futures, cp)
+	assert.ErrorIs(t, err, futures.ErrPositionNotFound, "an account without orders should have no positions")
 }
 
 // TestGetByDetail tests orderstore.getByDetail
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 )
@@ -29,6 +30,7 @@ var (
 	orderManagerGracefulStopTimeout = time.Minute
 
 	errInvalidFuturesTrackingSeekDuration = errors.New("invalid config value for futuresTrackingSeekDuration")
+	errInvalidOrderLimitAmount            = errors.New("invalid config value for limitAmount")
 )
 
 type orderManagerConfig struct {
@@ -48,10 +50,18 @@ type OrderManager struct {
 	shutdown                      chan struct{}
 	orderStore                    store
 	cfg                           orderManagerConfig
+	cfgMu                         sync.RWMutex
 	verbose                       bool
 	activelyTrackFuturesPositions bool
 	futuresPositionSeekDuration   time.Duration
 	respectOrderHistoryLimits     bool
+	paperTrader                   *paperTrader
+	reporter                      orderSubmissionReporter
+}
+
+// orderSubmissionReporter receives the outcome of each order submission
+type orderSubmissionReporter interface {
+	OrderSubmitted(exchName string, a asset.Item, err error)
 }
 
 // store holds all orders by exchange
@@ -62,6 +72,10 @@ type store struct {
 	exchangeManager           iExchangeManager
 	wg                        *sync.WaitGroup
 	futuresPositionController futures.PositionController
+	// accountPositionControllers track the futures positions of each named
+	// account apart from those of the default credentials
+	accountPositionControllers map[string]*futures.PositionController
+	positionsMu                sync.Mutex
 }
 
 // OrderSubmitResponse contains the order response along with an internal order ID
@@ -0,0 +1,584 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/key"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fill"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+// setupPaperTrader validates the paper trading config and seeds the
+// simulated account stores with any initial balances
+func setupPaperTrader(cfg *config.PaperTrading) (*paperTrader, error) {
+	if cfg == nil {
+		return nil, fmt.Errorf("%w PaperTrading", errNilConfig)
+	}
+	if cfg.FeeRate < 0 || cfg.FeeRate >= 1 {
+		return nil, fmt.Errorf("%w: %v", errInvalidPaperFeeRate, cfg.FeeRate)
+	}
+	p := &paperTrader{
+		feeRate:  cfg.FeeRate,
+		accounts: make(map[string]*accounts.Accounts),
+		resting:  make(map[string]map[string]*order.Detail),
+		consumed: make(map[paperBookSide]map[float64]float64),
+	}
+	seeds := make(map[string]accounts.SubAccounts)
+	for i := range cfg.InitialBalances {
+		b := cfg.InitialBalances[i]
+		if b.Exchange == "" || !b.Asset.IsValid() || b.Currency.IsEmpty() || b.Amount < 0 {
+			return nil, fmt.Errorf("%w: %s %s %s %v", errInvalidPaperBalance, b.Exchange, b.Asset, b.Currency, b.Amount)
+		}
+		sub := accounts.NewSubAccount(b.Asset, "")
+		sub.Balances.Set(b.Currency, accounts.Balance{
+			Total:                  b.Amount,
+			Free:                   b.Amount,
+			AvailableWithoutBorrow: b.Amount,
+		})
+		name := strings.ToLower(b.Exchange)
+		seeds[name] = seeds[name].Merge(sub)
+	}
+	for name, subs := range seeds {
+		acc, err := p.getAccounts(name)
+		if err != nil {
+			return nil, err
+		}
+		if err := acc.Save(context.Background(), subs, true); err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+// getAccounts returns the simulated account store for an exchange, creating
+// it if it does not exist
+func (p *paperTrader) getAccounts(exchName string) (*accounts.Accounts, error) {
+	name := strings.ToLower(exchName)
+	if acc, ok := p.accounts[name]; ok {
+		return acc, nil
+	}
+	acc, err := accounts.NewAccounts(&paperAccount{name: exchName}, dispatch.GetNewMux(nil))
+	if err != nil {
+		return nil, err
+	}
+	p.accounts[name] = acc
+	return acc, nil
+}
+
+// balances returns the simulated balances for an exchange asset
+func (p *paperTrader) balances(exchName string, a asset.Item) (accounts.CurrencyBalances, error) {
+	p.m.Lock()
+	defer p.m.Unlock()
+	acc, err := p.getAccounts(exchName)
+	if err != nil {
+		return nil, err
+	}
+	creds := paperTradingCredentials
+	return acc.CurrencyBalances(&creds, a)
+}
+
+// freeBalance returns the free amount of a simulated currency balance
+func (p *paperTrader) freeBalance(acc *accounts.Accounts, a asset.Item, c currency.Code) (float64, error) {
+	creds := paperTradingCredentials
+	bal, err := acc.GetBalance("", &creds, a, c)
+	if err != nil {
+		if errors.Is(err, accounts.ErrNoBalances) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return bal.Free, nil
+}
+
+// applyChanges applies balance deltas to the simulated account store
+func (p *paperTrader) applyChanges(ctx context.Context, acc *accounts.Accounts, a asset.Item, changes map[currency.Code]balanceChange) error {
+	creds := paperTradingCredentials
+	sub := accounts.NewSubAccount(a, "")
+	now := time.Now()
+	for c, change := range changes {
+		bal, err := acc.GetBalance("", &creds, a, c)
+		if err != nil && !errors.Is(err, accounts.ErrNoBalances) {
+			return err
+		}
+		bal.Total += change.total
+		bal.Hold += change.hold
+		bal.Free = bal.Total - bal.Hold
+		bal.AvailableWithoutBorrow = bal.Free
+		bal.UpdatedAt = now
+		sub.Balances.Set(c, bal)
+	}
+	return acc.Save(ctx, accounts.SubAccounts{sub}, false)
+}
+
+// holdFor returns the amount that must be reserved for a resting order
+func (p *paperTrader) holdFor(isBuy bool, remaining, price float64) float64 {
+	if isBuy {
+		return remaining * price * (1 + p.feeRate)
+	}
+	return remaining
+}
+
+// submit fills an order against the orderbook depth, resting any unfilled
+// limit amount until it can be matched
+func (p *paperTrader) submit(ctx context.Context, s *order.Submit) (*order.SubmitResponse, []fill.Data, error) {
+	if s == nil {
+		return nil, nil, errNilOrder
+	}
+	if s.AssetType != asset.Spot {
+		return nil, nil, fmt.Errorf("%w: %s", errPaperAssetNotSupported, s.AssetType)
+	}
+	if s.Type != order.Market && s.Type != order.Limit {
+		return nil, nil, fmt.Errorf("%w: %s", errPaperOrderTypeNotSupported, s.Type)
+	}
+
+	p.m.Lock()
+	defer p.m.Unlock()
+	levels, err := getPaperLevels(s.Exchange, s.Pair, s.AssetType, s.Side)
+	if err != nil {
+		return nil, nil, err
+	}
+	isBuy := s.Side.IsLong()
+	side := newPaperBookSide(s.Exchange, s.AssetType, s.Pair, isBuy)
+	levels = p.availableLevels(side, levels)
+
+	var limit float64
+	if s.Type == order.Limit {
+		limit = s.Price
+	}
+	byQuote := s.Type == order.Market && s.Amount == 0 && s.QuoteAmount > 0
+	amount := s.Amount
+	if byQuote {
+		amount = s.QuoteAmount
+	}
+	base, quote := walkBook(levels, amount, byQuote, isBuy, limit)
+	if s.TimeInForce.Is(order.PostOnly) && base > 0 {
+		return nil, nil, errPostOnlyWouldCross
+	}
+	var remaining float64
+	if !byQuote {
+		remaining = s.Amount - base
+	}
+	if s.TimeInForce.Is(order.FillOrKill) && (remaining > 0 || (byQuote && quote < s.QuoteAmount)) {
+		return nil, nil, errFillOrKillNotFilled
+	}
+	if s.Type == order.Market && base == 0 {
+		return nil, nil, fmt.Errorf("%w: %s %s %s", errNoPaperLiquidity, s.Exchange, s.AssetType, s.Pair)
+	}
+	rest := s.Type == order.Limit && remaining > 0 && !s.TimeInForce.Is(order.ImmediateOrCancel)
+	fee := quote * p.feeRate
+
+	acc, err := p.getAccounts(s.Exchange)
+	if err != nil {
+		return nil, nil, err
+	}
+	var hold float64
+	if rest {
+		hold = p.holdFor(isBuy, remaining, s.Price)
+	}
+	changes := make(map[currency.Code]balanceChange, 2)
+	spend, required := s.Pair.Quote, quote+fee+hold
+	if isBuy {
+		changes[s.Pair.Quote] = balanceChange{total: -quote - fee, hold: hold}
+		changes[s.Pair.Base] = balanceChange{total: base}
+	} else {
+		spend, required = s.Pair.Base, base+hold
+		changes[s.Pair.Base] = balanceChange{total: -base, hold: hold}
+		changes[s.Pair.Quote] = balanceChange{total: quote - fee}
+	}
+	free, err := p.freeBalance(acc, s.AssetType, spend)
+	if err != nil {
+		return nil, nil, err
+	}
+	if required > free {
+		return nil, nil, fmt.Errorf("%w: %s %s requires %v %s, %v free", errInsufficientPaperBalance, s.Exchange, s.Pair, required, spend, free)
+	}
+	if err := p.applyChanges(ctx, acc, s.AssetType, changes); err != nil {
+		return nil, nil, err
+	}
+	p.consume(side, levels, base)
+
+	id, err := uuid.NewV4()
+	if err != nil {
+		return nil, nil, err
+	}
+	resp, err := s.DeriveSubmitResponse(id.String())
+	if err != nil {
+		return nil, nil, err
+	}
+	switch {
+	case base > 0 && remaining == 0:
+		resp.Status = order.Filled
+	case rest && base > 0:
+		resp.Status = order.PartiallyFilled
+	case rest:
+		resp.Status = order.New
+	case base > 0:
+		resp.Status = order.PartiallyFilledCancelled
+	default:
+		resp.Status = order.Cancelled
+	}
+	if byQuote {
+		resp.Amount = base
+	}
+	resp.RemainingAmount = remaining
+	resp.Cost = quote
+	resp.Fee = fee
+	resp.FeeAsset = s.Pair.Quote
+	resp.Purchased = base
+	if !isBuy {
+		resp.Purchased = quote - fee
+	}
+
+	var fills []fill.Data
+	if base > 0 {
+		resp.AverageExecutedPrice = quote / base
+		trade, f, err := newPaperFill(resp.Exchange, resp.OrderID, resp.ClientOrderID, resp.AssetType, resp.Pair, resp.Side, resp.Type, base, quote, fee, resp.LastUpdated)
+		if err != nil {
+			return nil, nil, err
+		}
+		resp.Trades = []order.TradeHistory{trade}
+		fills = append(fills, f)
+	}
+
+	if rest {
+		detail, err := resp.DeriveDetail(uuid.Nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		detail.ExecutedAmount = base
+		detail.RemainingAmount = remaining
+		detail.AverageExecutedPrice = resp.AverageExecutedPrice
+		name := strings.ToLower(s.Exchange)
+		if p.resting[name] == nil {
+			p.resting[name] = make(map[string]*order.Detail)
+		}
+		p.resting[name][detail.OrderID] = detail
+	}
+	return resp, fills, nil
+}
+
+// modify amends the price and amount of a resting order, adjusting the held
+// balance to suit
+func (p *paperTrader) modify(ctx context.Context, mod *order.Modify) (*order.ModifyResponse, error) {
+	if mod == nil {
+		return nil, errNilOrder
+	}
+	p.m.Lock()
+	defer p.m.Unlock()
+	det, ok := p.resting[strings.ToLower(mod.Exchange)][mod.OrderID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s %s", ErrOrderNotFound, mod.Exchange, mod.OrderID)
+	}
+	price, amount := det.Price, det.Amount
+	if mod.Price > 0 {
+		price = mod.Price
+	}
+	if mod.Amount > 0 {
+		amount = mod.Amount
+	}
+	if amount <= det.ExecutedAmount {
+		return nil, fmt.Errorf("%w: %v <= %v", errInvalidPaperModifyAmount, amount, det.ExecutedAmount)
+	}
+	isBuy := det.Side.IsLong()
+	remaining := amount - det.ExecutedAmount
+	holdDelta := p.holdFor(isBuy, remaining, price) - p.holdFor(isBuy, det.RemainingAmount, det.Price)
+
+	acc, err := p.getAccounts(det.Exchange)
+	if err != nil {
+		return nil, err
+	}
+	held := det.Pair.Quote
+	if !isBuy {
+		held = det.Pair.Base
+	}
+	if holdDelta > 0 {
+		free, err := p.freeBalance(acc, det.AssetType, held)
+		if err != nil {
+			return nil, err
+		}
+		if holdDelta > free {
+			return nil, fmt.Errorf("%w: %s %s requires %v %s, %v free", errInsufficientPaperBalance, det.Exchange, det.Pair, holdDelta, held, free)
+		}
+	}
+	if err := p.applyChanges(ctx, acc, det.AssetType, map[currency.Code]balanceChange{held: {hold: holdDelta}}); err != nil {
+		return nil, err
+	}
+	det.Price = price
+	det.Amount = amount
+	det.RemainingAmount = remaining
+	det.LastUpdated = time.Now()
+
+	resp, err := mod.DeriveModifyResponse()
+	if err != nil {
+		return nil, err
+	}
+	resp.Price = price
+	resp.Amount = amount
+	resp.RemainingAmount = remaining
+	resp.Status = det.Status
+	resp.LastUpdated = det.LastUpdated
+	return resp, nil
+}
+
+// cancel removes a resting order and releases its held balance
+func (p *paperTrader) cancel(ctx context.Context, c *order.Cancel) error {
+	if c == nil {
+		return errNilOrder
+	}
+	p.m.Lock()
+	defer p.m.Unlock()
+	name := strings.ToLower(c.Exchange)
+	det, ok := p.resting[name][c.OrderID]
+	if !ok {
+		return fmt.Errorf("%w: %s %s", ErrOrderNotFound, c.Exchange, c.OrderID)
+	}
+	acc, err := p.getAccounts(det.Exchange)
+	if err != nil {
+		return err
+	}
+	isBuy := det.Side.IsLong()
+	held := det.Pair.Quote
+	if !isBuy {
+		held = det.Pair.Base
+	}
+	release := -p.holdFor(isBuy, det.RemainingAmount, det.Price)
+	if err := p.applyChanges(ctx, acc, det.AssetType, map[currency.Code]balanceChange{held: {hold: release}}); err != nil {
+		return err
+	}
+	delete(p.resting[name], c.OrderID)
+	return nil
+}
+
+// restingOrders returns cancel requests for every resting order on an
+// exchange
+func (p *paperTrader) restingOrders(exchName string) []order.Cancel {
+	p.m.Lock()
+	defer p.m.Unlock()
+	orders := p.resting[strings.ToLower(exchName)]
+	cancels := make([]order.Cancel, 0, len(orders))
+	for _, det := range orders {
+		c, err := det.DeriveCancel()
+		if err != nil {
+			continue
+		}
+		cancels = append(cancels, *c)
+	}
+	return cancels
+}
+
+// match attempts to fill all resting orders against the current orderbook
+// depth and returns the updated order details and resulting fills
+func (p *paperTrader) match(ctx context.Context) ([]order.Detail, []fill.Data, error) {
+	p.m.Lock()
+	defer p.m.Unlock()
+	var (
+		updated []order.Detail
+		fills   []fill.Data
+		errs    error
+	)
+	for name, orders := range p.resting {
+		for id, det := range orders {
+			levels, err := getPaperLevels(det.Exchange, det.Pair, det.AssetType, det.Side)
+			if err != nil {
+				errs = common.AppendError(errs, err)
+				continue
+			}
+			isBuy := det.Side.IsLong()
+			side := newPaperBookSide(det.Exchange, det.AssetType, det.Pair, isBuy)
+			levels = p.availableLevels(side, levels)
+			base, quote := walkBook(levels, det.RemainingAmount, false, isBuy, det.Price)
+			if base == 0 {
+				continue
+			}
+			acc, err := p.getAccounts(det.Exchange)
+			if err != nil {
+				errs = common.AppendError(errs, err)
+				continue
+			}
+			fee := quote * p.feeRate
+			release := p.holdFor(isBuy, base, det.Price)
+			changes := map[currency.Code]balanceChange{
+				det.Pair.Base:  {total: -base, hold: -release},
+				det.Pair.Quote: {total: quote - fee},
+			}
+			if isBuy {
+				changes[det.Pair.Base] = balanceChange{total: base}
+				changes[det.Pair.Quote] = balanceChange{total: -quote - fee, hold: -release}
+			}
+			if err := p.applyChanges(ctx, acc, det.AssetType, changes); err != nil {
+				errs = common.AppendError(errs, err)
+				continue
+			}
+			p.consume(side, levels, base)
+
+			now := time.Now()
+			trade, f, err := newPaperFill(det.Exchange, det.OrderID, det.ClientOrderID, det.AssetType, det.Pair, det.Side, det.Type, base, quote, fee, now)
+			if err != nil {
+				errs = common.AppendError(errs, err)
+				continue
+			}
+			det.AverageExecutedPrice = (det.AverageExecutedPrice*det.ExecutedAmount + quote) / (det.ExecutedAmount + base)
+			det.ExecutedAmount += base
+			det.RemainingAmount -= base
+			det.Cost += quote
+			det.Fee += fee
+			det.Trades = append(det.Trades, trade)
+			det.LastUpdated = now
+			det.Status = order.PartiallyFilled
+			if det.RemainingAmount <= 0 {
+				det.RemainingAmount = 0
+				det.Status = order.Filled
+				delete(orders, id)
+			}
+			updated = append(updated, det.Copy())
+			fills = append(fills, f)
+		}
+		if len(orders) == 0 {
+			delete(p.resting, name)
+		}
+	}
+	return updated, fills, errs
+}
+
+// getPaperLevels returns the orderbook side an order would trade against
+func getPaperLevels(exchName string, pair currency.Pair, a asset.Item, side order.Side) ([]orderbook.Level, error) {
+	depth, err := orderbook.GetDepth(exchName, pair, a)
+	if err != nil {
+		return nil, err
+	}
+	asks, bids, err := depth.GetLevels(0)
+	if err != nil {
+		return nil, err
+	}
+	if side.IsLong() {
+		return asks, nil
+	}
+	return bids, nil
+}
+
+// newPaperBookSide returns the key for the orderbook side an order trades
+// against
+func newPaperBookSide(exchName string, a asset.Item, pair currency.Pair, isBuy bool) paperBookSide {
+	return paperBookSide{
+		ExchangeAssetPair: key.NewExchangeAssetPair(strings.ToLower(exchName), a, pair),
+		isBuy:             isBuy,
+	}
+}
+
+// availableLevels returns the orderbook levels less the volume paper orders
+// have already filled. Filled volume is capped by the amount left on a level,
+// so liquidity added back to the book can fill again, and levels which have
+// left the book are forgotten
+func (p *paperTrader) availableLevels(side paperBookSide, levels []orderbook.Level) []orderbook.Level {
+	consumed := p.consumed[side]
+	if len(consumed) == 0 {
+		return levels
+	}
+	remaining := make(map[float64]float64, len(consumed))
+	available := make([]orderbook.Level, 0, len(levels))
+	for i := range levels {
+		level := levels[i]
+		if filled, ok := consumed[level.Price]; ok {
+			filled = math.Min(filled, level.Amount)
+			remaining[level.Price] = filled
+			level.Amount -= filled
+		}
+		if level.Amount > 0 {
+			available = append(available, level)
+		}
+	}
+	if len(remaining) == 0 {
+		delete(p.consumed, side)
+	} else {
+		p.consumed[side] = remaining
+	}
+	return available
+}
+
+// consume records the volume filled against the available levels
+func (p *paperTrader) consume(side paperBookSide, levels []orderbook.Level, base float64) {
+	if base <= 0 {
+		return
+	}
+	consumed, ok := p.consumed[side]
+	if !ok {
+		consumed = make(map[float64]float64)
+		p.consumed[side] = consumed
+	}
+	for i := 0; i < len(levels) && base > 0; i++ {
+		amount := math.Min(levels[i].Amount, base)
+		consumed[levels[i].Price] += amount
+		base -= amount
+	}
+}
+
+// walkBook consumes orderbook levels until the amount is filled or the limit
+// price is breached. When byQuote is true the amount is denominated in the
+// quote currency. A zero limit walks the book as a market order.
+func walkBook(levels []orderbook.Level, amount float64, byQuote, isBuy bool, limit float64) (base, quote float64) {
+	for i := range levels {
+		price := levels[i].Price
+		if limit > 0 && ((isBuy && price > limit) || (!isBuy && price < limit)) {
+			break
+		}
+		if byQuote {
+			if quote+levels[i].Amount*price >= amount {
+				base += (amount - quote) / price
+				return base, amount
+			}
+		} else if base+levels[i].Amount >= amount {
+			quote += (amount - base) * price
+			return amount, quote
+		}
+		base += levels[i].Amount
+		quote += levels[i].Amount * price
+	}
+	return base, quote
+}
+
+// newPaperFill returns the trade history and fill data for a simulated
+// execution
+func newPaperFill(exchName, orderID, clientOrderID string, a asset.Item, pair currency.Pair, side order.Side, oType order.Type, base, quote, fee float64, ts time.Time) (order.TradeHistory, fill.Data, error) {
+	tid, err := uuid.NewV4()
+	if err != nil {
+		return order.TradeHistory{}, fill.Data{}, err
+	}
+	price := quote / base
+	return order.TradeHistory{
+		Price:     price,
+		Amount:    base,
+		Fee:       fee,
+		Exchange:  exchName,
+		TID:       tid.String(),
+		Type:      oType,
+		Side:      side,
+		Timestamp: ts,
+		FeeAsset:  pair.Quote.String(),
+		Total:     quote,
+	}, fill.Data{
+		ID:            tid.String(),
+		Timestamp:     ts,
+		Exchange:      exchName,
+		AssetType:     a,
+		CurrencyPair:  pair,
+		Side:          side,
+		OrderID:       orderID,
+		ClientOrderID: clientOrderID,
+		TradeID:       tid.String(),
+		Price:         price,
+		Amount:        base,
+	}, nil
+}
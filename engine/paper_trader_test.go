@@ -0,0 +1,350 @@
+package engine
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/currencystate"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+)
+
+// loadPaperBook loads a small orderbook with asks from 100 and bids from 99
+func loadPaperBook(t *testing.T, exchName string, pair currency.Pair) {
+	t.Helper()
+	b := &orderbook.Book{
+		Exchange: exchName,
+		Pair:     pair,
+		Asset:    asset.Spot,
+		Asks:     orderbook.Levels{{Price: 100, Amount: 1}, {Price: 101, Amount: 2}},
+		Bids:     orderbook.Levels{{Price: 99, Amount: 1}, {Price: 98, Amount: 2}},
+	}
+	require.NoError(t, b.Process(), "orderbook Process must not error")
+}
+
+func newTestPaperTrader(t *testing.T, exchName string) *paperTrader {
+	t.Helper()
+	p, err := setupPaperTrader(&config.PaperTrading{
+		Enabled: true,
+		FeeRate: 0.001,
+		InitialBalances: []config.PaperTradingBalance{
+			{Exchange: exchName, Asset: asset.Spot, Currency: currency.USDT, Amount: 1000},
+			{Exchange: exchName, Asset: asset.Spot, Currency: currency.LTC, Amount: 5},
+		},
+	})
+	require.NoError(t, err, "setupPaperTrader must not error")
+	return p
+}
+
+func TestSetupPaperTrader(t *testing.T) {
+	t.Parallel()
+	_, err := setupPaperTrader(nil)
+	require.ErrorIs(t, err, errNilConfig)
+
+	_, err = setupPaperTrader(&config.PaperTrading{FeeRate: 1})
+	require.ErrorIs(t, err, errInvalidPaperFeeRate)
+
+	_, err = setupPaperTrader(&config.PaperTrading{InitialBalances: []config.PaperTradingBalance{{Exchange: "test", Asset: asset.Spot, Amount: 1}}})
+	require.ErrorIs(t, err, errInvalidPaperBalance)
+
+	p := newTestPaperTrader(t, "PaperSetup")
+	bals, err := p.balances("papersetup", asset.Spot)
+	require.NoError(t, err, "balances must not error")
+	assert.Equal(t, 1000.0, bals[currency.USDT].Free, "USDT free balance should be seeded")
+	assert.Equal(t, 5.0, bals[currency.LTC].Total, "LTC total balance should be seeded")
+}
+
+func TestWalkBook(t *testing.T) {
+	t.Parallel()
+	asks := []orderbook.Level{{Price: 100, Amount: 1}, {Price: 101, Amount: 2}}
+	base, quote := walkBook(asks, 2, false, true, 0)
+	assert.Equal(t, 2.0, base, "base should equal the requested amount")
+	assert.Equal(t, 201.0, quote, "quote should span both levels")
+
+	base, quote = walkBook(asks, 2, false, true, 100)
+	assert.Equal(t, 1.0, base, "base should stop at the limit price")
+	assert.Equal(t, 100.0, quote, "quote should stop at the limit price")
+
+	base, quote = walkBook(asks, 150, true, true, 0)
+	assert.InDelta(t, 1.4950495, base, 1e-6, "base should be derived from the quote amount")
+	assert.Equal(t, 150.0, quote, "quote should equal the requested amount")
+
+	base, _ = walkBook(asks, 10, false, true, 0)
+	assert.Equal(t, 3.0, base, "base should be capped by available liquidity")
+}
+
+func TestPaperTraderSubmit(t *testing.T) {
+	t.Parallel()
+	const exchName = "PaperSubmit"
+	pair := currency.NewPair(currency.LTC, currency.USDT)
+	p := newTestPaperTrader(t, exchName)
+
+	_, _, err := p.submit(t.Context(), nil)
+	require.ErrorIs(t, err, errNilOrder)
+
+	s := &order.Submit{Exchange: exchName, Pair: pair, AssetType: asset.Futures, Side: order.Buy, Type: order.Market, Amount: 1}
+	_, _, err = p.submit(t.Context(), s)
+	require.ErrorIs(t, err, errPaperAssetNotSupported)
+
+	s.AssetType = asset.Spot
+	s.Type = order.Stop
+	_, _, err = p.submit(t.Context(), s)
+	require.ErrorIs(t, err, errPaperOrderTypeNotSupported)
+
+	s.Type = order.Market
+	_, _, err = p.submit(t.Context(), s)
+	require.ErrorIs(t, err, orderbook.ErrOrderbookNotFound)
+
+	loadPaperBook(t, exchName, pair)
+	_, _, err = p.submit(t.Context(), &order.Submit{Exchange: exchName, Pair: pair, AssetType: asset.Spot, Side: order.Buy, Type: order.Limit, Amount: 20, Price: 101})
+	require.ErrorIs(t, err, errInsufficientPaperBalance)
+
+	s.Amount = 2
+	resp, fills, err := p.submit(t.Context(), s)
+	require.NoError(t, err, "submit must not error")
+	assert.Equal(t, order.Filled, resp.Status, "market order should be filled")
+	assert.Equal(t, 100.5, resp.AverageExecutedPrice, "average price should span both ask levels")
+	assert.InDelta(t, 0.201, resp.Fee, 1e-9, "fee should be charged on the quote cost")
+	require.Len(t, fills, 1, "fills must contain the execution")
+	assert.Equal(t, resp.OrderID, fills[0].OrderID, "fill should reference the order")
+
+	bals, err := p.balances(exchName, asset.Spot)
+	require.NoError(t, err, "balances must not error")
+	assert.InDelta(t, 798.799, bals[currency.USDT].Free, 1e-9, "USDT should be reduced by cost and fee")
+	assert.Equal(t, 7.0, bals[currency.LTC].Total, "LTC should be increased by the purchase")
+
+	b := &orderbook.Book{
+		Exchange: exchName,
+		Pair:     pair,
+		Asset:    asset.Spot,
+		Asks:     orderbook.Levels{{Price: 100, Amount: 2}, {Price: 101, Amount: 2}},
+		Bids:     orderbook.Levels{{Price: 99, Amount: 1}, {Price: 98, Amount: 2}},
+	}
+	require.NoError(t, b.Process(), "orderbook Process must not error")
+
+	s.Type = order.Limit
+	s.Price = 100
+	s.TimeInForce = order.PostOnly
+	_, _, err = p.submit(t.Context(), s)
+	require.ErrorIs(t, err, errPostOnlyWouldCross)
+
+	s.TimeInForce = order.FillOrKill
+	_, _, err = p.submit(t.Context(), s)
+	require.ErrorIs(t, err, errFillOrKillNotFilled)
+
+	s.TimeInForce = order.ImmediateOrCancel
+	resp, _, err = p.submit(t.Context(), s)
+	require.NoError(t, err, "submit must not error")
+	assert.Equal(t, order.PartiallyFilledCancelled, resp.Status, "IOC remainder should be cancelled")
+	assert.Empty(t, p.resting, "IOC orders should not rest")
+
+	s.TimeInForce = order.GoodTillCancel
+	s.Side = order.Sell
+	s.Price = 110
+	resp, fills, err = p.submit(t.Context(), s)
+	require.NoError(t, err, "submit must not error")
+	assert.Equal(t, order.New, resp.Status, "non crossing limit order should rest")
+	assert.Empty(t, fills, "non crossing limit order should not fill")
+	require.Contains(t, p.resting["papersubmit"], resp.OrderID, "limit order must be resting")
+
+	bals, err = p.balances(exchName, asset.Spot)
+	require.NoError(t, err, "balances must not error")
+	assert.Equal(t, 2.0, bals[currency.LTC].Hold, "resting sell should hold the base amount")
+}
+
+func TestPaperTraderModifyCancel(t *testing.T) {
+	t.Parallel()
+	const exchName = "PaperModify"
+	pair := currency.NewPair(currency.LTC, currency.USDT)
+	p := newTestPaperTrader(t, exchName)
+	loadPaperBook(t, exchName, pair)
+
+	resp, _, err := p.submit(t.Context(), &order.Submit{Exchange: exchName, Pair: pair, AssetType: asset.Spot, Side: order.Buy, Type: order.Limit, Amount: 1, Price: 90})
+	require.NoError(t, err, "submit must not error")
+
+	_, err = p.modify(t.Context(), nil)
+	require.ErrorIs(t, err, errNilOrder)
+
+	mod := &order.Modify{Exchange: exchName, OrderID: "nope"}
+	_, err = p.modify(t.Context(), mod)
+	require.ErrorIs(t, err, ErrOrderNotFound)
+
+	mod.OrderID = resp.OrderID
+	mod.Amount = 100
+	_, err = p.modify(t.Context(), mod)
+	require.ErrorIs(t, err, errInsufficientPaperBalance)
+
+	mod.Amount = 2
+	mod.Price = 95
+	modResp, err := p.modify(t.Context(), mod)
+	require.NoError(t, err, "modify must not error")
+	assert.Equal(t, 2.0, modResp.RemainingAmount, "remaining amount should be updated")
+
+	bals, err := p.balances(exchName, asset.Spot)
+	require.NoError(t, err, "balances must not error")
+	assert.InDelta(t, 190.19, bals[currency.USDT].Hold, 1e-9, "hold should reflect the modified order")
+
+	err = p.cancel(t.Context(), nil)
+	require.ErrorIs(t, err, errNilOrder)
+
+	err = p.cancel(t.Context(), &order.Cancel{Exchange: exchName, OrderID: "nope"})
+	require.ErrorIs(t, err, ErrOrderNotFound)
+
+	err = p.cancel(t.Context(), &order.Cancel{Exchange: exchName, OrderID: resp.OrderID})
+	require.NoError(t, err, "cancel must not error")
+
+	bals, err = p.balances(exchName, asset.Spot)
+	require.NoError(t, err, "balances must not error")
+	assert.InDelta(t, 0, bals[currency.USDT].Hold, 1e-9, "hold should be released on cancel")
+	assert.Equal(t, 1000.0, bals[currency.USDT].Free, "free balance should be restored on cancel")
+}
+
+func TestPaperTraderMatch(t *testing.T) {
+	t.Parallel()
+	const exchName = "PaperMatch"
+	pair := currency.NewPair(currency.LTC, currency.USDT)
+	p := newTestPaperTrader(t, exchName)
+	loadPaperBook(t, exchName, pair)
+
+	resp, _, err := p.submit(t.Context(), &order.Submit{Exchange: exchName, Pair: pair, AssetType: asset.Spot, Side: order.Sell, Type: order.Limit, Amount: 2, Price: 99.5})
+	require.NoError(t, err, "submit must not error")
+
+	updated, fills, err := p.match(t.Context())
+	require.NoError(t, err, "match must not error")
+	assert.Empty(t, updated, "nothing should match before the book moves")
+	assert.Empty(t, fills, "nothing should fill before the book moves")
+
+	b := &orderbook.Book{
+		Exchange: exchName,
+		Pair:     pair,
+		Asset:    asset.Spot,
+		Asks:     orderbook.Levels{{Price: 101, Amount: 1}},
+		Bids:     orderbook.Levels{{Price: 100, Amount: 3}},
+	}
+	require.NoError(t, b.Process(), "orderbook Process must not error")
+
+	updated, fills, err = p.match(t.Context())
+	require.NoError(t, err, "match must not error")
+	require.Len(t, updated, 1, "resting order must be updated")
+	require.Len(t, fills, 1, "resting order must fill")
+	assert.Equal(t, resp.OrderID, updated[0].OrderID, "updated order should match the resting order")
+	assert.Equal(t, order.Filled, updated[0].Status, "resting order should be filled")
+	assert.Equal(t, 100.0, updated[0].AverageExecutedPrice, "resting order should fill at the bid")
+	assert.Empty(t, p.resting, "filled orders should no longer rest")
+
+	bals, err := p.balances(exchName, asset.Spot)
+	require.NoError(t, err, "balances must not error")
+	assert.Equal(t, 3.0, bals[currency.LTC].Total, "LTC should be reduced by the sale")
+	assert.Zero(t, bals[currency.LTC].Hold, "LTC hold should be released")
+	assert.InDelta(t, 1199.8, bals[currency.USDT].Total, 1e-9, "USDT should include proceeds less fees")
+
+	resp, _, err = p.submit(t.Context(), &order.Submit{Exchange: exchName, Pair: pair, AssetType: asset.Spot, Side: order.Sell, Type: order.Limit, Amount: 2, Price: 99.5})
+	require.NoError(t, err, "submit must not error")
+	assert.Equal(t, order.PartiallyFilled, resp.Status, "order should only fill the bid volume not already filled")
+	assert.Equal(t, 1.0, resp.RemainingAmount, "remaining amount should exclude the filled bid volume")
+
+	updated, fills, err = p.match(t.Context())
+	require.NoError(t, err, "match must not error")
+	assert.Empty(t, updated, "filled volume should not fill again")
+	assert.Empty(t, fills, "filled volume should not fill again")
+
+	b.Bids = orderbook.Levels{{Price: 100, Amount: 2}}
+	require.NoError(t, b.Process(), "orderbook Process must not error")
+	updated, _, err = p.match(t.Context())
+	require.NoError(t, err, "match must not error")
+	assert.Empty(t, updated, "a shrinking level should not fill again")
+
+	b.Bids = orderbook.Levels{{Price: 100, Amount: 3}}
+	require.NoError(t, b.Process(), "orderbook Process must not error")
+	updated, _, err = p.match(t.Context())
+	require.NoError(t, err, "match must not error")
+	require.Len(t, updated, 1, "volume added to a level must fill")
+	assert.Equal(t, order.Filled, updated[0].Status, "resting order should fill against the added volume")
+}
+
+func TestOrderManagerPaperTrading(t *testing.T) {
+	t.Parallel()
+	var wg sync.WaitGroup
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	require.NoError(t, err, "NewExchangeByName must not error")
+	exch.SetDefaults()
+	exch.GetBase().States = currencystate.NewCurrencyStates()
+	require.NoError(t, em.Add(exch), "Add must not error")
+
+	m, err := SetupOrderManager(em, &CommunicationManager{}, &wg, &config.OrderManager{PaperTrading: &config.PaperTrading{Enabled: true, FeeRate: 2}})
+	require.ErrorIs(t, err, errInvalidPaperFeeRate)
+	assert.Nil(t, m, "order manager should be nil on error")
+
+	m, err = SetupOrderManager(em, &CommunicationManager{}, &wg, &config.OrderManager{})
+	require.NoError(t, err, "SetupOrderManager must not error")
+	assert.False(t, m.IsPaperTrading(), "IsPaperTrading should return false when disabled")
+	_, err = m.GetPaperTradingBalances(testExchange, asset.Spot)
+	require.ErrorIs(t, err, errPaperTradingDisabled)
+
+	m, err = SetupOrderManager(em, &CommunicationManager{}, &wg, &config.OrderManager{
+		PaperTrading: &config.PaperTrading{
+			Enabled:         true,
+			InitialBalances: []config.PaperTradingBalance{{Exchange: testExchange, Asset: asset.Spot, Currency: currency.USDT, Amount: 1000}},
+		},
+	})
+	require.NoError(t, err, "SetupOrderManager must not error")
+	assert.True(t, m.IsPaperTrading(), "IsPaperTrading should return true when enabled")
+	m.started.Store(true)
+
+	pair := currency.NewPair(currency.LTC, currency.USDT)
+	loadPaperBook(t, testExchange, pair)
+
+	resp, err := m.Submit(t.Context(), &order.Submit{Exchange: testExchange, Pair: pair, AssetType: asset.Spot, Side: order.Buy, Type: order.Limit, Amount: 2, Price: 100})
+	require.NoError(t, err, "Submit must not error")
+	assert.Equal(t, order.PartiallyFilled, resp.Status, "crossing limit order should partially fill")
+	assert.Equal(t, 1.0, resp.ExecutedAmount, "executed amount should be set")
+
+	stored, err := m.orderStore.getByExchangeAndID(testExchange, resp.OrderID)
+	require.NoError(t, err, "getByExchangeAndID must not error")
+	assert.Equal(t, 1.0, stored.RemainingAmount, "stored remaining amount should be set")
+
+	_, err = m.Modify(t.Context(), &order.Modify{Exchange: testExchange, OrderID: resp.OrderID, Price: 101})
+	require.NoError(t, err, "Modify must not error")
+
+	stored, err = m.orderStore.getByExchangeAndID(testExchange, resp.OrderID)
+	require.NoError(t, err, "getByExchangeAndID must not error")
+	assert.Equal(t, order.Filled, stored.Status, "modified order should fill once it crosses")
+	assert.Zero(t, stored.RemainingAmount, "filled order should have no remaining amount")
+	assert.Equal(t, 2.0, stored.ExecutedAmount, "filled order should be fully executed")
+
+	bals, err := m.GetPaperTradingBalances(testExchange, asset.Spot)
+	require.NoError(t, err, "GetPaperTradingBalances must not error")
+	assert.Equal(t, 2.0, bals[currency.LTC].Total, "LTC should be purchased")
+	assert.Equal(t, 799.0, bals[currency.USDT].Free, "USDT should be spent at the next ask once the first is filled")
+
+	resp, err = m.Submit(t.Context(), &order.Submit{Exchange: testExchange, Pair: pair, AssetType: asset.Spot, Side: order.Sell, Type: order.Limit, Amount: 1, Price: 200})
+	require.NoError(t, err, "Submit must not error")
+	require.NoError(t, m.Cancel(t.Context(), &order.Cancel{Exchange: testExchange, OrderID: resp.OrderID, AssetType: asset.Spot}), "Cancel must not error")
+	stored, err = m.orderStore.getByExchangeAndID(testExchange, resp.OrderID)
+	require.NoError(t, err, "getByExchangeAndID must not error")
+	assert.Equal(t, order.Cancelled, stored.Status, "cancelled order should be stored as cancelled")
+
+	resp, err = m.Submit(t.Context(), &order.Submit{Exchange: testExchange, Pair: pair, AssetType: asset.Spot, Side: order.Sell, Type: order.Limit, Amount: 1, Price: 200})
+	require.NoError(t, err, "Submit must not error")
+	status := m.cancelPaperOrders(t.Context(), []order.Cancel{
+		{Exchange: testExchange, OrderID: resp.OrderID, AssetType: asset.Spot},
+		{Exchange: testExchange, OrderID: "nope", AssetType: asset.Spot},
+	})
+	assert.Equal(t, order.Cancelled.String(), status[resp.OrderID], "resting order should be cancelled")
+	assert.Contains(t, status["nope"], ErrOrderNotFound.Error(), "unknown order should report the cancel error")
+
+	_, err = m.Submit(t.Context(), &order.Submit{Exchange: testExchange, Pair: pair, AssetType: asset.Spot, Side: order.Sell, Type: order.Limit, Amount: 1, Price: 200})
+	require.NoError(t, err, "Submit must not error")
+	s := RPCServer{Engine: &Engine{ExchangeManager: em, OrderManager: m}}
+	cancelled, err := s.CancelAllOrders(t.Context(), &gctrpc.CancelAllOrdersRequest{Exchange: testExchange})
+	require.NoError(t, err, "CancelAllOrders must not error")
+	assert.Equal(t, int64(1), cancelled.Count, "CancelAllOrders should only cancel resting paper orders")
+	assert.Empty(t, m.paperTrader.restingOrders(testExchange), "CancelAllOrders should leave no resting paper orders")
+}
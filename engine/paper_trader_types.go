@@ -0,0 +1,70 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/thrasher-corp/gocryptotrader/common/key"
+	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+var (
+	errPaperAssetNotSupported     = errors.New("paper trading only supports spot assets")
+	errPaperOrderTypeNotSupported = errors.New("paper trading only supports market and limit orders")
+	errInsufficientPaperBalance   = errors.New("insufficient paper trading balance")
+	errNoPaperLiquidity           = errors.New("no orderbook liquidity to fill paper order")
+	errFillOrKillNotFilled        = errors.New("fill or kill order cannot be completely filled")
+	errPostOnlyWouldCross         = errors.New("post only order would cross the orderbook")
+	errInvalidPaperFeeRate        = errors.New("invalid paper trading fee rate")
+	errInvalidPaperBalance        = errors.New("invalid paper trading initial balance")
+	errInvalidPaperModifyAmount   = errors.New("modified amount must exceed the executed amount")
+	errPaperTradingDisabled       = errors.New("paper trading is not enabled")
+
+	// paperTradingCredentials are assigned to every simulated account store
+	// so balances can be saved without live exchange API keys
+	paperTradingCredentials = accounts.Credentials{Key: "papertrading"}
+)
+
+// paperTrader is an in-process matching engine which fills orders against
+// the live orderbook depth and tracks simulated balances per exchange
+type paperTrader struct {
+	m        sync.Mutex
+	feeRate  float64
+	accounts map[string]*accounts.Accounts
+	// resting holds unfilled limit orders by exchange then order ID
+	resting map[string]map[string]*order.Detail
+	// consumed holds the volume paper orders have filled at each price so
+	// the same liquidity is not filled again on the next match
+	consumed map[paperBookSide]map[float64]float64
+}
+
+// paperBookSide identifies the orderbook side a paper order trades against
+type paperBookSide struct {
+	key.ExchangeAssetPair
+	isBuy bool
+}
+
+// paperAccount satisfies the accounts exchange requirements for a simulated
+// account store
+type paperAccount struct {
+	name string
+}
+
+// balanceChange defines a delta to apply to a simulated currency balance
+type balanceChange struct {
+	total float64
+	hold  float64
+}
+
+// GetName returns the exchange name the simulated account belongs to
+func (p *paperAccount) GetName() string {
+	return p.name
+}
+
+// GetCredentials returns the fixed paper trading credentials
+func (p *paperAccount) GetCredentials(context.Context) (*accounts.Credentials, error) {
+	creds := paperTradingCredentials
+	return &creds, nil
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/log"
@@ -164,9 +165,17 @@ func (m *portfolioManager) updateExchangeBalances(ctx context.Context) error {
 			assetTypes = e.GetAssetTypes(true)
 		}
 
-		for _, a := range assetTypes {
-			if _, err := e.UpdateAccountBalances(ctx, a); err != nil {
-				errs = common.AppendError(errs, fmt.Errorf("error updating %s %s account balances: %w", e.GetName(), a, err))
+		// Balances are stored against the credentials of each account profile,
+		// so the exchange address below totals every account
+		for _, account := range exchangeAccounts(e) {
+			accountCtx, name := ctx, e.GetName()
+			if account != "" {
+				accountCtx, name = accounts.DeployAccountToContext(ctx, account), name+" "+account
+			}
+			for _, a := range assetTypes {
+				if _, err := e.UpdateAccountBalances(accountCtx, a); err != nil {
+					errs = common.AppendError(errs, fmt.Errorf("error updating %s %s account balances: %w", name, a, err))
+				}
 			}
 		}
 		if err := m.updateExchangeAddressBalances(e); err != nil {
@@ -218,7 +227,7 @@ func (m *portfolioManager) updateExchangeAddressBalances(e exchange.IBotExchange
 }
 
 // AddAddress adds a new portfolio address for the portfolio manager to track
-func (m *portfolioManager) AddAddress(address, description string, coinType currency.Code, balance float64) error {
+func (m *portfolioManager) AddAddress(address, description string, coinType currency.Code, chain string, balance float64) error {
 	if m == nil {
 		return fmt.Errorf("portfolio manager %w", ErrNilSubsystem)
 	}
@@ -227,7 +236,7 @@ func (m *portfolioManager) AddAddress(address, description string, coinType curr
 	}
 	m.m.Lock()
 	defer m.m.Unlock()
-	return m.base.AddAddress(address, description, coinType, balance)
+	return m.base.AddAddress(address, description, coinType, chain, balance)
 }
 
 // RemoveAddress removes a portfolio address
@@ -243,12 +252,13 @@ func (m *portfolioManager) RemoveAddress(address, description string, coinType c
 	return m.base.RemoveAddress(address, description, coinType)
 }
 
-// GetPortfolioSummary returns a summary of all portfolio holdings
-func (m *portfolioManager) GetPortfolioSummary() portfolio.Summary {
+// GetPortfolioSummary returns a summary of all portfolio holdings, valued in
+// the valuation currency when set
+func (m *portfolioManager) GetPortfolioSummary(ctx context.Context, valuation currency.Code) portfolio.Summary {
 	if m == nil || !m.IsRunning() {
 		return portfolio.Summary{}
 	}
-	return m.base.GetPortfolioSummary()
+	return m.base.GetPortfolioSummary(ctx, valuation)
 }
 
 // GetAddresses returns all addresses
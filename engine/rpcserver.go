@@ -17,7 +17,6 @@ import (
 	"github.com/gofrs/uuid"
 	grpcauth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
-	"github.com/pquerna/otp/totp"
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gct-ta/indicators"
 	"github.com/thrasher-corp/gocryptotrader/common"
@@ -26,11 +25,13 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common/key"
 	"github.com/thrasher-corp/gocryptotrader/common/timeperiods"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/currency/crossrate"
 	"github.com/thrasher-corp/gocryptotrader/database"
 	"github.com/thrasher-corp/gocryptotrader/database/models/postgres"
 	"github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
 	"github.com/thrasher-corp/gocryptotrader/database/repository/audit"
 	exchangeDB "github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/withdrawaddress"
 	"github.com/thrasher-corp/gocryptotrader/encoding/json"
 	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
@@ -610,7 +611,7 @@ func (s *RPCServer) GetAccountBalances(ctx context.Context, r *gctrpc.GetAccount
 		return nil, err
 	}
 
-	return accountBalanceResp(r.Exchange, resp), nil
+	return accountBalanceResp(r.Exchange, resp, s.valuationCurrency(r.ValuationCurrency)), nil
 }
 
 // UpdateAccountBalances forces an update of the account balances.
@@ -634,17 +635,27 @@ func (s *RPCServer) UpdateAccountBalances(ctx context.Context, r *gctrpc.GetAcco
 		return nil, err
 	}
 
-	return accountBalanceResp(r.Exchange, resp), nil
+	return accountBalanceResp(r.Exchange, resp, s.valuationCurrency(r.ValuationCurrency)), nil
 }
 
-func accountBalanceResp(eName string, s accounts.SubAccounts) *gctrpc.GetAccountBalancesResponse {
-	subAccts := make([]*gctrpc.Account, len(s))
+// accountBalanceResp converts sub account balances to an RPC response, valuing
+// each balance in the valuation currency when set
+func accountBalanceResp(eName string, s accounts.SubAccounts, valuation currency.Code) *gctrpc.GetAccountBalancesResponse {
+	resp := &gctrpc.GetAccountBalancesResponse{
+		Exchange: eName,
+		Accounts: make([]*gctrpc.Account, len(s)),
+	}
+	var g *crossrate.Graph
+	if !valuation.IsEmpty() {
+		g = crossrate.GetGraph()
+		resp.ValuationCurrency = valuation.String()
+	}
 	for i, sa := range s {
-		subAccts[i] = &gctrpc.Account{
+		resp.Accounts[i] = &gctrpc.Account{
 			Id: sa.ID,
 		}
 		for curr, bal := range sa.Balances {
-			subAccts[i].Currencies = append(subAccts[i].Currencies, &gctrpc.AccountCurrencyInfo{
+			info := &gctrpc.AccountCurrencyInfo{
 				Currency:          curr.String(),
 				TotalValue:        bal.Total,
 				Hold:              bal.Hold,
@@ -652,13 +663,57 @@ func accountBalanceResp(eName string, s accounts.SubAccounts) *gctrpc.GetAccount
 				FreeWithoutBorrow: bal.AvailableWithoutBorrow,
 				Borrowed:          bal.Borrowed,
 				UpdatedAt:         timestamppb.New(bal.UpdatedAt),
-			})
+			}
+			if g != nil {
+				if value, path, err := g.Convert(bal.Total, curr, valuation); err == nil {
+					info.Value = value
+					info.ConversionPath = conversionPathResp(path)
+					resp.TotalValue += value
+				}
+			}
+			resp.Accounts[i].Currencies = append(resp.Accounts[i].Currencies, info)
 		}
 	}
-	return &gctrpc.GetAccountBalancesResponse{
-		Exchange: eName,
-		Accounts: subAccts,
+	return resp
+}
+
+// conversionPathResp converts the hops of a conversion path to an RPC response
+func conversionPathResp(p *crossrate.Path) []*gctrpc.ConversionHop {
+	if p == nil {
+		return nil
 	}
+	hops := make([]*gctrpc.ConversionHop, len(p.Hops))
+	for i := range p.Hops {
+		hops[i] = &gctrpc.ConversionHop{
+			From:   p.Hops[i].From.String(),
+			To:     p.Hops[i].To.String(),
+			Rate:   p.Hops[i].Rate,
+			Source: p.Hops[i].Source,
+		}
+		if !p.Hops[i].Pair.IsEmpty() {
+			hops[i].Pair = p.Hops[i].Pair.String()
+		}
+		if p.Hops[i].Asset.IsValid() {
+			hops[i].Asset = p.Hops[i].Asset.String()
+		}
+		if !p.Hops[i].Updated.IsZero() {
+			hops[i].UpdatedAt = timestamppb.New(p.Hops[i].Updated)
+			hops[i].Staleness = p.Hops[i].Staleness.String()
+		}
+	}
+	return hops
+}
+
+// valuationCurrency returns the requested valuation currency, falling back to
+// the configured fiat display currency
+func (s *RPCServer) valuationCurrency(requested string) currency.Code {
+	if requested != "" {
+		return currency.NewCode(requested)
+	}
+	if s.Config == nil {
+		return currency.EMPTYCODE
+	}
+	return s.Config.Currency.FiatDisplayCurrency
 }
 
 // GetAccountBalancesStream streams an account balance for a specific exchange
@@ -708,7 +763,7 @@ func (s *RPCServer) GetAccountBalancesStream(r *gctrpc.GetAccountBalancesRequest
 			return err
 		}
 
-		if err := stream.Send(accountBalanceResp(r.Exchange, subAccts)); err != nil {
+		if err := stream.Send(accountBalanceResp(r.Exchange, subAccts, s.valuationCurrency(r.ValuationCurrency))); err != nil {
 			return err
 		}
 	}
@@ -740,18 +795,25 @@ func (s *RPCServer) GetPortfolio(_ context.Context, _ *gctrpc.GetPortfolioReques
 }
 
 // GetPortfolioSummary returns the portfoliomanager summary
-func (s *RPCServer) GetPortfolioSummary(_ context.Context, _ *gctrpc.GetPortfolioSummaryRequest) (*gctrpc.GetPortfolioSummaryResponse, error) {
-	result := s.portfolioManager.GetPortfolioSummary()
-	var resp gctrpc.GetPortfolioSummaryResponse
+func (s *RPCServer) GetPortfolioSummary(ctx context.Context, r *gctrpc.GetPortfolioSummaryRequest) (*gctrpc.GetPortfolioSummaryResponse, error) {
+	result := s.portfolioManager.GetPortfolioSummary(ctx, s.valuationCurrency(r.ValuationCurrency))
+	resp := gctrpc.GetPortfolioSummaryResponse{
+		TotalValue: result.TotalValue,
+	}
+	if !result.ValuationCurrency.IsEmpty() {
+		resp.ValuationCurrency = result.ValuationCurrency.String()
+	}
 
 	p := func(coins []portfolio.Coin) []*gctrpc.Coin {
 		c := make([]*gctrpc.Coin, len(coins))
 		for x := range coins {
 			c[x] = &gctrpc.Coin{
-				Coin:       coins[x].Coin.String(),
-				Balance:    coins[x].Balance,
-				Address:    coins[x].Address,
-				Percentage: coins[x].Percentage,
+				Coin:           coins[x].Coin.String(),
+				Balance:        coins[x].Balance,
+				Address:        coins[x].Address,
+				Percentage:     coins[x].Percentage,
+				Value:          coins[x].Value,
+				ConversionPath: conversionPathResp(coins[x].Path),
 			}
 		}
 		return c
@@ -796,6 +858,7 @@ func (s *RPCServer) AddPortfolioAddress(_ context.Context, r *gctrpc.AddPortfoli
 	err := s.portfolioManager.AddAddress(r.Address,
 		r.Description,
 		currency.NewCode(r.CoinType),
+		r.Chain,
 		r.Balance)
 	if err != nil {
 		return nil, err
@@ -987,7 +1050,7 @@ func (s *RPCServer) GetOrders(ctx context.Context, r *gctrpc.GetOrdersRequest) (
 
 // GetManagedOrders returns all orders from the Order Manager for the provided exchange,
 // asset type and currency pair
-func (s *RPCServer) GetManagedOrders(_ context.Context, r *gctrpc.GetOrdersRequest) (*gctrpc.GetOrdersResponse, error) {
+func (s *RPCServer) GetManagedOrders(ctx context.Context, r *gctrpc.GetOrdersRequest) (*gctrpc.GetOrdersResponse, error) {
 	if r == nil {
 		return nil, errInvalidArguments
 	}
@@ -1018,6 +1081,7 @@ func (s *RPCServer) GetManagedOrders(_ context.Context, r *gctrpc.GetOrdersReque
 	var resp []order.Detail
 	filter := order.Filter{
 		Exchange:  exch.GetName(),
+		Account:   accounts.AccountFromContext(ctx),
 		Pair:      cp,
 		AssetType: a,
 	}
@@ -1061,6 +1125,7 @@ func (s *RPCServer) GetManagedOrders(_ context.Context, r *gctrpc.GetOrdersReque
 			Fee:           resp[x].Fee,
 			Cost:          resp[x].Cost,
 			Trades:        trades,
+			Account:       resp[x].Account,
 		}
 		if !resp[x].Date.IsZero() {
 			o.CreationTime = resp[x].Date.Format(common.SimpleTimeFormatWithTimezone)
@@ -1408,6 +1473,7 @@ func (s *RPCServer) CancelBatchOrders(ctx context.Context, r *gctrpc.CancelBatch
 		orderID := orders[x]
 		status[orderID] = order.Cancelled.String()
 		req[x] = order.Cancel{
+			Exchange:  r.Exchange,
 			AccountID: r.AccountId,
 			OrderID:   orderID,
 			Side:      side,
@@ -1416,6 +1482,19 @@ func (s *RPCServer) CancelBatchOrders(ctx context.Context, r *gctrpc.CancelBatch
 		}
 	}
 
+	if s.OrderManager.IsPaperTrading() {
+		return &gctrpc.CancelBatchOrdersResponse{
+			Orders: []*gctrpc.Orders{{
+				Exchange:    r.Exchange,
+				OrderStatus: s.OrderManager.cancelPaperOrders(ctx, req),
+			}},
+		}, nil
+	}
+
+	if err := CheckCredentialAccess(ctx, exch, accounts.TradeAccess); err != nil {
+		return nil, err
+	}
+
 	// TODO: Change to order manager
 	_, err = exch.CancelBatchOrders(ctx, req)
 	if err != nil {
@@ -1437,6 +1516,18 @@ func (s *RPCServer) CancelAllOrders(ctx context.Context, r *gctrpc.CancelAllOrde
 		return nil, err
 	}
 
+	if s.OrderManager.IsPaperTrading() {
+		status := s.OrderManager.cancelPaperOrders(ctx, s.OrderManager.paperTrader.restingOrders(exch.GetName()))
+		return &gctrpc.CancelAllOrdersResponse{
+			Orders: []*gctrpc.Orders{{Exchange: r.Exchange, OrderStatus: status}},
+			Count:  int64(len(status)),
+		}, nil
+	}
+
+	if err := CheckCredentialAccess(ctx, exch, accounts.TradeAccess); err != nil {
+		return nil, err
+	}
+
 	// TODO: Change to order manager
 	resp, err := exch.CancelAllOrders(ctx, nil)
 	if err != nil {
@@ -1633,12 +1724,17 @@ func (s *RPCServer) WithdrawCryptocurrencyFunds(ctx context.Context, r *gctrpc.W
 		return nil, err
 	}
 
+	if err := s.Engine.WithdrawManager.AuthenticateRequester(r.RequestedBy, r.RequestedByToken); err != nil {
+		return nil, err
+	}
+
 	req := &withdraw.Request{
 		Exchange:    r.Exchange,
 		Amount:      r.Amount,
 		Currency:    currency.NewCode(strings.ToUpper(r.Currency)),
 		Type:        withdraw.Crypto,
 		Description: r.Description,
+		RequestedBy: r.RequestedBy,
 		Crypto: withdraw.CryptoRequest{
 			Address:    r.Address,
 			AddressTag: r.AddressTag,
@@ -1647,34 +1743,10 @@ func (s *RPCServer) WithdrawCryptocurrencyFunds(ctx context.Context, r *gctrpc.W
 		},
 	}
 
-	exchCfg, err := s.Config.GetExchangeConfig(r.Exchange)
-	if err != nil {
+	if err := setWithdrawalSecrets(s.Config, req); err != nil {
 		return nil, err
 	}
 
-	if exchCfg.API.Credentials.OTPSecret != "" {
-		code, errOTP := totp.GenerateCode(exchCfg.API.Credentials.OTPSecret, time.Now())
-		if errOTP != nil {
-			return nil, errOTP
-		}
-
-		codeNum, errOTP := strconv.ParseInt(code, 10, 64)
-		if errOTP != nil {
-			return nil, errOTP
-		}
-		req.OneTimePassword = codeNum
-	}
-
-	if exchCfg.API.Credentials.PIN != "" {
-		pinCode, errPin := strconv.ParseInt(exchCfg.API.Credentials.PIN, 10, 64)
-		if errPin != nil {
-			return nil, errPin
-		}
-		req.PIN = pinCode
-	}
-
-	req.TradePassword = exchCfg.API.Credentials.TradePassword
-
 	resp, err := s.Engine.WithdrawManager.SubmitWithdrawal(ctx, req)
 	if err != nil {
 		return nil, err
@@ -1693,6 +1765,10 @@ func (s *RPCServer) WithdrawFiatFunds(ctx context.Context, r *gctrpc.WithdrawFia
 		return nil, err
 	}
 
+	if err := s.Engine.WithdrawManager.AuthenticateRequester(r.RequestedBy, r.RequestedByToken); err != nil {
+		return nil, err
+	}
+
 	bankAccount, err := banking.GetBankAccountByID(r.BankAccountId)
 	if err != nil {
 		base := exch.GetBase()
@@ -1712,39 +1788,16 @@ func (s *RPCServer) WithdrawFiatFunds(ctx context.Context, r *gctrpc.WithdrawFia
 		Currency:    currency.NewCode(strings.ToUpper(r.Currency)),
 		Type:        withdraw.Fiat,
 		Description: r.Description,
+		RequestedBy: r.RequestedBy,
 		Fiat: withdraw.FiatRequest{
 			Bank: *bankAccount,
 		},
 	}
 
-	exchCfg, err := s.Config.GetExchangeConfig(r.Exchange)
-	if err != nil {
+	if err := setWithdrawalSecrets(s.Config, req); err != nil {
 		return nil, err
 	}
 
-	if exchCfg.API.Credentials.OTPSecret != "" {
-		code, errOTP := totp.GenerateCode(exchCfg.API.Credentials.OTPSecret, time.Now())
-		if errOTP != nil {
-			return nil, errOTP
-		}
-
-		codeNum, errOTP := strconv.ParseInt(code, 10, 64)
-		if errOTP != nil {
-			return nil, errOTP
-		}
-		req.OneTimePassword = codeNum
-	}
-
-	if exchCfg.API.Credentials.PIN != "" {
-		pinCode, errPIN := strconv.ParseInt(exchCfg.API.Credentials.PIN, 10, 64)
-		if errPIN != nil {
-			return nil, errPIN
-		}
-		req.PIN = pinCode
-	}
-
-	req.TradePassword = exchCfg.API.Credentials.TradePassword
-
 	resp, err := s.Engine.WithdrawManager.SubmitWithdrawal(ctx, req)
 	if err != nil {
 		return nil, err
@@ -1876,6 +1929,99 @@ func (s *RPCServer) WithdrawalEventsByDate(_ context.Context, r *gctrpc.Withdraw
 	return parseMultipleEvents(ret), nil
 }
 
+// AddWithdrawalAddress adds an approved destination to the withdrawal address
+// book
+func (s *RPCServer) AddWithdrawalAddress(ctx context.Context, r *gctrpc.AddWithdrawalAddressRequest) (*gctrpc.WithdrawalAddress, error) {
+	if !s.Config.Database.Enabled {
+		return nil, database.ErrDatabaseSupportDisabled
+	}
+	d, err := withdrawaddress.Insert(ctx, withdrawaddress.Data{
+		Currency:   r.Currency,
+		Chain:      r.Chain,
+		Address:    r.Address,
+		AddressTag: r.AddressTag,
+		Label:      r.Label,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return withdrawalAddressResp(d), nil
+}
+
+// RemoveWithdrawalAddress removes a destination from the withdrawal address
+// book
+func (s *RPCServer) RemoveWithdrawalAddress(ctx context.Context, r *gctrpc.RemoveWithdrawalAddressRequest) (*gctrpc.GenericResponse, error) {
+	if !s.Config.Database.Enabled {
+		return nil, database.ErrDatabaseSupportDisabled
+	}
+	if err := withdrawaddress.Delete(ctx, r.Id); err != nil {
+		return nil, err
+	}
+	return &gctrpc.GenericResponse{Status: MsgStatusSuccess}, nil
+}
+
+// GetWithdrawalAddresses returns the withdrawal address book, optionally
+// filtered by currency
+func (s *RPCServer) GetWithdrawalAddresses(ctx context.Context, r *gctrpc.GetWithdrawalAddressesRequest) (*gctrpc.GetWithdrawalAddressesResponse, error) {
+	if !s.Config.Database.Enabled {
+		return nil, database.ErrDatabaseSupportDisabled
+	}
+	addresses, err := withdrawaddress.GetAll(ctx, r.Currency)
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetWithdrawalAddressesResponse{
+		Addresses: make([]*gctrpc.WithdrawalAddress, len(addresses)),
+	}
+	for i := range addresses {
+		resp.Addresses[i] = withdrawalAddressResp(&addresses[i])
+	}
+	return resp, nil
+}
+
+// GetPendingWithdrawals returns the withdrawals awaiting approval
+func (s *RPCServer) GetPendingWithdrawals(_ context.Context, _ *gctrpc.GetPendingWithdrawalsRequest) (*gctrpc.GetPendingWithdrawalsResponse, error) {
+	pending, err := s.WithdrawManager.GetPendingWithdrawals()
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetPendingWithdrawalsResponse{
+		Withdrawals: make([]*gctrpc.PendingWithdrawal, len(pending)),
+	}
+	for i := range pending {
+		resp.Withdrawals[i] = pendingWithdrawalResp(&pending[i])
+	}
+	return resp, nil
+}
+
+// ApproveWithdrawal approves a pending withdrawal on behalf of an
+// authenticated approver, submitting it once it has enough approvals
+func (s *RPCServer) ApproveWithdrawal(ctx context.Context, r *gctrpc.ApproveWithdrawalRequest) (*gctrpc.WithdrawResponse, error) {
+	if err := s.WithdrawManager.AuthenticateApprover(r.Approver, r.ApproverToken); err != nil {
+		return nil, err
+	}
+	resp, err := s.WithdrawManager.ApproveWithdrawal(ctx, r.Id, r.Approver)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.WithdrawResponse{
+		Id:     resp.ID.String(),
+		Status: resp.Exchange.Status,
+	}, nil
+}
+
+// RejectWithdrawal rejects and discards a pending withdrawal on behalf of an
+// authenticated approver
+func (s *RPCServer) RejectWithdrawal(_ context.Context, r *gctrpc.RejectWithdrawalRequest) (*gctrpc.GenericResponse, error) {
+	if err := s.WithdrawManager.AuthenticateApprover(r.Approver, r.ApproverToken); err != nil {
+		return nil, err
+	}
+	if err := s.WithdrawManager.RejectWithdrawal(r.Id, r.Approver); err != nil {
+		return nil, err
+	}
+	return &gctrpc.GenericResponse{Status: MsgStatusSuccess}, nil
+}
+
 // GetLoggerDetails returns a loggers details
 func (s *RPCServer) GetLoggerDetails(_ context.Context, r *gctrpc.GetLoggerDetailsRequest) (*gctrpc.GetLoggerDetailsResponse, error) {
 	levels, err := log.Level(r.Logger)
@@ -2966,6 +3112,27 @@ func (s *RPCServer) WebsocketGetInfo(_ context.Context, r *gctrpc.WebsocketGetIn
 		return nil, err
 	}
 
+	health := w.GetConnectionHealth()
+	connections := make([]*gctrpc.WebsocketConnectionHealth, len(health))
+	for i := range health {
+		connections[i] = &gctrpc.WebsocketConnectionHealth{
+			Url:              health[i].URL,
+			Connected:        health[i].Connected,
+			Subscriptions:    int64(health[i].Subscriptions),
+			MaxSubscriptions: int64(health[i].MaxSubscriptions),
+			Messages:         health[i].Messages,
+		}
+		if health[i].MessageFilter != nil {
+			connections[i].MessageFilter = fmt.Sprint(health[i].MessageFilter)
+		}
+		if !health[i].ConnectedAt.IsZero() {
+			connections[i].ConnectedAt = timestamppb.New(health[i].ConnectedAt)
+		}
+		if !health[i].LastMessage.IsZero() {
+			connections[i].LastMessage = timestamppb.New(health[i].LastMessage)
+		}
+	}
+
 	return &gctrpc.WebsocketGetInfoResponse{
 		Exchange:      exch.GetName(),
 		Supported:     exch.SupportsWebsocket(),
@@ -2973,6 +3140,7 @@ func (s *RPCServer) WebsocketGetInfo(_ context.Context, r *gctrpc.WebsocketGetIn
 		Authenticated: w.CanUseAuthenticatedEndpoints(),
 		RunningUrl:    w.GetWebsocketURL(),
 		ProxyAddress:  w.GetProxyAddress(),
+		Connections:   connections,
 	}, nil
 }
 
@@ -3732,6 +3900,61 @@ func parseSingleEvents(ret *withdraw.Response) *gctrpc.WithdrawalEventsByExchang
 	}
 }
 
+func withdrawalAddressResp(d *withdrawaddress.Data) *gctrpc.WithdrawalAddress {
+	return &gctrpc.WithdrawalAddress{
+		Id:         d.ID,
+		Currency:   d.Currency,
+		Chain:      d.Chain,
+		Address:    d.Address,
+		AddressTag: d.AddressTag,
+		Label:      d.Label,
+		CreatedAt:  timestamppb.New(d.CreatedAt),
+	}
+}
+
+func pendingWithdrawalResp(p *PendingWithdrawal) *gctrpc.PendingWithdrawal {
+	resp := &gctrpc.PendingWithdrawal{
+		Id:       p.ID.String(),
+		Exchange: p.Request.Exchange,
+		Request: &gctrpc.WithdrawalRequestEvent{
+			Currency:    p.Request.Currency.String(),
+			Description: p.Request.Description,
+			Amount:      p.Request.Amount,
+			Type:        int64(p.Request.Type),
+		},
+		RequestedBy:       p.Request.RequestedBy,
+		RequestedAt:       timestamppb.New(p.RequestedAt),
+		ExpiresAt:         timestamppb.New(p.ExpiresAt),
+		RequiredApprovals: int64(p.RequiredApprovals),
+		Approvals:         make([]*gctrpc.WithdrawalApproval, len(p.Approvals)),
+	}
+	switch p.Request.Type {
+	case withdraw.Crypto:
+		resp.Request.Crypto = &gctrpc.CryptoWithdrawalEvent{
+			Address:    p.Request.Crypto.Address,
+			AddressTag: p.Request.Crypto.AddressTag,
+			Fee:        p.Request.Crypto.FeeAmount,
+			Chain:      p.Request.Crypto.Chain,
+		}
+	case withdraw.Fiat:
+		resp.Request.Fiat = &gctrpc.FiatWithdrawalEvent{
+			BankName:      p.Request.Fiat.Bank.BankName,
+			AccountName:   p.Request.Fiat.Bank.AccountName,
+			AccountNumber: p.Request.Fiat.Bank.AccountNumber,
+			Bsb:           p.Request.Fiat.Bank.BSBNumber,
+			Swift:         p.Request.Fiat.Bank.SWIFTCode,
+			Iban:          p.Request.Fiat.Bank.IBAN,
+		}
+	}
+	for i := range p.Approvals {
+		resp.Approvals[i] = &gctrpc.WithdrawalApproval{
+			Approver:   p.Approvals[i].Approver,
+			ApprovedAt: timestamppb.New(p.Approvals[i].Time),
+		}
+	}
+	return resp
+}
+
 // UpsertDataHistoryJob adds or updates a data history job for the data history manager
 // It will upsert the entry in the database and allow for the processing of the job
 func (s *RPCServer) UpsertDataHistoryJob(_ context.Context, r *gctrpc.UpsertDataHistoryJobRequest) (*gctrpc.UpsertDataHistoryJobResponse, error) {
@@ -4218,7 +4441,7 @@ func (s *RPCServer) buildFuturePosition(position *futures.Position, getFundingPa
 }
 
 // GetManagedPosition returns an open positions from the order manager, no calling any API endpoints to return this information
-func (s *RPCServer) GetManagedPosition(_ context.Context, r *gctrpc.GetManagedPositionRequest) (*gctrpc.GetManagedPositionsResponse, error) {
+func (s *RPCServer) GetManagedPosition(ctx context.Context, r *gctrpc.GetManagedPositionRequest) (*gctrpc.GetManagedPositionsResponse, error) {
 	if r == nil {
 		return nil, fmt.Errorf("%w GetManagedPositionRequest", common.ErrNilPointer)
 	}
@@ -4260,7 +4483,7 @@ func (s *RPCServer) GetManagedPosition(_ context.Context, r *gctrpc.GetManagedPo
 	if err != nil {
 		return nil, err
 	}
-	position, err := s.OrderManager.GetOpenFuturesPosition(r.Exchange, ai, cp)
+	position, err := s.OrderManager.GetOpenFuturesPosition(r.Exchange, accounts.AccountFromContext(ctx), ai, cp)
 	if err != nil {
 		return nil, err
 	}
@@ -4271,14 +4494,14 @@ func (s *RPCServer) GetManagedPosition(_ context.Context, r *gctrpc.GetManagedPo
 }
 
 // GetAllManagedPositions returns all open positions from the order manager, no calling any API endpoints to return this information
-func (s *RPCServer) GetAllManagedPositions(_ context.Context, r *gctrpc.GetAllManagedPositionsRequest) (*gctrpc.GetManagedPositionsResponse, error) {
+func (s *RPCServer) GetAllManagedPositions(ctx context.Context, r *gctrpc.GetAllManagedPositionsRequest) (*gctrpc.GetManagedPositionsResponse, error) {
 	if r == nil {
 		return nil, fmt.Errorf("%w GetAllManagedPositionsRequest", common.ErrNilPointer)
 	}
 	if err := futures.CheckFundingRatePrerequisites(r.GetFundingPayments, r.IncludePredictedRate, r.GetFundingPayments); err != nil {
 		return nil, err
 	}
-	positions, err := s.OrderManager.GetAllOpenFuturesPositions()
+	positions, err := s.OrderManager.GetAllOpenFuturesPositions(accounts.AccountFromContext(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -5113,6 +5336,96 @@ func (s *RPCServer) GetTechnicalAnalysis(ctx context.Context, r *gctrpc.GetTechn
 			return nil, err
 		}
 		signals["RSI"] = &gctrpc.ListOfSignals{Signals: prices}
+	case "STOCH":
+		var stochastic *kline.Stochastic
+		stochastic, err = klines.GetStochastic(r.Period, r.SmoothingPeriod, r.SignalPeriod)
+		if err != nil {
+			return nil, err
+		}
+		signals["K"] = &gctrpc.ListOfSignals{Signals: stochastic.K}
+		signals["D"] = &gctrpc.ListOfSignals{Signals: stochastic.D}
+	case "STOCHRSI":
+		var stochastic *kline.Stochastic
+		stochastic, err = klines.GetStochasticRSIOnClose(r.Period, r.SecondaryPeriod, r.SmoothingPeriod, r.SignalPeriod)
+		if err != nil {
+			return nil, err
+		}
+		signals["K"] = &gctrpc.ListOfSignals{Signals: stochastic.K}
+		signals["D"] = &gctrpc.ListOfSignals{Signals: stochastic.D}
+	case "ADX":
+		var dmi *kline.DirectionalMovement
+		dmi, err = klines.GetAverageDirectionalIndex(r.Period)
+		if err != nil {
+			return nil, err
+		}
+		signals["ADX"] = &gctrpc.ListOfSignals{Signals: dmi.ADX}
+		signals["PLUSDI"] = &gctrpc.ListOfSignals{Signals: dmi.PlusDI}
+		signals["MINUSDI"] = &gctrpc.ListOfSignals{Signals: dmi.MinusDI}
+	case "ICHIMOKU":
+		var cloud *kline.Ichimoku
+		cloud, err = klines.GetIchimoku(r.FastPeriod, r.SlowPeriod, r.SecondaryPeriod, r.Displacement)
+		if err != nil {
+			return nil, err
+		}
+		signals["CONVERSION"] = &gctrpc.ListOfSignals{Signals: cloud.Conversion}
+		signals["BASE"] = &gctrpc.ListOfSignals{Signals: cloud.Base}
+		signals["SPANA"] = &gctrpc.ListOfSignals{Signals: cloud.LeadingSpanA}
+		signals["SPANB"] = &gctrpc.ListOfSignals{Signals: cloud.LeadingSpanB}
+		signals["LAGGING"] = &gctrpc.ListOfSignals{Signals: cloud.LaggingSpan}
+	case "AVWAP":
+		var prices []float64
+		prices, err = klines.GetAnchoredVWAPs(kline.Interval(r.SessionInterval))
+		if err != nil {
+			return nil, err
+		}
+		signals["AVWAP"] = &gctrpc.ListOfSignals{Signals: prices}
+	case "KELTNER":
+		var channel *kline.Channel
+		channel, err = klines.GetKeltnerChannels(r.Period, r.SecondaryPeriod, r.Multiplier)
+		if err != nil {
+			return nil, err
+		}
+		signals["UPPER"] = &gctrpc.ListOfSignals{Signals: channel.Upper}
+		signals["MIDDLE"] = &gctrpc.ListOfSignals{Signals: channel.Middle}
+		signals["LOWER"] = &gctrpc.ListOfSignals{Signals: channel.Lower}
+	case "DONCHIAN":
+		var channel *kline.Channel
+		channel, err = klines.GetDonchianChannels(r.Period)
+		if err != nil {
+			return nil, err
+		}
+		signals["UPPER"] = &gctrpc.ListOfSignals{Signals: channel.Upper}
+		signals["MIDDLE"] = &gctrpc.ListOfSignals{Signals: channel.Middle}
+		signals["LOWER"] = &gctrpc.ListOfSignals{Signals: channel.Lower}
+	case "SUPERTREND":
+		var st *kline.Supertrend
+		st, err = klines.GetSupertrend(r.Period, r.Multiplier)
+		if err != nil {
+			return nil, err
+		}
+		signals["SUPERTREND"] = &gctrpc.ListOfSignals{Signals: st.Values}
+		signals["DIRECTION"] = &gctrpc.ListOfSignals{Signals: st.Direction}
+	case "PSAR":
+		var prices []float64
+		prices, err = klines.GetParabolicSAR(r.AccelerationStep, r.AccelerationMax)
+		if err != nil {
+			return nil, err
+		}
+		signals["PSAR"] = &gctrpc.ListOfSignals{Signals: prices}
+	case "CCI":
+		var prices []float64
+		prices, err = klines.GetCommodityChannelIndex(r.Period)
+		if err != nil {
+			return nil, err
+		}
+		signals["CCI"] = &gctrpc.ListOfSignals{Signals: prices}
+	case "WILLR":
+		var prices []float64
+		prices, err = klines.GetWilliamsPercentRange(r.Period)
+		if err != nil {
+			return nil, err
+		}
+		signals["WILLR"] = &gctrpc.ListOfSignals{Signals: prices}
 	default:
 		return nil, fmt.Errorf("%w %q", errInvalidStrategy, r.AlgorithmType)
 	}
@@ -5597,6 +5910,9 @@ func (s *RPCServer) SetCollateralMode(ctx context.Context, r *gctrpc.SetCollater
 	if err != nil {
 		return nil, fmt.Errorf("%w %v", order.ErrCollateralInvalid, r.CollateralMode)
 	}
+	if err := CheckCredentialAccess(ctx, exch, accounts.TradeAccess); err != nil {
+		return nil, err
+	}
 	err = exch.SetCollateralMode(ctx, item, cm)
 	if err != nil {
 		return nil, err
@@ -5641,6 +5957,9 @@ func (s *RPCServer) SetMarginType(ctx context.Context, r *gctrpc.SetMarginTypeRe
 		return nil, err
 	}
 
+	if err := CheckCredentialAccess(ctx, exch, accounts.TradeAccess); err != nil {
+		return nil, err
+	}
 	err = exch.SetMarginType(ctx, ai, cp, mt)
 	if err != nil {
 		return nil, err
@@ -5759,6 +6078,9 @@ func (s *RPCServer) SetLeverage(ctx context.Context, r *gctrpc.SetLeverageReques
 		}
 	}
 
+	if err := CheckCredentialAccess(ctx, exch, accounts.TradeAccess); err != nil {
+		return nil, err
+	}
 	err = exch.SetLeverage(ctx, ai, cp, mt, r.Leverage, orderSide)
 	if err != nil {
 		return nil, err
@@ -5806,6 +6128,9 @@ func (s *RPCServer) ChangePositionMargin(ctx context.Context, r *gctrpc.ChangePo
 	if err != nil {
 		return nil, err
 	}
+	if err := CheckCredentialAccess(ctx, exch, accounts.TradeAccess); err != nil {
+		return nil, err
+	}
 	resp, err := exch.ChangePositionMargin(ctx, &margin.PositionChangeRequest{
 		Exchange:                exch.GetName(),
 		Pair:                    cp,
@@ -5911,3 +6236,307 @@ func (s *RPCServer) GetCurrencyTradeURL(ctx context.Context, r *gctrpc.GetCurren
 		Url: url,
 	}, nil
 }
+
+// GetCandleStream streams live candles built from websocket trades for the
+// requested exchange, pair, asset and interval. Each revision of the forming
+// candle is sent, with is_partial unset once the candle has closed.
+func (s *RPCServer) GetCandleStream(r *gctrpc.GetCandleStreamRequest, stream gctrpc.GoCryptoTraderService_GetCandleStreamServer) error {
+	if r.Exchange == "" {
+		return common.ErrExchangeNameNotSet
+	}
+	if r.Pair == nil {
+		return errCurrencyPairUnset
+	}
+	exch, err := s.GetExchangeByName(r.Exchange)
+	if err != nil {
+		return err
+	}
+	a, err := asset.New(r.AssetType)
+	if err != nil {
+		return err
+	}
+	p, err := currency.NewPairFromStrings(r.Pair.Base, r.Pair.Quote)
+	if err != nil {
+		return err
+	}
+	if err := checkParams(r.Exchange, exch, a, p); err != nil {
+		return err
+	}
+	interval := kline.Interval(r.TimeInterval)
+	if interval <= 0 {
+		return fmt.Errorf("%w: %v", kline.ErrInvalidInterval, interval)
+	}
+
+	pipe, err := s.candleAggregator.Subscribe(exch.GetName(), p, a, interval)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if pipeErr := pipe.Release(); pipeErr != nil {
+			log.Errorln(log.DispatchMgr, pipeErr)
+		}
+	}()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case data, ok := <-pipe.Channel():
+			if !ok {
+				return errDispatchSystem
+			}
+			c, ok := data.(*LiveCandle)
+			if !ok {
+				return common.GetTypeAssertError("*engine.LiveCandle", data)
+			}
+			if err := stream.Send(&gctrpc.CandleStreamResponse{
+				Exchange: c.Exchange,
+				Pair: &gctrpc.CurrencyPair{
+					Base:      c.Pair.Base.String(),
+					Quote:     c.Pair.Quote.String(),
+					Delimiter: c.Pair.Delimiter,
+				},
+				Asset:    c.Asset.String(),
+				Interval: c.Interval.Short(),
+				Candle: &gctrpc.Candle{
+					Time:      c.Time.UTC().Format(common.SimpleTimeFormatWithTimezone),
+					Low:       c.Low,
+					High:      c.High,
+					Open:      c.Open,
+					Close:     c.Close,
+					Volume:    c.Volume,
+					IsPartial: !c.Closed,
+				},
+			}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// GetRateLimitUsage returns the rate limit usage last reported by an exchange
+// in its response headers and any time requests are being held until
+func (s *RPCServer) GetRateLimitUsage(_ context.Context, r *gctrpc.GetRateLimitUsageRequest) (*gctrpc.GetRateLimitUsageResponse, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w GetRateLimitUsageRequest", common.ErrNilPointer)
+	}
+	exch, err := s.GetExchangeByName(r.Exchange)
+	if err != nil {
+		return nil, err
+	}
+	b := exch.GetBase()
+	usage := b.GetRateLimitUsage()
+	resp := &gctrpc.GetRateLimitUsageResponse{
+		Usage: make([]*gctrpc.RateLimitUsage, len(usage)),
+	}
+	for i := range usage {
+		resp.Usage[i] = &gctrpc.RateLimitUsage{
+			Name:      usage[i].Name,
+			Used:      usage[i].Used,
+			Limit:     usage[i].Limit,
+			Interval:  int64(usage[i].Interval),
+			UpdatedAt: timestamppb.New(usage[i].UpdatedAt),
+		}
+		if !usage[i].ResetAt.IsZero() {
+			resp.Usage[i].ResetAt = timestamppb.New(usage[i].ResetAt)
+		}
+	}
+	if until := b.GetRateLimitThrottle(); !until.IsZero() {
+		resp.ThrottledUntil = timestamppb.New(until)
+	}
+	return resp, nil
+}
+
+// GetMicrostructureStream streams the market microstructure signals derived
+// from each orderbook update for the requested exchange, pair and asset
+func (s *RPCServer) GetMicrostructureStream(r *gctrpc.GetMicrostructureStreamRequest, stream gctrpc.GoCryptoTraderService_GetMicrostructureStreamServer) error {
+	if r.Exchange == "" {
+		return common.ErrExchangeNameNotSet
+	}
+	if r.Pair == nil {
+		return errCurrencyPairUnset
+	}
+	exch, err := s.GetExchangeByName(r.Exchange)
+	if err != nil {
+		return err
+	}
+	a, err := asset.New(r.AssetType)
+	if err != nil {
+		return err
+	}
+	p, err := currency.NewPairFromStrings(r.Pair.Base, r.Pair.Quote)
+	if err != nil {
+		return err
+	}
+	if err := checkParams(r.Exchange, exch, a, p); err != nil {
+		return err
+	}
+
+	pipe, err := s.microstructureManager.Subscribe(exch.GetName(), p, a)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if pipeErr := pipe.Release(); pipeErr != nil {
+			log.Errorln(log.DispatchMgr, pipeErr)
+		}
+	}()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case data, ok := <-pipe.Channel():
+			if !ok {
+				return errDispatchSystem
+			}
+			m, ok := data.(*MicrostructureSignals)
+			if !ok {
+				return common.GetTypeAssertError("*engine.MicrostructureSignals", data)
+			}
+			depth := make([]*gctrpc.DepthAtBasisPoints, len(m.Depth))
+			for i := range m.Depth {
+				depth[i] = &gctrpc.DepthAtBasisPoints{
+					BasisPoints: m.Depth[i].BasisPoints,
+					BidAmount:   m.Depth[i].BidAmount,
+					AskAmount:   m.Depth[i].AskAmount,
+				}
+			}
+			if err := stream.Send(&gctrpc.MicrostructureResponse{
+				Exchange: m.Exchange,
+				Pair: &gctrpc.CurrencyPair{
+					Base:      m.Pair.Base.String(),
+					Quote:     m.Pair.Quote.String(),
+					Delimiter: m.Pair.Delimiter,
+				},
+				Asset:                        m.Asset.String(),
+				UpdateTime:                   timestamppb.New(m.UpdateTime),
+				MidPrice:                     m.MidPrice,
+				Microprice:                   m.Microprice,
+				OrderFlowImbalance:           m.OrderFlowImbalance,
+				CumulativeOrderFlowImbalance: m.CumulativeOrderFlowImbalance,
+				Depth:                        depth,
+				ResilienceMicros:             m.Resilience.Microseconds(),
+				Recovering:                   m.Recovering,
+				RealisedSpreadBps:            m.RealisedSpread,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// ReloadConfig reads the config file and applies its changes to the running
+// engine, returning the exchanges and subsystems which were updated
+func (s *RPCServer) ReloadConfig(ctx context.Context, _ *gctrpc.ReloadConfigRequest) (*gctrpc.ReloadConfigResponse, error) {
+	result, err := s.Engine.ReloadConfig(ctx)
+	if result == nil {
+		return nil, err
+	}
+	return &gctrpc.ReloadConfigResponse{
+		Exchanges:  result.Exchanges,
+		Subsystems: result.Subsystems,
+		Ignored:    result.Ignored,
+	}, err
+}
+
+// GetTreasuryTransfers returns the transfers made by the treasury manager
+func (s *RPCServer) GetTreasuryTransfers(_ context.Context, _ *gctrpc.GetTreasuryTransfersRequest) (*gctrpc.TreasuryTransfersResponse, error) {
+	transfers, err := s.treasuryManager.GetTransfers()
+	if err != nil {
+		return nil, err
+	}
+	return treasuryTransfersResp(transfers), nil
+}
+
+// RebalanceTreasury submits the transfers needed to bring each treasury
+// currency back within its target allocations, or only plans them when
+// PlanOnly is set
+func (s *RPCServer) RebalanceTreasury(ctx context.Context, r *gctrpc.RebalanceTreasuryRequest) (*gctrpc.TreasuryTransfersResponse, error) {
+	var transfers []TreasuryTransfer
+	var err error
+	if r.PlanOnly {
+		transfers, err = s.treasuryManager.Plan(ctx)
+	} else {
+		transfers, err = s.treasuryManager.Rebalance(ctx)
+	}
+	if transfers == nil && err != nil {
+		return nil, err
+	}
+	return treasuryTransfersResp(transfers), err
+}
+
+// GetFundingTransfers returns the deposits and withdrawals followed by the
+// funding tracker along with their changes in state
+func (s *RPCServer) GetFundingTransfers(_ context.Context, r *gctrpc.GetFundingTransfersRequest) (*gctrpc.GetFundingTransfersResponse, error) {
+	transfers, err := s.fundingTracker.GetTransfers(r.Exchange, r.ActiveOnly)
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetFundingTransfersResponse{
+		Transfers: make([]*gctrpc.FundingTransfer, len(transfers)),
+	}
+	for i := range transfers {
+		t := &transfers[i]
+		resp.Transfers[i] = &gctrpc.FundingTransfer{
+			Exchange:    t.Exchange,
+			Id:          t.ID,
+			Type:        t.Type,
+			Currency:    t.Currency.String(),
+			Amount:      t.Amount,
+			Fee:         t.Fee,
+			Chain:       t.Chain,
+			Address:     t.Address,
+			TxId:        t.TxID,
+			Status:      t.Status,
+			State:       t.State,
+			Stuck:       t.Stuck,
+			FirstSeen:   timestamppb.New(t.FirstSeen),
+			UpdatedAt:   timestamppb.New(t.UpdatedAt),
+			Transitions: make([]*gctrpc.FundingTransition, len(t.Transitions)),
+		}
+		if !t.Timestamp.IsZero() {
+			resp.Transfers[i].Timestamp = timestamppb.New(t.Timestamp)
+		}
+		for j := range t.Transitions {
+			resp.Transfers[i].Transitions[j] = &gctrpc.FundingTransition{
+				From:   t.Transitions[j].From,
+				To:     t.Transitions[j].To,
+				Status: t.Transitions[j].Status,
+				Time:   timestamppb.New(t.Transitions[j].Time),
+			}
+		}
+	}
+	return resp, nil
+}
+
+func treasuryTransfersResp(transfers []TreasuryTransfer) *gctrpc.TreasuryTransfersResponse {
+	resp := &gctrpc.TreasuryTransfersResponse{
+		Transfers: make([]*gctrpc.TreasuryTransfer, len(transfers)),
+	}
+	for i := range transfers {
+		t := &transfers[i]
+		resp.Transfers[i] = &gctrpc.TreasuryTransfer{
+			Currency:         t.Currency.String(),
+			From:             t.From,
+			To:               t.To,
+			Chain:            t.Chain,
+			Address:          t.Address,
+			Amount:           t.Amount,
+			Fee:              t.Fee,
+			Status:           t.Status,
+			WithdrawalStatus: t.WithdrawalStatus,
+			Error:            t.Error,
+		}
+		if !t.ID.IsNil() {
+			resp.Transfers[i].Id = t.ID.String()
+		}
+		if !t.SubmittedAt.IsZero() {
+			resp.Transfers[i].SubmittedAt = timestamppb.New(t.SubmittedAt)
+		}
+		if !t.SettledAt.IsZero() {
+			resp.Transfers[i].SettledAt = timestamppb.New(t.SettledAt)
+		}
+	}
+	return resp
+}
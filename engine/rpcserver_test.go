@@ -28,6 +28,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common/key"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/currency/crossrate"
 	"github.com/thrasher-corp/gocryptotrader/database"
 	"github.com/thrasher-corp/gocryptotrader/database/drivers"
 	"github.com/thrasher-corp/gocryptotrader/database/repository"
@@ -46,6 +47,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
 	"github.com/thrasher-corp/gocryptotrader/gctrpc"
@@ -1239,6 +1241,194 @@ func TestGetAccountBalances(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestAccountBalanceResp(t *testing.T) {
+	t.Parallel()
+	subAcct := accounts.NewSubAccount(asset.Spot, "42")
+	subAcct.Balances.Set(currency.USDT, accounts.Balance{Total: 10})
+	subAcct.Balances.Set(currency.USDC, accounts.Balance{Total: 5})
+
+	resp := accountBalanceResp(fakeExchangeName, accounts.SubAccounts{subAcct}, currency.EMPTYCODE)
+	require.Len(t, resp.Accounts, 1, "accountBalanceResp must return the sub account")
+	assert.Empty(t, resp.ValuationCurrency, "accountBalanceResp should not set a valuation currency when empty")
+	assert.Zero(t, resp.TotalValue, "accountBalanceResp should not value balances without a valuation currency")
+
+	resp = accountBalanceResp(fakeExchangeName, accounts.SubAccounts{subAcct}, currency.USD)
+	require.Len(t, resp.Accounts, 1, "accountBalanceResp must return the sub account")
+	require.Len(t, resp.Accounts[0].Currencies, 2, "accountBalanceResp must return each balance")
+	assert.Equal(t, currency.USD.String(), resp.ValuationCurrency, "accountBalanceResp should set the valuation currency")
+	assert.Equal(t, 15.0, resp.TotalValue, "accountBalanceResp should total the valued balances")
+	for _, c := range resp.Accounts[0].Currencies {
+		assert.Equal(t, c.TotalValue, c.Value, "Stablecoin balances should be valued at their USD peg")
+		require.Len(t, c.ConversionPath, 1, "accountBalanceResp must return the conversion path")
+		assert.Equal(t, crossrate.PegSource, c.ConversionPath[0].Source, "accountBalanceResp should return the hop source")
+	}
+}
+
+func TestConversionPathResp(t *testing.T) {
+	t.Parallel()
+	assert.Nil(t, conversionPathResp(nil), "conversionPathResp should return nil without a path")
+
+	updated := time.Now().Add(-time.Minute)
+	hops := conversionPathResp(&crossrate.Path{
+		From: currency.ETH,
+		To:   currency.USD,
+		Rate: 2000,
+		Hops: []crossrate.Hop{
+			{From: currency.ETH, To: currency.USDT, Rate: 2000, Source: "binance", Pair: currency.NewPair(currency.ETH, currency.USDT), Asset: asset.Spot, Updated: updated, Staleness: time.Minute},
+			{From: currency.USDT, To: currency.USD, Rate: 1, Source: crossrate.PegSource},
+		},
+	})
+	require.Len(t, hops, 2, "conversionPathResp must return every hop")
+	assert.Equal(t, "binance", hops[0].Source, "conversionPathResp should return the hop source")
+	assert.Equal(t, "ETHUSDT", hops[0].Pair, "conversionPathResp should return the hop pair")
+	assert.Equal(t, asset.Spot.String(), hops[0].Asset, "conversionPathResp should return the hop asset")
+	assert.Equal(t, time.Minute.String(), hops[0].Staleness, "conversionPathResp should return the hop staleness")
+	assert.True(t, hops[0].UpdatedAt.AsTime().Equal(updated), "conversionPathResp should return when the hop was updated")
+	assert.Empty(t, hops[1].Pair, "conversionPathResp should not set a pair for a peg")
+	assert.Empty(t, hops[1].Staleness, "conversionPathResp should not set a staleness when the update time is unknown")
+	assert.Nil(t, hops[1].UpdatedAt, "conversionPathResp should not set an update time when unknown")
+}
+
+func TestValuationCurrency(t *testing.T) {
+	t.Parallel()
+	s := RPCServer{Engine: &Engine{}}
+	assert.Equal(t, currency.EMPTYCODE, s.valuationCurrency(""), "valuationCurrency should return an empty code without a config")
+	assert.Equal(t, currency.EUR, s.valuationCurrency("EUR"), "valuationCurrency should return the requested currency")
+	s.Config = &config.Config{Currency: currency.Config{FiatDisplayCurrency: currency.AUD}}
+	assert.Equal(t, currency.AUD, s.valuationCurrency(""), "valuationCurrency should default to the fiat display currency")
+}
+
+func TestWithdrawalAddressBookRPC(t *testing.T) {
+	t.Parallel()
+	s := RPCServer{Engine: &Engine{Config: &config.Config{}}}
+	_, err := s.AddWithdrawalAddress(t.Context(), &gctrpc.AddWithdrawalAddressRequest{Currency: "BTC", Address: "1337"})
+	assert.ErrorIs(t, err, database.ErrDatabaseSupportDisabled)
+	_, err = s.RemoveWithdrawalAddress(t.Context(), &gctrpc.RemoveWithdrawalAddressRequest{Id: "1337"})
+	assert.ErrorIs(t, err, database.ErrDatabaseSupportDisabled)
+	_, err = s.GetWithdrawalAddresses(t.Context(), &gctrpc.GetWithdrawalAddressesRequest{})
+	assert.ErrorIs(t, err, database.ErrDatabaseSupportDisabled)
+}
+
+func TestPendingWithdrawalsRPC(t *testing.T) {
+	t.Parallel()
+	em, _ := accessControlTestExchange(t, accounts.WithdrawAccess)
+	m, err := SetupWithdrawManager(em, nil, true)
+	require.NoError(t, err, "SetupWithdrawManager must not error")
+	require.NoError(t, m.UpdateConfig(&config.WithdrawManager{
+		RequiredApprovals: 1,
+		ApprovalTimeout:   time.Hour,
+		Approvers:         []config.WithdrawApprover{{Name: "alice", Token: "a"}, {Name: "bob", Token: "b"}},
+	}), "UpdateConfig must not error")
+	cfg := &config.Config{Exchanges: []config.Exchange{{Name: testExchange}}}
+	s := RPCServer{Engine: &Engine{Config: cfg, ExchangeManager: em, WithdrawManager: m}}
+
+	req := &gctrpc.WithdrawCryptoRequest{
+		Exchange: testExchange,
+		Currency: "BTC",
+		Amount:   1,
+		Address:  "1337",
+		Chain:    "bitcoin",
+	}
+	_, err = s.WithdrawCryptocurrencyFunds(t.Context(), req)
+	assert.ErrorIs(t, err, errRequestedByNotSet, "WithdrawCryptocurrencyFunds should require the requester")
+	req.RequestedBy = "mallory"
+	_, err = s.WithdrawCryptocurrencyFunds(t.Context(), req)
+	assert.ErrorIs(t, err, errApproverNotAllowed, "WithdrawCryptocurrencyFunds should require the requester to be a configured approver")
+	req.RequestedBy, req.RequestedByToken = "bob", "a"
+	_, err = s.WithdrawCryptocurrencyFunds(t.Context(), req)
+	assert.ErrorIs(t, err, errInvalidApproverToken, "WithdrawCryptocurrencyFunds should not let a requester name another approver")
+	_, err = s.WithdrawFiatFunds(t.Context(), &gctrpc.WithdrawFiatRequest{Exchange: testExchange, Currency: "AUD", Amount: 1, BankAccountId: "test-bank-01", RequestedBy: "bob", RequestedByToken: "a"})
+	assert.ErrorIs(t, err, errInvalidApproverToken, "WithdrawFiatFunds should not let a requester name another approver")
+
+	req.RequestedBy, req.RequestedByToken = "alice", "a"
+	resp, err := s.WithdrawCryptocurrencyFunds(t.Context(), req)
+	require.NoError(t, err, "WithdrawCryptocurrencyFunds must not error")
+	assert.Equal(t, WithdrawStatusPendingApproval, resp.Status, "WithdrawCryptocurrencyFunds should hold the withdrawal for approval")
+
+	pending, err := s.GetPendingWithdrawals(t.Context(), &gctrpc.GetPendingWithdrawalsRequest{})
+	require.NoError(t, err, "GetPendingWithdrawals must not error")
+	require.Len(t, pending.Withdrawals, 1, "GetPendingWithdrawals must return the held withdrawal")
+	w := pending.Withdrawals[0]
+	assert.Equal(t, resp.Id, w.Id, "GetPendingWithdrawals should return the withdrawal ID")
+	assert.Equal(t, "alice", w.RequestedBy, "GetPendingWithdrawals should return the requester")
+	assert.Equal(t, int64(1), w.RequiredApprovals, "GetPendingWithdrawals should return the required approvals")
+	require.NotNil(t, w.Request.Crypto, "GetPendingWithdrawals must return the crypto destination")
+	assert.Equal(t, "bitcoin", w.Request.Crypto.Chain, "GetPendingWithdrawals should return the chain")
+
+	_, err = s.ApproveWithdrawal(t.Context(), &gctrpc.ApproveWithdrawalRequest{Id: resp.Id, Approver: "alice", ApproverToken: "a"})
+	assert.ErrorIs(t, err, errDuplicateApprover)
+	_, err = s.ApproveWithdrawal(t.Context(), &gctrpc.ApproveWithdrawalRequest{Id: resp.Id, Approver: "bob"})
+	assert.ErrorIs(t, err, errInvalidApproverToken, "ApproveWithdrawal should require the approver's token")
+	_, err = s.RejectWithdrawal(t.Context(), &gctrpc.RejectWithdrawalRequest{Id: resp.Id, Approver: "bob", ApproverToken: "a"})
+	assert.ErrorIs(t, err, errInvalidApproverToken, "RejectWithdrawal should require the approver's token")
+	approved, err := s.ApproveWithdrawal(t.Context(), &gctrpc.ApproveWithdrawalRequest{Id: resp.Id, Approver: "bob", ApproverToken: "b"})
+	require.NoError(t, err, "ApproveWithdrawal must not error")
+	assert.Equal(t, "dryrun", approved.Status, "ApproveWithdrawal should submit the approved withdrawal")
+
+	_, err = s.RejectWithdrawal(t.Context(), &gctrpc.RejectWithdrawalRequest{Id: resp.Id, Approver: "bob", ApproverToken: "b"})
+	assert.ErrorIs(t, err, ErrPendingWithdrawalNotFound)
+}
+
+func TestTreasuryRPC(t *testing.T) {
+	t.Parallel()
+	s := RPCServer{Engine: &Engine{}}
+	_, err := s.GetTreasuryTransfers(t.Context(), &gctrpc.GetTreasuryTransfersRequest{})
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+	_, err = s.RebalanceTreasury(t.Context(), &gctrpc.RebalanceTreasuryRequest{})
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+
+	em := treasuryTestExchangeManager{
+		"alpha": {name: "alpha", balance: 9},
+		"beta":  {name: "beta", balance: 1},
+	}
+	s.treasuryManager, err = SetupTreasuryManager(treasuryTestConfig(), em, &treasuryTestWithdrawManager{})
+	require.NoError(t, err, "SetupTreasuryManager must not error")
+	require.NoError(t, s.treasuryManager.Start(t.Context()), "Start must not error")
+	t.Cleanup(func() { assert.NoError(t, s.treasuryManager.Stop(), "Stop should not error") })
+
+	resp, err := s.RebalanceTreasury(t.Context(), &gctrpc.RebalanceTreasuryRequest{PlanOnly: true})
+	require.NoError(t, err, "RebalanceTreasury must not error")
+	require.Len(t, resp.Transfers, 1, "RebalanceTreasury must return the planned transfer")
+	assert.Empty(t, resp.Transfers[0].Id, "RebalanceTreasury should not submit planned transfers")
+	assert.Nil(t, resp.Transfers[0].SubmittedAt, "RebalanceTreasury should not submit planned transfers")
+
+	resp, err = s.RebalanceTreasury(t.Context(), &gctrpc.RebalanceTreasuryRequest{})
+	require.NoError(t, err, "RebalanceTreasury must not error")
+	require.Len(t, resp.Transfers, 1, "RebalanceTreasury must return the submitted transfer")
+	assert.Equal(t, TreasuryTransferInTransit, resp.Transfers[0].Status, "RebalanceTreasury should return the transfer status")
+	assert.NotEmpty(t, resp.Transfers[0].Id, "RebalanceTreasury should return the withdrawal ID")
+
+	resp, err = s.GetTreasuryTransfers(t.Context(), &gctrpc.GetTreasuryTransfersRequest{})
+	require.NoError(t, err, "GetTreasuryTransfers must not error")
+	require.Len(t, resp.Transfers, 1, "GetTreasuryTransfers must return the submitted transfer")
+	assert.Equal(t, "alpha", resp.Transfers[0].From, "GetTreasuryTransfers should return the source exchange")
+	assert.Equal(t, 4.0, resp.Transfers[0].Amount, "GetTreasuryTransfers should return the amount")
+}
+
+func TestGetFundingTransfers(t *testing.T) {
+	t.Parallel()
+	s := RPCServer{Engine: &Engine{}}
+	_, err := s.GetFundingTransfers(t.Context(), &gctrpc.GetFundingTransfersRequest{})
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+
+	exch := &fundingTestExchange{name: "Alpha", history: []exchange.FundingHistory{
+		{TransferID: "w1", TransferType: "withdrawal", Currency: "BTC", Amount: 1, Status: "pending", Timestamp: time.Now()},
+	}}
+	s.fundingTracker, err = SetupFundingTracker(fundingTestConfig(), &fundingTestExchangeManager{exch: exch}, &testCommsManager{})
+	require.NoError(t, err, "SetupFundingTracker must not error")
+	require.NoError(t, s.fundingTracker.Start(t.Context()), "Start must not error")
+	t.Cleanup(func() { assert.NoError(t, s.fundingTracker.Stop(), "Stop should not error") })
+	require.NoError(t, s.fundingTracker.Poll(t.Context()), "Poll must not error")
+
+	resp, err := s.GetFundingTransfers(t.Context(), &gctrpc.GetFundingTransfersRequest{Exchange: "alpha", ActiveOnly: true})
+	require.NoError(t, err, "GetFundingTransfers must not error")
+	require.Len(t, resp.Transfers, 1, "GetFundingTransfers must return the transfer")
+	assert.Equal(t, "w1", resp.Transfers[0].Id, "GetFundingTransfers should return the transfer ID")
+	assert.Equal(t, FundingStatePending, resp.Transfers[0].State, "GetFundingTransfers should return the transfer state")
+	require.Len(t, resp.Transfers[0].Transitions, 1, "GetFundingTransfers must return the transitions")
+	assert.Equal(t, FundingStatePending, resp.Transfers[0].Transitions[0].To, "GetFundingTransfers should return the transition")
+}
+
 func TestUpdateAccountBalances(t *testing.T) {
 	t.Parallel()
 	em := NewExchangeManager()
@@ -2552,6 +2742,34 @@ func TestGetTechnicalAnalysis(t *testing.T) {
 	if len(resp.Signals["RSI"].Signals) != 33 {
 		t.Fatalf("received: '%v' but expected: '%v'", len(resp.Signals["RSI"].Signals), 33)
 	}
+
+	for _, tc := range []struct {
+		req     *gctrpc.GetTechnicalAnalysisRequest
+		signals []string
+	}{
+		{&gctrpc.GetTechnicalAnalysisRequest{AlgorithmType: "stoch", Period: 9, SmoothingPeriod: 3, SignalPeriod: 3}, []string{"K", "D"}},
+		{&gctrpc.GetTechnicalAnalysisRequest{AlgorithmType: "stochrsi", Period: 9, SecondaryPeriod: 9, SmoothingPeriod: 3, SignalPeriod: 3}, []string{"K", "D"}},
+		{&gctrpc.GetTechnicalAnalysisRequest{AlgorithmType: "adx", Period: 9}, []string{"ADX", "PLUSDI", "MINUSDI"}},
+		{&gctrpc.GetTechnicalAnalysisRequest{AlgorithmType: "ichimoku", FastPeriod: 9, SlowPeriod: 26, SecondaryPeriod: 26, Displacement: 26}, []string{"CONVERSION", "BASE", "SPANA", "SPANB", "LAGGING"}},
+		{&gctrpc.GetTechnicalAnalysisRequest{AlgorithmType: "avwap", SessionInterval: int64(kline.OneWeek)}, []string{"AVWAP"}},
+		{&gctrpc.GetTechnicalAnalysisRequest{AlgorithmType: "keltner", Period: 9, SecondaryPeriod: 9, Multiplier: 2}, []string{"UPPER", "MIDDLE", "LOWER"}},
+		{&gctrpc.GetTechnicalAnalysisRequest{AlgorithmType: "donchian", Period: 9}, []string{"UPPER", "MIDDLE", "LOWER"}},
+		{&gctrpc.GetTechnicalAnalysisRequest{AlgorithmType: "supertrend", Period: 9, Multiplier: 3}, []string{"SUPERTREND", "DIRECTION"}},
+		{&gctrpc.GetTechnicalAnalysisRequest{AlgorithmType: "psar", AccelerationStep: 0.02, AccelerationMax: 0.2}, []string{"PSAR"}},
+		{&gctrpc.GetTechnicalAnalysisRequest{AlgorithmType: "cci", Period: 9}, []string{"CCI"}},
+		{&gctrpc.GetTechnicalAnalysisRequest{AlgorithmType: "willr", Period: 9}, []string{"WILLR"}},
+	} {
+		tc.req.Exchange = fakeExchangeName
+		tc.req.AssetType = "spot"
+		tc.req.Pair = &gctrpc.CurrencyPair{Base: "btc", Quote: "usd"}
+		tc.req.Interval = int64(kline.OneDay)
+		resp, err = s.GetTechnicalAnalysis(t.Context(), tc.req)
+		require.NoErrorf(t, err, "GetTechnicalAnalysis must not error for %s", tc.req.AlgorithmType)
+		for _, signal := range tc.signals {
+			require.Containsf(t, resp.Signals, signal, "%s response must contain %s signals", tc.req.AlgorithmType, signal)
+			assert.Lenf(t, resp.Signals[signal].Signals, 33, "%s %s signals should match the candle count", tc.req.AlgorithmType, signal)
+		}
+	}
 }
 
 func TestGetMarginRatesHistory(t *testing.T) {
@@ -3391,6 +3609,11 @@ func TestChangePositionMargin(t *testing.T) {
 	req.MarginType = "isolated"
 	_, err = s.ChangePositionMargin(t.Context(), req)
 	assert.NoError(t, err)
+
+	b.SetCredentials("test", "test", "test", "", "", "")
+	b.API.SetAccessControl(accounts.ReadOnlyAccess, nil)
+	_, err = s.ChangePositionMargin(t.Context(), req)
+	assert.ErrorIs(t, err, accounts.ErrAccessDenied, "ChangePositionMargin should require trade access")
 }
 
 func TestSetLeverage(t *testing.T) {
@@ -3457,6 +3680,11 @@ func TestSetLeverage(t *testing.T) {
 	req.OrderSide = order.Long.String()
 	_, err = s.SetLeverage(t.Context(), req)
 	assert.NoError(t, err)
+
+	b.SetCredentials("test", "test", "test", "", "", "")
+	b.API.SetAccessControl(accounts.ReadOnlyAccess, nil)
+	_, err = s.SetLeverage(t.Context(), req)
+	assert.ErrorIs(t, err, accounts.ErrAccessDenied, "SetLeverage should require trade access")
 }
 
 func TestGetLeverage(t *testing.T) {
@@ -3578,6 +3806,11 @@ func TestSetMarginType(t *testing.T) {
 	req.MarginType = "isolated"
 	_, err = s.SetMarginType(t.Context(), req)
 	assert.NoError(t, err)
+
+	b.SetCredentials("test", "test", "test", "", "", "")
+	b.API.SetAccessControl(accounts.ReadOnlyAccess, nil)
+	_, err = s.SetMarginType(t.Context(), req)
+	assert.ErrorIs(t, err, accounts.ErrAccessDenied, "SetMarginType should require trade access")
 }
 
 func TestSetCollateralMode(t *testing.T) {
@@ -3625,6 +3858,11 @@ func TestSetCollateralMode(t *testing.T) {
 	req.CollateralMode = "single"
 	_, err = s.SetCollateralMode(t.Context(), req)
 	assert.NoError(t, err)
+
+	b.SetCredentials("test", "test", "test", "", "", "")
+	b.API.SetAccessControl(accounts.ReadOnlyAccess, nil)
+	_, err = s.SetCollateralMode(t.Context(), req)
+	assert.ErrorIs(t, err, accounts.ErrAccessDenied, "SetCollateralMode should require trade access")
 }
 
 func TestGetCollateralMode(t *testing.T) {
@@ -3938,3 +4176,44 @@ func TestGetCurrencyTradeURL(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotEmpty(t, resp.Url)
 }
+
+func TestGetRateLimitUsage(t *testing.T) {
+	t.Parallel()
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName("binance")
+	require.NoError(t, err, "NewExchangeByName must not error")
+	exch.SetDefaults()
+	b := exch.GetBase()
+	b.Name = fakeExchangeName
+	require.NoError(t, em.Add(exch), "Add must not error")
+
+	s := RPCServer{Engine: &Engine{ExchangeManager: em}}
+	_, err = s.GetRateLimitUsage(t.Context(), nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+
+	_, err = s.GetRateLimitUsage(t.Context(), &gctrpc.GetRateLimitUsageRequest{})
+	assert.ErrorIs(t, err, common.ErrExchangeNameNotSet)
+
+	resp, err := s.GetRateLimitUsage(t.Context(), &gctrpc.GetRateLimitUsageRequest{Exchange: fakeExchangeName})
+	require.NoError(t, err, "GetRateLimitUsage must not error")
+	assert.Empty(t, resp.Usage, "usage should be empty before any requests")
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("X-MBX-USED-WEIGHT-1M", "5999")
+		_, _ = w.Write([]byte("{}"))
+	}))
+	t.Cleanup(srv.Close)
+	err = b.SendPayload(t.Context(), request.Unset, func() (*request.Item, error) {
+		return &request.Item{Method: http.MethodGet, Path: srv.URL, Result: &struct{}{}}, nil
+	}, request.UnauthenticatedRequest)
+	require.NoError(t, err, "SendPayload must not error")
+
+	resp, err = s.GetRateLimitUsage(t.Context(), &gctrpc.GetRateLimitUsageRequest{Exchange: fakeExchangeName})
+	require.NoError(t, err, "GetRateLimitUsage must not error")
+	require.Len(t, resp.Usage, 1, "must return the reported usage")
+	assert.Equal(t, "spot REQUEST_WEIGHT_1M", resp.Usage[0].Name, "name should be correct")
+	assert.Equal(t, int64(5999), resp.Usage[0].Used, "used should be correct")
+	assert.Equal(t, int64(6000), resp.Usage[0].Limit, "limit should be correct")
+	assert.Equal(t, int64(time.Minute), resp.Usage[0].Interval, "interval should be correct")
+	assert.NotNil(t, resp.ThrottledUntil, "requests should be throttled when usage exceeds the threshold")
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/portfolio"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
 )
 
 const (
@@ -63,11 +64,18 @@ type iOrderManager interface {
 
 // iPortfolioManager limits exposure of accessible functions to portfolio manager
 type iPortfolioManager interface {
-	GetPortfolioSummary() portfolio.Summary
+	GetPortfolioSummary(ctx context.Context, valuation currency.Code) portfolio.Summary
 	IsWhiteListed(string) bool
 	IsExchangeSupported(string, string) bool
 }
 
+// iWithdrawManager limits exposure of accessible functions to withdraw manager
+type iWithdrawManager interface {
+	SubmitWithdrawal(context.Context, *withdraw.Request) (*withdraw.Response, error)
+	GetPendingWithdrawals() ([]PendingWithdrawal, error)
+	WithdrawalEventByID(string) (*withdraw.Response, error)
+}
+
 // iCurrencyPairSyncer defines a limited scoped currency pair syncer
 type iCurrencyPairSyncer interface {
 	IsRunning() bool
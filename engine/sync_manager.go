@@ -14,6 +14,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common/key"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/currency/crossrate"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
@@ -57,28 +58,8 @@ func SetupSyncManager(c *config.SyncManagerConfig, exchangeManager iExchangeMana
 		return nil, errNilConfig
 	}
 
-	if c.NumWorkers <= 0 {
-		c.NumWorkers = config.DefaultSyncerWorkers
-	}
-
-	if c.TimeoutREST <= time.Duration(0) {
-		c.TimeoutREST = config.DefaultSyncerTimeoutREST
-	}
-
-	if c.TimeoutWebsocket <= time.Duration(0) {
-		c.TimeoutWebsocket = config.DefaultSyncerTimeoutWebsocket
-	}
-
-	if c.FiatDisplayCurrency.IsEmpty() {
-		return nil, fmt.Errorf("FiatDisplayCurrency %w", currency.ErrCurrencyCodeEmpty)
-	}
-
-	if !c.FiatDisplayCurrency.IsFiatCurrency() {
-		return nil, fmt.Errorf("%s %w", c.FiatDisplayCurrency, currency.ErrFiatDisplayCurrencyIsNotFiat)
-	}
-
-	if c.PairFormatDisplay == nil {
-		return nil, fmt.Errorf("%T %w", c.PairFormatDisplay, common.ErrNilPointer)
+	if err := checkSyncManagerConfig(c); err != nil {
+		return nil, err
 	}
 
 	s := &SyncManager{
@@ -108,27 +89,98 @@ func (m *SyncManager) IsRunning() bool {
 	return m != nil && m.started.Load()
 }
 
+// checkSyncManagerConfig validates the sync manager config, setting defaults
+// for unset workers and timeouts
+func checkSyncManagerConfig(c *config.SyncManagerConfig) error {
+	if c == nil {
+		return fmt.Errorf("%T %w", c, common.ErrNilPointer)
+	}
+
+	if !c.SynchronizeOrderbook && !c.SynchronizeTicker && !c.SynchronizeTrades {
+		return errNoSyncItemsEnabled
+	}
+
+	if c.NumWorkers <= 0 {
+		c.NumWorkers = config.DefaultSyncerWorkers
+	}
+
+	if c.TimeoutREST <= time.Duration(0) {
+		c.TimeoutREST = config.DefaultSyncerTimeoutREST
+	}
+
+	if c.TimeoutWebsocket <= time.Duration(0) {
+		c.TimeoutWebsocket = config.DefaultSyncerTimeoutWebsocket
+	}
+
+	if c.FiatDisplayCurrency.IsEmpty() {
+		return fmt.Errorf("FiatDisplayCurrency %w", currency.ErrCurrencyCodeEmpty)
+	}
+
+	if !c.FiatDisplayCurrency.IsFiatCurrency() {
+		return fmt.Errorf("%s %w", c.FiatDisplayCurrency, currency.ErrFiatDisplayCurrencyIsNotFiat)
+	}
+
+	if c.PairFormatDisplay == nil {
+		return fmt.Errorf("%T %w", c.PairFormatDisplay, common.ErrNilPointer)
+	}
+	return nil
+}
+
+// UpdateConfig replaces the config of a stopped sync manager. The config and
+// the enabled pairs of each exchange are applied when it is next started
+func (m *SyncManager) UpdateConfig(c *config.SyncManagerConfig) error {
+	if m == nil {
+		return fmt.Errorf("exchange CurrencyPairSyncer %w", ErrNilSubsystem)
+	}
+	if m.IsRunning() {
+		return fmt.Errorf("exchange CurrencyPairSyncer %w", ErrSubSystemAlreadyStarted)
+	}
+	if err := checkSyncManagerConfig(c); err != nil {
+		return err
+	}
+	m.setConfig(c)
+	return nil
+}
+
+// setConfig replaces the config of a stopped sync manager with a validated
+// config and clears the tracked currency pairs
+func (m *SyncManager) setConfig(c *config.SyncManagerConfig) {
+	m.mux.Lock()
+	m.config = *c
+	m.fiatDisplayCurrency = c.FiatDisplayCurrency
+	m.format = *c.PairFormatDisplay
+	m.currencyPairs = make(map[key.ExchangeAssetPair]*currencyPairSyncAgent)
+	m.mux.Unlock()
+}
+
 // Start runs the subsystem
 func (m *SyncManager) Start(ctx context.Context) error {
 	if m == nil {
 		return fmt.Errorf("exchange CurrencyPairSyncer %w", ErrNilSubsystem)
 	}
+	m.mux.Lock()
+	cfg := m.config
+	m.mux.Unlock()
+	if !cfg.SynchronizeTicker &&
+		!cfg.SynchronizeOrderbook &&
+		!cfg.SynchronizeTrades {
+		return errNoSyncItemsEnabled
+	}
 	if !m.started.CompareAndSwap(false, true) {
 		return ErrSubSystemAlreadyStarted
 	}
-	if !m.config.SynchronizeTicker &&
-		!m.config.SynchronizeOrderbook &&
-		!m.config.SynchronizeTrades {
-		return errNoSyncItemsEnabled
-	}
 	m.shutdown = make(chan bool)
-	m.initSyncWG.Add(1)
 	m.inService.Done()
 	log.Debugln(log.SyncMgr, "Exchange CurrencyPairSyncer started.")
+	if m.initSyncCompleted.Load() && !cfg.SynchronizeContinuously {
+		log.Debugln(log.SyncMgr, "Exchange CurrencyPairSyncer initial sync already completed, nothing to synchronise.")
+		return nil
+	}
 	exchanges, err := m.exchangeManager.GetExchanges()
 	if err != nil {
 		return err
 	}
+	m.initSyncWG.Add(1)
 	for x := range exchanges {
 		exchangeName := exchanges[x].GetName()
 		supportsWebsocket := exchanges[x].SupportsWebsocket()
@@ -193,7 +245,7 @@ func (m *SyncManager) Start(ctx context.Context) error {
 	}
 
 	if m.initSyncStarted.CompareAndSwap(false, true) {
-		if m.config.LogInitialSyncEvents {
+		if cfg.LogInitialSyncEvents {
 			log.Debugf(log.SyncMgr,
 				"Exchange CurrencyPairSyncer initial sync started. %d items to process.",
 				createdCounter.Load())
@@ -204,13 +256,13 @@ func (m *SyncManager) Start(ctx context.Context) error {
 	go func() {
 		m.initSyncWG.Wait()
 		if m.initSyncCompleted.CompareAndSwap(false, true) {
-			if m.config.LogInitialSyncEvents {
+			if cfg.LogInitialSyncEvents {
 				log.Debugf(log.SyncMgr, "Exchange CurrencyPairSyncer initial sync is complete.")
 				log.Debugf(log.SyncMgr, "Exchange CurrencyPairSyncer initial sync took %v [%v sync items].",
 					time.Since(m.initSyncStartTime), createdCounter.Load())
 			}
 
-			if !m.config.SynchronizeContinuously {
+			if !cfg.SynchronizeContinuously {
 				log.Debugln(log.SyncMgr, "Exchange CurrencyPairSyncer stopping.")
 				err := m.Stop()
 				if err != nil {
@@ -221,12 +273,9 @@ func (m *SyncManager) Start(ctx context.Context) error {
 		}
 	}()
 
-	if m.initSyncCompleted.Load() && !m.config.SynchronizeContinuously {
-		return nil
-	}
-
-	for range m.config.NumWorkers {
-		go m.worker(ctx)
+	for range cfg.NumWorkers {
+		m.workerWG.Add(1)
+		go m.worker(ctx, m.shutdown)
 	}
 	m.initSyncWG.Done()
 	return nil
@@ -241,6 +290,7 @@ func (m *SyncManager) Stop() error {
 		return fmt.Errorf("exchange CurrencyPairSyncer %w", ErrSubSystemNotStarted)
 	}
 	close(m.shutdown)
+	m.workerWG.Wait()
 	m.inService.Add(1)
 	log.Debugln(log.SyncMgr, "Exchange CurrencyPairSyncer stopped.")
 	return nil
@@ -253,6 +303,30 @@ func (m *SyncManager) get(k key.ExchangeAssetPair) *currencyPairSyncAgent {
 	return m.currencyPairs[k]
 }
 
+// orderbookStaleness returns the time since each synced orderbook was last
+// updated. Trackers currently held by a sync routine or without data are
+// skipped so a metrics scrape never blocks syncing
+func (m *SyncManager) orderbookStaleness() map[key.ExchangeAssetPair]time.Duration {
+	m.mux.Lock()
+	agents := make([]*currencyPairSyncAgent, 0, len(m.currencyPairs))
+	for _, c := range m.currencyPairs {
+		agents = append(agents, c)
+	}
+	m.mux.Unlock()
+
+	staleness := make(map[key.ExchangeAssetPair]time.Duration, len(agents))
+	for _, c := range agents {
+		if !c.locks[SyncItemOrderbook].TryLock() {
+			continue
+		}
+		if s := c.trackers[SyncItemOrderbook]; s != nil && s.HaveData {
+			staleness[c.Key] = time.Since(s.LastUpdated)
+		}
+		c.locks[SyncItemOrderbook].Unlock()
+	}
+	return staleness
+}
+
 func newCurrencyPairSyncAgent(k key.ExchangeAssetPair) *currencyPairSyncAgent {
 	return &currencyPairSyncAgent{
 		Key:      k,
@@ -435,7 +509,8 @@ func (m *SyncManager) update(c *currencyPairSyncAgent, syncType syncItemType, er
 	return nil
 }
 
-func (m *SyncManager) worker(ctx context.Context) {
+func (m *SyncManager) worker(ctx context.Context, shutdown <-chan bool) {
+	defer m.workerWG.Done()
 	cleanup := func() {
 		log.Debugln(log.SyncMgr,
 			"Exchange CurrencyPairSyncer worker shutting down.")
@@ -447,7 +522,7 @@ func (m *SyncManager) worker(ctx context.Context) {
 
 	for {
 		select {
-		case <-m.shutdown:
+		case <-shutdown:
 			return
 		case <-t.C:
 			exchanges, err := m.exchangeManager.GetExchanges()
@@ -608,6 +683,25 @@ func (m *SyncManager) syncOrderbook(ctx context.Context, c *currencyPairSyncAgen
 
 	s := c.trackers[SyncItemOrderbook]
 
+	// An invalidated websocket book has a gap which further updates cannot fill, so fetch a REST snapshot straight
+	// away rather than waiting for the websocket timeout
+	var fetchNow bool
+	if s.IsUsingWebsocket && e.SupportsREST() {
+		if d, err := orderbook.GetDepth(c.Key.Exchange, c.Pair, c.Key.Asset); err == nil && !d.IsValid() {
+			s.IsUsingWebsocket = false
+			s.IsUsingREST = true
+			fetchNow = true
+			if m.config.LogSwitchProtocolEvents {
+				log.Warnf(log.SyncMgr,
+					"%s %s %s: Websocket orderbook invalidated, switching from websocket to rest",
+					c.Key.Exchange,
+					m.FormatCurrency(c.Pair),
+					strings.ToUpper(c.Key.Asset.String()),
+				)
+			}
+		}
+	}
+
 	if s.IsUsingWebsocket &&
 		e.SupportsREST() &&
 		time.Since(s.LastUpdated) > m.config.TimeoutWebsocket &&
@@ -632,7 +726,7 @@ func (m *SyncManager) syncOrderbook(ctx context.Context, c *currencyPairSyncAgen
 		}
 	}
 
-	if s.IsUsingREST && time.Since(s.LastUpdated) > m.config.TimeoutREST {
+	if s.IsUsingREST && (fetchNow || time.Since(s.LastUpdated) > m.config.TimeoutREST) {
 		result, err := e.UpdateOrderbook(ctx,
 			c.Pair,
 			c.Key.Asset)
@@ -667,16 +761,13 @@ func printCurrencyFormat(price float64, displayCurrency currency.Code) string {
 	return fmt.Sprintf("%s%.8f", displaySymbol, price)
 }
 
-func printConvertCurrencyFormat(origPrice float64, origCurrency, displayCurrency currency.Code) string {
-	var conv string
+// printConvertCurrencyFormat formats a price alongside its value in the
+// display currency, converted using the rate of the path
+func printConvertCurrencyFormat(origPrice float64, path *crossrate.Path) string {
+	origCurrency, displayCurrency := path.From, path.To
+	conv := "0.00"
 	if origPrice > 0 {
-		if convFloat, err := currency.ConvertFiat(origPrice, origCurrency, displayCurrency); err != nil {
-			conv = "?.??"
-		} else {
-			conv = fmt.Sprintf("%.2f", convFloat)
-		}
-	} else {
-		conv = "0.00"
+		conv = fmt.Sprintf("%.2f", origPrice*path.Rate)
 	}
 
 	displaySymbol, err := currency.GetSymbolByCurrencyName(displayCurrency)
@@ -684,8 +775,9 @@ func printConvertCurrencyFormat(origPrice float64, origCurrency, displayCurrency
 		log.Errorf(log.SyncMgr, "Failed to get display symbol: %s", err)
 	}
 
+	// Cryptocurrencies have no symbol so only a missing fiat symbol is logged
 	origSymbol, err := currency.GetSymbolByCurrencyName(origCurrency)
-	if err != nil {
+	if err != nil && origCurrency.IsFiatCurrency() {
 		log.Errorf(log.SyncMgr, "Failed to get original currency symbol for %s: %s",
 			origCurrency,
 			err)
@@ -701,6 +793,20 @@ func printConvertCurrencyFormat(origPrice float64, origCurrency, displayCurrency
 	)
 }
 
+// displayPath returns the path converting prices quoted in the currency to the
+// display currency through exchange tickers or forex rates, or nil when there
+// is none
+func (m *SyncManager) displayPath(quote currency.Code) *crossrate.Path {
+	if m.fiatDisplayCurrency.IsEmpty() || quote.Equal(m.fiatDisplayCurrency) {
+		return nil
+	}
+	path, err := crossrate.FindPath(quote.Upper(), m.fiatDisplayCurrency)
+	if err != nil {
+		return nil
+	}
+	return path
+}
+
 // PrintTickerSummary outputs the ticker results
 func (m *SyncManager) PrintTickerSummary(result *ticker.Price, protocol string, err error) {
 	if m == nil || !m.started.Load() {
@@ -728,21 +834,17 @@ func (m *SyncManager) PrintTickerSummary(result *ticker.Price, protocol string,
 		return
 	}
 
-	if currency.ForexEnabled() &&
-		result.Pair.Quote.IsFiatCurrency() &&
-		!result.Pair.Quote.Equal(m.fiatDisplayCurrency) &&
-		!m.fiatDisplayCurrency.IsEmpty() {
-		origCurrency := result.Pair.Quote.Upper()
+	if path := m.displayPath(result.Pair.Quote); path != nil {
 		log.Infof(log.SyncMgr, "%s %s %s %s TICKER: Last %s Ask %s Bid %s High %s Low %s Volume %.8f",
 			result.ExchangeName,
 			protocol,
 			m.FormatCurrency(result.Pair),
 			strings.ToUpper(result.AssetType.String()),
-			printConvertCurrencyFormat(result.Last, origCurrency, m.fiatDisplayCurrency),
-			printConvertCurrencyFormat(result.Ask, origCurrency, m.fiatDisplayCurrency),
-			printConvertCurrencyFormat(result.Bid, origCurrency, m.fiatDisplayCurrency),
-			printConvertCurrencyFormat(result.High, origCurrency, m.fiatDisplayCurrency),
-			printConvertCurrencyFormat(result.Low, origCurrency, m.fiatDisplayCurrency),
+			printConvertCurrencyFormat(result.Last, path),
+			printConvertCurrencyFormat(result.Ask, path),
+			printConvertCurrencyFormat(result.Bid, path),
+			printConvertCurrencyFormat(result.High, path),
+			printConvertCurrencyFormat(result.Low, path),
 			result.Volume)
 	} else {
 		if result.Pair.Quote.IsFiatCurrency() &&
@@ -827,14 +929,14 @@ func (m *SyncManager) PrintOrderbookSummary(result *orderbook.Book, protocol str
 	asksAmount, asksValue := result.TotalAsksAmount()
 
 	var bidValueResult, askValueResult string
+	path := m.displayPath(result.Pair.Quote)
 	switch {
-	case currency.ForexEnabled() && result.Pair.Quote.IsFiatCurrency() && !result.Pair.Quote.Equal(m.fiatDisplayCurrency) && !m.fiatDisplayCurrency.IsEmpty():
-		origCurrency := result.Pair.Quote.Upper()
+	case path != nil:
 		if bidsValue > 0 {
-			bidValueResult = printConvertCurrencyFormat(bidsValue, origCurrency, m.fiatDisplayCurrency)
+			bidValueResult = printConvertCurrencyFormat(bidsValue, path)
 		}
 		if asksValue > 0 {
-			askValueResult = printConvertCurrencyFormat(asksValue, origCurrency, m.fiatDisplayCurrency)
+			askValueResult = printConvertCurrencyFormat(asksValue, path)
 		}
 	case result.Pair.Quote.IsFiatCurrency() && result.Pair.Quote.Equal(m.fiatDisplayCurrency) && !m.fiatDisplayCurrency.IsEmpty():
 		bidValueResult = printCurrencyFormat(bidsValue, m.fiatDisplayCurrency)
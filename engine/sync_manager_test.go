@@ -13,6 +13,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common/key"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/currency/crossrate"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
@@ -35,6 +36,20 @@ func (s syncTickerContextExchange) UpdateTicker(ctx context.Context, pair curren
 	return nil, nil
 }
 
+type syncOrderbookExchange struct {
+	exchange.IBotExchange
+	updates int
+}
+
+func (s *syncOrderbookExchange) SupportsREST() bool {
+	return true
+}
+
+func (s *syncOrderbookExchange) UpdateOrderbook(context.Context, currency.Pair, asset.Item) (*orderbook.Book, error) {
+	s.updates++
+	return nil, nil
+}
+
 func TestSetupSyncManager(t *testing.T) {
 	t.Parallel()
 	_, err := SetupSyncManager(nil, nil, nil, false)
@@ -121,6 +136,77 @@ func TestSyncManagerStop(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestSyncManagerRestart(t *testing.T) {
+	t.Parallel()
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName("Bitstamp")
+	require.NoError(t, err, "NewExchangeByName must not error")
+	exch.SetDefaults()
+	require.NoError(t, em.Add(exch), "Add must not error")
+
+	m, err := SetupSyncManager(&config.SyncManagerConfig{SynchronizeTrades: true, SynchronizeContinuously: true, NumWorkers: 2, FiatDisplayCurrency: currency.USD, PairFormatDisplay: &currency.EMPTYFORMAT}, em, &config.RemoteControlConfig{}, false)
+	require.NoError(t, err, "SetupSyncManager must not error")
+
+	require.NoError(t, m.Start(t.Context()), "Start must not error")
+	oldShutdown := m.shutdown
+	require.NoError(t, m.Stop(), "Stop must not error")
+	assert.True(t, waitGroupDone(&m.workerWG), "Stop should wait for all workers to exit")
+
+	err = m.UpdateConfig(&config.SyncManagerConfig{SynchronizeTicker: true, SynchronizeContinuously: true, NumWorkers: 1, FiatDisplayCurrency: currency.EUR, PairFormatDisplay: &currency.EMPTYFORMAT})
+	require.NoError(t, err, "UpdateConfig must not error")
+
+	require.NoError(t, m.Start(t.Context()), "Start must not error after restart")
+	assert.NotEqual(t, oldShutdown, m.shutdown, "Start should create a new shutdown channel")
+	assert.True(t, m.config.SynchronizeTicker, "restarted sync manager should use the updated config")
+	require.NoError(t, m.Stop(), "Stop must not error after restart")
+	assert.True(t, waitGroupDone(&m.workerWG), "Stop should wait for all restarted workers to exit")
+
+	m, err = SetupSyncManager(&config.SyncManagerConfig{SynchronizeTrades: true, FiatDisplayCurrency: currency.USD, PairFormatDisplay: &currency.EMPTYFORMAT}, em, &config.RemoteControlConfig{}, false)
+	require.NoError(t, err, "SetupSyncManager must not error")
+	m.initSyncCompleted.Store(true)
+	require.NoError(t, m.Start(t.Context()), "Start must not error when initial sync has completed")
+	assert.True(t, waitGroupDone(&m.initSyncWG), "Start should not leave the initial sync pending when there is nothing to synchronise")
+	require.NoError(t, m.WaitForInitialSync(), "WaitForInitialSync must not error")
+	require.NoError(t, m.Stop(), "Stop must not error")
+}
+
+func waitGroupDone(wg *sync.WaitGroup) bool {
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return true
+	case <-time.After(time.Second):
+		return false
+	}
+}
+
+func TestSyncManagerUpdateConfig(t *testing.T) {
+	t.Parallel()
+	var m *SyncManager
+	assert.ErrorIs(t, m.UpdateConfig(&config.SyncManagerConfig{}), ErrNilSubsystem)
+
+	m, err := SetupSyncManager(&config.SyncManagerConfig{SynchronizeTrades: true, FiatDisplayCurrency: currency.USD, PairFormatDisplay: &currency.EMPTYFORMAT}, &ExchangeManager{}, &config.RemoteControlConfig{}, true)
+	require.NoError(t, err, "SetupSyncManager must not error")
+
+	assert.ErrorIs(t, m.UpdateConfig(&config.SyncManagerConfig{}), errNoSyncItemsEnabled)
+
+	m.add(key.NewExchangeAssetPair("Bitstamp", asset.Spot, currency.NewBTCUSD()), syncBase{})
+	err = m.UpdateConfig(&config.SyncManagerConfig{SynchronizeTicker: true, FiatDisplayCurrency: currency.EUR, PairFormatDisplay: &currency.PairFormat{Delimiter: "-"}})
+	require.NoError(t, err, "UpdateConfig must not error")
+	assert.True(t, m.config.SynchronizeTicker, "SynchronizeTicker should be updated")
+	assert.Equal(t, config.DefaultSyncerWorkers, m.config.NumWorkers, "NumWorkers should be defaulted")
+	assert.Equal(t, currency.EUR, m.fiatDisplayCurrency, "fiatDisplayCurrency should be updated")
+	assert.Equal(t, "-", m.format.Delimiter, "format should be updated")
+	assert.Empty(t, m.currencyPairs, "currencyPairs should be cleared")
+
+	m.started.Store(true)
+	assert.ErrorIs(t, m.UpdateConfig(&config.SyncManagerConfig{SynchronizeTicker: true}), ErrSubSystemAlreadyStarted)
+}
+
 func TestSyncManagerSyncTickerUsesRuntimeContextCancellation(t *testing.T) {
 	em := NewExchangeManager()
 	exch, err := em.NewExchangeByName("Bitstamp")
@@ -192,10 +278,21 @@ func TestPrintCurrencyFormat(t *testing.T) {
 
 func TestPrintConvertCurrencyFormat(t *testing.T) {
 	t.Parallel()
-	c := printConvertCurrencyFormat(1337, currency.BTC, currency.USD)
-	if c == "" {
-		t.Error("expected formatted currency")
-	}
+	c := printConvertCurrencyFormat(1337, &crossrate.Path{From: currency.EUR, To: currency.USD, Rate: 1.1})
+	assert.Equal(t, "$1470.70 USD (€1337.00 EUR)", c, "printConvertCurrencyFormat should convert the price with the path rate")
+	c = printConvertCurrencyFormat(0, &crossrate.Path{From: currency.EUR, To: currency.USD, Rate: 1.1})
+	assert.Equal(t, "$0.00 USD (€0.00 EUR)", c, "printConvertCurrencyFormat should not convert a zero price")
+}
+
+func TestDisplayPath(t *testing.T) {
+	t.Parallel()
+	m := &SyncManager{}
+	assert.Nil(t, m.displayPath(currency.USDT), "displayPath should return nil without a display currency")
+	m.fiatDisplayCurrency = currency.USD
+	assert.Nil(t, m.displayPath(currency.USD), "displayPath should return nil for the display currency")
+	path := m.displayPath(currency.USDT)
+	require.NotNil(t, path, "displayPath must return a path for a pegged stablecoin")
+	assert.Equal(t, 1.0, path.Rate, "displayPath should return the rate of the path")
 }
 
 func TestPrintTickerSummary(t *testing.T) {
@@ -346,3 +443,49 @@ func TestSyncManagerWebsocketUpdate(t *testing.T) {
 	err = m.WebsocketUpdate("", currency.EMPTYPAIR, asset.Spot, SyncItemTrade, errors.New("test"))
 	require.NoError(t, err)
 }
+
+func TestSyncManagerOrderbookStaleness(t *testing.T) {
+	t.Parallel()
+	m := &SyncManager{config: config.SyncManagerConfig{SynchronizeOrderbook: true}}
+	assert.Empty(t, m.orderbookStaleness(), "orderbookStaleness should return no entries without sync agents")
+
+	btc := key.NewExchangeAssetPair("bitstamp", asset.Spot, currency.NewBTCUSD())
+	eth := key.NewExchangeAssetPair("bitstamp", asset.Spot, currency.NewPair(currency.ETH, currency.USD))
+	ltc := key.NewExchangeAssetPair("bitstamp", asset.Spot, currency.NewPair(currency.LTC, currency.USD))
+	m.add(btc, syncBase{HaveData: true, LastUpdated: time.Now().Add(-time.Minute)})
+	m.add(eth, syncBase{})
+	c := m.add(ltc, syncBase{HaveData: true, LastUpdated: time.Now()})
+
+	c.locks[SyncItemOrderbook].Lock()
+	staleness := m.orderbookStaleness()
+	c.locks[SyncItemOrderbook].Unlock()
+
+	require.Len(t, staleness, 1, "orderbookStaleness must skip trackers without data or which are busy")
+	assert.GreaterOrEqual(t, staleness[btc], time.Minute, "orderbookStaleness should return the time since last update")
+}
+
+func TestSyncOrderbookInvalidatedWebsocketBook(t *testing.T) {
+	t.Parallel()
+	m := &SyncManager{config: config.SyncManagerConfig{SynchronizeOrderbook: true, TimeoutWebsocket: time.Minute, TimeoutREST: time.Minute}}
+	k := key.NewExchangeAssetPair("syncOrderbookInvalidated", asset.Spot, currency.NewBTCUSD())
+	c := m.add(k, syncBase{IsUsingWebsocket: true, HaveData: true, LastUpdated: time.Now()})
+	e := &syncOrderbookExchange{}
+
+	m.syncOrderbook(t.Context(), c, e)
+	assert.True(t, c.trackers[SyncItemOrderbook].IsUsingWebsocket, "should stay on websocket without a depth")
+
+	d, err := orderbook.DeployDepth(k.Exchange, c.Pair, k.Asset)
+	require.NoError(t, err, "DeployDepth must not error")
+	m.syncOrderbook(t.Context(), c, e)
+	assert.True(t, c.trackers[SyncItemOrderbook].IsUsingWebsocket, "should stay on websocket with a valid depth")
+	assert.Zero(t, e.updates, "should not fetch a REST orderbook while the websocket is fresh")
+
+	require.ErrorIs(t, d.Invalidate(errors.New("gap")), orderbook.ErrOrderbookInvalid, "Invalidate must return the invalid error")
+	m.syncOrderbook(t.Context(), c, e)
+	assert.False(t, c.trackers[SyncItemOrderbook].IsUsingWebsocket, "should switch from websocket when the depth is invalid")
+	assert.True(t, c.trackers[SyncItemOrderbook].IsUsingREST, "should switch to REST when the depth is invalid")
+	assert.Equal(t, 1, e.updates, "should fetch a REST orderbook immediately")
+
+	m.syncOrderbook(t.Context(), c, e)
+	assert.Equal(t, 1, e.updates, "should wait for the REST timeout once switched")
+}
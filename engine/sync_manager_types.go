@@ -40,6 +40,7 @@ type SyncManager struct {
 	websocketRoutineManagerEnabled bool
 	mux                            sync.Mutex
 	initSyncWG                     sync.WaitGroup
+	workerWG                       sync.WaitGroup
 	inService                      sync.WaitGroup
 
 	currencyPairs            map[key.ExchangeAssetPair]*currencyPairSyncAgent
@@ -0,0 +1,581 @@
+package engine
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/currencystate"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
+)
+
+// SetupTreasuryManager applies configuration parameters before running
+func SetupTreasuryManager(cfg *config.TreasuryManager, em iExchangeManager, wm iWithdrawManager) (*TreasuryManager, error) {
+	if cfg == nil {
+		return nil, errNilTreasuryManagerConfig
+	}
+	if em == nil {
+		return nil, errNilExchangeManager
+	}
+	if wm == nil {
+		return nil, errNilWithdrawManager
+	}
+	if cfg.CheckInterval <= 0 {
+		return nil, fmt.Errorf("%s %w: %v", TreasuryManagerName, errInvalidCheckInterval, cfg.CheckInterval)
+	}
+	if cfg.SettlementTimeout <= 0 {
+		return nil, fmt.Errorf("%s %w: %v", TreasuryManagerName, errInvalidSettlementTimeout, cfg.SettlementTimeout)
+	}
+	return &TreasuryManager{
+		verbose:           cfg.Verbose,
+		interval:          cfg.CheckInterval,
+		settlementTimeout: cfg.SettlementTimeout,
+		targets:           slices.Clone(cfg.Targets),
+		exchangeManager:   em,
+		withdrawManager:   wm,
+		shutdown:          make(chan struct{}),
+	}, nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (m *TreasuryManager) IsRunning() bool {
+	return m != nil && m.started.Load()
+}
+
+// Start runs the subsystem
+func (m *TreasuryManager) Start(ctx context.Context) error {
+	if m == nil {
+		return fmt.Errorf("%s %w", TreasuryManagerName, ErrNilSubsystem)
+	}
+	if !m.started.CompareAndSwap(false, true) {
+		return fmt.Errorf("%s %w", TreasuryManagerName, ErrSubSystemAlreadyStarted)
+	}
+	log.Debugf(log.Global, "%s %s", TreasuryManagerName, MsgSubSystemStarting)
+	m.shutdown = make(chan struct{})
+	m.wg.Add(1)
+	go m.run(ctx)
+	log.Debugf(log.Global, "%s %s", TreasuryManagerName, MsgSubSystemStarted)
+	return nil
+}
+
+// Stop stops the subsystem
+func (m *TreasuryManager) Stop() error {
+	if m == nil {
+		return fmt.Errorf("%s %w", TreasuryManagerName, ErrNilSubsystem)
+	}
+	if !m.started.CompareAndSwap(true, false) {
+		return fmt.Errorf("%s %w", TreasuryManagerName, ErrSubSystemNotStarted)
+	}
+	log.Debugf(log.Global, "%s %s", TreasuryManagerName, MsgSubSystemShuttingDown)
+	close(m.shutdown)
+	m.wg.Wait()
+	log.Debugf(log.Global, "%s %s", TreasuryManagerName, MsgSubSystemShutdown)
+	return nil
+}
+
+func (m *TreasuryManager) run(ctx context.Context) {
+	defer m.wg.Done()
+	t := time.NewTicker(m.interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-m.shutdown:
+			return
+		case <-ctx.Done():
+			return
+		case <-t.C:
+			if _, err := m.Rebalance(ctx); err != nil {
+				log.Errorf(log.Global, "%s %v", TreasuryManagerName, err)
+			}
+		}
+	}
+}
+
+// Rebalance updates the transfers in transit and submits the transfers needed
+// to bring each currency outside its target allocation bands back to target.
+// Currencies with transfers awaiting approval or still in transit are left
+// until they have been approved and settled, or are no longer tracked.
+// Transfers which could not be submitted are returned with a failed status.
+func (m *TreasuryManager) Rebalance(ctx context.Context) ([]TreasuryTransfer, error) {
+	if !m.IsRunning() {
+		return nil, fmt.Errorf("%s %w", TreasuryManagerName, ErrSubSystemNotStarted)
+	}
+	m.m.Lock()
+	defer m.m.Unlock()
+	m.trackTransfers(ctx)
+	var resp []TreasuryTransfer
+	var errs error
+	for i := range m.targets {
+		if m.hasOpenTransfers(m.targets[i].Currency) {
+			if m.verbose {
+				log.Debugf(log.Global, "%s %s transfers awaiting approval or in transit, skipping", TreasuryManagerName, m.targets[i].Currency)
+			}
+			continue
+		}
+		planned, err := m.plan(ctx, &m.targets[i])
+		if err != nil {
+			errs = common.AppendError(errs, err)
+			continue
+		}
+		// Transfers to the same exchange raise its balance in turn, so each
+		// is expected to arrive on top of the ones before it
+		inbound := make(map[string]float64)
+		for _, t := range planned {
+			t.baseline += inbound[t.To]
+			m.submit(ctx, &m.targets[i], t)
+			if t.Status != TreasuryTransferFailed {
+				inbound[t.To] += t.Amount - t.Fee
+			}
+			m.transfers = append(m.transfers, t)
+			resp = append(resp, *t)
+		}
+	}
+	return resp, errs
+}
+
+// Plan returns the transfers which would be made to bring each currency back
+// within its target allocations, along with the chain they would be sent over,
+// without submitting them
+func (m *TreasuryManager) Plan(ctx context.Context) ([]TreasuryTransfer, error) {
+	if !m.IsRunning() {
+		return nil, fmt.Errorf("%s %w", TreasuryManagerName, ErrSubSystemNotStarted)
+	}
+	m.m.Lock()
+	defer m.m.Unlock()
+	var resp []TreasuryTransfer
+	var errs error
+	for i := range m.targets {
+		if m.hasOpenTransfers(m.targets[i].Currency) {
+			continue
+		}
+		planned, err := m.plan(ctx, &m.targets[i])
+		if err != nil {
+			errs = common.AppendError(errs, err)
+			continue
+		}
+		for _, t := range planned {
+			if _, _, err := m.prepare(ctx, &m.targets[i], t); err != nil {
+				t.Error = err.Error()
+			}
+			resp = append(resp, *t)
+		}
+	}
+	return resp, errs
+}
+
+// GetTransfers returns the transfers made by the treasury manager
+func (m *TreasuryManager) GetTransfers() ([]TreasuryTransfer, error) {
+	if !m.IsRunning() {
+		return nil, fmt.Errorf("%s %w", TreasuryManagerName, ErrSubSystemNotStarted)
+	}
+	m.m.Lock()
+	defer m.m.Unlock()
+	resp := make([]TreasuryTransfer, len(m.transfers))
+	for i := range m.transfers {
+		resp[i] = *m.transfers[i]
+	}
+	return resp, nil
+}
+
+// plan fetches the balances of a target currency from each exchange it is
+// allocated to and returns the transfers needed to rebalance them
+func (m *TreasuryManager) plan(ctx context.Context, t *config.TreasuryTarget) ([]*TreasuryTransfer, error) {
+	holdings := make([]treasuryHolding, len(t.Allocations))
+	for i := range t.Allocations {
+		exch, err := m.exchangeManager.GetExchangeByName(t.Allocations[i].Exchange)
+		if err != nil {
+			return nil, fmt.Errorf("%s %s: %w", TreasuryManagerName, t.Currency, err)
+		}
+		total, free, err := treasuryBalance(ctx, exch, t.Currency)
+		if err != nil {
+			return nil, fmt.Errorf("%s unable to get %s %s balance: %w", TreasuryManagerName, exch.GetName(), t.Currency, err)
+		}
+		holdings[i] = treasuryHolding{
+			exchange: exch.GetName(),
+			total:    total,
+			free:     free,
+			target:   t.Allocations[i].Target,
+			band:     t.Allocations[i].Band,
+		}
+	}
+	return planTreasuryTransfers(t, holdings), nil
+}
+
+// submit checks a planned transfer can be made, fetches the destination
+// deposit address and submits the withdrawal. Failures are recorded on the
+// transfer
+func (m *TreasuryManager) submit(ctx context.Context, t *config.TreasuryTarget, tr *TreasuryTransfer) {
+	from, chain, err := m.prepare(ctx, t, tr)
+	if err != nil {
+		m.fail(tr, err)
+		return
+	}
+	to, err := m.exchangeManager.GetExchangeByName(tr.To)
+	if err != nil {
+		m.fail(tr, err)
+		return
+	}
+	addr, err := to.GetDepositAddress(ctx, t.Currency, "", chain.deposit)
+	if err != nil {
+		m.fail(tr, fmt.Errorf("unable to get deposit address: %w", err))
+		return
+	}
+	tr.Address = addr.Address
+	req := &withdraw.Request{
+		Exchange:    from.GetName(),
+		Currency:    t.Currency,
+		Amount:      tr.Amount,
+		Type:        withdraw.Crypto,
+		Description: "treasury rebalance to " + to.GetName(),
+		RequestedBy: TreasuryManagerName,
+		Crypto: withdraw.CryptoRequest{
+			Address:    addr.Address,
+			AddressTag: addr.Tag,
+			Chain:      chain.withdraw,
+		},
+	}
+	if m.prepareRequest != nil {
+		if err := m.prepareRequest(req); err != nil {
+			m.fail(tr, err)
+			return
+		}
+	}
+	resp, err := m.withdrawManager.SubmitWithdrawal(ctx, req)
+	if err != nil {
+		m.fail(tr, err)
+		return
+	}
+	tr.ID = resp.ID
+	tr.WithdrawalStatus = resp.Exchange.Status
+	switch {
+	case resp.ID == withdraw.DryRunID:
+		tr.Status = TreasuryTransferDryRun
+		tr.SubmittedAt = time.Now()
+	case resp.Exchange.Status == WithdrawStatusPendingApproval:
+		tr.Status = TreasuryTransferPendingApproval
+	default:
+		tr.Status = TreasuryTransferInTransit
+		tr.SubmittedAt = time.Now()
+	}
+	log.Infof(log.Global, "%s submitted %v %s transfer from %s to %s over chain %q, withdrawal %s %s",
+		TreasuryManagerName, tr.Amount, tr.Currency, tr.From, tr.To, tr.Chain, tr.ID, tr.WithdrawalStatus)
+}
+
+// prepare checks the currency can be withdrawn from the source exchange and
+// deposited to the destination, then selects the cheapest chain they share
+func (m *TreasuryManager) prepare(ctx context.Context, t *config.TreasuryTarget, tr *TreasuryTransfer) (exchange.IBotExchange, treasuryChain, error) {
+	from, err := m.exchangeManager.GetExchangeByName(tr.From)
+	if err != nil {
+		return nil, treasuryChain{}, err
+	}
+	to, err := m.exchangeManager.GetExchangeByName(tr.To)
+	if err != nil {
+		return nil, treasuryChain{}, err
+	}
+	if err := from.CanWithdraw(t.Currency, asset.Spot); err != nil && !errors.Is(err, currencystate.ErrCurrencyStateNotFound) {
+		return nil, treasuryChain{}, fmt.Errorf("%s: %w", tr.From, err)
+	}
+	if err := to.CanDeposit(t.Currency, asset.Spot); err != nil && !errors.Is(err, currencystate.ErrCurrencyStateNotFound) {
+		return nil, treasuryChain{}, fmt.Errorf("%s: %w", tr.To, err)
+	}
+	chain, err := selectTreasuryChain(ctx, from, to, t, tr.Amount)
+	if err != nil {
+		return nil, treasuryChain{}, err
+	}
+	tr.Chain = chain.withdraw
+	tr.Fee = chain.fee
+	return from, chain, nil
+}
+
+func (m *TreasuryManager) fail(tr *TreasuryTransfer, err error) {
+	tr.Status = TreasuryTransferFailed
+	tr.Error = err.Error()
+	log.Errorf(log.Global, "%s unable to transfer %v %s from %s to %s: %v",
+		TreasuryManagerName, tr.Amount, tr.Currency, tr.From, tr.To, err)
+}
+
+// trackApprovals follows the transfers awaiting approval by the withdraw
+// manager. Approved transfers are tracked until they arrive, whereas those
+// rejected or left to expire are no longer tracked
+func (m *TreasuryManager) trackApprovals() {
+	if !slices.ContainsFunc(m.transfers, func(t *TreasuryTransfer) bool {
+		return t.Status == TreasuryTransferPendingApproval
+	}) {
+		return
+	}
+	pending, err := m.withdrawManager.GetPendingWithdrawals()
+	if err != nil {
+		log.Errorf(log.Global, "%s unable to get pending withdrawals: %v", TreasuryManagerName, err)
+		return
+	}
+	for _, tr := range m.transfers {
+		if tr.Status != TreasuryTransferPendingApproval || slices.ContainsFunc(pending, func(p PendingWithdrawal) bool {
+			return p.ID == tr.ID
+		}) {
+			continue
+		}
+		resp, err := m.withdrawManager.WithdrawalEventByID(tr.ID.String())
+		if err != nil {
+			tr.Status = TreasuryTransferNotApproved
+			log.Warnf(log.Global, "%s %v %s transfer from %s to %s was rejected or expired before being approved",
+				TreasuryManagerName, tr.Amount, tr.Currency, tr.From, tr.To)
+			continue
+		}
+		tr.WithdrawalStatus = resp.Exchange.Status
+		if resp.Exchange.ID == "" {
+			m.fail(tr, fmt.Errorf("%w: %s", errApprovedWithdrawalFailed, resp.Exchange.Status))
+			continue
+		}
+		tr.Status = TreasuryTransferInTransit
+		tr.SubmittedAt = time.Now()
+		log.Infof(log.Global, "%s %v %s transfer from %s to %s was approved and submitted, withdrawal %s %s",
+			TreasuryManagerName, tr.Amount, tr.Currency, tr.From, tr.To, tr.ID, tr.WithdrawalStatus)
+	}
+}
+
+// trackTransfers settles the transfers in transit which have arrived at their
+// destination and stops tracking those which have not arrived in time
+func (m *TreasuryManager) trackTransfers(ctx context.Context) {
+	m.trackApprovals()
+	type balanceKey struct {
+		exchange string
+		currency *currency.Item
+	}
+	balances := make(map[balanceKey]float64)
+	now := time.Now()
+	for _, tr := range m.transfers {
+		if tr.Status != TreasuryTransferInTransit {
+			continue
+		}
+		if now.Sub(tr.SubmittedAt) > m.settlementTimeout {
+			tr.Status = TreasuryTransferTimedOut
+			log.Warnf(log.Global, "%s %v %s transfer from %s to %s has not arrived after %s, no longer tracking",
+				TreasuryManagerName, tr.Amount, tr.Currency, tr.From, tr.To, m.settlementTimeout)
+			continue
+		}
+		k := balanceKey{exchange: tr.To, currency: tr.Currency.Item}
+		total, ok := balances[k]
+		if !ok {
+			exch, err := m.exchangeManager.GetExchangeByName(tr.To)
+			if err != nil {
+				log.Errorf(log.Global, "%s %v", TreasuryManagerName, err)
+				continue
+			}
+			if total, _, err = treasuryBalance(ctx, exch, tr.Currency); err != nil {
+				log.Errorf(log.Global, "%s unable to get %s %s balance: %v", TreasuryManagerName, tr.To, tr.Currency, err)
+				continue
+			}
+			balances[k] = total
+		}
+		if total-tr.baseline >= tr.Amount*(1-treasurySettlementTolerance)-tr.Fee {
+			tr.Status = TreasuryTransferSettled
+			tr.SettledAt = now
+			log.Infof(log.Global, "%s %v %s transfer from %s to %s has settled",
+				TreasuryManagerName, tr.Amount, tr.Currency, tr.From, tr.To)
+		}
+	}
+}
+
+// hasOpenTransfers returns whether a currency has transfers awaiting approval
+// or in transit
+func (m *TreasuryManager) hasOpenTransfers(c currency.Code) bool {
+	return slices.ContainsFunc(m.transfers, func(t *TreasuryTransfer) bool {
+		return (t.Status == TreasuryTransferInTransit || t.Status == TreasuryTransferPendingApproval) && t.Currency.Equal(c)
+	})
+}
+
+// planTreasuryTransfers returns the transfers which move holdings back to their
+// target allocations once any holding has drifted outside its band. Exchanges
+// holding the largest surpluses fund the largest shortfalls first, and only
+// free balances are moved. Amounts are rounded down to the withdrawal
+// precision of the currency
+func planTreasuryTransfers(t *config.TreasuryTarget, holdings []treasuryHolding) []*TreasuryTransfer {
+	var total, weight float64
+	for i := range holdings {
+		total += holdings[i].total
+		weight += holdings[i].target
+	}
+	if total <= 0 || weight <= 0 {
+		return nil
+	}
+
+	type imbalance struct {
+		exchange string
+		amount   float64
+		total    float64
+	}
+	var outOfBand bool
+	var surpluses, shortfalls []imbalance
+	for i := range holdings {
+		share := holdings[i].target / weight
+		if math.Abs(holdings[i].total/total-share) > holdings[i].band {
+			outOfBand = true
+		}
+		desired := total * share
+		switch {
+		case holdings[i].total > desired:
+			if surplus := min(holdings[i].total-desired, holdings[i].free); surplus > 0 {
+				surpluses = append(surpluses, imbalance{exchange: holdings[i].exchange, amount: surplus})
+			}
+		case holdings[i].total < desired:
+			shortfalls = append(shortfalls, imbalance{exchange: holdings[i].exchange, amount: desired - holdings[i].total, total: holdings[i].total})
+		}
+	}
+	if !outOfBand {
+		return nil
+	}
+
+	largestFirst := func(a, b imbalance) int { return cmp.Compare(b.amount, a.amount) }
+	slices.SortStableFunc(surpluses, largestFirst)
+	slices.SortStableFunc(shortfalls, largestFirst)
+	var transfers []*TreasuryTransfer
+	for i, j := 0, 0; i < len(surpluses) && j < len(shortfalls); {
+		amount := min(surpluses[i].amount, shortfalls[j].amount)
+		if transfer := roundTreasuryAmount(amount, t.WithdrawalPrecision); transfer > 0 && transfer >= t.MinimumTransfer {
+			transfers = append(transfers, &TreasuryTransfer{
+				Currency: t.Currency,
+				From:     surpluses[i].exchange,
+				To:       shortfalls[j].exchange,
+				Amount:   transfer,
+				baseline: shortfalls[j].total,
+			})
+		}
+		surpluses[i].amount -= amount
+		shortfalls[j].amount -= amount
+		if surpluses[i].amount <= 0 {
+			i++
+		}
+		if shortfalls[j].amount <= 0 {
+			j++
+		}
+	}
+	return transfers
+}
+
+// selectTreasuryChain returns the cheapest transfer chain supported by both
+// exchanges. Chains are priced by their configured fee estimate, otherwise by
+// the withdrawal fee the source exchange reports for the amount. Chains
+// without a fee are only used when no chain with one is available. Exchanges
+// which do not list their chains use their default chain, which is only done
+// when neither exchange lists its chains
+func selectTreasuryChain(ctx context.Context, from, to exchange.IBotExchange, t *config.TreasuryTarget, amount float64) (treasuryChain, error) {
+	withdrawChains, err := treasuryTransferChains(ctx, from, t.Currency)
+	if err != nil {
+		return treasuryChain{}, err
+	}
+	depositChains, err := treasuryTransferChains(ctx, to, t.Currency)
+	if err != nil {
+		return treasuryChain{}, err
+	}
+	exchFee, exchFeeKnown := treasuryWithdrawalFee(ctx, from, t.Currency, amount)
+	chainFee := func(chain string) (float64, bool) {
+		if fee, ok := treasuryChainFee(t, chain); ok {
+			return fee, true
+		}
+		return exchFee, exchFeeKnown
+	}
+	if len(withdrawChains) == 0 && len(depositChains) == 0 {
+		fee, ok := chainFee("")
+		return treasuryChain{fee: fee, feeKnown: ok}, nil
+	}
+	var candidates []treasuryChain
+	for _, w := range withdrawChains {
+		for _, d := range depositChains {
+			if strings.EqualFold(w, d) {
+				fee, ok := chainFee(w)
+				candidates = append(candidates, treasuryChain{withdraw: w, deposit: d, fee: fee, feeKnown: ok})
+				break
+			}
+		}
+	}
+	if len(candidates) == 0 {
+		return treasuryChain{}, fmt.Errorf("%w: %s %s to %s", errNoCommonTransferChain, t.Currency, from.GetName(), to.GetName())
+	}
+	slices.SortStableFunc(candidates, func(a, b treasuryChain) int {
+		if a.feeKnown != b.feeKnown {
+			if a.feeKnown {
+				return -1
+			}
+			return 1
+		}
+		return cmp.Compare(a.fee, b.fee)
+	})
+	return candidates[0], nil
+}
+
+// treasuryTransferChains returns the transfer chains of a currency, or none if
+// the exchange does not support listing them
+func treasuryTransferChains(ctx context.Context, exch exchange.IBotExchange, c currency.Code) ([]string, error) {
+	chains, err := exch.GetAvailableTransferChains(ctx, c)
+	if errors.Is(err, common.ErrFunctionNotSupported) || errors.Is(err, common.ErrNotYetImplemented) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s unable to get %s transfer chains: %w", exch.GetName(), c, err)
+	}
+	return chains, nil
+}
+
+func treasuryChainFee(t *config.TreasuryTarget, chain string) (float64, bool) {
+	for k, v := range t.ChainFees {
+		if strings.EqualFold(k, chain) {
+			return v, true
+		}
+	}
+	return 0, false
+}
+
+// treasuryWithdrawalFee returns the withdrawal fee an exchange reports for an
+// amount of a currency. Exchange fees are not specific to a chain, and a zero
+// fee is taken as the exchange not knowing the fee
+func treasuryWithdrawalFee(ctx context.Context, exch exchange.IBotExchange, c currency.Code, amount float64) (float64, bool) {
+	fee, err := exch.GetFeeByType(ctx, &exchange.FeeBuilder{
+		FeeType: exchange.CryptocurrencyWithdrawalFee,
+		Pair:    currency.Pair{Base: c},
+		Amount:  amount,
+	})
+	if err != nil || fee <= 0 {
+		return 0, false
+	}
+	return fee, true
+}
+
+// roundTreasuryAmount rounds an amount down to the withdrawal precision of its
+// currency
+func roundTreasuryAmount(amount, precision float64) float64 {
+	if precision <= 0 {
+		return amount
+	}
+	step := decimal.NewFromFloat(precision)
+	return decimal.NewFromFloat(amount).Div(step).Floor().Mul(step).InexactFloat64()
+}
+
+// treasuryBalance returns the total and free spot balances of a currency held
+// across an exchange's accounts
+func treasuryBalance(ctx context.Context, exch exchange.IBotExchange, c currency.Code) (total, free float64, err error) {
+	subAccounts, err := exch.UpdateAccountBalances(ctx, asset.Spot)
+	if err != nil {
+		return 0, 0, err
+	}
+	for _, sa := range subAccounts {
+		for code, b := range sa.Balances {
+			if code.Equal(c) {
+				total += b.Total
+				free += b.Free
+			}
+		}
+	}
+	return total, free, nil
+}
@@ -0,0 +1,416 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/deposit"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
+)
+
+var errTreasuryTest = errors.New("treasury test error")
+
+// treasuryTestExchange is an offline exchange holding a spot balance of a
+// single currency
+type treasuryTestExchange struct {
+	exchange.IBotExchange
+	name        string
+	balance     float64
+	chains      []string
+	chainsErr   error
+	withdrawErr error
+	fee         float64
+}
+
+func (e *treasuryTestExchange) GetName() string { return e.name }
+
+func (e *treasuryTestExchange) UpdateAccountBalances(context.Context, asset.Item) (accounts.SubAccounts, error) {
+	return accounts.SubAccounts{{
+		AssetType: asset.Spot,
+		Balances: accounts.CurrencyBalances{
+			currency.BTC: {Currency: currency.BTC, Total: e.balance, Free: e.balance},
+		},
+	}}, nil
+}
+
+func (e *treasuryTestExchange) GetAvailableTransferChains(context.Context, currency.Code) ([]string, error) {
+	return e.chains, e.chainsErr
+}
+
+func (e *treasuryTestExchange) GetDepositAddress(_ context.Context, c currency.Code, _, chain string) (*deposit.Address, error) {
+	return &deposit.Address{Address: strings.ToLower(e.name + c.String() + chain), Chain: chain}, nil
+}
+
+func (e *treasuryTestExchange) GetFeeByType(context.Context, *exchange.FeeBuilder) (float64, error) {
+	return e.fee, nil
+}
+
+func (e *treasuryTestExchange) CanWithdraw(currency.Code, asset.Item) error { return e.withdrawErr }
+
+func (e *treasuryTestExchange) CanDeposit(currency.Code, asset.Item) error { return nil }
+
+// treasuryTestExchangeManager looks up treasury test exchanges by name
+type treasuryTestExchangeManager map[string]*treasuryTestExchange
+
+func (m treasuryTestExchangeManager) GetExchanges() ([]exchange.IBotExchange, error) {
+	exchs := make([]exchange.IBotExchange, 0, len(m))
+	for _, e := range m {
+		exchs = append(exchs, e)
+	}
+	return exchs, nil
+}
+
+func (m treasuryTestExchangeManager) GetExchangeByName(name string) (exchange.IBotExchange, error) {
+	e, ok := m[name]
+	if !ok {
+		return nil, ErrExchangeNotFound
+	}
+	return e, nil
+}
+
+// treasuryTestWithdrawManager records the withdrawals submitted to it. With
+// approval set withdrawals are held as pending until removed from pending,
+// after which they are looked up in events
+type treasuryTestWithdrawManager struct {
+	requests []*withdraw.Request
+	dryRun   bool
+	approval bool
+	pending  []PendingWithdrawal
+	events   map[string]*withdraw.Response
+}
+
+func (w *treasuryTestWithdrawManager) SubmitWithdrawal(_ context.Context, req *withdraw.Request) (*withdraw.Response, error) {
+	w.requests = append(w.requests, req)
+	resp := &withdraw.Response{ID: withdraw.DryRunID, Exchange: withdraw.ExchangeResponse{Status: "dryrun"}}
+	switch {
+	case w.approval:
+		resp.ID = uuid.Must(uuid.NewV4())
+		resp.Exchange.Status = WithdrawStatusPendingApproval
+		w.pending = append(w.pending, PendingWithdrawal{ID: resp.ID, Request: *req})
+	case !w.dryRun:
+		resp.ID = uuid.Must(uuid.NewV4())
+		resp.Exchange.Status = "submitted"
+	}
+	return resp, nil
+}
+
+func (w *treasuryTestWithdrawManager) GetPendingWithdrawals() ([]PendingWithdrawal, error) {
+	return w.pending, nil
+}
+
+func (w *treasuryTestWithdrawManager) WithdrawalEventByID(id string) (*withdraw.Response, error) {
+	if e, ok := w.events[id]; ok {
+		return e, nil
+	}
+	return nil, ErrWithdrawRequestNotFound
+}
+
+func treasuryTestConfig() *config.TreasuryManager {
+	return &config.TreasuryManager{
+		CheckInterval:     time.Minute,
+		SettlementTimeout: time.Hour,
+		Targets: []config.TreasuryTarget{{
+			Currency:        currency.BTC,
+			MinimumTransfer: 0.1,
+			ChainFees:       map[string]float64{"btc": 0.0005, "lightning": 0.00001},
+			Allocations: []config.TreasuryAllocation{
+				{Exchange: "alpha", Target: 0.5, Band: 0.1},
+				{Exchange: "beta", Target: 0.5, Band: 0.1},
+			},
+		}},
+	}
+}
+
+func TestSetupTreasuryManager(t *testing.T) {
+	t.Parallel()
+	em := treasuryTestExchangeManager{}
+	wm := &treasuryTestWithdrawManager{}
+	_, err := SetupTreasuryManager(nil, em, wm)
+	assert.ErrorIs(t, err, errNilTreasuryManagerConfig)
+	_, err = SetupTreasuryManager(treasuryTestConfig(), nil, wm)
+	assert.ErrorIs(t, err, errNilExchangeManager)
+	_, err = SetupTreasuryManager(treasuryTestConfig(), em, nil)
+	assert.ErrorIs(t, err, errNilWithdrawManager)
+	_, err = SetupTreasuryManager(&config.TreasuryManager{SettlementTimeout: time.Hour}, em, wm)
+	assert.ErrorIs(t, err, errInvalidCheckInterval)
+	_, err = SetupTreasuryManager(&config.TreasuryManager{CheckInterval: time.Minute}, em, wm)
+	assert.ErrorIs(t, err, errInvalidSettlementTimeout)
+	m, err := SetupTreasuryManager(treasuryTestConfig(), em, wm)
+	require.NoError(t, err, "SetupTreasuryManager must not error")
+	assert.Len(t, m.targets, 1, "SetupTreasuryManager should keep the targets")
+}
+
+func TestTreasuryManagerStartStop(t *testing.T) {
+	t.Parallel()
+	var m *TreasuryManager
+	assert.False(t, m.IsRunning(), "IsRunning should return false on a nil manager")
+	assert.ErrorIs(t, m.Start(t.Context()), ErrNilSubsystem)
+	assert.ErrorIs(t, m.Stop(), ErrNilSubsystem)
+
+	m, err := SetupTreasuryManager(treasuryTestConfig(), treasuryTestExchangeManager{}, &treasuryTestWithdrawManager{})
+	require.NoError(t, err, "SetupTreasuryManager must not error")
+	assert.ErrorIs(t, m.Stop(), ErrSubSystemNotStarted)
+	_, err = m.GetTransfers()
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+	require.NoError(t, m.Start(t.Context()), "Start must not error")
+	assert.ErrorIs(t, m.Start(t.Context()), ErrSubSystemAlreadyStarted)
+	assert.True(t, m.IsRunning(), "IsRunning should return true once started")
+	require.NoError(t, m.Stop(), "Stop must not error")
+	assert.False(t, m.IsRunning(), "IsRunning should return false once stopped")
+}
+
+func TestPlanTreasuryTransfers(t *testing.T) {
+	t.Parallel()
+	target := &config.TreasuryTarget{Currency: currency.BTC, MinimumTransfer: 0.5}
+	assert.Empty(t, planTreasuryTransfers(target, []treasuryHolding{
+		{exchange: "alpha", target: 1, band: 0.1},
+		{exchange: "beta", target: 1, band: 0.1},
+	}), "planTreasuryTransfers should not plan transfers without holdings")
+	assert.Empty(t, planTreasuryTransfers(target, []treasuryHolding{
+		{exchange: "alpha", total: 5.4, free: 5.4, target: 1, band: 0.1},
+		{exchange: "beta", total: 4.6, free: 4.6, target: 1, band: 0.1},
+	}), "planTreasuryTransfers should not plan transfers within the bands")
+
+	transfers := planTreasuryTransfers(target, []treasuryHolding{
+		{exchange: "alpha", total: 8, free: 8, target: 2, band: 0.05},
+		{exchange: "beta", total: 1, free: 1, target: 1, band: 0.05},
+		{exchange: "gamma", total: 1, free: 1, target: 1, band: 0.05},
+	})
+	require.Len(t, transfers, 2, "planTreasuryTransfers must fund every shortfall")
+	for _, tr := range transfers {
+		assert.Equal(t, "alpha", tr.From, "planTreasuryTransfers should move funds from the surplus")
+		assert.Equal(t, 1.5, tr.Amount, "planTreasuryTransfers should move funds back to target")
+		assert.Equal(t, 1.0, tr.baseline, "planTreasuryTransfers should record the destination balance")
+	}
+
+	transfers = planTreasuryTransfers(target, []treasuryHolding{
+		{exchange: "alpha", total: 8, free: 1, target: 1, band: 0.1},
+		{exchange: "beta", total: 2, free: 2, target: 1, band: 0.1},
+	})
+	require.Len(t, transfers, 1, "planTreasuryTransfers must plan a transfer")
+	assert.Equal(t, 1.0, transfers[0].Amount, "planTreasuryTransfers should only move free balances")
+
+	target.WithdrawalPrecision = 0.1
+	transfers = planTreasuryTransfers(target, []treasuryHolding{
+		{exchange: "alpha", total: 8.05, free: 8.05, target: 1, band: 0.1},
+		{exchange: "beta", total: 2, free: 2, target: 1, band: 0.1},
+	})
+	require.Len(t, transfers, 1, "planTreasuryTransfers must plan a transfer")
+	assert.Equal(t, 3.0, transfers[0].Amount, "planTreasuryTransfers should round amounts down to the withdrawal precision")
+
+	target.MinimumTransfer = 2
+	assert.Empty(t, planTreasuryTransfers(target, []treasuryHolding{
+		{exchange: "alpha", total: 8, free: 1, target: 1, band: 0.1},
+		{exchange: "beta", total: 2, free: 2, target: 1, band: 0.1},
+	}), "planTreasuryTransfers should skip transfers below the minimum")
+}
+
+func TestSelectTreasuryChain(t *testing.T) {
+	t.Parallel()
+	target := treasuryTestConfig().Targets[0]
+	from := &treasuryTestExchange{name: "alpha", chains: []string{"ERC20", "BTC", "Lightning"}}
+	to := &treasuryTestExchange{name: "beta", chains: []string{"btc", "erc20"}}
+	chain, err := selectTreasuryChain(t.Context(), from, to, &target, 1)
+	require.NoError(t, err, "selectTreasuryChain must not error")
+	assert.Equal(t, "BTC", chain.withdraw, "selectTreasuryChain should select the cheapest common chain")
+	assert.Equal(t, "btc", chain.deposit, "selectTreasuryChain should use the destination spelling of the chain")
+	assert.Equal(t, 0.0005, chain.fee, "selectTreasuryChain should return the chain fee estimate")
+
+	from.fee = 0.0001
+	chain, err = selectTreasuryChain(t.Context(), from, to, &target, 1)
+	require.NoError(t, err, "selectTreasuryChain must not error")
+	assert.Equal(t, "ERC20", chain.withdraw, "selectTreasuryChain should price chains without an estimate by the exchange withdrawal fee")
+	assert.Equal(t, 0.0001, chain.fee, "selectTreasuryChain should return the exchange withdrawal fee")
+	assert.True(t, chain.feeKnown, "selectTreasuryChain should report a known fee")
+
+	from.fee = 0
+	to.chains = []string{"erc20"}
+	chain, err = selectTreasuryChain(t.Context(), from, to, &target, 1)
+	require.NoError(t, err, "selectTreasuryChain must not error")
+	assert.Equal(t, "ERC20", chain.withdraw, "selectTreasuryChain should fall back to chains without a fee estimate")
+	assert.False(t, chain.feeKnown, "selectTreasuryChain should report an unknown fee")
+
+	to.chains = []string{"trc20"}
+	_, err = selectTreasuryChain(t.Context(), from, to, &target, 1)
+	assert.ErrorIs(t, err, errNoCommonTransferChain)
+
+	to.chains, to.chainsErr = nil, common.ErrFunctionNotSupported
+	_, err = selectTreasuryChain(t.Context(), from, to, &target, 1)
+	assert.ErrorIs(t, err, errNoCommonTransferChain, "selectTreasuryChain should not guess the destination chain")
+
+	from.chains, from.chainsErr = nil, common.ErrNotYetImplemented
+	chain, err = selectTreasuryChain(t.Context(), from, to, &target, 1)
+	require.NoError(t, err, "selectTreasuryChain must not error")
+	assert.Empty(t, chain.withdraw, "selectTreasuryChain should use the default chain when neither exchange lists chains")
+
+	from.chainsErr = errTreasuryTest
+	_, err = selectTreasuryChain(t.Context(), from, to, &target, 1)
+	assert.ErrorIs(t, err, errTreasuryTest)
+}
+
+func TestTreasuryManagerRebalance(t *testing.T) {
+	t.Parallel()
+	alpha := &treasuryTestExchange{name: "alpha", balance: 9, chains: []string{"btc", "lightning"}}
+	beta := &treasuryTestExchange{name: "beta", balance: 1, chains: []string{"BTC"}}
+	em := treasuryTestExchangeManager{"alpha": alpha, "beta": beta}
+	wm := &treasuryTestWithdrawManager{}
+	m, err := SetupTreasuryManager(treasuryTestConfig(), em, wm)
+	require.NoError(t, err, "SetupTreasuryManager must not error")
+	var prepared int
+	m.prepareRequest = func(*withdraw.Request) error { prepared++; return nil }
+
+	_, err = m.Rebalance(t.Context())
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+	require.NoError(t, m.Start(t.Context()), "Start must not error")
+	t.Cleanup(func() { assert.NoError(t, m.Stop(), "Stop should not error") })
+
+	planned, err := m.Plan(t.Context())
+	require.NoError(t, err, "Plan must not error")
+	require.Len(t, planned, 1, "Plan must return the planned transfer")
+	assert.Equal(t, "btc", planned[0].Chain, "Plan should return the chain the transfer would use")
+	assert.Empty(t, wm.requests, "Plan should not submit withdrawals")
+
+	transfers, err := m.Rebalance(t.Context())
+	require.NoError(t, err, "Rebalance must not error")
+	require.Len(t, transfers, 1, "Rebalance must submit a transfer")
+	tr := transfers[0]
+	assert.Equal(t, TreasuryTransferInTransit, tr.Status, "Rebalance should track the transfer until it arrives")
+	assert.Equal(t, 4.0, tr.Amount, "Rebalance should transfer the surplus")
+	require.Len(t, wm.requests, 1, "Rebalance must submit the withdrawal")
+	req := wm.requests[0]
+	assert.Equal(t, "alpha", req.Exchange, "Rebalance should withdraw from the surplus exchange")
+	assert.Equal(t, "betabtcbtc", req.Crypto.Address, "Rebalance should withdraw to the destination deposit address")
+	assert.Equal(t, "btc", req.Crypto.Chain, "Rebalance should withdraw over the cheapest common chain")
+	assert.Equal(t, TreasuryManagerName, req.RequestedBy, "Rebalance should identify itself as the requester")
+	assert.Equal(t, 1, prepared, "Rebalance should set the withdrawal secrets")
+
+	alpha.balance = 5
+	_, err = m.Rebalance(t.Context())
+	require.NoError(t, err, "Rebalance must not error")
+	assert.Len(t, wm.requests, 1, "Rebalance should not rebalance a currency with transfers in transit")
+
+	beta.balance = 1 + 4 - 0.0005
+	_, err = m.Rebalance(t.Context())
+	require.NoError(t, err, "Rebalance must not error")
+	transfers, err = m.GetTransfers()
+	require.NoError(t, err, "GetTransfers must not error")
+	require.Len(t, transfers, 1, "GetTransfers must return the transfer")
+	assert.Equal(t, TreasuryTransferSettled, transfers[0].Status, "Rebalance should settle transfers which have arrived")
+	assert.False(t, transfers[0].SettledAt.IsZero(), "Rebalance should record when the transfer settled")
+
+	alpha.balance, beta.balance = 1, 9
+	beta.withdrawErr = errTreasuryTest
+	transfers, err = m.Rebalance(t.Context())
+	require.NoError(t, err, "Rebalance must not error")
+	require.Len(t, transfers, 1, "Rebalance must return the failed transfer")
+	assert.Equal(t, TreasuryTransferFailed, transfers[0].Status, "Rebalance should fail transfers which cannot be withdrawn")
+	assert.Contains(t, transfers[0].Error, errTreasuryTest.Error(), "Rebalance should record why the transfer failed")
+	assert.Len(t, wm.requests, 1, "Rebalance should not submit withdrawals which cannot be made")
+
+	beta.withdrawErr = nil
+	m.settlementTimeout = time.Nanosecond
+	_, err = m.Rebalance(t.Context())
+	require.NoError(t, err, "Rebalance must not error")
+	_, err = m.Rebalance(t.Context())
+	require.NoError(t, err, "Rebalance must not error")
+	transfers, err = m.GetTransfers()
+	require.NoError(t, err, "GetTransfers must not error")
+	assert.True(t, slices.ContainsFunc(transfers, func(tr TreasuryTransfer) bool {
+		return tr.Status == TreasuryTransferTimedOut
+	}), "Rebalance should stop tracking transfers which have not arrived in time")
+}
+
+func TestTreasuryManagerApprovals(t *testing.T) {
+	t.Parallel()
+	alpha := &treasuryTestExchange{name: "alpha", balance: 9, chains: []string{"btc"}}
+	beta := &treasuryTestExchange{name: "beta", balance: 1, chains: []string{"btc"}}
+	wm := &treasuryTestWithdrawManager{approval: true, events: make(map[string]*withdraw.Response)}
+	m, err := SetupTreasuryManager(treasuryTestConfig(), treasuryTestExchangeManager{"alpha": alpha, "beta": beta}, wm)
+	require.NoError(t, err, "SetupTreasuryManager must not error")
+	require.NoError(t, m.Start(t.Context()), "Start must not error")
+	t.Cleanup(func() { assert.NoError(t, m.Stop(), "Stop should not error") })
+
+	transfers, err := m.Rebalance(t.Context())
+	require.NoError(t, err, "Rebalance must not error")
+	require.Len(t, transfers, 1, "Rebalance must submit a transfer")
+	assert.Equal(t, TreasuryTransferPendingApproval, transfers[0].Status, "Rebalance should hold transfers awaiting approval")
+	assert.True(t, transfers[0].SubmittedAt.IsZero(), "Rebalance should not record a submission before approval")
+	id := transfers[0].ID
+
+	transfers, err = m.Rebalance(t.Context())
+	require.NoError(t, err, "Rebalance must not error")
+	assert.Empty(t, transfers, "Rebalance should not rebalance a currency with transfers awaiting approval")
+	assert.Len(t, wm.requests, 1, "Rebalance should not submit withdrawals awaiting approval again")
+
+	wm.pending = nil
+	wm.events[id.String()] = &withdraw.Response{ID: id, Exchange: withdraw.ExchangeResponse{ID: "1337", Status: "submitted"}}
+	_, err = m.Rebalance(t.Context())
+	require.NoError(t, err, "Rebalance must not error")
+	transfers, err = m.GetTransfers()
+	require.NoError(t, err, "GetTransfers must not error")
+	require.Len(t, transfers, 1, "GetTransfers must return the transfer")
+	assert.Equal(t, TreasuryTransferInTransit, transfers[0].Status, "Rebalance should track approved transfers until they arrive")
+	assert.Equal(t, "submitted", transfers[0].WithdrawalStatus, "Rebalance should record the status of the approved withdrawal")
+	assert.False(t, transfers[0].SubmittedAt.IsZero(), "Rebalance should record when the approved withdrawal was submitted")
+
+	alpha.balance, beta.balance = 5, 5
+	transfers, err = m.Rebalance(t.Context())
+	require.NoError(t, err, "Rebalance must not error")
+	assert.Empty(t, transfers, "Rebalance should not rebalance a settled currency within its bands")
+
+	alpha.balance, beta.balance = 9, 1
+	transfers, err = m.Rebalance(t.Context())
+	require.NoError(t, err, "Rebalance must not error")
+	require.Len(t, transfers, 1, "Rebalance must submit a transfer")
+	wm.pending = nil
+	failed := transfers[0].ID
+	wm.events[failed.String()] = &withdraw.Response{ID: failed, Exchange: withdraw.ExchangeResponse{Status: "insufficient funds"}}
+	transfers, err = m.Rebalance(t.Context())
+	require.NoError(t, err, "Rebalance must not error")
+	require.Len(t, transfers, 1, "Rebalance must submit a transfer once the approved withdrawal has failed")
+
+	wm.pending = nil
+	_, err = m.Rebalance(t.Context())
+	require.NoError(t, err, "Rebalance must not error")
+	transfers, err = m.GetTransfers()
+	require.NoError(t, err, "GetTransfers must not error")
+	statuses := make(map[uuid.UUID]TreasuryTransfer, len(transfers))
+	for _, tr := range transfers {
+		statuses[tr.ID] = tr
+	}
+	assert.Equal(t, TreasuryTransferFailed, statuses[failed].Status, "Rebalance should fail transfers whose approved withdrawal failed")
+	assert.Contains(t, statuses[failed].Error, "insufficient funds", "Rebalance should record why the approved withdrawal failed")
+	assert.True(t, slices.ContainsFunc(transfers, func(tr TreasuryTransfer) bool {
+		return tr.Status == TreasuryTransferNotApproved
+	}), "Rebalance should stop tracking transfers which were rejected or expired")
+}
+
+func TestTreasuryManagerDryRun(t *testing.T) {
+	t.Parallel()
+	em := treasuryTestExchangeManager{
+		"alpha": {name: "alpha", balance: 9},
+		"beta":  {name: "beta", balance: 1},
+	}
+	m, err := SetupTreasuryManager(treasuryTestConfig(), em, &treasuryTestWithdrawManager{dryRun: true})
+	require.NoError(t, err, "SetupTreasuryManager must not error")
+	require.NoError(t, m.Start(t.Context()), "Start must not error")
+	t.Cleanup(func() { assert.NoError(t, m.Stop(), "Stop should not error") })
+	transfers, err := m.Rebalance(t.Context())
+	require.NoError(t, err, "Rebalance must not error")
+	require.Len(t, transfers, 1, "Rebalance must submit a transfer")
+	assert.Equal(t, TreasuryTransferDryRun, transfers[0].Status, "Rebalance should not track dry run transfers")
+	assert.Empty(t, transfers[0].Chain, "Rebalance should use the default chain when neither exchange lists chains")
+}
@@ -0,0 +1,102 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
+)
+
+// TreasuryManagerName is an exported subsystem name
+const TreasuryManagerName = "treasury_manager"
+
+// Treasury transfer statuses
+const (
+	TreasuryTransferPendingApproval = "pending approval"
+	TreasuryTransferNotApproved     = "not approved"
+	TreasuryTransferInTransit       = "in transit"
+	TreasuryTransferSettled         = "settled"
+	TreasuryTransferTimedOut        = "timed out"
+	TreasuryTransferFailed          = "failed"
+	TreasuryTransferDryRun          = "dry run"
+)
+
+// treasurySettlementTolerance is the share of a transfer which may go missing
+// on arrival before it is settled, allowing for withdrawal fees which were not
+// covered by the chain fee estimate
+const treasurySettlementTolerance = 0.01
+
+var (
+	errNilTreasuryManagerConfig = errors.New("nil treasury manager config received")
+	errNilWithdrawManager       = errors.New("cannot start with nil withdraw manager")
+	errNoCommonTransferChain    = errors.New("no transfer chain supported by both exchanges")
+	errInvalidSettlementTimeout = errors.New("invalid settlement timeout")
+	errApprovedWithdrawalFailed = errors.New("approved withdrawal failed")
+)
+
+// TreasuryManager keeps inventory spread across exchanges within the target
+// allocation bands of each currency, moving funds between exchanges through
+// the withdraw manager and tracking each transfer until it has arrived
+type TreasuryManager struct {
+	started           atomic.Bool
+	verbose           bool
+	interval          time.Duration
+	settlementTimeout time.Duration
+	targets           []config.TreasuryTarget
+	exchangeManager   iExchangeManager
+	withdrawManager   iWithdrawManager
+	// prepareRequest sets the withdrawal secrets of an exchange on a request
+	prepareRequest func(*withdraw.Request) error
+	transfers      []*TreasuryTransfer
+	m              sync.Mutex
+	shutdown       chan struct{}
+	wg             sync.WaitGroup
+}
+
+// TreasuryTransfer is a movement of funds between two exchanges made to bring
+// a currency back within its target allocations
+type TreasuryTransfer struct {
+	ID       uuid.UUID
+	Currency currency.Code
+	From     string
+	To       string
+	Chain    string
+	Address  string
+	Amount   float64
+	// Fee is the estimated withdrawal fee of the chain used
+	Fee              float64
+	Status           string
+	WithdrawalStatus string
+	Error            string
+	// SubmittedAt is when the withdrawal was submitted to the exchange, which
+	// is once it is approved when the withdraw manager requires approvals
+	SubmittedAt time.Time
+	SettledAt   time.Time
+
+	// baseline is the destination balance when the transfer was submitted
+	baseline float64
+}
+
+// treasuryHolding is the balance of a currency held on an exchange and its
+// target allocation
+type treasuryHolding struct {
+	exchange string
+	total    float64
+	free     float64
+	target   float64
+	band     float64
+}
+
+// treasuryChain is a transfer chain supported by both exchanges, spelt as
+// each exchange expects it
+type treasuryChain struct {
+	withdraw string
+	deposit  string
+	fee      float64
+	feeKnown bool
+}
@@ -2,12 +2,21 @@ package engine
 
 import (
 	"context"
+	"crypto/subtle"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
+	"github.com/gofrs/uuid"
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
 	dbwithdraw "github.com/thrasher-corp/gocryptotrader/database/repository/withdraw"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/withdrawaddress"
+	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/currencystate"
 	"github.com/thrasher-corp/gocryptotrader/log"
@@ -26,8 +35,30 @@ func SetupWithdrawManager(em iExchangeManager, pm iPortfolioManager, isDryRun bo
 	}, nil
 }
 
+// UpdateConfig replaces the withdrawal safeguards. Pending withdrawals still
+// need the number of approvals required when they were requested
+func (m *WithdrawManager) UpdateConfig(cfg *config.WithdrawManager) error {
+	if m == nil {
+		return fmt.Errorf("withdraw manager %w", ErrNilSubsystem)
+	}
+	if cfg == nil {
+		return fmt.Errorf("%w WithdrawManager", errNilConfig)
+	}
+	m.setConfig(cfg)
+	return nil
+}
+
+// setConfig replaces the withdrawal approval settings and limits
+func (m *WithdrawManager) setConfig(cfg *config.WithdrawManager) {
+	m.mu.Lock()
+	m.cfg = *cfg
+	m.cfg.Limits = slices.Clone(cfg.Limits)
+	m.mu.Unlock()
+}
+
 // SubmitWithdrawal performs validation and submits a new withdraw request to
-// exchange
+// exchange. When approvals are required the withdrawal is held and returned
+// with a pending approval status instead
 func (m *WithdrawManager) SubmitWithdrawal(ctx context.Context, req *withdraw.Request) (*withdraw.Response, error) {
 	if m == nil {
 		return nil, ErrNilSubsystem
@@ -54,12 +85,276 @@ func (m *WithdrawManager) SubmitWithdrawal(ctx context.Context, req *withdraw.Re
 		return nil, errF
 	}
 
+	if err := m.checkAddressBook(ctx, req); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	m.mu.Lock()
+	m.purgeExpired(now)
+	if err := m.checkLimits(req, now); err != nil {
+		m.mu.Unlock()
+		return nil, err
+	}
+	if m.cfg.RequiredApprovals > 0 {
+		if req.RequestedBy == "" {
+			m.mu.Unlock()
+			return nil, errRequestedByNotSet
+		}
+		p := &PendingWithdrawal{
+			Request:           *req,
+			RequestedAt:       now,
+			ExpiresAt:         now.Add(m.cfg.ApprovalTimeout),
+			RequiredApprovals: m.cfg.RequiredApprovals,
+		}
+		if p.ID, err = uuid.NewV4(); err != nil {
+			m.mu.Unlock()
+			return nil, err
+		}
+		if creds, err := exch.GetCredentials(ctx); err == nil {
+			c := *creds
+			p.credentials = &c
+		}
+		if m.pending == nil {
+			m.pending = make(map[uuid.UUID]*PendingWithdrawal)
+		}
+		m.pending[p.ID] = p
+		m.mu.Unlock()
+		log.Infof(log.Global, "Withdrawal %s of %v %s from %s requires %d approvals before %s",
+			p.ID, req.Amount, req.Currency, req.Exchange, p.RequiredApprovals, p.ExpiresAt.Format(time.RFC3339))
+		return p.response(), nil
+	}
+	w := m.reserve(req, now)
+	m.mu.Unlock()
+
+	resp, err = m.submit(ctx, exch, resp)
+	if err != nil {
+		m.release(w)
+	}
+	return resp, err
+}
+
+// AuthenticateApprover checks the token of a configured approver. Approvals
+// made over gRPC must be authenticated before being recorded
+func (m *WithdrawManager) AuthenticateApprover(approver, token string) error {
+	if m == nil {
+		return ErrNilSubsystem
+	}
+	if approver == "" {
+		return errApproverNotSet
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	a, err := m.getApprover(approver)
+	if err != nil {
+		return err
+	}
+	if token == "" || a.Token == "" || subtle.ConstantTimeCompare([]byte(token), []byte(a.Token)) != 1 {
+		return fmt.Errorf("%w for %q", errInvalidApproverToken, approver)
+	}
+	return nil
+}
+
+// AuthenticateRequester checks the token of the approver named as the requester
+// of a withdrawal made over gRPC. While withdrawals require approval the
+// requester must be a configured approver, so nobody can approve a withdrawal
+// they requested by naming someone else as its requester
+func (m *WithdrawManager) AuthenticateRequester(requestedBy, token string) error {
+	if m == nil {
+		return ErrNilSubsystem
+	}
+	m.mu.Lock()
+	required := m.cfg.RequiredApprovals
+	m.mu.Unlock()
+	if required <= 0 {
+		return nil
+	}
+	if requestedBy == "" {
+		return errRequestedByNotSet
+	}
+	return m.AuthenticateApprover(requestedBy, token)
+}
+
+// ApproveWithdrawal records an approval of a pending withdrawal by a
+// configured approver. The withdrawal is submitted to the exchange once it has
+// the required approvals, otherwise it is returned with a pending approval
+// status
+func (m *WithdrawManager) ApproveWithdrawal(ctx context.Context, id, approver string) (*withdraw.Response, error) {
+	if m == nil {
+		return nil, ErrNilSubsystem
+	}
+	if approver == "" {
+		return nil, errApproverNotSet
+	}
+
+	now := time.Now()
+	m.mu.Lock()
+	if _, err := m.getApprover(approver); err != nil {
+		m.mu.Unlock()
+		return nil, err
+	}
+	p, err := m.getPending(id, now)
+	if err != nil {
+		m.mu.Unlock()
+		return nil, err
+	}
+	if strings.EqualFold(approver, p.Request.RequestedBy) || slices.ContainsFunc(p.Approvals, func(a WithdrawalApproval) bool {
+		return strings.EqualFold(approver, a.Approver)
+	}) {
+		m.mu.Unlock()
+		return nil, fmt.Errorf("%w %q", errDuplicateApprover, approver)
+	}
+	p.Approvals = append(p.Approvals, WithdrawalApproval{Approver: approver, Time: now})
+	if len(p.Approvals) < p.RequiredApprovals {
+		resp := p.response()
+		m.mu.Unlock()
+		log.Infof(log.Global, "Withdrawal %s approved by %s, %d of %d approvals", p.ID, approver, len(p.Approvals), p.RequiredApprovals)
+		return resp, nil
+	}
+	delete(m.pending, p.ID)
+	w := m.reserve(&p.Request, now)
+	m.mu.Unlock()
+	log.Infof(log.Global, "Withdrawal %s approved by %s and is being submitted", p.ID, approver)
+
+	resp, err := m.submitApproved(ctx, p)
+	if err != nil {
+		m.release(w)
+	}
+	return resp, err
+}
+
+// RejectWithdrawal discards a pending withdrawal on behalf of a configured
+// approver
+func (m *WithdrawManager) RejectWithdrawal(id, approver string) error {
+	if m == nil {
+		return ErrNilSubsystem
+	}
+	if approver == "" {
+		return errApproverNotSet
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, err := m.getApprover(approver); err != nil {
+		return err
+	}
+	p, err := m.getPending(id, time.Now())
+	if err != nil {
+		return err
+	}
+	delete(m.pending, p.ID)
+	log.Infof(log.Global, "Withdrawal %s rejected by %s", p.ID, approver)
+	return nil
+}
+
+// GetPendingWithdrawals returns the withdrawals awaiting approval, oldest
+// first
+func (m *WithdrawManager) GetPendingWithdrawals() ([]PendingWithdrawal, error) {
+	if m == nil {
+		return nil, ErrNilSubsystem
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.purgeExpired(time.Now())
+	resp := make([]PendingWithdrawal, 0, len(m.pending))
+	for _, p := range m.pending {
+		cpy := *p
+		cpy.Approvals = slices.Clone(p.Approvals)
+		cpy.credentials = nil
+		resp = append(resp, cpy)
+	}
+	slices.SortFunc(resp, func(a, b PendingWithdrawal) int {
+		return a.RequestedAt.Compare(b.RequestedAt)
+	})
+	return resp, nil
+}
+
+// RegisterCommands adds chat commands to list, approve and reject pending
+// withdrawals. The relayer user, authenticated by its platform, is recorded as
+// the approver and must be one of the configured approvers
+func (m *WithdrawManager) RegisterCommands(c *CommunicationManager) error {
+	if m == nil {
+		return ErrNilSubsystem
+	}
+	if err := c.RegisterCommand("pendingwithdrawals", func(string, []string) (string, error) {
+		pending, err := m.GetPendingWithdrawals()
+		if err != nil {
+			return "", err
+		}
+		if len(pending) == 0 {
+			return "No withdrawals are awaiting approval", nil
+		}
+		lines := make([]string, len(pending))
+		for i := range pending {
+			lines[i] = pending[i].String()
+		}
+		return strings.Join(lines, "\n"), nil
+	}); err != nil {
+		return err
+	}
+	if err := c.RegisterCommand("approvewithdrawal", func(user string, args []string) (string, error) {
+		if len(args) != 1 {
+			return "", errWithdrawalIDNotSet
+		}
+		resp, err := m.ApproveWithdrawal(context.Background(), args[0], user)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("Withdrawal %s status: %s", args[0], resp.Exchange.Status), nil
+	}); err != nil {
+		return err
+	}
+	return c.RegisterCommand("rejectwithdrawal", func(user string, args []string) (string, error) {
+		if len(args) != 1 {
+			return "", errWithdrawalIDNotSet
+		}
+		if err := m.RejectWithdrawal(args[0], user); err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("Withdrawal %s rejected", args[0]), nil
+	})
+}
+
+// submitApproved submits a withdrawal which has received its approvals,
+// using the credentials it was requested with
+func (m *WithdrawManager) submitApproved(ctx context.Context, p *PendingWithdrawal) (*withdraw.Response, error) {
+	exch, err := m.exchangeManager.GetExchangeByName(p.Request.Exchange)
+	if err != nil {
+		return nil, err
+	}
+	// The address may have been removed from the address book while pending
+	if err := m.checkAddressBook(ctx, &p.Request); err != nil {
+		return nil, err
+	}
+	if m.prepareRequest != nil && !m.isDryRun {
+		if err := m.prepareRequest(&p.Request); err != nil {
+			return nil, err
+		}
+	}
+	if p.credentials != nil {
+		ctx = accounts.DeployCredentialsToContext(ctx, p.credentials)
+	}
+	return m.submit(ctx, exch, &withdraw.Response{
+		ID: p.ID,
+		Exchange: withdraw.ExchangeResponse{
+			Name: p.Request.Exchange,
+		},
+		RequestDetails: p.Request,
+	})
+}
+
+// submit sends a validated withdrawal to the exchange, or records a dry run
+func (m *WithdrawManager) submit(ctx context.Context, exch exchange.IBotExchange, resp *withdraw.Response) (*withdraw.Response, error) {
+	req := &resp.RequestDetails
+	var err error
 	if m.isDryRun {
 		log.Warnln(log.Global, "Dry run enabled, no withdrawal request will be submitted or have an event created")
 		resp.ID = withdraw.DryRunID
 		resp.Exchange.Status = "dryrun"
 		resp.Exchange.ID = withdraw.DryRunID.String()
 	} else {
+		if err := CheckCredentialAccess(ctx, exch, accounts.WithdrawAccess); err != nil {
+			return nil, err
+		}
 		var ret *withdraw.ExchangeResponse
 		if req.Type == withdraw.Crypto {
 			if !m.portfolioManager.IsWhiteListed(req.Crypto.Address) {
@@ -92,6 +387,189 @@ func (m *WithdrawManager) SubmitWithdrawal(ctx context.Context, req *withdraw.Re
 	return resp, err
 }
 
+// checkAddressBook rejects crypto withdrawals to addresses which are not in
+// the address book when it is enforced
+func (m *WithdrawManager) checkAddressBook(ctx context.Context, req *withdraw.Request) error {
+	m.mu.Lock()
+	enforce := m.cfg.EnforceAddressBook
+	m.mu.Unlock()
+	if !enforce || req.Type != withdraw.Crypto {
+		return nil
+	}
+	if _, err := withdrawaddress.Get(ctx, req.Currency.String(), req.Crypto.Chain, req.Crypto.Address, req.Crypto.AddressTag); err != nil {
+		return fmt.Errorf("%w: %w", ErrWithdrawalAddressNotApproved, err)
+	}
+	return nil
+}
+
+// checkLimits rejects withdrawals above the per transaction limit of their
+// currency, or which take the amount withdrawn and awaiting approval over the
+// last 24 hours above the daily limit. m.mu must be held
+func (m *WithdrawManager) checkLimits(req *withdraw.Request, now time.Time) error {
+	for _, l := range m.cfg.Limits {
+		if !l.Currency.Equal(req.Currency) {
+			continue
+		}
+		if l.PerTransaction > 0 && req.Amount > l.PerTransaction {
+			return fmt.Errorf("%w: %v %s is above the per transaction limit of %v",
+				ErrWithdrawalLimitExceeded, req.Amount, req.Currency, l.PerTransaction)
+		}
+		if l.Daily > 0 {
+			total := m.withdrawnSince(req.Currency, now.Add(-withdrawVelocityWindow)) + req.Amount
+			if total > l.Daily {
+				return fmt.Errorf("%w: %v %s would take the 24 hour total to %v, above the daily limit of %v",
+					ErrWithdrawalLimitExceeded, req.Amount, req.Currency, total, l.Daily)
+			}
+		}
+	}
+	return nil
+}
+
+// withdrawnSince returns the amount of the currency withdrawn since the time,
+// including withdrawals awaiting approval. m.mu must be held
+func (m *WithdrawManager) withdrawnSince(c currency.Code, since time.Time) float64 {
+	var total float64
+	for _, w := range m.withdrawn {
+		if w.currency == c.Item && !w.time.Before(since) {
+			total += w.amount
+		}
+	}
+	for _, p := range m.pending {
+		if p.Request.Currency.Equal(c) {
+			total += p.Request.Amount
+		}
+	}
+	return total
+}
+
+// reserve counts a withdrawal towards the daily limits before it is submitted
+// so that concurrent requests cannot exceed them. Dry runs are not counted.
+// m.mu must be held
+func (m *WithdrawManager) reserve(req *withdraw.Request, now time.Time) *withdrawnAmount {
+	since := now.Add(-withdrawVelocityWindow)
+	m.withdrawn = slices.DeleteFunc(m.withdrawn, func(w *withdrawnAmount) bool {
+		return w.time.Before(since)
+	})
+	if m.isDryRun {
+		return nil
+	}
+	w := &withdrawnAmount{currency: req.Currency.Item, amount: req.Amount, time: now}
+	m.withdrawn = append(m.withdrawn, w)
+	return w
+}
+
+// release stops counting a withdrawal which failed towards the daily limits
+func (m *WithdrawManager) release(w *withdrawnAmount) {
+	if w == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.withdrawn = slices.DeleteFunc(m.withdrawn, func(x *withdrawnAmount) bool {
+		return x == w
+	})
+}
+
+// getApprover returns the configured approver by name. m.mu must be held
+func (m *WithdrawManager) getApprover(name string) (*config.WithdrawApprover, error) {
+	for i := range m.cfg.Approvers {
+		if strings.EqualFold(m.cfg.Approvers[i].Name, name) {
+			return &m.cfg.Approvers[i], nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %q", errApproverNotAllowed, name)
+}
+
+// loadWithdrawn seeds the daily withdrawal limits with the withdrawals stored
+// in the database over the last 24 hours, so that they are still enforced
+// after a restart. Failed withdrawals and dry runs are not counted
+func (m *WithdrawManager) loadWithdrawn(now time.Time) error {
+	if m == nil {
+		return ErrNilSubsystem
+	}
+	since := now.Add(-withdrawVelocityWindow)
+	// The range is widened as stored timestamps are compared as strings on
+	// some databases, the exact window is applied below
+	events, err := dbwithdraw.GetEventsByDate("", since.Add(-withdrawVelocityWindow), now.Add(withdrawVelocityWindow), withdrawHistoryLimit)
+	if err != nil {
+		if errors.Is(err, common.ErrNoResults) {
+			return nil
+		}
+		return err
+	}
+	m.addWithdrawn(events, now)
+	return nil
+}
+
+// addWithdrawn counts the stored withdrawal events made over the 24 hours
+// before now towards the daily withdrawal limits
+func (m *WithdrawManager) addWithdrawn(events []*withdraw.Response, now time.Time) {
+	since := now.Add(-withdrawVelocityWindow)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, e := range events {
+		if e.Exchange.ID == "" || e.ID == withdraw.DryRunID || e.CreatedAt.Before(since) || e.CreatedAt.After(now) {
+			continue
+		}
+		m.withdrawn = append(m.withdrawn, &withdrawnAmount{
+			currency: e.RequestDetails.Currency.Item,
+			amount:   e.RequestDetails.Amount,
+			time:     e.CreatedAt,
+		})
+	}
+}
+
+// getPending returns a pending withdrawal by ID. m.mu must be held
+func (m *WithdrawManager) getPending(id string, now time.Time) (*PendingWithdrawal, error) {
+	m.purgeExpired(now)
+	uid, err := uuid.FromString(id)
+	if err != nil {
+		return nil, fmt.Errorf("%w %q", ErrPendingWithdrawalNotFound, id)
+	}
+	p, ok := m.pending[uid]
+	if !ok {
+		return nil, fmt.Errorf("%w %q", ErrPendingWithdrawalNotFound, id)
+	}
+	return p, nil
+}
+
+// purgeExpired discards pending withdrawals which were not approved in time.
+// m.mu must be held
+func (m *WithdrawManager) purgeExpired(now time.Time) {
+	for id, p := range m.pending {
+		if now.After(p.ExpiresAt) {
+			delete(m.pending, id)
+			log.Warnf(log.Global, "Withdrawal %s of %v %s from %s expired before receiving %d approvals",
+				id, p.Request.Amount, p.Request.Currency, p.Request.Exchange, p.RequiredApprovals)
+		}
+	}
+}
+
+// response returns the withdrawal response of a pending withdrawal
+func (p *PendingWithdrawal) response() *withdraw.Response {
+	return &withdraw.Response{
+		ID: p.ID,
+		Exchange: withdraw.ExchangeResponse{
+			Name:   p.Request.Exchange,
+			Status: WithdrawStatusPendingApproval,
+		},
+		RequestDetails: p.Request,
+		CreatedAt:      p.RequestedAt,
+		UpdatedAt:      p.RequestedAt,
+	}
+}
+
+// String returns a summary of the pending withdrawal
+func (p *PendingWithdrawal) String() string {
+	dest := p.Request.Crypto.Address
+	if p.Request.Type == withdraw.Fiat {
+		dest = p.Request.Fiat.Bank.AccountName
+	}
+	return fmt.Sprintf("%s: %v %s from %s to %s, %d of %d approvals, expires %s",
+		p.ID, p.Request.Amount, p.Request.Currency, p.Request.Exchange, dest,
+		len(p.Approvals), p.RequiredApprovals, p.ExpiresAt.Format(time.RFC3339))
+}
+
 // WithdrawalEventByID returns a withdrawal request by ID
 func (m *WithdrawManager) WithdrawalEventByID(id string) (*withdraw.Response, error) {
 	if m == nil {
@@ -9,7 +9,10 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/okx"
 	"github.com/thrasher-corp/gocryptotrader/portfolio"
@@ -94,7 +97,7 @@ func TestSubmitWithdrawal(t *testing.T) {
 	if err != nil {
 		t.Error(err)
 	}
-	err = pm.AddAddress("1337", "", req.Currency, 1337)
+	err = pm.AddAddress("1337", "", req.Currency, "", 1337)
 	if err != nil {
 		t.Error(err)
 	}
@@ -117,6 +120,270 @@ func TestSubmitWithdrawal(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestSubmitWithdrawalAccessControl(t *testing.T) {
+	t.Parallel()
+	em, _ := accessControlTestExchange(t, accounts.TradeAccess)
+	m, err := SetupWithdrawManager(em, nil, false)
+	require.NoError(t, err, "SetupWithdrawManager must not error")
+	_, err = m.SubmitWithdrawal(t.Context(), &withdraw.Request{
+		Exchange: testExchange,
+		Currency: currency.BTC,
+		Amount:   1,
+		Type:     withdraw.Crypto,
+		Crypto:   withdraw.CryptoRequest{Address: "1337"},
+	})
+	require.ErrorIs(t, err, accounts.ErrAccessDenied)
+}
+
+func TestWithdrawManagerUpdateConfig(t *testing.T) {
+	t.Parallel()
+	var m *WithdrawManager
+	assert.ErrorIs(t, m.UpdateConfig(&config.WithdrawManager{}), ErrNilSubsystem)
+
+	m, err := SetupWithdrawManager(NewExchangeManager(), nil, false)
+	require.NoError(t, err, "SetupWithdrawManager must not error")
+	assert.ErrorIs(t, m.UpdateConfig(nil), errNilConfig)
+
+	cfg := &config.WithdrawManager{RequiredApprovals: 1, Limits: []config.WithdrawLimit{{Currency: currency.BTC, Daily: 1}}}
+	require.NoError(t, m.UpdateConfig(cfg), "UpdateConfig must not error")
+	cfg.Limits[0].Daily = 5
+	assert.Equal(t, 1.0, m.cfg.Limits[0].Daily, "UpdateConfig should copy the limits")
+	assert.Equal(t, 1, m.cfg.RequiredApprovals, "UpdateConfig should store the config")
+}
+
+func TestSubmitWithdrawalSafeguards(t *testing.T) {
+	t.Parallel()
+	em, _ := accessControlTestExchange(t, accounts.WithdrawAccess)
+	m, err := SetupWithdrawManager(em, nil, true)
+	require.NoError(t, err, "SetupWithdrawManager must not error")
+	require.NoError(t, m.UpdateConfig(&config.WithdrawManager{
+		EnforceAddressBook: true,
+		ApprovalTimeout:    time.Hour,
+		Limits:             []config.WithdrawLimit{{Currency: currency.BTC, PerTransaction: 1, Daily: 2}},
+	}), "UpdateConfig must not error")
+	req := &withdraw.Request{
+		Exchange: testExchange,
+		Currency: currency.BTC,
+		Amount:   1,
+		Type:     withdraw.Crypto,
+		Crypto:   withdraw.CryptoRequest{Address: "1337"},
+	}
+
+	_, err = m.SubmitWithdrawal(t.Context(), req)
+	assert.ErrorIs(t, err, ErrWithdrawalAddressNotApproved, "SubmitWithdrawal should reject addresses which cannot be found in the address book")
+
+	m.cfg.EnforceAddressBook = false
+	req.Amount = 1.5
+	_, err = m.SubmitWithdrawal(t.Context(), req)
+	assert.ErrorIs(t, err, ErrWithdrawalLimitExceeded, "SubmitWithdrawal should enforce the per transaction limit")
+
+	req.Amount = 1
+	resp, err := m.SubmitWithdrawal(t.Context(), req)
+	require.NoError(t, err, "SubmitWithdrawal must not error")
+	assert.Equal(t, "dryrun", resp.Exchange.Status, "SubmitWithdrawal should submit without required approvals")
+	assert.Empty(t, m.withdrawn, "dry runs should not count towards the daily limit")
+
+	m.isDryRun = false
+	m.reserve(req, time.Now().Add(-withdrawVelocityWindow-time.Minute))
+	m.reserve(req, time.Now())
+	m.isDryRun = true
+	_, err = m.SubmitWithdrawal(t.Context(), req)
+	require.NoError(t, err, "SubmitWithdrawal must not error")
+	assert.Len(t, m.withdrawn, 1, "withdrawals older than a day should be discarded")
+
+	m.cfg.RequiredApprovals = 1
+	_, err = m.SubmitWithdrawal(t.Context(), req)
+	assert.ErrorIs(t, err, errRequestedByNotSet, "SubmitWithdrawal should require the requester when approvals are required")
+	req.RequestedBy = "alice"
+	resp, err = m.SubmitWithdrawal(t.Context(), req)
+	require.NoError(t, err, "SubmitWithdrawal must not error")
+	assert.Equal(t, WithdrawStatusPendingApproval, resp.Exchange.Status, "SubmitWithdrawal should hold withdrawals which require approval")
+	_, err = m.SubmitWithdrawal(t.Context(), req)
+	assert.ErrorIs(t, err, ErrWithdrawalLimitExceeded, "SubmitWithdrawal should count pending withdrawals towards the daily limit")
+
+	m.release(m.withdrawn[0])
+	assert.Empty(t, m.withdrawn, "release should stop counting a withdrawal")
+}
+
+func TestWithdrawalApprovals(t *testing.T) {
+	t.Parallel()
+	em, _ := accessControlTestExchange(t, accounts.WithdrawAccess)
+	m, err := SetupWithdrawManager(em, nil, true)
+	require.NoError(t, err, "SetupWithdrawManager must not error")
+	require.NoError(t, m.UpdateConfig(&config.WithdrawManager{
+		RequiredApprovals: 2,
+		ApprovalTimeout:   time.Hour,
+		Approvers:         []config.WithdrawApprover{{Name: "alice"}, {Name: "bob"}, {Name: "carol"}, {Name: "dave"}},
+	}), "UpdateConfig must not error")
+	req := &withdraw.Request{
+		Exchange:    testExchange,
+		Currency:    currency.BTC,
+		Amount:      1,
+		Type:        withdraw.Crypto,
+		Crypto:      withdraw.CryptoRequest{Address: "1337"},
+		RequestedBy: "alice",
+	}
+	resp, err := m.SubmitWithdrawal(t.Context(), req)
+	require.NoError(t, err, "SubmitWithdrawal must not error")
+	id := resp.ID.String()
+	assert.NotNil(t, m.pending[resp.ID].credentials, "SubmitWithdrawal should keep the credentials a withdrawal was requested with")
+
+	pending, err := m.GetPendingWithdrawals()
+	require.NoError(t, err, "GetPendingWithdrawals must not error")
+	require.Len(t, pending, 1, "GetPendingWithdrawals must return the held withdrawal")
+	assert.Equal(t, resp.ID, pending[0].ID, "GetPendingWithdrawals should return the withdrawal ID")
+	assert.Equal(t, 2, pending[0].RequiredApprovals, "GetPendingWithdrawals should return the required approvals")
+
+	_, err = m.ApproveWithdrawal(t.Context(), id, "")
+	assert.ErrorIs(t, err, errApproverNotSet)
+	_, err = m.ApproveWithdrawal(t.Context(), id, "mallory")
+	assert.ErrorIs(t, err, errApproverNotAllowed, "ApproveWithdrawal should only allow configured approvers")
+	_, err = m.ApproveWithdrawal(t.Context(), "1337", "bob")
+	assert.ErrorIs(t, err, ErrPendingWithdrawalNotFound)
+	_, err = m.ApproveWithdrawal(t.Context(), id, "Alice")
+	assert.ErrorIs(t, err, errDuplicateApprover, "ApproveWithdrawal should not allow the requester to approve")
+
+	resp, err = m.ApproveWithdrawal(t.Context(), id, "bob")
+	require.NoError(t, err, "ApproveWithdrawal must not error")
+	assert.Equal(t, WithdrawStatusPendingApproval, resp.Exchange.Status, "ApproveWithdrawal should hold the withdrawal until fully approved")
+	_, err = m.ApproveWithdrawal(t.Context(), id, "bob")
+	assert.ErrorIs(t, err, errDuplicateApprover, "ApproveWithdrawal should not count an approver twice")
+
+	resp, err = m.ApproveWithdrawal(t.Context(), id, "carol")
+	require.NoError(t, err, "ApproveWithdrawal must not error")
+	assert.Equal(t, "dryrun", resp.Exchange.Status, "ApproveWithdrawal should submit a fully approved withdrawal")
+	_, err = m.ApproveWithdrawal(t.Context(), id, "dave")
+	assert.ErrorIs(t, err, ErrPendingWithdrawalNotFound, "ApproveWithdrawal should not submit a withdrawal twice")
+
+	resp, err = m.SubmitWithdrawal(t.Context(), req)
+	require.NoError(t, err, "SubmitWithdrawal must not error")
+	assert.ErrorIs(t, m.RejectWithdrawal(resp.ID.String(), ""), errApproverNotSet)
+	assert.ErrorIs(t, m.RejectWithdrawal(resp.ID.String(), "mallory"), errApproverNotAllowed, "RejectWithdrawal should only allow configured approvers")
+	require.NoError(t, m.RejectWithdrawal(resp.ID.String(), "bob"), "RejectWithdrawal must not error")
+	assert.ErrorIs(t, m.RejectWithdrawal(resp.ID.String(), "bob"), ErrPendingWithdrawalNotFound, "RejectWithdrawal should discard the withdrawal")
+
+	resp, err = m.SubmitWithdrawal(t.Context(), req)
+	require.NoError(t, err, "SubmitWithdrawal must not error")
+	m.pending[resp.ID].ExpiresAt = time.Now().Add(-time.Second)
+	_, err = m.ApproveWithdrawal(t.Context(), resp.ID.String(), "bob")
+	assert.ErrorIs(t, err, ErrPendingWithdrawalNotFound, "ApproveWithdrawal should not approve an expired withdrawal")
+	pending, err = m.GetPendingWithdrawals()
+	require.NoError(t, err, "GetPendingWithdrawals must not error")
+	assert.Empty(t, pending, "GetPendingWithdrawals should discard expired withdrawals")
+}
+
+func TestWithdrawManagerRegisterCommands(t *testing.T) {
+	t.Parallel()
+	em, _ := accessControlTestExchange(t, accounts.WithdrawAccess)
+	m, err := SetupWithdrawManager(em, nil, true)
+	require.NoError(t, err, "SetupWithdrawManager must not error")
+	require.NoError(t, m.UpdateConfig(&config.WithdrawManager{
+		RequiredApprovals: 1,
+		ApprovalTimeout:   time.Hour,
+		Approvers:         []config.WithdrawApprover{{Name: "smsglobal:bob"}},
+	}), "UpdateConfig must not error")
+	c, err := SetupCommunicationManager(&base.CommunicationsConfig{SMSGlobalConfig: base.SMSGlobalConfig{Name: "SMSGlobal", Enabled: true}})
+	require.NoError(t, err, "SetupCommunicationManager must not error")
+	require.NoError(t, m.RegisterCommands(c), "RegisterCommands must not error")
+	relayer, ok := c.comms.IComm[0].(interface {
+		HandleCommand(user, name string, args []string) (string, bool, error)
+	})
+	require.True(t, ok, "relayer must handle commands")
+
+	reply, _, err := relayer.HandleCommand("bob", "pendingwithdrawals", nil)
+	require.NoError(t, err, "pendingwithdrawals must not error")
+	assert.Equal(t, "No withdrawals are awaiting approval", reply)
+
+	resp, err := m.SubmitWithdrawal(t.Context(), &withdraw.Request{
+		Exchange:    testExchange,
+		Currency:    currency.BTC,
+		Amount:      1,
+		Type:        withdraw.Crypto,
+		Crypto:      withdraw.CryptoRequest{Address: "1337"},
+		RequestedBy: "alice",
+	})
+	require.NoError(t, err, "SubmitWithdrawal must not error")
+	reply, _, err = relayer.HandleCommand("bob", "pendingwithdrawals", nil)
+	require.NoError(t, err, "pendingwithdrawals must not error")
+	assert.Contains(t, reply, resp.ID.String(), "pendingwithdrawals should list the pending withdrawal")
+
+	_, _, err = relayer.HandleCommand("bob", "approvewithdrawal", nil)
+	assert.ErrorIs(t, err, errWithdrawalIDNotSet)
+	_, _, err = relayer.HandleCommand("mallory", "approvewithdrawal", []string{resp.ID.String()})
+	assert.ErrorIs(t, err, errApproverNotAllowed, "approvewithdrawal should only allow configured approvers")
+	reply, _, err = relayer.HandleCommand("bob", "approvewithdrawal", []string{resp.ID.String()})
+	require.NoError(t, err, "approvewithdrawal must not error")
+	assert.Contains(t, reply, "dryrun", "approvewithdrawal should submit the approved withdrawal")
+
+	resp, err = m.SubmitWithdrawal(t.Context(), &resp.RequestDetails)
+	require.NoError(t, err, "SubmitWithdrawal must not error")
+	_, _, err = relayer.HandleCommand("bob", "rejectwithdrawal", nil)
+	assert.ErrorIs(t, err, errWithdrawalIDNotSet)
+	_, _, err = relayer.HandleCommand("bob", "rejectwithdrawal", []string{resp.ID.String()})
+	require.NoError(t, err, "rejectwithdrawal must not error")
+	pending, err := m.GetPendingWithdrawals()
+	require.NoError(t, err, "GetPendingWithdrawals must not error")
+	assert.Empty(t, pending, "rejectwithdrawal should discard the withdrawal")
+}
+
+func TestAuthenticateApprover(t *testing.T) {
+	t.Parallel()
+	assert.ErrorIs(t, (*WithdrawManager)(nil).AuthenticateApprover("bob", "secret"), ErrNilSubsystem)
+
+	m := &WithdrawManager{cfg: config.WithdrawManager{Approvers: []config.WithdrawApprover{{Name: "bob", Token: "secret"}, {Name: "carol"}}}}
+	assert.ErrorIs(t, m.AuthenticateApprover("", "secret"), errApproverNotSet)
+	assert.ErrorIs(t, m.AuthenticateApprover("mallory", "secret"), errApproverNotAllowed, "AuthenticateApprover should reject unknown approvers")
+	assert.ErrorIs(t, m.AuthenticateApprover("bob", "guess"), errInvalidApproverToken, "AuthenticateApprover should reject an incorrect token")
+	assert.ErrorIs(t, m.AuthenticateApprover("bob", ""), errInvalidApproverToken, "AuthenticateApprover should reject an empty token")
+	assert.ErrorIs(t, m.AuthenticateApprover("carol", ""), errInvalidApproverToken, "AuthenticateApprover should reject approvers without a token")
+	assert.NoError(t, m.AuthenticateApprover("Bob", "secret"), "AuthenticateApprover should accept the approver's token")
+}
+
+func TestAuthenticateRequester(t *testing.T) {
+	t.Parallel()
+	assert.ErrorIs(t, (*WithdrawManager)(nil).AuthenticateRequester("bob", "secret"), ErrNilSubsystem)
+
+	m := &WithdrawManager{cfg: config.WithdrawManager{Approvers: []config.WithdrawApprover{{Name: "bob", Token: "secret"}, {Name: "alice", Token: "other"}}}}
+	assert.NoError(t, m.AuthenticateRequester("", ""), "AuthenticateRequester should not require a requester without required approvals")
+
+	m.cfg.RequiredApprovals = 1
+	assert.ErrorIs(t, m.AuthenticateRequester("", ""), errRequestedByNotSet)
+	assert.ErrorIs(t, m.AuthenticateRequester("mallory", "secret"), errApproverNotAllowed, "AuthenticateRequester should reject requesters who are not approvers")
+	assert.ErrorIs(t, m.AuthenticateRequester("alice", "secret"), errInvalidApproverToken, "AuthenticateRequester should reject a requester named with another approver's token")
+	assert.NoError(t, m.AuthenticateRequester("bob", "secret"), "AuthenticateRequester should accept the requester's token")
+}
+
+func TestLoadWithdrawn(t *testing.T) {
+	t.Parallel()
+	assert.ErrorIs(t, (*WithdrawManager)(nil).loadWithdrawn(time.Now()), ErrNilSubsystem)
+
+	now := time.Now()
+	id, err := uuid.NewV4()
+	require.NoError(t, err, "NewV4 must not error")
+	event := func(c currency.Code, amount float64, exchangeID string, created time.Time) *withdraw.Response {
+		return &withdraw.Response{
+			ID:             id,
+			Exchange:       withdraw.ExchangeResponse{ID: exchangeID},
+			RequestDetails: withdraw.Request{Currency: c, Amount: amount},
+			CreatedAt:      created,
+		}
+	}
+	dryRun := event(currency.BTC, 4, withdraw.DryRunID.String(), now)
+	dryRun.ID = withdraw.DryRunID
+	m := &WithdrawManager{}
+	m.addWithdrawn([]*withdraw.Response{
+		event(currency.BTC, 1, "1337", now.Add(-time.Hour)),
+		event(currency.ETH, 2, "1338", now.Add(-time.Minute)),
+		event(currency.BTC, 3, "", now),
+		event(currency.BTC, 5, "1339", now.Add(-withdrawVelocityWindow-time.Minute)),
+		dryRun,
+	}, now)
+	require.Len(t, m.withdrawn, 2, "addWithdrawn must only count successful withdrawals from the last day")
+	since := now.Add(-withdrawVelocityWindow)
+	assert.Equal(t, 1.0, m.withdrawnSince(currency.BTC, since), "addWithdrawn should count stored withdrawals towards the daily limit")
+	assert.Equal(t, 2.0, m.withdrawnSince(currency.ETH, since), "addWithdrawn should count stored withdrawals towards the daily limit")
+}
+
 func TestWithdrawEventByID(t *testing.T) {
 	t.Parallel()
 	em, pm := withdrawManagerTestHelper(t)
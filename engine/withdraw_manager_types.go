@@ -2,10 +2,51 @@ package engine
 
 import (
 	"errors"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
 )
 
-// ErrWithdrawRequestNotFound message to display when no record is found
-var ErrWithdrawRequestNotFound = errors.New("request not found")
+// WithdrawManagerName is an exported subsystem name
+const WithdrawManagerName = "withdraw_manager"
+
+// WithdrawStatusPendingApproval is the status of a withdrawal which is held
+// until it receives the required approvals
+const WithdrawStatusPendingApproval = "pending approval"
+
+// withdrawVelocityWindow is the rolling period daily withdrawal limits apply
+// to
+const withdrawVelocityWindow = 24 * time.Hour
+
+// withdrawHistoryLimit caps the stored withdrawal events loaded to seed the
+// daily withdrawal limits
+const withdrawHistoryLimit = 10000
+
+var (
+	// ErrWithdrawRequestNotFound message to display when no record is found
+	ErrWithdrawRequestNotFound = errors.New("request not found")
+	// ErrWithdrawalAddressNotApproved is returned when a crypto withdrawal is
+	// sent to an address which is not in the address book
+	ErrWithdrawalAddressNotApproved = errors.New("withdrawal address not in address book")
+	// ErrWithdrawalLimitExceeded is returned when a withdrawal is above a
+	// per transaction or daily limit
+	ErrWithdrawalLimitExceeded = errors.New("withdrawal limit exceeded")
+	// ErrPendingWithdrawalNotFound is returned when a pending withdrawal does
+	// not exist or has expired
+	ErrPendingWithdrawalNotFound = errors.New("pending withdrawal not found")
+
+	errApproverNotSet       = errors.New("approver not set")
+	errApproverNotAllowed   = errors.New("approver is not in the withdraw manager approvers")
+	errInvalidApproverToken = errors.New("invalid approver token")
+	errRequestedByNotSet    = errors.New("requested by must be set when withdrawals require approval")
+	errWithdrawalIDNotSet   = errors.New("withdrawal id not set")
+	errDuplicateApprover    = errors.New("withdrawal cannot be approved twice by the same approver")
+)
 
 // WithdrawManager is responsible for performing withdrawal requests and
 // saving them to the database
@@ -13,4 +54,40 @@ type WithdrawManager struct {
 	exchangeManager  iExchangeManager
 	portfolioManager iPortfolioManager
 	isDryRun         bool
+	// prepareRequest refreshes the one time password and exchange secrets of
+	// a request which is submitted after being approved
+	prepareRequest func(*withdraw.Request) error
+
+	mu        sync.Mutex
+	cfg       config.WithdrawManager
+	pending   map[uuid.UUID]*PendingWithdrawal
+	withdrawn []*withdrawnAmount
+}
+
+// PendingWithdrawal is a withdrawal held until it receives the required
+// approvals
+type PendingWithdrawal struct {
+	ID          uuid.UUID
+	Request     withdraw.Request
+	RequestedAt time.Time
+	ExpiresAt   time.Time
+	Approvals   []WithdrawalApproval
+	// RequiredApprovals is the number of approvals needed when the withdrawal
+	// was requested
+	RequiredApprovals int
+
+	credentials *accounts.Credentials
+}
+
+// WithdrawalApproval records who approved a pending withdrawal
+type WithdrawalApproval struct {
+	Approver string
+	Time     time.Time
+}
+
+// withdrawnAmount is an amount counted towards the daily withdrawal limits
+type withdrawnAmount struct {
+	currency *currency.Item
+	amount   float64
+	time     time.Time
 }
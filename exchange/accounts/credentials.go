@@ -21,6 +21,9 @@ const (
 	// context, when the default config credentials sub account needs to be
 	// changed while the same keys can be used.
 	ContextSubAccountFlag contextCredential = "subaccountoverride"
+	// ContextAccountFlag used for retrieving the name of the account profile
+	// whose credentials are used in place of the default config credentials
+	ContextAccountFlag contextCredential = "account"
 
 	apiKeyDisplaySize = 16
 )
@@ -35,9 +38,14 @@ const (
 	PEMKey          = "pemkey"
 )
 
+// ErrAccountNotFound is returned when an account profile is not configured for
+// an exchange
+var ErrAccountNotFound = errors.New("account not found")
+
 var (
 	errMetaDataIsNil                   = errors.New("meta data is nil")
 	errInvalidCredentialMetaDataLength = errors.New("invalid meta data to process credentials")
+	errInvalidAccountMetaDataLength    = errors.New("invalid meta data to process account")
 	errMissingInfo                     = errors.New("cannot parse meta data missing information in key value pair")
 )
 
@@ -50,7 +58,91 @@ type Credentials struct {
 	SubAccount          string
 	OneTimePassword     string
 	SecretBase64Decoded bool
-	// TODO: Add AccessControl uint8 for READ/WRITE/Withdraw capabilities.
+	AccessControl       AccessControl
+	// Account is the name of the account profile the credentials belong to,
+	// empty for the default config credentials
+	Account string
+}
+
+// AccessControl defines the operations a set of credentials may perform. Each
+// level permits the operations of the levels below it
+type AccessControl uint8
+
+// AccessControl levels. Credentials which have not declared a level are
+// unrestricted
+const (
+	UnrestrictedAccess AccessControl = iota
+	ReadOnlyAccess
+	TradeAccess
+	WithdrawAccess
+)
+
+// ErrAccessDenied is returned when credentials are used for an operation above
+// their access control level
+var ErrAccessDenied = errors.New("operation not permitted by credential access control")
+
+var errUnknownAccessControl = errors.New("unknown access control level")
+
+// ParseAccessControl returns the access control level for its name, an empty
+// name being unrestricted
+func ParseAccessControl(s string) (AccessControl, error) {
+	switch strings.ToLower(s) {
+	case "", "unrestricted":
+		return UnrestrictedAccess, nil
+	case "readonly", "read":
+		return ReadOnlyAccess, nil
+	case "trade":
+		return TradeAccess, nil
+	case "withdraw":
+		return WithdrawAccess, nil
+	}
+	return UnrestrictedAccess, fmt.Errorf("%w: %q", errUnknownAccessControl, s)
+}
+
+// String returns the name of the access control level
+func (a AccessControl) String() string {
+	switch a {
+	case UnrestrictedAccess:
+		return "unrestricted"
+	case ReadOnlyAccess:
+		return "readOnly"
+	case TradeAccess:
+		return "trade"
+	case WithdrawAccess:
+		return "withdraw"
+	}
+	return fmt.Sprintf("AccessControl(%d)", uint8(a))
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (a AccessControl) MarshalText() ([]byte, error) {
+	if a > WithdrawAccess {
+		return nil, fmt.Errorf("%w: %d", errUnknownAccessControl, uint8(a))
+	}
+	return []byte(a.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (a *AccessControl) UnmarshalText(text []byte) error {
+	level, err := ParseAccessControl(string(text))
+	if err != nil {
+		return err
+	}
+	*a = level
+	return nil
+}
+
+// Permits returns true if the level allows operations requiring level required
+func (a AccessControl) Permits(required AccessControl) bool {
+	return a == UnrestrictedAccess || a >= required
+}
+
+// Restrict returns the more restrictive of the two levels
+func (a AccessControl) Restrict(other AccessControl) AccessControl {
+	if a == UnrestrictedAccess || other != UnrestrictedAccess && other < a {
+		return other
+	}
+	return a
 }
 
 // GetMetaData returns the credentials for metadata context deployment
@@ -90,6 +182,15 @@ func (c *Credentials) String() string {
 		c.ClientID)
 }
 
+// CheckAccess returns ErrAccessDenied if the credentials are not permitted to
+// perform operations requiring the required access control level
+func (c *Credentials) CheckAccess(required AccessControl) error {
+	if c == nil || c.AccessControl.Permits(required) {
+		return nil
+	}
+	return fmt.Errorf("%w: %s credentials %s require %s access", ErrAccessDenied, c.AccessControl, c, required)
+}
+
 // getInternal returns the values for assignment to an internal context
 func (c *Credentials) getInternal() (contextCredential, *ContextCredentialsStore) {
 	if c.IsEmpty() {
@@ -152,6 +253,13 @@ func ParseCredentialsMetadata(ctx context.Context, md metadata.MD) (context.Cont
 		return ctx, errMetaDataIsNil
 	}
 
+	if acct, ok := md[string(ContextAccountFlag)]; ok && len(acct) != 0 {
+		if len(acct) != 1 {
+			return ctx, errInvalidAccountMetaDataLength
+		}
+		ctx = DeployAccountToContext(ctx, acct[0])
+	}
+
 	credMD, ok := md[string(ContextCredentialsFlag)]
 	if !ok || len(credMD) == 0 {
 		return ctx, nil
@@ -210,3 +318,16 @@ func DeployCredentialsToContext(ctx context.Context, creds *Credentials) context
 func DeploySubAccountOverrideToContext(ctx context.Context, subAccount string) context.Context {
 	return context.WithValue(ctx, ContextSubAccountFlag, subAccount)
 }
+
+// DeployAccountToContext sets the account profile whose credentials are used
+// for requests made with the context
+func DeployAccountToContext(ctx context.Context, account string) context.Context {
+	return context.WithValue(ctx, ContextAccountFlag, account)
+}
+
+// AccountFromContext returns the account profile set for the context, empty if
+// the default credentials are used
+func AccountFromContext(ctx context.Context) string {
+	account, _ := ctx.Value(ContextAccountFlag).(string)
+	return account
+}
@@ -3,6 +3,7 @@ package accounts
 import (
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/metadata"
 )
@@ -100,6 +101,28 @@ func TestParseCredentialsMetadata(t *testing.T) {
 	}
 }
 
+func TestParseAccountMetadata(t *testing.T) {
+	t.Parallel()
+	ctx := metadata.AppendToOutgoingContext(t.Context(),
+		string(ContextAccountFlag), "desk1", string(ContextAccountFlag), "desk2")
+	md, _ := metadata.FromOutgoingContext(ctx)
+	_, err := ParseCredentialsMetadata(t.Context(), md)
+	require.ErrorIs(t, err, errInvalidAccountMetaDataLength)
+
+	ctx = metadata.AppendToOutgoingContext(t.Context(), string(ContextAccountFlag), "desk1")
+	md, _ = metadata.FromOutgoingContext(ctx)
+	ctx, err = ParseCredentialsMetadata(t.Context(), md)
+	require.NoError(t, err, "ParseCredentialsMetadata must not error")
+	assert.Equal(t, "desk1", AccountFromContext(ctx), "AccountFromContext should return the account from metadata")
+}
+
+func TestAccountFromContext(t *testing.T) {
+	t.Parallel()
+	assert.Empty(t, AccountFromContext(t.Context()), "AccountFromContext should return empty without an account")
+	ctx := DeployAccountToContext(t.Context(), "desk1")
+	assert.Equal(t, "desk1", AccountFromContext(ctx), "AccountFromContext should return the deployed account")
+}
+
 func TestGetInternal(t *testing.T) {
 	t.Parallel()
 	flag, store := (&Credentials{}).getInternal()
@@ -172,3 +195,66 @@ func TestCredentialsEqual(t *testing.T) {
 		t.Fatal("unexpected value")
 	}
 }
+
+func TestParseAccessControl(t *testing.T) {
+	t.Parallel()
+	for s, exp := range map[string]AccessControl{
+		"":             UnrestrictedAccess,
+		"unrestricted": UnrestrictedAccess,
+		"readOnly":     ReadOnlyAccess,
+		"READ":         ReadOnlyAccess,
+		"trade":        TradeAccess,
+		"Withdraw":     WithdrawAccess,
+	} {
+		a, err := ParseAccessControl(s)
+		require.NoErrorf(t, err, "ParseAccessControl must not error for %q", s)
+		assert.Equalf(t, exp, a, "ParseAccessControl should return the correct level for %q", s)
+	}
+	_, err := ParseAccessControl("admin")
+	require.ErrorIs(t, err, errUnknownAccessControl)
+}
+
+func TestAccessControlText(t *testing.T) {
+	t.Parallel()
+	for _, a := range []AccessControl{UnrestrictedAccess, ReadOnlyAccess, TradeAccess, WithdrawAccess} {
+		text, err := a.MarshalText()
+		require.NoError(t, err, "MarshalText must not error")
+		var got AccessControl
+		require.NoError(t, got.UnmarshalText(text), "UnmarshalText must not error")
+		assert.Equal(t, a, got, "UnmarshalText should return the marshalled level")
+	}
+	_, err := AccessControl(42).MarshalText()
+	require.ErrorIs(t, err, errUnknownAccessControl)
+	assert.Equal(t, "AccessControl(42)", AccessControl(42).String(), "String should print unknown levels")
+	var a AccessControl
+	require.ErrorIs(t, a.UnmarshalText([]byte("admin")), errUnknownAccessControl)
+}
+
+func TestAccessControlPermits(t *testing.T) {
+	t.Parallel()
+	assert.True(t, UnrestrictedAccess.Permits(WithdrawAccess), "Unrestricted access should permit withdrawals")
+	assert.True(t, ReadOnlyAccess.Permits(ReadOnlyAccess), "Read-only access should permit reads")
+	assert.False(t, ReadOnlyAccess.Permits(TradeAccess), "Read-only access should not permit trading")
+	assert.True(t, TradeAccess.Permits(TradeAccess), "Trade access should permit trading")
+	assert.False(t, TradeAccess.Permits(WithdrawAccess), "Trade access should not permit withdrawals")
+	assert.True(t, WithdrawAccess.Permits(TradeAccess), "Withdraw access should permit trading")
+}
+
+func TestAccessControlRestrict(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, TradeAccess, UnrestrictedAccess.Restrict(TradeAccess), "Restrict should apply a level to unrestricted access")
+	assert.Equal(t, TradeAccess, TradeAccess.Restrict(UnrestrictedAccess), "Restrict should keep a level over unrestricted access")
+	assert.Equal(t, ReadOnlyAccess, WithdrawAccess.Restrict(ReadOnlyAccess), "Restrict should lower the level")
+	assert.Equal(t, TradeAccess, TradeAccess.Restrict(WithdrawAccess), "Restrict should not raise the level")
+}
+
+func TestCheckAccess(t *testing.T) {
+	t.Parallel()
+	var c *Credentials
+	require.NoError(t, c.CheckAccess(WithdrawAccess), "CheckAccess must not error for nil credentials")
+	c = &Credentials{Key: "key"}
+	require.NoError(t, c.CheckAccess(WithdrawAccess), "CheckAccess must not error for unrestricted credentials")
+	c.AccessControl = ReadOnlyAccess
+	require.NoError(t, c.CheckAccess(ReadOnlyAccess), "CheckAccess must not error for permitted operations")
+	require.ErrorIs(t, c.CheckAccess(TradeAccess), ErrAccessDenied)
+}
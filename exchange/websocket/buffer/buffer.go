@@ -14,6 +14,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchange/stream"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/log"
 )
 
 const packageError = "websocket orderbook buffer error: %w"
@@ -35,9 +36,12 @@ func (o *Orderbook) Setup(exchangeConfig *config.Exchange, c *Config, dataHandle
 	// NOTE: These variables are set by config.json under "orderbook" for each individual exchange
 	o.bufferEnabled = exchangeConfig.Orderbook.WebsocketBufferEnabled
 	o.obBufferLimit = exchangeConfig.Orderbook.WebsocketBufferLimit
+	o.deduplicate = exchangeConfig.Orderbook.WebsocketRedundancy
 
 	o.sortBuffer = c.SortBuffer
 	o.sortBufferByUpdateIDs = c.SortBufferByUpdateIDs
+	o.checksum = c.Checksum
+	o.resync = c.Resync
 	o.exchangeName = exchangeConfig.Name
 	o.dataHandler = dataHandler
 	o.ob = make(map[key.PairAsset]*orderbookHolder)
@@ -69,6 +73,15 @@ func (o *Orderbook) LoadSnapshot(book *orderbook.Book) error {
 		o.m.Unlock()
 	}
 
+	holder.m.Lock()
+	defer holder.m.Unlock()
+	if o.deduplicate {
+		if book.LastUpdateID != 0 && book.LastUpdateID <= holder.lastUpdateID && holder.ob.IsValid() {
+			return nil // Already loaded from a redundant connection
+		}
+		holder.lastUpdateID = book.LastUpdateID
+	}
+
 	book.RestSnapshot = false
 	if err := holder.ob.LoadSnapshot(book); err != nil {
 		return err
@@ -88,12 +101,23 @@ func (o *Orderbook) Update(u *orderbook.Update) error {
 		return fmt.Errorf("%w for Exchange %s CurrencyPair: %s AssetType: %s", orderbook.ErrDepthNotFound, o.exchangeName, u.Pair, u.Asset)
 	}
 
+	holder.m.Lock()
+	defer holder.m.Unlock()
+	if u.ExpectedChecksum != 0 && u.GenerateChecksum == nil {
+		u.GenerateChecksum = o.checksum
+	}
+
 	if o.bufferEnabled {
 		if processed, err := o.processBufferUpdate(holder, u); err != nil || !processed {
+			o.checkResync(holder, u, err)
 			return err
 		}
 	} else {
+		if o.isDuplicate(holder, u) {
+			return nil
+		}
 		if err := holder.ob.ProcessUpdate(u); err != nil {
+			o.checkResync(holder, u, err)
 			return err
 		}
 	}
@@ -103,6 +127,34 @@ func (o *Orderbook) Update(u *orderbook.Update) error {
 	return o.dataHandler.Send(context.TODO(), holder.ob)
 }
 
+// isDuplicate returns true if an update has already been applied from a redundant connection, otherwise it records
+// the update ID as the last one accepted
+// holder.m must be held by the caller
+func (o *Orderbook) isDuplicate(holder *orderbookHolder, u *orderbook.Update) bool {
+	if !o.deduplicate || u.UpdateID == 0 {
+		return false
+	}
+	if u.UpdateID <= holder.lastUpdateID {
+		return true
+	}
+	holder.lastUpdateID = u.UpdateID
+	return false
+}
+
+// checkResync requests a fresh snapshot when an update has failed checksum verification, unless one is already
+// being requested for the orderbook
+func (o *Orderbook) checkResync(holder *orderbookHolder, u *orderbook.Update, err error) {
+	if o.resync == nil || !errors.Is(err, orderbook.ErrChecksumMismatch) || !holder.resyncing.CompareAndSwap(false, true) {
+		return
+	}
+	go func() {
+		defer holder.resyncing.Store(false)
+		if err := o.resync(context.TODO(), u.Pair, u.Asset); err != nil {
+			log.Errorf(log.ExchangeSys, "%s websocket orderbook buffer: failed to resync %v %v after checksum failure: %v", o.exchangeName, u.Pair, u.Asset, err)
+		}
+	}()
+}
+
 // processBufferUpdate stores update into buffer, when buffer at capacity as
 // defined by o.obBufferLimit it well then sort and apply updates.
 func (o *Orderbook) processBufferUpdate(holder *orderbookHolder, u *orderbook.Update) (bool, error) {
@@ -128,6 +180,10 @@ func (o *Orderbook) processBufferUpdate(holder *orderbookHolder, u *orderbook.Up
 	defer func() { holder.buffer = holder.buffer[:0] }()
 
 	for i := range holder.buffer {
+		// Duplicates are only dropped once sorted, so an update buffered out of order is still applied
+		if o.isDuplicate(holder, &holder.buffer[i]) {
+			continue
+		}
 		if err := holder.ob.ProcessUpdate(&holder.buffer[i]); err != nil {
 			return false, err
 		}
@@ -175,7 +231,10 @@ func (o *Orderbook) LastUpdateID(p currency.Pair, a asset.Item) (int64, error) {
 func (o *Orderbook) FlushBuffer() {
 	o.m.Lock()
 	for _, holder := range o.ob {
+		holder.m.Lock()
 		holder.buffer = holder.buffer[:0]
+		holder.lastUpdateID = 0
+		holder.m.Unlock()
 	}
 	o.m.Unlock()
 }
@@ -1,6 +1,7 @@
 package buffer
 
 import (
+	"context"
 	"math/rand"
 	"strconv"
 	"testing"
@@ -495,6 +496,138 @@ func TestFlushBuffer(t *testing.T) {
 	assert.Equal(t, 10, cap(holder.buffer), "FlushBuffer should leave the buffer cap to avoid reallocs")
 }
 
+func TestDeduplicate(t *testing.T) {
+	t.Parallel()
+	cp, err := getExclusivePair()
+	require.NoError(t, err, "getExclusivePair must not error")
+
+	obl, _, _, err := createSnapshot(cp)
+	require.NoError(t, err, "createSnapshot must not error")
+	obl.deduplicate = true
+
+	update := func(id int64, amount float64) error {
+		return obl.Update(&orderbook.Update{
+			Asks:       orderbook.Levels{{Price: 1000, Amount: amount}},
+			Pair:       cp,
+			UpdateID:   id,
+			Asset:      asset.Spot,
+			UpdateTime: time.Now(),
+		})
+	}
+	asks := func() orderbook.Levels {
+		ob, err := obl.GetOrderbook(cp, asset.Spot)
+		require.NoError(t, err, "GetOrderbook must not error")
+		return ob.Asks
+	}
+
+	require.NoError(t, update(69421, 1), "Update must not error")
+	require.NoError(t, update(69421, 2), "Update must not error on a duplicate")
+	assert.Equal(t, 1.0, asks()[0].Amount, "duplicate update should be dropped")
+
+	require.NoError(t, update(0, 3), "Update must not error")
+	assert.Equal(t, 3.0, asks()[0].Amount, "updates without an ID should not be deduplicated")
+
+	book := &orderbook.Book{
+		Exchange:     exchangeName,
+		Asks:         orderbook.Levels{{Price: 5000, Amount: 1}},
+		Bids:         orderbook.Levels{{Price: 4000, Amount: 1}},
+		Asset:        asset.Spot,
+		Pair:         cp,
+		LastUpdated:  time.Now(),
+		LastUpdateID: 69421,
+	}
+	require.NoError(t, obl.LoadSnapshot(book), "LoadSnapshot must not error")
+	assert.Equal(t, 1000.0, asks()[0].Price, "snapshot already superseded by updates should be dropped")
+
+	obl.FlushBuffer()
+	require.NoError(t, obl.LoadSnapshot(book), "LoadSnapshot must not error")
+	assert.Equal(t, 5000.0, asks()[0].Price, "snapshot should load after the buffer is flushed for a reconnect")
+	require.NoError(t, update(69421, 2), "Update must not error")
+	assert.Equal(t, 1.0, asks()[0].Amount, "update covered by the snapshot should be dropped")
+}
+
+func TestDeduplicateBuffered(t *testing.T) {
+	t.Parallel()
+	cp, err := getExclusivePair()
+	require.NoError(t, err, "getExclusivePair must not error")
+
+	obl, _, _, err := createSnapshot(cp)
+	require.NoError(t, err, "createSnapshot must not error")
+	obl.deduplicate = true
+	obl.bufferEnabled = true
+	obl.sortBuffer = true
+	obl.sortBufferByUpdateIDs = true
+	obl.obBufferLimit = 4
+
+	update := func(id int64, price float64) error {
+		return obl.Update(&orderbook.Update{
+			Asks:       orderbook.Levels{{Price: price, Amount: 1}},
+			Pair:       cp,
+			UpdateID:   id,
+			Asset:      asset.Spot,
+			UpdateTime: time.Now(),
+		})
+	}
+	// Each update is received out of order and again from a redundant connection
+	for _, id := range []int64{69422, 69421, 69422, 69421} {
+		require.NoErrorf(t, update(id, float64(id-68421)), "Update %d must not error", id)
+	}
+	ob, err := obl.GetOrderbook(cp, asset.Spot)
+	require.NoError(t, err, "GetOrderbook must not error")
+	require.Len(t, ob.Asks, 3, "out of order updates must be applied once sorted")
+	assert.Equal(t, 1000.0, ob.Asks[0].Price, "update received out of order should be applied")
+	assert.Equal(t, 1001.0, ob.Asks[1].Price, "later update should be applied")
+	assert.Equal(t, 1.0, ob.Asks[0].Amount, "duplicate update should be applied once")
+
+	lastID, err := obl.LastUpdateID(cp, asset.Spot)
+	require.NoError(t, err, "LastUpdateID must not error")
+	assert.Equal(t, int64(69422), lastID, "LastUpdateID should be the highest update applied")
+}
+
+func TestChecksum(t *testing.T) {
+	t.Parallel()
+	cp, err := getExclusivePair()
+	require.NoError(t, err, "getExclusivePair must not error")
+
+	obl, _, _, err := createSnapshot(cp)
+	require.NoError(t, err, "createSnapshot must not error")
+	obl.checksum = func(b *orderbook.Book) uint32 { return uint32(b.Asks[0].Amount) }
+	resynced := make(chan key.PairAsset, 1)
+	obl.resync = func(_ context.Context, p currency.Pair, a asset.Item) error {
+		resynced <- key.PairAsset{Base: p.Base.Item, Quote: p.Quote.Item, Asset: a}
+		return nil
+	}
+	update := func(amount float64, checksum uint32) error {
+		return obl.Update(&orderbook.Update{
+			Asks:             orderbook.Levels{{Price: 4000, Amount: amount}},
+			Pair:             cp,
+			Asset:            asset.Spot,
+			UpdateTime:       time.Now(),
+			ExpectedChecksum: checksum,
+		})
+	}
+
+	require.NoError(t, update(2, 2), "Update must not error when the checksum matches")
+	select {
+	case <-resynced:
+		require.Fail(t, "resync must not be requested when the checksum matches")
+	default:
+	}
+
+	require.ErrorIs(t, update(3, 4), orderbook.ErrChecksumMismatch, "Update must error when the checksum does not match")
+	select {
+	case k := <-resynced:
+		assert.Equal(t, key.PairAsset{Base: cp.Base.Item, Quote: cp.Quote.Item, Asset: asset.Spot}, k, "resync should be requested for the orderbook")
+	case <-time.After(time.Second):
+		require.Fail(t, "resync must be requested on checksum mismatch")
+	}
+
+	d, err := orderbook.GetDepth(exchangeName, cp, asset.Spot)
+	require.NoError(t, err, "GetDepth must not error")
+	assert.False(t, d.IsValid(), "orderbook should be invalidated on checksum mismatch")
+	assert.Equal(t, uint64(1), d.ChecksumFailures(), "checksum failure should be counted against the pair")
+}
+
 // TestInsertingSnapShots logic test
 func TestInsertingSnapShots(t *testing.T) {
 	t.Parallel()
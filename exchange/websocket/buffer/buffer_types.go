@@ -1,10 +1,14 @@
 package buffer
 
 import (
+	"context"
 	"sync"
+	"sync/atomic"
 
 	"github.com/thrasher-corp/gocryptotrader/common/key"
+	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchange/stream"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 )
 
@@ -16,6 +20,14 @@ type Config struct {
 	// SortBufferByUpdateIDs allows the sorting of the buffered updates by their
 	// corresponding update IDs.
 	SortBufferByUpdateIDs bool
+	// Checksum generates a checksum from the stored orderbook after an update
+	// is applied. It is used for updates which carry an ExpectedChecksum
+	// without their own GenerateChecksum function.
+	Checksum orderbook.ChecksumFunc
+	// Resync requests a fresh snapshot, e.g. by resubscribing, after an update
+	// fails checksum verification and the orderbook has been invalidated. It
+	// runs in its own goroutine, once at a time per orderbook.
+	Resync func(ctx context.Context, p currency.Pair, a asset.Item) error
 }
 
 // Orderbook defines a local cache of orderbooks for amending, appending
@@ -26,6 +38,9 @@ type Orderbook struct {
 	bufferEnabled         bool
 	sortBuffer            bool
 	sortBufferByUpdateIDs bool // When timestamps aren't provided, an id can help sort
+	deduplicate           bool // Drops updates already applied when redundant connections carry the same subscription
+	checksum              orderbook.ChecksumFunc
+	resync                func(ctx context.Context, p currency.Pair, a asset.Item) error
 	exchangeName          string
 	dataHandler           *stream.Relay
 	verbose               bool
@@ -38,4 +53,9 @@ type Orderbook struct {
 type orderbookHolder struct {
 	ob     *orderbook.Depth
 	buffer []orderbook.Update
+	// lastUpdateID is the highest update ID accepted, used to drop duplicates
+	lastUpdateID int64
+	// resyncing is set while a resync requested after a checksum failure is running
+	resyncing atomic.Bool
+	m         sync.Mutex
 }
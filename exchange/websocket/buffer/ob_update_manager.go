@@ -41,6 +41,7 @@ type UpdateManager struct {
 	delay              time.Duration
 	fetchOrderbook     func(ctx context.Context, p currency.Pair, a asset.Item) (*orderbook.Book, error)
 	checkPendingUpdate func(lastUpdateID, firstUpdateID int64, update *orderbook.Update) (skip bool, err error)
+	checksum           orderbook.ChecksumFunc
 	ob                 *Orderbook
 }
 
@@ -78,7 +79,11 @@ type UpdateManagerParams struct {
 	// CheckPendingUpdate allows custom logic to determine if a pending update added to cache should be skipped or if an
 	// error has occurred.
 	CheckPendingUpdate func(lastUpdateID, firstUpdateID int64, update *orderbook.Update) (skip bool, err error)
-	BufferInstance     *Orderbook // TODO: Integrate directly with orderbook struct
+	// Checksum verifies updates which carry an ExpectedChecksum without their own GenerateChecksum function, taking
+	// precedence over the buffer Checksum so each asset's manager can use its own scheme. A mismatch invalidates the
+	// orderbook and resyncs it from a fresh REST snapshot.
+	Checksum       orderbook.ChecksumFunc
+	BufferInstance *Orderbook // TODO: Integrate directly with orderbook struct
 }
 
 // NewUpdateManager creates a new websocket orderbook update manager
@@ -98,6 +103,7 @@ func NewUpdateManager(params *UpdateManagerParams) *UpdateManager {
 		delay:              params.FetchDelay,
 		fetchOrderbook:     params.FetchOrderbook,
 		checkPendingUpdate: params.CheckPendingUpdate,
+		checksum:           params.Checksum,
 		ob:                 params.BufferInstance,
 	}
 }
@@ -109,6 +115,10 @@ func (m *UpdateManager) ProcessOrderbookUpdate(ctx context.Context, firstUpdateI
 		return err
 	}
 
+	if update.ExpectedChecksum != 0 && update.GenerateChecksum == nil {
+		update.GenerateChecksum = m.checksum
+	}
+
 	cache.m.Lock()
 	defer cache.m.Unlock()
 	switch cache.state {
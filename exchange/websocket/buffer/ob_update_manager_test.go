@@ -149,6 +149,24 @@ func TestProcessOrderbookUpdate(t *testing.T) {
 	cache.m.Unlock()
 }
 
+func TestProcessOrderbookUpdateChecksum(t *testing.T) {
+	t.Parallel()
+	tp := newTestParams()
+	tp.Checksum = func(*orderbook.Book) uint32 { return 1 }
+	m := NewUpdateManager(&tp)
+	pair := currency.NewPair(currency.BABY, currency.USDT)
+
+	u := &orderbook.Update{Pair: pair, Asset: asset.Spot, UpdateID: 1, UpdateTime: time.Now(), ExpectedChecksum: 1}
+	require.NoError(t, m.ProcessOrderbookUpdate(t.Context(), 1, u), "ProcessOrderbookUpdate must not error")
+	require.NotNil(t, u.GenerateChecksum, "GenerateChecksum must be set from the manager")
+	assert.Equal(t, uint32(1), u.GenerateChecksum(nil), "GenerateChecksum should use the manager checksum")
+
+	own := func(*orderbook.Book) uint32 { return 2 }
+	u = &orderbook.Update{Pair: pair, Asset: asset.Spot, UpdateID: 2, UpdateTime: time.Now(), ExpectedChecksum: 2, GenerateChecksum: own}
+	require.NoError(t, m.ProcessOrderbookUpdate(t.Context(), 2, u), "ProcessOrderbookUpdate must not error")
+	assert.Equal(t, uint32(2), u.GenerateChecksum(nil), "GenerateChecksum should not be replaced when set on the update")
+}
+
 func TestLoadCache(t *testing.T) {
 	t.Parallel()
 
@@ -19,6 +19,7 @@ import (
 	gws "github.com/gorilla/websocket"
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/chaos"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/subscription"
 	"github.com/thrasher-corp/gocryptotrader/log"
@@ -101,6 +102,19 @@ type ConnectionSetup struct {
 	// MessageFilter defines the criteria used to match messages to a specific connection.
 	// The filter enables precise routing and handling of messages for distinct connection contexts.
 	MessageFilter any
+	// MaxSubscriptions overrides the manager's MaxSubscriptionsPerConnection for connections created from this setup,
+	// for exchanges which allow a different number of subscriptions per endpoint.
+	MaxSubscriptions int
+	// MaxConnections caps the number of connections the subscriptions for this setup can be sharded across. Zero allows
+	// the pool to grow as required.
+	MaxConnections int
+	// AllowRedundancy marks setups carrying only streams which are safe to receive twice, such as orderbooks with
+	// update IDs. When websocket redundancy is enabled in the exchange config these setups are duplicated onto a hot
+	// standby connection, so a stalled connection fails over without waiting for a full reconnect. Only applies with
+	// multi connection management.
+	AllowRedundancy bool
+	// RedundantURL optionally connects the standby to an alternate endpoint, defaulting to URL
+	RedundantURL string
 }
 
 // Inspector is used to verify messages via SendMessageReturnResponsesWithInspection
@@ -124,6 +138,10 @@ type connection struct {
 	RateLimit            *request.RateLimiterWithWeight // RateLimit is a rate limiter for the connection itself
 	RateLimitDefinitions request.RateLimitDefinitions   // RateLimitDefinitions contains the rate limiters shared between WebSocket and REST connections
 	Reporter             Reporter
+	Recorder             Recorder
+	Faults               FaultInjector
+	duplicate            []byte // frame queued for redelivery by the fault injector
+	duplicateType        int
 	ExchangeName         string
 	URL                  string
 	ProxyURL             string
@@ -134,6 +152,10 @@ type connection struct {
 	ResponseMaxLimit     time.Duration
 	Traffic              chan struct{}
 	readMessageErrors    chan error
+	onFault              func(*connection, error) bool // handles a fault without a full reconnect, returning false if it cannot
+	connectedAt          atomic.Int64                  // unix nano
+	lastMessage          atomic.Int64                  // unix nano
+	messages             atomic.Uint64
 }
 
 // Dial sets proxy urls and then connects to the websocket
@@ -165,6 +187,7 @@ func (c *connection) Dial(ctx context.Context, dialer *gws.Dialer, headers http.
 	case c.Traffic <- struct{}{}:
 	default:
 	}
+	c.connectedAt.Store(time.Now().UnixNano())
 	c.setConnectedStatus(true)
 	return nil
 }
@@ -172,9 +195,14 @@ func (c *connection) Dial(ctx context.Context, dialer *gws.Dialer, headers http.
 // SendJSONMessage sends a JSON encoded message over the connection
 func (c *connection) SendJSONMessage(ctx context.Context, epl request.EndpointLimit, data any) error {
 	return c.writeToConn(ctx, epl, func() error {
-		if request.IsVerbose(ctx, c.Verbose) {
+		if verbose := request.IsVerbose(ctx, c.Verbose); verbose || c.Recorder != nil {
 			if msg, err := json.Marshal(data); err == nil { // WriteJSON will error for us anyway
-				log.Debugf(log.WebsocketMgr, "%v %v: Sending message: %v", c.ExchangeName, removeURLQueryString(c.URL), string(msg))
+				if verbose {
+					log.Debugf(log.WebsocketMgr, "%v %v: Sending message: %v", c.ExchangeName, removeURLQueryString(c.URL), string(msg))
+				}
+				if c.Recorder != nil {
+					c.Recorder.RecordFrame(true, gws.TextMessage, msg)
+				}
 			}
 		}
 		return c.Connection.WriteJSON(data)
@@ -187,6 +215,9 @@ func (c *connection) SendRawMessage(ctx context.Context, epl request.EndpointLim
 		if request.IsVerbose(ctx, c.Verbose) {
 			log.Debugf(log.WebsocketMgr, "%v %v: Sending message: %v", c.ExchangeName, removeURLQueryString(c.URL), string(message))
 		}
+		if c.Recorder != nil {
+			c.Recorder.RecordFrame(true, messageType, message)
+		}
 		return c.Connection.WriteMessage(messageType, message)
 	})
 }
@@ -269,7 +300,7 @@ func (c *connection) IsConnected() bool {
 
 // ReadMessage reads messages, can handle text, gzip and binary
 func (c *connection) ReadMessage() Response {
-	mType, resp, err := c.Connection.ReadMessage()
+	mType, resp, err := c.readFrame()
 	if err != nil {
 		// If any error occurs, a Response{Raw: nil, Type: 0} is returned, causing the
 		// reader routine to exit. This leaves the connection without an active reader,
@@ -283,6 +314,9 @@ func (c *connection) ReadMessage() Response {
 			// externally closed and an error is reported else Shutdown()
 			// method on WebsocketConnection type has been called and can
 			// be skipped.
+			if c.onFault != nil && c.onFault(c, err) {
+				return Response{}
+			}
 			select {
 			case c.readMessageErrors <- fmt.Errorf("%w: %w (%q)", err, errConnectionFault, c.URL):
 			default:
@@ -294,11 +328,23 @@ func (c *connection) ReadMessage() Response {
 		return Response{}
 	}
 
+	c.lastMessage.Store(time.Now().UnixNano())
+	c.messages.Add(1)
+
 	select {
 	case c.Traffic <- struct{}{}:
 	default: // Non-Blocking write ensures 1 buffered signal per trafficCheckInterval to avoid flooding
 	}
 
+	if c.Recorder != nil {
+		// Record the raw frame so compressed binary payloads replay exactly
+		c.Recorder.RecordFrame(false, mType, resp)
+	}
+
+	if rep, ok := c.Reporter.(MessageReporter); ok {
+		rep.Message(c.ExchangeName)
+	}
+
 	var standardMessage []byte
 	switch mType {
 	case gws.TextMessage:
@@ -316,6 +362,34 @@ func (c *connection) ReadMessage() Response {
 	return Response{Raw: standardMessage, Type: mType}
 }
 
+// readFrame reads the next frame from the connection, applying any faults from
+// the fault injector
+func (c *connection) readFrame() (int, []byte, error) {
+	if c.Faults == nil {
+		return c.Connection.ReadMessage()
+	}
+	if c.duplicate != nil {
+		data := c.duplicate
+		c.duplicate = nil
+		return c.duplicateType, data, nil
+	}
+	for {
+		mType, data, err := c.Connection.ReadMessage()
+		if err != nil {
+			return mType, data, err
+		}
+		switch c.Faults.Frame(data) {
+		case chaos.Drop:
+			continue
+		case chaos.Duplicate:
+			c.duplicate, c.duplicateType = data, mType
+		case chaos.Disconnect:
+			return 0, nil, chaos.ErrInjectedFault
+		}
+		return mType, data, nil
+	}
+}
+
 // parseBinaryResponse parses a websocket binary response into a usable byte array
 func (c *connection) parseBinaryResponse(resp []byte) ([]byte, error) {
 	var reader io.ReadCloser
@@ -341,6 +415,11 @@ func (c *connection) Shutdown() error {
 		return nil // Allow Shutdown to be called during early startup/teardown when the socket hasn't been created yet.
 	}
 	c.setConnectedStatus(false)
+	if s, ok := c.Recorder.(Saver); ok {
+		if err := s.Save(); err != nil {
+			log.Errorf(log.WebsocketMgr, "%v %v: failed to save websocket recording: %v", c.ExchangeName, removeURLQueryString(c.URL), err)
+		}
+	}
 	c.writeControl.Lock()
 	defer c.writeControl.Unlock()
 	return c.Connection.NetConn().Close()
@@ -485,3 +564,20 @@ func (c *connection) IncomingWithData(signature any, data []byte) bool {
 func (c *connection) Subscriptions() *subscription.Store {
 	return c.subscriptions
 }
+
+// health returns the connection's health, with subscriptions and limits filled
+// in by the manager
+func (c *connection) health() ConnectionHealth {
+	h := ConnectionHealth{
+		URL:       removeURLQueryString(c.URL),
+		Connected: c.IsConnected(),
+		Messages:  c.messages.Load(),
+	}
+	if ns := c.connectedAt.Load(); ns != 0 {
+		h.ConnectedAt = time.Unix(0, ns)
+	}
+	if ns := c.lastMessage.Load(); ns != 0 {
+		h.LastMessage = time.Unix(0, ns)
+	}
+	return h
+}
@@ -1,13 +1,21 @@
 package websocket
 
 import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
+	gws "github.com/gorilla/websocket"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/chaos"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/subscription"
 	testsubs "github.com/thrasher-corp/gocryptotrader/internal/testing/subscriptions"
+	mockws "github.com/thrasher-corp/gocryptotrader/internal/testing/websocket"
 )
 
 func TestMatchReturnResponses(t *testing.T) {
@@ -70,3 +78,103 @@ func TestConnectionSubscriptions(t *testing.T) {
 	require.NotNil(t, ws.Subscriptions())
 	testsubs.EqualLists(t, ws.subscriptions.List(), ws.Subscriptions().List())
 }
+
+type frameRecorder struct {
+	m      sync.Mutex
+	frames []recordedFrame
+	saved  int
+}
+
+type recordedFrame struct {
+	outbound bool
+	mType    int
+	data     string
+}
+
+func (r *frameRecorder) RecordFrame(outbound bool, messageType int, data []byte) {
+	r.m.Lock()
+	defer r.m.Unlock()
+	r.frames = append(r.frames, recordedFrame{outbound, messageType, string(data)})
+}
+
+func (r *frameRecorder) Save() error {
+	r.m.Lock()
+	defer r.m.Unlock()
+	r.saved++
+	return nil
+}
+
+func TestConnectionRecorder(t *testing.T) {
+	t.Parallel()
+
+	mock := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { mockws.WsMockUpgrader(t, w, r, mockws.EchoHandler) }))
+	t.Cleanup(mock.Close)
+
+	r := &frameRecorder{}
+	wc := &connection{
+		ExchangeName: "test",
+		URL:          "ws" + strings.TrimPrefix(mock.URL, "http"),
+		Recorder:     r,
+		Traffic:      make(chan struct{}, 1),
+	}
+	require.NoError(t, wc.Dial(t.Context(), &gws.Dialer{}, http.Header{}, nil), "Dial must not error")
+
+	require.NoError(t, wc.SendJSONMessage(t.Context(), request.Unset, map[string]string{"op": "ping"}), "SendJSONMessage must not error")
+	assert.JSONEq(t, `{"op":"ping"}`, string(wc.ReadMessage().Raw), "ReadMessage should return the echoed message")
+	require.NoError(t, wc.SendRawMessage(t.Context(), request.Unset, gws.BinaryMessage, []byte{1}), "SendRawMessage must not error")
+	require.NoError(t, wc.Shutdown(), "Shutdown must not error")
+
+	require.Len(t, r.frames, 3, "all frames must be recorded")
+	assert.Equal(t, recordedFrame{true, gws.TextMessage, `{"op":"ping"}`}, r.frames[0], "JSON messages should be recorded as outbound text frames")
+	assert.False(t, r.frames[1].outbound, "inbound messages should be recorded as inbound")
+	assert.JSONEq(t, `{"op":"ping"}`, r.frames[1].data, "inbound messages should be recorded")
+	assert.Equal(t, recordedFrame{true, gws.BinaryMessage, "\x01"}, r.frames[2], "raw messages should be recorded with their message type")
+	assert.Equal(t, 1, r.saved, "Save should be called on Shutdown")
+}
+
+func TestConnectionFaults(t *testing.T) {
+	t.Parallel()
+
+	mock := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { mockws.WsMockUpgrader(t, w, r, mockws.EchoHandler) }))
+	t.Cleanup(mock.Close)
+
+	for _, tc := range []struct {
+		name   string
+		cfg    chaos.Config
+		expect []string
+	}{
+		{name: "duplicate", cfg: chaos.Config{DuplicateProbability: 1}, expect: []string{"a", "a", "b", "b"}},
+		{name: "sequence gap", cfg: chaos.Config{SequenceGapProbability: 1, SequenceGapPattern: "a"}, expect: []string{"b"}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			f, err := chaos.New(&tc.cfg)
+			require.NoError(t, err, "chaos.New must not error")
+			wc := &connection{ExchangeName: "test", URL: "ws" + strings.TrimPrefix(mock.URL, "http"), Faults: f, Traffic: make(chan struct{}, 1)}
+			require.NoError(t, wc.Dial(t.Context(), &gws.Dialer{}, http.Header{}, nil), "Dial must not error")
+			t.Cleanup(func() { assert.NoError(t, wc.Shutdown(), "Shutdown should not error") })
+			for _, msg := range []string{"a", "b"} {
+				require.NoError(t, wc.SendRawMessage(t.Context(), request.Unset, gws.TextMessage, []byte(msg)), "SendRawMessage must not error")
+			}
+			for _, want := range tc.expect {
+				assert.Equal(t, want, string(wc.ReadMessage().Raw), "ReadMessage should return the expected message")
+			}
+		})
+	}
+
+	t.Run("disconnect", func(t *testing.T) {
+		t.Parallel()
+		f, err := chaos.New(&chaos.Config{DisconnectProbability: 1})
+		require.NoError(t, err, "chaos.New must not error")
+		errs := make(chan error, 1)
+		wc := &connection{ExchangeName: "test", URL: "ws" + strings.TrimPrefix(mock.URL, "http"), Faults: f, Traffic: make(chan struct{}, 1), readMessageErrors: errs}
+		require.NoError(t, wc.Dial(t.Context(), &gws.Dialer{}, http.Header{}, nil), "Dial must not error")
+		t.Cleanup(func() { assert.NoError(t, wc.Shutdown(), "Shutdown should not error") })
+		require.NoError(t, wc.SendRawMessage(t.Context(), request.Unset, gws.TextMessage, []byte("a")), "SendRawMessage must not error")
+		assert.Nil(t, wc.ReadMessage().Raw, "ReadMessage should return no data on an injected disconnect")
+		assert.False(t, wc.IsConnected(), "connection should be marked as disconnected")
+		err = <-errs
+		assert.ErrorIs(t, err, errConnectionFault, "a connection fault should be relayed so the connection monitor reconnects")
+		assert.ErrorIs(t, err, chaos.ErrInjectedFault, "the injected fault should be relayed")
+	})
+}
@@ -104,6 +104,7 @@ type Manager struct {
 	Unsubscriber                  func(subscription.List) error
 	GenerateSubs                  func() (subscription.List, error)
 	useMultiConnectionManagement  bool
+	redundancy                    bool
 	DataHandler                   *stream.Relay
 	Match                         *Match
 	ShutdownC                     chan struct{}
@@ -117,6 +118,8 @@ type Manager struct {
 	AuthConn                      Connection // Authenticated Private connection
 	ExchangeLevelReporter         Reporter   // Latency reporter
 	MaxSubscriptionsPerConnection int
+	NewRecorder                   func(url string) Recorder // Creates a frame recorder for each new connection, used to capture replay fixtures
+	FaultInjector                 FaultInjector             // Injects faults into inbound frames on every connection, used for resilience testing
 
 	// connectionManager stores all *potential* connections for the exchange, organised within websocket structs.
 	// For example, separate connections can be used for Spot, Margin, and Futures trading. This structure is especially useful
@@ -163,6 +166,8 @@ type websocket struct {
 	setup         *ConnectionSetup
 	subscriptions *subscription.Store
 	connections   []Connection
+	redundant     *websocket // hot standby carrying the same subscriptions, linked in both directions
+	standby       bool       // set on the redundant copy so its subscriptions are not reported twice
 }
 
 var globalReporter Reporter
@@ -225,6 +230,7 @@ func (m *Manager) Setup(s *ManagerSetup) error {
 	m.setEnabled(s.ExchangeConfig.Features.Enabled.Websocket)
 
 	m.useMultiConnectionManagement = s.UseMultiConnectionManagement
+	m.redundancy = s.ExchangeConfig.Orderbook.WebsocketRedundancy
 
 	if !m.useMultiConnectionManagement {
 		// TODO: Remove this block when all exchanges are updated and backwards
@@ -349,6 +355,12 @@ func (m *Manager) SetupNewConnection(c *ConnectionSetup) error {
 		if c.Handler == nil {
 			return fmt.Errorf("%w: %w", errConnSetup, errWebsocketDataHandlerUnset)
 		}
+		if c.MaxSubscriptions < 0 {
+			return fmt.Errorf("%w: %w", errConnSetup, errInvalidMaxSubscriptions)
+		}
+		if c.MaxConnections < 0 {
+			return fmt.Errorf("%w: %w", errConnSetup, errInvalidMaxConnections)
+		}
 
 		if c.MessageFilter != nil && !reflect.TypeOf(c.MessageFilter).Comparable() {
 			return errMessageFilterNotComparable
@@ -364,7 +376,11 @@ func (m *Manager) SetupNewConnection(c *ConnectionSetup) error {
 				return fmt.Errorf("%w: %w", errConnSetup, errDuplicateConnectionSetup)
 			}
 		}
-		m.connectionManager = append(m.connectionManager, &websocket{setup: c, subscriptions: subscription.NewStore()})
+		ws := &websocket{setup: c, subscriptions: subscription.NewStore()}
+		m.connectionManager = append(m.connectionManager, ws)
+		if m.redundancy && c.AllowRedundancy {
+			m.connectionManager = append(m.connectionManager, newRedundantWebsocket(ws))
+		}
 		return nil
 	}
 
@@ -394,6 +410,10 @@ func (m *Manager) createConnectionFromSetup(c *ConnectionSetup) *connection {
 	if c.ConnectionRateLimiter != nil {
 		rateLimit = c.ConnectionRateLimiter()
 	}
+	var recorder Recorder
+	if m.NewRecorder != nil {
+		recorder = m.NewRecorder(connectionURL)
+	}
 	return &connection{
 		ExchangeName:         m.exchangeName,
 		URL:                  connectionURL,
@@ -407,6 +427,8 @@ func (m *Manager) createConnectionFromSetup(c *ConnectionSetup) *connection {
 		Match:                match,
 		RateLimit:            rateLimit,
 		Reporter:             c.ConnectionLevelReporter,
+		Recorder:             recorder,
+		Faults:               m.FaultInjector,
 		RateLimitDefinitions: m.rateLimitDefinitions,
 		subscriptions:        subscription.NewStore(),
 	}
@@ -581,7 +603,7 @@ func (m *Manager) connect(ctx context.Context) error {
 			continue
 		}
 
-		for _, batchCandidates := range common.Batch(remainingSubs, m.MaxSubscriptionsPerConnection) {
+		for _, batchCandidates := range shardSubscriptions(remainingSubs, m.subscriptionLimit(ws)) {
 			batchRemainingSubs, err := m.absorbTrackableSubscriptionsAndValidate(ctx, ws, batchCandidates)
 			if err != nil {
 				subscriptionError = common.AppendError(subscriptionError, fmt.Errorf("subscription error on [conn:%d] [URL:%s]: %w ", i+1, ws.setup.URL, err))
@@ -651,11 +673,17 @@ func (m *Manager) connect(ctx context.Context) error {
 }
 
 func (m *Manager) createConnectAndSubscribe(ctx context.Context, ws *websocket, subs subscription.List) error {
-	if m.MaxSubscriptionsPerConnection > 0 && len(subs) > m.MaxSubscriptionsPerConnection {
-		return fmt.Errorf("%w %w: max subs allowed %d, requested %d", common.ErrFatal, errSubscriptionsExceedsLimit, m.MaxSubscriptionsPerConnection, len(subs))
+	if limit := m.subscriptionLimit(ws); limit > 0 && len(subs) > limit {
+		return fmt.Errorf("%w %w: max subs allowed %d, requested %d", common.ErrFatal, errSubscriptionsExceedsLimit, limit, len(subs))
+	}
+	if err := m.checkConnectionCapacity(ws, subs); err != nil {
+		return fmt.Errorf("%w: %w", ErrSubscriptionFailure, err)
 	}
 
 	conn := m.createConnectionFromSetup(ws.setup)
+	if ws.redundant != nil {
+		conn.onFault = func(c *connection, err error) bool { return m.failover(ctx, ws, c, err) }
+	}
 
 	if err := ws.setup.Connector(ctx, conn); err != nil {
 		return fmt.Errorf("%w: %w", common.ErrFatal, err)
@@ -1078,6 +1106,7 @@ func (m *Manager) observeConnection(ctx context.Context, t *time.Timer) (exit bo
 	case err := <-m.ReadMessageErrors:
 		if errors.Is(err, errConnectionFault) {
 			log.Warnf(log.WebsocketMgr, "%v websocket has been disconnected. Reason: %v", m.exchangeName, err)
+			m.reportReconnect()
 			if m.IsConnected() {
 				if shutdownErr := m.Shutdown(); shutdownErr != nil {
 					log.Errorf(log.WebsocketMgr, "%v websocket: connectionMonitor shutdown err: %s", m.exchangeName, shutdownErr)
@@ -1154,12 +1183,25 @@ func (m *Manager) observeTraffic(timeout <-chan time.Time, onTimeout func()) boo
 			log.Warnf(log.WebsocketMgr, "%v websocket: has not received a traffic alert in %v. Reconnecting", m.exchangeName, m.trafficTimeout)
 		}
 		if m.IsConnected() && onTimeout != nil {
+			m.reportReconnect()
 			onTimeout()
 		}
 	}
 	return true
 }
 
+// reportReconnect notifies the exchange or global reporter that the connection
+// is being reset
+func (m *Manager) reportReconnect() {
+	r := m.ExchangeLevelReporter
+	if r == nil {
+		r = globalReporter
+	}
+	if rep, ok := r.(ReconnectReporter); ok {
+		rep.Reconnect(m.exchangeName)
+	}
+}
+
 // signalReceived checks if a signal has been received, this also clears the signal.
 func signalReceived(ch chan struct{}) bool {
 	select {
@@ -1198,6 +1240,9 @@ func (m *Manager) GetConnection(messageFilter any) (Connection, error) {
 			continue
 		}
 		if len(ws.connections) == 0 {
+			if ws.redundant != nil && len(ws.redundant.connections) != 0 {
+				return ws.redundant.connections[0], nil
+			}
 			return nil, fmt.Errorf("%s: %s %w associated with message filter: '%v'", m.exchangeName, ws.setup.URL, ErrNotConnected, messageFilter)
 		}
 		return ws.connections[0], nil
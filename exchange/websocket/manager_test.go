@@ -12,6 +12,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -23,6 +24,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/encoding/json"
 	"github.com/thrasher-corp/gocryptotrader/exchange/stream"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/chaos"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/subscription"
@@ -1140,9 +1142,11 @@ type inspection struct {
 func (i inspection) IsFinal([]byte) bool { return i.breakEarly }
 
 type reporter struct {
-	name string
-	msg  []byte
-	t    time.Duration
+	name       string
+	msg        []byte
+	t          time.Duration
+	messages   atomic.Int64
+	reconnects atomic.Int64
 }
 
 func (r *reporter) Latency(name string, payload []byte, t time.Duration) {
@@ -1151,6 +1155,14 @@ func (r *reporter) Latency(name string, payload []byte, t time.Duration) {
 	r.t = t
 }
 
+func (r *reporter) Message(string) {
+	r.messages.Add(1)
+}
+
+func (r *reporter) Reconnect(string) {
+	r.reconnects.Add(1)
+}
+
 // readMessages helper func
 func readMessages(t *testing.T, wc *connection) {
 	t.Helper()
@@ -1383,6 +1395,13 @@ func TestSetupNewConnection(t *testing.T) {
 	err = web.SetupNewConnection(&ConnectionSetup{URL: "urlstring", Authenticated: true})
 	assert.NoError(t, err, "SetupNewConnection should not error")
 
+	f, err := chaos.New(&chaos.Config{})
+	require.NoError(t, err, "chaos.New must not error")
+	web.FaultInjector = f
+	err = web.SetupNewConnection(&ConnectionSetup{URL: "urlstring"})
+	require.NoError(t, err, "SetupNewConnection must not error")
+	assert.Same(t, f, web.Conn.(*connection).Faults, "connections should use the manager's fault injector")
+
 	// Test connection candidates for multi connection tracking.
 	multi := NewManager()
 	set := newDefaultSetup()
@@ -1417,6 +1436,16 @@ func TestSetupNewConnection(t *testing.T) {
 	require.ErrorIs(t, err, errWebsocketDataHandlerUnset)
 
 	connSetup.Handler = func(context.Context, Connection, []byte) error { return nil }
+	connSetup.MaxSubscriptions = -1
+	err = multi.SetupNewConnection(connSetup)
+	require.ErrorIs(t, err, errInvalidMaxSubscriptions)
+
+	connSetup.MaxSubscriptions = 0
+	connSetup.MaxConnections = -1
+	err = multi.SetupNewConnection(connSetup)
+	require.ErrorIs(t, err, errInvalidMaxConnections)
+
+	connSetup.MaxConnections = 0
 	connSetup.MessageFilter = []string{"slices are super naughty and not comparable"}
 	err = multi.SetupNewConnection(connSetup)
 	require.ErrorIs(t, err, errMessageFilterNotComparable)
@@ -1549,6 +1578,7 @@ func TestLatency(t *testing.T) {
 	require.NoError(t, err)
 	require.NotEmpty(t, r.t, "Latency must have a duration")
 	require.Equal(t, exch, r.name, "Latency must have the correct exchange name")
+	require.Positive(t, r.messages.Load(), "Message must be reported for each inbound message")
 }
 
 func TestRemoveURLQueryString(t *testing.T) {
@@ -1696,6 +1726,8 @@ func TestMonitorTraffic(t *testing.T) { //nolint:tparallel // top-level parallel
 		ws := newManager()
 		ws.setState(connectedState)
 
+		r := &reporter{}
+		ws.ExchangeLevelReporter = r
 		shutdownCalled := false
 		require.True(t, ws.observeTraffic(newTimeoutSignal(), func() {
 			shutdownCalled = true
@@ -1703,6 +1735,7 @@ func TestMonitorTraffic(t *testing.T) { //nolint:tparallel // top-level parallel
 		}))
 		require.True(t, shutdownCalled, "timeout handler must be called when traffic is missing")
 		require.Equal(t, disconnectedState, ws.state.Load())
+		require.Equal(t, int64(1), r.reconnects.Load(), "Reconnect must be reported on traffic timeout")
 	})
 
 	t.Run("monitor traffic shell", func(t *testing.T) {
@@ -0,0 +1,100 @@
+package websocket
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/thrasher-corp/gocryptotrader/exchanges/subscription"
+)
+
+var (
+	errConnectionPoolExhausted = errors.New("connection pool exhausted")
+	errInvalidMaxConnections   = errors.New("max connections cannot be less than 0")
+)
+
+// subscriptionLimit returns the maximum number of subscriptions for connections in a websocket, 0 when unlimited
+func (m *Manager) subscriptionLimit(ws *websocket) int {
+	if ws != nil && ws.setup != nil && ws.setup.MaxSubscriptions > 0 {
+		return ws.setup.MaxSubscriptions
+	}
+	return m.MaxSubscriptionsPerConnection
+}
+
+// checkConnectionCapacity returns an error if the websocket cannot open another connection
+func (m *Manager) checkConnectionCapacity(ws *websocket, subs subscription.List) error {
+	if ws.setup.MaxConnections <= 0 {
+		return nil
+	}
+	if n := len(m.snapshotManagedConnections(ws)); n >= ws.setup.MaxConnections {
+		return fmt.Errorf("%w: %d of %d connections in use for %s, cannot shard %d subscriptions", errConnectionPoolExhausted, n, ws.setup.MaxConnections, ws.setup.URL, len(subs))
+	}
+	return nil
+}
+
+// shardSubscriptions splits subs into the fewest shards which respect limit, spreading subscriptions evenly so each
+// connection keeps similar headroom for later subscriptions. Order is preserved and a limit of 0 returns a single shard
+func shardSubscriptions(subs subscription.List, limit int) []subscription.List {
+	if len(subs) == 0 {
+		return nil
+	}
+	if limit <= 0 || len(subs) <= limit {
+		return []subscription.List{subs}
+	}
+	n := (len(subs) + limit - 1) / limit
+	size, extra := len(subs)/n, len(subs)%n
+	shards := make([]subscription.List, 0, n)
+	for i := range n {
+		end := size
+		if i < extra {
+			end++
+		}
+		shards = append(shards, subs[:end:end])
+		subs = subs[end:]
+	}
+	return shards
+}
+
+// GetConnectionHealth returns the health of every live connection. With multi connection management each connection
+// reports its own subscriptions, otherwise all subscriptions are reported against Conn
+func (m *Manager) GetConnectionHealth() []ConnectionHealth {
+	if m == nil {
+		return nil
+	}
+	if !m.useMultiConnectionManagement {
+		var health []ConnectionHealth
+		for i, conn := range []Connection{m.Conn, m.AuthConn} {
+			c, ok := conn.(*connection)
+			if !ok || c == nil || c.Connection == nil {
+				continue
+			}
+			h := c.health()
+			h.MaxSubscriptions = m.MaxSubscriptionsPerConnection
+			if i == 0 && m.subscriptions != nil {
+				h.Subscriptions = m.subscriptions.Len()
+			}
+			health = append(health, h)
+		}
+		return health
+	}
+
+	m.connectionManagerMu.RLock()
+	defer m.connectionManagerMu.RUnlock()
+	var health []ConnectionHealth
+	for _, ws := range m.connectionManager {
+		for _, conn := range ws.connections {
+			var h ConnectionHealth
+			if c, ok := conn.(*connection); ok {
+				h = c.health()
+			} else {
+				h = ConnectionHealth{URL: removeURLQueryString(conn.GetURL()), Connected: true}
+			}
+			h.MessageFilter = ws.setup.MessageFilter
+			h.MaxSubscriptions = m.subscriptionLimit(ws)
+			if store := conn.Subscriptions(); store != nil {
+				h.Subscriptions = store.Len()
+			}
+			health = append(health, h)
+		}
+	}
+	return health
+}
@@ -0,0 +1,152 @@
+package websocket
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	gws "github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/subscription"
+	mockws "github.com/thrasher-corp/gocryptotrader/internal/testing/websocket"
+)
+
+func TestShardSubscriptions(t *testing.T) {
+	t.Parallel()
+	subs := make(subscription.List, 10)
+	for i := range subs {
+		subs[i] = &subscription.Subscription{Channel: strconv.Itoa(i)}
+	}
+	for _, tc := range []struct {
+		subs  subscription.List
+		limit int
+		sizes []int
+	}{
+		{subs: nil, limit: 4},
+		{subs: subs, limit: 0, sizes: []int{10}},
+		{subs: subs, limit: 10, sizes: []int{10}},
+		{subs: subs, limit: 4, sizes: []int{4, 3, 3}},
+		{subs: subs, limit: 3, sizes: []int{3, 3, 2, 2}},
+		{subs: subs, limit: 1, sizes: []int{1, 1, 1, 1, 1, 1, 1, 1, 1, 1}},
+	} {
+		t.Run(strconv.Itoa(tc.limit), func(t *testing.T) {
+			t.Parallel()
+			shards := shardSubscriptions(tc.subs, tc.limit)
+			require.Len(t, shards, len(tc.sizes), "must return the correct number of shards")
+			var flattened subscription.List
+			for i, s := range shards {
+				assert.Len(t, s, tc.sizes[i], "shard %d should be the correct size", i)
+				flattened = append(flattened, s...)
+			}
+			if len(tc.subs) != 0 {
+				assert.Equal(t, tc.subs, flattened, "shards should preserve subscription order")
+			}
+		})
+	}
+}
+
+func TestSubscriptionLimit(t *testing.T) {
+	t.Parallel()
+	m := NewManager()
+	m.MaxSubscriptionsPerConnection = 5
+	assert.Equal(t, 5, m.subscriptionLimit(nil), "should return the manager limit without a websocket")
+	ws := &websocket{setup: &ConnectionSetup{}}
+	assert.Equal(t, 5, m.subscriptionLimit(ws), "should return the manager limit when the setup has no limit")
+	ws.setup.MaxSubscriptions = 2
+	assert.Equal(t, 2, m.subscriptionLimit(ws), "should return the setup limit")
+}
+
+func TestConnectionPool(t *testing.T) {
+	t.Parallel()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mockws.WsMockUpgrader(t, w, r, mockws.EchoHandler)
+	}))
+	t.Cleanup(server.Close)
+
+	mgr := NewManager()
+	setup := newDefaultSetup()
+	setup.UseMultiConnectionManagement = true
+	require.NoError(t, mgr.Setup(setup), "Setup must not error")
+
+	var subs subscription.List
+	generate := func(n int) {
+		subs = make(subscription.List, n)
+		for i := range subs {
+			subs[i] = &subscription.Subscription{Channel: strconv.Itoa(i)}
+		}
+	}
+	generate(10)
+	require.NoError(t, mgr.SetupNewConnection(&ConnectionSetup{
+		URL:                   "ws" + server.URL[len("http"):] + "/ws",
+		MaxSubscriptions:      4,
+		MaxConnections:        3,
+		Connector:             func(ctx context.Context, conn Connection) error { return conn.Dial(ctx, gws.DefaultDialer, nil, nil) },
+		GenerateSubscriptions: func() (subscription.List, error) { return subs, nil },
+		Subscriber: func(_ context.Context, conn Connection, s subscription.List) error {
+			return mgr.AddSuccessfulSubscriptions(conn, s...)
+		},
+		Unsubscriber: func(_ context.Context, conn Connection, s subscription.List) error {
+			return mgr.RemoveSubscriptions(conn, s...)
+		},
+		Handler: func(context.Context, Connection, []byte) error { return nil },
+	}), "SetupNewConnection must not error")
+	t.Cleanup(func() { cleanupManagerMonitors(t, mgr) })
+
+	sizes := func() []int {
+		var s []int
+		for _, h := range mgr.GetConnectionHealth() {
+			assert.True(t, h.Connected, "connection should report as connected")
+			assert.False(t, h.ConnectedAt.IsZero(), "connection should report when it connected")
+			assert.Equal(t, 4, h.MaxSubscriptions, "connection should report the setup limit")
+			s = append(s, h.Subscriptions)
+		}
+		return s
+	}
+
+	require.NoError(t, mgr.Connect(t.Context()), "Connect must not error")
+	assert.Equal(t, []int{4, 3, 3}, sizes(), "subscriptions should be sharded evenly across the pool")
+
+	require.NoError(t, mgr.Shutdown(), "Shutdown must not error")
+	generate(12)
+	require.NoError(t, mgr.Connect(t.Context()), "Connect must not error")
+	assert.Equal(t, []int{4, 4, 4}, sizes(), "subscriptions should be rebalanced on reconnect")
+
+	require.NoError(t, mgr.Shutdown(), "Shutdown must not error")
+	generate(13)
+	err := mgr.Connect(t.Context())
+	assert.ErrorIs(t, err, errConnectionPoolExhausted, "Connect should error when the pool cannot carry every subscription")
+	assert.Equal(t, []int{4, 3, 3}, sizes(), "shards within the pool should remain subscribed")
+}
+
+func TestGetConnectionHealth(t *testing.T) {
+	t.Parallel()
+	var m *Manager
+	assert.Nil(t, m.GetConnectionHealth(), "should return nil for a nil manager")
+
+	m = NewManager()
+	require.NoError(t, m.Setup(newDefaultSetup()), "Setup must not error")
+	assert.Empty(t, m.GetConnectionHealth(), "should not report connections which have not been dialled")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mockws.WsMockUpgrader(t, w, r, mockws.EchoHandler)
+	}))
+	t.Cleanup(server.Close)
+	conn := m.createConnectionFromSetup(&ConnectionSetup{URL: "ws" + server.URL[len("http"):] + "/ws?secret=1"})
+	m.Conn = conn
+	require.NoError(t, conn.Dial(t.Context(), gws.DefaultDialer, nil, nil), "Dial must not error")
+	t.Cleanup(func() { assert.NoError(t, conn.Shutdown(), "Shutdown should not error") })
+	require.NoError(t, m.AddSuccessfulSubscriptions(nil, &subscription.Subscription{Channel: "a"}), "AddSuccessfulSubscriptions must not error")
+	require.NoError(t, conn.SendRawMessage(t.Context(), 0, gws.TextMessage, []byte("hello")), "SendRawMessage must not error")
+	require.NotNil(t, conn.ReadMessage().Raw, "ReadMessage must return the echoed message")
+
+	health := m.GetConnectionHealth()
+	require.Len(t, health, 1, "must report the connection")
+	assert.Equal(t, "ws"+server.URL[len("http"):]+"/ws", health[0].URL, "URL should not include the query string")
+	assert.True(t, health[0].Connected, "connection should report as connected")
+	assert.Equal(t, 1, health[0].Subscriptions, "subscriptions should be reported against the connection")
+	assert.Equal(t, uint64(1), health[0].Messages, "messages should be counted")
+	assert.False(t, health[0].LastMessage.IsZero(), "last message time should be set")
+}
@@ -0,0 +1,105 @@
+package websocket
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/exchanges/subscription"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// newRedundantWebsocket returns a hot standby for ws which carries the same subscriptions on its own connections
+func newRedundantWebsocket(ws *websocket) *websocket {
+	setup := *ws.setup
+	setup.URL = cmp.Or(setup.RedundantURL, setup.URL)
+	standby := &websocket{setup: &setup, subscriptions: subscription.NewStore(), redundant: ws, standby: true}
+	ws.redundant = standby
+	return standby
+}
+
+// hasLiveConnection returns true if any connection for the websocket is connected
+// connectionManagerMu must be held by the caller
+func (ws *websocket) hasLiveConnection() bool {
+	return slices.ContainsFunc(ws.connections, func(conn Connection) bool {
+		c, ok := conn.(*connection)
+		return !ok || c.IsConnected()
+	})
+}
+
+// failover drops a faulted connection when its redundant partner is still live, so the manager stays connected and
+// data continues to flow from the partner while the dropped connection is restored in the background
+// Returns false if the fault must be handled by a full reconnect
+func (m *Manager) failover(ctx context.Context, ws *websocket, c *connection, err error) bool {
+	if !m.IsConnected() {
+		return false
+	}
+	m.connectionManagerMu.Lock()
+	if ws.redundant == nil || !ws.redundant.hasLiveConnection() {
+		m.connectionManagerMu.Unlock()
+		return false
+	}
+	delete(m.connections, c)
+	ws.connections = slices.DeleteFunc(ws.connections, func(conn Connection) bool { return conn == c })
+	for _, sub := range c.subscriptions.List() {
+		if err := ws.subscriptions.Remove(sub); err != nil {
+			log.Warnf(log.WebsocketMgr, "%v websocket: failover unable to remove subscription %s: %v", m.exchangeName, sub, err)
+		}
+	}
+	c.subscriptions.Clear()
+	m.connectionManagerMu.Unlock()
+
+	log.Warnf(log.WebsocketMgr, "%v websocket: connection %s faulted, failing over to redundant connection %s. Reason: %v", m.exchangeName, removeURLQueryString(c.URL), removeURLQueryString(ws.redundant.setup.URL), err)
+	m.reportReconnect()
+	go m.restoreRedundantConnection(ctx, ws, c.shutdown)
+	return true
+}
+
+// restoreRedundantConnection reconnects and resubscribes a websocket after failover, retrying each connection monitor
+// cycle until it succeeds or the manager is shut down
+func (m *Manager) restoreRedundantConnection(ctx context.Context, ws *websocket, shutdownC <-chan struct{}) {
+	for {
+		select {
+		case <-shutdownC:
+			return
+		case <-ctx.Done():
+			return
+		default:
+		}
+		err := m.restoreConnection(ctx, ws, shutdownC)
+		if err == nil {
+			return
+		}
+		log.Errorf(log.WebsocketMgr, "%v websocket: unable to restore redundant connection %s: %v", m.exchangeName, removeURLQueryString(ws.setup.URL), err)
+		select {
+		case <-shutdownC:
+			return
+		case <-ctx.Done():
+			return
+		case <-time.After(m.connectionMonitorDelay):
+		}
+	}
+}
+
+func (m *Manager) restoreConnection(ctx context.Context, ws *websocket, shutdownC <-chan struct{}) error {
+	// Holding m.m stops shutdown from waiting on the wait group while a new reader is being added
+	m.m.Lock()
+	defer m.m.Unlock()
+	select {
+	case <-shutdownC:
+		return nil
+	default:
+	}
+	if !m.IsConnected() {
+		return nil // A full reconnect will restore the connection
+	}
+	if ws.setup.SubscriptionsNotRequired {
+		return m.createConnectAndSubscribe(ctx, ws, nil)
+	}
+	subs, err := ws.setup.GenerateSubscriptions()
+	if err != nil {
+		return err
+	}
+	return m.scaleConnectionsToSubscriptions(ctx, ws, subs)
+}
@@ -0,0 +1,91 @@
+package websocket
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	gws "github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/subscription"
+	mockws "github.com/thrasher-corp/gocryptotrader/internal/testing/websocket"
+)
+
+func TestNewRedundantWebsocket(t *testing.T) {
+	t.Parallel()
+	ws := &websocket{setup: &ConnectionSetup{URL: "wss://primary", MessageFilter: "book"}}
+	standby := newRedundantWebsocket(ws)
+	assert.Same(t, ws, standby.redundant, "standby should link to the primary")
+	assert.Same(t, standby, ws.redundant, "primary should link to the standby")
+	assert.True(t, standby.standby, "standby should be flagged")
+	assert.Equal(t, "wss://primary", standby.setup.URL, "standby should default to the primary URL")
+	assert.Equal(t, "book", standby.setup.MessageFilter, "standby should share the message filter")
+	assert.NotSame(t, ws.setup, standby.setup, "standby should not share the primary setup")
+
+	ws = &websocket{setup: &ConnectionSetup{URL: "wss://primary", RedundantURL: "wss://backup"}}
+	assert.Equal(t, "wss://backup", newRedundantWebsocket(ws).setup.URL, "standby should use the redundant URL")
+	assert.Equal(t, "wss://primary", ws.setup.URL, "primary URL should not change")
+}
+
+func TestRedundantFailover(t *testing.T) {
+	t.Parallel()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mockws.WsMockUpgrader(t, w, r, mockws.EchoHandler)
+	}))
+	t.Cleanup(server.Close)
+
+	mgr := NewManager()
+	setup := newDefaultSetup()
+	setup.UseMultiConnectionManagement = true
+	setup.ExchangeConfig.Orderbook.WebsocketRedundancy = true
+	require.NoError(t, mgr.Setup(setup), "Setup must not error")
+
+	subs := subscription.List{{Channel: "orderbook"}, {Channel: "trades"}}
+	require.NoError(t, mgr.SetupNewConnection(&ConnectionSetup{
+		URL:                   "ws" + server.URL[len("http"):] + "/ws",
+		AllowRedundancy:       true,
+		Connector:             func(ctx context.Context, conn Connection) error { return conn.Dial(ctx, gws.DefaultDialer, nil, nil) },
+		GenerateSubscriptions: func() (subscription.List, error) { return subs.Clone(), nil },
+		Subscriber: func(_ context.Context, conn Connection, s subscription.List) error {
+			return mgr.AddSuccessfulSubscriptions(conn, s...)
+		},
+		Unsubscriber: func(_ context.Context, conn Connection, s subscription.List) error {
+			return mgr.RemoveSubscriptions(conn, s...)
+		},
+		Handler: func(context.Context, Connection, []byte) error { return nil },
+	}), "SetupNewConnection must not error")
+	t.Cleanup(func() { cleanupManagerMonitors(t, mgr) })
+	require.Len(t, mgr.connectionManager, 2, "must set up a standby alongside the primary")
+
+	require.NoError(t, mgr.Connect(t.Context()), "Connect must not error")
+	health := mgr.GetConnectionHealth()
+	require.Len(t, health, 2, "must connect both the primary and the standby")
+	for _, h := range health {
+		assert.Equal(t, 2, h.Subscriptions, "each connection should carry every subscription")
+	}
+	assert.Len(t, mgr.GetSubscriptions(), 2, "GetSubscriptions should not report standby subscriptions")
+
+	primary, ok := mgr.snapshotManagedConnections(mgr.connectionManager[0])[0].(*connection)
+	require.True(t, ok, "primary connection must be a *connection")
+	require.NoError(t, primary.Connection.NetConn().Close(), "closing the primary socket must not error")
+
+	require.Eventually(t, func() bool {
+		conns := mgr.snapshotManagedConnections(mgr.connectionManager[0])
+		return len(conns) == 1 && conns[0] != primary
+	}, 5*time.Second, 10*time.Millisecond, "primary connection must be restored")
+	assert.True(t, mgr.IsConnected(), "manager should stay connected through failover")
+	assert.Len(t, mgr.GetConnectionHealth(), 2, "both connections should be live after restore")
+	assert.Empty(t, mgr.ReadMessageErrors, "failover should not trigger a full reconnect")
+	assert.Empty(t, mgr.connectionManager[0].subscriptions.Missing(subs), "restored connection should resubscribe")
+
+	standby, ok := mgr.snapshotManagedConnections(mgr.connectionManager[1])[0].(*connection)
+	require.True(t, ok, "standby connection must be a *connection")
+	standby.setConnectedStatus(false)
+	c, ok := mgr.snapshotManagedConnections(mgr.connectionManager[0])[0].(*connection)
+	require.True(t, ok, "restored connection must be a *connection")
+	assert.False(t, mgr.failover(t.Context(), mgr.connectionManager[0], c, errConnectionFault), "failover should not occur without a live partner")
+	standby.setConnectedStatus(true)
+}
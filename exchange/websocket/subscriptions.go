@@ -224,6 +224,32 @@ func (m *Manager) GetSubscription(key any) *subscription.Subscription {
 	return nil
 }
 
+// GetSubscriptionConnection returns the connection carrying a subscription at the key provided in a multi connection
+// context, so exchanges can resubscribe outside of the connection's handler e.g. when resyncing an orderbook
+// Standby connections are skipped as they carry the same subscriptions as their primary
+func (m *Manager) GetSubscriptionConnection(key any) (Connection, error) {
+	if err := common.NilGuard(m); err != nil {
+		return nil, err
+	}
+	if key == nil {
+		return nil, fmt.Errorf("%w: key", common.ErrNilPointer)
+	}
+	if !m.useMultiConnectionManagement {
+		return nil, fmt.Errorf("%s: multi connection management not enabled %w please use exported Conn and AuthConn fields", m.exchangeName, errCannotObtainOutboundConnection)
+	}
+	for _, ws := range m.snapshotConnectionManager() {
+		if ws.standby {
+			continue
+		}
+		for _, conn := range m.snapshotManagedConnections(ws) {
+			if conn.Subscriptions().Get(key) != nil {
+				return conn, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("%s: %w: %v", m.exchangeName, subscription.ErrNotFound, key)
+}
+
 // GetSubscriptions returns a new slice of the subscriptions
 func (m *Manager) GetSubscriptions() subscription.List {
 	if m == nil {
@@ -231,6 +257,9 @@ func (m *Manager) GetSubscriptions() subscription.List {
 	}
 	var subs subscription.List
 	for _, ws := range m.snapshotConnectionManager() {
+		if ws.standby {
+			continue
+		}
 		m.connectionManagerMu.RLock()
 		store := ws.subscriptions
 		if store != nil {
@@ -249,6 +278,7 @@ func (m *Manager) GetSubscriptions() subscription.List {
 func (m *Manager) checkSubscriptions(conn Connection, subs subscription.List) error {
 	var subscriptionStore *subscription.Store
 	var usedCapacity int
+	limit := m.MaxSubscriptionsPerConnection
 	if ws, ok := m.managedWebsocket(conn); ok {
 		if ws.subscriptions == nil {
 			return fmt.Errorf("%w: Websocket.subscriptions", common.ErrNilPointer)
@@ -265,6 +295,7 @@ func (m *Manager) checkSubscriptions(conn Connection, subs subscription.List) er
 		}
 		subscriptionStore = ws.subscriptions
 		usedCapacity = connSubStore.Len()
+		limit = m.subscriptionLimit(ws)
 	} else {
 		subscriptionStore = m.subscriptionStore(nil)
 		if subscriptionStore == nil {
@@ -273,12 +304,12 @@ func (m *Manager) checkSubscriptions(conn Connection, subs subscription.List) er
 		usedCapacity = subscriptionStore.Len()
 	}
 
-	if m.MaxSubscriptionsPerConnection > 0 && usedCapacity+len(subs) > m.MaxSubscriptionsPerConnection {
+	if limit > 0 && usedCapacity+len(subs) > limit {
 		return fmt.Errorf("%w: current subscriptions: %v, incoming subscriptions: %v, max subscriptions per connection: %v",
 			errSubscriptionsExceedsLimit,
 			usedCapacity,
 			len(subs),
-			m.MaxSubscriptionsPerConnection)
+			limit)
 	}
 
 	for _, s := range subs {
@@ -494,7 +525,7 @@ func (m *Manager) scaleConnectionsToSubscriptions(ctx context.Context, ws *webso
 		}
 
 		// Spawn new connections if there are still subscriptions left to process
-		for _, batch := range common.Batch(currentSubs, m.MaxSubscriptionsPerConnection) {
+		for _, batch := range shardSubscriptions(currentSubs, m.subscriptionLimit(ws)) {
 			toConnect, err := m.absorbTrackableSubscriptionsAndValidate(ctx, ws, batch)
 			if err != nil {
 				return err
@@ -583,14 +614,16 @@ func (m *Manager) subscribeToConnection(ctx context.Context, conn Connection, su
 		return nil, fmt.Errorf("websocket connection %w", err)
 	}
 
+	ws, _ := m.managedWebsocket(conn)
+	limit := m.subscriptionLimit(ws)
 	usedCap := store.Len()
-	if m.MaxSubscriptionsPerConnection > 0 && usedCap >= m.MaxSubscriptionsPerConnection {
+	if limit > 0 && usedCap >= limit {
 		return subs, nil // No capacity left for this connection
 	}
 
 	availableCap := len(subs)
-	if m.MaxSubscriptionsPerConnection > 0 {
-		availableCap = m.MaxSubscriptionsPerConnection - usedCap
+	if limit > 0 {
+		availableCap = limit - usedCap
 	}
 
 	if availableCap > len(subs) {
@@ -206,6 +206,34 @@ func TestGetSubscription(t *testing.T) {
 	assert.Same(t, s, w.GetSubscription(42), "GetSubscription should delegate to the store")
 }
 
+func TestGetSubscriptionConnection(t *testing.T) {
+	t.Parallel()
+	_, err := (*Manager)(nil).GetSubscriptionConnection(42)
+	assert.ErrorIs(t, err, common.ErrNilPointer, "GetSubscriptionConnection should error on a nil Manager")
+	m := NewManager()
+	_, err = m.GetSubscriptionConnection(nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer, "GetSubscriptionConnection should error on a nil key")
+	_, err = m.GetSubscriptionConnection(42)
+	assert.ErrorIs(t, err, errCannotObtainOutboundConnection, "GetSubscriptionConnection should error without multi connection management")
+
+	m.useMultiConnectionManagement = true
+	primary := &connection{subscriptions: subscription.NewStore()}
+	standby := &connection{subscriptions: subscription.NewStore()}
+	other := &connection{subscriptions: subscription.NewStore()}
+	require.NoError(t, standby.subscriptions.Add(&subscription.Subscription{Key: 42}), "Add must not error")
+	require.NoError(t, primary.subscriptions.Add(&subscription.Subscription{Key: 42}), "Add must not error")
+	require.NoError(t, other.subscriptions.Add(&subscription.Subscription{Key: 45}), "Add must not error")
+	m.connectionManager = []*websocket{
+		{setup: &ConnectionSetup{}, connections: []Connection{standby}, standby: true},
+		{setup: &ConnectionSetup{}, connections: []Connection{other, primary}},
+	}
+	conn, err := m.GetSubscriptionConnection(42)
+	require.NoError(t, err, "GetSubscriptionConnection must not error")
+	assert.Same(t, primary, conn, "GetSubscriptionConnection should return the primary connection carrying the subscription")
+	_, err = m.GetSubscriptionConnection(1337)
+	assert.ErrorIs(t, err, subscription.ErrNotFound, "GetSubscriptionConnection should error when no connection carries the subscription")
+}
+
 // TestGetSubscriptions logic test
 func TestGetSubscriptions(t *testing.T) {
 	t.Parallel()
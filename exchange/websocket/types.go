@@ -5,6 +5,7 @@ import (
 
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/chaos"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 )
 
@@ -37,3 +38,46 @@ type UnhandledMessageWarning struct {
 type Reporter interface {
 	Latency(name string, message []byte, t time.Duration)
 }
+
+// Recorder captures the raw frames sent and received on a connection. If the
+// recorder also implements Saver it is saved when the connection shuts down
+type Recorder interface {
+	RecordFrame(outbound bool, messageType int, data []byte)
+}
+
+// Saver is an optional Recorder extension which persists recorded frames
+type Saver interface {
+	Save() error
+}
+
+// FaultInjector decides what happens to each inbound frame so dropped,
+// duplicated and delayed frames and forced disconnects can be simulated
+type FaultInjector interface {
+	Frame(data []byte) chaos.FrameAction
+}
+
+// MessageReporter is an optional Reporter extension which is notified of each
+// inbound message read from a connection
+type MessageReporter interface {
+	Message(name string)
+}
+
+// ReconnectReporter is an optional Reporter extension which is notified when a
+// connection is torn down due to a fault or traffic timeout
+type ReconnectReporter interface {
+	Reconnect(name string)
+}
+
+// ConnectionHealth reports the state of a single websocket connection
+type ConnectionHealth struct {
+	URL           string
+	MessageFilter any
+	Connected     bool
+	// Subscriptions is the number of subscriptions carried by the connection
+	// and MaxSubscriptions the limit it is sharded against, 0 when unlimited
+	Subscriptions    int
+	MaxSubscriptions int
+	ConnectedAt      time.Time
+	LastMessage      time.Time
+	Messages         uint64
+}
@@ -180,7 +180,8 @@ func (e *Exchange) SetDefaults() {
 	var err error
 	e.Requester, err = request.New(e.Name,
 		common.NewHTTPClientWithTimeout(exchange.DefaultHTTPTimeout),
-		request.WithLimiter(GetRateLimits()))
+		request.WithLimiter(GetRateLimits()),
+		request.WithRateLimitHeaderParser(parseRateLimitHeaders, request.DefaultAdaptiveThreshold))
 	if err != nil {
 		log.Errorln(log.ExchangeSys, err)
 	}
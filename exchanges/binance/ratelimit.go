@@ -1,6 +1,9 @@
 package binance
 
 import (
+	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
@@ -193,3 +196,84 @@ func orderbookLimit(depth uint64) request.EndpointLimit {
 
 	return spotOrderbookDepth5000Rate
 }
+
+// parseRateLimitHeaders reports request weight and order count usage from the
+// X-MBX-USED-WEIGHT-* and X-MBX-ORDER-COUNT-* headers. Spot, USDT margined and
+// coin margined futures each have separate budgets, selected by host, so each
+// is mapped to the limiter of its market and exhausting one does not hold
+// requests for the others
+func parseRateLimitHeaders(resp *http.Response, _ time.Time) []request.RateLimitUsage {
+	if resp.Request == nil || resp.Request.URL == nil {
+		return nil
+	}
+	market, weightEndpoint, weightLimit := "spot", spotDefaultRate, int64(spotRequestRate)
+	orderEndpoint, orderInterval, orderLimit := spotOrderRate, spotOrderInterval, int64(spotOrderRequestRate)
+	switch host := resp.Request.URL.Hostname(); {
+	case strings.HasPrefix(host, "fapi."):
+		market, weightEndpoint, weightLimit = "usdtmarginedfutures", uFuturesDefaultRate, uFuturesRequestRate
+		orderEndpoint, orderInterval, orderLimit = uFuturesOrdersDefaultRate, uFuturesOrderInterval, uFuturesOrderRequestRate
+	case strings.HasPrefix(host, "dapi."):
+		market, weightEndpoint, weightLimit = "coinmarginedfutures", cFuturesDefaultRate, cFuturesRequestRate
+		orderEndpoint, orderInterval, orderLimit = cFuturesOrdersDefaultRate, cFuturesOrderInterval, cFuturesOrderRequestRate
+	}
+
+	var usage []request.RateLimitUsage
+	for k, v := range resp.Header {
+		k = strings.ToUpper(k)
+		var name, suffix string
+		switch {
+		case strings.HasPrefix(k, "X-MBX-USED-WEIGHT-"):
+			name, suffix = "REQUEST_WEIGHT", strings.TrimPrefix(k, "X-MBX-USED-WEIGHT-")
+		case strings.HasPrefix(k, "X-MBX-ORDER-COUNT-"):
+			name, suffix = "ORDERS", strings.TrimPrefix(k, "X-MBX-ORDER-COUNT-")
+		default:
+			continue
+		}
+		interval, ok := parseRateLimitInterval(suffix)
+		if !ok || len(v) == 0 {
+			continue
+		}
+		used, err := strconv.ParseInt(v[0], 10, 64)
+		if err != nil {
+			continue
+		}
+		u := request.RateLimitUsage{
+			Name:     market + " " + name + "_" + suffix,
+			Used:     used,
+			Interval: interval,
+		}
+		switch {
+		case name == "REQUEST_WEIGHT" && interval == time.Minute:
+			u.Limit, u.Endpoint = weightLimit, weightEndpoint
+		case name == "ORDERS" && interval == orderInterval:
+			u.Limit, u.Endpoint = orderLimit, orderEndpoint
+		}
+		usage = append(usage, u)
+	}
+	return usage
+}
+
+// parseRateLimitInterval parses Binance interval suffixes such as 1M and 10S
+func parseRateLimitInterval(s string) (time.Duration, bool) {
+	if len(s) < 2 {
+		return 0, false
+	}
+	n, err := strconv.ParseInt(s[:len(s)-1], 10, 64)
+	if err != nil || n <= 0 {
+		return 0, false
+	}
+	var unit time.Duration
+	switch s[len(s)-1] {
+	case 'S':
+		unit = time.Second
+	case 'M':
+		unit = time.Minute
+	case 'H':
+		unit = time.Hour
+	case 'D':
+		unit = 24 * time.Hour
+	default:
+		return 0, false
+	}
+	return time.Duration(n) * unit, true
+}
@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 )
@@ -79,3 +80,30 @@ func TestRateLimit_LimitStatic(t *testing.T) {
 		})
 	}
 }
+
+func TestParseRateLimitHeaders(t *testing.T) {
+	t.Parallel()
+	assert.Empty(t, parseRateLimitHeaders(&http.Response{}, time.Now()), "should return no usage without a request")
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, "https://fapi.binance.com/fapi/v1/time", http.NoBody)
+	require.NoError(t, err, "NewRequestWithContext must not error")
+	resp := &http.Response{Request: req, Header: http.Header{}}
+	resp.Header.Set("X-MBX-USED-WEIGHT-1M", "2300")
+	resp.Header.Set("X-MBX-ORDER-COUNT-10S", "12")
+	resp.Header.Set("X-MBX-ORDER-COUNT-1D", "50")
+	resp.Header.Set("X-MBX-USED-WEIGHT-1X", "1")
+	resp.Header.Set("X-MBX-USED-WEIGHT", "2300")
+	resp.Header.Set("X-MBX-ORDER-COUNT-1M", "bad")
+	assert.ElementsMatch(t, []request.RateLimitUsage{
+		{Name: "usdtmarginedfutures REQUEST_WEIGHT_1M", Used: 2300, Limit: uFuturesRequestRate, Interval: time.Minute, Endpoint: uFuturesDefaultRate},
+		{Name: "usdtmarginedfutures ORDERS_10S", Used: 12, Limit: uFuturesOrderRequestRate, Interval: 10 * time.Second, Endpoint: uFuturesOrdersDefaultRate},
+		{Name: "usdtmarginedfutures ORDERS_1D", Used: 50, Interval: 24 * time.Hour},
+	}, parseRateLimitHeaders(resp, time.Now()), "should parse usage headers for the futures budget")
+
+	resp.Request.URL.Host = "api.binance.com"
+	resp.Header = http.Header{}
+	resp.Header.Set("X-MBX-USED-WEIGHT-1M", "10")
+	assert.Equal(t, []request.RateLimitUsage{
+		{Name: "spot REQUEST_WEIGHT_1M", Used: 10, Limit: spotRequestRate, Interval: time.Minute, Endpoint: spotDefaultRate},
+	}, parseRateLimitHeaders(resp, time.Now()), "should parse usage headers for the spot budget")
+}
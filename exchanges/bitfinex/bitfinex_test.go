@@ -1617,6 +1617,29 @@ func TestWSOrderBook(t *testing.T) {
 	assert.ErrorIs(t, err, errNoSeqNo, "handleWSBookUpdate should send correct error")
 }
 
+func TestWSOrderBookChecksum(t *testing.T) {
+	t.Parallel()
+
+	e := new(Exchange)
+	require.NoError(t, testexch.Setup(e), "Test instance Setup must not error")
+	err := e.Websocket.AddSubscriptions(e.Websocket.Conn, &subscription.Subscription{Key: 23406, Asset: asset.Spot, Pairs: currency.Pairs{btcusdPair}, Channel: subscription.OrderbookChannel})
+	require.NoError(t, err, "AddSubscriptions must not error")
+	err = e.WsInsertSnapshot(btcusdPair, asset.Spot, []WebsocketBook{
+		{ID: 2, Price: 9348.8, Amount: 0.53},
+		{ID: 1, Price: 9348.8, Amount: 5.98979404},
+		{ID: 3, Price: 9350.1, Amount: -1.015},
+	}, false)
+	require.NoError(t, err, "WsInsertSnapshot must not error")
+	ob, err := e.Websocket.Orderbook.GetOrderbook(btcusdPair, asset.Spot)
+	require.NoError(t, err, "GetOrderbook must not error")
+	checksum := generateChecksum(ob)
+
+	err = e.wsHandleData(t.Context(), []byte(`[23406,"cs",`+strconv.FormatUint(uint64(checksum), 10)+`,7]`))
+	require.NoError(t, err, "wsHandleData must not error on a matching checksum")
+	err = e.wsHandleData(t.Context(), []byte(`[23406,"cs",`+strconv.FormatUint(uint64(checksum+1), 10)+`,8]`))
+	assert.ErrorIs(t, err, orderbook.ErrChecksumMismatch, "wsHandleData should error on a checksum mismatch")
+}
+
 func TestWSAllTrades(t *testing.T) {
 	t.Parallel()
 
@@ -2149,10 +2172,7 @@ var testOb = orderbook.Book{
 }
 
 func TestChecksum(t *testing.T) {
-	err := validateCRC32(&testOb, 190468240)
-	if err != nil {
-		t.Fatal(err)
-	}
+	assert.Equal(t, uint32(190468240), generateChecksum(&testOb), "generateChecksum should return the correct checksum")
 }
 
 func TestReOrderbyID(t *testing.T) {
@@ -8,10 +8,10 @@ import (
 	"fmt"
 	"hash/crc32"
 	"net/http"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
-	"sync"
 	"text/template"
 	"time"
 
@@ -101,17 +101,6 @@ var defaultSubscriptions = subscription.List{
 	{Enabled: true, Channel: subscription.OrderbookChannel, Asset: asset.All, Levels: 100, Params: map[string]any{"prec": "R0"}},
 }
 
-type checksum struct {
-	Token    uint32
-	Sequence int64
-}
-
-// checksumStore quick global for now
-var (
-	checksumStore = make(map[int]*checksum)
-	cMtx          sync.Mutex
-)
-
 var subscriptionNames = map[string]string{
 	subscription.TickerChannel:    wsTickerChannel,
 	subscription.OrderbookChannel: wsBookChannel,
@@ -605,41 +594,32 @@ func (e *Exchange) handleWSChannelUpdate(ctx context.Context, s *subscription.Su
 	return fmt.Errorf("%s unhandled channel update: %s", e.Name, s.Channel)
 }
 
+// handleWSChecksum verifies the orderbook against the checksum sent after each book iteration, by applying an empty
+// update which carries it through the websocket orderbook buffer
 func (e *Exchange) handleWSChecksum(c *subscription.Subscription, d []any) error {
 	if c == nil {
 		return fmt.Errorf("%w: Subscription param", common.ErrNilPointer)
 	}
-	var token uint32
-	if f, ok := d[2].(float64); !ok {
+	if len(c.Pairs) != 1 {
+		return subscription.ErrNotSinglePair
+	}
+	f, ok := d[2].(float64)
+	if !ok {
 		return common.GetTypeAssertError("float64", d[2], "checksum")
-	} else { //nolint:revive // using lexical variable requires else statement
-		token = uint32(f)
 	}
 	if len(d) < 4 {
 		return errNoSeqNo
 	}
-	var seqNo int64
-	if f, ok := d[3].(float64); !ok {
-		return common.GetTypeAssertError("float64", d[3], "seqNo")
-	} else { //nolint:revive // using lexical variable requires else statement
-		seqNo = int64(f)
-	}
-
-	chanID, ok := c.Key.(int)
-	if !ok {
-		return common.GetTypeAssertError("int", c.Key, "ChanID") // Should be impossible
-	}
-
-	cMtx.Lock()
-	checksumStore[chanID] = &checksum{
-		Token:    token,
-		Sequence: seqNo,
-	}
-	cMtx.Unlock()
-	return nil
+	return e.Websocket.Orderbook.Update(&orderbook.Update{
+		Pair:             c.Pairs[0],
+		Asset:            c.Asset,
+		UpdateTime:       time.Now(), // Not included in checksum
+		ExpectedChecksum: uint32(f),
+		AllowEmpty:       true,
+	})
 }
 
-func (e *Exchange) handleWSBookUpdate(ctx context.Context, c *subscription.Subscription, d []any) error {
+func (e *Exchange) handleWSBookUpdate(_ context.Context, c *subscription.Subscription, d []any) error {
 	if c == nil {
 		return fmt.Errorf("%w: Subscription param", common.ErrNilPointer)
 	}
@@ -657,8 +637,7 @@ func (e *Exchange) handleWSBookUpdate(ctx context.Context, c *subscription.Subsc
 	if len(d) < 3 {
 		return errNoSeqNo
 	}
-	sequenceNo, ok := d[2].(float64)
-	if !ok {
+	if _, ok := d[2].(float64); !ok {
 		return errors.New("type assertion failure")
 	}
 	var fundingRate bool
@@ -735,7 +714,7 @@ func (e *Exchange) handleWSBookUpdate(ctx context.Context, c *subscription.Subsc
 			})
 		}
 
-		if err := e.WsUpdateOrderbook(ctx, c, c.Pairs[0], c.Asset, newOrderbook, int64(sequenceNo), fundingRate); err != nil {
+		if err := e.WsUpdateOrderbook(c, c.Pairs[0], c.Asset, newOrderbook, fundingRate); err != nil {
 			return fmt.Errorf("updating orderbook error: %s",
 				err)
 		}
@@ -1506,7 +1485,7 @@ func (e *Exchange) WsInsertSnapshot(p currency.Pair, assetType asset.Item, books
 
 // WsUpdateOrderbook updates the orderbook list, removing and adding to the
 // orderbook sides
-func (e *Exchange) WsUpdateOrderbook(ctx context.Context, c *subscription.Subscription, p currency.Pair, assetType asset.Item, book []WebsocketBook, sequenceNo int64, fundingRate bool) error {
+func (e *Exchange) WsUpdateOrderbook(c *subscription.Subscription, p currency.Pair, assetType asset.Item, book []WebsocketBook, fundingRate bool) error {
 	if c == nil {
 		return fmt.Errorf("%w: Subscription param", common.ErrNilPointer)
 	}
@@ -1568,66 +1547,22 @@ func (e *Exchange) WsUpdateOrderbook(ctx context.Context, c *subscription.Subscr
 		}
 	}
 
-	chanID, ok := c.Key.(int)
-	if !ok {
-		return common.GetTypeAssertError("int", c.Key, "ChanID") // Should be impossible
-	}
-
-	cMtx.Lock()
-	checkme := checksumStore[chanID]
-	if checkme == nil {
-		cMtx.Unlock()
-		return e.Websocket.Orderbook.Update(&orderbookUpdate)
-	}
-	checksumStore[chanID] = nil
-	cMtx.Unlock()
-
-	if checkme.Sequence+1 == sequenceNo {
-		// Sequence numbers get dropped, if checksum is not in line with
-		// sequence, do not check.
-		ob, err := e.Websocket.Orderbook.GetOrderbook(p, assetType)
-		if err != nil {
-			return fmt.Errorf("cannot calculate websocket checksum: book not found for %s %s %w",
-				p,
-				assetType,
-				err)
-		}
-
-		if err = validateCRC32(ob, checkme.Token); err != nil {
-			log.Errorf(log.WebsocketMgr, "%s websocket orderbook update error, will resubscribe orderbook: %v", e.Name, err)
-			if e2 := e.resubOrderbook(ctx, c); e2 != nil {
-				log.Errorf(log.WebsocketMgr, "%s error resubscribing orderbook: %v", e.Name, e2)
-			}
-			return err
-		}
-	}
-
 	return e.Websocket.Orderbook.Update(&orderbookUpdate)
 }
 
-// resubOrderbook resubscribes the orderbook after a consistency error, probably a failed checksum,
-// which forces a fresh snapshot. If we don't do this the orderbook will keep erroring and drifting.
-// Flushing the orderbook happens immediately, but the ReSub itself is a go routine to avoid blocking the WS data channel
-func (e *Exchange) resubOrderbook(ctx context.Context, c *subscription.Subscription) error {
-	if c == nil {
-		return fmt.Errorf("%w: Subscription param", common.ErrNilPointer)
-	}
-	if len(c.Pairs) != 1 {
-		return subscription.ErrNotSinglePair
-	}
-	if err := e.Websocket.Orderbook.InvalidateOrderbook(c.Pairs[0], c.Asset); err != nil {
-		// Non-fatal error
-		log.Errorf(log.ExchangeSys, "%s error flushing orderbook: %v", e.Name, err)
-	}
-
-	// Resub will block so we have to do this in a goro
-	go func() {
-		if err := e.Websocket.ResubscribeToChannel(ctx, e.Websocket.Conn, c); err != nil {
-			log.Errorf(log.ExchangeSys, "%s error resubscribing orderbook: %v", e.Name, err)
+// resyncOrderbook resubscribes the orderbook after an update fails checksum verification, which forces a fresh
+// snapshot. If we don't do this the orderbook will keep erroring and drifting.
+func (e *Exchange) resyncOrderbook(ctx context.Context, p currency.Pair, a asset.Item) error {
+	var errs error
+	for _, s := range e.Websocket.GetSubscriptions() {
+		if s.Channel != subscription.OrderbookChannel || s.Asset != a || !s.Pairs.Contains(p, true) {
+			continue
 		}
-	}()
-
-	return nil
+		if err := e.Websocket.ResubscribeToChannel(ctx, e.Websocket.Conn, s); err != nil && !errors.Is(err, subscription.ErrInStateAlready) {
+			errs = common.AppendError(errs, err)
+		}
+	}
+	return errs
 }
 
 // generateSubscriptions returns a list of subscriptions from the configured subscriptions feature
@@ -2013,20 +1948,25 @@ func makeRequestInterface(channelName string, data any) []any {
 	return []any{0, channelName, nil, data}
 }
 
-func validateCRC32(book *orderbook.Book, token uint32) error {
+// generateChecksum returns the CRC32 checksum of the top 25 bids and asks, verified by the websocket orderbook buffer
+// against the checksum sent after each book iteration
+func generateChecksum(book *orderbook.Book) uint32 {
 	// Order ID's need to be sub-sorted in ascending order, this needs to be
-	// done on the main book to ensure that we do not cut price levels out below
-	reOrderByID(book.Bids)
-	reOrderByID(book.Asks)
+	// done on the whole book to ensure that we do not cut price levels out
+	// below. The levels are cloned as the snapshot references the stored book
+	bookBids := slices.Clone(book.Bids)
+	bookAsks := slices.Clone(book.Asks)
+	reOrderByID(bookBids)
+	reOrderByID(bookAsks)
 
 	// R0 precision calculation is based on order ID's and amount values
 	var bids, asks []orderbook.Level
 	for i := range 25 {
-		if i < len(book.Bids) {
-			bids = append(bids, book.Bids[i])
+		if i < len(bookBids) {
+			bids = append(bids, bookBids[i])
 		}
-		if i < len(book.Asks) {
-			asks = append(asks, book.Asks[i])
+		if i < len(bookAsks) {
+			asks = append(asks, bookAsks[i])
 		}
 	}
 
@@ -2060,15 +2000,7 @@ func validateCRC32(book *orderbook.Book, token uint32) error {
 	}
 
 	checksumStr := strings.TrimSuffix(check.String(), ":")
-	checksum := crc32.ChecksumIEEE([]byte(checksumStr))
-	if checksum == token {
-		return nil
-	}
-	return fmt.Errorf("invalid checksum for %s %s: calculated [%d] does not match [%d]",
-		book.Asset,
-		book.Pair,
-		checksum,
-		token)
+	return crc32.ChecksumIEEE([]byte(checksumStr))
 }
 
 // reOrderByID sub sorts orderbook items by its corresponding ID when price
@@ -0,0 +1,187 @@
+package chaos
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// ErrInjectedFault is returned for transport errors and disconnects raised by
+// an Injector, allowing callers to tell injected faults from real ones
+var ErrInjectedFault = errors.New("chaos injected fault")
+
+var (
+	errNilConfig          = errors.New("chaos config is nil")
+	errInvalidProbability = errors.New("probability must be between 0 and 1")
+	errInvalidStatusCode  = errors.New("invalid HTTP status code")
+	errNegativeLatency    = errors.New("latency cannot be negative")
+)
+
+// defaultHTTPErrorStatusCodes are used when no error status codes are set
+var defaultHTTPErrorStatusCodes = []int{
+	http.StatusInternalServerError,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// New returns an Injector for the supplied fault configuration
+func New(cfg *Config) (*Injector, error) {
+	if cfg == nil {
+		return nil, errNilConfig
+	}
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+	c := *cfg
+	if len(c.HTTPErrorStatusCodes) == 0 {
+		c.HTTPErrorStatusCodes = defaultHTTPErrorStatusCodes
+	}
+	seed := uint64(c.Seed) //nolint:gosec // Sign is irrelevant for a random seed
+	if c.Seed == 0 {
+		seed = uint64(time.Now().UnixNano()) //nolint:gosec // Sign is irrelevant for a random seed
+	}
+	return &Injector{cfg: c, rng: rand.New(rand.NewPCG(seed, seed))}, nil //nolint:gosec // Fault injection does not require a cryptographically secure source
+}
+
+func (c *Config) validate() error {
+	for name, p := range map[string]float64{
+		"latency":         c.LatencyProbability,
+		"HTTP error":      c.HTTPErrorProbability,
+		"transport error": c.TransportErrorProbability,
+		"truncate":        c.TruncateProbability,
+		"drop":            c.DropProbability,
+		"duplicate":       c.DuplicateProbability,
+		"sequence gap":    c.SequenceGapProbability,
+		"disconnect":      c.DisconnectProbability,
+	} {
+		if p < 0 || p > 1 {
+			return fmt.Errorf("%w: %s probability %v", errInvalidProbability, name, p)
+		}
+	}
+	if c.Latency < 0 || c.LatencyJitter < 0 {
+		return errNegativeLatency
+	}
+	for _, code := range c.HTTPErrorStatusCodes {
+		if code < 100 || code > 599 {
+			return fmt.Errorf("%w: %d", errInvalidStatusCode, code)
+		}
+	}
+	return nil
+}
+
+// Stats returns the number of faults injected so far
+func (i *Injector) Stats() Stats {
+	i.m.Lock()
+	defer i.m.Unlock()
+	return i.stats
+}
+
+// RoundTrip sends req using next, delaying the request, replacing the response
+// with an error status or transport error, or truncating the response body as
+// configured
+func (i *Injector) RoundTrip(req *http.Request, next func(*http.Request) (*http.Response, error)) (*http.Response, error) {
+	if d := i.delay(); d > 0 {
+		select {
+		case <-time.After(d):
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+	}
+
+	i.m.Lock()
+	transportErr := i.roll(i.cfg.TransportErrorProbability, &i.stats.TransportErrors)
+	var code int
+	if !transportErr && i.roll(i.cfg.HTTPErrorProbability, &i.stats.HTTPErrors) {
+		code = i.cfg.HTTPErrorStatusCodes[i.rng.IntN(len(i.cfg.HTTPErrorStatusCodes))]
+	}
+	truncate := !transportErr && code == 0 && i.roll(i.cfg.TruncateProbability, &i.stats.Truncations)
+	i.m.Unlock()
+
+	switch {
+	case transportErr:
+		return nil, fmt.Errorf("%w: %s %s transport error", ErrInjectedFault, req.Method, req.URL.Path)
+	case code != 0:
+		body := `{"error":"` + ErrInjectedFault.Error() + `"}`
+		return &http.Response{
+			Status:        strconv.Itoa(code) + " " + http.StatusText(code),
+			StatusCode:    code,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        http.Header{"Content-Type": []string{"application/json"}},
+			Body:          io.NopCloser(bytes.NewBufferString(body)),
+			ContentLength: int64(len(body)),
+			Request:       req,
+		}, nil
+	}
+
+	resp, err := next(req)
+	if err != nil || !truncate {
+		return resp, err
+	}
+	contents, err := io.ReadAll(resp.Body)
+	if closeErr := resp.Body.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, err
+	}
+	contents = contents[:len(contents)/2]
+	resp.Body = io.NopCloser(bytes.NewReader(contents))
+	resp.ContentLength = int64(len(contents))
+	resp.Header.Del("Content-Length")
+	return resp, nil
+}
+
+// Frame decides what happens to an inbound websocket frame, sleeping first if
+// latency is injected. Sequence gaps only apply to frames containing the
+// configured pattern, so compressed binary frames will not match
+func (i *Injector) Frame(data []byte) FrameAction {
+	if d := i.delay(); d > 0 {
+		time.Sleep(d)
+	}
+	i.m.Lock()
+	defer i.m.Unlock()
+	switch {
+	case i.roll(i.cfg.DisconnectProbability, &i.stats.Disconnects):
+		return Disconnect
+	case i.roll(i.cfg.DropProbability, &i.stats.Drops):
+		return Drop
+	case i.cfg.SequenceGapPattern != "" && bytes.Contains(data, []byte(i.cfg.SequenceGapPattern)) && i.roll(i.cfg.SequenceGapProbability, &i.stats.SequenceGaps):
+		return Drop
+	case i.roll(i.cfg.DuplicateProbability, &i.stats.Duplicates):
+		return Duplicate
+	default:
+		return Deliver
+	}
+}
+
+// delay returns the latency to inject, or zero if none
+func (i *Injector) delay() time.Duration {
+	i.m.Lock()
+	defer i.m.Unlock()
+	if !i.roll(i.cfg.LatencyProbability, &i.stats.Delays) {
+		return 0
+	}
+	d := i.cfg.Latency
+	if i.cfg.LatencyJitter > 0 {
+		d += time.Duration(i.rng.Int64N(int64(i.cfg.LatencyJitter)))
+	}
+	return d
+}
+
+// roll returns true with probability p, incrementing counter on success.
+// Calling function must hold the lock
+func (i *Injector) roll(p float64, counter *uint64) bool {
+	if p <= 0 || i.rng.Float64() >= p {
+		return false
+	}
+	*counter++
+	return true
+}
@@ -0,0 +1,142 @@
+package chaos
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+	_, err := New(nil)
+	assert.ErrorIs(t, err, errNilConfig)
+
+	_, err = New(&Config{DropProbability: 1.5})
+	assert.ErrorIs(t, err, errInvalidProbability)
+
+	_, err = New(&Config{HTTPErrorProbability: -0.1})
+	assert.ErrorIs(t, err, errInvalidProbability)
+
+	_, err = New(&Config{Latency: -time.Second})
+	assert.ErrorIs(t, err, errNegativeLatency)
+
+	_, err = New(&Config{HTTPErrorStatusCodes: []int{1000}})
+	assert.ErrorIs(t, err, errInvalidStatusCode)
+
+	i, err := New(&Config{})
+	require.NoError(t, err, "New must not error")
+	assert.Equal(t, defaultHTTPErrorStatusCodes, i.cfg.HTTPErrorStatusCodes, "default error status codes should be used")
+}
+
+func TestRoundTrip(t *testing.T) {
+	t.Parallel()
+	var calls int
+	next := func(req *http.Request) (*http.Response, error) {
+		calls++
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Length": []string{"10"}},
+			Body:       io.NopCloser(strings.NewReader(`{"a":"bc"}`)),
+			Request:    req,
+		}, nil
+	}
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, "https://test/path", http.NoBody)
+	require.NoError(t, err, "NewRequestWithContext must not error")
+
+	i, err := New(&Config{})
+	require.NoError(t, err, "New must not error")
+	resp, err := i.RoundTrip(req, next)
+	require.NoError(t, err, "RoundTrip must not error")
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err, "ReadAll must not error")
+	assert.Equal(t, `{"a":"bc"}`, string(body), "response should be passed through when no faults are configured")
+	assert.Equal(t, Stats{}, i.Stats(), "no faults should be recorded")
+
+	i, err = New(&Config{TransportErrorProbability: 1})
+	require.NoError(t, err, "New must not error")
+	_, err = i.RoundTrip(req, next)
+	assert.ErrorIs(t, err, ErrInjectedFault)
+
+	i, err = New(&Config{HTTPErrorProbability: 1, HTTPErrorStatusCodes: []int{http.StatusServiceUnavailable}})
+	require.NoError(t, err, "New must not error")
+	resp, err = i.RoundTrip(req, next)
+	require.NoError(t, err, "RoundTrip must not error")
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode, "injected status code should be returned")
+	assert.Equal(t, "503 Service Unavailable", resp.Status, "status should include the status text")
+	require.NoError(t, resp.Body.Close(), "Body.Close must not error")
+
+	i, err = New(&Config{TruncateProbability: 1})
+	require.NoError(t, err, "New must not error")
+	resp, err = i.RoundTrip(req, next)
+	require.NoError(t, err, "RoundTrip must not error")
+	body, err = io.ReadAll(resp.Body)
+	require.NoError(t, err, "ReadAll must not error")
+	assert.Equal(t, `{"a":`, string(body), "response body should be truncated")
+	assert.Equal(t, int64(5), resp.ContentLength, "content length should match the truncated body")
+	assert.Empty(t, resp.Header.Get("Content-Length"), "stale Content-Length header should be removed")
+
+	i, err = New(&Config{TruncateProbability: 1})
+	require.NoError(t, err, "New must not error")
+	_, err = i.RoundTrip(req, func(*http.Request) (*http.Response, error) { return nil, errors.New("fail") })
+	assert.Error(t, err, "RoundTrip should return errors from next")
+
+	i, err = New(&Config{Latency: time.Hour, LatencyProbability: 1})
+	require.NoError(t, err, "New must not error")
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+	_, err = i.RoundTrip(req.WithContext(ctx), next)
+	assert.ErrorIs(t, err, context.Canceled)
+
+	assert.Equal(t, 2, calls, "next should only be called when the request is not faulted")
+}
+
+func TestFrame(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		name   string
+		cfg    Config
+		data   string
+		action FrameAction
+		stats  Stats
+	}{
+		{name: "deliver", data: "a", action: Deliver},
+		{name: "disconnect", cfg: Config{DisconnectProbability: 1, DropProbability: 1}, data: "a", action: Disconnect, stats: Stats{Disconnects: 1}},
+		{name: "drop", cfg: Config{DropProbability: 1, DuplicateProbability: 1}, data: "a", action: Drop, stats: Stats{Drops: 1}},
+		{name: "sequence gap", cfg: Config{SequenceGapProbability: 1, SequenceGapPattern: "depthUpdate"}, data: `{"e":"depthUpdate"}`, action: Drop, stats: Stats{SequenceGaps: 1}},
+		{name: "sequence gap unmatched", cfg: Config{SequenceGapProbability: 1, SequenceGapPattern: "depthUpdate"}, data: `{"e":"trade"}`, action: Deliver},
+		{name: "duplicate", cfg: Config{DuplicateProbability: 1}, data: "a", action: Duplicate, stats: Stats{Duplicates: 1}},
+		{name: "latency", cfg: Config{Latency: time.Millisecond, LatencyJitter: time.Millisecond, LatencyProbability: 1}, data: "a", action: Deliver, stats: Stats{Delays: 1}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			i, err := New(&tc.cfg)
+			require.NoError(t, err, "New must not error")
+			assert.Equal(t, tc.action, i.Frame([]byte(tc.data)), "Frame should return the correct action")
+			assert.Equal(t, tc.stats, i.Stats(), "Stats should record the injected fault")
+		})
+	}
+}
+
+func TestSeed(t *testing.T) {
+	t.Parallel()
+	actions := func() []FrameAction {
+		i, err := New(&Config{Seed: 1337, DropProbability: 0.5})
+		require.NoError(t, err, "New must not error")
+		a := make([]FrameAction, 32)
+		for x := range a {
+			a[x] = i.Frame(nil)
+		}
+		return a
+	}
+	first := actions()
+	assert.Equal(t, first, actions(), "the same seed should inject the same faults")
+	assert.Contains(t, first, Drop, "some frames should be dropped")
+	assert.Contains(t, first, Deliver, "some frames should be delivered")
+}
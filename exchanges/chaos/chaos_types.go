@@ -0,0 +1,77 @@
+package chaos
+
+import (
+	"math/rand/v2"
+	"sync"
+	"time"
+)
+
+// FrameAction defines what a websocket connection should do with an inbound
+// frame
+type FrameAction uint8
+
+// Frame actions returned by Injector.Frame
+const (
+	Deliver    FrameAction = iota // Deliver the frame as normal
+	Drop                          // Drop the frame without delivering it
+	Duplicate                     // Deliver the frame twice
+	Disconnect                    // Drop the frame and fault the connection
+)
+
+// Config defines the faults to inject and how often they occur. Probabilities
+// are between 0 and 1 and are evaluated independently for each request or
+// frame; a zero probability disables the fault
+type Config struct {
+	// Seed makes fault selection repeatable, zero uses a time based seed
+	Seed int64
+
+	// Latency is added to requests and frames selected by LatencyProbability,
+	// plus a random amount up to LatencyJitter
+	Latency            time.Duration
+	LatencyJitter      time.Duration
+	LatencyProbability float64
+
+	// HTTPErrorProbability replaces the response with an error status chosen
+	// from HTTPErrorStatusCodes, which defaults to 500, 502, 503 and 504
+	HTTPErrorProbability float64
+	HTTPErrorStatusCodes []int
+	// TransportErrorProbability fails the request before it is sent
+	TransportErrorProbability float64
+	// TruncateProbability cuts the response body in half, producing invalid
+	// JSON as seen when an exchange drops a connection mid response
+	TruncateProbability float64
+
+	// DropProbability discards inbound websocket frames
+	DropProbability float64
+	// DuplicateProbability delivers inbound websocket frames twice
+	DuplicateProbability float64
+	// SequenceGapProbability discards inbound frames containing
+	// SequenceGapPattern, such as an orderbook update event name, so that
+	// sequence validation and resync can be exercised
+	SequenceGapProbability float64
+	SequenceGapPattern     string
+	// DisconnectProbability forces a connection fault on an inbound frame
+	DisconnectProbability float64
+}
+
+// Stats holds the number of each fault injected
+type Stats struct {
+	Delays          uint64
+	HTTPErrors      uint64
+	TransportErrors uint64
+	Truncations     uint64
+	Drops           uint64
+	Duplicates      uint64
+	SequenceGaps    uint64
+	Disconnects     uint64
+}
+
+// Injector injects faults into HTTP requests and websocket frames. It is safe
+// for concurrent use and may be shared between a requester and a websocket
+// manager
+type Injector struct {
+	cfg   Config
+	m     sync.Mutex
+	rng   *rand.Rand
+	stats Stats
+}
@@ -5,6 +5,8 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
 	"strings"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
@@ -65,6 +67,79 @@ func (a *API) SetSubAccount(sub string) {
 	a.credentials.SubAccount = sub
 }
 
+// SetAccessControl sets the access control level of the default credentials
+// and any levels declared for individual sub-accounts
+func (a *API) SetAccessControl(level accounts.AccessControl, subAccounts map[string]accounts.AccessControl) {
+	a.credMu.Lock()
+	defer a.credMu.Unlock()
+	a.credentials.AccessControl = level
+	a.subAccountAccess = maps.Clone(subAccounts)
+}
+
+// accessControl returns the access control level of the default credentials
+// when used with the sub-account, which cannot exceed that of the credentials
+func (a *API) accessControl(subAccount string) accounts.AccessControl {
+	a.credMu.RLock()
+	defer a.credMu.RUnlock()
+	level := a.credentials.AccessControl
+	if sub, ok := a.subAccountAccess[subAccount]; ok {
+		level = level.Restrict(sub)
+	}
+	return level
+}
+
+// SetAccounts sets the named account profiles whose credentials can be used in
+// place of the default credentials by deploying the account name to a context
+func (b *Base) SetAccounts(accts []config.AccountConfig) {
+	b.API.credMu.Lock()
+	defer b.API.credMu.Unlock()
+	b.API.accounts = make(map[string]accounts.Credentials, len(accts))
+	b.API.accountSubAccess = make(map[string]map[string]accounts.AccessControl, len(accts))
+	for i := range accts {
+		b.API.accounts[accts[i].Name] = accountCredentials(accts[i].Name, &accts[i].Credentials, accts[i].AccessControl)
+		if len(accts[i].SubAccountAccessControl) > 0 {
+			b.API.accountSubAccess[accts[i].Name] = maps.Clone(accts[i].SubAccountAccessControl)
+		}
+	}
+}
+
+// SetAccountCredentials replaces the credentials of a loaded account profile,
+// keeping its access control level
+func (b *Base) SetAccountCredentials(account string, creds *config.APICredentialsConfig) error {
+	if creds == nil {
+		return fmt.Errorf("%w: *config.APICredentialsConfig", common.ErrNilPointer)
+	}
+	b.API.credMu.Lock()
+	defer b.API.credMu.Unlock()
+	existing, ok := b.API.accounts[account]
+	if !ok {
+		return fmt.Errorf("%s %w: %q", b.Name, accounts.ErrAccountNotFound, account)
+	}
+	b.API.accounts[account] = accountCredentials(account, creds, existing.AccessControl)
+	return nil
+}
+
+func accountCredentials(account string, c *config.APICredentialsConfig, level accounts.AccessControl) accounts.Credentials {
+	return accounts.Credentials{
+		Key:             c.Key,
+		Secret:          c.Secret,
+		ClientID:        c.ClientID,
+		PEMKey:          c.PEMKey,
+		SubAccount:      c.Subaccount,
+		OneTimePassword: c.OTPSecret,
+		AccessControl:   level,
+		Account:         account,
+	}
+}
+
+// GetAccountNames returns the names of the account profiles loaded for the
+// exchange
+func (b *Base) GetAccountNames() []string {
+	b.API.credMu.RLock()
+	defer b.API.credMu.RUnlock()
+	return slices.Sorted(maps.Keys(b.API.accounts))
+}
+
 // CheckCredentials checks to see if the required fields have been set before
 // sending an authenticated API request
 func (b *Base) CheckCredentials(creds *accounts.Credentials, isContext bool) error {
@@ -125,6 +200,10 @@ func (b *Base) GetCredentials(ctx context.Context) (*accounts.Credentials, error
 		return creds, nil
 	}
 
+	if account := accounts.AccountFromContext(ctx); account != "" {
+		return b.getAccountCredentials(ctx, account)
+	}
+
 	// Fallback to exchange loaded credentials
 	b.API.credMu.RLock()
 	creds := b.API.credentials
@@ -136,7 +215,30 @@ func (b *Base) GetCredentials(ctx context.Context) (*accounts.Credentials, error
 	if subAccountOverride, ok := ctx.Value(accounts.ContextSubAccountFlag).(string); ok {
 		creds.SubAccount = subAccountOverride
 	}
+	creds.AccessControl = b.API.accessControl(creds.SubAccount)
+
+	return &creds, nil
+}
 
+// getAccountCredentials returns the checked credentials of a named account
+// profile, restricted to the access control level of its sub-account
+func (b *Base) getAccountCredentials(ctx context.Context, account string) (*accounts.Credentials, error) {
+	b.API.credMu.RLock()
+	creds, ok := b.API.accounts[account]
+	subAccess := b.API.accountSubAccess[account]
+	b.API.credMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("%s %w: %q", b.Name, accounts.ErrAccountNotFound, account)
+	}
+	if err := b.CheckCredentials(&creds, false); err != nil {
+		return nil, fmt.Errorf("error checking %q account credentials: %w", account, err)
+	}
+	if subAccountOverride, ok := ctx.Value(accounts.ContextSubAccountFlag).(string); ok {
+		creds.SubAccount = subAccountOverride
+	}
+	if sub, ok := subAccess[creds.SubAccount]; ok {
+		creds.AccessControl = creds.AccessControl.Restrict(sub)
+	}
 	return &creds, nil
 }
 
@@ -334,6 +334,97 @@ func TestAPISetters(t *testing.T) {
 	}
 }
 
+func TestSetAccessControl(t *testing.T) {
+	t.Parallel()
+	b := Base{Name: "TESTNAME", SkipAuthCheck: true}
+	b.SetCredentials("key", "secret", "", "main", "", "")
+	subAccounts := map[string]accounts.AccessControl{"main": accounts.TradeAccess, "treasury": accounts.ReadOnlyAccess, "vault": accounts.WithdrawAccess}
+	b.API.SetAccessControl(accounts.TradeAccess, subAccounts)
+	subAccounts["main"] = accounts.ReadOnlyAccess
+	b.SetCredentials("rotated", "secret", "", "main", "", "")
+
+	creds, err := b.GetCredentials(t.Context())
+	require.NoError(t, err, "GetCredentials must not error")
+	assert.Equal(t, "rotated", creds.Key, "SetCredentials should rotate the key")
+	assert.Equal(t, accounts.TradeAccess, creds.AccessControl, "SetAccessControl should copy sub-account levels and survive rotation")
+
+	creds, err = b.GetCredentials(accounts.DeploySubAccountOverrideToContext(t.Context(), "treasury"))
+	require.NoError(t, err, "GetCredentials must not error")
+	assert.Equal(t, accounts.ReadOnlyAccess, creds.AccessControl, "GetCredentials should apply the sub-account level")
+
+	creds, err = b.GetCredentials(accounts.DeploySubAccountOverrideToContext(t.Context(), "vault"))
+	require.NoError(t, err, "GetCredentials must not error")
+	assert.Equal(t, accounts.TradeAccess, creds.AccessControl, "GetCredentials should not raise the level above the credentials")
+
+	creds, err = b.GetCredentials(accounts.DeployCredentialsToContext(t.Context(), &accounts.Credentials{Key: "ctx", Secret: "ctx"}))
+	require.NoError(t, err, "GetCredentials must not error")
+	assert.Equal(t, accounts.UnrestrictedAccess, creds.AccessControl, "GetCredentials should not restrict context credentials")
+}
+
+func TestSetAccounts(t *testing.T) {
+	t.Parallel()
+	b := Base{Name: "TESTNAME", SkipAuthCheck: true}
+	b.SetCredentials("default", "secret", "", "", "", "")
+	b.SetAccounts([]config.AccountConfig{
+		{Name: "desk2", AccessControl: accounts.ReadOnlyAccess, Credentials: config.APICredentialsConfig{Key: "key2", Secret: "secret2"}},
+		{
+			Name:                    "desk1",
+			AccessControl:           accounts.WithdrawAccess,
+			SubAccountAccessControl: map[string]accounts.AccessControl{"main": accounts.TradeAccess, "treasury": accounts.ReadOnlyAccess},
+			Credentials:             config.APICredentialsConfig{Key: "key1", Secret: "secret1", Subaccount: "main"},
+		},
+	})
+	assert.Equal(t, []string{"desk1", "desk2"}, b.GetAccountNames(), "GetAccountNames should return sorted account names")
+
+	creds, err := b.GetCredentials(t.Context())
+	require.NoError(t, err, "GetCredentials must not error")
+	assert.Equal(t, "default", creds.Key, "GetCredentials should return the default credentials without an account")
+	assert.Empty(t, creds.Account, "Account should be empty for the default credentials")
+
+	ctx := accounts.DeployAccountToContext(t.Context(), "desk2")
+	creds, err = b.GetCredentials(ctx)
+	require.NoError(t, err, "GetCredentials must not error")
+	assert.Equal(t, "key2", creds.Key, "GetCredentials should return the account credentials")
+	assert.Equal(t, "desk2", creds.Account, "Account should be set to the account name")
+	assert.Equal(t, accounts.ReadOnlyAccess, creds.AccessControl, "AccessControl should be set from the account config")
+
+	creds, err = b.GetCredentials(accounts.DeploySubAccountOverrideToContext(accounts.DeployAccountToContext(t.Context(), "desk1"), "treasury"))
+	require.NoError(t, err, "GetCredentials must not error")
+	assert.Equal(t, "treasury", creds.SubAccount, "GetCredentials should apply the sub-account override")
+	assert.Equal(t, accounts.ReadOnlyAccess, creds.AccessControl, "GetCredentials should restrict the account to the level of the overriding sub-account")
+
+	creds, err = b.GetCredentials(accounts.DeployAccountToContext(t.Context(), "desk1"))
+	require.NoError(t, err, "GetCredentials must not error")
+	assert.Equal(t, accounts.TradeAccess, creds.AccessControl, "GetCredentials should restrict the account to the level of its configured sub-account")
+
+	creds, err = b.GetCredentials(accounts.DeploySubAccountOverrideToContext(accounts.DeployAccountToContext(t.Context(), "desk1"), "desk"))
+	require.NoError(t, err, "GetCredentials must not error")
+	assert.Equal(t, accounts.WithdrawAccess, creds.AccessControl, "GetCredentials should keep the account level for a sub-account without a declared level")
+
+	_, err = b.GetCredentials(accounts.DeployAccountToContext(t.Context(), "desk3"))
+	assert.ErrorIs(t, err, accounts.ErrAccountNotFound)
+
+	creds, err = b.GetCredentials(accounts.DeployCredentialsToContext(ctx, &accounts.Credentials{Key: "ctx", Secret: "ctx"}))
+	require.NoError(t, err, "GetCredentials must not error")
+	assert.Equal(t, "ctx", creds.Key, "context credentials should take precedence over the account")
+}
+
+func TestSetAccountCredentials(t *testing.T) {
+	t.Parallel()
+	b := Base{Name: "TESTNAME", SkipAuthCheck: true}
+	b.SetAccounts([]config.AccountConfig{{Name: "desk1", AccessControl: accounts.ReadOnlyAccess, Credentials: config.APICredentialsConfig{Key: "key1", Secret: "secret1"}}})
+
+	require.ErrorIs(t, b.SetAccountCredentials("desk1", nil), common.ErrNilPointer)
+	require.ErrorIs(t, b.SetAccountCredentials("desk2", &config.APICredentialsConfig{}), accounts.ErrAccountNotFound)
+
+	require.NoError(t, b.SetAccountCredentials("desk1", &config.APICredentialsConfig{Key: "key2", Secret: "secret2"}), "SetAccountCredentials must not error")
+	creds, err := b.GetCredentials(accounts.DeployAccountToContext(t.Context(), "desk1"))
+	require.NoError(t, err, "GetCredentials must not error")
+	assert.Equal(t, "key2", creds.Key, "SetAccountCredentials should replace the account key")
+	assert.Equal(t, "secret2", creds.Secret, "SetAccountCredentials should replace the account secret")
+	assert.Equal(t, accounts.ReadOnlyAccess, creds.AccessControl, "SetAccountCredentials should keep the account access control")
+}
+
 func TestSetCredentials(t *testing.T) {
 	t.Parallel()
 
@@ -543,6 +543,8 @@ func (b *Base) SetupDefaults(exch *config.Exchange) error {
 			exch.API.Credentials.PEMKey,
 			exch.API.Credentials.OTPSecret,
 		)
+		b.API.SetAccessControl(exch.API.AccessControl, exch.API.SubAccountAccessControl)
+		b.SetAccounts(exch.API.Accounts)
 	}
 
 	if exch.HTTPTimeout <= time.Duration(0) {
@@ -222,8 +222,11 @@ type API struct {
 
 	Endpoints *Endpoints
 
-	credentials accounts.Credentials
-	credMu      sync.RWMutex
+	credentials      accounts.Credentials
+	subAccountAccess map[string]accounts.AccessControl
+	accounts         map[string]accounts.Credentials
+	accountSubAccess map[string]map[string]accounts.AccessControl
+	credMu           sync.RWMutex
 
 	CredentialsValidator config.APICredentialsValidatorConfig
 }
@@ -0,0 +1,538 @@
+package kline
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/thrasher-corp/gct-ta/indicators"
+	"github.com/thrasher-corp/gocryptotrader/common"
+)
+
+// ErrCandleOutOfOrder is returned when a streaming indicator receives a candle
+// older than the last candle it processed
+var ErrCandleOutOfOrder = errors.New("candle is older than the last processed candle")
+
+var errUnsupportedMAType = errors.New("unsupported moving average type")
+
+// StreamingIndicator defines a stateful indicator which is updated one candle
+// at a time in O(1). A candle with the same open time as the previous update
+// amends that candle, which allows a forming candle to be pushed on every
+// trade and then finalised when the next interval opens.
+type StreamingIndicator interface {
+	// Update processes a new candle or amends the latest candle
+	Update(c *Candle) error
+	// Ready returns whether enough candles have been processed to derive a value
+	Ready() bool
+}
+
+// StreamingSMA is a streaming simple moving average on close prices
+type StreamingSMA struct {
+	sequence candleSequence
+	window   rollingWindow
+}
+
+// StreamingEMA is a streaming exponential moving average on close prices
+type StreamingEMA struct {
+	sequence candleSequence
+	ema      emaState
+}
+
+// StreamingRSI is a streaming relative strength index on close prices using
+// Wilder's smoothing
+type StreamingRSI struct {
+	sequence candleSequence
+	closes   priceHistory
+	gain     wilderState
+	loss     wilderState
+}
+
+// StreamingATR is a streaming average true range using Wilder's smoothing
+type StreamingATR struct {
+	sequence candleSequence
+	closes   priceHistory
+	atr      wilderState
+}
+
+// StreamingMACD is a streaming moving average convergence divergence on close
+// prices
+type StreamingMACD struct {
+	sequence candleSequence
+	fast     emaState
+	slow     emaState
+	signal   emaState
+}
+
+// StreamingBollingerBands is a streaming Bollinger Bands indicator on close
+// prices
+type StreamingBollingerBands struct {
+	sequence  candleSequence
+	window    rollingWindow
+	ema       *emaState
+	nbDevUp   float64
+	nbDevDown float64
+}
+
+// StreamingOBV is a streaming on balance volume indicator
+type StreamingOBV struct {
+	sequence candleSequence
+	closes   priceHistory
+	base     float64
+	value    float64
+}
+
+// candleSequence tracks candle open times to determine whether an update opens
+// a new candle or amends the latest one
+type candleSequence struct {
+	last  time.Time
+	count int64
+}
+
+// rollingWindow holds the latest values for a fixed period along with running
+// sums so the mean and variance can be derived without iterating the window
+type rollingWindow struct {
+	values []float64
+	start  int
+	count  int
+	sum    float64
+	sumSq  float64
+}
+
+// emaState holds an exponential moving average which is seeded with the
+// simple moving average of the first period of values
+type emaState struct {
+	seed       rollingWindow
+	count      int
+	multiplier float64
+	previous   float64
+	value      float64
+}
+
+// wilderState holds a Wilder smoothed average which is seeded with the mean
+// of the first period of values
+type wilderState struct {
+	period int
+	count  int
+	base   float64
+	value  float64
+}
+
+// priceHistory holds the latest price and the price of the candle before it
+type priceHistory struct {
+	count    int
+	previous float64
+	latest   float64
+}
+
+// NewStreamingSMA returns a streaming simple moving average for the given
+// period
+func NewStreamingSMA(period int64) (*StreamingSMA, error) {
+	if period <= 0 {
+		return nil, fmt.Errorf("streaming simple moving average %w", errInvalidPeriod)
+	}
+	return &StreamingSMA{window: newRollingWindow(int(period))}, nil
+}
+
+// Update processes a new candle or amends the latest candle
+func (s *StreamingSMA) Update(c *Candle) error {
+	amend, err := s.sequence.next(c)
+	if err != nil {
+		return err
+	}
+	s.window.update(c.Close, amend)
+	return nil
+}
+
+// Ready returns whether enough candles have been processed to derive a value
+func (s *StreamingSMA) Ready() bool {
+	return s.window.full()
+}
+
+// Value returns the latest simple moving average, zero until ready
+func (s *StreamingSMA) Value() float64 {
+	if !s.window.full() {
+		return 0
+	}
+	return s.window.mean()
+}
+
+// NewStreamingEMA returns a streaming exponential moving average for the given
+// period
+func NewStreamingEMA(period int64) (*StreamingEMA, error) {
+	if period <= 0 {
+		return nil, fmt.Errorf("streaming exponential moving average %w", errInvalidPeriod)
+	}
+	return &StreamingEMA{ema: newEMAState(int(period))}, nil
+}
+
+// Update processes a new candle or amends the latest candle
+func (e *StreamingEMA) Update(c *Candle) error {
+	amend, err := e.sequence.next(c)
+	if err != nil {
+		return err
+	}
+	e.ema.update(c.Close, amend)
+	return nil
+}
+
+// Ready returns whether enough candles have been processed to derive a value
+func (e *StreamingEMA) Ready() bool {
+	return e.ema.ready()
+}
+
+// Value returns the latest exponential moving average, zero until ready
+func (e *StreamingEMA) Value() float64 {
+	return e.ema.value
+}
+
+// NewStreamingRSI returns a streaming relative strength index for the given
+// period
+func NewStreamingRSI(period int64) (*StreamingRSI, error) {
+	if period < 2 {
+		return nil, fmt.Errorf("streaming relative strength index %w must be at least 2", errInvalidPeriod)
+	}
+	return &StreamingRSI{
+		gain: wilderState{period: int(period)},
+		loss: wilderState{period: int(period)},
+	}, nil
+}
+
+// Update processes a new candle or amends the latest candle
+func (r *StreamingRSI) Update(c *Candle) error {
+	amend, err := r.sequence.next(c)
+	if err != nil {
+		return err
+	}
+	if !r.closes.update(c.Close, amend) {
+		return nil
+	}
+	change := c.Close - r.closes.previous
+	r.gain.update(math.Max(change, 0), amend)
+	r.loss.update(math.Max(-change, 0), amend)
+	return nil
+}
+
+// Ready returns whether enough candles have been processed to derive a value
+func (r *StreamingRSI) Ready() bool {
+	return r.gain.ready()
+}
+
+// Value returns the latest relative strength index, zero until ready
+func (r *StreamingRSI) Value() float64 {
+	if !r.gain.ready() {
+		return 0
+	}
+	total := r.gain.value + r.loss.value
+	if total > -0.00000000000001 && total < 0.00000000000001 {
+		return 0
+	}
+	return 100 * (r.gain.value / total)
+}
+
+// NewStreamingATR returns a streaming average true range for the given period
+func NewStreamingATR(period int64) (*StreamingATR, error) {
+	if period <= 0 {
+		return nil, fmt.Errorf("streaming average true range %w", errInvalidPeriod)
+	}
+	return &StreamingATR{atr: wilderState{period: int(period)}}, nil
+}
+
+// Update processes a new candle or amends the latest candle
+func (a *StreamingATR) Update(c *Candle) error {
+	amend, err := a.sequence.next(c)
+	if err != nil {
+		return err
+	}
+	if !a.closes.update(c.Close, amend) {
+		return nil
+	}
+	a.atr.update(trueRange(c.High, c.Low, a.closes.previous), amend)
+	return nil
+}
+
+// Ready returns whether enough candles have been processed to derive a value
+func (a *StreamingATR) Ready() bool {
+	return a.atr.ready()
+}
+
+// Value returns the latest average true range, zero until ready
+func (a *StreamingATR) Value() float64 {
+	if !a.atr.ready() {
+		return 0
+	}
+	return a.atr.value
+}
+
+// NewStreamingMACD returns a streaming MACD for the given fast, slow and signal
+// periods
+func NewStreamingMACD(fast, slow, signal int64) (*StreamingMACD, error) {
+	if fast <= 0 {
+		return nil, fmt.Errorf("streaming macd %w fast", errInvalidPeriod)
+	}
+	if slow <= 0 {
+		return nil, fmt.Errorf("streaming macd %w slow", errInvalidPeriod)
+	}
+	if signal <= 0 {
+		return nil, fmt.Errorf("streaming macd %w signal", errInvalidPeriod)
+	}
+	if fast > slow {
+		return nil, fmt.Errorf("streaming macd %w fast period %v exceeds slow period %v", errInvalidPeriod, fast, slow)
+	}
+	return &StreamingMACD{
+		fast:   newEMAState(int(fast)),
+		slow:   newEMAState(int(slow)),
+		signal: newEMAState(int(signal)),
+	}, nil
+}
+
+// Update processes a new candle or amends the latest candle
+func (m *StreamingMACD) Update(c *Candle) error {
+	amend, err := m.sequence.next(c)
+	if err != nil {
+		return err
+	}
+	m.fast.update(c.Close, amend)
+	m.slow.update(c.Close, amend)
+	if !m.slow.ready() {
+		return nil
+	}
+	m.signal.update(m.fast.value-m.slow.value, amend)
+	return nil
+}
+
+// Ready returns whether enough candles have been processed to derive a signal
+// value
+func (m *StreamingMACD) Ready() bool {
+	return m.signal.ready()
+}
+
+// Values returns the latest MACD, signal and histogram values. The MACD value
+// is available once the slow period is satisfied, the signal and histogram
+// values are zero until ready.
+func (m *StreamingMACD) Values() (macd, signal, histogram float64) {
+	if !m.slow.ready() {
+		return 0, 0, 0
+	}
+	macd = m.fast.value - m.slow.value
+	if !m.signal.ready() {
+		return macd, 0, 0
+	}
+	return macd, m.signal.value, macd - m.signal.value
+}
+
+// NewStreamingBollingerBands returns streaming Bollinger Bands for the given
+// period, deviation multipliers and moving average type
+func NewStreamingBollingerBands(period int64, nbDevUp, nbDevDown float64, m indicators.MaType) (*StreamingBollingerBands, error) {
+	if period <= 0 {
+		return nil, fmt.Errorf("streaming bollinger bands %w", errInvalidPeriod)
+	}
+	if nbDevUp <= 0 {
+		return nil, fmt.Errorf("streaming bollinger bands %w upper limit", errInvalidDeviationMultiplier)
+	}
+	if nbDevDown <= 0 {
+		return nil, fmt.Errorf("streaming bollinger bands %w lower limit", errInvalidDeviationMultiplier)
+	}
+	b := &StreamingBollingerBands{window: newRollingWindow(int(period))}
+	switch m {
+	case indicators.Sma:
+	case indicators.Ema:
+		ema := newEMAState(int(period))
+		b.ema = &ema
+	default:
+		return nil, fmt.Errorf("streaming bollinger bands %w: %v", errUnsupportedMAType, m)
+	}
+	b.nbDevUp = nbDevUp
+	b.nbDevDown = nbDevDown
+	return b, nil
+}
+
+// Update processes a new candle or amends the latest candle
+func (b *StreamingBollingerBands) Update(c *Candle) error {
+	amend, err := b.sequence.next(c)
+	if err != nil {
+		return err
+	}
+	b.window.update(c.Close, amend)
+	if b.ema != nil {
+		b.ema.update(c.Close, amend)
+	}
+	return nil
+}
+
+// Ready returns whether enough candles have been processed to derive a value
+func (b *StreamingBollingerBands) Ready() bool {
+	return b.window.full()
+}
+
+// Values returns the latest upper, middle and lower bands, zero until ready
+func (b *StreamingBollingerBands) Values() (upper, middle, lower float64) {
+	if !b.window.full() {
+		return 0, 0, 0
+	}
+	middle = b.window.mean()
+	if b.ema != nil {
+		middle = b.ema.value
+	}
+	var deviation float64
+	if variance := b.window.variance(); variance >= 0.00000000000001 {
+		deviation = math.Sqrt(variance)
+	}
+	return middle + deviation*b.nbDevUp, middle, middle - deviation*b.nbDevDown
+}
+
+// NewStreamingOBV returns a streaming on balance volume indicator
+func NewStreamingOBV() *StreamingOBV {
+	return &StreamingOBV{}
+}
+
+// Update processes a new candle or amends the latest candle
+func (o *StreamingOBV) Update(c *Candle) error {
+	amend, err := o.sequence.next(c)
+	if err != nil {
+		return err
+	}
+	if !amend {
+		o.base = o.value
+	}
+	if !o.closes.update(c.Close, amend) {
+		return nil
+	}
+	switch {
+	case c.Close > o.closes.previous:
+		o.value = o.base + c.Volume
+	case c.Close < o.closes.previous:
+		o.value = o.base - c.Volume
+	default:
+		o.value = o.base
+	}
+	return nil
+}
+
+// Ready returns whether enough candles have been processed to derive a value
+func (o *StreamingOBV) Ready() bool {
+	return o.closes.count > 0
+}
+
+// Value returns the latest on balance volume
+func (o *StreamingOBV) Value() float64 {
+	return o.value
+}
+
+// next returns whether the candle amends the latest candle processed
+func (s *candleSequence) next(c *Candle) (bool, error) {
+	if c == nil {
+		return false, fmt.Errorf("%w: %T", common.ErrNilPointer, c)
+	}
+	if s.count > 0 {
+		if c.Time.Equal(s.last) {
+			return true, nil
+		}
+		if c.Time.Before(s.last) {
+			return false, fmt.Errorf("%w: %v is before %v", ErrCandleOutOfOrder, c.Time, s.last)
+		}
+	}
+	s.last = c.Time
+	s.count++
+	return false, nil
+}
+
+func newRollingWindow(period int) rollingWindow {
+	return rollingWindow{values: make([]float64, period)}
+}
+
+// update pushes a new value, evicting the oldest value once full, or replaces
+// the newest value when amending
+func (w *rollingWindow) update(v float64, amend bool) {
+	if amend && w.count > 0 {
+		newest := (w.start + w.count - 1) % len(w.values)
+		w.sum += v - w.values[newest]
+		w.sumSq += v*v - w.values[newest]*w.values[newest]
+		w.values[newest] = v
+		return
+	}
+	if w.count == len(w.values) {
+		w.sum -= w.values[w.start]
+		w.sumSq -= w.values[w.start] * w.values[w.start]
+		w.values[w.start] = v
+		w.start = (w.start + 1) % len(w.values)
+	} else {
+		w.values[(w.start+w.count)%len(w.values)] = v
+		w.count++
+	}
+	w.sum += v
+	w.sumSq += v * v
+}
+
+func (w *rollingWindow) period() int {
+	return len(w.values)
+}
+
+func (w *rollingWindow) full() bool {
+	return w.count == len(w.values)
+}
+
+func (w *rollingWindow) mean() float64 {
+	return w.sum / float64(w.count)
+}
+
+// variance returns the population variance of the window
+func (w *rollingWindow) variance() float64 {
+	mean := w.mean()
+	return w.sumSq/float64(w.count) - mean*mean
+}
+
+func newEMAState(period int) emaState {
+	return emaState{
+		seed:       newRollingWindow(period),
+		multiplier: 2 / (float64(period) + 1),
+	}
+}
+
+func (e *emaState) update(v float64, amend bool) {
+	if !amend || e.count == 0 {
+		e.previous = e.value
+		e.count++
+	}
+	if e.count <= e.seed.period() {
+		e.seed.update(v, amend)
+		if e.seed.full() {
+			e.value = e.seed.mean()
+		}
+		return
+	}
+	e.value = (v-e.previous)*e.multiplier + e.previous
+}
+
+func (e *emaState) ready() bool {
+	return e.count >= e.seed.period()
+}
+
+func (w *wilderState) update(v float64, amend bool) {
+	if !amend || w.count == 0 {
+		w.base = w.value
+		w.count++
+	}
+	switch {
+	case w.count < w.period:
+		w.value = w.base + v
+	case w.count == w.period:
+		w.value = (w.base + v) / float64(w.period)
+	default:
+		w.value = (w.base*float64(w.period-1) + v) / float64(w.period)
+	}
+}
+
+func (w *wilderState) ready() bool {
+	return w.count >= w.period
+}
+
+// update records the latest price and returns whether a previous price exists
+func (p *priceHistory) update(price float64, amend bool) bool {
+	if !amend || p.count == 0 {
+		p.previous = p.latest
+		p.count++
+	}
+	p.latest = price
+	return p.count > 1
+}
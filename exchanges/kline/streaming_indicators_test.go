@@ -0,0 +1,236 @@
+package kline
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gct-ta/indicators"
+	"github.com/thrasher-corp/gocryptotrader/common"
+)
+
+// testStreamingCandles returns a deterministic oscillating candle set
+func testStreamingCandles(length int) []Candle {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	candles := make([]Candle, length)
+	for i := range candles {
+		c := 100 + 10*math.Sin(float64(i)/3) + float64(i%4)
+		candles[i] = Candle{
+			Time:   start.Add(time.Duration(i) * time.Minute),
+			Open:   c - 1,
+			High:   c + 2,
+			Low:    c - 3,
+			Close:  c,
+			Volume: 10 + float64(i%7),
+		}
+	}
+	return candles
+}
+
+// streamCandles pushes each candle through the indicator, preceded by a forming
+// revision of the same candle to ensure amendments are applied correctly
+func streamCandles(t *testing.T, candles []Candle, ind StreamingIndicator, check func(i int)) {
+	t.Helper()
+	for i := range candles {
+		forming := Candle{Time: candles[i].Time, Open: candles[i].Open, High: candles[i].Open, Low: candles[i].Open, Close: candles[i].Open, Volume: 1}
+		require.NoError(t, ind.Update(&forming), "Update must not error")
+		require.NoError(t, ind.Update(&candles[i]), "Update must not error")
+		check(i)
+	}
+}
+
+func TestCandleSequence(t *testing.T) {
+	t.Parallel()
+	var s candleSequence
+	_, err := s.next(nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+
+	now := time.Now()
+	amend, err := s.next(&Candle{Time: now})
+	require.NoError(t, err, "next must not error")
+	assert.False(t, amend, "first candle should not amend")
+
+	amend, err = s.next(&Candle{Time: now})
+	require.NoError(t, err, "next must not error")
+	assert.True(t, amend, "same candle time should amend")
+
+	_, err = s.next(&Candle{Time: now.Add(-time.Minute)})
+	assert.ErrorIs(t, err, ErrCandleOutOfOrder)
+
+	amend, err = s.next(&Candle{Time: now.Add(time.Minute)})
+	require.NoError(t, err, "next must not error")
+	assert.False(t, amend, "later candle should not amend")
+}
+
+func TestRollingWindow(t *testing.T) {
+	t.Parallel()
+	w := newRollingWindow(3)
+	w.update(1, false)
+	w.update(5, true)
+	assert.False(t, w.full(), "window should not be full")
+	w.update(2, false)
+	w.update(3, false)
+	assert.True(t, w.full(), "window should be full")
+	assert.Equal(t, 10.0/3, w.mean(), "mean should be correct")
+	w.update(4, false)
+	assert.Equal(t, 3.0, w.mean(), "mean should evict the oldest value")
+	assert.InDelta(t, 2.0/3, w.variance(), 1e-12, "variance should be correct")
+	w.update(10, true)
+	assert.Equal(t, 5.0, w.mean(), "mean should amend the newest value")
+}
+
+func TestStreamingSMA(t *testing.T) {
+	t.Parallel()
+	_, err := NewStreamingSMA(0)
+	assert.ErrorIs(t, err, errInvalidPeriod)
+
+	candles := testStreamingCandles(50)
+	expected, err := (&Item{Candles: candles}).GetSimpleMovingAverageOnClose(9)
+	require.NoError(t, err, "GetSimpleMovingAverageOnClose must not error")
+
+	s, err := NewStreamingSMA(9)
+	require.NoError(t, err, "NewStreamingSMA must not error")
+	streamCandles(t, candles, s, func(i int) {
+		assert.Equal(t, i >= 8, s.Ready(), "Ready should be correct")
+		assert.InDelta(t, expected[i], s.Value(), 1e-9, "Value should match batch calculation")
+	})
+	assert.ErrorIs(t, s.Update(&candles[0]), ErrCandleOutOfOrder)
+}
+
+func TestStreamingEMA(t *testing.T) {
+	t.Parallel()
+	_, err := NewStreamingEMA(-1)
+	assert.ErrorIs(t, err, errInvalidPeriod)
+
+	candles := testStreamingCandles(50)
+	expected, err := (&Item{Candles: candles}).GetExponentialMovingAverageOnClose(9)
+	require.NoError(t, err, "GetExponentialMovingAverageOnClose must not error")
+
+	e, err := NewStreamingEMA(9)
+	require.NoError(t, err, "NewStreamingEMA must not error")
+	streamCandles(t, candles, e, func(i int) {
+		assert.Equal(t, i >= 8, e.Ready(), "Ready should be correct")
+		assert.InDelta(t, expected[i], e.Value(), 1e-9, "Value should match batch calculation")
+	})
+}
+
+func TestStreamingRSI(t *testing.T) {
+	t.Parallel()
+	_, err := NewStreamingRSI(1)
+	assert.ErrorIs(t, err, errInvalidPeriod)
+
+	candles := testStreamingCandles(50)
+	expected, err := (&Item{Candles: candles}).GetRelativeStrengthIndexOnClose(14)
+	require.NoError(t, err, "GetRelativeStrengthIndexOnClose must not error")
+
+	r, err := NewStreamingRSI(14)
+	require.NoError(t, err, "NewStreamingRSI must not error")
+	streamCandles(t, candles, r, func(i int) {
+		assert.Equal(t, i >= 14, r.Ready(), "Ready should be correct")
+		assert.InDelta(t, expected[i], r.Value(), 1e-9, "Value should match batch calculation")
+	})
+}
+
+func TestStreamingATR(t *testing.T) {
+	t.Parallel()
+	_, err := NewStreamingATR(0)
+	assert.ErrorIs(t, err, errInvalidPeriod)
+
+	candles := testStreamingCandles(50)
+	expected, err := (&Item{Candles: candles}).GetAverageTrueRange(14)
+	require.NoError(t, err, "GetAverageTrueRange must not error")
+
+	a, err := NewStreamingATR(14)
+	require.NoError(t, err, "NewStreamingATR must not error")
+	streamCandles(t, candles, a, func(i int) {
+		assert.Equal(t, i >= 14, a.Ready(), "Ready should be correct")
+		assert.InDelta(t, expected[i], a.Value(), 1e-9, "Value should match batch calculation")
+	})
+}
+
+func TestStreamingMACD(t *testing.T) {
+	t.Parallel()
+	_, err := NewStreamingMACD(0, 26, 9)
+	assert.ErrorIs(t, err, errInvalidPeriod)
+	_, err = NewStreamingMACD(12, 0, 9)
+	assert.ErrorIs(t, err, errInvalidPeriod)
+	_, err = NewStreamingMACD(12, 26, 0)
+	assert.ErrorIs(t, err, errInvalidPeriod)
+	_, err = NewStreamingMACD(26, 12, 9)
+	assert.ErrorIs(t, err, errInvalidPeriod)
+
+	candles := testStreamingCandles(60)
+	item := &Item{Candles: candles}
+	fast, err := item.GetExponentialMovingAverageOnClose(5)
+	require.NoError(t, err, "GetExponentialMovingAverageOnClose must not error")
+	slow, err := item.GetExponentialMovingAverageOnClose(10)
+	require.NoError(t, err, "GetExponentialMovingAverageOnClose must not error")
+	line := make([]float64, 0, len(candles))
+	for i := 9; i < len(candles); i++ {
+		line = append(line, fast[i]-slow[i])
+	}
+	signal := indicators.EMA(line, 4)
+
+	m, err := NewStreamingMACD(5, 10, 4)
+	require.NoError(t, err, "NewStreamingMACD must not error")
+	streamCandles(t, candles, m, func(i int) {
+		macd, sig, hist := m.Values()
+		assert.Equal(t, i >= 12, m.Ready(), "Ready should be correct")
+		if i < 9 {
+			assert.Zero(t, macd, "macd should be zero during warm up")
+			return
+		}
+		assert.InDelta(t, line[i-9], macd, 1e-9, "macd should match batch calculation")
+		assert.InDelta(t, signal[i-9], sig, 1e-9, "signal should match batch calculation")
+		if m.Ready() {
+			assert.InDelta(t, signal[i-9], macd-hist, 1e-9, "histogram should be the macd less the signal")
+		}
+	})
+}
+
+func TestStreamingBollingerBands(t *testing.T) {
+	t.Parallel()
+	_, err := NewStreamingBollingerBands(0, 2, 2, indicators.Sma)
+	assert.ErrorIs(t, err, errInvalidPeriod)
+	_, err = NewStreamingBollingerBands(20, 0, 2, indicators.Sma)
+	assert.ErrorIs(t, err, errInvalidDeviationMultiplier)
+	_, err = NewStreamingBollingerBands(20, 2, 0, indicators.Sma)
+	assert.ErrorIs(t, err, errInvalidDeviationMultiplier)
+	_, err = NewStreamingBollingerBands(20, 2, 2, indicators.MaType(1337))
+	assert.ErrorIs(t, err, errUnsupportedMAType)
+
+	candles := testStreamingCandles(60)
+	for _, ma := range []indicators.MaType{indicators.Sma, indicators.Ema} {
+		expected, err := (&Item{Candles: candles}).GetBollingerBands(20, 2, 1.5, ma)
+		require.NoError(t, err, "GetBollingerBands must not error")
+
+		b, err := NewStreamingBollingerBands(20, 2, 1.5, ma)
+		require.NoError(t, err, "NewStreamingBollingerBands must not error")
+		streamCandles(t, candles, b, func(i int) {
+			upper, middle, lower := b.Values()
+			assert.Equal(t, i >= 19, b.Ready(), "Ready should be correct")
+			if i < 19 {
+				return
+			}
+			assert.InDelta(t, expected.Upper[i], upper, 1e-6, "upper should match batch calculation")
+			assert.InDelta(t, expected.Middle[i], middle, 1e-9, "middle should match batch calculation")
+			assert.InDelta(t, expected.Lower[i], lower, 1e-6, "lower should match batch calculation")
+		})
+	}
+}
+
+func TestStreamingOBV(t *testing.T) {
+	t.Parallel()
+	candles := testStreamingCandles(50)
+	expected, err := (&Item{Candles: candles}).GetOnBalanceVolume()
+	require.NoError(t, err, "GetOnBalanceVolume must not error")
+
+	o := NewStreamingOBV()
+	assert.False(t, o.Ready(), "Ready should be false before any updates")
+	streamCandles(t, candles, o, func(i int) {
+		assert.InDelta(t, expected[i], o.Value(), 1e-9, "Value should match batch calculation")
+	})
+	assert.True(t, o.Ready(), "Ready should be true")
+}
@@ -3,6 +3,8 @@ package kline
 import (
 	"errors"
 	"fmt"
+	"math"
+	"slices"
 
 	"github.com/thrasher-corp/gct-ta/indicators"
 )
@@ -14,6 +16,9 @@ var (
 	errNilOHLC                    = errors.New("nil OHLC data")
 	errInvalidDataSetLengths      = errors.New("invalid data set lengths")
 	errNotEnoughData              = errors.New("not enough data to derive signal")
+	errInvalidMultiplier          = errors.New("invalid multiplier")
+	errInvalidAcceleration        = errors.New("invalid acceleration factor")
+	errInvalidAnchor              = errors.New("invalid anchor")
 )
 
 // OHLC is a connector for technical analysis usage
@@ -352,3 +357,660 @@ func (o *OHLC) GetRelativeStrengthIndex(option []float64, period int64) ([]float
 	}
 	return indicators.RSI(option, int(period)), nil
 }
+
+// Stochastic defines the %K and %D lines of a stochastic oscillator
+type Stochastic struct {
+	K []float64
+	D []float64
+}
+
+// GetStochastic returns the slow stochastic oscillator for the given %K
+// lookback period, %K smoothing period and %D signal period.
+func (k *Item) GetStochastic(period, smoothing, signal int64) (*Stochastic, error) {
+	return k.GetOHLC().GetStochastic(period, smoothing, signal)
+}
+
+// GetStochastic returns the slow stochastic oscillator for the given %K
+// lookback period, %K smoothing period and %D signal period.
+func (o *OHLC) GetStochastic(period, smoothing, signal int64) (*Stochastic, error) {
+	if o == nil {
+		return nil, fmt.Errorf("get stochastic %w", errNilOHLC)
+	}
+	if period <= 0 {
+		return nil, fmt.Errorf("get stochastic %w", errInvalidPeriod)
+	}
+	if smoothing <= 0 {
+		return nil, fmt.Errorf("get stochastic %w smoothing", errInvalidPeriod)
+	}
+	if signal <= 0 {
+		return nil, fmt.Errorf("get stochastic %w signal", errInvalidPeriod)
+	}
+	if err := o.checkHighLowClose("get stochastic"); err != nil {
+		return nil, err
+	}
+	if required := int(period + smoothing + signal - 2); len(o.Close) < required {
+		return nil, fmt.Errorf("get stochastic %w %v data points are less than minimum %v length requirement",
+			errNotEnoughData, len(o.Close), required)
+	}
+	return stochastic(o.High, o.Low, o.Close, 0, int(period), int(smoothing), int(signal)), nil
+}
+
+// GetStochasticRSIOnClose returns the stochastic RSI on the close price set.
+func (k *Item) GetStochasticRSIOnClose(rsiPeriod, period, smoothing, signal int64) (*Stochastic, error) {
+	ohlc := k.GetOHLC()
+	return ohlc.GetStochasticRSI(ohlc.Close, rsiPeriod, period, smoothing, signal)
+}
+
+// GetStochasticRSI returns the stochastic oscillator applied to the relative
+// strength index of the supplied price set.
+func (o *OHLC) GetStochasticRSI(option []float64, rsiPeriod, period, smoothing, signal int64) (*Stochastic, error) {
+	if o == nil {
+		return nil, fmt.Errorf("get stochastic rsi %w", errNilOHLC)
+	}
+	if rsiPeriod <= 1 {
+		return nil, fmt.Errorf("get stochastic rsi %w rsi period cannot be equal or below 1", errInvalidPeriod)
+	}
+	if period <= 0 {
+		return nil, fmt.Errorf("get stochastic rsi %w", errInvalidPeriod)
+	}
+	if smoothing <= 0 {
+		return nil, fmt.Errorf("get stochastic rsi %w smoothing", errInvalidPeriod)
+	}
+	if signal <= 0 {
+		return nil, fmt.Errorf("get stochastic rsi %w signal", errInvalidPeriod)
+	}
+	if len(option) == 0 {
+		return nil, fmt.Errorf("get stochastic rsi %w", errNoData)
+	}
+	if required := int(rsiPeriod + period + smoothing + signal - 2); len(option) < required {
+		return nil, fmt.Errorf("get stochastic rsi %w %v data points are less than minimum %v length requirement",
+			errNotEnoughData, len(option), required)
+	}
+	rsi := indicators.RSI(option, int(rsiPeriod))
+	return stochastic(rsi, rsi, rsi, int(rsiPeriod), int(period), int(smoothing), int(signal)), nil
+}
+
+// DirectionalMovement defines the Directional Movement Index lines and the
+// Average Directional Index derived from them
+type DirectionalMovement struct {
+	PlusDI  []float64
+	MinusDI []float64
+	ADX     []float64
+}
+
+// GetAverageDirectionalIndex returns the ADX and its +DI and -DI components
+// for the given period.
+func (k *Item) GetAverageDirectionalIndex(period int64) (*DirectionalMovement, error) {
+	return k.GetOHLC().GetAverageDirectionalIndex(period)
+}
+
+// GetAverageDirectionalIndex returns the ADX and its +DI and -DI components
+// for the given period using Wilder smoothing.
+func (o *OHLC) GetAverageDirectionalIndex(period int64) (*DirectionalMovement, error) {
+	if o == nil {
+		return nil, fmt.Errorf("get average directional index %w", errNilOHLC)
+	}
+	if period <= 0 {
+		return nil, fmt.Errorf("get average directional index %w", errInvalidPeriod)
+	}
+	if err := o.checkHighLowClose("get average directional index"); err != nil {
+		return nil, err
+	}
+	p := int(period)
+	if len(o.Close) < 2*p {
+		return nil, fmt.Errorf("get average directional index %w %v data points are less than minimum %v length requirement",
+			errNotEnoughData, len(o.Close), 2*p)
+	}
+
+	dm := &DirectionalMovement{
+		PlusDI:  make([]float64, len(o.Close)),
+		MinusDI: make([]float64, len(o.Close)),
+		ADX:     make([]float64, len(o.Close)),
+	}
+	dx := make([]float64, len(o.Close))
+	var smoothedTR, smoothedPlus, smoothedMinus float64
+	for i := 1; i < len(o.Close); i++ {
+		upMove := o.High[i] - o.High[i-1]
+		downMove := o.Low[i-1] - o.Low[i]
+		var plusDM, minusDM float64
+		if upMove > downMove && upMove > 0 {
+			plusDM = upMove
+		}
+		if downMove > upMove && downMove > 0 {
+			minusDM = downMove
+		}
+		tr := trueRange(o.High[i], o.Low[i], o.Close[i-1])
+		if i <= p {
+			smoothedTR += tr
+			smoothedPlus += plusDM
+			smoothedMinus += minusDM
+			if i < p {
+				continue
+			}
+		} else {
+			smoothedTR += tr - smoothedTR/float64(p)
+			smoothedPlus += plusDM - smoothedPlus/float64(p)
+			smoothedMinus += minusDM - smoothedMinus/float64(p)
+		}
+		if smoothedTR != 0 {
+			dm.PlusDI[i] = 100 * smoothedPlus / smoothedTR
+			dm.MinusDI[i] = 100 * smoothedMinus / smoothedTR
+		}
+		if sum := dm.PlusDI[i] + dm.MinusDI[i]; sum != 0 {
+			dx[i] = 100 * math.Abs(dm.PlusDI[i]-dm.MinusDI[i]) / sum
+		}
+		switch {
+		case i == 2*p-1:
+			var total float64
+			for _, v := range dx[p : 2*p] {
+				total += v
+			}
+			dm.ADX[i] = total / float64(p)
+		case i > 2*p-1:
+			dm.ADX[i] = (dm.ADX[i-1]*float64(p-1) + dx[i]) / float64(p)
+		}
+	}
+	return dm, nil
+}
+
+// Ichimoku defines the Ichimoku Kinko Hyo cloud lines. Each line is aligned to
+// the candle it is plotted against, so the leading spans are shifted forward
+// and the lagging span backward by the displacement period.
+type Ichimoku struct {
+	Conversion   []float64
+	Base         []float64
+	LeadingSpanA []float64
+	LeadingSpanB []float64
+	LaggingSpan  []float64
+}
+
+// GetIchimoku returns the Ichimoku cloud for the given conversion, base and
+// leading span B periods and displacement.
+func (k *Item) GetIchimoku(conversion, base, spanB, displacement int64) (*Ichimoku, error) {
+	return k.GetOHLC().GetIchimoku(conversion, base, spanB, displacement)
+}
+
+// GetIchimoku returns the Ichimoku cloud for the given conversion, base and
+// leading span B periods and displacement.
+func (o *OHLC) GetIchimoku(conversion, base, spanB, displacement int64) (*Ichimoku, error) {
+	if o == nil {
+		return nil, fmt.Errorf("get ichimoku %w", errNilOHLC)
+	}
+	if conversion <= 0 {
+		return nil, fmt.Errorf("get ichimoku %w conversion", errInvalidPeriod)
+	}
+	if base <= 0 {
+		return nil, fmt.Errorf("get ichimoku %w base", errInvalidPeriod)
+	}
+	if spanB <= 0 {
+		return nil, fmt.Errorf("get ichimoku %w leading span b", errInvalidPeriod)
+	}
+	if displacement < 0 {
+		return nil, fmt.Errorf("get ichimoku %w displacement", errInvalidPeriod)
+	}
+	if err := o.checkHighLowClose("get ichimoku"); err != nil {
+		return nil, err
+	}
+	if longest := max(conversion, base, spanB); int(longest) > len(o.Close) {
+		return nil, fmt.Errorf("get ichimoku %w '%v' should not exceed data length '%v'",
+			errInvalidPeriod, longest, len(o.Close))
+	}
+
+	ichimoku := &Ichimoku{
+		Conversion:   midpoints(o.High, o.Low, int(conversion)),
+		Base:         midpoints(o.High, o.Low, int(base)),
+		LeadingSpanA: make([]float64, len(o.Close)),
+		LeadingSpanB: make([]float64, len(o.Close)),
+		LaggingSpan:  make([]float64, len(o.Close)),
+	}
+	spanBRaw := midpoints(o.High, o.Low, int(spanB))
+	spanAStart := int(max(conversion, base)) - 1
+	shift := int(displacement)
+	for i := range o.Close {
+		if src := i - shift; src >= spanAStart {
+			ichimoku.LeadingSpanA[i] = (ichimoku.Conversion[src] + ichimoku.Base[src]) / 2
+		}
+		if src := i - shift; src >= int(spanB)-1 {
+			ichimoku.LeadingSpanB[i] = spanBRaw[src]
+		}
+		if src := i + shift; src < len(o.Close) {
+			ichimoku.LaggingSpan[i] = o.Close[src]
+		}
+	}
+	return ichimoku, nil
+}
+
+// GetAnchoredVWAPs returns the Volume Weighted Average Prices with the
+// cumulative totals reset at the start of each session. A session interval
+// of one day will anchor the VWAP to each UTC midnight and calendar intervals
+// such as one month anchor to the start of each month.
+// NOTE: This assumes candles are sorted by time
+func (k *Item) GetAnchoredVWAPs(session Interval) ([]float64, error) {
+	if session <= 0 {
+		return nil, fmt.Errorf("get anchored vwap %w session interval", errInvalidAnchor)
+	}
+	if len(k.Candles) == 0 {
+		return nil, fmt.Errorf("get anchored vwap %w", errNoData)
+	}
+	anchors := []int{0}
+	for x := 1; x < len(k.Candles); x++ {
+		if !alignIntervalStart(k.Candles[x].Time, session).Equal(alignIntervalStart(k.Candles[x-1].Time, session)) {
+			anchors = append(anchors, x)
+		}
+	}
+	return k.GetOHLC().GetAnchoredVWAPs(anchors)
+}
+
+// GetAnchoredVWAPs returns the Volume Weighted Average Prices with the
+// cumulative totals reset at each of the supplied ascending anchor elements.
+func (o *OHLC) GetAnchoredVWAPs(anchors []int) ([]float64, error) {
+	if o == nil {
+		return nil, fmt.Errorf("get anchored vwap %w", errNilOHLC)
+	}
+	if len(o.High) == 0 || len(o.Low) == 0 || len(o.Close) == 0 || len(o.Volume) == 0 {
+		return nil, fmt.Errorf("get anchored vwap %w", errNoData)
+	}
+	if len(o.High) != len(o.Low) || len(o.High) != len(o.Close) || len(o.High) != len(o.Volume) {
+		return nil, fmt.Errorf("get anchored vwap %w", errDataLengthMismatch)
+	}
+	for x := range anchors {
+		if anchors[x] < 0 || anchors[x] >= len(o.Close) || (x > 0 && anchors[x] <= anchors[x-1]) {
+			return nil, fmt.Errorf("get anchored vwap %w element %v", errInvalidAnchor, anchors[x])
+		}
+	}
+
+	store := make([]float64, len(o.Close))
+	var cumTotal, cumVolume float64
+	for x := range o.Close {
+		if _, found := slices.BinarySearch(anchors, x); found {
+			cumTotal, cumVolume = 0, 0
+		}
+		typPrice := (o.High[x] + o.Low[x] + o.Close[x]) / 3
+		cumTotal += typPrice * o.Volume[x]
+		cumVolume += o.Volume[x]
+		if cumVolume == 0 {
+			store[x] = typPrice
+			continue
+		}
+		store[x] = cumTotal / cumVolume
+	}
+	return store, nil
+}
+
+// Channel defines the upper, middle and lower lines of a price channel
+type Channel struct {
+	Upper  []float64
+	Middle []float64
+	Lower  []float64
+}
+
+// GetKeltnerChannels returns the Keltner Channels using an EMA of the close
+// price set for the middle line and an ATR multiple for the band width.
+func (k *Item) GetKeltnerChannels(emaPeriod, atrPeriod int64, multiplier float64) (*Channel, error) {
+	return k.GetOHLC().GetKeltnerChannels(emaPeriod, atrPeriod, multiplier)
+}
+
+// GetKeltnerChannels returns the Keltner Channels using an EMA of the close
+// price set for the middle line and an ATR multiple for the band width.
+func (o *OHLC) GetKeltnerChannels(emaPeriod, atrPeriod int64, multiplier float64) (*Channel, error) {
+	if o == nil {
+		return nil, fmt.Errorf("get keltner channels %w", errNilOHLC)
+	}
+	if emaPeriod <= 0 {
+		return nil, fmt.Errorf("get keltner channels %w ema", errInvalidPeriod)
+	}
+	if atrPeriod <= 0 {
+		return nil, fmt.Errorf("get keltner channels %w atr", errInvalidPeriod)
+	}
+	if multiplier <= 0 {
+		return nil, fmt.Errorf("get keltner channels %w", errInvalidMultiplier)
+	}
+	if err := o.checkHighLowClose("get keltner channels"); err != nil {
+		return nil, err
+	}
+	if int(emaPeriod) > len(o.Close) || int(atrPeriod) >= len(o.Close) {
+		return nil, fmt.Errorf("get keltner channels %w exceeds data length, please reduce", errInvalidPeriod)
+	}
+
+	middle := indicators.EMA(o.Close, int(emaPeriod))
+	atr := indicators.ATR(o.High, o.Low, o.Close, int(atrPeriod))
+	start := max(int(emaPeriod)-1, int(atrPeriod))
+	channel := &Channel{
+		Upper:  make([]float64, len(o.Close)),
+		Middle: make([]float64, len(o.Close)),
+		Lower:  make([]float64, len(o.Close)),
+	}
+	for i := start; i < len(o.Close); i++ {
+		channel.Middle[i] = middle[i]
+		channel.Upper[i] = middle[i] + multiplier*atr[i]
+		channel.Lower[i] = middle[i] - multiplier*atr[i]
+	}
+	return channel, nil
+}
+
+// GetDonchianChannels returns the Donchian Channels for the given period.
+func (k *Item) GetDonchianChannels(period int64) (*Channel, error) {
+	return k.GetOHLC().GetDonchianChannels(period)
+}
+
+// GetDonchianChannels returns the Donchian Channels for the given period.
+func (o *OHLC) GetDonchianChannels(period int64) (*Channel, error) {
+	if o == nil {
+		return nil, fmt.Errorf("get donchian channels %w", errNilOHLC)
+	}
+	if period <= 0 {
+		return nil, fmt.Errorf("get donchian channels %w", errInvalidPeriod)
+	}
+	if len(o.High) == 0 {
+		return nil, fmt.Errorf("get donchian channels high %w", errNoData)
+	}
+	if len(o.Low) == 0 {
+		return nil, fmt.Errorf("get donchian channels low %w", errNoData)
+	}
+	if len(o.High) != len(o.Low) {
+		return nil, fmt.Errorf("get donchian channels %w", errInvalidDataSetLengths)
+	}
+	if int(period) > len(o.High) {
+		return nil, fmt.Errorf("get donchian channels %w exceeds data length, please reduce", errInvalidPeriod)
+	}
+	channel := &Channel{Middle: midpoints(o.High, o.Low, int(period))}
+	channel.Upper, channel.Lower = extremes(o.High, o.Low, int(period))
+	return channel, nil
+}
+
+// Supertrend defines the supertrend line and the trend direction for each
+// element, where 1 denotes an uptrend and -1 a downtrend
+type Supertrend struct {
+	Values    []float64
+	Direction []float64
+}
+
+// GetSupertrend returns the supertrend for the given ATR period and
+// multiplier.
+func (k *Item) GetSupertrend(period int64, multiplier float64) (*Supertrend, error) {
+	return k.GetOHLC().GetSupertrend(period, multiplier)
+}
+
+// GetSupertrend returns the supertrend for the given ATR period and
+// multiplier.
+func (o *OHLC) GetSupertrend(period int64, multiplier float64) (*Supertrend, error) {
+	if o == nil {
+		return nil, fmt.Errorf("get supertrend %w", errNilOHLC)
+	}
+	if period <= 0 {
+		return nil, fmt.Errorf("get supertrend %w", errInvalidPeriod)
+	}
+	if multiplier <= 0 {
+		return nil, fmt.Errorf("get supertrend %w", errInvalidMultiplier)
+	}
+	if err := o.checkHighLowClose("get supertrend"); err != nil {
+		return nil, err
+	}
+	if int(period) >= len(o.Close) {
+		return nil, fmt.Errorf("get supertrend %w '%v' should not exceed or equal close data length '%v'",
+			errInvalidPeriod, period, len(o.Close))
+	}
+
+	atr := indicators.ATR(o.High, o.Low, o.Close, int(period))
+	st := &Supertrend{
+		Values:    make([]float64, len(o.Close)),
+		Direction: make([]float64, len(o.Close)),
+	}
+	var upper, lower float64
+	for i := int(period); i < len(o.Close); i++ {
+		hl2 := (o.High[i] + o.Low[i]) / 2
+		basicUpper := hl2 + multiplier*atr[i]
+		basicLower := hl2 - multiplier*atr[i]
+		if i == int(period) {
+			upper, lower = basicUpper, basicLower
+			st.Direction[i] = -1
+			if o.Close[i] > upper {
+				st.Direction[i] = 1
+			}
+		} else {
+			// Bands only ratchet towards price unless the prior close broke
+			// through them.
+			if basicUpper < upper || o.Close[i-1] > upper {
+				upper = basicUpper
+			}
+			if basicLower > lower || o.Close[i-1] < lower {
+				lower = basicLower
+			}
+			st.Direction[i] = st.Direction[i-1]
+			switch {
+			case st.Direction[i] < 0 && o.Close[i] > upper:
+				st.Direction[i] = 1
+			case st.Direction[i] > 0 && o.Close[i] < lower:
+				st.Direction[i] = -1
+			}
+		}
+		if st.Direction[i] > 0 {
+			st.Values[i] = lower
+		} else {
+			st.Values[i] = upper
+		}
+	}
+	return st, nil
+}
+
+// GetParabolicSAR returns the Parabolic Stop and Reverse for the given
+// acceleration factor step and maximum.
+func (k *Item) GetParabolicSAR(step, maximum float64) ([]float64, error) {
+	return k.GetOHLC().GetParabolicSAR(step, maximum)
+}
+
+// GetParabolicSAR returns the Parabolic Stop and Reverse for the given
+// acceleration factor step and maximum.
+func (o *OHLC) GetParabolicSAR(step, maximum float64) ([]float64, error) {
+	if o == nil {
+		return nil, fmt.Errorf("get parabolic sar %w", errNilOHLC)
+	}
+	if step <= 0 {
+		return nil, fmt.Errorf("get parabolic sar %w step", errInvalidAcceleration)
+	}
+	if maximum < step {
+		return nil, fmt.Errorf("get parabolic sar %w maximum should not be less than step", errInvalidAcceleration)
+	}
+	if err := o.checkHighLowClose("get parabolic sar"); err != nil {
+		return nil, err
+	}
+	if len(o.Close) < 2 {
+		return nil, fmt.Errorf("get parabolic sar %w, requires at least 2 data points", errNotEnoughData)
+	}
+
+	sar := make([]float64, len(o.Close))
+	long := o.Close[1] >= o.Close[0]
+	var extreme float64
+	if long {
+		sar[1], extreme = o.Low[0], o.High[1]
+	} else {
+		sar[1], extreme = o.High[0], o.Low[1]
+	}
+	af := step
+	for i := 2; i < len(o.Close); i++ {
+		next := sar[i-1] + af*(extreme-sar[i-1])
+		if long {
+			next = min(next, o.Low[i-1], o.Low[i-2])
+			switch {
+			case o.Low[i] < next:
+				long, next, extreme, af = false, extreme, o.Low[i], step
+			case o.High[i] > extreme:
+				extreme, af = o.High[i], min(af+step, maximum)
+			}
+		} else {
+			next = max(next, o.High[i-1], o.High[i-2])
+			switch {
+			case o.High[i] > next:
+				long, next, extreme, af = true, extreme, o.High[i], step
+			case o.Low[i] < extreme:
+				extreme, af = o.Low[i], min(af+step, maximum)
+			}
+		}
+		sar[i] = next
+	}
+	return sar, nil
+}
+
+// GetCommodityChannelIndex returns the Commodity Channel Index for the given
+// period.
+func (k *Item) GetCommodityChannelIndex(period int64) ([]float64, error) {
+	return k.GetOHLC().GetCommodityChannelIndex(period)
+}
+
+// GetCommodityChannelIndex returns the Commodity Channel Index for the given
+// period.
+func (o *OHLC) GetCommodityChannelIndex(period int64) ([]float64, error) {
+	if o == nil {
+		return nil, fmt.Errorf("get commodity channel index %w", errNilOHLC)
+	}
+	if period <= 0 {
+		return nil, fmt.Errorf("get commodity channel index %w", errInvalidPeriod)
+	}
+	if err := o.checkHighLowClose("get commodity channel index"); err != nil {
+		return nil, err
+	}
+	if int(period) > len(o.Close) {
+		return nil, fmt.Errorf("get commodity channel index %w exceeds data length, please reduce", errInvalidPeriod)
+	}
+
+	p := int(period)
+	typical := make([]float64, len(o.Close))
+	for i := range o.Close {
+		typical[i] = (o.High[i] + o.Low[i] + o.Close[i]) / 3
+	}
+	cci := make([]float64, len(o.Close))
+	for i := p - 1; i < len(o.Close); i++ {
+		window := typical[i-p+1 : i+1]
+		var mean float64
+		for _, v := range window {
+			mean += v
+		}
+		mean /= float64(p)
+		var deviation float64
+		for _, v := range window {
+			deviation += math.Abs(v - mean)
+		}
+		deviation /= float64(p)
+		if deviation == 0 {
+			continue
+		}
+		// Lambert's constant scales roughly 70-80% of values between -100 and 100
+		cci[i] = (typical[i] - mean) / (0.015 * deviation)
+	}
+	return cci, nil
+}
+
+// GetWilliamsPercentRange returns Williams %R for the given period.
+func (k *Item) GetWilliamsPercentRange(period int64) ([]float64, error) {
+	return k.GetOHLC().GetWilliamsPercentRange(period)
+}
+
+// GetWilliamsPercentRange returns Williams %R for the given period.
+func (o *OHLC) GetWilliamsPercentRange(period int64) ([]float64, error) {
+	if o == nil {
+		return nil, fmt.Errorf("get williams percent range %w", errNilOHLC)
+	}
+	if period <= 0 {
+		return nil, fmt.Errorf("get williams percent range %w", errInvalidPeriod)
+	}
+	if err := o.checkHighLowClose("get williams percent range"); err != nil {
+		return nil, err
+	}
+	if int(period) > len(o.Close) {
+		return nil, fmt.Errorf("get williams percent range %w exceeds data length, please reduce", errInvalidPeriod)
+	}
+
+	highest, lowest := extremes(o.High, o.Low, int(period))
+	willR := make([]float64, len(o.Close))
+	for i := int(period) - 1; i < len(o.Close); i++ {
+		if r := highest[i] - lowest[i]; r != 0 {
+			willR[i] = -100 * (highest[i] - o.Close[i]) / r
+			continue
+		}
+		willR[i] = -50
+	}
+	return willR, nil
+}
+
+// checkHighLowClose ensures the high, low and close data sets are populated
+// and aligned for indicators that walk them together.
+func (o *OHLC) checkHighLowClose(operation string) error {
+	if len(o.High) == 0 {
+		return fmt.Errorf("%s high %w", operation, errNoData)
+	}
+	if len(o.Low) == 0 {
+		return fmt.Errorf("%s low %w", operation, errNoData)
+	}
+	if len(o.Close) == 0 {
+		return fmt.Errorf("%s close %w", operation, errNoData)
+	}
+	if len(o.High) != len(o.Close) || len(o.Low) != len(o.Close) {
+		return fmt.Errorf("%s %w", operation, errInvalidDataSetLengths)
+	}
+	return nil
+}
+
+// stochastic derives the smoothed %K and %D lines, starting from the first
+// valid element so that warm-up values of a source indicator are ignored.
+func stochastic(high, low, closes []float64, start, period, smoothing, signal int) *Stochastic {
+	highest, lowest := extremes(high[start:], low[start:], period)
+	fastK := make([]float64, len(closes))
+	for i := period - 1; i < len(highest); i++ {
+		if r := highest[i] - lowest[i]; r != 0 {
+			fastK[start+i] = 100 * (closes[start+i] - lowest[i]) / r
+			continue
+		}
+		fastK[start+i] = 50
+	}
+	k := smoothFrom(fastK, start+period-1, smoothing)
+	return &Stochastic{
+		K: k,
+		D: smoothFrom(k, start+period+smoothing-2, signal),
+	}
+}
+
+// smoothFrom returns the simple moving average of the data set beginning at
+// the first valid element, zero filled until a full window is available.
+func smoothFrom(in []float64, first, period int) []float64 {
+	out := make([]float64, len(in))
+	var sum float64
+	for i := first; i < len(in); i++ {
+		sum += in[i]
+		if i-first >= period {
+			sum -= in[i-period]
+		}
+		if i-first >= period-1 {
+			out[i] = sum / float64(period)
+		}
+	}
+	return out
+}
+
+// extremes returns the rolling highest high and lowest low over the period,
+// zero filled until a full window is available.
+func extremes(high, low []float64, period int) (highest, lowest []float64) {
+	highest = make([]float64, len(high))
+	lowest = make([]float64, len(low))
+	for i := period - 1; i < len(high); i++ {
+		highest[i] = slices.Max(high[i-period+1 : i+1])
+		lowest[i] = slices.Min(low[i-period+1 : i+1])
+	}
+	return highest, lowest
+}
+
+// midpoints returns the rolling midpoint between the highest high and lowest
+// low over the period.
+func midpoints(high, low []float64, period int) []float64 {
+	highest, lowest := extremes(high, low, period)
+	out := make([]float64, len(highest))
+	for i := period - 1; i < len(out); i++ {
+		out[i] = (highest[i] + lowest[i]) / 2
+	}
+	return out
+}
+
+// trueRange returns the greatest of the current range and the gaps from the
+// previous close.
+func trueRange(high, low, prevClose float64) float64 {
+	return max(high-low, math.Abs(high-prevClose), math.Abs(low-prevClose))
+}
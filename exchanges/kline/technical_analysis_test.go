@@ -2,10 +2,35 @@ package kline
 
 import (
 	"testing"
+	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
+// testOHLC returns a deterministic zig-zag trending data set
+func testOHLC(length int) *OHLC {
+	o := &OHLC{
+		Open:   make([]float64, length),
+		High:   make([]float64, length),
+		Low:    make([]float64, length),
+		Close:  make([]float64, length),
+		Volume: make([]float64, length),
+	}
+	for i := range length {
+		base := 100 + float64(i)
+		if i%3 == 0 {
+			base -= 2
+		}
+		o.Open[i] = base
+		o.High[i] = base + 2
+		o.Low[i] = base - 2
+		o.Close[i] = base + 1
+		o.Volume[i] = 10 + float64(i%5)
+	}
+	return o
+}
+
 func TestGetOHLC(t *testing.T) {
 	t.Parallel()
 	if (&Item{Candles: []Candle{{Open: 1337}}}).GetOHLC() == nil {
@@ -294,3 +319,420 @@ func TestGetRelativeStrengthIndex(t *testing.T) {
 	_, err = wrap.GetRelativeStrengthIndexOnClose(2)
 	require.NoError(t, err)
 }
+
+func TestGetStochastic(t *testing.T) {
+	t.Parallel()
+
+	var ohlc *OHLC
+	_, err := ohlc.GetStochastic(0, 0, 0)
+	require.ErrorIs(t, err, errNilOHLC)
+
+	ohlc = &OHLC{}
+	_, err = ohlc.GetStochastic(0, 3, 3)
+	require.ErrorIs(t, err, errInvalidPeriod)
+
+	_, err = ohlc.GetStochastic(14, 0, 3)
+	require.ErrorIs(t, err, errInvalidPeriod)
+
+	_, err = ohlc.GetStochastic(14, 3, 0)
+	require.ErrorIs(t, err, errInvalidPeriod)
+
+	_, err = ohlc.GetStochastic(14, 3, 3)
+	require.ErrorIs(t, err, errNoData)
+
+	_, err = (&OHLC{High: []float64{1}, Low: []float64{1}, Close: []float64{1, 2}}).GetStochastic(14, 3, 3)
+	require.ErrorIs(t, err, errInvalidDataSetLengths)
+
+	ohlc = testOHLC(17)
+	_, err = ohlc.GetStochastic(14, 3, 3)
+	require.ErrorIs(t, err, errNotEnoughData)
+
+	ohlc = testOHLC(18)
+	stoch, err := ohlc.GetStochastic(14, 3, 3)
+	require.NoError(t, err)
+	require.Len(t, stoch.K, 18, "K must be the same length as the data set")
+	assert.Zero(t, stoch.K[14], "K should be zero during warm up")
+	assert.NotZero(t, stoch.K[15], "K should be set after warm up")
+	assert.Zero(t, stoch.D[16], "D should be zero during warm up")
+	assert.NotZero(t, stoch.D[17], "D should be set after warm up")
+	for i := 15; i < len(stoch.K); i++ {
+		assert.GreaterOrEqual(t, stoch.K[i], 0.0, "K should not be below 0")
+		assert.LessOrEqual(t, stoch.K[i], 100.0, "K should not exceed 100")
+	}
+
+	flat := &OHLC{High: []float64{5, 5}, Low: []float64{5, 5}, Close: []float64{5, 5}}
+	stoch, err = flat.GetStochastic(1, 1, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []float64{50, 50}, stoch.K, "K should be neutral when there is no range")
+
+	wrap := Item{Candles: []Candle{{High: 2, Low: 1, Close: 2}, {High: 3, Low: 1, Close: 1}}}
+	stoch, err = wrap.GetStochastic(2, 1, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []float64{0, 0}, stoch.K, "K should equal the lowest low")
+}
+
+func TestGetStochasticRSI(t *testing.T) {
+	t.Parallel()
+
+	var ohlc *OHLC
+	_, err := ohlc.GetStochasticRSI(nil, 0, 0, 0, 0)
+	require.ErrorIs(t, err, errNilOHLC)
+
+	ohlc = &OHLC{}
+	_, err = ohlc.GetStochasticRSI(nil, 1, 14, 3, 3)
+	require.ErrorIs(t, err, errInvalidPeriod)
+
+	_, err = ohlc.GetStochasticRSI(nil, 14, 0, 3, 3)
+	require.ErrorIs(t, err, errInvalidPeriod)
+
+	_, err = ohlc.GetStochasticRSI(nil, 14, 14, 0, 3)
+	require.ErrorIs(t, err, errInvalidPeriod)
+
+	_, err = ohlc.GetStochasticRSI(nil, 14, 14, 3, 0)
+	require.ErrorIs(t, err, errInvalidPeriod)
+
+	_, err = ohlc.GetStochasticRSI(nil, 14, 14, 3, 3)
+	require.ErrorIs(t, err, errNoData)
+
+	ohlc = testOHLC(31)
+	_, err = ohlc.GetStochasticRSI(ohlc.Close, 14, 14, 3, 3)
+	require.ErrorIs(t, err, errNotEnoughData)
+
+	wrap := Item{Candles: make([]Candle, 40)}
+	for i := range wrap.Candles {
+		wrap.Candles[i].Close = testOHLC(40).Close[i]
+	}
+	stoch, err := wrap.GetStochasticRSIOnClose(14, 14, 3, 3)
+	require.NoError(t, err)
+	assert.Zero(t, stoch.K[28], "K should be zero during warm up")
+	assert.NotZero(t, stoch.K[29], "K should be set after warm up")
+	assert.Zero(t, stoch.D[30], "D should be zero during warm up")
+	assert.NotZero(t, stoch.D[31], "D should be set after warm up")
+}
+
+func TestGetAverageDirectionalIndex(t *testing.T) {
+	t.Parallel()
+
+	var ohlc *OHLC
+	_, err := ohlc.GetAverageDirectionalIndex(0)
+	require.ErrorIs(t, err, errNilOHLC)
+
+	ohlc = &OHLC{}
+	_, err = ohlc.GetAverageDirectionalIndex(0)
+	require.ErrorIs(t, err, errInvalidPeriod)
+
+	_, err = ohlc.GetAverageDirectionalIndex(14)
+	require.ErrorIs(t, err, errNoData)
+
+	ohlc = testOHLC(27)
+	_, err = ohlc.GetAverageDirectionalIndex(14)
+	require.ErrorIs(t, err, errNotEnoughData)
+
+	ohlc = testOHLC(40)
+	dmi, err := ohlc.GetAverageDirectionalIndex(14)
+	require.NoError(t, err)
+	assert.Zero(t, dmi.PlusDI[13], "+DI should be zero during warm up")
+	assert.Greater(t, dmi.PlusDI[14], dmi.MinusDI[14], "+DI should exceed -DI in an uptrend")
+	assert.Zero(t, dmi.ADX[26], "ADX should be zero during warm up")
+	assert.NotZero(t, dmi.ADX[27], "ADX should be set after warm up")
+
+	wrap := Item{Candles: []Candle{{High: 2, Low: 1, Close: 1}, {High: 3, Low: 2, Close: 3}}}
+	dmi, err = wrap.GetAverageDirectionalIndex(1)
+	require.NoError(t, err)
+	assert.Equal(t, 50.0, dmi.PlusDI[1], "+DI should be derived from the directional movement")
+	assert.Equal(t, 100.0, dmi.ADX[1], "ADX should be derived from the directional index")
+}
+
+func TestGetIchimoku(t *testing.T) {
+	t.Parallel()
+
+	var ohlc *OHLC
+	_, err := ohlc.GetIchimoku(0, 0, 0, 0)
+	require.ErrorIs(t, err, errNilOHLC)
+
+	ohlc = &OHLC{}
+	_, err = ohlc.GetIchimoku(0, 26, 52, 26)
+	require.ErrorIs(t, err, errInvalidPeriod)
+
+	_, err = ohlc.GetIchimoku(9, 0, 52, 26)
+	require.ErrorIs(t, err, errInvalidPeriod)
+
+	_, err = ohlc.GetIchimoku(9, 26, 0, 26)
+	require.ErrorIs(t, err, errInvalidPeriod)
+
+	_, err = ohlc.GetIchimoku(9, 26, 52, -1)
+	require.ErrorIs(t, err, errInvalidPeriod)
+
+	_, err = ohlc.GetIchimoku(9, 26, 52, 26)
+	require.ErrorIs(t, err, errNoData)
+
+	ohlc = testOHLC(51)
+	_, err = ohlc.GetIchimoku(9, 26, 52, 26)
+	require.ErrorIs(t, err, errInvalidPeriod)
+
+	ohlc = testOHLC(100)
+	cloud, err := ohlc.GetIchimoku(9, 26, 52, 26)
+	require.NoError(t, err)
+	assert.Zero(t, cloud.Conversion[7], "conversion should be zero during warm up")
+	assert.NotZero(t, cloud.Conversion[8], "conversion should be set after warm up")
+	assert.Zero(t, cloud.LeadingSpanA[50], "leading span a should be displaced")
+	assert.Equal(t, (cloud.Conversion[25]+cloud.Base[25])/2, cloud.LeadingSpanA[51], "leading span a should be displaced")
+	assert.Zero(t, cloud.LeadingSpanB[76], "leading span b should be displaced")
+	assert.NotZero(t, cloud.LeadingSpanB[77], "leading span b should be displaced")
+	assert.Equal(t, ohlc.Close[99], cloud.LaggingSpan[73], "lagging span should be displaced")
+	assert.Zero(t, cloud.LaggingSpan[74], "lagging span should be zero beyond the data set")
+
+	wrap := Item{Candles: []Candle{{High: 3, Low: 1, Close: 2}}}
+	cloud, err = wrap.GetIchimoku(1, 1, 1, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []float64{2}, cloud.LeadingSpanA, "leading span a should match the midpoint")
+}
+
+func TestGetAnchoredVWAPs(t *testing.T) {
+	t.Parallel()
+
+	var ohlc *OHLC
+	_, err := ohlc.GetAnchoredVWAPs(nil)
+	require.ErrorIs(t, err, errNilOHLC)
+
+	ohlc = &OHLC{}
+	_, err = ohlc.GetAnchoredVWAPs(nil)
+	require.ErrorIs(t, err, errNoData)
+
+	ohlc = &OHLC{High: []float64{3, 6}, Low: []float64{3, 6}, Close: []float64{3, 6}, Volume: []float64{1}}
+	_, err = ohlc.GetAnchoredVWAPs(nil)
+	require.ErrorIs(t, err, errDataLengthMismatch)
+
+	ohlc.Volume = append(ohlc.Volume, 1)
+	_, err = ohlc.GetAnchoredVWAPs([]int{2})
+	require.ErrorIs(t, err, errInvalidAnchor)
+
+	_, err = ohlc.GetAnchoredVWAPs([]int{1, 0})
+	require.ErrorIs(t, err, errInvalidAnchor)
+
+	vwap, err := ohlc.GetAnchoredVWAPs([]int{0})
+	require.NoError(t, err)
+	assert.Equal(t, []float64{3, 4.5}, vwap, "VWAP should accumulate without a reset")
+
+	vwap, err = ohlc.GetAnchoredVWAPs([]int{0, 1})
+	require.NoError(t, err)
+	assert.Equal(t, []float64{3, 6}, vwap, "VWAP should reset at the anchor")
+
+	var wrap Item
+	_, err = wrap.GetAnchoredVWAPs(0)
+	require.ErrorIs(t, err, errInvalidAnchor)
+
+	_, err = wrap.GetAnchoredVWAPs(OneDay)
+	require.ErrorIs(t, err, errNoData)
+
+	start := time.Date(2020, 1, 1, 22, 0, 0, 0, time.UTC)
+	wrap.Candles = []Candle{
+		{Time: start, High: 3, Low: 3, Close: 3, Volume: 1},
+		{Time: start.Add(time.Hour), High: 6, Low: 6, Close: 6, Volume: 1},
+		{Time: start.Add(time.Hour * 2), High: 9, Low: 9, Close: 9, Volume: 1},
+	}
+	vwap, err = wrap.GetAnchoredVWAPs(OneDay)
+	require.NoError(t, err)
+	assert.Equal(t, []float64{3, 4.5, 9}, vwap, "VWAP should reset at the start of each session")
+}
+
+func TestGetKeltnerChannels(t *testing.T) {
+	t.Parallel()
+
+	var ohlc *OHLC
+	_, err := ohlc.GetKeltnerChannels(0, 0, 0)
+	require.ErrorIs(t, err, errNilOHLC)
+
+	ohlc = &OHLC{}
+	_, err = ohlc.GetKeltnerChannels(0, 10, 2)
+	require.ErrorIs(t, err, errInvalidPeriod)
+
+	_, err = ohlc.GetKeltnerChannels(20, 0, 2)
+	require.ErrorIs(t, err, errInvalidPeriod)
+
+	_, err = ohlc.GetKeltnerChannels(20, 10, 0)
+	require.ErrorIs(t, err, errInvalidMultiplier)
+
+	_, err = ohlc.GetKeltnerChannels(20, 10, 2)
+	require.ErrorIs(t, err, errNoData)
+
+	ohlc = testOHLC(10)
+	_, err = ohlc.GetKeltnerChannels(20, 10, 2)
+	require.ErrorIs(t, err, errInvalidPeriod)
+
+	ohlc = testOHLC(30)
+	channel, err := ohlc.GetKeltnerChannels(20, 10, 2)
+	require.NoError(t, err)
+	assert.Zero(t, channel.Middle[18], "middle should be zero during warm up")
+	assert.NotZero(t, channel.Middle[19], "middle should be set after warm up")
+	assert.Greater(t, channel.Upper[19], channel.Middle[19], "upper should exceed middle")
+	assert.Less(t, channel.Lower[19], channel.Middle[19], "lower should be below middle")
+
+	wrap := Item{Candles: make([]Candle, 30)}
+	_, err = wrap.GetKeltnerChannels(20, 10, 2)
+	require.NoError(t, err)
+}
+
+func TestGetDonchianChannels(t *testing.T) {
+	t.Parallel()
+
+	var ohlc *OHLC
+	_, err := ohlc.GetDonchianChannels(0)
+	require.ErrorIs(t, err, errNilOHLC)
+
+	ohlc = &OHLC{}
+	_, err = ohlc.GetDonchianChannels(0)
+	require.ErrorIs(t, err, errInvalidPeriod)
+
+	_, err = ohlc.GetDonchianChannels(2)
+	require.ErrorIs(t, err, errNoData)
+
+	ohlc.High = append(ohlc.High, 1)
+	_, err = ohlc.GetDonchianChannels(2)
+	require.ErrorIs(t, err, errNoData)
+
+	ohlc.Low = append(ohlc.Low, 1, 1)
+	_, err = ohlc.GetDonchianChannels(2)
+	require.ErrorIs(t, err, errInvalidDataSetLengths)
+
+	ohlc.High = append(ohlc.High, 1)
+	_, err = ohlc.GetDonchianChannels(3)
+	require.ErrorIs(t, err, errInvalidPeriod)
+
+	wrap := Item{Candles: []Candle{{High: 4, Low: 2}, {High: 5, Low: 3}, {High: 3, Low: 1}}}
+	channel, err := wrap.GetDonchianChannels(2)
+	require.NoError(t, err)
+	assert.Equal(t, []float64{0, 5, 5}, channel.Upper, "upper should be the highest high")
+	assert.Equal(t, []float64{0, 2, 1}, channel.Lower, "lower should be the lowest low")
+	assert.Equal(t, []float64{0, 3.5, 3}, channel.Middle, "middle should be the midpoint")
+}
+
+func TestGetSupertrend(t *testing.T) {
+	t.Parallel()
+
+	var ohlc *OHLC
+	_, err := ohlc.GetSupertrend(0, 0)
+	require.ErrorIs(t, err, errNilOHLC)
+
+	ohlc = &OHLC{}
+	_, err = ohlc.GetSupertrend(0, 3)
+	require.ErrorIs(t, err, errInvalidPeriod)
+
+	_, err = ohlc.GetSupertrend(10, 0)
+	require.ErrorIs(t, err, errInvalidMultiplier)
+
+	_, err = ohlc.GetSupertrend(10, 3)
+	require.ErrorIs(t, err, errNoData)
+
+	ohlc = testOHLC(10)
+	_, err = ohlc.GetSupertrend(10, 3)
+	require.ErrorIs(t, err, errInvalidPeriod)
+
+	ohlc = testOHLC(40)
+	st, err := ohlc.GetSupertrend(10, 1)
+	require.NoError(t, err)
+	assert.Zero(t, st.Direction[9], "direction should be zero during warm up")
+	assert.Equal(t, 1.0, st.Direction[39], "direction should reflect the uptrend")
+	assert.Less(t, st.Values[39], ohlc.Close[39], "supertrend should trail price in an uptrend")
+
+	for i := range ohlc.Close {
+		ohlc.Close[i] = 200 - ohlc.Close[i]
+		ohlc.High[i], ohlc.Low[i] = 200-ohlc.Low[i], 200-ohlc.High[i]
+	}
+	st, err = ohlc.GetSupertrend(10, 1)
+	require.NoError(t, err)
+	assert.Equal(t, -1.0, st.Direction[39], "direction should reflect the downtrend")
+	assert.Greater(t, st.Values[39], ohlc.Close[39], "supertrend should sit above price in a downtrend")
+
+	wrap := Item{Candles: make([]Candle, 3)}
+	_, err = wrap.GetSupertrend(2, 3)
+	require.NoError(t, err)
+}
+
+func TestGetParabolicSAR(t *testing.T) {
+	t.Parallel()
+
+	var ohlc *OHLC
+	_, err := ohlc.GetParabolicSAR(0, 0)
+	require.ErrorIs(t, err, errNilOHLC)
+
+	ohlc = &OHLC{}
+	_, err = ohlc.GetParabolicSAR(0, 0.2)
+	require.ErrorIs(t, err, errInvalidAcceleration)
+
+	_, err = ohlc.GetParabolicSAR(0.02, 0.01)
+	require.ErrorIs(t, err, errInvalidAcceleration)
+
+	_, err = ohlc.GetParabolicSAR(0.02, 0.2)
+	require.ErrorIs(t, err, errNoData)
+
+	ohlc = testOHLC(1)
+	_, err = ohlc.GetParabolicSAR(0.02, 0.2)
+	require.ErrorIs(t, err, errNotEnoughData)
+
+	wrap := Item{Candles: []Candle{
+		{High: 10, Low: 8, Close: 9},
+		{High: 11, Low: 9, Close: 10},
+		{High: 12, Low: 10, Close: 11},
+		{High: 13, Low: 11, Close: 12},
+		{High: 9, Low: 6, Close: 7},
+	}}
+	sar, err := wrap.GetParabolicSAR(0.1, 0.2)
+	require.NoError(t, err)
+	assert.Equal(t, 8.0, sar[1], "initial SAR should be the prior low in an uptrend")
+	assert.Equal(t, 8.0, sar[2], "SAR should not exceed the prior two lows")
+	assert.InDelta(t, 8.8, sar[3], 1e-9, "SAR should accelerate towards the extreme point")
+	assert.Equal(t, 13.0, sar[4], "SAR should reverse to the extreme point")
+}
+
+func TestGetCommodityChannelIndex(t *testing.T) {
+	t.Parallel()
+
+	var ohlc *OHLC
+	_, err := ohlc.GetCommodityChannelIndex(0)
+	require.ErrorIs(t, err, errNilOHLC)
+
+	ohlc = &OHLC{}
+	_, err = ohlc.GetCommodityChannelIndex(0)
+	require.ErrorIs(t, err, errInvalidPeriod)
+
+	_, err = ohlc.GetCommodityChannelIndex(20)
+	require.ErrorIs(t, err, errNoData)
+
+	ohlc = testOHLC(19)
+	_, err = ohlc.GetCommodityChannelIndex(20)
+	require.ErrorIs(t, err, errInvalidPeriod)
+
+	wrap := Item{Candles: []Candle{{High: 1, Low: 1, Close: 1}, {High: 2, Low: 2, Close: 2}, {High: 3, Low: 3, Close: 3}}}
+	cci, err := wrap.GetCommodityChannelIndex(3)
+	require.NoError(t, err)
+	assert.InDelta(t, 100.0, cci[2], 1e-9, "CCI should be derived from the mean deviation")
+}
+
+func TestGetWilliamsPercentRange(t *testing.T) {
+	t.Parallel()
+
+	var ohlc *OHLC
+	_, err := ohlc.GetWilliamsPercentRange(0)
+	require.ErrorIs(t, err, errNilOHLC)
+
+	ohlc = &OHLC{}
+	_, err = ohlc.GetWilliamsPercentRange(0)
+	require.ErrorIs(t, err, errInvalidPeriod)
+
+	_, err = ohlc.GetWilliamsPercentRange(14)
+	require.ErrorIs(t, err, errNoData)
+
+	ohlc = testOHLC(13)
+	_, err = ohlc.GetWilliamsPercentRange(14)
+	require.ErrorIs(t, err, errInvalidPeriod)
+
+	wrap := Item{Candles: []Candle{{High: 4, Low: 2, Close: 3}, {High: 5, Low: 1, Close: 2}, {High: 1, Low: 1, Close: 1}}}
+	willR, err := wrap.GetWilliamsPercentRange(2)
+	require.NoError(t, err)
+	assert.Equal(t, []float64{0, -75, -100}, willR, "Williams %R should be relative to the range")
+
+	willR, err = wrap.GetWilliamsPercentRange(1)
+	require.NoError(t, err)
+	assert.Equal(t, -50.0, willR[2], "Williams %R should be neutral when there is no range")
+}
@@ -1458,10 +1458,7 @@ func TestChecksumCalculation(t *testing.T) {
 		t.Errorf("expected %s but received %s", expected, v)
 	}
 
-	err := validateCRC32(&testOb, krakenAPIDocChecksum)
-	if err != nil {
-		t.Error(err)
-	}
+	assert.Equal(t, uint32(krakenAPIDocChecksum), generateChecksum(&testOb), "generateChecksum should match the API documentation")
 }
 
 func TestGetCharts(t *testing.T) {
@@ -80,7 +80,6 @@ func init() {
 var (
 	errCancellingOrder        = errors.New("error cancelling order")
 	errSubPairMissing         = errors.New("pair missing from subscription response")
-	errInvalidChecksum        = errors.New("invalid checksum")
 	errExpectedOneSubResponse = errors.New("expected 1 subscription response")
 )
 
@@ -487,16 +486,7 @@ func (e *Exchange) wsProcessOrderBook(ctx context.Context, c string, response []
 			copy(update.Bids, update2.Bids)
 			update.Checksum = update2.Checksum
 		}
-		err := e.wsProcessOrderBookUpdate(pair, &update)
-		if errors.Is(err, errInvalidChecksum) {
-			log.Debugf(log.Global, "%s Resubscribing to invalid %s orderbook", e.Name, pair)
-			go func() {
-				if e2 := e.Websocket.ResubscribeToChannel(ctx, e.Websocket.Conn, s); e2 != nil && !errors.Is(e2, subscription.ErrInStateAlready) {
-					log.Errorf(log.ExchangeSys, "%s resubscription failure for %v: %v", e.Name, pair, e2)
-				}
-			}()
-		}
-		return err
+		return e.wsProcessOrderBookUpdate(pair, &update)
 	}
 
 	var snapshot wsSnapshot
@@ -552,10 +542,11 @@ func (e *Exchange) wsProcessOrderBookPartial(pair currency.Pair, obSnapshot *wsS
 // wsProcessOrderBookUpdate updates an orderbook entry for a given currency pair
 func (e *Exchange) wsProcessOrderBookUpdate(pair currency.Pair, wsUpdt *wsUpdate) error {
 	obUpdate := orderbook.Update{
-		Asset: asset.Spot,
-		Pair:  pair,
-		Bids:  make(orderbook.Levels, len(wsUpdt.Bids)),
-		Asks:  make(orderbook.Levels, len(wsUpdt.Asks)),
+		Asset:            asset.Spot,
+		Pair:             pair,
+		Bids:             make(orderbook.Levels, len(wsUpdt.Bids)),
+		Asks:             make(orderbook.Levels, len(wsUpdt.Asks)),
+		ExpectedChecksum: wsUpdt.Checksum,
 	}
 
 	// Calculating checksum requires incoming decimal place checks for both
@@ -589,43 +580,36 @@ func (e *Exchange) wsProcessOrderBookUpdate(pair currency.Pair, wsUpdt *wsUpdate
 		}
 	}
 	obUpdate.UpdateTime = highestLastUpdate
-
-	err := e.Websocket.Orderbook.Update(&obUpdate)
-	if err != nil {
-		return err
-	}
-
-	book, err := e.Websocket.Orderbook.GetOrderbook(pair, asset.Spot)
-	if err != nil {
-		return fmt.Errorf("cannot calculate websocket checksum: book not found for %s %s %w", pair, asset.Spot, err)
-	}
-
-	return validateCRC32(book, wsUpdt.Checksum)
+	return e.Websocket.Orderbook.Update(&obUpdate)
 }
 
-func validateCRC32(b *orderbook.Book, token uint32) error {
-	if b == nil {
-		return common.ErrNilPointer
-	}
+// generateChecksum returns the CRC32 checksum of the top 10 asks and bids, verified by the websocket orderbook buffer
+// against the checksum sent with each update
+func generateChecksum(b *orderbook.Book) uint32 {
 	var checkStr strings.Builder
 	for i := 0; i < 10 && i < len(b.Asks); i++ {
-		_, err := checkStr.WriteString(trim(b.Asks[i].StrPrice + trim(b.Asks[i].StrAmount)))
-		if err != nil {
-			return err
-		}
+		checkStr.WriteString(trim(b.Asks[i].StrPrice + trim(b.Asks[i].StrAmount)))
 	}
-
 	for i := 0; i < 10 && i < len(b.Bids); i++ {
-		_, err := checkStr.WriteString(trim(b.Bids[i].StrPrice) + trim(b.Bids[i].StrAmount))
-		if err != nil {
-			return err
-		}
+		checkStr.WriteString(trim(b.Bids[i].StrPrice) + trim(b.Bids[i].StrAmount))
 	}
+	return crc32.ChecksumIEEE([]byte(checkStr.String()))
+}
 
-	if check := crc32.ChecksumIEEE([]byte(checkStr.String())); check != token {
-		return fmt.Errorf("%s %s %w %d, expected %d", b.Pair, b.Asset, errInvalidChecksum, check, token)
+// resyncOrderbook resubscribes to orderbook channels for a pair after an update fails checksum verification, so a
+// fresh snapshot is received
+func (e *Exchange) resyncOrderbook(ctx context.Context, p currency.Pair, a asset.Item) error {
+	var errs error
+	for _, s := range e.Websocket.GetSubscriptions() {
+		if s.Channel != subscription.OrderbookChannel || s.Asset != a || !s.Pairs.Contains(p, true) {
+			continue
+		}
+		log.Debugf(log.ExchangeSys, "%s Resubscribing to invalid %s orderbook", e.Name, p)
+		if err := e.Websocket.ResubscribeToChannel(ctx, e.Websocket.Conn, s); err != nil && !errors.Is(err, subscription.ErrInStateAlready) {
+			errs = common.AppendError(errs, err)
+		}
 	}
-	return nil
+	return errs
 }
 
 // trim removes '.' and prefixed '0' from subsequent string
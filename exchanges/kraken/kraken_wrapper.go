@@ -203,7 +203,7 @@ func (e *Exchange) Setup(exch *config.Exchange) error {
 		Unsubscriber:          e.Unsubscribe,
 		GenerateSubscriptions: e.generateSubscriptions,
 		Features:              &e.Features.Supports.WebsocketCapabilities,
-		OrderbookBufferConfig: buffer.Config{SortBuffer: true},
+		OrderbookBufferConfig: buffer.Config{SortBuffer: true, Checksum: generateChecksum, Resync: e.resyncOrderbook},
 	})
 	if err != nil {
 		return err
@@ -0,0 +1,183 @@
+package mock
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	gws "github.com/gorilla/websocket"
+	"github.com/thrasher-corp/gocryptotrader/common/file"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+)
+
+var (
+	errWebsocketRecordingPathRequired = errors.New("no path to websocket recording file found")
+	errUnsupportedFrameType           = errors.New("unsupported websocket frame type")
+	errNoRecordedFrames               = errors.New("websocket recording contains no frames")
+)
+
+// WebsocketRecording defines the frames captured from a single websocket
+// connection in the order they were sent or received
+type WebsocketRecording struct {
+	URL    string           `json:"url"`
+	Frames []WebsocketFrame `json:"frames"`
+}
+
+// WebsocketFrame defines a single recorded websocket message. Text frames are
+// stored verbatim and binary frames are base64 encoded
+type WebsocketFrame struct {
+	Outbound  bool      `json:"outbound"`
+	Timestamp time.Time `json:"timestamp"`
+	Type      int       `json:"type"`
+	Data      string    `json:"data"`
+}
+
+// WebsocketRecorder captures inbound and outbound frames for a websocket
+// connection and writes them to a JSON file on Save
+type WebsocketRecorder struct {
+	m         sync.Mutex
+	path      string
+	recording WebsocketRecording
+}
+
+// NewWebsocketRecorder returns a recorder which saves the frames of the
+// connection at url to path
+func NewWebsocketRecorder(path, url string) (*WebsocketRecorder, error) {
+	if path == "" {
+		return nil, errWebsocketRecordingPathRequired
+	}
+	return &WebsocketRecorder{path: path, recording: WebsocketRecording{URL: url}}, nil
+}
+
+// RecordFrame captures a frame sent to or read from the connection
+func (r *WebsocketRecorder) RecordFrame(outbound bool, messageType int, data []byte) {
+	f := WebsocketFrame{Outbound: outbound, Timestamp: time.Now(), Type: messageType}
+	if messageType == gws.BinaryMessage {
+		f.Data = base64.StdEncoding.EncodeToString(data)
+	} else {
+		f.Data = string(data)
+	}
+	r.m.Lock()
+	r.recording.Frames = append(r.recording.Frames, f)
+	r.m.Unlock()
+}
+
+// Frames returns a copy of the frames recorded so far
+func (r *WebsocketRecorder) Frames() []WebsocketFrame {
+	r.m.Lock()
+	defer r.m.Unlock()
+	frames := make([]WebsocketFrame, len(r.recording.Frames))
+	copy(frames, r.recording.Frames)
+	return frames
+}
+
+// Save redacts credentials from JSON text frames and writes all frames recorded
+// so far to file. Recording continues after Save so it may be called on each
+// connection shutdown
+func (r *WebsocketRecorder) Save() error {
+	r.m.Lock()
+	rec := WebsocketRecording{URL: r.recording.URL, Frames: make([]WebsocketFrame, len(r.recording.Frames))}
+	copy(rec.Frames, r.recording.Frames)
+	r.m.Unlock()
+	for i := range rec.Frames {
+		if rec.Frames[i].Type != gws.TextMessage {
+			continue
+		}
+		redacted, err := redactFrame([]byte(rec.Frames[i].Data))
+		if err != nil {
+			return err
+		}
+		rec.Frames[i].Data = string(redacted)
+	}
+	data, err := json.MarshalIndent(rec, "", " ")
+	if err != nil {
+		return err
+	}
+	return file.Write(r.path, data)
+}
+
+// LoadWebsocketRecording reads a websocket recording from file
+func LoadWebsocketRecording(path string) (*WebsocketRecording, error) {
+	if path == "" {
+		return nil, errWebsocketRecordingPathRequired
+	}
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var rec WebsocketRecording
+	if err := json.Unmarshal(contents, &rec); err != nil {
+		return nil, fmt.Errorf("contents of file %s are not a valid websocket recording: %w", path, err)
+	}
+	if len(rec.Frames) == 0 {
+		return nil, fmt.Errorf("%w: %s", errNoRecordedFrames, path)
+	}
+	for i := range rec.Frames {
+		if rec.Frames[i].Type != gws.TextMessage && rec.Frames[i].Type != gws.BinaryMessage {
+			return nil, fmt.Errorf("%w %d in frame %d", errUnsupportedFrameType, rec.Frames[i].Type, i)
+		}
+	}
+	return &rec, nil
+}
+
+// payload returns the raw frame data to be written to a connection
+func (f *WebsocketFrame) payload() ([]byte, error) {
+	if f.Type == gws.BinaryMessage {
+		return base64.StdEncoding.DecodeString(f.Data)
+	}
+	return []byte(f.Data), nil
+}
+
+// websocketExcludedVariables are credential fields zeroed in recorded frames.
+// This is narrower than the HTTP exclusion list as websocket payloads commonly
+// use keys such as name for channel identifiers
+var websocketExcludedVariables = Exclusion{Variables: []string{
+	"apiKey",
+	"api_key",
+	"key",
+	"passphrase",
+	"sign",
+	"signature",
+	"token",
+	"listenKey",
+}}
+
+// redactFrame zeroes excluded variables in a JSON frame. Frames which contain
+// no excluded keys are returned unchanged so numeric precision and key order
+// are preserved
+func redactFrame(data []byte) ([]byte, error) {
+	if !containsExcludedKey(data) {
+		return data, nil
+	}
+	var intermediary any
+	if err := json.Unmarshal(data, &intermediary); err != nil {
+		return data, nil //nolint:nilerr // Non JSON frames cannot contain excluded variables
+	}
+	switch intermediary.(type) {
+	case map[string]any, []any:
+	default:
+		return data, nil
+	}
+	checked, err := CheckJSON(intermediary, &websocketExcludedVariables, 0)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(checked)
+}
+
+// containsExcludedKey reports whether any excluded variable appears as a JSON
+// key in data
+func containsExcludedKey(data []byte) bool {
+	lower := bytes.ToLower(data)
+	for _, v := range websocketExcludedVariables.Variables {
+		if bytes.Contains(lower, []byte(`"`+strings.ToLower(v)+`"`)) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,64 @@
+package mock
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	gws "github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebsocketRecorder(t *testing.T) {
+	t.Parallel()
+	_, err := NewWebsocketRecorder("", "wss://test")
+	assert.ErrorIs(t, err, errWebsocketRecordingPathRequired)
+
+	path := filepath.Join(t.TempDir(), "ws", "recording.json")
+	r, err := NewWebsocketRecorder(path, "wss://test")
+	require.NoError(t, err, "NewWebsocketRecorder must not error")
+
+	r.RecordFrame(true, gws.TextMessage, []byte(`{"op":"login","args":[{"apiKey":"secret","sign":"abc","passphrase":"p"}]}`))
+	r.RecordFrame(false, gws.TextMessage, []byte(`{"event":"login","code":"0","tradeId":123456789012345678901}`))
+	r.RecordFrame(false, gws.BinaryMessage, []byte{0x1f, 0x8b, 0x00})
+	r.RecordFrame(true, gws.TextMessage, []byte(`ping`))
+	require.Len(t, r.Frames(), 4, "Frames must return every recorded frame")
+	require.NoError(t, r.Save(), "Save must not error")
+
+	rec, err := LoadWebsocketRecording(path)
+	require.NoError(t, err, "LoadWebsocketRecording must not error")
+	assert.Equal(t, "wss://test", rec.URL, "URL should be persisted")
+	require.Len(t, rec.Frames, 4, "all frames must be persisted")
+	assert.True(t, rec.Frames[0].Outbound, "outbound frames should be flagged")
+	assert.JSONEq(t, `{"op":"login","args":[{"apiKey":"","sign":"","passphrase":""}]}`, rec.Frames[0].Data, "credentials should be redacted")
+	assert.Equal(t, `{"event":"login","code":"0","tradeId":123456789012345678901}`, rec.Frames[1].Data, "frames without credentials should be stored verbatim")
+	assert.False(t, rec.Frames[1].Timestamp.IsZero(), "frames should be timestamped")
+	binary, err := rec.Frames[2].payload()
+	require.NoError(t, err, "payload must not error")
+	assert.Equal(t, []byte{0x1f, 0x8b, 0x00}, binary, "binary frames should round trip")
+	assert.Equal(t, "ping", rec.Frames[3].Data, "non JSON text frames should be stored verbatim")
+}
+
+func TestLoadWebsocketRecording(t *testing.T) {
+	t.Parallel()
+	_, err := LoadWebsocketRecording("")
+	assert.ErrorIs(t, err, errWebsocketRecordingPathRequired)
+
+	dir := t.TempDir()
+	_, err = LoadWebsocketRecording(filepath.Join(dir, "missing.json"))
+	assert.ErrorIs(t, err, os.ErrNotExist)
+
+	path := filepath.Join(dir, "bad.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"url":"wss://test","frames":[]}`), 0o600), "WriteFile must not error")
+	_, err = LoadWebsocketRecording(path)
+	assert.ErrorIs(t, err, errNoRecordedFrames)
+
+	require.NoError(t, os.WriteFile(path, []byte(`{"url":"wss://test","frames":[{"type":9,"data":""}]}`), 0o600), "WriteFile must not error")
+	_, err = LoadWebsocketRecording(path)
+	assert.ErrorIs(t, err, errUnsupportedFrameType)
+
+	require.NoError(t, os.WriteFile(path, []byte(`{`), 0o600), "WriteFile must not error")
+	_, err = LoadWebsocketRecording(path)
+	assert.Error(t, err, "LoadWebsocketRecording should error on invalid JSON")
+}
@@ -0,0 +1,289 @@
+package mock
+
+import (
+	"bytes"
+	"net/http"
+	"reflect"
+	"slices"
+	"sync"
+
+	gws "github.com/gorilla/websocket"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+)
+
+// DefaultWebsocketIgnoredKeys are request fields which commonly change between
+// a recording and a replay, such as request IDs and nonces. They are ignored
+// when matching requests and their replayed values are substituted into the
+// recorded responses so signature based response matching still works
+var DefaultWebsocketIgnoredKeys = []string{
+	"id",
+	"reqid",
+	"req_id",
+	"request_id",
+	"cid",
+	"nonce",
+	"timestamp",
+	"ts",
+	"expires",
+}
+
+var replayUpgrader = gws.Upgrader{CheckOrigin: func(*http.Request) bool { return true }}
+
+// WebsocketReplayer is a http.Handler which upgrades connections and replays a
+// websocket recording. Inbound frames recorded before the first outbound frame
+// are sent on connect. Every subsequent outbound frame is treated as a request
+// and the inbound frames which followed it are sent when a matching request is
+// received from the client. Requests may be matched in any order
+type WebsocketReplayer struct {
+	preamble    []WebsocketFrame
+	exchanges   []replayExchange
+	ignoredKeys []string
+	m           sync.Mutex
+	unmatched   [][]byte
+}
+
+// replayExchange is a recorded request and the frames which followed it
+type replayExchange struct {
+	request   []byte
+	responses []WebsocketFrame
+}
+
+// NewWebsocketReplayer loads a recording from path and returns a replay
+// handler. DefaultWebsocketIgnoredKeys are used when no ignored keys are
+// supplied
+func NewWebsocketReplayer(path string, ignoredKeys ...string) (*WebsocketReplayer, error) {
+	rec, err := LoadWebsocketRecording(path)
+	if err != nil {
+		return nil, err
+	}
+	return NewWebsocketReplayerFromRecording(rec, ignoredKeys...)
+}
+
+// NewWebsocketReplayerFromRecording returns a replay handler for an in memory
+// recording
+func NewWebsocketReplayerFromRecording(rec *WebsocketRecording, ignoredKeys ...string) (*WebsocketReplayer, error) {
+	if rec == nil || len(rec.Frames) == 0 {
+		return nil, errNoRecordedFrames
+	}
+	if len(ignoredKeys) == 0 {
+		ignoredKeys = DefaultWebsocketIgnoredKeys
+	}
+	r := &WebsocketReplayer{ignoredKeys: slices.Clone(ignoredKeys)}
+	for i := range rec.Frames {
+		f := rec.Frames[i]
+		if f.Outbound {
+			req, err := f.payload()
+			if err != nil {
+				return nil, err
+			}
+			r.exchanges = append(r.exchanges, replayExchange{request: req})
+			continue
+		}
+		if len(r.exchanges) == 0 {
+			r.preamble = append(r.preamble, f)
+			continue
+		}
+		last := &r.exchanges[len(r.exchanges)-1]
+		last.responses = append(last.responses, f)
+	}
+	return r, nil
+}
+
+// Unmatched returns client messages which did not match any recorded request
+func (r *WebsocketReplayer) Unmatched() [][]byte {
+	r.m.Lock()
+	defer r.m.Unlock()
+	return slices.Clone(r.unmatched)
+}
+
+// ServeHTTP upgrades the connection and replays the recording until the client
+// disconnects. Each connection replays the recording from the start
+func (r *WebsocketReplayer) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	c, err := replayUpgrader.Upgrade(w, req, nil)
+	if err != nil {
+		return // Upgrade has already replied to the client with an error
+	}
+	defer c.Close()
+	if err := writeFrames(c, r.preamble, nil); err != nil {
+		return
+	}
+	consumed := make([]bool, len(r.exchanges))
+	for {
+		_, msg, err := c.ReadMessage()
+		if err != nil {
+			// Any error here is likely due to the connection closing
+			return
+		}
+		idx := r.match(msg, consumed)
+		if idx == -1 {
+			r.m.Lock()
+			r.unmatched = append(r.unmatched, msg)
+			r.m.Unlock()
+			continue
+		}
+		consumed[idx] = true
+		if err := writeFrames(c, r.exchanges[idx].responses, r.substitutions(r.exchanges[idx].request, msg)); err != nil {
+			return
+		}
+	}
+}
+
+// match returns the index of the first unconsumed recorded request matching
+// msg, or -1 if there is none
+func (r *WebsocketReplayer) match(msg []byte, consumed []bool) int {
+	received, isJSON := decodeJSONFrame(msg)
+	if isJSON {
+		received = r.normalise(received)
+	}
+	for i := range r.exchanges {
+		if consumed[i] {
+			continue
+		}
+		if bytes.Equal(r.exchanges[i].request, msg) {
+			return i
+		}
+		if !isJSON {
+			continue
+		}
+		if recorded, ok := decodeJSONFrame(r.exchanges[i].request); ok && reflect.DeepEqual(r.normalise(recorded), received) {
+			return i
+		}
+	}
+	return -1
+}
+
+// normalise removes ignored and credential keys from a decoded JSON value
+func (r *WebsocketReplayer) normalise(v any) any {
+	switch t := v.(type) {
+	case map[string]any:
+		for k, val := range t {
+			if IsExcluded(k, r.ignoredKeys) || IsExcluded(k, websocketExcludedVariables.Variables) {
+				delete(t, k)
+				continue
+			}
+			t[k] = r.normalise(val)
+		}
+	case []any:
+		for i := range t {
+			t[i] = r.normalise(t[i])
+		}
+	}
+	return v
+}
+
+// substitutions returns the ignored key values which differ between the
+// recorded and received request, keyed by field name
+func (r *WebsocketReplayer) substitutions(recorded, received []byte) map[string][2]any {
+	rec, ok := decodeJSONFrame(recorded)
+	if !ok {
+		return nil
+	}
+	recv, ok := decodeJSONFrame(received)
+	if !ok {
+		return nil
+	}
+	recVals, recvVals := make(map[string]any), make(map[string]any)
+	r.collectIgnored(rec, recVals)
+	r.collectIgnored(recv, recvVals)
+	var subs map[string][2]any
+	for k, from := range recVals {
+		to, ok := recvVals[k]
+		if !ok || reflect.DeepEqual(from, to) {
+			continue
+		}
+		if subs == nil {
+			subs = make(map[string][2]any)
+		}
+		subs[k] = [2]any{from, to}
+	}
+	return subs
+}
+
+// collectIgnored stores the first value found for each ignored key
+func (r *WebsocketReplayer) collectIgnored(v any, out map[string]any) {
+	switch t := v.(type) {
+	case map[string]any:
+		for k, val := range t {
+			if _, ok := out[k]; !ok && IsExcluded(k, r.ignoredKeys) {
+				out[k] = val
+			}
+			r.collectIgnored(val, out)
+		}
+	case []any:
+		for i := range t {
+			r.collectIgnored(t[i], out)
+		}
+	}
+}
+
+// writeFrames sends frames to the client, rewriting recorded request IDs in
+// JSON text frames to the values supplied by the client
+func writeFrames(c *gws.Conn, frames []WebsocketFrame, subs map[string][2]any) error {
+	for i := range frames {
+		data, err := frames[i].payload()
+		if err != nil {
+			return err
+		}
+		if len(subs) != 0 && frames[i].Type == gws.TextMessage {
+			data = substitute(data, subs)
+		}
+		if err := c.WriteMessage(frames[i].Type, data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// substitute replaces recorded field values with those received from the
+// client, returning data unchanged if nothing was replaced
+func substitute(data []byte, subs map[string][2]any) []byte {
+	v, ok := decodeJSONFrame(data)
+	if !ok {
+		return data
+	}
+	var replaced bool
+	var walk func(any)
+	walk = func(v any) {
+		switch t := v.(type) {
+		case map[string]any:
+			for k, val := range t {
+				if sub, ok := subs[k]; ok && reflect.DeepEqual(val, sub[0]) {
+					t[k] = sub[1]
+					replaced = true
+					continue
+				}
+				walk(val)
+			}
+		case []any:
+			for i := range t {
+				walk(t[i])
+			}
+		}
+	}
+	walk(v)
+	if !replaced {
+		return data
+	}
+	out, err := json.Marshal(v)
+	if err != nil {
+		return data
+	}
+	return out
+}
+
+// decodeJSONFrame decodes a JSON object or array, retaining numbers as
+// json.Number so large IDs keep their precision
+func decodeJSONFrame(data []byte) (any, bool) {
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+	var v any
+	if err := d.Decode(&v); err != nil {
+		return nil, false
+	}
+	switch v.(type) {
+	case map[string]any, []any:
+		return v, true
+	default:
+		return nil, false
+	}
+}
@@ -0,0 +1,86 @@
+package mock
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	gws "github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewWebsocketReplayer(t *testing.T) {
+	t.Parallel()
+	_, err := NewWebsocketReplayer("")
+	assert.ErrorIs(t, err, errWebsocketRecordingPathRequired)
+
+	_, err = NewWebsocketReplayerFromRecording(nil)
+	assert.ErrorIs(t, err, errNoRecordedFrames)
+
+	r, err := NewWebsocketReplayerFromRecording(&WebsocketRecording{Frames: []WebsocketFrame{
+		{Type: gws.TextMessage, Data: "welcome"},
+		{Outbound: true, Type: gws.TextMessage, Data: "sub"},
+		{Type: gws.TextMessage, Data: "ack"},
+		{Type: gws.TextMessage, Data: "update"},
+	}})
+	require.NoError(t, err, "NewWebsocketReplayerFromRecording must not error")
+	assert.Len(t, r.preamble, 1, "frames before the first request should be sent on connect")
+	require.Len(t, r.exchanges, 1, "each outbound frame must start a request exchange")
+	assert.Len(t, r.exchanges[0].responses, 2, "inbound frames should be grouped with the preceding request")
+	assert.Equal(t, DefaultWebsocketIgnoredKeys, r.ignoredKeys, "default ignored keys should be used")
+}
+
+func TestWebsocketReplayerServeHTTP(t *testing.T) {
+	t.Parallel()
+	r, err := NewWebsocketReplayerFromRecording(&WebsocketRecording{Frames: []WebsocketFrame{
+		{Type: gws.TextMessage, Data: `{"event":"welcome"}`},
+		{Outbound: true, Type: gws.TextMessage, Data: `{"id":1,"op":"subscribe","channel":"ticker"}`},
+		{Type: gws.TextMessage, Data: `{"id":1,"result":"ok"}`},
+		{Type: gws.BinaryMessage, Data: "AQID"},
+		{Outbound: true, Type: gws.TextMessage, Data: `{"id":2,"op":"subscribe","channel":"trades"}`},
+		{Type: gws.TextMessage, Data: `{"id":2,"result":"ok","tradeId":123456789012345678901}`},
+		{Outbound: true, Type: gws.TextMessage, Data: `{"op":"login","apiKey":""}`},
+		{Type: gws.TextMessage, Data: `{"event":"login"}`},
+	}})
+	require.NoError(t, err, "NewWebsocketReplayerFromRecording must not error")
+
+	s := httptest.NewServer(r)
+	t.Cleanup(s.Close)
+	c, resp, err := gws.DefaultDialer.DialContext(t.Context(), "ws"+strings.TrimPrefix(s.URL, "http"), nil)
+	require.NoError(t, err, "Dial must not error")
+	require.NoError(t, resp.Body.Close(), "Body.Close must not error")
+	t.Cleanup(func() { c.Close() })
+	require.NoError(t, c.SetReadDeadline(time.Now().Add(5*time.Second)), "SetReadDeadline must not error")
+
+	read := func() (int, string) {
+		t.Helper()
+		mType, msg, err := c.ReadMessage()
+		require.NoError(t, err, "ReadMessage must not error")
+		return mType, string(msg)
+	}
+
+	_, msg := read()
+	assert.Equal(t, `{"event":"welcome"}`, msg, "preamble should be sent on connect")
+
+	require.NoError(t, c.WriteMessage(gws.TextMessage, []byte(`{"op":"unknown"}`)), "WriteMessage must not error")
+	// Requests are matched out of order and request IDs are rewritten to the replayed value
+	require.NoError(t, c.WriteMessage(gws.TextMessage, []byte(`{"channel":"trades","op":"subscribe","id":77}`)), "WriteMessage must not error")
+	_, msg = read()
+	assert.Equal(t, `{"id":77,"result":"ok","tradeId":123456789012345678901}`, msg, "response should have the replayed request ID substituted")
+
+	require.NoError(t, c.WriteMessage(gws.TextMessage, []byte(`{"id":1,"op":"subscribe","channel":"ticker"}`)), "WriteMessage must not error")
+	_, msg = read()
+	assert.Equal(t, `{"id":1,"result":"ok"}`, msg, "response should be sent verbatim when request IDs match")
+	mType, msg := read()
+	assert.Equal(t, gws.BinaryMessage, mType, "binary frames should be replayed as binary")
+	assert.Equal(t, "\x01\x02\x03", msg, "binary frames should be decoded")
+
+	require.NoError(t, c.WriteMessage(gws.TextMessage, []byte(`{"op":"login","apiKey":"live-key"}`)), "WriteMessage must not error")
+	_, msg = read()
+	assert.Equal(t, `{"event":"login"}`, msg, "redacted credentials should be ignored when matching")
+
+	assert.Eventually(t, func() bool { return len(r.Unmatched()) == 1 }, time.Second, time.Millisecond*10, "unmatched requests should be recorded")
+	assert.Equal(t, `{"op":"unknown"}`, string(r.Unmatched()[0]), "Unmatched should return the client message")
+}
@@ -4143,6 +4143,17 @@ func TestWsHandleData(t *testing.T) {
 	}
 }
 
+func TestWsProcessOrderBooksChecksum(t *testing.T) {
+	t.Parallel()
+	e := new(Exchange)
+	require.NoError(t, testexch.Setup(e), "Setup must not error")
+	require.NoError(t, e.wsHandleData(t.Context(), nil, []byte(pushDataMap["Test Snapshot Orderbook"])), "wsHandleData must not error on a snapshot")
+
+	update := `{"arg":{"channel":"books","instId":"BTC-USDT"},"action":"update","data":[{"asks":[["0.07026","6","0","1"]],"bids":[],"ts":"1659792392541","checksum":1,"prevSeqId":0,"seqId":1}]}`
+	err := e.wsHandleData(t.Context(), nil, []byte(update))
+	assert.ErrorIs(t, err, orderbook.ErrChecksumMismatch, "wsHandleData should verify update checksums with the buffer checksum generator")
+}
+
 func TestPushDataDynamic(t *testing.T) {
 	t.Parallel()
 	dataMap := map[string]string{
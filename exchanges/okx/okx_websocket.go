@@ -16,6 +16,7 @@ import (
 
 	"github.com/buger/jsonparser"
 	gws "github.com/gorilla/websocket"
+	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/common/crypto"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/encoding/json"
@@ -935,19 +936,13 @@ func (e *Exchange) wsProcessOrderBooks(ctx context.Context, conn websocket.Conne
 			err = e.WsProcessUpdateOrderbook(&response.Data[i], response.Argument.InstrumentID, assets)
 		}
 		if err != nil {
-			if errors.Is(err, errInvalidChecksum) {
-				err = e.Subscribe(ctx, conn, subscription.List{
-					{
-						Channel: response.Argument.Channel,
-						Asset:   assets[0],
-						Pairs:   currency.Pairs{response.Argument.InstrumentID},
-					},
-				})
-				if err != nil {
+			if !errors.Is(err, errInvalidChecksum) {
+				return err // Update checksum failures are resynced by the websocket orderbook buffer
+			}
+			for _, a := range assets {
+				if err := e.resyncOrderbook(ctx, response.Argument.InstrumentID, a); err != nil {
 					return err
 				}
-			} else {
-				return err
 			}
 		}
 	}
@@ -1011,7 +1006,6 @@ func (e *Exchange) WsProcessUpdateOrderbook(data *WsOrderBookData, pair currency
 			Asset:            assets[i],
 			UpdateTime:       data.Timestamp.Time(),
 			LastPushed:       data.Timestamp.Time(),
-			GenerateChecksum: generateOrderbookChecksum,
 			ExpectedChecksum: uint32(data.Checksum), //nolint:gosec // Requires type casting
 			Asks:             asks,
 			Bids:             bids,
@@ -1055,6 +1049,31 @@ func generateOrderbookChecksum(orderbookData *orderbook.Book) uint32 {
 	return crc32.ChecksumIEEE([]byte(checksumStr))
 }
 
+// resyncOrderbook resubscribes to orderbook channels for a pair on the connection carrying them after a checksum
+// failure, so a fresh snapshot is received
+func (e *Exchange) resyncOrderbook(ctx context.Context, p currency.Pair, a asset.Item) error {
+	var errs error
+	for _, s := range e.Websocket.GetSubscriptions() {
+		if s.Asset != a || !s.Pairs.Contains(p, true) {
+			continue
+		}
+		switch channelName(s) {
+		case channelOrderBooks, channelOrderBooks50TBT, channelBBOTBT, channelOrderBooksTBT:
+		default:
+			continue
+		}
+		conn, err := e.Websocket.GetSubscriptionConnection(s)
+		if err != nil {
+			errs = common.AppendError(errs, err)
+			continue
+		}
+		if err := e.Websocket.ResubscribeToChannel(ctx, conn, s); err != nil && !errors.Is(err, subscription.ErrInStateAlready) {
+			errs = common.AppendError(errs, err)
+		}
+	}
+	return errs
+}
+
 // CalculateOrderbookChecksum alternates over the first 25 bid and ask entries from websocket data.
 func (e *Exchange) CalculateOrderbookChecksum(orderbookData *WsOrderBookData) (uint32, error) {
 	var checksum strings.Builder
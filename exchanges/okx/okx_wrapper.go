@@ -20,6 +20,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
 	"github.com/thrasher-corp/gocryptotrader/exchange/order/limits"
 	"github.com/thrasher-corp/gocryptotrader/exchange/websocket"
+	"github.com/thrasher-corp/gocryptotrader/exchange/websocket/buffer"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/collateral"
@@ -212,6 +213,7 @@ func (e *Exchange) Setup(exch *config.Exchange) error {
 		MaxWebsocketSubscriptionsPerConnection: 30, // see: https://www.okx.com/docs-v5/en/#overview-websocket-connection-count-limit
 		RateLimitDefinitions:                   rateLimits,
 		UseMultiConnectionManagement:           true,
+		OrderbookBufferConfig:                  buffer.Config{Checksum: generateOrderbookChecksum, Resync: e.resyncOrderbook},
 	}); err != nil {
 		return err
 	}
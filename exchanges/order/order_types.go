@@ -249,17 +249,20 @@ type Detail struct {
 	ClientOrderID        string
 	AccountID            string
 	ClientID             string
-	Type                 Type
-	Side                 Side
-	Status               Status
-	AssetType            asset.Item
-	Date                 time.Time
-	CloseTime            time.Time
-	LastUpdated          time.Time
-	Pair                 currency.Pair
-	MarginType           margin.Type
-	Trades               []TradeHistory
-	SettlementCurrency   currency.Code
+	// Account is the name of the account profile the order was placed with,
+	// empty for the default config credentials
+	Account            string
+	Type               Type
+	Side               Side
+	Status             Status
+	AssetType          asset.Item
+	Date               time.Time
+	CloseTime          time.Time
+	LastUpdated        time.Time
+	Pair               currency.Pair
+	MarginType         margin.Type
+	Trades             []TradeHistory
+	SettlementCurrency currency.Code
 }
 
 // Filter contains all properties an order can be filtered for
@@ -271,6 +274,7 @@ type Filter struct {
 	ClientOrderID   string
 	AccountID       string
 	ClientID        string
+	Account         string
 	Type            Type
 	Side            Side
 	Status          Status
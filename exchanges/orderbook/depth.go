@@ -45,6 +45,8 @@ type Depth struct {
 
 	// validationError defines current book state and why it was invalidated.
 	validationError error
+	// checksumFailures counts updates which failed checksum verification.
+	checksumFailures uint64
 
 	m sync.RWMutex
 }
@@ -134,6 +136,14 @@ func (d *Depth) IsValid() bool {
 	return d.validationError == nil
 }
 
+// ChecksumFailures returns the number of updates which have failed checksum
+// verification since the depth was created
+func (d *Depth) ChecksumFailures() uint64 {
+	d.m.RLock()
+	defer d.m.RUnlock()
+	return d.checksumFailures
+}
+
 // AssignOptions assigns the initial options for the depth instance
 func (d *Depth) AssignOptions(b *Book) {
 	d.m.Lock()
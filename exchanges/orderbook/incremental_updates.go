@@ -23,8 +23,9 @@ const (
 
 // Public error vars
 var (
-	ErrDepthNotFound = errors.New("orderbook depth not found")
-	ErrEmptyUpdate   = errors.New("update contains no bids or asks")
+	ErrDepthNotFound    = errors.New("orderbook depth not found")
+	ErrEmptyUpdate      = errors.New("update contains no bids or asks")
+	ErrChecksumMismatch = errors.New("checksum mismatch")
 )
 
 var (
@@ -32,10 +33,13 @@ var (
 	errUpdateFailed           = errors.New("orderbook update failed")
 	errDeleteFailed           = errors.New("orderbook update delete failed")
 	errRESTSnapshot           = errors.New("cannot update REST protocol loaded snapshot")
-	errChecksumMismatch       = errors.New("checksum mismatch")
 	errChecksumGeneratorUnset = errors.New("checksum generator unset")
 )
 
+// ChecksumFunc generates a checksum from a snapshot of the stored orderbook, for comparison against the checksum
+// sent by an exchange with an update
+type ChecksumFunc func(snapshot *Book) uint32
+
 // Update holds changes that are to be applied to a stored orderbook
 type Update struct {
 	UpdateID   int64
@@ -49,7 +53,7 @@ type Update struct {
 	// ExpectedChecksum defines the expected value when the books have been verified
 	ExpectedChecksum uint32
 	// GenerateChecksum is a function that will be called to generate a checksum from the stored orderbook post update
-	GenerateChecksum func(snapshot *Book) uint32
+	GenerateChecksum ChecksumFunc
 	// AllowEmpty, when true, permits loading an empty order book update to set an UpdateID without including actual data
 	AllowEmpty bool
 	// Action defines the action to be performed on the orderbook e.g. amend, delete, insert, update/insert
@@ -106,7 +110,8 @@ func (d *Depth) ProcessUpdate(u *Update) error {
 			return d.invalidate(errChecksumGeneratorUnset)
 		}
 		if checksum := u.GenerateChecksum(d.snapshot()); checksum != u.ExpectedChecksum {
-			return d.invalidate(fmt.Errorf("%s %s %s %w: expected '%d', got '%d'", d.exchange, d.pair, d.asset, errChecksumMismatch, u.ExpectedChecksum, checksum))
+			d.checksumFailures++
+			return d.invalidate(fmt.Errorf("%s %s %s %w: expected '%d', got '%d'", d.exchange, d.pair, d.asset, ErrChecksumMismatch, u.ExpectedChecksum, checksum))
 		}
 	}
 
@@ -29,6 +29,12 @@ func DeployDepth(exchange string, p currency.Pair, a asset.Item) (*Depth, error)
 	return s.DeployDepth(exchange, p, a)
 }
 
+// ChecksumFailures returns the checksum failure count of every orderbook which
+// has failed checksum verification
+func ChecksumFailures() map[key.ExchangeAssetPair]uint64 {
+	return s.ChecksumFailures()
+}
+
 // SubscribeToExchangeOrderbooks returns a pipe to an exchange feed
 func SubscribeToExchangeOrderbooks(exchange string) (dispatch.Pipe, error) {
 	s.m.RLock()
@@ -78,6 +84,23 @@ func (s *store) track(b *Book) (book, error) {
 	return ob, nil
 }
 
+// ChecksumFailures returns the checksum failure count of every stored orderbook with at least one failure
+func (s *store) ChecksumFailures() map[key.ExchangeAssetPair]uint64 {
+	s.m.RLock()
+	depths := make(map[key.ExchangeAssetPair]*Depth, len(s.orderbooks))
+	for k, ob := range s.orderbooks {
+		depths[k] = ob.Depth
+	}
+	s.m.RUnlock()
+	failures := make(map[key.ExchangeAssetPair]uint64)
+	for k, d := range depths {
+		if n := d.ChecksumFailures(); n != 0 {
+			failures[k] = n
+		}
+	}
+	return failures
+}
+
 // DeployDepth used for subsystem deployment creates a depth item in the struct then returns a ptr to that Depth item
 func (s *store) DeployDepth(exchange string, p currency.Pair, a asset.Item) (*Depth, error) {
 	if exchange == "" {
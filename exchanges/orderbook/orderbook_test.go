@@ -238,6 +238,36 @@ func TestDeployDepth(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestChecksumFailures(t *testing.T) {
+	t.Parallel()
+	pair := currency.NewPair(currency.ETH, currency.USD)
+	d, err := DeployDepth("ChecksumFailures", pair, asset.Spot)
+	require.NoError(t, err, "DeployDepth must not error")
+	k := d.Key()
+	assert.NotContains(t, ChecksumFailures(), k, "ChecksumFailures should not include books without failures")
+
+	b := &Book{
+		Exchange:          "ChecksumFailures",
+		Pair:              pair,
+		Asset:             asset.Spot,
+		Bids:              Levels{{Price: 99, Amount: 1}},
+		Asks:              Levels{{Price: 101, Amount: 1}},
+		LastUpdated:       time.Now(),
+		ValidateOrderbook: true,
+	}
+	d.AssignOptions(b)
+	require.NoError(t, d.LoadSnapshot(b), "LoadSnapshot must not error")
+	err = d.ProcessUpdate(&Update{
+		UpdateTime:       time.Now(),
+		Bids:             Levels{{Price: 99, Amount: 2}},
+		ExpectedChecksum: 1,
+		GenerateChecksum: func(*Book) uint32 { return 2 },
+	})
+	require.ErrorIs(t, err, ErrChecksumMismatch, "ProcessUpdate must error on checksum mismatch")
+	assert.False(t, d.IsValid(), "depth should be invalidated on checksum mismatch")
+	assert.Equal(t, uint64(1), ChecksumFailures()[k], "ChecksumFailures should report the failure against the pair")
+}
+
 func TestProcessOrderbook(t *testing.T) {
 	b := Book{
 		Asks:     []Level{{Price: 100, Amount: 10}},
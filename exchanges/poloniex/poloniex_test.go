@@ -2138,7 +2138,11 @@ func TestGenerateSubscriptions(t *testing.T) {
 	}{
 		{
 			gen: e.generateSubscriptions,
-			exp: []string{"candles_minute_5", "trades", "ticker", "book_lv2"},
+			exp: []string{"candles_minute_5", "trades", "ticker"},
+		},
+		{
+			gen: e.generateOrderbookSubscriptions,
+			exp: []string{"book_lv2"},
 		},
 		{
 			gen: e.generatePrivateSubscriptions,
@@ -428,7 +428,24 @@ func (e *Exchange) handleSubscription(operation string, s *subscription.Subscrip
 }
 
 func (e *Exchange) generateSubscriptions() (subscription.List, error) {
-	return e.Features.Subscriptions.ExpandTemplates(e)
+	subs, err := e.Features.Subscriptions.ExpandTemplates(e)
+	if err != nil {
+		return nil, err
+	}
+	return slices.DeleteFunc(subs, isOrderbookSubscription), nil
+}
+
+// generateOrderbookSubscriptions returns the spot orderbook subscriptions, which are carried on their own connection
+func (e *Exchange) generateOrderbookSubscriptions() (subscription.List, error) {
+	subs, err := e.Features.Subscriptions.ExpandTemplates(e)
+	if err != nil {
+		return nil, err
+	}
+	return slices.DeleteFunc(subs, func(s *subscription.Subscription) bool { return !isOrderbookSubscription(s) }), nil
+}
+
+func isOrderbookSubscription(s *subscription.Subscription) bool {
+	return s.Asset == asset.Spot && (s.Channel == subscription.OrderbookChannel || s.Channel == channelBooks)
 }
 
 func (e *Exchange) generatePrivateSubscriptions() (subscription.List, error) {
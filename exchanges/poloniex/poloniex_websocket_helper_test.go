@@ -8,6 +8,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/sharedtestvalues"
 	testexch "github.com/thrasher-corp/gocryptotrader/internal/testing/exchange"
 	mockws "github.com/thrasher-corp/gocryptotrader/internal/testing/websocket"
 )
@@ -35,10 +36,42 @@ func TestSetupWsSupportsMultiConnectionManagement(t *testing.T) {
 	assert.Empty(t, e.Features.Subscriptions, "Features.Subscriptions should be cleared by SetupWs")
 	assert.True(t, e.Websocket.IsConnected(), "Websocket manager should be connected after SetupWs")
 
-	for _, messageFilter := range []string{connSpotPublic, connSpotPrivate, connFuturesPublic, connFuturesPrivate} {
+	for _, messageFilter := range []string{connSpotPublic, connSpotOrderbook, connSpotPrivate, connFuturesPublic, connFuturesPrivate} {
 		conn, connErr := e.Websocket.GetConnection(messageFilter)
 		require.NoErrorf(t, connErr, "GetConnection must not error for message filter %s", messageFilter)
 		assert.Equalf(t, wsURL, conn.GetURL(), "Connection URL should be redirected for message filter %s", messageFilter)
 		assert.Emptyf(t, conn.Subscriptions().List(), "Connection subscriptions should remain empty for message filter %s", messageFilter)
 	}
 }
+
+func TestSetupWsRedundantOrderbookConnection(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mockws.WsMockUpgrader(t, w, r, mockws.EchoHandler)
+	}))
+	t.Cleanup(server.Close)
+
+	e := new(Exchange)
+	require.NoError(t, testexch.Setup(e), "Test instance Setup must not error")
+	e.Config.Orderbook.WebsocketRedundancy = true
+	e.Websocket = sharedtestvalues.NewTestWebsocket()
+	require.NoError(t, e.Setup(e.Config), "Setup must not error with websocket redundancy enabled")
+
+	wsURL := "ws" + strings.TrimPrefix(server.URL, "http")
+	require.NoError(t, e.Websocket.SetAllConnectionURLs(wsURL), "SetAllConnectionURLs must not error for Poloniex")
+
+	testexch.SetupWs(t, e)
+	t.Cleanup(func() {
+		if e.Websocket.IsConnected() {
+			assert.NoError(t, e.Websocket.Shutdown(), "Websocket shutdown should not error")
+		}
+	})
+
+	connections := make(map[any]int)
+	for _, h := range e.Websocket.GetConnectionHealth() {
+		connections[h.MessageFilter]++
+	}
+	assert.Equal(t, 2, connections[connSpotOrderbook], "orderbook connection should have a hot standby")
+	for _, messageFilter := range []string{connSpotPublic, connSpotPrivate, connFuturesPublic, connFuturesPrivate} {
+		assert.Equalf(t, 1, connections[messageFilter], "connection for message filter %s should not have a standby", messageFilter)
+	}
+}
@@ -57,6 +57,7 @@ var assetPairStores = map[asset.Item]currency.PairStore{
 
 const (
 	connSpotPublic     = "spot:public"
+	connSpotOrderbook  = "spot:orderbook"
 	connSpotPrivate    = "spot:private"
 	connFuturesPublic  = "futures:public"
 	connFuturesPrivate = "futures:private"
@@ -217,6 +218,22 @@ func (e *Exchange) Setup(exch *config.Exchange) error {
 	}); err != nil {
 		return err
 	}
+	// Orderbooks are carried on their own connection so a hot standby can be kept when websocket redundancy is enabled
+	if err := e.Websocket.SetupNewConnection(&websocket.ConnectionSetup{
+		ResponseCheckTimeout:  exch.WebsocketResponseCheckTimeout,
+		ResponseMaxLimit:      exch.WebsocketResponseMaxLimit,
+		URL:                   wsSpot,
+		RateLimit:             request.NewWeightedRateLimitByDuration(2 * time.Millisecond),
+		Subscriber:            e.Subscribe,
+		Unsubscriber:          e.Unsubscribe,
+		GenerateSubscriptions: e.generateOrderbookSubscriptions,
+		Handler:               e.wsHandleData,
+		Connector:             e.wsConnect,
+		MessageFilter:         connSpotOrderbook,
+		AllowRedundancy:       true,
+	}); err != nil {
+		return err
+	}
 	wsSpotPrivate, err := e.API.Endpoints.GetURL(exchange.WebsocketPrivate)
 	if err != nil {
 		return err
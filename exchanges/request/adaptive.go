@@ -0,0 +1,223 @@
+package request
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"golang.org/x/time/rate"
+)
+
+// DefaultAdaptiveThreshold is the fraction of an exchange reported budget at
+// which requests are held until the budget resets
+const DefaultAdaptiveThreshold = 0.9
+
+// RateLimitUsage is the usage of a rate limit budget as reported by an
+// exchange in its response headers
+type RateLimitUsage struct {
+	// Name identifies the budget, such as REQUEST_WEIGHT_1M
+	Name  string
+	Used  int64
+	Limit int64
+	// Interval is the length of the budget window. When ResetAt is not set the
+	// window is assumed to be aligned to the clock, as is common for per minute
+	// budgets
+	Interval time.Duration
+	ResetAt  time.Time
+	// Endpoint optionally references the rate limit definition governing this
+	// budget. When set, only requests sharing its rate limiter are held once
+	// the budget nears exhaustion and usage not yet seen by the limiter is
+	// reserved from it. Otherwise all requests are held
+	Endpoint  any
+	UpdatedAt time.Time
+}
+
+// RateLimitHeaderParser extracts rate limit usage from an exchange response.
+// The response body must not be read. Parsers should ignore headers which are
+// missing or malformed
+type RateLimitHeaderParser func(resp *http.Response, now time.Time) []RateLimitUsage
+
+// adaptiveLimiter tracks exchange reported usage and holds requests before a
+// budget is exhausted
+type adaptiveLimiter struct {
+	parser    RateLimitHeaderParser
+	threshold float64
+	m         sync.Mutex
+	usage     map[string]RateLimitUsage
+	// until holds every request for budgets without a rate limiter, held
+	// holds requests by the rate limiter governing their budget
+	until time.Time
+	held  map[*rate.Limiter]time.Time
+}
+
+// newAdaptiveLimiter returns an adaptiveLimiter using the parser and threshold
+func newAdaptiveLimiter(p RateLimitHeaderParser, threshold float64) *adaptiveLimiter {
+	return &adaptiveLimiter{
+		parser:    p,
+		threshold: threshold,
+		usage:     make(map[string]RateLimitUsage),
+		held:      make(map[*rate.Limiter]time.Time),
+	}
+}
+
+// GetRateLimitUsage returns the latest exchange reported rate limit usage,
+// sorted by name
+func (r *Requester) GetRateLimitUsage() []RateLimitUsage {
+	if r == nil || r.adaptive == nil {
+		return nil
+	}
+	r.adaptive.m.Lock()
+	defer r.adaptive.m.Unlock()
+	usage := make([]RateLimitUsage, 0, len(r.adaptive.usage))
+	for _, u := range r.adaptive.usage {
+		usage = append(usage, u)
+	}
+	slices.SortFunc(usage, func(a, b RateLimitUsage) int { return strings.Compare(a.Name, b.Name) })
+	return usage
+}
+
+// GetRateLimitThrottle returns the latest time until which any requests are
+// being held due to exchange reported usage, or the zero time if none are
+func (r *Requester) GetRateLimitThrottle() time.Time {
+	if r == nil || r.adaptive == nil {
+		return time.Time{}
+	}
+	r.adaptive.m.Lock()
+	defer r.adaptive.m.Unlock()
+	until := r.adaptive.until
+	for _, t := range r.adaptive.held {
+		if t.After(until) {
+			until = t
+		}
+	}
+	if !time.Now().Before(until) {
+		return time.Time{}
+	}
+	return until
+}
+
+// getRateLimitThrottle returns the time until which requests for an endpoint
+// are being held, or the zero time if they are not
+func (r *Requester) getRateLimitThrottle(e EndpointLimit) time.Time {
+	if r.adaptive == nil {
+		return time.Time{}
+	}
+	r.adaptive.m.Lock()
+	defer r.adaptive.m.Unlock()
+	until := r.adaptive.until
+	if l := r.limiter[e]; l != nil && l.limiter != nil {
+		if t := r.adaptive.held[l.limiter]; t.After(until) {
+			until = t
+		}
+	}
+	if !time.Now().Before(until) {
+		return time.Time{}
+	}
+	return until
+}
+
+// updateRateLimitUsage parses usage from response headers, reserving usage
+// the limiters have not accounted for and holding further requests if a
+// budget is close to being exhausted
+func (r *Requester) updateRateLimitUsage(resp *http.Response, now time.Time) {
+	if r.adaptive == nil || resp == nil {
+		return
+	}
+	usage := r.adaptive.parser(resp, now)
+	if len(usage) == 0 {
+		return
+	}
+	r.adaptive.m.Lock()
+	defer r.adaptive.m.Unlock()
+	for i := range usage {
+		u := usage[i]
+		if u.UpdatedAt.IsZero() {
+			u.UpdatedAt = now
+		}
+		r.adaptive.usage[u.Name] = u
+		if u.Limit <= 0 {
+			continue
+		}
+		reset := u.ResetAt
+		if reset.IsZero() && u.Interval > 0 {
+			reset = now.Truncate(u.Interval).Add(u.Interval)
+		}
+		var l *RateLimiterWithWeight
+		if u.Endpoint != nil {
+			l = r.limiter[u.Endpoint]
+		}
+		if l != nil && l.limiter != nil && reset.After(now) {
+			reserveExcessUsage(l, u.Limit-u.Used, reset, now)
+		}
+		if float64(u.Used) < r.adaptive.threshold*float64(u.Limit) || reset.IsZero() {
+			continue
+		}
+		if l == nil || l.limiter == nil {
+			if reset.After(r.adaptive.until) {
+				r.adaptive.until = reset
+				log.Warnf(log.RequestSys, "%s %s usage %d of %d, holding requests until %s", r.name, u.Name, u.Used, u.Limit, reset.Format(time.RFC3339))
+			}
+			continue
+		}
+		if reset.After(r.adaptive.held[l.limiter]) {
+			r.adaptive.held[l.limiter] = reset
+			log.Warnf(log.RequestSys, "%s %s usage %d of %d, holding its requests until %s", r.name, u.Name, u.Used, u.Limit, reset.Format(time.RFC3339))
+		}
+	}
+}
+
+// reserveExcessUsage reserves tokens from a limiter when it would allow more
+// requests before the budget resets than the exchange has left, such as when
+// other clients share the budget. Burst tokens are not counted so a limiter in
+// step with the exchange is not slowed. Limiters using a shared backend are
+// left to the backend
+func reserveExcessUsage(l *RateLimiterWithWeight, remaining int64, reset, now time.Time) {
+	l.m.Lock()
+	defer l.m.Unlock()
+	if l.backend != nil {
+		return
+	}
+	limit := l.limiter.Limit()
+	if limit == rate.Inf || limit <= 0 {
+		return
+	}
+	allowed := l.limiter.TokensAt(now) - float64(l.limiter.Burst()) + float64(limit)*reset.Sub(now).Seconds()
+	excess := int64(allowed) - max(remaining, 0)
+	for range excess {
+		l.limiter.ReserveN(now, 1)
+	}
+}
+
+// waitForRateLimitThrottle holds a request while an exchange reported budget
+// governing its endpoint is close to being exhausted
+func (r *Requester) waitForRateLimitThrottle(ctx context.Context, e EndpointLimit) error {
+	if atomic.LoadInt32(&r.disableRateLimiter) == 1 {
+		return nil
+	}
+	until := r.getRateLimitThrottle(e)
+	if until.IsZero() {
+		return nil
+	}
+	delay := time.Until(until)
+	if delay <= 0 {
+		return nil
+	}
+	if hasDelayNotAllowed(ctx) {
+		return ErrDelayNotAllowed
+	}
+	if dl, ok := ctx.Deadline(); ok && dl.Before(until) {
+		return fmt.Errorf("rate limit throttle of %s will exceed deadline: %w", delay, context.DeadlineExceeded)
+	}
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(delay):
+		return nil
+	}
+}
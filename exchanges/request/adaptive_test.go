@@ -0,0 +1,123 @@
+package request
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"testing"
+	"testing/synctest"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testWeightParser(resp *http.Response, _ time.Time) []RateLimitUsage {
+	used, err := strconv.ParseInt(resp.Header.Get("X-Used-Weight"), 10, 64)
+	if err != nil {
+		return nil
+	}
+	return []RateLimitUsage{{Name: "weight", Used: used, Limit: 100, Interval: time.Hour}}
+}
+
+func TestAdaptiveRateLimiting(t *testing.T) {
+	t.Parallel()
+	r, err := New("test", new(http.Client), WithRateLimitHeaderParser(testWeightParser, 0.5))
+	require.NoError(t, err, "New must not error")
+	send := func(ctx context.Context, used string) error {
+		return r.SendPayload(ctx, Unset, func() (*Item, error) {
+			return &Item{Method: http.MethodGet, Path: testURL + "/weight?used=" + used}, nil
+		}, UnauthenticatedRequest)
+	}
+
+	require.NoError(t, send(t.Context(), "10"), "SendPayload must not error")
+	usage := r.GetRateLimitUsage()
+	require.Len(t, usage, 1, "usage must be recorded from response headers")
+	assert.Equal(t, int64(10), usage[0].Used, "used weight should be recorded")
+	assert.Equal(t, int64(100), usage[0].Limit, "limit should be recorded")
+	assert.False(t, usage[0].UpdatedAt.IsZero(), "update time should be set")
+	assert.Zero(t, r.GetRateLimitThrottle(), "requests should not be held below the threshold")
+
+	require.NoError(t, send(t.Context(), "50"), "SendPayload must not error")
+	assert.False(t, r.GetRateLimitThrottle().IsZero(), "requests should be held at the threshold")
+	assert.ErrorIs(t, send(WithDelayNotAllowed(t.Context()), "0"), ErrDelayNotAllowed)
+	ctx, cancel := context.WithTimeout(t.Context(), time.Second)
+	defer cancel()
+	assert.ErrorIs(t, send(ctx, "0"), context.DeadlineExceeded)
+
+	require.NoError(t, r.DisableRateLimiter(), "DisableRateLimiter must not error")
+	assert.NoError(t, send(t.Context(), "0"), "requests should not be held with the rate limiter disabled")
+
+	assert.Nil(t, (*Requester)(nil).GetRateLimitUsage(), "nil requester should have no usage")
+	assert.Zero(t, (*Requester)(nil).GetRateLimitThrottle(), "nil requester should not be throttled")
+	r, err = New("test", new(http.Client), WithRateLimitHeaderParser(nil, 0))
+	require.NoError(t, err, "New must not error")
+	assert.Nil(t, r.adaptive, "nil parser should disable adaptive rate limiting")
+}
+
+func TestUpdateRateLimitUsage(t *testing.T) {
+	t.Parallel()
+
+	synctest.Test(t, func(t *testing.T) { //nolint:thelper,nolintlint // false positive
+		defs := NewBasicRateLimit(time.Second, 10, 1)
+		var usage []RateLimitUsage
+		r, err := New("test", new(http.Client), WithLimiter(defs), WithRateLimitHeaderParser(func(*http.Response, time.Time) []RateLimitUsage { return usage }, 2))
+		require.NoError(t, err, "New must not error")
+		assert.Equal(t, DefaultAdaptiveThreshold, r.adaptive.threshold, "invalid thresholds should use the default")
+
+		r.updateRateLimitUsage(nil, time.Now())
+		r.updateRateLimitUsage(&http.Response{}, time.Now())
+		assert.Empty(t, r.GetRateLimitUsage(), "no usage should be recorded without a parser result")
+
+		reset := time.Now().Add(3 * time.Second)
+		usage = []RateLimitUsage{
+			{Name: "b", Used: 95, Limit: 100, ResetAt: reset},
+			{Name: "a", Used: 15, Limit: 20, Interval: time.Second, Endpoint: Auth},
+			{Name: "c", Used: 100, Limit: 100},
+		}
+		r.updateRateLimitUsage(&http.Response{}, time.Now())
+		got := r.GetRateLimitUsage()
+		require.Len(t, got, 3, "all usage must be recorded")
+		assert.Equal(t, "a", got[0].Name, "usage should be sorted by name")
+		assert.Equal(t, reset, r.GetRateLimitThrottle(), "requests should be held until the budget resets")
+		assert.InDelta(t, -4.0, defs[Auth].limiter.TokensAt(time.Now()), 1e-9, "usage the limiter has not seen should be reserved from it")
+
+		start := time.Now()
+		require.NoError(t, r.waitForRateLimitThrottle(t.Context(), Unset), "waitForRateLimitThrottle must not error")
+		assert.Equal(t, 3*time.Second, time.Since(start), "should wait until the budget resets")
+		assert.Zero(t, r.GetRateLimitThrottle(), "throttle should clear once the budget resets")
+
+		time.Sleep(500 * time.Millisecond)
+		usage = []RateLimitUsage{{Name: "minute", Used: 90, Limit: 100, Interval: time.Minute}}
+		r.updateRateLimitUsage(&http.Response{}, time.Now())
+		assert.Equal(t, time.Now().Truncate(time.Minute).Add(time.Minute), r.GetRateLimitThrottle(), "clock aligned windows should reset at the next interval boundary")
+
+		cancelled, cancel := context.WithCancel(t.Context())
+		cancel()
+		assert.ErrorIs(t, r.waitForRateLimitThrottle(cancelled, Unset), context.Canceled)
+	})
+}
+
+func TestRateLimitThrottlePerBudget(t *testing.T) {
+	t.Parallel()
+
+	synctest.Test(t, func(t *testing.T) { //nolint:thelper,nolintlint // false positive
+		defs := NewBasicRateLimit(time.Second, 10, 1)
+		defs[EndpointLimit(5)] = NewRateLimitWithWeight(time.Second, 10, 1)
+		var usage []RateLimitUsage
+		r, err := New("test", new(http.Client), WithLimiter(defs), WithRateLimitHeaderParser(func(*http.Response, time.Time) []RateLimitUsage { return usage }, 0.5))
+		require.NoError(t, err, "New must not error")
+
+		usage = []RateLimitUsage{{Name: "futures", Used: 60, Limit: 100, Interval: time.Minute, Endpoint: EndpointLimit(5)}}
+		r.updateRateLimitUsage(&http.Response{}, time.Now())
+		reset := time.Now().Truncate(time.Minute).Add(time.Minute)
+		assert.Equal(t, reset, r.getRateLimitThrottle(EndpointLimit(5)), "requests for the exhausted budget should be held")
+		assert.Zero(t, r.getRateLimitThrottle(Auth), "requests for other budgets should not be held")
+		assert.Equal(t, reset, r.GetRateLimitThrottle(), "throttle should report the held budget")
+		require.NoError(t, r.waitForRateLimitThrottle(WithDelayNotAllowed(t.Context()), Auth), "requests for other budgets must not wait")
+
+		usage = []RateLimitUsage{{Name: "orders", Used: 60, Limit: 100, Interval: time.Second}}
+		r.updateRateLimitUsage(&http.Response{}, time.Now())
+		assert.Equal(t, time.Now().Truncate(time.Second).Add(time.Second), r.getRateLimitThrottle(Auth), "budgets without a limiter should hold every request")
+	})
+}
@@ -45,9 +45,11 @@ type RateLimitDefinitions map[any]*RateLimiterWithWeight
 // RateLimiterWithWeight is a rate limiter coupled with a weight which refers to the number or weighting of the request.
 // This is used to define the rate limit for a specific endpoint.
 type RateLimiterWithWeight struct {
-	limiter *rate.Limiter
-	weight  Weight
-	m       sync.Mutex
+	limiter    *rate.Limiter
+	weight     Weight
+	m          sync.Mutex
+	backend    LimiterBackend // shared backend used in place of limiter when set
+	backendKey string
 }
 
 // NewRateLimit creates a new RateLimit based of time interval and how many actions allowed and breaks it down to an
@@ -126,6 +128,10 @@ func (r *RateLimiterWithWeight) RateLimit(ctx context.Context) error {
 		return errInvalidWeight
 	}
 
+	if r.backend != nil {
+		return r.rateLimitWithBackend(ctx)
+	}
+
 	tn := time.Now()
 	reserved := make([]*rate.Reservation, 0, r.weight)
 	for range r.weight {
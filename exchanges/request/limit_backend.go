@@ -0,0 +1,107 @@
+package request
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+var (
+	errLimiterBackendNil = errors.New("rate limiter backend is nil")
+	errNamespaceEmpty    = errors.New("rate limiter namespace is empty")
+)
+
+// LimiterBackend stores rate limiter state outside of the process so that
+// several instances trading on the same account can share a single budget
+type LimiterBackend interface {
+	// Reserve reserves weight tokens for the limiter identified by key, where
+	// each token is replenished after interval and up to burst tokens may be
+	// held. It returns how long the caller must wait before sending the
+	// request. If the wait would exceed maxDelay no tokens are reserved and
+	// reserved is false
+	Reserve(ctx context.Context, key string, interval time.Duration, burst int, weight Weight, maxDelay time.Duration) (delay time.Duration, reserved bool, err error)
+}
+
+// SetBackend moves every limiter in the definitions to a shared backend. Keys
+// are derived from the namespace and endpoint so that instances using the same
+// namespace, usually the exchange name, share one budget per endpoint class.
+// Endpoints whose weighted limiters wrap the same rate limiter share one key,
+// the first of their endpoints in sorted order, so that all instances agree on
+// it
+func (r RateLimitDefinitions) SetBackend(b LimiterBackend, namespace string) error {
+	if b == nil {
+		return errLimiterBackendNil
+	}
+	if namespace == "" {
+		return errNamespaceEmpty
+	}
+	keys := make([]string, 0, len(r))
+	byKey := make(map[string]*RateLimiterWithWeight, len(r))
+	for k, l := range r {
+		if l == nil || l.limiter == nil {
+			continue
+		}
+		s := fmt.Sprintf("%s/%v", namespace, k)
+		keys = append(keys, s)
+		byKey[s] = l
+	}
+	slices.Sort(keys)
+	assigned := make(map[*rate.Limiter]string, len(keys))
+	for _, k := range keys {
+		l := byKey[k]
+		backendKey, ok := assigned[l.limiter]
+		if !ok {
+			backendKey = k
+			assigned[l.limiter] = k
+		}
+		l.m.Lock()
+		l.backend, l.backendKey = b, backendKey
+		l.m.Unlock()
+	}
+	return nil
+}
+
+// rateLimitWithBackend throttles a request using the shared backend. Calling
+// function must hold the limiter lock, which is released before waiting
+func (r *RateLimiterWithWeight) rateLimitWithBackend(ctx context.Context) error {
+	limit := r.limiter.Limit()
+	if limit == rate.Inf || limit <= 0 {
+		r.m.Unlock()
+		return nil
+	}
+	interval := time.Duration(float64(time.Second) / float64(limit))
+
+	maxDelay := time.Duration(math.MaxInt64)
+	if hasDelayNotAllowed(ctx) {
+		maxDelay = 0
+	} else if dl, ok := ctx.Deadline(); ok {
+		maxDelay = time.Until(dl)
+	}
+
+	delay, reserved, err := r.backend.Reserve(ctx, r.backendKey, interval, r.limiter.Burst(), r.weight, maxDelay)
+	r.m.Unlock()
+	if err != nil {
+		return fmt.Errorf("%s: %w", r.backendKey, err)
+	}
+	if !reserved {
+		if maxDelay == 0 {
+			return ErrDelayNotAllowed
+		}
+		return fmt.Errorf("rate limit delay of %s will exceed deadline: %w", delay, context.DeadlineExceeded)
+	}
+	if delay <= 0 {
+		return nil
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(delay):
+		return nil
+	}
+}
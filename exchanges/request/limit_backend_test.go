@@ -0,0 +1,103 @@
+package request
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"testing/synctest"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testLimiterBackend struct {
+	keys     []string
+	interval time.Duration
+	burst    int
+	weight   Weight
+	maxDelay time.Duration
+	delay    time.Duration
+	reserved bool
+	err      error
+}
+
+func (b *testLimiterBackend) Reserve(_ context.Context, key string, interval time.Duration, burst int, weight Weight, maxDelay time.Duration) (time.Duration, bool, error) {
+	b.keys = append(b.keys, key)
+	b.interval, b.burst, b.weight, b.maxDelay = interval, burst, weight, maxDelay
+	return b.delay, b.reserved, b.err
+}
+
+func TestSetBackend(t *testing.T) {
+	t.Parallel()
+	defs := NewBasicRateLimit(time.Second, 10, 1)
+	defs[EndpointLimit(5)] = NewRateLimitWithWeight(time.Second, 1, 2)
+	defs[EndpointLimit(6)] = nil
+
+	assert.ErrorIs(t, defs.SetBackend(nil, "test"), errLimiterBackendNil)
+	b := &testLimiterBackend{}
+	assert.ErrorIs(t, defs.SetBackend(b, ""), errNamespaceEmpty)
+	require.NoError(t, defs.SetBackend(b, "test"), "SetBackend must not error")
+
+	assert.Equal(t, "test/0", defs[Unset].backendKey, "shared limiters should be keyed by their first endpoint")
+	assert.Same(t, defs[Unset], defs[Auth], "limiters should remain shared between endpoints")
+	assert.Equal(t, "test/5", defs[EndpointLimit(5)].backendKey, "each limiter should be keyed by its endpoint")
+	assert.Same(t, b, defs[EndpointLimit(5)].backend, "backend should be set on every limiter")
+
+	shared := NewRateLimit(time.Second, 10)
+	defs = RateLimitDefinitions{
+		EndpointLimit(3): GetRateLimiterWithWeight(shared, 5),
+		EndpointLimit(1): GetRateLimiterWithWeight(shared, 1),
+		EndpointLimit(2): NewRateLimitWithWeight(time.Second, 10, 1),
+	}
+	require.NoError(t, defs.SetBackend(b, "test"), "SetBackend must not error")
+	assert.Equal(t, "test/1", defs[EndpointLimit(1)].backendKey, "shared rate limiters should be keyed by their first endpoint")
+	assert.Equal(t, "test/1", defs[EndpointLimit(3)].backendKey, "weights wrapping the same rate limiter should share one key")
+	assert.Equal(t, "test/2", defs[EndpointLimit(2)].backendKey, "separate rate limiters should have their own key")
+}
+
+func TestRateLimitWithBackend(t *testing.T) {
+	t.Parallel()
+
+	synctest.Test(t, func(t *testing.T) { //nolint:thelper,nolintlint // false positive
+		b := &testLimiterBackend{reserved: true, delay: 250 * time.Millisecond}
+		r := NewRateLimitWithWeight(time.Second, 10, 3)
+		require.NoError(t, RateLimitDefinitions{Unset: r}.SetBackend(b, "test"), "SetBackend must not error")
+
+		start := time.Now()
+		require.NoError(t, r.RateLimit(t.Context()), "RateLimit must not error")
+		assert.Equal(t, 250*time.Millisecond, time.Since(start), "should wait for the delay returned by the backend")
+		assert.Equal(t, []string{"test/0"}, b.keys, "backend should be called with the limiter key")
+		assert.Equal(t, 100*time.Millisecond, b.interval, "interval should be derived from the limiter rate")
+		assert.Equal(t, Weight(3), b.weight, "weight should be passed to the backend")
+		assert.Equal(t, 1, b.burst, "burst should be passed to the backend")
+		assert.Greater(t, b.maxDelay, time.Hour, "delay should be unbounded without a deadline")
+
+		b.reserved = false
+		err := r.RateLimit(WithDelayNotAllowed(t.Context()))
+		require.ErrorIs(t, err, ErrDelayNotAllowed)
+		assert.Zero(t, b.maxDelay, "no delay should be allowed")
+
+		ctx, cancel := context.WithTimeout(t.Context(), 100*time.Millisecond)
+		defer cancel()
+		err = r.RateLimit(ctx)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Equal(t, 100*time.Millisecond, b.maxDelay, "delay should be bounded by the context deadline")
+
+		b.err = errors.New("backend failure")
+		assert.ErrorIs(t, r.RateLimit(t.Context()), b.err)
+
+		b.err = nil
+		b.reserved = true
+		cancelled, cancel := context.WithCancel(t.Context())
+		cancel()
+		assert.ErrorIs(t, r.RateLimit(cancelled), context.Canceled)
+
+		calls := len(b.keys)
+		require.NoError(t, RateLimitDefinitions{Unset: RateLimitNotRequired}.SetBackend(b, "test"), "SetBackend must not error")
+		unlimited := NewRateLimitWithWeight(0, 0, 1)
+		require.NoError(t, RateLimitDefinitions{Unset: unlimited}.SetBackend(b, "test"), "SetBackend must not error")
+		require.NoError(t, unlimited.RateLimit(t.Context()), "RateLimit must not error")
+		assert.Len(t, b.keys, calls, "unlimited limiters should not use the backend")
+	})
+}
@@ -0,0 +1,133 @@
+package request
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common/file"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+const (
+	fileLimiterLockPoll  = time.Millisecond
+	fileLimiterStaleLock = 10 * time.Second
+)
+
+var errLimiterDirectoryEmpty = errors.New("rate limiter directory is empty")
+
+// FileLimiterBackend is a LimiterBackend which keeps limiter state in a
+// directory, allowing processes on the same host to share rate limits without
+// an external service. Each key is stored in its own file and access is
+// serialised with an exclusive lock file
+type FileLimiterBackend struct {
+	dir string
+}
+
+// NewFileLimiterBackend returns a FileLimiterBackend storing state in dir,
+// creating the directory if required
+func NewFileLimiterBackend(dir string) (*FileLimiterBackend, error) {
+	if dir == "" {
+		return nil, errLimiterDirectoryEmpty
+	}
+	if err := os.MkdirAll(dir, file.DefaultPermissionOctal); err != nil {
+		return nil, err
+	}
+	return &FileLimiterBackend{dir: dir}, nil
+}
+
+// Reserve implements LimiterBackend. The stored value is the time at which the
+// limiter's tokens are fully used; reserving weight tokens moves it forward by
+// weight intervals and the caller waits until it is no more than burst
+// intervals ahead
+func (f *FileLimiterBackend) Reserve(ctx context.Context, key string, interval time.Duration, burst int, weight Weight, maxDelay time.Duration) (time.Duration, bool, error) {
+	if weight == 0 {
+		return 0, false, errInvalidWeight
+	}
+	burst = max(burst, 1)
+	path := filepath.Join(f.dir, url.QueryEscape(key))
+	unlock, err := lockFile(ctx, path+".lock")
+	if err != nil {
+		return 0, false, err
+	}
+	defer unlock()
+
+	next, err := readLimiterState(path)
+	if err != nil {
+		return 0, false, err
+	}
+	now := time.Now()
+	start := now
+	if next.After(now) {
+		start = next
+	}
+	next = start.Add(time.Duration(weight) * interval)
+	delay := max(next.Sub(now)-time.Duration(burst)*interval, 0)
+	if delay > maxDelay {
+		return delay, false, nil
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, []byte(strconv.FormatInt(next.UnixNano(), 10)), file.DefaultPermissionOctal); err != nil {
+		return 0, false, err
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return 0, false, err
+	}
+	return delay, true, nil
+}
+
+// readLimiterState returns the stored time the limiter's tokens are used until,
+// or the zero time if the key has not been used
+func readLimiterState(path string) (time.Time, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, err
+	}
+	ns, err := strconv.ParseInt(string(bytes.TrimSpace(data)), 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid rate limiter state in %s: %w", path, err)
+	}
+	return time.Unix(0, ns), nil
+}
+
+// lockFile creates path exclusively, waiting for any other holder to release
+// it. Locks older than fileLimiterStaleLock are treated as abandoned by a
+// crashed process and removed
+func lockFile(ctx context.Context, path string) (func(), error) {
+	for {
+		lf, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, file.DefaultPermissionOctal)
+		if err == nil {
+			if err := lf.Close(); err != nil {
+				return nil, err
+			}
+			return func() {
+				if err := os.Remove(path); err != nil {
+					log.Errorf(log.RequestSys, "failed to release rate limiter lock %s: %v", path, err)
+				}
+			}, nil
+		}
+		if !errors.Is(err, os.ErrExist) {
+			return nil, err
+		}
+		if info, statErr := os.Stat(path); statErr == nil && time.Since(info.ModTime()) > fileLimiterStaleLock {
+			if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+				return nil, err
+			}
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(fileLimiterLockPoll):
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package request
+
+import (
+	"context"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"testing/synctest"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewFileLimiterBackend(t *testing.T) {
+	t.Parallel()
+	_, err := NewFileLimiterBackend("")
+	assert.ErrorIs(t, err, errLimiterDirectoryEmpty)
+
+	dir := filepath.Join(t.TempDir(), "limits")
+	_, err = NewFileLimiterBackend(dir)
+	require.NoError(t, err, "NewFileLimiterBackend must not error")
+	assert.DirExists(t, dir, "directory should be created")
+}
+
+func TestFileLimiterBackendReserve(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+
+	synctest.Test(t, func(t *testing.T) { //nolint:thelper,nolintlint // false positive
+		// Two backends on the same directory stand in for two processes
+		a, err := NewFileLimiterBackend(dir)
+		require.NoError(t, err, "NewFileLimiterBackend must not error")
+		b, err := NewFileLimiterBackend(dir)
+		require.NoError(t, err, "NewFileLimiterBackend must not error")
+
+		_, _, err = a.Reserve(t.Context(), "test/0", time.Second, 1, 0, time.Hour)
+		assert.ErrorIs(t, err, errInvalidWeight)
+
+		delay, reserved, err := a.Reserve(t.Context(), "test/0", time.Second, 1, 1, time.Hour)
+		require.NoError(t, err, "Reserve must not error")
+		assert.True(t, reserved, "first reservation should be taken")
+		assert.Zero(t, delay, "first reservation should not wait")
+
+		delay, reserved, err = b.Reserve(t.Context(), "test/0", time.Second, 1, 3, time.Hour)
+		require.NoError(t, err, "Reserve must not error")
+		assert.True(t, reserved, "reservation should be taken")
+		assert.Equal(t, 3*time.Second, delay, "should wait for the token used by the other backend and two more intervals")
+
+		delay, reserved, err = a.Reserve(t.Context(), "test/0", time.Second, 1, 1, 0)
+		require.NoError(t, err, "Reserve must not error")
+		assert.False(t, reserved, "reservation exceeding max delay should not be taken")
+		assert.Equal(t, 4*time.Second, delay, "delay should include all prior reservations")
+
+		delay, _, err = a.Reserve(t.Context(), "test/1", time.Second, 1, 1, 0)
+		require.NoError(t, err, "Reserve must not error")
+		assert.Zero(t, delay, "keys should have independent budgets")
+
+		time.Sleep(5 * time.Second)
+		delay, reserved, err = b.Reserve(t.Context(), "test/0", time.Second, 1, 1, 0)
+		require.NoError(t, err, "Reserve must not error")
+		assert.True(t, reserved, "reservation should be taken once tokens are replenished")
+		assert.Zero(t, delay, "rejected reservations should not consume tokens")
+	})
+
+	synctest.Test(t, func(t *testing.T) { //nolint:thelper,nolintlint // false positive
+		b, err := NewFileLimiterBackend(dir)
+		require.NoError(t, err, "NewFileLimiterBackend must not error")
+		for range 3 {
+			delay, reserved, err := b.Reserve(t.Context(), "burst/0", time.Second, 3, 1, 0)
+			require.NoError(t, err, "Reserve must not error")
+			assert.True(t, reserved, "reservations within the burst should be taken")
+			assert.Zero(t, delay, "reservations within the burst should not wait")
+		}
+		delay, reserved, err := b.Reserve(t.Context(), "burst/0", time.Second, 3, 2, time.Hour)
+		require.NoError(t, err, "Reserve must not error")
+		assert.True(t, reserved, "reservation should be taken")
+		assert.Equal(t, 2*time.Second, delay, "reservations beyond the burst should wait for replenished tokens")
+	})
+
+	path := filepath.Join(dir, url.QueryEscape("bad/0"))
+	require.NoError(t, os.WriteFile(path, []byte("nope"), 0o600), "WriteFile must not error")
+	b, err := NewFileLimiterBackend(dir)
+	require.NoError(t, err, "NewFileLimiterBackend must not error")
+	_, _, err = b.Reserve(t.Context(), "bad/0", time.Second, 1, 1, 0)
+	assert.ErrorContains(t, err, "invalid rate limiter state", "Reserve should error on corrupt state")
+}
+
+func TestFileLimiterBackendConcurrent(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	var wg sync.WaitGroup
+	delays := make(chan time.Duration, 20)
+	for range 20 {
+		wg.Go(func() {
+			b, err := NewFileLimiterBackend(dir)
+			if !assert.NoError(t, err, "NewFileLimiterBackend should not error") {
+				return
+			}
+			delay, reserved, err := b.Reserve(t.Context(), "test/0", time.Hour, 1, 1, 100*time.Hour)
+			assert.NoError(t, err, "Reserve should not error")
+			assert.True(t, reserved, "reservation should be taken")
+			delays <- delay
+		})
+	}
+	wg.Wait()
+	close(delays)
+	var hours []int
+	for d := range delays {
+		hours = append(hours, int(d.Round(time.Hour)/time.Hour))
+	}
+	assert.ElementsMatch(t, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19}, hours, "each reservation should receive a distinct slot")
+}
+
+func TestLockFile(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "test.lock")
+	unlock, err := lockFile(t.Context(), path)
+	require.NoError(t, err, "lockFile must not error")
+
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Millisecond)
+	defer cancel()
+	_, err = lockFile(ctx, path)
+	assert.ErrorIs(t, err, context.DeadlineExceeded, "lockFile should wait while the lock is held")
+
+	stale := time.Now().Add(-2 * fileLimiterStaleLock)
+	require.NoError(t, os.Chtimes(path, stale, stale), "Chtimes must not error")
+	unlockStale, err := lockFile(t.Context(), path)
+	require.NoError(t, err, "lockFile must take over a stale lock")
+	unlockStale()
+	assert.NoFileExists(t, path, "unlock should remove the lock file")
+	unlock() // Releasing an already removed lock only logs
+}
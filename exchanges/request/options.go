@@ -27,3 +27,26 @@ func WithReporter(rep Reporter) RequesterOption {
 		r.reporter = rep
 	}
 }
+
+// WithFaultInjector configures the fault injector for a Requester.
+func WithFaultInjector(f FaultInjector) RequesterOption {
+	return func(r *Requester) {
+		r.faults = f
+	}
+}
+
+// WithRateLimitHeaderParser configures a Requester to track rate limit usage
+// reported in response headers. Requests are held once usage reaches threshold
+// of a budget; thresholds outside of (0, 1] use DefaultAdaptiveThreshold.
+func WithRateLimitHeaderParser(p RateLimitHeaderParser, threshold float64) RequesterOption {
+	return func(r *Requester) {
+		if p == nil {
+			r.adaptive = nil
+			return
+		}
+		if threshold <= 0 || threshold > 1 {
+			threshold = DefaultAdaptiveThreshold
+		}
+		r.adaptive = newAdaptiveLimiter(p, threshold)
+	}
+}
@@ -1,6 +1,7 @@
 package request
 
 import (
+	"net/http"
 	"time"
 )
 
@@ -10,6 +11,24 @@ type Reporter interface {
 	Latency(name, method, path string, t time.Duration)
 }
 
+// RetryReporter is an optional Reporter extension which is notified each time
+// a request is retried
+type RetryReporter interface {
+	Retry(name, method, path string)
+}
+
+// RateLimitReporter is an optional Reporter extension which receives the time
+// spent waiting on the rate limiter before a request is sent
+type RateLimitReporter interface {
+	RateLimitWait(name, method, path string, t time.Duration)
+}
+
+// FaultInjector wraps the sending of HTTP requests so that latency, error
+// statuses and malformed responses can be injected when testing resilience
+type FaultInjector interface {
+	RoundTrip(req *http.Request, next func(*http.Request) (*http.Response, error)) (*http.Response, error)
+}
+
 // SetupGlobalReporter sets a reporter interface to be used
 // for all exchange requests
 func SetupGlobalReporter(r Reporter) {
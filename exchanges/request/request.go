@@ -148,12 +148,19 @@ func (r *Requester) doRequest(ctx context.Context, endpoint EndpointLimit, newRe
 		default:
 		}
 
+		var waited time.Duration
 		if r.limiter != nil {
 			// Initiate a rate limit reservation and sleep on requested endpoint
+			waitStart := time.Now()
 			err := r.InitiateRateLimit(ctx, endpoint)
 			if err != nil {
 				return fmt.Errorf("failed to rate limit HTTP request: %w", err)
 			}
+			waited = time.Since(waitStart)
+		}
+
+		if err := r.waitForRateLimitThrottle(ctx, endpoint); err != nil {
+			return fmt.Errorf("failed to rate limit HTTP request: %w", err)
 		}
 
 		p, err := newRequest()
@@ -161,6 +168,10 @@ func (r *Requester) doRequest(ctx context.Context, endpoint EndpointLimit, newRe
 			return err
 		}
 
+		if rep, ok := r.reporter.(RateLimitReporter); ok && r.limiter != nil {
+			rep.RateLimitWait(r.name, p.Method, p.Path, waited)
+		}
+
 		req, err := p.validateRequest(ctx, r)
 		if err != nil {
 			return err
@@ -193,15 +204,27 @@ func (r *Requester) doRequest(ctx context.Context, endpoint EndpointLimit, newRe
 
 		start := time.Now()
 
-		resp, err := r._HTTPClient.do(req)
+		var resp *http.Response
+		if r.faults != nil {
+			resp, err = r.faults.RoundTrip(req, r._HTTPClient.do)
+		} else {
+			resp, err = r._HTTPClient.do(req)
+		}
 
 		if r.reporter != nil && err == nil {
 			r.reporter.Latency(r.name, p.Method, p.Path, time.Since(start))
 		}
 
+		if err == nil {
+			r.updateRateLimitUsage(resp, time.Now())
+		}
+
 		if retry, err := r.evaluateRetry(ctx, resp, err, attempt, verbose); err != nil {
 			return err
 		} else if retry {
+			if rep, ok := r.reporter.(RetryReporter); ok {
+				rep.Retry(r.name, p.Method, p.Path)
+			}
 			continue
 		}
 
@@ -373,6 +396,16 @@ func (r *Requester) SetHTTPClientUserAgent(userAgent string) error {
 	return nil
 }
 
+// SetFaultInjector sets a fault injector for outbound requests, or removes it
+// when nil. It should be set before requests are sent
+func (r *Requester) SetFaultInjector(f FaultInjector) error {
+	if r == nil {
+		return ErrRequestSystemIsNil
+	}
+	r.faults = f
+	return nil
+}
+
 // GetHTTPClientUserAgent gets the exchanges HTTP user agent
 func (r *Requester) GetHTTPClientUserAgent() (string, error) {
 	if r == nil {
@@ -14,12 +14,14 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/chaos"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/nonce"
 )
 
@@ -97,6 +99,13 @@ func TestMain(m *testing.M) {
 	sm.HandleFunc("/nocontent", func(w http.ResponseWriter, _ *http.Request) {
 		w.WriteHeader(http.StatusNoContent)
 	})
+	sm.HandleFunc("/weight", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Used-Weight", r.URL.Query().Get("used"))
+		_, err := io.WriteString(w, `{"response":true}`)
+		if err != nil {
+			log.Fatal(err)
+		}
+	})
 
 	server := httptest.NewServer(sm)
 	testURL = server.URL
@@ -469,6 +478,81 @@ func TestDoRequest_RetryNonRecoverable(t *testing.T) {
 	require.ErrorIs(t, err, errFailedToRetryRequest)
 }
 
+type testReporter struct {
+	m          sync.Mutex
+	latencies  int
+	retries    int
+	limitWaits int
+	path       string
+}
+
+func (r *testReporter) Latency(_, _, path string, _ time.Duration) {
+	r.m.Lock()
+	defer r.m.Unlock()
+	r.latencies++
+	r.path = path
+}
+
+func (r *testReporter) Retry(string, string, string) {
+	r.m.Lock()
+	defer r.m.Unlock()
+	r.retries++
+}
+
+func (r *testReporter) RateLimitWait(string, string, string, time.Duration) {
+	r.m.Lock()
+	defer r.m.Unlock()
+	r.limitWaits++
+}
+
+func TestDoRequest_Reporter(t *testing.T) {
+	t.Parallel()
+
+	rep := &testReporter{}
+	r, err := New("test", new(http.Client),
+		WithBackoff(func(int) time.Duration { return 0 }),
+		WithLimiter(NewBasicRateLimit(time.Second, 100, 1)),
+		WithReporter(rep))
+	require.NoError(t, err, "New requester must not error")
+	err = r.SendPayload(t.Context(), Unset, func() (*Item, error) {
+		return &Item{
+			Method: http.MethodGet,
+			Path:   testURL + "/always-retry",
+		}, nil
+	}, UnauthenticatedRequest)
+	require.ErrorIs(t, err, errFailedToRetryRequest)
+	assert.Equal(t, MaxRetryAttempts+1, rep.latencies, "Latency should be reported for every attempt")
+	assert.Equal(t, MaxRetryAttempts, rep.retries, "Retry should be reported for every retried attempt")
+	assert.Equal(t, MaxRetryAttempts+1, rep.limitWaits, "RateLimitWait should be reported for every attempt")
+	assert.Equal(t, testURL+"/always-retry", rep.path, "Latency should be reported with the request path")
+}
+
+func TestDoRequest_FaultInjector(t *testing.T) {
+	t.Parallel()
+
+	f, err := chaos.New(&chaos.Config{HTTPErrorProbability: 1, HTTPErrorStatusCodes: []int{http.StatusTooManyRequests}})
+	require.NoError(t, err, "chaos.New must not error")
+	r, err := New("test", new(http.Client), WithBackoff(func(int) time.Duration { return 0 }), WithFaultInjector(f))
+	require.NoError(t, err, "New requester must not error")
+	generate := func() (*Item, error) {
+		return &Item{Method: http.MethodGet, Path: testURL, Result: new(map[string]any)}, nil
+	}
+	err = r.SendPayload(t.Context(), Unset, generate, UnauthenticatedRequest)
+	require.ErrorIs(t, err, errFailedToRetryRequest)
+	assert.Equal(t, uint64(MaxRetryAttempts+1), f.Stats().HTTPErrors, "every attempt should be faulted")
+
+	f, err = chaos.New(&chaos.Config{TruncateProbability: 1})
+	require.NoError(t, err, "chaos.New must not error")
+	require.NoError(t, r.SetFaultInjector(f), "SetFaultInjector must not error")
+	err = r.SendPayload(t.Context(), Unset, generate, UnauthenticatedRequest)
+	assert.Error(t, err, "truncated responses should fail to unmarshal")
+
+	require.NoError(t, r.SetFaultInjector(nil), "SetFaultInjector must not error")
+	assert.NoError(t, r.SendPayload(t.Context(), Unset, generate, UnauthenticatedRequest), "SendPayload should not error without a fault injector")
+
+	assert.ErrorIs(t, (*Requester)(nil).SetFaultInjector(f), ErrRequestSystemIsNil)
+}
+
 func TestDoRequest_NotRetryable(t *testing.T) {
 	t.Parallel()
 
@@ -29,6 +29,8 @@ type Requester struct {
 	_HTTPClient        *client
 	limiter            RateLimitDefinitions
 	reporter           Reporter
+	faults             FaultInjector
+	adaptive           *adaptiveLimiter
 	name               string
 	userAgent          string
 	maxRetries         int
@@ -113,6 +113,22 @@ func (s *Service) getExchangeTickers(exchange string) ([]*Price, error) {
 	return tickers, nil
 }
 
+// GetAllTickers returns every ticker stored across all exchanges
+func GetAllTickers() []*Price {
+	return service.getAllTickers()
+}
+
+func (s *Service) getAllTickers() []*Price {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	tickers := make([]*Price, 0, len(s.Tickers))
+	for _, v := range s.Tickers {
+		cpy := v.Price // Don't let external functions have access to underlying
+		tickers = append(tickers, &cpy)
+	}
+	return tickers
+}
+
 // FindLast searches for a currency pair and returns the first available
 func FindLast(p currency.Pair, a asset.Item) (float64, error) {
 	service.mu.Lock()
@@ -466,3 +466,28 @@ func TestGetExchangeTickers(t *testing.T) {
 	}
 	assert.Equal(t, 1337.0, resp[0].OpenInterest)
 }
+
+func TestGetAllTickers(t *testing.T) {
+	t.Parallel()
+	s := Service{
+		Tickers:  make(map[key.ExchangeAssetPair]*Ticker),
+		Exchange: make(map[string]uuid.UUID),
+	}
+	assert.Empty(t, s.getAllTickers(), "getAllTickers should return no tickers")
+
+	for _, exch := range []string{"test", "test2"} {
+		s.Tickers[key.NewExchangeAssetPair(exch, asset.Spot, currency.NewBTCUSD())] = &Ticker{
+			Price: Price{
+				Pair:         currency.NewBTCUSD(),
+				ExchangeName: exch,
+				AssetType:    asset.Spot,
+				Last:         1337,
+			},
+		}
+	}
+	resp := s.getAllTickers()
+	require.Len(t, resp, 2, "getAllTickers must return tickers from every exchange")
+	resp[0].Last = 0
+	assert.Equal(t, 1337.0, s.Tickers[key.NewExchangeAssetPair(resp[0].ExchangeName, asset.Spot, currency.NewBTCUSD())].Last, "getAllTickers should return copies")
+	assert.NotNil(t, GetAllTickers(), "GetAllTickers should not return nil")
+}
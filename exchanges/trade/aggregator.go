@@ -0,0 +1,250 @@
+package trade
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+)
+
+// NewCandleAggregator returns a CandleAggregator which builds candles of the
+// supplied interval for trades matching the exchange, asset and pair
+func NewCandleAggregator(exchangeName string, a asset.Item, p currency.Pair, interval kline.Interval) (*CandleAggregator, error) {
+	if exchangeName == "" {
+		return nil, common.ErrExchangeNameNotSet
+	}
+	if !a.IsValid() {
+		return nil, fmt.Errorf("%w: %v", asset.ErrNotSupported, a)
+	}
+	if p.IsEmpty() {
+		return nil, currency.ErrCurrencyPairEmpty
+	}
+	if interval <= 0 {
+		return nil, fmt.Errorf("%w: %v", kline.ErrInvalidInterval, interval)
+	}
+	return &CandleAggregator{
+		exchange: strings.ToLower(exchangeName),
+		asset:    a,
+		pair:     p,
+		interval: interval,
+	}, nil
+}
+
+// AddIndicators registers streaming indicators to be updated on every candle
+// revision. Any candles already aggregated are replayed into the indicators so
+// they are in sync with indicators registered earlier.
+func (c *CandleAggregator) AddIndicators(indicators ...kline.StreamingIndicator) error {
+	c.m.Lock()
+	defer c.m.Unlock()
+	for _, ind := range indicators {
+		if ind == nil {
+			return fmt.Errorf("%w: %T", common.ErrNilPointer, ind)
+		}
+		for i := range c.closed {
+			if err := ind.Update(&c.closed[i]); err != nil {
+				return err
+			}
+		}
+		if c.hasCurrent {
+			if err := ind.Update(&c.current); err != nil {
+				return err
+			}
+		}
+	}
+	c.indicators = append(c.indicators, indicators...)
+	return nil
+}
+
+// SetCandleHandler sets a function which is called with every candle revision.
+// The closed flag is set when a candle is finalised by a trade opening a later
+// interval.
+func (c *CandleAggregator) SetCandleHandler(fn CandleHandler) {
+	c.m.Lock()
+	c.handler = fn
+	c.m.Unlock()
+}
+
+// SetMaxCandles sets how many closed candles are retained for Candles. When
+// zero, closed candles are not retained.
+func (c *CandleAggregator) SetMaxCandles(maxCandles int) {
+	c.m.Lock()
+	defer c.m.Unlock()
+	c.maxCandles = maxCandles
+	c.trimClosed()
+}
+
+// Seed loads historic candles, such as those retrieved via REST on startup, to
+// warm up indicators before live trades are received. Candles must be newer
+// than any candle already processed. The last seeded candle remains open so
+// trades received within its interval are merged into it.
+func (c *CandleAggregator) Seed(candles ...kline.Candle) error {
+	c.m.Lock()
+	defer c.m.Unlock()
+	for i := range candles {
+		candleTime := candles[i].Time.Truncate(c.interval.Duration())
+		if c.hasCurrent {
+			if !candleTime.After(c.current.Time) {
+				return fmt.Errorf("%w: %v is not after %v", kline.ErrCandleOutOfOrder, candleTime, c.current.Time)
+			}
+			if err := c.closeCurrent(); err != nil {
+				return err
+			}
+		}
+		c.current = candles[i]
+		c.current.Time = candleTime
+		c.openTime, c.closeTime = candleTime, candleTime
+		c.hasCurrent = true
+		if err := c.publish(false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Process folds trades into candles and updates registered indicators. Trades
+// for other exchanges, assets or pairs are ignored, as are trades which belong
+// to an interval that has already closed.
+func (c *CandleAggregator) Process(trades ...Data) error {
+	c.m.Lock()
+	defer c.m.Unlock()
+	var errs error
+	for i := range trades {
+		if !c.matches(&trades[i]) {
+			continue
+		}
+		price, amount := trades[i].Price, trades[i].Amount
+		if price < 0 {
+			price *= -1
+		}
+		if amount < 0 {
+			amount *= -1
+		}
+		if price == 0 || trades[i].Timestamp.IsZero() {
+			continue
+		}
+		candleTime := trades[i].Timestamp.Truncate(c.interval.Duration())
+		switch {
+		case !c.hasCurrent:
+			c.open(candleTime, &trades[i], price, amount)
+		case candleTime.Before(c.current.Time):
+			c.dropped++
+			continue
+		case candleTime.After(c.current.Time):
+			if err := c.closeCurrent(); err != nil {
+				errs = common.AppendError(errs, err)
+			}
+			c.open(candleTime, &trades[i], price, amount)
+		default:
+			c.merge(&trades[i], price, amount)
+		}
+		if err := c.publish(false); err != nil {
+			errs = common.AppendError(errs, err)
+		}
+	}
+	return errs
+}
+
+// Latest returns the candle currently being formed
+func (c *CandleAggregator) Latest() (kline.Candle, bool) {
+	c.m.Lock()
+	defer c.m.Unlock()
+	return c.current, c.hasCurrent
+}
+
+// Candles returns the retained closed candles followed by the candle currently
+// being formed
+func (c *CandleAggregator) Candles() *kline.Item {
+	c.m.Lock()
+	defer c.m.Unlock()
+	item := &kline.Item{
+		Exchange: c.exchange,
+		Pair:     c.pair,
+		Asset:    c.asset,
+		Interval: c.interval,
+		Candles:  slices.Clone(c.closed),
+	}
+	if c.hasCurrent {
+		item.Candles = append(item.Candles, c.current)
+	}
+	return item
+}
+
+// Dropped returns the amount of trades ignored because their interval had
+// already closed
+func (c *CandleAggregator) Dropped() int64 {
+	c.m.Lock()
+	defer c.m.Unlock()
+	return c.dropped
+}
+
+func (c *CandleAggregator) matches(d *Data) bool {
+	return strings.EqualFold(d.Exchange, c.exchange) &&
+		d.AssetType == c.asset &&
+		d.CurrencyPair.Equal(c.pair)
+}
+
+func (c *CandleAggregator) open(candleTime time.Time, d *Data, price, amount float64) {
+	c.current = kline.Candle{
+		Time:   candleTime,
+		Open:   price,
+		High:   price,
+		Low:    price,
+		Close:  price,
+		Volume: amount,
+	}
+	c.openTime, c.closeTime = d.Timestamp, d.Timestamp
+	c.hasCurrent = true
+}
+
+// merge applies a trade within the current interval. Trades may arrive out of
+// order within a batch so the open and close are set by trade time rather than
+// arrival.
+func (c *CandleAggregator) merge(d *Data, price, amount float64) {
+	if d.Timestamp.Before(c.openTime) {
+		c.current.Open = price
+		c.openTime = d.Timestamp
+	}
+	if !d.Timestamp.Before(c.closeTime) {
+		c.current.Close = price
+		c.closeTime = d.Timestamp
+	}
+	c.current.High = max(c.current.High, price)
+	c.current.Low = min(c.current.Low, price)
+	c.current.Volume += amount
+}
+
+func (c *CandleAggregator) closeCurrent() error {
+	if c.maxCandles > 0 {
+		c.closed = append(c.closed, c.current)
+		c.trimClosed()
+	}
+	return c.publish(true)
+}
+
+func (c *CandleAggregator) trimClosed() {
+	if len(c.closed) > c.maxCandles {
+		c.closed = slices.Delete(c.closed, 0, len(c.closed)-c.maxCandles)
+	}
+}
+
+func (c *CandleAggregator) publish(closed bool) error {
+	if c.handler != nil {
+		c.handler(c.current, closed)
+	}
+	if closed {
+		// Indicators have already processed the final revision of the candle
+		return nil
+	}
+	var errs error
+	for _, ind := range c.indicators {
+		if err := ind.Update(&c.current); err != nil {
+			errs = common.AppendError(errs, err)
+		}
+	}
+	return errs
+}
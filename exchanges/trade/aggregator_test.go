@@ -0,0 +1,136 @@
+package trade
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+)
+
+func TestNewCandleAggregator(t *testing.T) {
+	t.Parallel()
+	_, err := NewCandleAggregator("", asset.Spot, currency.NewBTCUSD(), kline.OneMin)
+	assert.ErrorIs(t, err, common.ErrExchangeNameNotSet)
+	_, err = NewCandleAggregator("test", asset.Empty, currency.NewBTCUSD(), kline.OneMin)
+	assert.ErrorIs(t, err, asset.ErrNotSupported)
+	_, err = NewCandleAggregator("test", asset.Spot, currency.EMPTYPAIR, kline.OneMin)
+	assert.ErrorIs(t, err, currency.ErrCurrencyPairEmpty)
+	_, err = NewCandleAggregator("test", asset.Spot, currency.NewBTCUSD(), 0)
+	assert.ErrorIs(t, err, kline.ErrInvalidInterval)
+	c, err := NewCandleAggregator("Test", asset.Spot, currency.NewBTCUSD(), kline.OneMin)
+	require.NoError(t, err, "NewCandleAggregator must not error")
+	assert.Equal(t, "test", c.exchange, "exchange name should be lower case")
+}
+
+func TestCandleAggregatorProcess(t *testing.T) {
+	t.Parallel()
+	c, err := NewCandleAggregator("test", asset.Spot, currency.NewBTCUSD(), kline.OneMin)
+	require.NoError(t, err, "NewCandleAggregator must not error")
+	c.SetMaxCandles(10)
+
+	sma, err := kline.NewStreamingSMA(2)
+	require.NoError(t, err, "NewStreamingSMA must not error")
+	require.NoError(t, c.AddIndicators(sma), "AddIndicators must not error")
+	assert.ErrorIs(t, c.AddIndicators(nil), common.ErrNilPointer)
+
+	var revisions, closed int
+	c.SetCandleHandler(func(_ kline.Candle, isClosed bool) {
+		if isClosed {
+			closed++
+			return
+		}
+		revisions++
+	})
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	trade := func(offset time.Duration, price, amount float64) Data {
+		return Data{
+			Exchange:     "test",
+			CurrencyPair: currency.NewBTCUSD(),
+			AssetType:    asset.Spot,
+			Price:        price,
+			Amount:       amount,
+			Timestamp:    start.Add(offset),
+		}
+	}
+
+	_, ok := c.Latest()
+	assert.False(t, ok, "Latest should not return a candle before any trades")
+
+	err = c.Process(
+		trade(time.Second*10, 100, 1),
+		trade(time.Second*5, 99, 1), // out of order within the interval sets the open
+		trade(time.Second*30, 105, -2),
+		trade(time.Second*50, 102, 1),
+		Data{Exchange: "other", CurrencyPair: currency.NewBTCUSD(), AssetType: asset.Spot, Price: 1, Amount: 1, Timestamp: start},
+	)
+	require.NoError(t, err, "Process must not error")
+
+	latest, ok := c.Latest()
+	require.True(t, ok, "Latest must return a candle")
+	assert.Equal(t, kline.Candle{Time: start, Open: 99, High: 105, Low: 99, Close: 102, Volume: 5}, latest, "candle should be aggregated from trades")
+	assert.False(t, sma.Ready(), "indicator should not be ready after one candle")
+	assert.Equal(t, 4, revisions, "handler should receive a revision per trade")
+
+	require.NoError(t, c.Process(trade(time.Minute+time.Second, 110, 1)), "Process must not error")
+	assert.Equal(t, 1, closed, "handler should receive the closed candle")
+	assert.True(t, sma.Ready(), "indicator should be ready after two candles")
+	assert.Equal(t, 106.0, sma.Value(), "indicator should use the latest candle revision")
+
+	require.NoError(t, c.Process(trade(time.Minute+time.Second*2, 112, 1)), "Process must not error")
+	assert.Equal(t, 107.0, sma.Value(), "indicator should amend the forming candle")
+
+	require.NoError(t, c.Process(trade(time.Second*59, 1, 1)), "Process must not error")
+	assert.Equal(t, int64(1), c.Dropped(), "trades for closed intervals should be dropped")
+
+	item := c.Candles()
+	require.Len(t, item.Candles, 2, "Candles must return the closed and forming candles")
+	assert.Equal(t, start.Add(time.Minute), item.Candles[1].Time, "forming candle should be last")
+	assert.Equal(t, kline.OneMin, item.Interval, "interval should be set")
+
+	late, err := kline.NewStreamingSMA(2)
+	require.NoError(t, err, "NewStreamingSMA must not error")
+	require.NoError(t, c.AddIndicators(late), "AddIndicators must not error")
+	assert.Equal(t, sma.Value(), late.Value(), "late indicators should be replayed the retained candles")
+
+	c.SetMaxCandles(0)
+	assert.Len(t, c.Candles().Candles, 1, "Candles should only return the forming candle")
+}
+
+func TestCandleAggregatorSeed(t *testing.T) {
+	t.Parallel()
+	c, err := NewCandleAggregator("test", asset.Spot, currency.NewBTCUSD(), kline.OneMin)
+	require.NoError(t, err, "NewCandleAggregator must not error")
+	ema, err := kline.NewStreamingEMA(2)
+	require.NoError(t, err, "NewStreamingEMA must not error")
+	require.NoError(t, c.AddIndicators(ema), "AddIndicators must not error")
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	err = c.Seed(
+		kline.Candle{Time: start, Open: 1, High: 2, Low: 1, Close: 2, Volume: 1},
+		kline.Candle{Time: start.Add(time.Minute), Open: 2, High: 4, Low: 2, Close: 4, Volume: 1},
+	)
+	require.NoError(t, err, "Seed must not error")
+	assert.Equal(t, 3.0, ema.Value(), "indicator should be warmed up by seeded candles")
+
+	err = c.Seed(kline.Candle{Time: start})
+	assert.ErrorIs(t, err, kline.ErrCandleOutOfOrder)
+
+	err = c.Process(Data{
+		Exchange:     "test",
+		CurrencyPair: currency.NewBTCUSD(),
+		AssetType:    asset.Spot,
+		Price:        6,
+		Amount:       1,
+		Timestamp:    start.Add(time.Minute + time.Second),
+	})
+	require.NoError(t, err, "Process must not error")
+	latest, _ := c.Latest()
+	assert.Equal(t, kline.Candle{Time: start.Add(time.Minute), Open: 2, High: 6, Low: 2, Close: 6, Volume: 2}, latest, "trade should merge into the seeded candle")
+	assert.Equal(t, 4.0, ema.Value(), "indicator should amend the seeded candle")
+}
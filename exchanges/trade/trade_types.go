@@ -9,6 +9,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchange/stream"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 )
 
@@ -54,6 +55,28 @@ type Processor struct {
 	buffer                  []Data
 }
 
+// CandleHandler receives each candle revision produced by a CandleAggregator
+type CandleHandler func(c kline.Candle, closed bool)
+
+// CandleAggregator builds rolling candles from a stream of trades and keeps
+// streaming indicators up to date with each revision of the forming candle
+type CandleAggregator struct {
+	m          sync.Mutex
+	exchange   string
+	asset      asset.Item
+	pair       currency.Pair
+	interval   kline.Interval
+	current    kline.Candle
+	hasCurrent bool
+	openTime   time.Time
+	closeTime  time.Time
+	closed     []kline.Candle
+	maxCandles int
+	dropped    int64
+	indicators []kline.StreamingIndicator
+	handler    CandleHandler
+}
+
 // ByDate sorts trades by date ascending
 type ByDate []Data
 
@@ -1720,11 +1720,12 @@ func (x *GetOrderbooksResponse) GetOrderbooks() []*Orderbooks {
 }
 
 type GetAccountBalancesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	AssetType     string                 `protobuf:"bytes,2,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Exchange          string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	AssetType         string                 `protobuf:"bytes,2,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	ValuationCurrency string                 `protobuf:"bytes,3,opt,name=valuation_currency,json=valuationCurrency,proto3" json:"valuation_currency,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *GetAccountBalancesRequest) Reset() {
@@ -1771,6 +1772,13 @@ func (x *GetAccountBalancesRequest) GetAssetType() string {
 	return ""
 }
 
+func (x *GetAccountBalancesRequest) GetValuationCurrency() string {
+	if x != nil {
+		return x.ValuationCurrency
+	}
+	return ""
+}
+
 type Account struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -1832,6 +1840,8 @@ type AccountCurrencyInfo struct {
 	FreeWithoutBorrow float64                `protobuf:"fixed64,5,opt,name=free_without_borrow,json=freeWithoutBorrow,proto3" json:"free_without_borrow,omitempty"`
 	Borrowed          float64                `protobuf:"fixed64,6,opt,name=borrowed,proto3" json:"borrowed,omitempty"`
 	UpdatedAt         *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Value             float64                `protobuf:"fixed64,8,opt,name=value,proto3" json:"value,omitempty"`
+	ConversionPath    []*ConversionHop       `protobuf:"bytes,9,rep,name=conversion_path,json=conversionPath,proto3" json:"conversion_path,omitempty"`
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
@@ -1915,17 +1925,133 @@ func (x *AccountCurrencyInfo) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
-type GetAccountBalancesResponse struct {
+func (x *AccountCurrencyInfo) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *AccountCurrencyInfo) GetConversionPath() []*ConversionHop {
+	if x != nil {
+		return x.ConversionPath
+	}
+	return nil
+}
+
+type ConversionHop struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Accounts      []*Account             `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Rate          float64                `protobuf:"fixed64,3,opt,name=rate,proto3" json:"rate,omitempty"`
+	Source        string                 `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
+	Pair          string                 `protobuf:"bytes,5,opt,name=pair,proto3" json:"pair,omitempty"`
+	Asset         string                 `protobuf:"bytes,6,opt,name=asset,proto3" json:"asset,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Staleness     string                 `protobuf:"bytes,8,opt,name=staleness,proto3" json:"staleness,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *ConversionHop) Reset() {
+	*x = ConversionHop{}
+	mi := &file_rpc_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConversionHop) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConversionHop) ProtoMessage() {}
+
+func (x *ConversionHop) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConversionHop.ProtoReflect.Descriptor instead.
+func (*ConversionHop) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ConversionHop) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *ConversionHop) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *ConversionHop) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *ConversionHop) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ConversionHop) GetPair() string {
+	if x != nil {
+		return x.Pair
+	}
+	return ""
+}
+
+func (x *ConversionHop) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *ConversionHop) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *ConversionHop) GetStaleness() string {
+	if x != nil {
+		return x.Staleness
+	}
+	return ""
+}
+
+type GetAccountBalancesResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Exchange          string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Accounts          []*Account             `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	ValuationCurrency string                 `protobuf:"bytes,3,opt,name=valuation_currency,json=valuationCurrency,proto3" json:"valuation_currency,omitempty"`
+	TotalValue        float64                `protobuf:"fixed64,4,opt,name=total_value,json=totalValue,proto3" json:"total_value,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
 func (x *GetAccountBalancesResponse) Reset() {
 	*x = GetAccountBalancesResponse{}
-	mi := &file_rpc_proto_msgTypes[35]
+	mi := &file_rpc_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1937,7 +2063,7 @@ func (x *GetAccountBalancesResponse) String() string {
 func (*GetAccountBalancesResponse) ProtoMessage() {}
 
 func (x *GetAccountBalancesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[35]
+	mi := &file_rpc_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1950,7 +2076,7 @@ func (x *GetAccountBalancesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAccountBalancesResponse.ProtoReflect.Descriptor instead.
 func (*GetAccountBalancesResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{35}
+	return file_rpc_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *GetAccountBalancesResponse) GetExchange() string {
@@ -1967,6 +2093,20 @@ func (x *GetAccountBalancesResponse) GetAccounts() []*Account {
 	return nil
 }
 
+func (x *GetAccountBalancesResponse) GetValuationCurrency() string {
+	if x != nil {
+		return x.ValuationCurrency
+	}
+	return ""
+}
+
+func (x *GetAccountBalancesResponse) GetTotalValue() float64 {
+	if x != nil {
+		return x.TotalValue
+	}
+	return 0
+}
+
 type GetConfigRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -1975,7 +2115,7 @@ type GetConfigRequest struct {
 
 func (x *GetConfigRequest) Reset() {
 	*x = GetConfigRequest{}
-	mi := &file_rpc_proto_msgTypes[36]
+	mi := &file_rpc_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1987,7 +2127,7 @@ func (x *GetConfigRequest) String() string {
 func (*GetConfigRequest) ProtoMessage() {}
 
 func (x *GetConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[36]
+	mi := &file_rpc_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2000,7 +2140,7 @@ func (x *GetConfigRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetConfigRequest.ProtoReflect.Descriptor instead.
 func (*GetConfigRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{36}
+	return file_rpc_proto_rawDescGZIP(), []int{37}
 }
 
 type GetConfigResponse struct {
@@ -2012,7 +2152,7 @@ type GetConfigResponse struct {
 
 func (x *GetConfigResponse) Reset() {
 	*x = GetConfigResponse{}
-	mi := &file_rpc_proto_msgTypes[37]
+	mi := &file_rpc_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2024,7 +2164,7 @@ func (x *GetConfigResponse) String() string {
 func (*GetConfigResponse) ProtoMessage() {}
 
 func (x *GetConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[37]
+	mi := &file_rpc_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2037,7 +2177,7 @@ func (x *GetConfigResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetConfigResponse.ProtoReflect.Descriptor instead.
 func (*GetConfigResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{37}
+	return file_rpc_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *GetConfigResponse) GetData() []byte {
@@ -2059,7 +2199,7 @@ type PortfolioAddress struct {
 
 func (x *PortfolioAddress) Reset() {
 	*x = PortfolioAddress{}
-	mi := &file_rpc_proto_msgTypes[38]
+	mi := &file_rpc_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2071,7 +2211,7 @@ func (x *PortfolioAddress) String() string {
 func (*PortfolioAddress) ProtoMessage() {}
 
 func (x *PortfolioAddress) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[38]
+	mi := &file_rpc_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2084,7 +2224,7 @@ func (x *PortfolioAddress) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PortfolioAddress.ProtoReflect.Descriptor instead.
 func (*PortfolioAddress) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{38}
+	return file_rpc_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *PortfolioAddress) GetAddress() string {
@@ -2123,7 +2263,7 @@ type GetPortfolioRequest struct {
 
 func (x *GetPortfolioRequest) Reset() {
 	*x = GetPortfolioRequest{}
-	mi := &file_rpc_proto_msgTypes[39]
+	mi := &file_rpc_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2135,7 +2275,7 @@ func (x *GetPortfolioRequest) String() string {
 func (*GetPortfolioRequest) ProtoMessage() {}
 
 func (x *GetPortfolioRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[39]
+	mi := &file_rpc_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2148,7 +2288,7 @@ func (x *GetPortfolioRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPortfolioRequest.ProtoReflect.Descriptor instead.
 func (*GetPortfolioRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{39}
+	return file_rpc_proto_rawDescGZIP(), []int{40}
 }
 
 type GetPortfolioResponse struct {
@@ -2160,7 +2300,7 @@ type GetPortfolioResponse struct {
 
 func (x *GetPortfolioResponse) Reset() {
 	*x = GetPortfolioResponse{}
-	mi := &file_rpc_proto_msgTypes[40]
+	mi := &file_rpc_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2172,7 +2312,7 @@ func (x *GetPortfolioResponse) String() string {
 func (*GetPortfolioResponse) ProtoMessage() {}
 
 func (x *GetPortfolioResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[40]
+	mi := &file_rpc_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2185,7 +2325,7 @@ func (x *GetPortfolioResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPortfolioResponse.ProtoReflect.Descriptor instead.
 func (*GetPortfolioResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{40}
+	return file_rpc_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *GetPortfolioResponse) GetPortfolio() []*PortfolioAddress {
@@ -2196,14 +2336,15 @@ func (x *GetPortfolioResponse) GetPortfolio() []*PortfolioAddress {
 }
 
 type GetPortfolioSummaryRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ValuationCurrency string                 `protobuf:"bytes,1,opt,name=valuation_currency,json=valuationCurrency,proto3" json:"valuation_currency,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *GetPortfolioSummaryRequest) Reset() {
 	*x = GetPortfolioSummaryRequest{}
-	mi := &file_rpc_proto_msgTypes[41]
+	mi := &file_rpc_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2215,7 +2356,7 @@ func (x *GetPortfolioSummaryRequest) String() string {
 func (*GetPortfolioSummaryRequest) ProtoMessage() {}
 
 func (x *GetPortfolioSummaryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[41]
+	mi := &file_rpc_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2228,22 +2369,31 @@ func (x *GetPortfolioSummaryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPortfolioSummaryRequest.ProtoReflect.Descriptor instead.
 func (*GetPortfolioSummaryRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{41}
+	return file_rpc_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetPortfolioSummaryRequest) GetValuationCurrency() string {
+	if x != nil {
+		return x.ValuationCurrency
+	}
+	return ""
 }
 
 type Coin struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Coin          string                 `protobuf:"bytes,1,opt,name=coin,proto3" json:"coin,omitempty"`
-	Balance       float64                `protobuf:"fixed64,2,opt,name=balance,proto3" json:"balance,omitempty"`
-	Address       string                 `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
-	Percentage    float64                `protobuf:"fixed64,4,opt,name=percentage,proto3" json:"percentage,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Coin           string                 `protobuf:"bytes,1,opt,name=coin,proto3" json:"coin,omitempty"`
+	Balance        float64                `protobuf:"fixed64,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	Address        string                 `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Percentage     float64                `protobuf:"fixed64,4,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	Value          float64                `protobuf:"fixed64,5,opt,name=value,proto3" json:"value,omitempty"`
+	ConversionPath []*ConversionHop       `protobuf:"bytes,6,rep,name=conversion_path,json=conversionPath,proto3" json:"conversion_path,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *Coin) Reset() {
 	*x = Coin{}
-	mi := &file_rpc_proto_msgTypes[42]
+	mi := &file_rpc_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2255,7 +2405,7 @@ func (x *Coin) String() string {
 func (*Coin) ProtoMessage() {}
 
 func (x *Coin) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[42]
+	mi := &file_rpc_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2268,7 +2418,7 @@ func (x *Coin) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Coin.ProtoReflect.Descriptor instead.
 func (*Coin) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{42}
+	return file_rpc_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *Coin) GetCoin() string {
@@ -2299,6 +2449,20 @@ func (x *Coin) GetPercentage() float64 {
 	return 0
 }
 
+func (x *Coin) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *Coin) GetConversionPath() []*ConversionHop {
+	if x != nil {
+		return x.ConversionPath
+	}
+	return nil
+}
+
 type OfflineCoinSummary struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
@@ -2310,7 +2474,7 @@ type OfflineCoinSummary struct {
 
 func (x *OfflineCoinSummary) Reset() {
 	*x = OfflineCoinSummary{}
-	mi := &file_rpc_proto_msgTypes[43]
+	mi := &file_rpc_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2322,7 +2486,7 @@ func (x *OfflineCoinSummary) String() string {
 func (*OfflineCoinSummary) ProtoMessage() {}
 
 func (x *OfflineCoinSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[43]
+	mi := &file_rpc_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2335,7 +2499,7 @@ func (x *OfflineCoinSummary) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OfflineCoinSummary.ProtoReflect.Descriptor instead.
 func (*OfflineCoinSummary) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{43}
+	return file_rpc_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *OfflineCoinSummary) GetAddress() string {
@@ -2369,7 +2533,7 @@ type OnlineCoinSummary struct {
 
 func (x *OnlineCoinSummary) Reset() {
 	*x = OnlineCoinSummary{}
-	mi := &file_rpc_proto_msgTypes[44]
+	mi := &file_rpc_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2381,7 +2545,7 @@ func (x *OnlineCoinSummary) String() string {
 func (*OnlineCoinSummary) ProtoMessage() {}
 
 func (x *OnlineCoinSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[44]
+	mi := &file_rpc_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2394,7 +2558,7 @@ func (x *OnlineCoinSummary) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OnlineCoinSummary.ProtoReflect.Descriptor instead.
 func (*OnlineCoinSummary) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{44}
+	return file_rpc_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *OnlineCoinSummary) GetBalance() float64 {
@@ -2420,7 +2584,7 @@ type OfflineCoins struct {
 
 func (x *OfflineCoins) Reset() {
 	*x = OfflineCoins{}
-	mi := &file_rpc_proto_msgTypes[45]
+	mi := &file_rpc_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2432,7 +2596,7 @@ func (x *OfflineCoins) String() string {
 func (*OfflineCoins) ProtoMessage() {}
 
 func (x *OfflineCoins) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[45]
+	mi := &file_rpc_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2445,7 +2609,7 @@ func (x *OfflineCoins) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OfflineCoins.ProtoReflect.Descriptor instead.
 func (*OfflineCoins) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{45}
+	return file_rpc_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *OfflineCoins) GetAddresses() []*OfflineCoinSummary {
@@ -2464,7 +2628,7 @@ type OnlineCoins struct {
 
 func (x *OnlineCoins) Reset() {
 	*x = OnlineCoins{}
-	mi := &file_rpc_proto_msgTypes[46]
+	mi := &file_rpc_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2476,7 +2640,7 @@ func (x *OnlineCoins) String() string {
 func (*OnlineCoins) ProtoMessage() {}
 
 func (x *OnlineCoins) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[46]
+	mi := &file_rpc_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2489,7 +2653,7 @@ func (x *OnlineCoins) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OnlineCoins.ProtoReflect.Descriptor instead.
 func (*OnlineCoins) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{46}
+	return file_rpc_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *OnlineCoins) GetCoins() map[string]*OnlineCoinSummary {
@@ -2506,13 +2670,15 @@ type GetPortfolioSummaryResponse struct {
 	CoinsOfflineSummary map[string]*OfflineCoins `protobuf:"bytes,3,rep,name=coins_offline_summary,json=coinsOfflineSummary,proto3" json:"coins_offline_summary,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	CoinsOnline         []*Coin                  `protobuf:"bytes,4,rep,name=coins_online,json=coinsOnline,proto3" json:"coins_online,omitempty"`
 	CoinsOnlineSummary  map[string]*OnlineCoins  `protobuf:"bytes,5,rep,name=coins_online_summary,json=coinsOnlineSummary,proto3" json:"coins_online_summary,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ValuationCurrency   string                   `protobuf:"bytes,6,opt,name=valuation_currency,json=valuationCurrency,proto3" json:"valuation_currency,omitempty"`
+	TotalValue          float64                  `protobuf:"fixed64,7,opt,name=total_value,json=totalValue,proto3" json:"total_value,omitempty"`
 	unknownFields       protoimpl.UnknownFields
 	sizeCache           protoimpl.SizeCache
 }
 
 func (x *GetPortfolioSummaryResponse) Reset() {
 	*x = GetPortfolioSummaryResponse{}
-	mi := &file_rpc_proto_msgTypes[47]
+	mi := &file_rpc_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2524,7 +2690,7 @@ func (x *GetPortfolioSummaryResponse) String() string {
 func (*GetPortfolioSummaryResponse) ProtoMessage() {}
 
 func (x *GetPortfolioSummaryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[47]
+	mi := &file_rpc_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2537,7 +2703,7 @@ func (x *GetPortfolioSummaryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPortfolioSummaryResponse.ProtoReflect.Descriptor instead.
 func (*GetPortfolioSummaryResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{47}
+	return file_rpc_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *GetPortfolioSummaryResponse) GetCoinTotals() []*Coin {
@@ -2575,6 +2741,20 @@ func (x *GetPortfolioSummaryResponse) GetCoinsOnlineSummary() map[string]*Online
 	return nil
 }
 
+func (x *GetPortfolioSummaryResponse) GetValuationCurrency() string {
+	if x != nil {
+		return x.ValuationCurrency
+	}
+	return ""
+}
+
+func (x *GetPortfolioSummaryResponse) GetTotalValue() float64 {
+	if x != nil {
+		return x.TotalValue
+	}
+	return 0
+}
+
 type AddPortfolioAddressRequest struct {
 	state              protoimpl.MessageState `protogen:"open.v1"`
 	Address            string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
@@ -2583,13 +2763,14 @@ type AddPortfolioAddressRequest struct {
 	Balance            float64                `protobuf:"fixed64,4,opt,name=balance,proto3" json:"balance,omitempty"`
 	SupportedExchanges string                 `protobuf:"bytes,5,opt,name=supported_exchanges,json=supportedExchanges,proto3" json:"supported_exchanges,omitempty"`
 	ColdStorage        bool                   `protobuf:"varint,6,opt,name=cold_storage,json=coldStorage,proto3" json:"cold_storage,omitempty"`
+	Chain              string                 `protobuf:"bytes,7,opt,name=chain,proto3" json:"chain,omitempty"`
 	unknownFields      protoimpl.UnknownFields
 	sizeCache          protoimpl.SizeCache
 }
 
 func (x *AddPortfolioAddressRequest) Reset() {
 	*x = AddPortfolioAddressRequest{}
-	mi := &file_rpc_proto_msgTypes[48]
+	mi := &file_rpc_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2601,7 +2782,7 @@ func (x *AddPortfolioAddressRequest) String() string {
 func (*AddPortfolioAddressRequest) ProtoMessage() {}
 
 func (x *AddPortfolioAddressRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[48]
+	mi := &file_rpc_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2614,7 +2795,7 @@ func (x *AddPortfolioAddressRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddPortfolioAddressRequest.ProtoReflect.Descriptor instead.
 func (*AddPortfolioAddressRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{48}
+	return file_rpc_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *AddPortfolioAddressRequest) GetAddress() string {
@@ -2659,6 +2840,13 @@ func (x *AddPortfolioAddressRequest) GetColdStorage() bool {
 	return false
 }
 
+func (x *AddPortfolioAddressRequest) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
 type RemovePortfolioAddressRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
@@ -2670,7 +2858,7 @@ type RemovePortfolioAddressRequest struct {
 
 func (x *RemovePortfolioAddressRequest) Reset() {
 	*x = RemovePortfolioAddressRequest{}
-	mi := &file_rpc_proto_msgTypes[49]
+	mi := &file_rpc_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2682,7 +2870,7 @@ func (x *RemovePortfolioAddressRequest) String() string {
 func (*RemovePortfolioAddressRequest) ProtoMessage() {}
 
 func (x *RemovePortfolioAddressRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[49]
+	mi := &file_rpc_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2695,7 +2883,7 @@ func (x *RemovePortfolioAddressRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemovePortfolioAddressRequest.ProtoReflect.Descriptor instead.
 func (*RemovePortfolioAddressRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{49}
+	return file_rpc_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *RemovePortfolioAddressRequest) GetAddress() string {
@@ -2727,7 +2915,7 @@ type GetForexProvidersRequest struct {
 
 func (x *GetForexProvidersRequest) Reset() {
 	*x = GetForexProvidersRequest{}
-	mi := &file_rpc_proto_msgTypes[50]
+	mi := &file_rpc_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2739,7 +2927,7 @@ func (x *GetForexProvidersRequest) String() string {
 func (*GetForexProvidersRequest) ProtoMessage() {}
 
 func (x *GetForexProvidersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[50]
+	mi := &file_rpc_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2752,7 +2940,7 @@ func (x *GetForexProvidersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetForexProvidersRequest.ProtoReflect.Descriptor instead.
 func (*GetForexProvidersRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{50}
+	return file_rpc_proto_rawDescGZIP(), []int{51}
 }
 
 type ForexProvider struct {
@@ -2770,7 +2958,7 @@ type ForexProvider struct {
 
 func (x *ForexProvider) Reset() {
 	*x = ForexProvider{}
-	mi := &file_rpc_proto_msgTypes[51]
+	mi := &file_rpc_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2782,7 +2970,7 @@ func (x *ForexProvider) String() string {
 func (*ForexProvider) ProtoMessage() {}
 
 func (x *ForexProvider) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[51]
+	mi := &file_rpc_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2795,7 +2983,7 @@ func (x *ForexProvider) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ForexProvider.ProtoReflect.Descriptor instead.
 func (*ForexProvider) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{51}
+	return file_rpc_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *ForexProvider) GetName() string {
@@ -2856,7 +3044,7 @@ type GetForexProvidersResponse struct {
 
 func (x *GetForexProvidersResponse) Reset() {
 	*x = GetForexProvidersResponse{}
-	mi := &file_rpc_proto_msgTypes[52]
+	mi := &file_rpc_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2868,7 +3056,7 @@ func (x *GetForexProvidersResponse) String() string {
 func (*GetForexProvidersResponse) ProtoMessage() {}
 
 func (x *GetForexProvidersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[52]
+	mi := &file_rpc_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2881,7 +3069,7 @@ func (x *GetForexProvidersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetForexProvidersResponse.ProtoReflect.Descriptor instead.
 func (*GetForexProvidersResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{52}
+	return file_rpc_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *GetForexProvidersResponse) GetForexProviders() []*ForexProvider {
@@ -2899,7 +3087,7 @@ type GetForexRatesRequest struct {
 
 func (x *GetForexRatesRequest) Reset() {
 	*x = GetForexRatesRequest{}
-	mi := &file_rpc_proto_msgTypes[53]
+	mi := &file_rpc_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2911,7 +3099,7 @@ func (x *GetForexRatesRequest) String() string {
 func (*GetForexRatesRequest) ProtoMessage() {}
 
 func (x *GetForexRatesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[53]
+	mi := &file_rpc_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2924,7 +3112,7 @@ func (x *GetForexRatesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetForexRatesRequest.ProtoReflect.Descriptor instead.
 func (*GetForexRatesRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{53}
+	return file_rpc_proto_rawDescGZIP(), []int{54}
 }
 
 type ForexRatesConversion struct {
@@ -2939,7 +3127,7 @@ type ForexRatesConversion struct {
 
 func (x *ForexRatesConversion) Reset() {
 	*x = ForexRatesConversion{}
-	mi := &file_rpc_proto_msgTypes[54]
+	mi := &file_rpc_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2951,7 +3139,7 @@ func (x *ForexRatesConversion) String() string {
 func (*ForexRatesConversion) ProtoMessage() {}
 
 func (x *ForexRatesConversion) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[54]
+	mi := &file_rpc_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2964,7 +3152,7 @@ func (x *ForexRatesConversion) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ForexRatesConversion.ProtoReflect.Descriptor instead.
 func (*ForexRatesConversion) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{54}
+	return file_rpc_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *ForexRatesConversion) GetFrom() string {
@@ -3004,7 +3192,7 @@ type GetForexRatesResponse struct {
 
 func (x *GetForexRatesResponse) Reset() {
 	*x = GetForexRatesResponse{}
-	mi := &file_rpc_proto_msgTypes[55]
+	mi := &file_rpc_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3016,7 +3204,7 @@ func (x *GetForexRatesResponse) String() string {
 func (*GetForexRatesResponse) ProtoMessage() {}
 
 func (x *GetForexRatesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[55]
+	mi := &file_rpc_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3029,7 +3217,7 @@ func (x *GetForexRatesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetForexRatesResponse.ProtoReflect.Descriptor instead.
 func (*GetForexRatesResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{55}
+	return file_rpc_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *GetForexRatesResponse) GetForexRates() []*ForexRatesConversion {
@@ -3059,13 +3247,14 @@ type OrderDetails struct {
 	Cost           float64                `protobuf:"fixed64,16,opt,name=cost,proto3" json:"cost,omitempty"`
 	Trades         []*TradeHistory        `protobuf:"bytes,17,rep,name=trades,proto3" json:"trades,omitempty"`
 	ContractAmount float64                `protobuf:"fixed64,18,opt,name=contract_amount,json=contractAmount,proto3" json:"contract_amount,omitempty"`
+	Account        string                 `protobuf:"bytes,19,opt,name=account,proto3" json:"account,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
 func (x *OrderDetails) Reset() {
 	*x = OrderDetails{}
-	mi := &file_rpc_proto_msgTypes[56]
+	mi := &file_rpc_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3077,7 +3266,7 @@ func (x *OrderDetails) String() string {
 func (*OrderDetails) ProtoMessage() {}
 
 func (x *OrderDetails) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[56]
+	mi := &file_rpc_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3090,7 +3279,7 @@ func (x *OrderDetails) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OrderDetails.ProtoReflect.Descriptor instead.
 func (*OrderDetails) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{56}
+	return file_rpc_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *OrderDetails) GetExchange() string {
@@ -3219,6 +3408,13 @@ func (x *OrderDetails) GetContractAmount() float64 {
 	return 0
 }
 
+func (x *OrderDetails) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
 type TradeHistory struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CreationTime  int64                  `protobuf:"varint,1,opt,name=creation_time,json=creationTime,proto3" json:"creation_time,omitempty"`
@@ -3236,7 +3432,7 @@ type TradeHistory struct {
 
 func (x *TradeHistory) Reset() {
 	*x = TradeHistory{}
-	mi := &file_rpc_proto_msgTypes[57]
+	mi := &file_rpc_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3248,7 +3444,7 @@ func (x *TradeHistory) String() string {
 func (*TradeHistory) ProtoMessage() {}
 
 func (x *TradeHistory) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[57]
+	mi := &file_rpc_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3261,7 +3457,7 @@ func (x *TradeHistory) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TradeHistory.ProtoReflect.Descriptor instead.
 func (*TradeHistory) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{57}
+	return file_rpc_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *TradeHistory) GetCreationTime() int64 {
@@ -3340,7 +3536,7 @@ type GetOrdersRequest struct {
 
 func (x *GetOrdersRequest) Reset() {
 	*x = GetOrdersRequest{}
-	mi := &file_rpc_proto_msgTypes[58]
+	mi := &file_rpc_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3352,7 +3548,7 @@ func (x *GetOrdersRequest) String() string {
 func (*GetOrdersRequest) ProtoMessage() {}
 
 func (x *GetOrdersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[58]
+	mi := &file_rpc_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3365,7 +3561,7 @@ func (x *GetOrdersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetOrdersRequest.ProtoReflect.Descriptor instead.
 func (*GetOrdersRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{58}
+	return file_rpc_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *GetOrdersRequest) GetExchange() string {
@@ -3412,7 +3608,7 @@ type GetOrdersResponse struct {
 
 func (x *GetOrdersResponse) Reset() {
 	*x = GetOrdersResponse{}
-	mi := &file_rpc_proto_msgTypes[59]
+	mi := &file_rpc_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3424,7 +3620,7 @@ func (x *GetOrdersResponse) String() string {
 func (*GetOrdersResponse) ProtoMessage() {}
 
 func (x *GetOrdersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[59]
+	mi := &file_rpc_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3437,7 +3633,7 @@ func (x *GetOrdersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetOrdersResponse.ProtoReflect.Descriptor instead.
 func (*GetOrdersResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{59}
+	return file_rpc_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *GetOrdersResponse) GetOrders() []*OrderDetails {
@@ -3459,7 +3655,7 @@ type GetOrderRequest struct {
 
 func (x *GetOrderRequest) Reset() {
 	*x = GetOrderRequest{}
-	mi := &file_rpc_proto_msgTypes[60]
+	mi := &file_rpc_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3471,7 +3667,7 @@ func (x *GetOrderRequest) String() string {
 func (*GetOrderRequest) ProtoMessage() {}
 
 func (x *GetOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[60]
+	mi := &file_rpc_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3484,7 +3680,7 @@ func (x *GetOrderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetOrderRequest.ProtoReflect.Descriptor instead.
 func (*GetOrderRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{60}
+	return file_rpc_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *GetOrderRequest) GetExchange() string {
@@ -3532,7 +3728,7 @@ type SubmitOrderRequest struct {
 
 func (x *SubmitOrderRequest) Reset() {
 	*x = SubmitOrderRequest{}
-	mi := &file_rpc_proto_msgTypes[61]
+	mi := &file_rpc_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3544,7 +3740,7 @@ func (x *SubmitOrderRequest) String() string {
 func (*SubmitOrderRequest) ProtoMessage() {}
 
 func (x *SubmitOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[61]
+	mi := &file_rpc_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3557,7 +3753,7 @@ func (x *SubmitOrderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubmitOrderRequest.ProtoReflect.Descriptor instead.
 func (*SubmitOrderRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{61}
+	return file_rpc_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *SubmitOrderRequest) GetExchange() string {
@@ -3635,7 +3831,7 @@ type Trades struct {
 
 func (x *Trades) Reset() {
 	*x = Trades{}
-	mi := &file_rpc_proto_msgTypes[62]
+	mi := &file_rpc_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3647,7 +3843,7 @@ func (x *Trades) String() string {
 func (*Trades) ProtoMessage() {}
 
 func (x *Trades) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[62]
+	mi := &file_rpc_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3660,7 +3856,7 @@ func (x *Trades) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Trades.ProtoReflect.Descriptor instead.
 func (*Trades) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{62}
+	return file_rpc_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *Trades) GetAmount() float64 {
@@ -3702,7 +3898,7 @@ type SubmitOrderResponse struct {
 
 func (x *SubmitOrderResponse) Reset() {
 	*x = SubmitOrderResponse{}
-	mi := &file_rpc_proto_msgTypes[63]
+	mi := &file_rpc_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3714,7 +3910,7 @@ func (x *SubmitOrderResponse) String() string {
 func (*SubmitOrderResponse) ProtoMessage() {}
 
 func (x *SubmitOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[63]
+	mi := &file_rpc_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3727,7 +3923,7 @@ func (x *SubmitOrderResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubmitOrderResponse.ProtoReflect.Descriptor instead.
 func (*SubmitOrderResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{63}
+	return file_rpc_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *SubmitOrderResponse) GetOrderPlaced() bool {
@@ -3764,7 +3960,7 @@ type SimulateOrderRequest struct {
 
 func (x *SimulateOrderRequest) Reset() {
 	*x = SimulateOrderRequest{}
-	mi := &file_rpc_proto_msgTypes[64]
+	mi := &file_rpc_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3776,7 +3972,7 @@ func (x *SimulateOrderRequest) String() string {
 func (*SimulateOrderRequest) ProtoMessage() {}
 
 func (x *SimulateOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[64]
+	mi := &file_rpc_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3789,7 +3985,7 @@ func (x *SimulateOrderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SimulateOrderRequest.ProtoReflect.Descriptor instead.
 func (*SimulateOrderRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{64}
+	return file_rpc_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *SimulateOrderRequest) GetExchange() string {
@@ -3841,7 +4037,7 @@ type SimulateOrderResponse struct {
 
 func (x *SimulateOrderResponse) Reset() {
 	*x = SimulateOrderResponse{}
-	mi := &file_rpc_proto_msgTypes[65]
+	mi := &file_rpc_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3853,7 +4049,7 @@ func (x *SimulateOrderResponse) String() string {
 func (*SimulateOrderResponse) ProtoMessage() {}
 
 func (x *SimulateOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[65]
+	mi := &file_rpc_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3866,7 +4062,7 @@ func (x *SimulateOrderResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SimulateOrderResponse.ProtoReflect.Descriptor instead.
 func (*SimulateOrderResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{65}
+	return file_rpc_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *SimulateOrderResponse) GetOrders() []*OrderbookItem {
@@ -3924,7 +4120,7 @@ type WhaleBombRequest struct {
 
 func (x *WhaleBombRequest) Reset() {
 	*x = WhaleBombRequest{}
-	mi := &file_rpc_proto_msgTypes[66]
+	mi := &file_rpc_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3936,7 +4132,7 @@ func (x *WhaleBombRequest) String() string {
 func (*WhaleBombRequest) ProtoMessage() {}
 
 func (x *WhaleBombRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[66]
+	mi := &file_rpc_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3949,7 +4145,7 @@ func (x *WhaleBombRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WhaleBombRequest.ProtoReflect.Descriptor instead.
 func (*WhaleBombRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{66}
+	return file_rpc_proto_rawDescGZIP(), []int{67}
 }
 
 func (x *WhaleBombRequest) GetExchange() string {
@@ -4001,7 +4197,7 @@ type CancelOrderRequest struct {
 
 func (x *CancelOrderRequest) Reset() {
 	*x = CancelOrderRequest{}
-	mi := &file_rpc_proto_msgTypes[67]
+	mi := &file_rpc_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4013,7 +4209,7 @@ func (x *CancelOrderRequest) String() string {
 func (*CancelOrderRequest) ProtoMessage() {}
 
 func (x *CancelOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[67]
+	mi := &file_rpc_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4026,7 +4222,7 @@ func (x *CancelOrderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelOrderRequest.ProtoReflect.Descriptor instead.
 func (*CancelOrderRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{67}
+	return file_rpc_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *CancelOrderRequest) GetExchange() string {
@@ -4085,7 +4281,7 @@ type CancelBatchOrdersRequest struct {
 
 func (x *CancelBatchOrdersRequest) Reset() {
 	*x = CancelBatchOrdersRequest{}
-	mi := &file_rpc_proto_msgTypes[68]
+	mi := &file_rpc_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4097,7 +4293,7 @@ func (x *CancelBatchOrdersRequest) String() string {
 func (*CancelBatchOrdersRequest) ProtoMessage() {}
 
 func (x *CancelBatchOrdersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[68]
+	mi := &file_rpc_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4110,7 +4306,7 @@ func (x *CancelBatchOrdersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelBatchOrdersRequest.ProtoReflect.Descriptor instead.
 func (*CancelBatchOrdersRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{68}
+	return file_rpc_proto_rawDescGZIP(), []int{69}
 }
 
 func (x *CancelBatchOrdersRequest) GetExchange() string {
@@ -4165,7 +4361,7 @@ type Orders struct {
 
 func (x *Orders) Reset() {
 	*x = Orders{}
-	mi := &file_rpc_proto_msgTypes[69]
+	mi := &file_rpc_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4177,7 +4373,7 @@ func (x *Orders) String() string {
 func (*Orders) ProtoMessage() {}
 
 func (x *Orders) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[69]
+	mi := &file_rpc_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4190,7 +4386,7 @@ func (x *Orders) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Orders.ProtoReflect.Descriptor instead.
 func (*Orders) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{69}
+	return file_rpc_proto_rawDescGZIP(), []int{70}
 }
 
 func (x *Orders) GetExchange() string {
@@ -4216,7 +4412,7 @@ type CancelBatchOrdersResponse struct {
 
 func (x *CancelBatchOrdersResponse) Reset() {
 	*x = CancelBatchOrdersResponse{}
-	mi := &file_rpc_proto_msgTypes[70]
+	mi := &file_rpc_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4228,7 +4424,7 @@ func (x *CancelBatchOrdersResponse) String() string {
 func (*CancelBatchOrdersResponse) ProtoMessage() {}
 
 func (x *CancelBatchOrdersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[70]
+	mi := &file_rpc_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4241,7 +4437,7 @@ func (x *CancelBatchOrdersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelBatchOrdersResponse.ProtoReflect.Descriptor instead.
 func (*CancelBatchOrdersResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{70}
+	return file_rpc_proto_rawDescGZIP(), []int{71}
 }
 
 func (x *CancelBatchOrdersResponse) GetOrders() []*Orders {
@@ -4260,7 +4456,7 @@ type CancelAllOrdersRequest struct {
 
 func (x *CancelAllOrdersRequest) Reset() {
 	*x = CancelAllOrdersRequest{}
-	mi := &file_rpc_proto_msgTypes[71]
+	mi := &file_rpc_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4272,7 +4468,7 @@ func (x *CancelAllOrdersRequest) String() string {
 func (*CancelAllOrdersRequest) ProtoMessage() {}
 
 func (x *CancelAllOrdersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[71]
+	mi := &file_rpc_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4285,7 +4481,7 @@ func (x *CancelAllOrdersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelAllOrdersRequest.ProtoReflect.Descriptor instead.
 func (*CancelAllOrdersRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{71}
+	return file_rpc_proto_rawDescGZIP(), []int{72}
 }
 
 func (x *CancelAllOrdersRequest) GetExchange() string {
@@ -4305,7 +4501,7 @@ type CancelAllOrdersResponse struct {
 
 func (x *CancelAllOrdersResponse) Reset() {
 	*x = CancelAllOrdersResponse{}
-	mi := &file_rpc_proto_msgTypes[72]
+	mi := &file_rpc_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4317,7 +4513,7 @@ func (x *CancelAllOrdersResponse) String() string {
 func (*CancelAllOrdersResponse) ProtoMessage() {}
 
 func (x *CancelAllOrdersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[72]
+	mi := &file_rpc_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4330,7 +4526,7 @@ func (x *CancelAllOrdersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelAllOrdersResponse.ProtoReflect.Descriptor instead.
 func (*CancelAllOrdersResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{72}
+	return file_rpc_proto_rawDescGZIP(), []int{73}
 }
 
 func (x *CancelAllOrdersResponse) GetOrders() []*Orders {
@@ -4355,7 +4551,7 @@ type GetEventsRequest struct {
 
 func (x *GetEventsRequest) Reset() {
 	*x = GetEventsRequest{}
-	mi := &file_rpc_proto_msgTypes[73]
+	mi := &file_rpc_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4367,7 +4563,7 @@ func (x *GetEventsRequest) String() string {
 func (*GetEventsRequest) ProtoMessage() {}
 
 func (x *GetEventsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[73]
+	mi := &file_rpc_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4380,7 +4576,7 @@ func (x *GetEventsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEventsRequest.ProtoReflect.Descriptor instead.
 func (*GetEventsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{73}
+	return file_rpc_proto_rawDescGZIP(), []int{74}
 }
 
 type ConditionParams struct {
@@ -4396,7 +4592,7 @@ type ConditionParams struct {
 
 func (x *ConditionParams) Reset() {
 	*x = ConditionParams{}
-	mi := &file_rpc_proto_msgTypes[74]
+	mi := &file_rpc_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4408,7 +4604,7 @@ func (x *ConditionParams) String() string {
 func (*ConditionParams) ProtoMessage() {}
 
 func (x *ConditionParams) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[74]
+	mi := &file_rpc_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4421,7 +4617,7 @@ func (x *ConditionParams) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConditionParams.ProtoReflect.Descriptor instead.
 func (*ConditionParams) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{74}
+	return file_rpc_proto_rawDescGZIP(), []int{75}
 }
 
 func (x *ConditionParams) GetCondition() string {
@@ -4474,7 +4670,7 @@ type GetEventsResponse struct {
 
 func (x *GetEventsResponse) Reset() {
 	*x = GetEventsResponse{}
-	mi := &file_rpc_proto_msgTypes[75]
+	mi := &file_rpc_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4486,7 +4682,7 @@ func (x *GetEventsResponse) String() string {
 func (*GetEventsResponse) ProtoMessage() {}
 
 func (x *GetEventsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[75]
+	mi := &file_rpc_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4499,7 +4695,7 @@ func (x *GetEventsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEventsResponse.ProtoReflect.Descriptor instead.
 func (*GetEventsResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{75}
+	return file_rpc_proto_rawDescGZIP(), []int{76}
 }
 
 func (x *GetEventsResponse) GetId() int64 {
@@ -4565,7 +4761,7 @@ type AddEventRequest struct {
 
 func (x *AddEventRequest) Reset() {
 	*x = AddEventRequest{}
-	mi := &file_rpc_proto_msgTypes[76]
+	mi := &file_rpc_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4577,7 +4773,7 @@ func (x *AddEventRequest) String() string {
 func (*AddEventRequest) ProtoMessage() {}
 
 func (x *AddEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[76]
+	mi := &file_rpc_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4590,7 +4786,7 @@ func (x *AddEventRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddEventRequest.ProtoReflect.Descriptor instead.
 func (*AddEventRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{76}
+	return file_rpc_proto_rawDescGZIP(), []int{77}
 }
 
 func (x *AddEventRequest) GetExchange() string {
@@ -4644,7 +4840,7 @@ type AddEventResponse struct {
 
 func (x *AddEventResponse) Reset() {
 	*x = AddEventResponse{}
-	mi := &file_rpc_proto_msgTypes[77]
+	mi := &file_rpc_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4656,7 +4852,7 @@ func (x *AddEventResponse) String() string {
 func (*AddEventResponse) ProtoMessage() {}
 
 func (x *AddEventResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[77]
+	mi := &file_rpc_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4669,7 +4865,7 @@ func (x *AddEventResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddEventResponse.ProtoReflect.Descriptor instead.
 func (*AddEventResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{77}
+	return file_rpc_proto_rawDescGZIP(), []int{78}
 }
 
 func (x *AddEventResponse) GetId() int64 {
@@ -4688,7 +4884,7 @@ type RemoveEventRequest struct {
 
 func (x *RemoveEventRequest) Reset() {
 	*x = RemoveEventRequest{}
-	mi := &file_rpc_proto_msgTypes[78]
+	mi := &file_rpc_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4700,7 +4896,7 @@ func (x *RemoveEventRequest) String() string {
 func (*RemoveEventRequest) ProtoMessage() {}
 
 func (x *RemoveEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[78]
+	mi := &file_rpc_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4713,7 +4909,7 @@ func (x *RemoveEventRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveEventRequest.ProtoReflect.Descriptor instead.
 func (*RemoveEventRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{78}
+	return file_rpc_proto_rawDescGZIP(), []int{79}
 }
 
 func (x *RemoveEventRequest) GetId() int64 {
@@ -4732,7 +4928,7 @@ type GetCryptocurrencyDepositAddressesRequest struct {
 
 func (x *GetCryptocurrencyDepositAddressesRequest) Reset() {
 	*x = GetCryptocurrencyDepositAddressesRequest{}
-	mi := &file_rpc_proto_msgTypes[79]
+	mi := &file_rpc_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4744,7 +4940,7 @@ func (x *GetCryptocurrencyDepositAddressesRequest) String() string {
 func (*GetCryptocurrencyDepositAddressesRequest) ProtoMessage() {}
 
 func (x *GetCryptocurrencyDepositAddressesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[79]
+	mi := &file_rpc_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4757,7 +4953,7 @@ func (x *GetCryptocurrencyDepositAddressesRequest) ProtoReflect() protoreflect.M
 
 // Deprecated: Use GetCryptocurrencyDepositAddressesRequest.ProtoReflect.Descriptor instead.
 func (*GetCryptocurrencyDepositAddressesRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{79}
+	return file_rpc_proto_rawDescGZIP(), []int{80}
 }
 
 func (x *GetCryptocurrencyDepositAddressesRequest) GetExchange() string {
@@ -4778,7 +4974,7 @@ type DepositAddress struct {
 
 func (x *DepositAddress) Reset() {
 	*x = DepositAddress{}
-	mi := &file_rpc_proto_msgTypes[80]
+	mi := &file_rpc_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4790,7 +4986,7 @@ func (x *DepositAddress) String() string {
 func (*DepositAddress) ProtoMessage() {}
 
 func (x *DepositAddress) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[80]
+	mi := &file_rpc_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4803,7 +4999,7 @@ func (x *DepositAddress) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DepositAddress.ProtoReflect.Descriptor instead.
 func (*DepositAddress) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{80}
+	return file_rpc_proto_rawDescGZIP(), []int{81}
 }
 
 func (x *DepositAddress) GetAddress() string {
@@ -4836,7 +5032,7 @@ type DepositAddresses struct {
 
 func (x *DepositAddresses) Reset() {
 	*x = DepositAddresses{}
-	mi := &file_rpc_proto_msgTypes[81]
+	mi := &file_rpc_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4848,7 +5044,7 @@ func (x *DepositAddresses) String() string {
 func (*DepositAddresses) ProtoMessage() {}
 
 func (x *DepositAddresses) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[81]
+	mi := &file_rpc_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4861,7 +5057,7 @@ func (x *DepositAddresses) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DepositAddresses.ProtoReflect.Descriptor instead.
 func (*DepositAddresses) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{81}
+	return file_rpc_proto_rawDescGZIP(), []int{82}
 }
 
 func (x *DepositAddresses) GetAddresses() []*DepositAddress {
@@ -4880,7 +5076,7 @@ type GetCryptocurrencyDepositAddressesResponse struct {
 
 func (x *GetCryptocurrencyDepositAddressesResponse) Reset() {
 	*x = GetCryptocurrencyDepositAddressesResponse{}
-	mi := &file_rpc_proto_msgTypes[82]
+	mi := &file_rpc_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4892,7 +5088,7 @@ func (x *GetCryptocurrencyDepositAddressesResponse) String() string {
 func (*GetCryptocurrencyDepositAddressesResponse) ProtoMessage() {}
 
 func (x *GetCryptocurrencyDepositAddressesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[82]
+	mi := &file_rpc_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4905,7 +5101,7 @@ func (x *GetCryptocurrencyDepositAddressesResponse) ProtoReflect() protoreflect.
 
 // Deprecated: Use GetCryptocurrencyDepositAddressesResponse.ProtoReflect.Descriptor instead.
 func (*GetCryptocurrencyDepositAddressesResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{82}
+	return file_rpc_proto_rawDescGZIP(), []int{83}
 }
 
 func (x *GetCryptocurrencyDepositAddressesResponse) GetAddresses() map[string]*DepositAddresses {
@@ -4927,7 +5123,7 @@ type GetCryptocurrencyDepositAddressRequest struct {
 
 func (x *GetCryptocurrencyDepositAddressRequest) Reset() {
 	*x = GetCryptocurrencyDepositAddressRequest{}
-	mi := &file_rpc_proto_msgTypes[83]
+	mi := &file_rpc_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4939,7 +5135,7 @@ func (x *GetCryptocurrencyDepositAddressRequest) String() string {
 func (*GetCryptocurrencyDepositAddressRequest) ProtoMessage() {}
 
 func (x *GetCryptocurrencyDepositAddressRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[83]
+	mi := &file_rpc_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4952,7 +5148,7 @@ func (x *GetCryptocurrencyDepositAddressRequest) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use GetCryptocurrencyDepositAddressRequest.ProtoReflect.Descriptor instead.
 func (*GetCryptocurrencyDepositAddressRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{83}
+	return file_rpc_proto_rawDescGZIP(), []int{84}
 }
 
 func (x *GetCryptocurrencyDepositAddressRequest) GetExchange() string {
@@ -4993,7 +5189,7 @@ type GetCryptocurrencyDepositAddressResponse struct {
 
 func (x *GetCryptocurrencyDepositAddressResponse) Reset() {
 	*x = GetCryptocurrencyDepositAddressResponse{}
-	mi := &file_rpc_proto_msgTypes[84]
+	mi := &file_rpc_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5005,7 +5201,7 @@ func (x *GetCryptocurrencyDepositAddressResponse) String() string {
 func (*GetCryptocurrencyDepositAddressResponse) ProtoMessage() {}
 
 func (x *GetCryptocurrencyDepositAddressResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[84]
+	mi := &file_rpc_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5018,7 +5214,7 @@ func (x *GetCryptocurrencyDepositAddressResponse) ProtoReflect() protoreflect.Me
 
 // Deprecated: Use GetCryptocurrencyDepositAddressResponse.ProtoReflect.Descriptor instead.
 func (*GetCryptocurrencyDepositAddressResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{84}
+	return file_rpc_proto_rawDescGZIP(), []int{85}
 }
 
 func (x *GetCryptocurrencyDepositAddressResponse) GetAddress() string {
@@ -5045,7 +5241,7 @@ type GetAvailableTransferChainsRequest struct {
 
 func (x *GetAvailableTransferChainsRequest) Reset() {
 	*x = GetAvailableTransferChainsRequest{}
-	mi := &file_rpc_proto_msgTypes[85]
+	mi := &file_rpc_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5057,7 +5253,7 @@ func (x *GetAvailableTransferChainsRequest) String() string {
 func (*GetAvailableTransferChainsRequest) ProtoMessage() {}
 
 func (x *GetAvailableTransferChainsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[85]
+	mi := &file_rpc_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5070,7 +5266,7 @@ func (x *GetAvailableTransferChainsRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GetAvailableTransferChainsRequest.ProtoReflect.Descriptor instead.
 func (*GetAvailableTransferChainsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{85}
+	return file_rpc_proto_rawDescGZIP(), []int{86}
 }
 
 func (x *GetAvailableTransferChainsRequest) GetExchange() string {
@@ -5096,7 +5292,7 @@ type GetAvailableTransferChainsResponse struct {
 
 func (x *GetAvailableTransferChainsResponse) Reset() {
 	*x = GetAvailableTransferChainsResponse{}
-	mi := &file_rpc_proto_msgTypes[86]
+	mi := &file_rpc_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5108,7 +5304,7 @@ func (x *GetAvailableTransferChainsResponse) String() string {
 func (*GetAvailableTransferChainsResponse) ProtoMessage() {}
 
 func (x *GetAvailableTransferChainsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[86]
+	mi := &file_rpc_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5121,7 +5317,7 @@ func (x *GetAvailableTransferChainsResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GetAvailableTransferChainsResponse.ProtoReflect.Descriptor instead.
 func (*GetAvailableTransferChainsResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{86}
+	return file_rpc_proto_rawDescGZIP(), []int{87}
 }
 
 func (x *GetAvailableTransferChainsResponse) GetChains() []string {
@@ -5132,19 +5328,21 @@ func (x *GetAvailableTransferChainsResponse) GetChains() []string {
 }
 
 type WithdrawFiatRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
-	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	BankAccountId string                 `protobuf:"bytes,5,opt,name=bank_account_id,json=bankAccountId,proto3" json:"bank_account_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Exchange         string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Currency         string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	Amount           float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Description      string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	BankAccountId    string                 `protobuf:"bytes,5,opt,name=bank_account_id,json=bankAccountId,proto3" json:"bank_account_id,omitempty"`
+	RequestedBy      string                 `protobuf:"bytes,6,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	RequestedByToken string                 `protobuf:"bytes,7,opt,name=requested_by_token,json=requestedByToken,proto3" json:"requested_by_token,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *WithdrawFiatRequest) Reset() {
 	*x = WithdrawFiatRequest{}
-	mi := &file_rpc_proto_msgTypes[87]
+	mi := &file_rpc_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5156,7 +5354,7 @@ func (x *WithdrawFiatRequest) String() string {
 func (*WithdrawFiatRequest) ProtoMessage() {}
 
 func (x *WithdrawFiatRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[87]
+	mi := &file_rpc_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5169,7 +5367,7 @@ func (x *WithdrawFiatRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WithdrawFiatRequest.ProtoReflect.Descriptor instead.
 func (*WithdrawFiatRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{87}
+	return file_rpc_proto_rawDescGZIP(), []int{88}
 }
 
 func (x *WithdrawFiatRequest) GetExchange() string {
@@ -5207,26 +5405,42 @@ func (x *WithdrawFiatRequest) GetBankAccountId() string {
 	return ""
 }
 
-type WithdrawCryptoRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Address       string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	AddressTag    string                 `protobuf:"bytes,3,opt,name=address_tag,json=addressTag,proto3" json:"address_tag,omitempty"`
-	Currency      string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
-	Amount        float64                `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
-	Fee           float64                `protobuf:"fixed64,6,opt,name=fee,proto3" json:"fee,omitempty"`
-	Description   string                 `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
-	Chain         string                 `protobuf:"bytes,8,opt,name=chain,proto3" json:"chain,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *WithdrawFiatRequest) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
 }
 
-func (x *WithdrawCryptoRequest) Reset() {
-	*x = WithdrawCryptoRequest{}
-	mi := &file_rpc_proto_msgTypes[88]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
+func (x *WithdrawFiatRequest) GetRequestedByToken() string {
+	if x != nil {
+		return x.RequestedByToken
+	}
+	return ""
+}
+
+type WithdrawCryptoRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Exchange         string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Address          string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	AddressTag       string                 `protobuf:"bytes,3,opt,name=address_tag,json=addressTag,proto3" json:"address_tag,omitempty"`
+	Currency         string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	Amount           float64                `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Fee              float64                `protobuf:"fixed64,6,opt,name=fee,proto3" json:"fee,omitempty"`
+	Description      string                 `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
+	Chain            string                 `protobuf:"bytes,8,opt,name=chain,proto3" json:"chain,omitempty"`
+	RequestedBy      string                 `protobuf:"bytes,9,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	RequestedByToken string                 `protobuf:"bytes,10,opt,name=requested_by_token,json=requestedByToken,proto3" json:"requested_by_token,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *WithdrawCryptoRequest) Reset() {
+	*x = WithdrawCryptoRequest{}
+	mi := &file_rpc_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
 
 func (x *WithdrawCryptoRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
@@ -5235,7 +5449,7 @@ func (x *WithdrawCryptoRequest) String() string {
 func (*WithdrawCryptoRequest) ProtoMessage() {}
 
 func (x *WithdrawCryptoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[88]
+	mi := &file_rpc_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5248,7 +5462,7 @@ func (x *WithdrawCryptoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WithdrawCryptoRequest.ProtoReflect.Descriptor instead.
 func (*WithdrawCryptoRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{88}
+	return file_rpc_proto_rawDescGZIP(), []int{89}
 }
 
 func (x *WithdrawCryptoRequest) GetExchange() string {
@@ -5307,6 +5521,20 @@ func (x *WithdrawCryptoRequest) GetChain() string {
 	return ""
 }
 
+func (x *WithdrawCryptoRequest) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *WithdrawCryptoRequest) GetRequestedByToken() string {
+	if x != nil {
+		return x.RequestedByToken
+	}
+	return ""
+}
+
 type WithdrawResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -5317,7 +5545,7 @@ type WithdrawResponse struct {
 
 func (x *WithdrawResponse) Reset() {
 	*x = WithdrawResponse{}
-	mi := &file_rpc_proto_msgTypes[89]
+	mi := &file_rpc_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5329,7 +5557,7 @@ func (x *WithdrawResponse) String() string {
 func (*WithdrawResponse) ProtoMessage() {}
 
 func (x *WithdrawResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[89]
+	mi := &file_rpc_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5342,7 +5570,7 @@ func (x *WithdrawResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WithdrawResponse.ProtoReflect.Descriptor instead.
 func (*WithdrawResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{89}
+	return file_rpc_proto_rawDescGZIP(), []int{90}
 }
 
 func (x *WithdrawResponse) GetId() string {
@@ -5368,7 +5596,7 @@ type WithdrawalEventByIDRequest struct {
 
 func (x *WithdrawalEventByIDRequest) Reset() {
 	*x = WithdrawalEventByIDRequest{}
-	mi := &file_rpc_proto_msgTypes[90]
+	mi := &file_rpc_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5380,7 +5608,7 @@ func (x *WithdrawalEventByIDRequest) String() string {
 func (*WithdrawalEventByIDRequest) ProtoMessage() {}
 
 func (x *WithdrawalEventByIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[90]
+	mi := &file_rpc_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5393,7 +5621,7 @@ func (x *WithdrawalEventByIDRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WithdrawalEventByIDRequest.ProtoReflect.Descriptor instead.
 func (*WithdrawalEventByIDRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{90}
+	return file_rpc_proto_rawDescGZIP(), []int{91}
 }
 
 func (x *WithdrawalEventByIDRequest) GetId() string {
@@ -5412,7 +5640,7 @@ type WithdrawalEventByIDResponse struct {
 
 func (x *WithdrawalEventByIDResponse) Reset() {
 	*x = WithdrawalEventByIDResponse{}
-	mi := &file_rpc_proto_msgTypes[91]
+	mi := &file_rpc_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5424,7 +5652,7 @@ func (x *WithdrawalEventByIDResponse) String() string {
 func (*WithdrawalEventByIDResponse) ProtoMessage() {}
 
 func (x *WithdrawalEventByIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[91]
+	mi := &file_rpc_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5437,7 +5665,7 @@ func (x *WithdrawalEventByIDResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WithdrawalEventByIDResponse.ProtoReflect.Descriptor instead.
 func (*WithdrawalEventByIDResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{91}
+	return file_rpc_proto_rawDescGZIP(), []int{92}
 }
 
 func (x *WithdrawalEventByIDResponse) GetEvent() *WithdrawalEventResponse {
@@ -5460,7 +5688,7 @@ type WithdrawalEventsByExchangeRequest struct {
 
 func (x *WithdrawalEventsByExchangeRequest) Reset() {
 	*x = WithdrawalEventsByExchangeRequest{}
-	mi := &file_rpc_proto_msgTypes[92]
+	mi := &file_rpc_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5472,7 +5700,7 @@ func (x *WithdrawalEventsByExchangeRequest) String() string {
 func (*WithdrawalEventsByExchangeRequest) ProtoMessage() {}
 
 func (x *WithdrawalEventsByExchangeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[92]
+	mi := &file_rpc_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5485,7 +5713,7 @@ func (x *WithdrawalEventsByExchangeRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use WithdrawalEventsByExchangeRequest.ProtoReflect.Descriptor instead.
 func (*WithdrawalEventsByExchangeRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{92}
+	return file_rpc_proto_rawDescGZIP(), []int{93}
 }
 
 func (x *WithdrawalEventsByExchangeRequest) GetExchange() string {
@@ -5535,7 +5763,7 @@ type WithdrawalEventsByDateRequest struct {
 
 func (x *WithdrawalEventsByDateRequest) Reset() {
 	*x = WithdrawalEventsByDateRequest{}
-	mi := &file_rpc_proto_msgTypes[93]
+	mi := &file_rpc_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5547,7 +5775,7 @@ func (x *WithdrawalEventsByDateRequest) String() string {
 func (*WithdrawalEventsByDateRequest) ProtoMessage() {}
 
 func (x *WithdrawalEventsByDateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[93]
+	mi := &file_rpc_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5560,7 +5788,7 @@ func (x *WithdrawalEventsByDateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WithdrawalEventsByDateRequest.ProtoReflect.Descriptor instead.
 func (*WithdrawalEventsByDateRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{93}
+	return file_rpc_proto_rawDescGZIP(), []int{94}
 }
 
 func (x *WithdrawalEventsByDateRequest) GetExchange() string {
@@ -5600,7 +5828,7 @@ type WithdrawalEventsByExchangeResponse struct {
 
 func (x *WithdrawalEventsByExchangeResponse) Reset() {
 	*x = WithdrawalEventsByExchangeResponse{}
-	mi := &file_rpc_proto_msgTypes[94]
+	mi := &file_rpc_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5612,7 +5840,7 @@ func (x *WithdrawalEventsByExchangeResponse) String() string {
 func (*WithdrawalEventsByExchangeResponse) ProtoMessage() {}
 
 func (x *WithdrawalEventsByExchangeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[94]
+	mi := &file_rpc_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5625,7 +5853,7 @@ func (x *WithdrawalEventsByExchangeResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use WithdrawalEventsByExchangeResponse.ProtoReflect.Descriptor instead.
 func (*WithdrawalEventsByExchangeResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{94}
+	return file_rpc_proto_rawDescGZIP(), []int{95}
 }
 
 func (x *WithdrawalEventsByExchangeResponse) GetEvent() []*WithdrawalEventResponse {
@@ -5648,7 +5876,7 @@ type WithdrawalEventResponse struct {
 
 func (x *WithdrawalEventResponse) Reset() {
 	*x = WithdrawalEventResponse{}
-	mi := &file_rpc_proto_msgTypes[95]
+	mi := &file_rpc_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5660,7 +5888,7 @@ func (x *WithdrawalEventResponse) String() string {
 func (*WithdrawalEventResponse) ProtoMessage() {}
 
 func (x *WithdrawalEventResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[95]
+	mi := &file_rpc_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5673,7 +5901,7 @@ func (x *WithdrawalEventResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WithdrawalEventResponse.ProtoReflect.Descriptor instead.
 func (*WithdrawalEventResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{95}
+	return file_rpc_proto_rawDescGZIP(), []int{96}
 }
 
 func (x *WithdrawalEventResponse) GetId() string {
@@ -5722,7 +5950,7 @@ type WithdrawalExchangeEvent struct {
 
 func (x *WithdrawalExchangeEvent) Reset() {
 	*x = WithdrawalExchangeEvent{}
-	mi := &file_rpc_proto_msgTypes[96]
+	mi := &file_rpc_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5734,7 +5962,7 @@ func (x *WithdrawalExchangeEvent) String() string {
 func (*WithdrawalExchangeEvent) ProtoMessage() {}
 
 func (x *WithdrawalExchangeEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[96]
+	mi := &file_rpc_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5747,7 +5975,7 @@ func (x *WithdrawalExchangeEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WithdrawalExchangeEvent.ProtoReflect.Descriptor instead.
 func (*WithdrawalExchangeEvent) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{96}
+	return file_rpc_proto_rawDescGZIP(), []int{97}
 }
 
 func (x *WithdrawalExchangeEvent) GetName() string {
@@ -5785,7 +6013,7 @@ type WithdrawalRequestEvent struct {
 
 func (x *WithdrawalRequestEvent) Reset() {
 	*x = WithdrawalRequestEvent{}
-	mi := &file_rpc_proto_msgTypes[97]
+	mi := &file_rpc_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5797,7 +6025,7 @@ func (x *WithdrawalRequestEvent) String() string {
 func (*WithdrawalRequestEvent) ProtoMessage() {}
 
 func (x *WithdrawalRequestEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[97]
+	mi := &file_rpc_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5810,7 +6038,7 @@ func (x *WithdrawalRequestEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WithdrawalRequestEvent.ProtoReflect.Descriptor instead.
 func (*WithdrawalRequestEvent) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{97}
+	return file_rpc_proto_rawDescGZIP(), []int{98}
 }
 
 func (x *WithdrawalRequestEvent) GetCurrency() string {
@@ -5869,7 +6097,7 @@ type FiatWithdrawalEvent struct {
 
 func (x *FiatWithdrawalEvent) Reset() {
 	*x = FiatWithdrawalEvent{}
-	mi := &file_rpc_proto_msgTypes[98]
+	mi := &file_rpc_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5881,7 +6109,7 @@ func (x *FiatWithdrawalEvent) String() string {
 func (*FiatWithdrawalEvent) ProtoMessage() {}
 
 func (x *FiatWithdrawalEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[98]
+	mi := &file_rpc_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5894,7 +6122,7 @@ func (x *FiatWithdrawalEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FiatWithdrawalEvent.ProtoReflect.Descriptor instead.
 func (*FiatWithdrawalEvent) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{98}
+	return file_rpc_proto_rawDescGZIP(), []int{99}
 }
 
 func (x *FiatWithdrawalEvent) GetBankName() string {
@@ -5945,13 +6173,14 @@ type CryptoWithdrawalEvent struct {
 	AddressTag    string                 `protobuf:"bytes,2,opt,name=address_tag,json=addressTag,proto3" json:"address_tag,omitempty"`
 	Fee           float64                `protobuf:"fixed64,3,opt,name=fee,proto3" json:"fee,omitempty"`
 	TxId          string                 `protobuf:"bytes,4,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	Chain         string                 `protobuf:"bytes,5,opt,name=chain,proto3" json:"chain,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CryptoWithdrawalEvent) Reset() {
 	*x = CryptoWithdrawalEvent{}
-	mi := &file_rpc_proto_msgTypes[99]
+	mi := &file_rpc_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5963,7 +6192,7 @@ func (x *CryptoWithdrawalEvent) String() string {
 func (*CryptoWithdrawalEvent) ProtoMessage() {}
 
 func (x *CryptoWithdrawalEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[99]
+	mi := &file_rpc_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5976,7 +6205,7 @@ func (x *CryptoWithdrawalEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CryptoWithdrawalEvent.ProtoReflect.Descriptor instead.
 func (*CryptoWithdrawalEvent) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{99}
+	return file_rpc_proto_rawDescGZIP(), []int{100}
 }
 
 func (x *CryptoWithdrawalEvent) GetAddress() string {
@@ -6007,28 +6236,41 @@ func (x *CryptoWithdrawalEvent) GetTxId() string {
 	return ""
 }
 
-type GetLoggerDetailsRequest struct {
+func (x *CryptoWithdrawalEvent) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+type WithdrawalAddress struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Logger        string                 `protobuf:"bytes,1,opt,name=logger,proto3" json:"logger,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	Chain         string                 `protobuf:"bytes,3,opt,name=chain,proto3" json:"chain,omitempty"`
+	Address       string                 `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	AddressTag    string                 `protobuf:"bytes,5,opt,name=address_tag,json=addressTag,proto3" json:"address_tag,omitempty"`
+	Label         string                 `protobuf:"bytes,6,opt,name=label,proto3" json:"label,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetLoggerDetailsRequest) Reset() {
-	*x = GetLoggerDetailsRequest{}
-	mi := &file_rpc_proto_msgTypes[100]
+func (x *WithdrawalAddress) Reset() {
+	*x = WithdrawalAddress{}
+	mi := &file_rpc_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLoggerDetailsRequest) String() string {
+func (x *WithdrawalAddress) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLoggerDetailsRequest) ProtoMessage() {}
+func (*WithdrawalAddress) ProtoMessage() {}
 
-func (x *GetLoggerDetailsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[100]
+func (x *WithdrawalAddress) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6039,108 +6281,85 @@ func (x *GetLoggerDetailsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLoggerDetailsRequest.ProtoReflect.Descriptor instead.
-func (*GetLoggerDetailsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{100}
+// Deprecated: Use WithdrawalAddress.ProtoReflect.Descriptor instead.
+func (*WithdrawalAddress) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{101}
 }
 
-func (x *GetLoggerDetailsRequest) GetLogger() string {
+func (x *WithdrawalAddress) GetId() string {
 	if x != nil {
-		return x.Logger
+		return x.Id
 	}
 	return ""
 }
 
-type GetLoggerDetailsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Info          bool                   `protobuf:"varint,1,opt,name=info,proto3" json:"info,omitempty"`
-	Debug         bool                   `protobuf:"varint,2,opt,name=debug,proto3" json:"debug,omitempty"`
-	Warn          bool                   `protobuf:"varint,3,opt,name=warn,proto3" json:"warn,omitempty"`
-	Error         bool                   `protobuf:"varint,4,opt,name=error,proto3" json:"error,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetLoggerDetailsResponse) Reset() {
-	*x = GetLoggerDetailsResponse{}
-	mi := &file_rpc_proto_msgTypes[101]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetLoggerDetailsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetLoggerDetailsResponse) ProtoMessage() {}
-
-func (x *GetLoggerDetailsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[101]
+func (x *WithdrawalAddress) GetCurrency() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Currency
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetLoggerDetailsResponse.ProtoReflect.Descriptor instead.
-func (*GetLoggerDetailsResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{101}
+func (x *WithdrawalAddress) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
 }
 
-func (x *GetLoggerDetailsResponse) GetInfo() bool {
+func (x *WithdrawalAddress) GetAddress() string {
 	if x != nil {
-		return x.Info
+		return x.Address
 	}
-	return false
+	return ""
 }
 
-func (x *GetLoggerDetailsResponse) GetDebug() bool {
+func (x *WithdrawalAddress) GetAddressTag() string {
 	if x != nil {
-		return x.Debug
+		return x.AddressTag
 	}
-	return false
+	return ""
 }
 
-func (x *GetLoggerDetailsResponse) GetWarn() bool {
+func (x *WithdrawalAddress) GetLabel() string {
 	if x != nil {
-		return x.Warn
+		return x.Label
 	}
-	return false
+	return ""
 }
 
-func (x *GetLoggerDetailsResponse) GetError() bool {
+func (x *WithdrawalAddress) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Error
+		return x.CreatedAt
 	}
-	return false
+	return nil
 }
 
-type SetLoggerDetailsRequest struct {
+type AddWithdrawalAddressRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Logger        string                 `protobuf:"bytes,1,opt,name=logger,proto3" json:"logger,omitempty"`
-	Level         string                 `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"`
+	Currency      string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	Chain         string                 `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	Address       string                 `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	AddressTag    string                 `protobuf:"bytes,4,opt,name=address_tag,json=addressTag,proto3" json:"address_tag,omitempty"`
+	Label         string                 `protobuf:"bytes,5,opt,name=label,proto3" json:"label,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetLoggerDetailsRequest) Reset() {
-	*x = SetLoggerDetailsRequest{}
+func (x *AddWithdrawalAddressRequest) Reset() {
+	*x = AddWithdrawalAddressRequest{}
 	mi := &file_rpc_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetLoggerDetailsRequest) String() string {
+func (x *AddWithdrawalAddressRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetLoggerDetailsRequest) ProtoMessage() {}
+func (*AddWithdrawalAddressRequest) ProtoMessage() {}
 
-func (x *SetLoggerDetailsRequest) ProtoReflect() protoreflect.Message {
+func (x *AddWithdrawalAddressRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6152,47 +6371,67 @@ func (x *SetLoggerDetailsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetLoggerDetailsRequest.ProtoReflect.Descriptor instead.
-func (*SetLoggerDetailsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddWithdrawalAddressRequest.ProtoReflect.Descriptor instead.
+func (*AddWithdrawalAddressRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{102}
 }
 
-func (x *SetLoggerDetailsRequest) GetLogger() string {
+func (x *AddWithdrawalAddressRequest) GetCurrency() string {
 	if x != nil {
-		return x.Logger
+		return x.Currency
 	}
 	return ""
 }
 
-func (x *SetLoggerDetailsRequest) GetLevel() string {
+func (x *AddWithdrawalAddressRequest) GetChain() string {
 	if x != nil {
-		return x.Level
+		return x.Chain
 	}
 	return ""
 }
 
-type GetExchangePairsRequest struct {
+func (x *AddWithdrawalAddressRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AddWithdrawalAddressRequest) GetAddressTag() string {
+	if x != nil {
+		return x.AddressTag
+	}
+	return ""
+}
+
+func (x *AddWithdrawalAddressRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+type RemoveWithdrawalAddressRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetExchangePairsRequest) Reset() {
-	*x = GetExchangePairsRequest{}
+func (x *RemoveWithdrawalAddressRequest) Reset() {
+	*x = RemoveWithdrawalAddressRequest{}
 	mi := &file_rpc_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetExchangePairsRequest) String() string {
+func (x *RemoveWithdrawalAddressRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetExchangePairsRequest) ProtoMessage() {}
+func (*RemoveWithdrawalAddressRequest) ProtoMessage() {}
 
-func (x *GetExchangePairsRequest) ProtoReflect() protoreflect.Message {
+func (x *RemoveWithdrawalAddressRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6204,46 +6443,39 @@ func (x *GetExchangePairsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetExchangePairsRequest.ProtoReflect.Descriptor instead.
-func (*GetExchangePairsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RemoveWithdrawalAddressRequest.ProtoReflect.Descriptor instead.
+func (*RemoveWithdrawalAddressRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{103}
 }
 
-func (x *GetExchangePairsRequest) GetExchange() string {
-	if x != nil {
-		return x.Exchange
-	}
-	return ""
-}
-
-func (x *GetExchangePairsRequest) GetAsset() string {
+func (x *RemoveWithdrawalAddressRequest) GetId() string {
 	if x != nil {
-		return x.Asset
+		return x.Id
 	}
 	return ""
 }
 
-type GetExchangePairsResponse struct {
-	state           protoimpl.MessageState     `protogen:"open.v1"`
-	SupportedAssets map[string]*PairsSupported `protobuf:"bytes,1,rep,name=supported_assets,json=supportedAssets,proto3" json:"supported_assets,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type GetWithdrawalAddressesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Currency      string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetExchangePairsResponse) Reset() {
-	*x = GetExchangePairsResponse{}
+func (x *GetWithdrawalAddressesRequest) Reset() {
+	*x = GetWithdrawalAddressesRequest{}
 	mi := &file_rpc_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetExchangePairsResponse) String() string {
+func (x *GetWithdrawalAddressesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetExchangePairsResponse) ProtoMessage() {}
+func (*GetWithdrawalAddressesRequest) ProtoMessage() {}
 
-func (x *GetExchangePairsResponse) ProtoReflect() protoreflect.Message {
+func (x *GetWithdrawalAddressesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6255,42 +6487,39 @@ func (x *GetExchangePairsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetExchangePairsResponse.ProtoReflect.Descriptor instead.
-func (*GetExchangePairsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetWithdrawalAddressesRequest.ProtoReflect.Descriptor instead.
+func (*GetWithdrawalAddressesRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{104}
 }
 
-func (x *GetExchangePairsResponse) GetSupportedAssets() map[string]*PairsSupported {
+func (x *GetWithdrawalAddressesRequest) GetCurrency() string {
 	if x != nil {
-		return x.SupportedAssets
+		return x.Currency
 	}
-	return nil
+	return ""
 }
 
-type SetExchangePairRequest struct {
+type GetWithdrawalAddressesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	AssetType     string                 `protobuf:"bytes,2,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
-	Pairs         []*CurrencyPair        `protobuf:"bytes,3,rep,name=pairs,proto3" json:"pairs,omitempty"`
-	Enable        bool                   `protobuf:"varint,4,opt,name=enable,proto3" json:"enable,omitempty"`
+	Addresses     []*WithdrawalAddress   `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetExchangePairRequest) Reset() {
-	*x = SetExchangePairRequest{}
+func (x *GetWithdrawalAddressesResponse) Reset() {
+	*x = GetWithdrawalAddressesResponse{}
 	mi := &file_rpc_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetExchangePairRequest) String() string {
+func (x *GetWithdrawalAddressesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetExchangePairRequest) ProtoMessage() {}
+func (*GetWithdrawalAddressesResponse) ProtoMessage() {}
 
-func (x *SetExchangePairRequest) ProtoReflect() protoreflect.Message {
+func (x *GetWithdrawalAddressesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6302,62 +6531,40 @@ func (x *SetExchangePairRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetExchangePairRequest.ProtoReflect.Descriptor instead.
-func (*SetExchangePairRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetWithdrawalAddressesResponse.ProtoReflect.Descriptor instead.
+func (*GetWithdrawalAddressesResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{105}
 }
 
-func (x *SetExchangePairRequest) GetExchange() string {
-	if x != nil {
-		return x.Exchange
-	}
-	return ""
-}
-
-func (x *SetExchangePairRequest) GetAssetType() string {
-	if x != nil {
-		return x.AssetType
-	}
-	return ""
-}
-
-func (x *SetExchangePairRequest) GetPairs() []*CurrencyPair {
+func (x *GetWithdrawalAddressesResponse) GetAddresses() []*WithdrawalAddress {
 	if x != nil {
-		return x.Pairs
+		return x.Addresses
 	}
 	return nil
 }
 
-func (x *SetExchangePairRequest) GetEnable() bool {
-	if x != nil {
-		return x.Enable
-	}
-	return false
-}
-
-type GetOrderbookStreamRequest struct {
+type WithdrawalApproval struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Pair          *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
-	AssetType     string                 `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Approver      string                 `protobuf:"bytes,1,opt,name=approver,proto3" json:"approver,omitempty"`
+	ApprovedAt    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=approved_at,json=approvedAt,proto3" json:"approved_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOrderbookStreamRequest) Reset() {
-	*x = GetOrderbookStreamRequest{}
+func (x *WithdrawalApproval) Reset() {
+	*x = WithdrawalApproval{}
 	mi := &file_rpc_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrderbookStreamRequest) String() string {
+func (x *WithdrawalApproval) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrderbookStreamRequest) ProtoMessage() {}
+func (*WithdrawalApproval) ProtoMessage() {}
 
-func (x *GetOrderbookStreamRequest) ProtoReflect() protoreflect.Message {
+func (x *WithdrawalApproval) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6369,53 +6576,53 @@ func (x *GetOrderbookStreamRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrderbookStreamRequest.ProtoReflect.Descriptor instead.
-func (*GetOrderbookStreamRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use WithdrawalApproval.ProtoReflect.Descriptor instead.
+func (*WithdrawalApproval) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{106}
 }
 
-func (x *GetOrderbookStreamRequest) GetExchange() string {
+func (x *WithdrawalApproval) GetApprover() string {
 	if x != nil {
-		return x.Exchange
+		return x.Approver
 	}
 	return ""
 }
 
-func (x *GetOrderbookStreamRequest) GetPair() *CurrencyPair {
+func (x *WithdrawalApproval) GetApprovedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Pair
+		return x.ApprovedAt
 	}
 	return nil
 }
 
-func (x *GetOrderbookStreamRequest) GetAssetType() string {
-	if x != nil {
-		return x.AssetType
-	}
-	return ""
-}
-
-type GetExchangeOrderbookStreamRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type PendingWithdrawal struct {
+	state             protoimpl.MessageState  `protogen:"open.v1"`
+	Id                string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Exchange          string                  `protobuf:"bytes,2,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Request           *WithdrawalRequestEvent `protobuf:"bytes,3,opt,name=request,proto3" json:"request,omitempty"`
+	RequestedBy       string                  `protobuf:"bytes,4,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	RequestedAt       *timestamppb.Timestamp  `protobuf:"bytes,5,opt,name=requested_at,json=requestedAt,proto3" json:"requested_at,omitempty"`
+	ExpiresAt         *timestamppb.Timestamp  `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	RequiredApprovals int64                   `protobuf:"varint,7,opt,name=required_approvals,json=requiredApprovals,proto3" json:"required_approvals,omitempty"`
+	Approvals         []*WithdrawalApproval   `protobuf:"bytes,8,rep,name=approvals,proto3" json:"approvals,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *GetExchangeOrderbookStreamRequest) Reset() {
-	*x = GetExchangeOrderbookStreamRequest{}
+func (x *PendingWithdrawal) Reset() {
+	*x = PendingWithdrawal{}
 	mi := &file_rpc_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetExchangeOrderbookStreamRequest) String() string {
+func (x *PendingWithdrawal) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetExchangeOrderbookStreamRequest) ProtoMessage() {}
+func (*PendingWithdrawal) ProtoMessage() {}
 
-func (x *GetExchangeOrderbookStreamRequest) ProtoReflect() protoreflect.Message {
+func (x *PendingWithdrawal) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6427,41 +6634,87 @@ func (x *GetExchangeOrderbookStreamRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetExchangeOrderbookStreamRequest.ProtoReflect.Descriptor instead.
-func (*GetExchangeOrderbookStreamRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PendingWithdrawal.ProtoReflect.Descriptor instead.
+func (*PendingWithdrawal) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{107}
 }
 
-func (x *GetExchangeOrderbookStreamRequest) GetExchange() string {
+func (x *PendingWithdrawal) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PendingWithdrawal) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-type GetTickerStreamRequest struct {
+func (x *PendingWithdrawal) GetRequest() *WithdrawalRequestEvent {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *PendingWithdrawal) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *PendingWithdrawal) GetRequestedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RequestedAt
+	}
+	return nil
+}
+
+func (x *PendingWithdrawal) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *PendingWithdrawal) GetRequiredApprovals() int64 {
+	if x != nil {
+		return x.RequiredApprovals
+	}
+	return 0
+}
+
+func (x *PendingWithdrawal) GetApprovals() []*WithdrawalApproval {
+	if x != nil {
+		return x.Approvals
+	}
+	return nil
+}
+
+type GetPendingWithdrawalsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Pair          *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
-	AssetType     string                 `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTickerStreamRequest) Reset() {
-	*x = GetTickerStreamRequest{}
+func (x *GetPendingWithdrawalsRequest) Reset() {
+	*x = GetPendingWithdrawalsRequest{}
 	mi := &file_rpc_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTickerStreamRequest) String() string {
+func (x *GetPendingWithdrawalsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTickerStreamRequest) ProtoMessage() {}
+func (*GetPendingWithdrawalsRequest) ProtoMessage() {}
 
-func (x *GetTickerStreamRequest) ProtoReflect() protoreflect.Message {
+func (x *GetPendingWithdrawalsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6473,53 +6726,32 @@ func (x *GetTickerStreamRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTickerStreamRequest.ProtoReflect.Descriptor instead.
-func (*GetTickerStreamRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetPendingWithdrawalsRequest.ProtoReflect.Descriptor instead.
+func (*GetPendingWithdrawalsRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{108}
 }
 
-func (x *GetTickerStreamRequest) GetExchange() string {
-	if x != nil {
-		return x.Exchange
-	}
-	return ""
-}
-
-func (x *GetTickerStreamRequest) GetPair() *CurrencyPair {
-	if x != nil {
-		return x.Pair
-	}
-	return nil
-}
-
-func (x *GetTickerStreamRequest) GetAssetType() string {
-	if x != nil {
-		return x.AssetType
-	}
-	return ""
-}
-
-type GetExchangeTickerStreamRequest struct {
+type GetPendingWithdrawalsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Withdrawals   []*PendingWithdrawal   `protobuf:"bytes,1,rep,name=withdrawals,proto3" json:"withdrawals,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetExchangeTickerStreamRequest) Reset() {
-	*x = GetExchangeTickerStreamRequest{}
+func (x *GetPendingWithdrawalsResponse) Reset() {
+	*x = GetPendingWithdrawalsResponse{}
 	mi := &file_rpc_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetExchangeTickerStreamRequest) String() string {
+func (x *GetPendingWithdrawalsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetExchangeTickerStreamRequest) ProtoMessage() {}
+func (*GetPendingWithdrawalsResponse) ProtoMessage() {}
 
-func (x *GetExchangeTickerStreamRequest) ProtoReflect() protoreflect.Message {
+func (x *GetPendingWithdrawalsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6531,43 +6763,41 @@ func (x *GetExchangeTickerStreamRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetExchangeTickerStreamRequest.ProtoReflect.Descriptor instead.
-func (*GetExchangeTickerStreamRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetPendingWithdrawalsResponse.ProtoReflect.Descriptor instead.
+func (*GetPendingWithdrawalsResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{109}
 }
 
-func (x *GetExchangeTickerStreamRequest) GetExchange() string {
+func (x *GetPendingWithdrawalsResponse) GetWithdrawals() []*PendingWithdrawal {
 	if x != nil {
-		return x.Exchange
+		return x.Withdrawals
 	}
-	return ""
+	return nil
 }
 
-type GetAuditEventRequest struct {
+type ApproveWithdrawalRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	StartDate     string                 `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate       string                 `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
-	OrderBy       string                 `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
-	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Approver      string                 `protobuf:"bytes,2,opt,name=approver,proto3" json:"approver,omitempty"`
+	ApproverToken string                 `protobuf:"bytes,3,opt,name=approver_token,json=approverToken,proto3" json:"approver_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAuditEventRequest) Reset() {
-	*x = GetAuditEventRequest{}
+func (x *ApproveWithdrawalRequest) Reset() {
+	*x = ApproveWithdrawalRequest{}
 	mi := &file_rpc_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAuditEventRequest) String() string {
+func (x *ApproveWithdrawalRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAuditEventRequest) ProtoMessage() {}
+func (*ApproveWithdrawalRequest) ProtoMessage() {}
 
-func (x *GetAuditEventRequest) ProtoReflect() protoreflect.Message {
+func (x *ApproveWithdrawalRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6579,67 +6809,55 @@ func (x *GetAuditEventRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAuditEventRequest.ProtoReflect.Descriptor instead.
-func (*GetAuditEventRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ApproveWithdrawalRequest.ProtoReflect.Descriptor instead.
+func (*ApproveWithdrawalRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{110}
 }
 
-func (x *GetAuditEventRequest) GetStartDate() string {
+func (x *ApproveWithdrawalRequest) GetId() string {
 	if x != nil {
-		return x.StartDate
+		return x.Id
 	}
 	return ""
 }
 
-func (x *GetAuditEventRequest) GetEndDate() string {
+func (x *ApproveWithdrawalRequest) GetApprover() string {
 	if x != nil {
-		return x.EndDate
+		return x.Approver
 	}
 	return ""
 }
 
-func (x *GetAuditEventRequest) GetOrderBy() string {
+func (x *ApproveWithdrawalRequest) GetApproverToken() string {
 	if x != nil {
-		return x.OrderBy
+		return x.ApproverToken
 	}
 	return ""
 }
 
-func (x *GetAuditEventRequest) GetLimit() int32 {
-	if x != nil {
-		return x.Limit
-	}
-	return 0
-}
-
-func (x *GetAuditEventRequest) GetOffset() int32 {
-	if x != nil {
-		return x.Offset
-	}
-	return 0
-}
-
-type GetAuditEventResponse struct {
+type RejectWithdrawalRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Events        []*AuditEvent          `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Approver      string                 `protobuf:"bytes,2,opt,name=approver,proto3" json:"approver,omitempty"`
+	ApproverToken string                 `protobuf:"bytes,3,opt,name=approver_token,json=approverToken,proto3" json:"approver_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAuditEventResponse) Reset() {
-	*x = GetAuditEventResponse{}
+func (x *RejectWithdrawalRequest) Reset() {
+	*x = RejectWithdrawalRequest{}
 	mi := &file_rpc_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAuditEventResponse) String() string {
+func (x *RejectWithdrawalRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAuditEventResponse) ProtoMessage() {}
+func (*RejectWithdrawalRequest) ProtoMessage() {}
 
-func (x *GetAuditEventResponse) ProtoReflect() protoreflect.Message {
+func (x *RejectWithdrawalRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6651,43 +6869,53 @@ func (x *GetAuditEventResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAuditEventResponse.ProtoReflect.Descriptor instead.
-func (*GetAuditEventResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RejectWithdrawalRequest.ProtoReflect.Descriptor instead.
+func (*RejectWithdrawalRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{111}
 }
 
-func (x *GetAuditEventResponse) GetEvents() []*AuditEvent {
+func (x *RejectWithdrawalRequest) GetId() string {
 	if x != nil {
-		return x.Events
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type GetSavedTradesRequest struct {
+func (x *RejectWithdrawalRequest) GetApprover() string {
+	if x != nil {
+		return x.Approver
+	}
+	return ""
+}
+
+func (x *RejectWithdrawalRequest) GetApproverToken() string {
+	if x != nil {
+		return x.ApproverToken
+	}
+	return ""
+}
+
+type GetLoggerDetailsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Pair          *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
-	AssetType     string                 `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
-	Start         string                 `protobuf:"bytes,4,opt,name=start,proto3" json:"start,omitempty"`
-	End           string                 `protobuf:"bytes,5,opt,name=end,proto3" json:"end,omitempty"`
+	Logger        string                 `protobuf:"bytes,1,opt,name=logger,proto3" json:"logger,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSavedTradesRequest) Reset() {
-	*x = GetSavedTradesRequest{}
+func (x *GetLoggerDetailsRequest) Reset() {
+	*x = GetLoggerDetailsRequest{}
 	mi := &file_rpc_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSavedTradesRequest) String() string {
+func (x *GetLoggerDetailsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSavedTradesRequest) ProtoMessage() {}
+func (*GetLoggerDetailsRequest) ProtoMessage() {}
 
-func (x *GetSavedTradesRequest) ProtoReflect() protoreflect.Message {
+func (x *GetLoggerDetailsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6699,71 +6927,42 @@ func (x *GetSavedTradesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSavedTradesRequest.ProtoReflect.Descriptor instead.
-func (*GetSavedTradesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetLoggerDetailsRequest.ProtoReflect.Descriptor instead.
+func (*GetLoggerDetailsRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{112}
 }
 
-func (x *GetSavedTradesRequest) GetExchange() string {
+func (x *GetLoggerDetailsRequest) GetLogger() string {
 	if x != nil {
-		return x.Exchange
+		return x.Logger
 	}
 	return ""
 }
 
-func (x *GetSavedTradesRequest) GetPair() *CurrencyPair {
-	if x != nil {
-		return x.Pair
-	}
-	return nil
-}
-
-func (x *GetSavedTradesRequest) GetAssetType() string {
-	if x != nil {
-		return x.AssetType
-	}
-	return ""
-}
-
-func (x *GetSavedTradesRequest) GetStart() string {
-	if x != nil {
-		return x.Start
-	}
-	return ""
-}
-
-func (x *GetSavedTradesRequest) GetEnd() string {
-	if x != nil {
-		return x.End
-	}
-	return ""
-}
-
-type SavedTrades struct {
+type GetLoggerDetailsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Price         float64                `protobuf:"fixed64,1,opt,name=price,proto3" json:"price,omitempty"`
-	Amount        float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	Side          string                 `protobuf:"bytes,3,opt,name=side,proto3" json:"side,omitempty"`
-	Timestamp     string                 `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	TradeId       string                 `protobuf:"bytes,5,opt,name=trade_id,json=tradeId,proto3" json:"trade_id,omitempty"`
+	Info          bool                   `protobuf:"varint,1,opt,name=info,proto3" json:"info,omitempty"`
+	Debug         bool                   `protobuf:"varint,2,opt,name=debug,proto3" json:"debug,omitempty"`
+	Warn          bool                   `protobuf:"varint,3,opt,name=warn,proto3" json:"warn,omitempty"`
+	Error         bool                   `protobuf:"varint,4,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SavedTrades) Reset() {
-	*x = SavedTrades{}
+func (x *GetLoggerDetailsResponse) Reset() {
+	*x = GetLoggerDetailsResponse{}
 	mi := &file_rpc_proto_msgTypes[113]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SavedTrades) String() string {
+func (x *GetLoggerDetailsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SavedTrades) ProtoMessage() {}
+func (*GetLoggerDetailsResponse) ProtoMessage() {}
 
-func (x *SavedTrades) ProtoReflect() protoreflect.Message {
+func (x *GetLoggerDetailsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[113]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6775,70 +6974,61 @@ func (x *SavedTrades) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SavedTrades.ProtoReflect.Descriptor instead.
-func (*SavedTrades) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetLoggerDetailsResponse.ProtoReflect.Descriptor instead.
+func (*GetLoggerDetailsResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{113}
 }
 
-func (x *SavedTrades) GetPrice() float64 {
-	if x != nil {
-		return x.Price
-	}
-	return 0
-}
-
-func (x *SavedTrades) GetAmount() float64 {
+func (x *GetLoggerDetailsResponse) GetInfo() bool {
 	if x != nil {
-		return x.Amount
+		return x.Info
 	}
-	return 0
+	return false
 }
 
-func (x *SavedTrades) GetSide() string {
+func (x *GetLoggerDetailsResponse) GetDebug() bool {
 	if x != nil {
-		return x.Side
+		return x.Debug
 	}
-	return ""
+	return false
 }
 
-func (x *SavedTrades) GetTimestamp() string {
+func (x *GetLoggerDetailsResponse) GetWarn() bool {
 	if x != nil {
-		return x.Timestamp
+		return x.Warn
 	}
-	return ""
+	return false
 }
 
-func (x *SavedTrades) GetTradeId() string {
+func (x *GetLoggerDetailsResponse) GetError() bool {
 	if x != nil {
-		return x.TradeId
+		return x.Error
 	}
-	return ""
+	return false
 }
 
-type SavedTradesResponse struct {
+type SetLoggerDetailsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ExchangeName  string                 `protobuf:"bytes,1,opt,name=exchange_name,json=exchangeName,proto3" json:"exchange_name,omitempty"`
-	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	Trades        []*SavedTrades         `protobuf:"bytes,4,rep,name=trades,proto3" json:"trades,omitempty"`
+	Logger        string                 `protobuf:"bytes,1,opt,name=logger,proto3" json:"logger,omitempty"`
+	Level         string                 `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SavedTradesResponse) Reset() {
-	*x = SavedTradesResponse{}
+func (x *SetLoggerDetailsRequest) Reset() {
+	*x = SetLoggerDetailsRequest{}
 	mi := &file_rpc_proto_msgTypes[114]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SavedTradesResponse) String() string {
+func (x *SetLoggerDetailsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SavedTradesResponse) ProtoMessage() {}
+func (*SetLoggerDetailsRequest) ProtoMessage() {}
 
-func (x *SavedTradesResponse) ProtoReflect() protoreflect.Message {
+func (x *SetLoggerDetailsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[114]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6850,67 +7040,47 @@ func (x *SavedTradesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SavedTradesResponse.ProtoReflect.Descriptor instead.
-func (*SavedTradesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetLoggerDetailsRequest.ProtoReflect.Descriptor instead.
+func (*SetLoggerDetailsRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{114}
 }
 
-func (x *SavedTradesResponse) GetExchangeName() string {
+func (x *SetLoggerDetailsRequest) GetLogger() string {
 	if x != nil {
-		return x.ExchangeName
+		return x.Logger
 	}
 	return ""
 }
 
-func (x *SavedTradesResponse) GetAsset() string {
+func (x *SetLoggerDetailsRequest) GetLevel() string {
 	if x != nil {
-		return x.Asset
+		return x.Level
 	}
 	return ""
 }
 
-func (x *SavedTradesResponse) GetPair() *CurrencyPair {
-	if x != nil {
-		return x.Pair
-	}
-	return nil
-}
-
-func (x *SavedTradesResponse) GetTrades() []*SavedTrades {
-	if x != nil {
-		return x.Trades
-	}
-	return nil
-}
-
-type ConvertTradesToCandlesRequest struct {
+type GetExchangePairsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Pair          *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
-	AssetType     string                 `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
-	Start         string                 `protobuf:"bytes,4,opt,name=start,proto3" json:"start,omitempty"`
-	End           string                 `protobuf:"bytes,5,opt,name=end,proto3" json:"end,omitempty"`
-	TimeInterval  int64                  `protobuf:"varint,6,opt,name=time_interval,json=timeInterval,proto3" json:"time_interval,omitempty"`
-	Sync          bool                   `protobuf:"varint,7,opt,name=sync,proto3" json:"sync,omitempty"`
-	Force         bool                   `protobuf:"varint,8,opt,name=force,proto3" json:"force,omitempty"`
+	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ConvertTradesToCandlesRequest) Reset() {
-	*x = ConvertTradesToCandlesRequest{}
+func (x *GetExchangePairsRequest) Reset() {
+	*x = GetExchangePairsRequest{}
 	mi := &file_rpc_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ConvertTradesToCandlesRequest) String() string {
+func (x *GetExchangePairsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConvertTradesToCandlesRequest) ProtoMessage() {}
+func (*GetExchangePairsRequest) ProtoMessage() {}
 
-func (x *ConvertTradesToCandlesRequest) ProtoReflect() protoreflect.Message {
+func (x *GetExchangePairsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6922,99 +7092,94 @@ func (x *ConvertTradesToCandlesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConvertTradesToCandlesRequest.ProtoReflect.Descriptor instead.
-func (*ConvertTradesToCandlesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetExchangePairsRequest.ProtoReflect.Descriptor instead.
+func (*GetExchangePairsRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{115}
 }
 
-func (x *ConvertTradesToCandlesRequest) GetExchange() string {
+func (x *GetExchangePairsRequest) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *ConvertTradesToCandlesRequest) GetPair() *CurrencyPair {
+func (x *GetExchangePairsRequest) GetAsset() string {
 	if x != nil {
-		return x.Pair
+		return x.Asset
 	}
-	return nil
+	return ""
 }
 
-func (x *ConvertTradesToCandlesRequest) GetAssetType() string {
-	if x != nil {
-		return x.AssetType
-	}
-	return ""
+type GetExchangePairsResponse struct {
+	state           protoimpl.MessageState     `protogen:"open.v1"`
+	SupportedAssets map[string]*PairsSupported `protobuf:"bytes,1,rep,name=supported_assets,json=supportedAssets,proto3" json:"supported_assets,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *ConvertTradesToCandlesRequest) GetStart() string {
-	if x != nil {
-		return x.Start
-	}
-	return ""
+func (x *GetExchangePairsResponse) Reset() {
+	*x = GetExchangePairsResponse{}
+	mi := &file_rpc_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *ConvertTradesToCandlesRequest) GetEnd() string {
-	if x != nil {
-		return x.End
-	}
-	return ""
+func (x *GetExchangePairsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ConvertTradesToCandlesRequest) GetTimeInterval() int64 {
+func (*GetExchangePairsResponse) ProtoMessage() {}
+
+func (x *GetExchangePairsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[116]
 	if x != nil {
-		return x.TimeInterval
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *ConvertTradesToCandlesRequest) GetSync() bool {
-	if x != nil {
-		return x.Sync
-	}
-	return false
+// Deprecated: Use GetExchangePairsResponse.ProtoReflect.Descriptor instead.
+func (*GetExchangePairsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{116}
 }
 
-func (x *ConvertTradesToCandlesRequest) GetForce() bool {
+func (x *GetExchangePairsResponse) GetSupportedAssets() map[string]*PairsSupported {
 	if x != nil {
-		return x.Force
+		return x.SupportedAssets
 	}
-	return false
+	return nil
 }
 
-type GetHistoricCandlesRequest struct {
-	state                 protoimpl.MessageState `protogen:"open.v1"`
-	Exchange              string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Pair                  *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
-	AssetType             string                 `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
-	Start                 string                 `protobuf:"bytes,4,opt,name=start,proto3" json:"start,omitempty"`
-	End                   string                 `protobuf:"bytes,5,opt,name=end,proto3" json:"end,omitempty"`
-	TimeInterval          int64                  `protobuf:"varint,6,opt,name=time_interval,json=timeInterval,proto3" json:"time_interval,omitempty"`
-	ExRequest             bool                   `protobuf:"varint,7,opt,name=ex_request,json=exRequest,proto3" json:"ex_request,omitempty"`
-	Sync                  bool                   `protobuf:"varint,8,opt,name=sync,proto3" json:"sync,omitempty"`
-	UseDb                 bool                   `protobuf:"varint,9,opt,name=use_db,json=useDb,proto3" json:"use_db,omitempty"`
-	FillMissingWithTrades bool                   `protobuf:"varint,10,opt,name=fill_missing_with_trades,json=fillMissingWithTrades,proto3" json:"fill_missing_with_trades,omitempty"`
-	Force                 bool                   `protobuf:"varint,11,opt,name=force,proto3" json:"force,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+type SetExchangePairRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	AssetType     string                 `protobuf:"bytes,2,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Pairs         []*CurrencyPair        `protobuf:"bytes,3,rep,name=pairs,proto3" json:"pairs,omitempty"`
+	Enable        bool                   `protobuf:"varint,4,opt,name=enable,proto3" json:"enable,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetHistoricCandlesRequest) Reset() {
-	*x = GetHistoricCandlesRequest{}
-	mi := &file_rpc_proto_msgTypes[116]
+func (x *SetExchangePairRequest) Reset() {
+	*x = SetExchangePairRequest{}
+	mi := &file_rpc_proto_msgTypes[117]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetHistoricCandlesRequest) String() string {
+func (x *SetExchangePairRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetHistoricCandlesRequest) ProtoMessage() {}
+func (*SetExchangePairRequest) ProtoMessage() {}
 
-func (x *GetHistoricCandlesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[116]
+func (x *SetExchangePairRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[117]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7025,115 +7190,63 @@ func (x *GetHistoricCandlesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetHistoricCandlesRequest.ProtoReflect.Descriptor instead.
-func (*GetHistoricCandlesRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{116}
+// Deprecated: Use SetExchangePairRequest.ProtoReflect.Descriptor instead.
+func (*SetExchangePairRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{117}
 }
 
-func (x *GetHistoricCandlesRequest) GetExchange() string {
+func (x *SetExchangePairRequest) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *GetHistoricCandlesRequest) GetPair() *CurrencyPair {
-	if x != nil {
-		return x.Pair
-	}
-	return nil
-}
-
-func (x *GetHistoricCandlesRequest) GetAssetType() string {
+func (x *SetExchangePairRequest) GetAssetType() string {
 	if x != nil {
 		return x.AssetType
 	}
 	return ""
 }
 
-func (x *GetHistoricCandlesRequest) GetStart() string {
-	if x != nil {
-		return x.Start
-	}
-	return ""
-}
-
-func (x *GetHistoricCandlesRequest) GetEnd() string {
-	if x != nil {
-		return x.End
-	}
-	return ""
-}
-
-func (x *GetHistoricCandlesRequest) GetTimeInterval() int64 {
-	if x != nil {
-		return x.TimeInterval
-	}
-	return 0
-}
-
-func (x *GetHistoricCandlesRequest) GetExRequest() bool {
-	if x != nil {
-		return x.ExRequest
-	}
-	return false
-}
-
-func (x *GetHistoricCandlesRequest) GetSync() bool {
-	if x != nil {
-		return x.Sync
-	}
-	return false
-}
-
-func (x *GetHistoricCandlesRequest) GetUseDb() bool {
-	if x != nil {
-		return x.UseDb
-	}
-	return false
-}
-
-func (x *GetHistoricCandlesRequest) GetFillMissingWithTrades() bool {
+func (x *SetExchangePairRequest) GetPairs() []*CurrencyPair {
 	if x != nil {
-		return x.FillMissingWithTrades
+		return x.Pairs
 	}
-	return false
+	return nil
 }
 
-func (x *GetHistoricCandlesRequest) GetForce() bool {
+func (x *SetExchangePairRequest) GetEnable() bool {
 	if x != nil {
-		return x.Force
+		return x.Enable
 	}
 	return false
 }
 
-type GetHistoricCandlesResponse struct {
+type GetOrderbookStreamRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
 	Pair          *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
-	Start         string                 `protobuf:"bytes,3,opt,name=start,proto3" json:"start,omitempty"`
-	End           string                 `protobuf:"bytes,4,opt,name=end,proto3" json:"end,omitempty"`
-	Interval      string                 `protobuf:"bytes,6,opt,name=interval,proto3" json:"interval,omitempty"`
-	Candle        []*Candle              `protobuf:"bytes,5,rep,name=candle,proto3" json:"candle,omitempty"`
+	AssetType     string                 `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetHistoricCandlesResponse) Reset() {
-	*x = GetHistoricCandlesResponse{}
-	mi := &file_rpc_proto_msgTypes[117]
+func (x *GetOrderbookStreamRequest) Reset() {
+	*x = GetOrderbookStreamRequest{}
+	mi := &file_rpc_proto_msgTypes[118]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetHistoricCandlesResponse) String() string {
+func (x *GetOrderbookStreamRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetHistoricCandlesResponse) ProtoMessage() {}
+func (*GetOrderbookStreamRequest) ProtoMessage() {}
 
-func (x *GetHistoricCandlesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[117]
+func (x *GetOrderbookStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[118]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7144,81 +7257,54 @@ func (x *GetHistoricCandlesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetHistoricCandlesResponse.ProtoReflect.Descriptor instead.
-func (*GetHistoricCandlesResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{117}
+// Deprecated: Use GetOrderbookStreamRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderbookStreamRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{118}
 }
 
-func (x *GetHistoricCandlesResponse) GetExchange() string {
+func (x *GetOrderbookStreamRequest) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *GetHistoricCandlesResponse) GetPair() *CurrencyPair {
+func (x *GetOrderbookStreamRequest) GetPair() *CurrencyPair {
 	if x != nil {
 		return x.Pair
 	}
 	return nil
 }
 
-func (x *GetHistoricCandlesResponse) GetStart() string {
-	if x != nil {
-		return x.Start
-	}
-	return ""
-}
-
-func (x *GetHistoricCandlesResponse) GetEnd() string {
-	if x != nil {
-		return x.End
-	}
-	return ""
-}
-
-func (x *GetHistoricCandlesResponse) GetInterval() string {
+func (x *GetOrderbookStreamRequest) GetAssetType() string {
 	if x != nil {
-		return x.Interval
+		return x.AssetType
 	}
 	return ""
 }
 
-func (x *GetHistoricCandlesResponse) GetCandle() []*Candle {
-	if x != nil {
-		return x.Candle
-	}
-	return nil
-}
-
-type Candle struct {
+type GetExchangeOrderbookStreamRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Time          string                 `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
-	Low           float64                `protobuf:"fixed64,2,opt,name=low,proto3" json:"low,omitempty"`
-	High          float64                `protobuf:"fixed64,3,opt,name=high,proto3" json:"high,omitempty"`
-	Open          float64                `protobuf:"fixed64,4,opt,name=open,proto3" json:"open,omitempty"`
-	Close         float64                `protobuf:"fixed64,5,opt,name=close,proto3" json:"close,omitempty"`
-	Volume        float64                `protobuf:"fixed64,6,opt,name=volume,proto3" json:"volume,omitempty"`
-	IsPartial     bool                   `protobuf:"varint,7,opt,name=is_partial,json=isPartial,proto3" json:"is_partial,omitempty"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Candle) Reset() {
-	*x = Candle{}
-	mi := &file_rpc_proto_msgTypes[118]
+func (x *GetExchangeOrderbookStreamRequest) Reset() {
+	*x = GetExchangeOrderbookStreamRequest{}
+	mi := &file_rpc_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Candle) String() string {
+func (x *GetExchangeOrderbookStreamRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Candle) ProtoMessage() {}
+func (*GetExchangeOrderbookStreamRequest) ProtoMessage() {}
 
-func (x *Candle) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[118]
+func (x *GetExchangeOrderbookStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7229,85 +7315,42 @@ func (x *Candle) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Candle.ProtoReflect.Descriptor instead.
-func (*Candle) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{118}
+// Deprecated: Use GetExchangeOrderbookStreamRequest.ProtoReflect.Descriptor instead.
+func (*GetExchangeOrderbookStreamRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{119}
 }
 
-func (x *Candle) GetTime() string {
+func (x *GetExchangeOrderbookStreamRequest) GetExchange() string {
 	if x != nil {
-		return x.Time
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *Candle) GetLow() float64 {
-	if x != nil {
-		return x.Low
-	}
-	return 0
-}
-
-func (x *Candle) GetHigh() float64 {
-	if x != nil {
-		return x.High
-	}
-	return 0
-}
-
-func (x *Candle) GetOpen() float64 {
-	if x != nil {
-		return x.Open
-	}
-	return 0
-}
-
-func (x *Candle) GetClose() float64 {
-	if x != nil {
-		return x.Close
-	}
-	return 0
-}
-
-func (x *Candle) GetVolume() float64 {
-	if x != nil {
-		return x.Volume
-	}
-	return 0
-}
-
-func (x *Candle) GetIsPartial() bool {
-	if x != nil {
-		return x.IsPartial
-	}
-	return false
-}
-
-type AuditEvent struct {
+type GetTickerStreamRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
-	Identifier    string                 `protobuf:"bytes,2,opt,name=identifier,proto3" json:"identifier,omitempty"`
-	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	Timestamp     string                 `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType     string                 `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AuditEvent) Reset() {
-	*x = AuditEvent{}
-	mi := &file_rpc_proto_msgTypes[119]
+func (x *GetTickerStreamRequest) Reset() {
+	*x = GetTickerStreamRequest{}
+	mi := &file_rpc_proto_msgTypes[120]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AuditEvent) String() string {
+func (x *GetTickerStreamRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuditEvent) ProtoMessage() {}
+func (*GetTickerStreamRequest) ProtoMessage() {}
 
-func (x *AuditEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[119]
+func (x *GetTickerStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[120]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7318,64 +7361,54 @@ func (x *AuditEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuditEvent.ProtoReflect.Descriptor instead.
-func (*AuditEvent) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{119}
-}
-
-func (x *AuditEvent) GetType() string {
-	if x != nil {
-		return x.Type
-	}
-	return ""
+// Deprecated: Use GetTickerStreamRequest.ProtoReflect.Descriptor instead.
+func (*GetTickerStreamRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{120}
 }
 
-func (x *AuditEvent) GetIdentifier() string {
+func (x *GetTickerStreamRequest) GetExchange() string {
 	if x != nil {
-		return x.Identifier
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *AuditEvent) GetMessage() string {
+func (x *GetTickerStreamRequest) GetPair() *CurrencyPair {
 	if x != nil {
-		return x.Message
+		return x.Pair
 	}
-	return ""
+	return nil
 }
 
-func (x *AuditEvent) GetTimestamp() string {
+func (x *GetTickerStreamRequest) GetAssetType() string {
 	if x != nil {
-		return x.Timestamp
+		return x.AssetType
 	}
 	return ""
 }
 
-type GCTScript struct {
+type GetExchangeTickerStreamRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Path          string                 `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
-	NextRun       string                 `protobuf:"bytes,4,opt,name=next_run,json=nextRun,proto3" json:"next_run,omitempty"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GCTScript) Reset() {
-	*x = GCTScript{}
-	mi := &file_rpc_proto_msgTypes[120]
+func (x *GetExchangeTickerStreamRequest) Reset() {
+	*x = GetExchangeTickerStreamRequest{}
+	mi := &file_rpc_proto_msgTypes[121]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GCTScript) String() string {
+func (x *GetExchangeTickerStreamRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GCTScript) ProtoMessage() {}
+func (*GetExchangeTickerStreamRequest) ProtoMessage() {}
 
-func (x *GCTScript) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[120]
+func (x *GetExchangeTickerStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[121]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7386,61 +7419,44 @@ func (x *GCTScript) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GCTScript.ProtoReflect.Descriptor instead.
-func (*GCTScript) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{120}
-}
-
-func (x *GCTScript) GetUuid() string {
-	if x != nil {
-		return x.Uuid
-	}
-	return ""
-}
-
-func (x *GCTScript) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *GCTScript) GetPath() string {
-	if x != nil {
-		return x.Path
-	}
-	return ""
+// Deprecated: Use GetExchangeTickerStreamRequest.ProtoReflect.Descriptor instead.
+func (*GetExchangeTickerStreamRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{121}
 }
 
-func (x *GCTScript) GetNextRun() string {
+func (x *GetExchangeTickerStreamRequest) GetExchange() string {
 	if x != nil {
-		return x.NextRun
+		return x.Exchange
 	}
 	return ""
 }
 
-type GCTScriptExecuteRequest struct {
+type GetAuditEventRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Script        *GCTScript             `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
+	StartDate     string                 `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       string                 `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	OrderBy       string                 `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GCTScriptExecuteRequest) Reset() {
-	*x = GCTScriptExecuteRequest{}
-	mi := &file_rpc_proto_msgTypes[121]
+func (x *GetAuditEventRequest) Reset() {
+	*x = GetAuditEventRequest{}
+	mi := &file_rpc_proto_msgTypes[122]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GCTScriptExecuteRequest) String() string {
+func (x *GetAuditEventRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GCTScriptExecuteRequest) ProtoMessage() {}
+func (*GetAuditEventRequest) ProtoMessage() {}
 
-func (x *GCTScriptExecuteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[121]
+func (x *GetAuditEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[122]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7451,82 +7467,67 @@ func (x *GCTScriptExecuteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GCTScriptExecuteRequest.ProtoReflect.Descriptor instead.
-func (*GCTScriptExecuteRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{121}
+// Deprecated: Use GetAuditEventRequest.ProtoReflect.Descriptor instead.
+func (*GetAuditEventRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{122}
 }
 
-func (x *GCTScriptExecuteRequest) GetScript() *GCTScript {
+func (x *GetAuditEventRequest) GetStartDate() string {
 	if x != nil {
-		return x.Script
+		return x.StartDate
 	}
-	return nil
-}
-
-type GCTScriptStopRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Script        *GCTScript             `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GCTScriptStopRequest) Reset() {
-	*x = GCTScriptStopRequest{}
-	mi := &file_rpc_proto_msgTypes[122]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+	return ""
 }
 
-func (x *GCTScriptStopRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetAuditEventRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
 }
 
-func (*GCTScriptStopRequest) ProtoMessage() {}
-
-func (x *GCTScriptStopRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[122]
+func (x *GetAuditEventRequest) GetOrderBy() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.OrderBy
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GCTScriptStopRequest.ProtoReflect.Descriptor instead.
-func (*GCTScriptStopRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{122}
+func (x *GetAuditEventRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
 }
 
-func (x *GCTScriptStopRequest) GetScript() *GCTScript {
+func (x *GetAuditEventRequest) GetOffset() int32 {
 	if x != nil {
-		return x.Script
+		return x.Offset
 	}
-	return nil
+	return 0
 }
 
-type GCTScriptStopAllRequest struct {
+type GetAuditEventResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*AuditEvent          `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GCTScriptStopAllRequest) Reset() {
-	*x = GCTScriptStopAllRequest{}
+func (x *GetAuditEventResponse) Reset() {
+	*x = GetAuditEventResponse{}
 	mi := &file_rpc_proto_msgTypes[123]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GCTScriptStopAllRequest) String() string {
+func (x *GetAuditEventResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GCTScriptStopAllRequest) ProtoMessage() {}
+func (*GetAuditEventResponse) ProtoMessage() {}
 
-func (x *GCTScriptStopAllRequest) ProtoReflect() protoreflect.Message {
+func (x *GetAuditEventResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[123]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7538,31 +7539,43 @@ func (x *GCTScriptStopAllRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GCTScriptStopAllRequest.ProtoReflect.Descriptor instead.
-func (*GCTScriptStopAllRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetAuditEventResponse.ProtoReflect.Descriptor instead.
+func (*GetAuditEventResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{123}
 }
 
-type GCTScriptStatusRequest struct {
+func (x *GetAuditEventResponse) GetEvents() []*AuditEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type GetSavedTradesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType     string                 `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Start         string                 `protobuf:"bytes,4,opt,name=start,proto3" json:"start,omitempty"`
+	End           string                 `protobuf:"bytes,5,opt,name=end,proto3" json:"end,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GCTScriptStatusRequest) Reset() {
-	*x = GCTScriptStatusRequest{}
+func (x *GetSavedTradesRequest) Reset() {
+	*x = GetSavedTradesRequest{}
 	mi := &file_rpc_proto_msgTypes[124]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GCTScriptStatusRequest) String() string {
+func (x *GetSavedTradesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GCTScriptStatusRequest) ProtoMessage() {}
+func (*GetSavedTradesRequest) ProtoMessage() {}
 
-func (x *GCTScriptStatusRequest) ProtoReflect() protoreflect.Message {
+func (x *GetSavedTradesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[124]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7574,73 +7587,72 @@ func (x *GCTScriptStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GCTScriptStatusRequest.ProtoReflect.Descriptor instead.
-func (*GCTScriptStatusRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSavedTradesRequest.ProtoReflect.Descriptor instead.
+func (*GetSavedTradesRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{124}
 }
 
-type GCTScriptListAllRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GetSavedTradesRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
 }
 
-func (x *GCTScriptListAllRequest) Reset() {
-	*x = GCTScriptListAllRequest{}
-	mi := &file_rpc_proto_msgTypes[125]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *GetSavedTradesRequest) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
 }
 
-func (x *GCTScriptListAllRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetSavedTradesRequest) GetAssetType() string {
+	if x != nil {
+		return x.AssetType
+	}
+	return ""
 }
 
-func (*GCTScriptListAllRequest) ProtoMessage() {}
-
-func (x *GCTScriptListAllRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[125]
+func (x *GetSavedTradesRequest) GetStart() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Start
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GCTScriptListAllRequest.ProtoReflect.Descriptor instead.
-func (*GCTScriptListAllRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{125}
+func (x *GetSavedTradesRequest) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
 }
 
-type GCTScriptUploadRequest struct {
+type SavedTrades struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ScriptName    string                 `protobuf:"bytes,1,opt,name=script_name,json=scriptName,proto3" json:"script_name,omitempty"`
-	ScriptData    string                 `protobuf:"bytes,2,opt,name=script_data,json=scriptData,proto3" json:"script_data,omitempty"`
-	Data          []byte                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
-	Archived      bool                   `protobuf:"varint,4,opt,name=archived,proto3" json:"archived,omitempty"`
-	Overwrite     bool                   `protobuf:"varint,5,opt,name=overwrite,proto3" json:"overwrite,omitempty"`
+	Price         float64                `protobuf:"fixed64,1,opt,name=price,proto3" json:"price,omitempty"`
+	Amount        float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Side          string                 `protobuf:"bytes,3,opt,name=side,proto3" json:"side,omitempty"`
+	Timestamp     string                 `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	TradeId       string                 `protobuf:"bytes,5,opt,name=trade_id,json=tradeId,proto3" json:"trade_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GCTScriptUploadRequest) Reset() {
-	*x = GCTScriptUploadRequest{}
-	mi := &file_rpc_proto_msgTypes[126]
+func (x *SavedTrades) Reset() {
+	*x = SavedTrades{}
+	mi := &file_rpc_proto_msgTypes[125]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GCTScriptUploadRequest) String() string {
+func (x *SavedTrades) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GCTScriptUploadRequest) ProtoMessage() {}
+func (*SavedTrades) ProtoMessage() {}
 
-func (x *GCTScriptUploadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[126]
+func (x *SavedTrades) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[125]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7651,68 +7663,71 @@ func (x *GCTScriptUploadRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GCTScriptUploadRequest.ProtoReflect.Descriptor instead.
-func (*GCTScriptUploadRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{126}
+// Deprecated: Use SavedTrades.ProtoReflect.Descriptor instead.
+func (*SavedTrades) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{125}
 }
 
-func (x *GCTScriptUploadRequest) GetScriptName() string {
+func (x *SavedTrades) GetPrice() float64 {
 	if x != nil {
-		return x.ScriptName
+		return x.Price
 	}
-	return ""
+	return 0
 }
 
-func (x *GCTScriptUploadRequest) GetScriptData() string {
+func (x *SavedTrades) GetAmount() float64 {
 	if x != nil {
-		return x.ScriptData
+		return x.Amount
 	}
-	return ""
+	return 0
 }
 
-func (x *GCTScriptUploadRequest) GetData() []byte {
+func (x *SavedTrades) GetSide() string {
 	if x != nil {
-		return x.Data
+		return x.Side
 	}
-	return nil
+	return ""
 }
 
-func (x *GCTScriptUploadRequest) GetArchived() bool {
+func (x *SavedTrades) GetTimestamp() string {
 	if x != nil {
-		return x.Archived
+		return x.Timestamp
 	}
-	return false
+	return ""
 }
 
-func (x *GCTScriptUploadRequest) GetOverwrite() bool {
+func (x *SavedTrades) GetTradeId() string {
 	if x != nil {
-		return x.Overwrite
+		return x.TradeId
 	}
-	return false
+	return ""
 }
 
-type GCTScriptReadScriptRequest struct {
+type SavedTradesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Script        *GCTScript             `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
+	ExchangeName  string                 `protobuf:"bytes,1,opt,name=exchange_name,json=exchangeName,proto3" json:"exchange_name,omitempty"`
+	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	Trades        []*SavedTrades         `protobuf:"bytes,4,rep,name=trades,proto3" json:"trades,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GCTScriptReadScriptRequest) Reset() {
-	*x = GCTScriptReadScriptRequest{}
-	mi := &file_rpc_proto_msgTypes[127]
+func (x *SavedTradesResponse) Reset() {
+	*x = SavedTradesResponse{}
+	mi := &file_rpc_proto_msgTypes[126]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GCTScriptReadScriptRequest) String() string {
+func (x *SavedTradesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GCTScriptReadScriptRequest) ProtoMessage() {}
+func (*SavedTradesResponse) ProtoMessage() {}
 
-func (x *GCTScriptReadScriptRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[127]
+func (x *SavedTradesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[126]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7723,85 +7738,68 @@ func (x *GCTScriptReadScriptRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GCTScriptReadScriptRequest.ProtoReflect.Descriptor instead.
-func (*GCTScriptReadScriptRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{127}
+// Deprecated: Use SavedTradesResponse.ProtoReflect.Descriptor instead.
+func (*SavedTradesResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{126}
 }
 
-func (x *GCTScriptReadScriptRequest) GetScript() *GCTScript {
+func (x *SavedTradesResponse) GetExchangeName() string {
 	if x != nil {
-		return x.Script
+		return x.ExchangeName
 	}
-	return nil
-}
-
-type GCTScriptQueryRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Script        *GCTScript             `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GCTScriptQueryRequest) Reset() {
-	*x = GCTScriptQueryRequest{}
-	mi := &file_rpc_proto_msgTypes[128]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GCTScriptQueryRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return ""
 }
 
-func (*GCTScriptQueryRequest) ProtoMessage() {}
-
-func (x *GCTScriptQueryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[128]
+func (x *SavedTradesResponse) GetAsset() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Asset
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GCTScriptQueryRequest.ProtoReflect.Descriptor instead.
-func (*GCTScriptQueryRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{128}
+func (x *SavedTradesResponse) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
 }
 
-func (x *GCTScriptQueryRequest) GetScript() *GCTScript {
+func (x *SavedTradesResponse) GetTrades() []*SavedTrades {
 	if x != nil {
-		return x.Script
+		return x.Trades
 	}
 	return nil
 }
 
-type GCTScriptAutoLoadRequest struct {
+type ConvertTradesToCandlesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Script        string                 `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
-	Status        bool                   `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType     string                 `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Start         string                 `protobuf:"bytes,4,opt,name=start,proto3" json:"start,omitempty"`
+	End           string                 `protobuf:"bytes,5,opt,name=end,proto3" json:"end,omitempty"`
+	TimeInterval  int64                  `protobuf:"varint,6,opt,name=time_interval,json=timeInterval,proto3" json:"time_interval,omitempty"`
+	Sync          bool                   `protobuf:"varint,7,opt,name=sync,proto3" json:"sync,omitempty"`
+	Force         bool                   `protobuf:"varint,8,opt,name=force,proto3" json:"force,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GCTScriptAutoLoadRequest) Reset() {
-	*x = GCTScriptAutoLoadRequest{}
-	mi := &file_rpc_proto_msgTypes[129]
+func (x *ConvertTradesToCandlesRequest) Reset() {
+	*x = ConvertTradesToCandlesRequest{}
+	mi := &file_rpc_proto_msgTypes[127]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GCTScriptAutoLoadRequest) String() string {
+func (x *ConvertTradesToCandlesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GCTScriptAutoLoadRequest) ProtoMessage() {}
+func (*ConvertTradesToCandlesRequest) ProtoMessage() {}
 
-func (x *GCTScriptAutoLoadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[129]
+func (x *ConvertTradesToCandlesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[127]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7812,101 +7810,99 @@ func (x *GCTScriptAutoLoadRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GCTScriptAutoLoadRequest.ProtoReflect.Descriptor instead.
-func (*GCTScriptAutoLoadRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{129}
+// Deprecated: Use ConvertTradesToCandlesRequest.ProtoReflect.Descriptor instead.
+func (*ConvertTradesToCandlesRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{127}
 }
 
-func (x *GCTScriptAutoLoadRequest) GetScript() string {
+func (x *ConvertTradesToCandlesRequest) GetExchange() string {
 	if x != nil {
-		return x.Script
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *GCTScriptAutoLoadRequest) GetStatus() bool {
+func (x *ConvertTradesToCandlesRequest) GetPair() *CurrencyPair {
 	if x != nil {
-		return x.Status
+		return x.Pair
 	}
-	return false
-}
-
-type GCTScriptStatusResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Scripts       []*GCTScript           `protobuf:"bytes,2,rep,name=scripts,proto3" json:"scripts,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return nil
 }
 
-func (x *GCTScriptStatusResponse) Reset() {
-	*x = GCTScriptStatusResponse{}
-	mi := &file_rpc_proto_msgTypes[130]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ConvertTradesToCandlesRequest) GetAssetType() string {
+	if x != nil {
+		return x.AssetType
+	}
+	return ""
 }
 
-func (x *GCTScriptStatusResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ConvertTradesToCandlesRequest) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
 }
 
-func (*GCTScriptStatusResponse) ProtoMessage() {}
-
-func (x *GCTScriptStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[130]
+func (x *ConvertTradesToCandlesRequest) GetEnd() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.End
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GCTScriptStatusResponse.ProtoReflect.Descriptor instead.
-func (*GCTScriptStatusResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{130}
+func (x *ConvertTradesToCandlesRequest) GetTimeInterval() int64 {
+	if x != nil {
+		return x.TimeInterval
+	}
+	return 0
 }
 
-func (x *GCTScriptStatusResponse) GetStatus() string {
+func (x *ConvertTradesToCandlesRequest) GetSync() bool {
 	if x != nil {
-		return x.Status
+		return x.Sync
 	}
-	return ""
+	return false
 }
 
-func (x *GCTScriptStatusResponse) GetScripts() []*GCTScript {
+func (x *ConvertTradesToCandlesRequest) GetForce() bool {
 	if x != nil {
-		return x.Scripts
+		return x.Force
 	}
-	return nil
+	return false
 }
 
-type GCTScriptQueryResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Script        *GCTScript             `protobuf:"bytes,2,opt,name=script,proto3" json:"script,omitempty"`
-	Data          string                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetHistoricCandlesRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Exchange              string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                  *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType             string                 `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Start                 string                 `protobuf:"bytes,4,opt,name=start,proto3" json:"start,omitempty"`
+	End                   string                 `protobuf:"bytes,5,opt,name=end,proto3" json:"end,omitempty"`
+	TimeInterval          int64                  `protobuf:"varint,6,opt,name=time_interval,json=timeInterval,proto3" json:"time_interval,omitempty"`
+	ExRequest             bool                   `protobuf:"varint,7,opt,name=ex_request,json=exRequest,proto3" json:"ex_request,omitempty"`
+	Sync                  bool                   `protobuf:"varint,8,opt,name=sync,proto3" json:"sync,omitempty"`
+	UseDb                 bool                   `protobuf:"varint,9,opt,name=use_db,json=useDb,proto3" json:"use_db,omitempty"`
+	FillMissingWithTrades bool                   `protobuf:"varint,10,opt,name=fill_missing_with_trades,json=fillMissingWithTrades,proto3" json:"fill_missing_with_trades,omitempty"`
+	Force                 bool                   `protobuf:"varint,11,opt,name=force,proto3" json:"force,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
-func (x *GCTScriptQueryResponse) Reset() {
-	*x = GCTScriptQueryResponse{}
-	mi := &file_rpc_proto_msgTypes[131]
+func (x *GetHistoricCandlesRequest) Reset() {
+	*x = GetHistoricCandlesRequest{}
+	mi := &file_rpc_proto_msgTypes[128]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GCTScriptQueryResponse) String() string {
+func (x *GetHistoricCandlesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GCTScriptQueryResponse) ProtoMessage() {}
+func (*GetHistoricCandlesRequest) ProtoMessage() {}
 
-func (x *GCTScriptQueryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[131]
+func (x *GetHistoricCandlesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[128]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7917,108 +7913,115 @@ func (x *GCTScriptQueryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GCTScriptQueryResponse.ProtoReflect.Descriptor instead.
-func (*GCTScriptQueryResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{131}
+// Deprecated: Use GetHistoricCandlesRequest.ProtoReflect.Descriptor instead.
+func (*GetHistoricCandlesRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{128}
 }
 
-func (x *GCTScriptQueryResponse) GetStatus() string {
+func (x *GetHistoricCandlesRequest) GetExchange() string {
 	if x != nil {
-		return x.Status
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *GCTScriptQueryResponse) GetScript() *GCTScript {
+func (x *GetHistoricCandlesRequest) GetPair() *CurrencyPair {
 	if x != nil {
-		return x.Script
+		return x.Pair
 	}
 	return nil
 }
 
-func (x *GCTScriptQueryResponse) GetData() string {
+func (x *GetHistoricCandlesRequest) GetAssetType() string {
 	if x != nil {
-		return x.Data
+		return x.AssetType
 	}
 	return ""
 }
 
-type GenericResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Data          string                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GetHistoricCandlesRequest) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
 }
 
-func (x *GenericResponse) Reset() {
-	*x = GenericResponse{}
-	mi := &file_rpc_proto_msgTypes[132]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *GetHistoricCandlesRequest) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
 }
 
-func (x *GenericResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetHistoricCandlesRequest) GetTimeInterval() int64 {
+	if x != nil {
+		return x.TimeInterval
+	}
+	return 0
 }
 
-func (*GenericResponse) ProtoMessage() {}
+func (x *GetHistoricCandlesRequest) GetExRequest() bool {
+	if x != nil {
+		return x.ExRequest
+	}
+	return false
+}
 
-func (x *GenericResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[132]
+func (x *GetHistoricCandlesRequest) GetSync() bool {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Sync
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use GenericResponse.ProtoReflect.Descriptor instead.
-func (*GenericResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{132}
+func (x *GetHistoricCandlesRequest) GetUseDb() bool {
+	if x != nil {
+		return x.UseDb
+	}
+	return false
 }
 
-func (x *GenericResponse) GetStatus() string {
+func (x *GetHistoricCandlesRequest) GetFillMissingWithTrades() bool {
 	if x != nil {
-		return x.Status
+		return x.FillMissingWithTrades
 	}
-	return ""
+	return false
 }
 
-func (x *GenericResponse) GetData() string {
+func (x *GetHistoricCandlesRequest) GetForce() bool {
 	if x != nil {
-		return x.Data
+		return x.Force
 	}
-	return ""
+	return false
 }
 
-type SetExchangeAssetRequest struct {
+type GetHistoricCandlesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Enable        bool                   `protobuf:"varint,3,opt,name=enable,proto3" json:"enable,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	Start         string                 `protobuf:"bytes,3,opt,name=start,proto3" json:"start,omitempty"`
+	End           string                 `protobuf:"bytes,4,opt,name=end,proto3" json:"end,omitempty"`
+	Interval      string                 `protobuf:"bytes,6,opt,name=interval,proto3" json:"interval,omitempty"`
+	Candle        []*Candle              `protobuf:"bytes,5,rep,name=candle,proto3" json:"candle,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetExchangeAssetRequest) Reset() {
-	*x = SetExchangeAssetRequest{}
-	mi := &file_rpc_proto_msgTypes[133]
+func (x *GetHistoricCandlesResponse) Reset() {
+	*x = GetHistoricCandlesResponse{}
+	mi := &file_rpc_proto_msgTypes[129]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetExchangeAssetRequest) String() string {
+func (x *GetHistoricCandlesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetExchangeAssetRequest) ProtoMessage() {}
+func (*GetHistoricCandlesResponse) ProtoMessage() {}
 
-func (x *SetExchangeAssetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[133]
+func (x *GetHistoricCandlesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[129]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8029,106 +8032,81 @@ func (x *SetExchangeAssetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetExchangeAssetRequest.ProtoReflect.Descriptor instead.
-func (*SetExchangeAssetRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{133}
+// Deprecated: Use GetHistoricCandlesResponse.ProtoReflect.Descriptor instead.
+func (*GetHistoricCandlesResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{129}
 }
 
-func (x *SetExchangeAssetRequest) GetExchange() string {
+func (x *GetHistoricCandlesResponse) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *SetExchangeAssetRequest) GetAsset() string {
+func (x *GetHistoricCandlesResponse) GetPair() *CurrencyPair {
 	if x != nil {
-		return x.Asset
+		return x.Pair
 	}
-	return ""
+	return nil
 }
 
-func (x *SetExchangeAssetRequest) GetEnable() bool {
+func (x *GetHistoricCandlesResponse) GetStart() string {
 	if x != nil {
-		return x.Enable
+		return x.Start
 	}
-	return false
-}
-
-type SetExchangeAllPairsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Enable        bool                   `protobuf:"varint,2,opt,name=enable,proto3" json:"enable,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *SetExchangeAllPairsRequest) Reset() {
-	*x = SetExchangeAllPairsRequest{}
-	mi := &file_rpc_proto_msgTypes[134]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *SetExchangeAllPairsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return ""
 }
 
-func (*SetExchangeAllPairsRequest) ProtoMessage() {}
-
-func (x *SetExchangeAllPairsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[134]
+func (x *GetHistoricCandlesResponse) GetEnd() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.End
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use SetExchangeAllPairsRequest.ProtoReflect.Descriptor instead.
-func (*SetExchangeAllPairsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{134}
+	return ""
 }
 
-func (x *SetExchangeAllPairsRequest) GetExchange() string {
+func (x *GetHistoricCandlesResponse) GetInterval() string {
 	if x != nil {
-		return x.Exchange
+		return x.Interval
 	}
 	return ""
 }
 
-func (x *SetExchangeAllPairsRequest) GetEnable() bool {
+func (x *GetHistoricCandlesResponse) GetCandle() []*Candle {
 	if x != nil {
-		return x.Enable
+		return x.Candle
 	}
-	return false
+	return nil
 }
 
-type UpdateExchangeSupportedPairsRequest struct {
+type Candle struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Time          string                 `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	Low           float64                `protobuf:"fixed64,2,opt,name=low,proto3" json:"low,omitempty"`
+	High          float64                `protobuf:"fixed64,3,opt,name=high,proto3" json:"high,omitempty"`
+	Open          float64                `protobuf:"fixed64,4,opt,name=open,proto3" json:"open,omitempty"`
+	Close         float64                `protobuf:"fixed64,5,opt,name=close,proto3" json:"close,omitempty"`
+	Volume        float64                `protobuf:"fixed64,6,opt,name=volume,proto3" json:"volume,omitempty"`
+	IsPartial     bool                   `protobuf:"varint,7,opt,name=is_partial,json=isPartial,proto3" json:"is_partial,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateExchangeSupportedPairsRequest) Reset() {
-	*x = UpdateExchangeSupportedPairsRequest{}
-	mi := &file_rpc_proto_msgTypes[135]
+func (x *Candle) Reset() {
+	*x = Candle{}
+	mi := &file_rpc_proto_msgTypes[130]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateExchangeSupportedPairsRequest) String() string {
+func (x *Candle) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateExchangeSupportedPairsRequest) ProtoMessage() {}
+func (*Candle) ProtoMessage() {}
 
-func (x *UpdateExchangeSupportedPairsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[135]
+func (x *Candle) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[130]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8139,84 +8117,85 @@ func (x *UpdateExchangeSupportedPairsRequest) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateExchangeSupportedPairsRequest.ProtoReflect.Descriptor instead.
-func (*UpdateExchangeSupportedPairsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{135}
+// Deprecated: Use Candle.ProtoReflect.Descriptor instead.
+func (*Candle) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{130}
 }
 
-func (x *UpdateExchangeSupportedPairsRequest) GetExchange() string {
+func (x *Candle) GetTime() string {
 	if x != nil {
-		return x.Exchange
+		return x.Time
 	}
 	return ""
 }
 
-type GetExchangeAssetsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Candle) GetLow() float64 {
+	if x != nil {
+		return x.Low
+	}
+	return 0
 }
 
-func (x *GetExchangeAssetsRequest) Reset() {
-	*x = GetExchangeAssetsRequest{}
-	mi := &file_rpc_proto_msgTypes[136]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Candle) GetHigh() float64 {
+	if x != nil {
+		return x.High
+	}
+	return 0
 }
 
-func (x *GetExchangeAssetsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Candle) GetOpen() float64 {
+	if x != nil {
+		return x.Open
+	}
+	return 0
 }
 
-func (*GetExchangeAssetsRequest) ProtoMessage() {}
-
-func (x *GetExchangeAssetsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[136]
+func (x *Candle) GetClose() float64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Close
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GetExchangeAssetsRequest.ProtoReflect.Descriptor instead.
-func (*GetExchangeAssetsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{136}
+func (x *Candle) GetVolume() float64 {
+	if x != nil {
+		return x.Volume
+	}
+	return 0
 }
 
-func (x *GetExchangeAssetsRequest) GetExchange() string {
+func (x *Candle) GetIsPartial() bool {
 	if x != nil {
-		return x.Exchange
+		return x.IsPartial
 	}
-	return ""
+	return false
 }
 
-type GetExchangeAssetsResponse struct {
+type AuditEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Assets        string                 `protobuf:"bytes,1,opt,name=assets,proto3" json:"assets,omitempty"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Identifier    string                 `protobuf:"bytes,2,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Timestamp     string                 `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetExchangeAssetsResponse) Reset() {
-	*x = GetExchangeAssetsResponse{}
-	mi := &file_rpc_proto_msgTypes[137]
+func (x *AuditEvent) Reset() {
+	*x = AuditEvent{}
+	mi := &file_rpc_proto_msgTypes[131]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetExchangeAssetsResponse) String() string {
+func (x *AuditEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetExchangeAssetsResponse) ProtoMessage() {}
+func (*AuditEvent) ProtoMessage() {}
 
-func (x *GetExchangeAssetsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[137]
+func (x *AuditEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[131]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8227,90 +8206,64 @@ func (x *GetExchangeAssetsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetExchangeAssetsResponse.ProtoReflect.Descriptor instead.
-func (*GetExchangeAssetsResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{137}
+// Deprecated: Use AuditEvent.ProtoReflect.Descriptor instead.
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{131}
 }
 
-func (x *GetExchangeAssetsResponse) GetAssets() string {
+func (x *AuditEvent) GetType() string {
 	if x != nil {
-		return x.Assets
+		return x.Type
 	}
 	return ""
 }
 
-type WebsocketGetInfoRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *WebsocketGetInfoRequest) Reset() {
-	*x = WebsocketGetInfoRequest{}
-	mi := &file_rpc_proto_msgTypes[138]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *WebsocketGetInfoRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*WebsocketGetInfoRequest) ProtoMessage() {}
-
-func (x *WebsocketGetInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[138]
+func (x *AuditEvent) GetIdentifier() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Identifier
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use WebsocketGetInfoRequest.ProtoReflect.Descriptor instead.
-func (*WebsocketGetInfoRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{138}
+func (x *AuditEvent) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
 }
 
-func (x *WebsocketGetInfoRequest) GetExchange() string {
+func (x *AuditEvent) GetTimestamp() string {
 	if x != nil {
-		return x.Exchange
+		return x.Timestamp
 	}
 	return ""
 }
 
-type WebsocketGetInfoResponse struct {
-	state                  protoimpl.MessageState `protogen:"open.v1"`
-	Exchange               string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Supported              bool                   `protobuf:"varint,2,opt,name=supported,proto3" json:"supported,omitempty"`
-	Enabled                bool                   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
-	AuthenticatedSupported bool                   `protobuf:"varint,4,opt,name=authenticated_supported,json=authenticatedSupported,proto3" json:"authenticated_supported,omitempty"`
-	Authenticated          bool                   `protobuf:"varint,5,opt,name=authenticated,proto3" json:"authenticated,omitempty"`
-	RunningUrl             string                 `protobuf:"bytes,6,opt,name=running_url,json=runningUrl,proto3" json:"running_url,omitempty"`
-	ProxyAddress           string                 `protobuf:"bytes,7,opt,name=proxy_address,json=proxyAddress,proto3" json:"proxy_address,omitempty"`
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+type GCTScript struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Path          string                 `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	NextRun       string                 `protobuf:"bytes,4,opt,name=next_run,json=nextRun,proto3" json:"next_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WebsocketGetInfoResponse) Reset() {
-	*x = WebsocketGetInfoResponse{}
-	mi := &file_rpc_proto_msgTypes[139]
+func (x *GCTScript) Reset() {
+	*x = GCTScript{}
+	mi := &file_rpc_proto_msgTypes[132]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WebsocketGetInfoResponse) String() string {
+func (x *GCTScript) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WebsocketGetInfoResponse) ProtoMessage() {}
+func (*GCTScript) ProtoMessage() {}
 
-func (x *WebsocketGetInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[139]
+func (x *GCTScript) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[132]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8321,83 +8274,61 @@ func (x *WebsocketGetInfoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WebsocketGetInfoResponse.ProtoReflect.Descriptor instead.
-func (*WebsocketGetInfoResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{139}
+// Deprecated: Use GCTScript.ProtoReflect.Descriptor instead.
+func (*GCTScript) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{132}
 }
 
-func (x *WebsocketGetInfoResponse) GetExchange() string {
+func (x *GCTScript) GetUuid() string {
 	if x != nil {
-		return x.Exchange
+		return x.Uuid
 	}
 	return ""
 }
 
-func (x *WebsocketGetInfoResponse) GetSupported() bool {
-	if x != nil {
-		return x.Supported
-	}
-	return false
-}
-
-func (x *WebsocketGetInfoResponse) GetEnabled() bool {
-	if x != nil {
-		return x.Enabled
-	}
-	return false
-}
-
-func (x *WebsocketGetInfoResponse) GetAuthenticatedSupported() bool {
-	if x != nil {
-		return x.AuthenticatedSupported
-	}
-	return false
-}
-
-func (x *WebsocketGetInfoResponse) GetAuthenticated() bool {
+func (x *GCTScript) GetName() string {
 	if x != nil {
-		return x.Authenticated
+		return x.Name
 	}
-	return false
+	return ""
 }
 
-func (x *WebsocketGetInfoResponse) GetRunningUrl() string {
+func (x *GCTScript) GetPath() string {
 	if x != nil {
-		return x.RunningUrl
+		return x.Path
 	}
 	return ""
 }
 
-func (x *WebsocketGetInfoResponse) GetProxyAddress() string {
+func (x *GCTScript) GetNextRun() string {
 	if x != nil {
-		return x.ProxyAddress
+		return x.NextRun
 	}
 	return ""
 }
 
-type WebsocketSetEnabledRequest struct {
+type GCTScriptExecuteRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Enable        bool                   `protobuf:"varint,2,opt,name=enable,proto3" json:"enable,omitempty"`
+	Script        *GCTScript             `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WebsocketSetEnabledRequest) Reset() {
-	*x = WebsocketSetEnabledRequest{}
-	mi := &file_rpc_proto_msgTypes[140]
+func (x *GCTScriptExecuteRequest) Reset() {
+	*x = GCTScriptExecuteRequest{}
+	mi := &file_rpc_proto_msgTypes[133]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WebsocketSetEnabledRequest) String() string {
+func (x *GCTScriptExecuteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WebsocketSetEnabledRequest) ProtoMessage() {}
+func (*GCTScriptExecuteRequest) ProtoMessage() {}
 
-func (x *WebsocketSetEnabledRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[140]
+func (x *GCTScriptExecuteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[133]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8408,47 +8339,40 @@ func (x *WebsocketSetEnabledRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WebsocketSetEnabledRequest.ProtoReflect.Descriptor instead.
-func (*WebsocketSetEnabledRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{140}
-}
-
-func (x *WebsocketSetEnabledRequest) GetExchange() string {
-	if x != nil {
-		return x.Exchange
-	}
-	return ""
+// Deprecated: Use GCTScriptExecuteRequest.ProtoReflect.Descriptor instead.
+func (*GCTScriptExecuteRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{133}
 }
 
-func (x *WebsocketSetEnabledRequest) GetEnable() bool {
+func (x *GCTScriptExecuteRequest) GetScript() *GCTScript {
 	if x != nil {
-		return x.Enable
+		return x.Script
 	}
-	return false
+	return nil
 }
 
-type WebsocketGetSubscriptionsRequest struct {
+type GCTScriptStopRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Script        *GCTScript             `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WebsocketGetSubscriptionsRequest) Reset() {
-	*x = WebsocketGetSubscriptionsRequest{}
-	mi := &file_rpc_proto_msgTypes[141]
+func (x *GCTScriptStopRequest) Reset() {
+	*x = GCTScriptStopRequest{}
+	mi := &file_rpc_proto_msgTypes[134]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WebsocketGetSubscriptionsRequest) String() string {
+func (x *GCTScriptStopRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WebsocketGetSubscriptionsRequest) ProtoMessage() {}
+func (*GCTScriptStopRequest) ProtoMessage() {}
 
-func (x *WebsocketGetSubscriptionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[141]
+func (x *GCTScriptStopRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[134]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8459,43 +8383,39 @@ func (x *WebsocketGetSubscriptionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WebsocketGetSubscriptionsRequest.ProtoReflect.Descriptor instead.
-func (*WebsocketGetSubscriptionsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{141}
+// Deprecated: Use GCTScriptStopRequest.ProtoReflect.Descriptor instead.
+func (*GCTScriptStopRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{134}
 }
 
-func (x *WebsocketGetSubscriptionsRequest) GetExchange() string {
+func (x *GCTScriptStopRequest) GetScript() *GCTScript {
 	if x != nil {
-		return x.Exchange
+		return x.Script
 	}
-	return ""
+	return nil
 }
 
-type WebsocketSubscription struct {
+type GCTScriptStopAllRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Channel       string                 `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
-	Pairs         string                 `protobuf:"bytes,2,opt,name=pairs,proto3" json:"pairs,omitempty"`
-	Asset         string                 `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
-	Params        string                 `protobuf:"bytes,4,opt,name=params,proto3" json:"params,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WebsocketSubscription) Reset() {
-	*x = WebsocketSubscription{}
-	mi := &file_rpc_proto_msgTypes[142]
+func (x *GCTScriptStopAllRequest) Reset() {
+	*x = GCTScriptStopAllRequest{}
+	mi := &file_rpc_proto_msgTypes[135]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WebsocketSubscription) String() string {
+func (x *GCTScriptStopAllRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WebsocketSubscription) ProtoMessage() {}
+func (*GCTScriptStopAllRequest) ProtoMessage() {}
 
-func (x *WebsocketSubscription) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[142]
+func (x *GCTScriptStopAllRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[135]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8506,62 +8426,32 @@ func (x *WebsocketSubscription) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WebsocketSubscription.ProtoReflect.Descriptor instead.
-func (*WebsocketSubscription) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{142}
-}
-
-func (x *WebsocketSubscription) GetChannel() string {
-	if x != nil {
-		return x.Channel
-	}
-	return ""
-}
-
-func (x *WebsocketSubscription) GetPairs() string {
-	if x != nil {
-		return x.Pairs
-	}
-	return ""
-}
-
-func (x *WebsocketSubscription) GetAsset() string {
-	if x != nil {
-		return x.Asset
-	}
-	return ""
-}
-
-func (x *WebsocketSubscription) GetParams() string {
-	if x != nil {
-		return x.Params
-	}
-	return ""
+// Deprecated: Use GCTScriptStopAllRequest.ProtoReflect.Descriptor instead.
+func (*GCTScriptStopAllRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{135}
 }
 
-type WebsocketGetSubscriptionsResponse struct {
-	state         protoimpl.MessageState   `protogen:"open.v1"`
-	Exchange      string                   `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Subscriptions []*WebsocketSubscription `protobuf:"bytes,2,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+type GCTScriptStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WebsocketGetSubscriptionsResponse) Reset() {
-	*x = WebsocketGetSubscriptionsResponse{}
-	mi := &file_rpc_proto_msgTypes[143]
+func (x *GCTScriptStatusRequest) Reset() {
+	*x = GCTScriptStatusRequest{}
+	mi := &file_rpc_proto_msgTypes[136]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WebsocketGetSubscriptionsResponse) String() string {
+func (x *GCTScriptStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WebsocketGetSubscriptionsResponse) ProtoMessage() {}
+func (*GCTScriptStatusRequest) ProtoMessage() {}
 
-func (x *WebsocketGetSubscriptionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[143]
+func (x *GCTScriptStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[136]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8572,48 +8462,32 @@ func (x *WebsocketGetSubscriptionsResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WebsocketGetSubscriptionsResponse.ProtoReflect.Descriptor instead.
-func (*WebsocketGetSubscriptionsResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{143}
-}
-
-func (x *WebsocketGetSubscriptionsResponse) GetExchange() string {
-	if x != nil {
-		return x.Exchange
-	}
-	return ""
-}
-
-func (x *WebsocketGetSubscriptionsResponse) GetSubscriptions() []*WebsocketSubscription {
-	if x != nil {
-		return x.Subscriptions
-	}
-	return nil
+// Deprecated: Use GCTScriptStatusRequest.ProtoReflect.Descriptor instead.
+func (*GCTScriptStatusRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{136}
 }
 
-type WebsocketSetProxyRequest struct {
+type GCTScriptListAllRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Proxy         string                 `protobuf:"bytes,2,opt,name=proxy,proto3" json:"proxy,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WebsocketSetProxyRequest) Reset() {
-	*x = WebsocketSetProxyRequest{}
-	mi := &file_rpc_proto_msgTypes[144]
+func (x *GCTScriptListAllRequest) Reset() {
+	*x = GCTScriptListAllRequest{}
+	mi := &file_rpc_proto_msgTypes[137]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WebsocketSetProxyRequest) String() string {
+func (x *GCTScriptListAllRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WebsocketSetProxyRequest) ProtoMessage() {}
+func (*GCTScriptListAllRequest) ProtoMessage() {}
 
-func (x *WebsocketSetProxyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[144]
+func (x *GCTScriptListAllRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[137]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8624,48 +8498,37 @@ func (x *WebsocketSetProxyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WebsocketSetProxyRequest.ProtoReflect.Descriptor instead.
-func (*WebsocketSetProxyRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{144}
-}
-
-func (x *WebsocketSetProxyRequest) GetExchange() string {
-	if x != nil {
-		return x.Exchange
-	}
-	return ""
-}
-
-func (x *WebsocketSetProxyRequest) GetProxy() string {
-	if x != nil {
-		return x.Proxy
-	}
-	return ""
+// Deprecated: Use GCTScriptListAllRequest.ProtoReflect.Descriptor instead.
+func (*GCTScriptListAllRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{137}
 }
 
-type WebsocketSetURLRequest struct {
+type GCTScriptUploadRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	ScriptName    string                 `protobuf:"bytes,1,opt,name=script_name,json=scriptName,proto3" json:"script_name,omitempty"`
+	ScriptData    string                 `protobuf:"bytes,2,opt,name=script_data,json=scriptData,proto3" json:"script_data,omitempty"`
+	Data          []byte                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Archived      bool                   `protobuf:"varint,4,opt,name=archived,proto3" json:"archived,omitempty"`
+	Overwrite     bool                   `protobuf:"varint,5,opt,name=overwrite,proto3" json:"overwrite,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WebsocketSetURLRequest) Reset() {
-	*x = WebsocketSetURLRequest{}
-	mi := &file_rpc_proto_msgTypes[145]
+func (x *GCTScriptUploadRequest) Reset() {
+	*x = GCTScriptUploadRequest{}
+	mi := &file_rpc_proto_msgTypes[138]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WebsocketSetURLRequest) String() string {
+func (x *GCTScriptUploadRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WebsocketSetURLRequest) ProtoMessage() {}
+func (*GCTScriptUploadRequest) ProtoMessage() {}
 
-func (x *WebsocketSetURLRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[145]
+func (x *GCTScriptUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[138]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8676,52 +8539,68 @@ func (x *WebsocketSetURLRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WebsocketSetURLRequest.ProtoReflect.Descriptor instead.
-func (*WebsocketSetURLRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{145}
+// Deprecated: Use GCTScriptUploadRequest.ProtoReflect.Descriptor instead.
+func (*GCTScriptUploadRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{138}
 }
 
-func (x *WebsocketSetURLRequest) GetExchange() string {
+func (x *GCTScriptUploadRequest) GetScriptName() string {
 	if x != nil {
-		return x.Exchange
+		return x.ScriptName
 	}
 	return ""
 }
 
-func (x *WebsocketSetURLRequest) GetUrl() string {
+func (x *GCTScriptUploadRequest) GetScriptData() string {
 	if x != nil {
-		return x.Url
+		return x.ScriptData
 	}
 	return ""
 }
 
-type FindMissingCandlePeriodsRequest struct {
+func (x *GCTScriptUploadRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *GCTScriptUploadRequest) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
+func (x *GCTScriptUploadRequest) GetOverwrite() bool {
+	if x != nil {
+		return x.Overwrite
+	}
+	return false
+}
+
+type GCTScriptReadScriptRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ExchangeName  string                 `protobuf:"bytes,1,opt,name=exchange_name,json=exchangeName,proto3" json:"exchange_name,omitempty"`
-	AssetType     string                 `protobuf:"bytes,2,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
-	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	Interval      int64                  `protobuf:"varint,4,opt,name=interval,proto3" json:"interval,omitempty"`
-	Start         string                 `protobuf:"bytes,5,opt,name=start,proto3" json:"start,omitempty"`
-	End           string                 `protobuf:"bytes,6,opt,name=end,proto3" json:"end,omitempty"`
+	Script        *GCTScript             `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FindMissingCandlePeriodsRequest) Reset() {
-	*x = FindMissingCandlePeriodsRequest{}
-	mi := &file_rpc_proto_msgTypes[146]
+func (x *GCTScriptReadScriptRequest) Reset() {
+	*x = GCTScriptReadScriptRequest{}
+	mi := &file_rpc_proto_msgTypes[139]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FindMissingCandlePeriodsRequest) String() string {
+func (x *GCTScriptReadScriptRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindMissingCandlePeriodsRequest) ProtoMessage() {}
+func (*GCTScriptReadScriptRequest) ProtoMessage() {}
 
-func (x *FindMissingCandlePeriodsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[146]
+func (x *GCTScriptReadScriptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[139]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8732,79 +8611,85 @@ func (x *FindMissingCandlePeriodsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindMissingCandlePeriodsRequest.ProtoReflect.Descriptor instead.
-func (*FindMissingCandlePeriodsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{146}
+// Deprecated: Use GCTScriptReadScriptRequest.ProtoReflect.Descriptor instead.
+func (*GCTScriptReadScriptRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{139}
 }
 
-func (x *FindMissingCandlePeriodsRequest) GetExchangeName() string {
+func (x *GCTScriptReadScriptRequest) GetScript() *GCTScript {
 	if x != nil {
-		return x.ExchangeName
+		return x.Script
 	}
-	return ""
+	return nil
 }
 
-func (x *FindMissingCandlePeriodsRequest) GetAssetType() string {
-	if x != nil {
-		return x.AssetType
-	}
-	return ""
+type GCTScriptQueryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Script        *GCTScript             `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FindMissingCandlePeriodsRequest) GetPair() *CurrencyPair {
-	if x != nil {
-		return x.Pair
-	}
-	return nil
+func (x *GCTScriptQueryRequest) Reset() {
+	*x = GCTScriptQueryRequest{}
+	mi := &file_rpc_proto_msgTypes[140]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *FindMissingCandlePeriodsRequest) GetInterval() int64 {
-	if x != nil {
-		return x.Interval
-	}
-	return 0
+func (x *GCTScriptQueryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *FindMissingCandlePeriodsRequest) GetStart() string {
+func (*GCTScriptQueryRequest) ProtoMessage() {}
+
+func (x *GCTScriptQueryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[140]
 	if x != nil {
-		return x.Start
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *FindMissingCandlePeriodsRequest) GetEnd() string {
+// Deprecated: Use GCTScriptQueryRequest.ProtoReflect.Descriptor instead.
+func (*GCTScriptQueryRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *GCTScriptQueryRequest) GetScript() *GCTScript {
 	if x != nil {
-		return x.End
+		return x.Script
 	}
-	return ""
+	return nil
 }
 
-type FindMissingTradePeriodsRequest struct {
+type GCTScriptAutoLoadRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ExchangeName  string                 `protobuf:"bytes,1,opt,name=exchange_name,json=exchangeName,proto3" json:"exchange_name,omitempty"`
-	AssetType     string                 `protobuf:"bytes,2,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
-	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	Start         string                 `protobuf:"bytes,4,opt,name=start,proto3" json:"start,omitempty"`
-	End           string                 `protobuf:"bytes,5,opt,name=end,proto3" json:"end,omitempty"`
+	Script        string                 `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
+	Status        bool                   `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FindMissingTradePeriodsRequest) Reset() {
-	*x = FindMissingTradePeriodsRequest{}
-	mi := &file_rpc_proto_msgTypes[147]
+func (x *GCTScriptAutoLoadRequest) Reset() {
+	*x = GCTScriptAutoLoadRequest{}
+	mi := &file_rpc_proto_msgTypes[141]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FindMissingTradePeriodsRequest) String() string {
+func (x *GCTScriptAutoLoadRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindMissingTradePeriodsRequest) ProtoMessage() {}
+func (*GCTScriptAutoLoadRequest) ProtoMessage() {}
 
-func (x *FindMissingTradePeriodsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[147]
+func (x *GCTScriptAutoLoadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[141]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8815,72 +8700,101 @@ func (x *FindMissingTradePeriodsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindMissingTradePeriodsRequest.ProtoReflect.Descriptor instead.
-func (*FindMissingTradePeriodsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{147}
+// Deprecated: Use GCTScriptAutoLoadRequest.ProtoReflect.Descriptor instead.
+func (*GCTScriptAutoLoadRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{141}
 }
 
-func (x *FindMissingTradePeriodsRequest) GetExchangeName() string {
+func (x *GCTScriptAutoLoadRequest) GetScript() string {
 	if x != nil {
-		return x.ExchangeName
+		return x.Script
 	}
 	return ""
 }
 
-func (x *FindMissingTradePeriodsRequest) GetAssetType() string {
+func (x *GCTScriptAutoLoadRequest) GetStatus() bool {
 	if x != nil {
-		return x.AssetType
+		return x.Status
 	}
-	return ""
+	return false
 }
 
-func (x *FindMissingTradePeriodsRequest) GetPair() *CurrencyPair {
-	if x != nil {
-		return x.Pair
-	}
-	return nil
+type GCTScriptStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Scripts       []*GCTScript           `protobuf:"bytes,2,rep,name=scripts,proto3" json:"scripts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FindMissingTradePeriodsRequest) GetStart() string {
+func (x *GCTScriptStatusResponse) Reset() {
+	*x = GCTScriptStatusResponse{}
+	mi := &file_rpc_proto_msgTypes[142]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GCTScriptStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GCTScriptStatusResponse) ProtoMessage() {}
+
+func (x *GCTScriptStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[142]
 	if x != nil {
-		return x.Start
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *FindMissingTradePeriodsRequest) GetEnd() string {
+// Deprecated: Use GCTScriptStatusResponse.ProtoReflect.Descriptor instead.
+func (*GCTScriptStatusResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *GCTScriptStatusResponse) GetStatus() string {
 	if x != nil {
-		return x.End
+		return x.Status
 	}
 	return ""
 }
 
-type FindMissingIntervalsResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	ExchangeName   string                 `protobuf:"bytes,1,opt,name=exchange_name,json=exchangeName,proto3" json:"exchange_name,omitempty"`
-	AssetType      string                 `protobuf:"bytes,2,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
-	Pair           *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	MissingPeriods []string               `protobuf:"bytes,4,rep,name=missing_periods,json=missingPeriods,proto3" json:"missing_periods,omitempty"`
-	Status         string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *GCTScriptStatusResponse) GetScripts() []*GCTScript {
+	if x != nil {
+		return x.Scripts
+	}
+	return nil
 }
 
-func (x *FindMissingIntervalsResponse) Reset() {
-	*x = FindMissingIntervalsResponse{}
-	mi := &file_rpc_proto_msgTypes[148]
+type GCTScriptQueryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Script        *GCTScript             `protobuf:"bytes,2,opt,name=script,proto3" json:"script,omitempty"`
+	Data          string                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GCTScriptQueryResponse) Reset() {
+	*x = GCTScriptQueryResponse{}
+	mi := &file_rpc_proto_msgTypes[143]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FindMissingIntervalsResponse) String() string {
+func (x *GCTScriptQueryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindMissingIntervalsResponse) ProtoMessage() {}
+func (*GCTScriptQueryResponse) ProtoMessage() {}
 
-func (x *FindMissingIntervalsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[148]
+func (x *GCTScriptQueryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[143]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8891,69 +8805,55 @@ func (x *FindMissingIntervalsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindMissingIntervalsResponse.ProtoReflect.Descriptor instead.
-func (*FindMissingIntervalsResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{148}
-}
-
-func (x *FindMissingIntervalsResponse) GetExchangeName() string {
-	if x != nil {
-		return x.ExchangeName
-	}
-	return ""
+// Deprecated: Use GCTScriptQueryResponse.ProtoReflect.Descriptor instead.
+func (*GCTScriptQueryResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{143}
 }
 
-func (x *FindMissingIntervalsResponse) GetAssetType() string {
+func (x *GCTScriptQueryResponse) GetStatus() string {
 	if x != nil {
-		return x.AssetType
+		return x.Status
 	}
 	return ""
 }
 
-func (x *FindMissingIntervalsResponse) GetPair() *CurrencyPair {
-	if x != nil {
-		return x.Pair
-	}
-	return nil
-}
-
-func (x *FindMissingIntervalsResponse) GetMissingPeriods() []string {
+func (x *GCTScriptQueryResponse) GetScript() *GCTScript {
 	if x != nil {
-		return x.MissingPeriods
+		return x.Script
 	}
 	return nil
 }
 
-func (x *FindMissingIntervalsResponse) GetStatus() string {
+func (x *GCTScriptQueryResponse) GetData() string {
 	if x != nil {
-		return x.Status
+		return x.Data
 	}
 	return ""
 }
 
-type SetExchangeTradeProcessingRequest struct {
+type GenericResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Status        bool                   `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Data          string                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetExchangeTradeProcessingRequest) Reset() {
-	*x = SetExchangeTradeProcessingRequest{}
-	mi := &file_rpc_proto_msgTypes[149]
+func (x *GenericResponse) Reset() {
+	*x = GenericResponse{}
+	mi := &file_rpc_proto_msgTypes[144]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetExchangeTradeProcessingRequest) String() string {
+func (x *GenericResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetExchangeTradeProcessingRequest) ProtoMessage() {}
+func (*GenericResponse) ProtoMessage() {}
 
-func (x *SetExchangeTradeProcessingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[149]
+func (x *GenericResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[144]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8964,65 +8864,49 @@ func (x *SetExchangeTradeProcessingRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetExchangeTradeProcessingRequest.ProtoReflect.Descriptor instead.
-func (*SetExchangeTradeProcessingRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{149}
+// Deprecated: Use GenericResponse.ProtoReflect.Descriptor instead.
+func (*GenericResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{144}
 }
 
-func (x *SetExchangeTradeProcessingRequest) GetExchange() string {
+func (x *GenericResponse) GetStatus() string {
 	if x != nil {
-		return x.Exchange
+		return x.Status
 	}
 	return ""
 }
 
-func (x *SetExchangeTradeProcessingRequest) GetStatus() bool {
+func (x *GenericResponse) GetData() string {
 	if x != nil {
-		return x.Status
+		return x.Data
 	}
-	return false
+	return ""
 }
 
-type UpsertDataHistoryJobRequest struct {
-	state                    protoimpl.MessageState `protogen:"open.v1"`
-	Nickname                 string                 `protobuf:"bytes,1,opt,name=nickname,proto3" json:"nickname,omitempty"`
-	Exchange                 string                 `protobuf:"bytes,2,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset                    string                 `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair                     *CurrencyPair          `protobuf:"bytes,4,opt,name=pair,proto3" json:"pair,omitempty"`
-	StartDate                string                 `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate                  string                 `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
-	Interval                 int64                  `protobuf:"varint,7,opt,name=interval,proto3" json:"interval,omitempty"`
-	RequestSizeLimit         uint64                 `protobuf:"varint,8,opt,name=request_size_limit,json=requestSizeLimit,proto3" json:"request_size_limit,omitempty"`
-	DataType                 int64                  `protobuf:"varint,9,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
-	MaxRetryAttempts         uint64                 `protobuf:"varint,10,opt,name=max_retry_attempts,json=maxRetryAttempts,proto3" json:"max_retry_attempts,omitempty"`
-	BatchSize                uint64                 `protobuf:"varint,11,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
-	InsertOnly               bool                   `protobuf:"varint,12,opt,name=insert_only,json=insertOnly,proto3" json:"insert_only,omitempty"`
-	ConversionInterval       int64                  `protobuf:"varint,13,opt,name=conversion_interval,json=conversionInterval,proto3" json:"conversion_interval,omitempty"`
-	OverwriteExistingData    bool                   `protobuf:"varint,14,opt,name=overwrite_existing_data,json=overwriteExistingData,proto3" json:"overwrite_existing_data,omitempty"`
-	PrerequisiteJobNickname  string                 `protobuf:"bytes,15,opt,name=prerequisite_job_nickname,json=prerequisiteJobNickname,proto3" json:"prerequisite_job_nickname,omitempty"`
-	DecimalPlaceComparison   uint64                 `protobuf:"varint,16,opt,name=decimal_place_comparison,json=decimalPlaceComparison,proto3" json:"decimal_place_comparison,omitempty"`
-	SecondaryExchangeName    string                 `protobuf:"bytes,17,opt,name=secondary_exchange_name,json=secondaryExchangeName,proto3" json:"secondary_exchange_name,omitempty"`
-	IssueTolerancePercentage float64                `protobuf:"fixed64,18,opt,name=issue_tolerance_percentage,json=issueTolerancePercentage,proto3" json:"issue_tolerance_percentage,omitempty"`
-	ReplaceOnIssue           bool                   `protobuf:"varint,19,opt,name=replace_on_issue,json=replaceOnIssue,proto3" json:"replace_on_issue,omitempty"`
-	unknownFields            protoimpl.UnknownFields
-	sizeCache                protoimpl.SizeCache
+type SetExchangeAssetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Enable        bool                   `protobuf:"varint,3,opt,name=enable,proto3" json:"enable,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpsertDataHistoryJobRequest) Reset() {
-	*x = UpsertDataHistoryJobRequest{}
-	mi := &file_rpc_proto_msgTypes[150]
+func (x *SetExchangeAssetRequest) Reset() {
+	*x = SetExchangeAssetRequest{}
+	mi := &file_rpc_proto_msgTypes[145]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpsertDataHistoryJobRequest) String() string {
+func (x *SetExchangeAssetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpsertDataHistoryJobRequest) ProtoMessage() {}
+func (*SetExchangeAssetRequest) ProtoMessage() {}
 
-func (x *UpsertDataHistoryJobRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[150]
+func (x *SetExchangeAssetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[145]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9033,166 +8917,150 @@ func (x *UpsertDataHistoryJobRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpsertDataHistoryJobRequest.ProtoReflect.Descriptor instead.
-func (*UpsertDataHistoryJobRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{150}
-}
-
-func (x *UpsertDataHistoryJobRequest) GetNickname() string {
-	if x != nil {
-		return x.Nickname
-	}
-	return ""
+// Deprecated: Use SetExchangeAssetRequest.ProtoReflect.Descriptor instead.
+func (*SetExchangeAssetRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{145}
 }
 
-func (x *UpsertDataHistoryJobRequest) GetExchange() string {
+func (x *SetExchangeAssetRequest) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *UpsertDataHistoryJobRequest) GetAsset() string {
+func (x *SetExchangeAssetRequest) GetAsset() string {
 	if x != nil {
 		return x.Asset
 	}
 	return ""
 }
 
-func (x *UpsertDataHistoryJobRequest) GetPair() *CurrencyPair {
+func (x *SetExchangeAssetRequest) GetEnable() bool {
 	if x != nil {
-		return x.Pair
+		return x.Enable
 	}
-	return nil
+	return false
 }
 
-func (x *UpsertDataHistoryJobRequest) GetStartDate() string {
-	if x != nil {
-		return x.StartDate
-	}
-	return ""
+type SetExchangeAllPairsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Enable        bool                   `protobuf:"varint,2,opt,name=enable,proto3" json:"enable,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpsertDataHistoryJobRequest) GetEndDate() string {
-	if x != nil {
-		return x.EndDate
-	}
-	return ""
+func (x *SetExchangeAllPairsRequest) Reset() {
+	*x = SetExchangeAllPairsRequest{}
+	mi := &file_rpc_proto_msgTypes[146]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpsertDataHistoryJobRequest) GetInterval() int64 {
-	if x != nil {
-		return x.Interval
-	}
-	return 0
+func (x *SetExchangeAllPairsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UpsertDataHistoryJobRequest) GetRequestSizeLimit() uint64 {
+func (*SetExchangeAllPairsRequest) ProtoMessage() {}
+
+func (x *SetExchangeAllPairsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[146]
 	if x != nil {
-		return x.RequestSizeLimit
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *UpsertDataHistoryJobRequest) GetDataType() int64 {
-	if x != nil {
-		return x.DataType
-	}
-	return 0
+// Deprecated: Use SetExchangeAllPairsRequest.ProtoReflect.Descriptor instead.
+func (*SetExchangeAllPairsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{146}
 }
 
-func (x *UpsertDataHistoryJobRequest) GetMaxRetryAttempts() uint64 {
+func (x *SetExchangeAllPairsRequest) GetExchange() string {
 	if x != nil {
-		return x.MaxRetryAttempts
-	}
-	return 0
-}
-
-func (x *UpsertDataHistoryJobRequest) GetBatchSize() uint64 {
-	if x != nil {
-		return x.BatchSize
+		return x.Exchange
 	}
-	return 0
+	return ""
 }
 
-func (x *UpsertDataHistoryJobRequest) GetInsertOnly() bool {
+func (x *SetExchangeAllPairsRequest) GetEnable() bool {
 	if x != nil {
-		return x.InsertOnly
+		return x.Enable
 	}
 	return false
 }
 
-func (x *UpsertDataHistoryJobRequest) GetConversionInterval() int64 {
-	if x != nil {
-		return x.ConversionInterval
-	}
-	return 0
+type UpdateExchangeSupportedPairsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpsertDataHistoryJobRequest) GetOverwriteExistingData() bool {
-	if x != nil {
-		return x.OverwriteExistingData
-	}
-	return false
+func (x *UpdateExchangeSupportedPairsRequest) Reset() {
+	*x = UpdateExchangeSupportedPairsRequest{}
+	mi := &file_rpc_proto_msgTypes[147]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpsertDataHistoryJobRequest) GetPrerequisiteJobNickname() string {
-	if x != nil {
-		return x.PrerequisiteJobNickname
-	}
-	return ""
+func (x *UpdateExchangeSupportedPairsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UpsertDataHistoryJobRequest) GetDecimalPlaceComparison() uint64 {
-	if x != nil {
-		return x.DecimalPlaceComparison
-	}
-	return 0
-}
+func (*UpdateExchangeSupportedPairsRequest) ProtoMessage() {}
 
-func (x *UpsertDataHistoryJobRequest) GetSecondaryExchangeName() string {
+func (x *UpdateExchangeSupportedPairsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[147]
 	if x != nil {
-		return x.SecondaryExchangeName
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UpsertDataHistoryJobRequest) GetIssueTolerancePercentage() float64 {
-	if x != nil {
-		return x.IssueTolerancePercentage
-	}
-	return 0
+// Deprecated: Use UpdateExchangeSupportedPairsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateExchangeSupportedPairsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{147}
 }
 
-func (x *UpsertDataHistoryJobRequest) GetReplaceOnIssue() bool {
+func (x *UpdateExchangeSupportedPairsRequest) GetExchange() string {
 	if x != nil {
-		return x.ReplaceOnIssue
+		return x.Exchange
 	}
-	return false
+	return ""
 }
 
-type InsertSequentialJobsRequest struct {
-	state         protoimpl.MessageState         `protogen:"open.v1"`
-	Jobs          []*UpsertDataHistoryJobRequest `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+type GetExchangeAssetsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InsertSequentialJobsRequest) Reset() {
-	*x = InsertSequentialJobsRequest{}
-	mi := &file_rpc_proto_msgTypes[151]
+func (x *GetExchangeAssetsRequest) Reset() {
+	*x = GetExchangeAssetsRequest{}
+	mi := &file_rpc_proto_msgTypes[148]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InsertSequentialJobsRequest) String() string {
+func (x *GetExchangeAssetsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InsertSequentialJobsRequest) ProtoMessage() {}
+func (*GetExchangeAssetsRequest) ProtoMessage() {}
 
-func (x *InsertSequentialJobsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[151]
+func (x *GetExchangeAssetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[148]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9203,40 +9071,40 @@ func (x *InsertSequentialJobsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InsertSequentialJobsRequest.ProtoReflect.Descriptor instead.
-func (*InsertSequentialJobsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{151}
+// Deprecated: Use GetExchangeAssetsRequest.ProtoReflect.Descriptor instead.
+func (*GetExchangeAssetsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{148}
 }
 
-func (x *InsertSequentialJobsRequest) GetJobs() []*UpsertDataHistoryJobRequest {
+func (x *GetExchangeAssetsRequest) GetExchange() string {
 	if x != nil {
-		return x.Jobs
+		return x.Exchange
 	}
-	return nil
+	return ""
 }
 
-type InsertSequentialJobsResponse struct {
-	state         protoimpl.MessageState          `protogen:"open.v1"`
-	Jobs          []*UpsertDataHistoryJobResponse `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+type GetExchangeAssetsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Assets        string                 `protobuf:"bytes,1,opt,name=assets,proto3" json:"assets,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InsertSequentialJobsResponse) Reset() {
-	*x = InsertSequentialJobsResponse{}
-	mi := &file_rpc_proto_msgTypes[152]
+func (x *GetExchangeAssetsResponse) Reset() {
+	*x = GetExchangeAssetsResponse{}
+	mi := &file_rpc_proto_msgTypes[149]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InsertSequentialJobsResponse) String() string {
+func (x *GetExchangeAssetsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InsertSequentialJobsResponse) ProtoMessage() {}
+func (*GetExchangeAssetsResponse) ProtoMessage() {}
 
-func (x *InsertSequentialJobsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[152]
+func (x *GetExchangeAssetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[149]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9247,41 +9115,40 @@ func (x *InsertSequentialJobsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InsertSequentialJobsResponse.ProtoReflect.Descriptor instead.
-func (*InsertSequentialJobsResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{152}
+// Deprecated: Use GetExchangeAssetsResponse.ProtoReflect.Descriptor instead.
+func (*GetExchangeAssetsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{149}
 }
 
-func (x *InsertSequentialJobsResponse) GetJobs() []*UpsertDataHistoryJobResponse {
+func (x *GetExchangeAssetsResponse) GetAssets() string {
 	if x != nil {
-		return x.Jobs
+		return x.Assets
 	}
-	return nil
+	return ""
 }
 
-type UpsertDataHistoryJobResponse struct {
+type WebsocketGetInfoRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-	JobId         string                 `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpsertDataHistoryJobResponse) Reset() {
-	*x = UpsertDataHistoryJobResponse{}
-	mi := &file_rpc_proto_msgTypes[153]
+func (x *WebsocketGetInfoRequest) Reset() {
+	*x = WebsocketGetInfoRequest{}
+	mi := &file_rpc_proto_msgTypes[150]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpsertDataHistoryJobResponse) String() string {
+func (x *WebsocketGetInfoRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpsertDataHistoryJobResponse) ProtoMessage() {}
+func (*WebsocketGetInfoRequest) ProtoMessage() {}
 
-func (x *UpsertDataHistoryJobResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[153]
+func (x *WebsocketGetInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[150]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9292,49 +9159,47 @@ func (x *UpsertDataHistoryJobResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpsertDataHistoryJobResponse.ProtoReflect.Descriptor instead.
-func (*UpsertDataHistoryJobResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{153}
-}
-
-func (x *UpsertDataHistoryJobResponse) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
+// Deprecated: Use WebsocketGetInfoRequest.ProtoReflect.Descriptor instead.
+func (*WebsocketGetInfoRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{150}
 }
 
-func (x *UpsertDataHistoryJobResponse) GetJobId() string {
+func (x *WebsocketGetInfoRequest) GetExchange() string {
 	if x != nil {
-		return x.JobId
+		return x.Exchange
 	}
 	return ""
 }
 
-type GetDataHistoryJobDetailsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Nickname      string                 `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"`
-	FullDetails   bool                   `protobuf:"varint,3,opt,name=full_details,json=fullDetails,proto3" json:"full_details,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type WebsocketGetInfoResponse struct {
+	state                  protoimpl.MessageState       `protogen:"open.v1"`
+	Exchange               string                       `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Supported              bool                         `protobuf:"varint,2,opt,name=supported,proto3" json:"supported,omitempty"`
+	Enabled                bool                         `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	AuthenticatedSupported bool                         `protobuf:"varint,4,opt,name=authenticated_supported,json=authenticatedSupported,proto3" json:"authenticated_supported,omitempty"`
+	Authenticated          bool                         `protobuf:"varint,5,opt,name=authenticated,proto3" json:"authenticated,omitempty"`
+	RunningUrl             string                       `protobuf:"bytes,6,opt,name=running_url,json=runningUrl,proto3" json:"running_url,omitempty"`
+	ProxyAddress           string                       `protobuf:"bytes,7,opt,name=proxy_address,json=proxyAddress,proto3" json:"proxy_address,omitempty"`
+	Connections            []*WebsocketConnectionHealth `protobuf:"bytes,8,rep,name=connections,proto3" json:"connections,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
-func (x *GetDataHistoryJobDetailsRequest) Reset() {
-	*x = GetDataHistoryJobDetailsRequest{}
-	mi := &file_rpc_proto_msgTypes[154]
+func (x *WebsocketGetInfoResponse) Reset() {
+	*x = WebsocketGetInfoResponse{}
+	mi := &file_rpc_proto_msgTypes[151]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDataHistoryJobDetailsRequest) String() string {
+func (x *WebsocketGetInfoResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDataHistoryJobDetailsRequest) ProtoMessage() {}
+func (*WebsocketGetInfoResponse) ProtoMessage() {}
 
-func (x *GetDataHistoryJobDetailsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[154]
+func (x *WebsocketGetInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[151]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9345,270 +9210,190 @@ func (x *GetDataHistoryJobDetailsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDataHistoryJobDetailsRequest.ProtoReflect.Descriptor instead.
-func (*GetDataHistoryJobDetailsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{154}
+// Deprecated: Use WebsocketGetInfoResponse.ProtoReflect.Descriptor instead.
+func (*WebsocketGetInfoResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{151}
 }
 
-func (x *GetDataHistoryJobDetailsRequest) GetId() string {
+func (x *WebsocketGetInfoResponse) GetExchange() string {
 	if x != nil {
-		return x.Id
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *GetDataHistoryJobDetailsRequest) GetNickname() string {
+func (x *WebsocketGetInfoResponse) GetSupported() bool {
 	if x != nil {
-		return x.Nickname
+		return x.Supported
 	}
-	return ""
+	return false
 }
 
-func (x *GetDataHistoryJobDetailsRequest) GetFullDetails() bool {
+func (x *WebsocketGetInfoResponse) GetEnabled() bool {
 	if x != nil {
-		return x.FullDetails
+		return x.Enabled
 	}
 	return false
 }
 
-type DataHistoryJob struct {
-	state                    protoimpl.MessageState  `protogen:"open.v1"`
-	Id                       string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Nickname                 string                  `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"`
-	Exchange                 string                  `protobuf:"bytes,3,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset                    string                  `protobuf:"bytes,4,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair                     *CurrencyPair           `protobuf:"bytes,5,opt,name=pair,proto3" json:"pair,omitempty"`
-	StartDate                string                  `protobuf:"bytes,6,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate                  string                  `protobuf:"bytes,7,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
-	Interval                 int64                   `protobuf:"varint,8,opt,name=interval,proto3" json:"interval,omitempty"`
-	RequestSizeLimit         uint64                  `protobuf:"varint,9,opt,name=request_size_limit,json=requestSizeLimit,proto3" json:"request_size_limit,omitempty"`
-	MaxRetryAttempts         uint64                  `protobuf:"varint,10,opt,name=max_retry_attempts,json=maxRetryAttempts,proto3" json:"max_retry_attempts,omitempty"`
-	BatchSize                uint64                  `protobuf:"varint,11,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
-	Status                   string                  `protobuf:"bytes,12,opt,name=status,proto3" json:"status,omitempty"`
-	DataType                 string                  `protobuf:"bytes,13,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
-	ConversionInterval       int64                   `protobuf:"varint,14,opt,name=conversion_interval,json=conversionInterval,proto3" json:"conversion_interval,omitempty"`
-	OverwriteExistingData    bool                    `protobuf:"varint,15,opt,name=overwrite_existing_data,json=overwriteExistingData,proto3" json:"overwrite_existing_data,omitempty"`
-	PrerequisiteJobNickname  string                  `protobuf:"bytes,16,opt,name=prerequisite_job_nickname,json=prerequisiteJobNickname,proto3" json:"prerequisite_job_nickname,omitempty"`
-	DecimalPlaceComparison   uint64                  `protobuf:"varint,17,opt,name=decimal_place_comparison,json=decimalPlaceComparison,proto3" json:"decimal_place_comparison,omitempty"`
-	SecondaryExchangeName    string                  `protobuf:"bytes,18,opt,name=secondary_exchange_name,json=secondaryExchangeName,proto3" json:"secondary_exchange_name,omitempty"`
-	IssueTolerancePercentage float64                 `protobuf:"fixed64,19,opt,name=issue_tolerance_percentage,json=issueTolerancePercentage,proto3" json:"issue_tolerance_percentage,omitempty"`
-	ReplaceOnIssue           bool                    `protobuf:"varint,20,opt,name=replace_on_issue,json=replaceOnIssue,proto3" json:"replace_on_issue,omitempty"`
-	JobResults               []*DataHistoryJobResult `protobuf:"bytes,21,rep,name=job_results,json=jobResults,proto3" json:"job_results,omitempty"`
-	ResultSummaries          []string                `protobuf:"bytes,22,rep,name=result_summaries,json=resultSummaries,proto3" json:"result_summaries,omitempty"`
-	unknownFields            protoimpl.UnknownFields
-	sizeCache                protoimpl.SizeCache
-}
-
-func (x *DataHistoryJob) Reset() {
-	*x = DataHistoryJob{}
-	mi := &file_rpc_proto_msgTypes[155]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *WebsocketGetInfoResponse) GetAuthenticatedSupported() bool {
+	if x != nil {
+		return x.AuthenticatedSupported
+	}
+	return false
 }
 
-func (x *DataHistoryJob) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*DataHistoryJob) ProtoMessage() {}
-
-func (x *DataHistoryJob) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[155]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use DataHistoryJob.ProtoReflect.Descriptor instead.
-func (*DataHistoryJob) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{155}
-}
-
-func (x *DataHistoryJob) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *DataHistoryJob) GetNickname() string {
+func (x *WebsocketGetInfoResponse) GetAuthenticated() bool {
 	if x != nil {
-		return x.Nickname
+		return x.Authenticated
 	}
-	return ""
+	return false
 }
 
-func (x *DataHistoryJob) GetExchange() string {
+func (x *WebsocketGetInfoResponse) GetRunningUrl() string {
 	if x != nil {
-		return x.Exchange
+		return x.RunningUrl
 	}
 	return ""
 }
 
-func (x *DataHistoryJob) GetAsset() string {
+func (x *WebsocketGetInfoResponse) GetProxyAddress() string {
 	if x != nil {
-		return x.Asset
+		return x.ProxyAddress
 	}
 	return ""
 }
 
-func (x *DataHistoryJob) GetPair() *CurrencyPair {
+func (x *WebsocketGetInfoResponse) GetConnections() []*WebsocketConnectionHealth {
 	if x != nil {
-		return x.Pair
+		return x.Connections
 	}
 	return nil
 }
 
-func (x *DataHistoryJob) GetStartDate() string {
-	if x != nil {
-		return x.StartDate
-	}
-	return ""
+type WebsocketConnectionHealth struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Url              string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	MessageFilter    string                 `protobuf:"bytes,2,opt,name=message_filter,json=messageFilter,proto3" json:"message_filter,omitempty"`
+	Connected        bool                   `protobuf:"varint,3,opt,name=connected,proto3" json:"connected,omitempty"`
+	Subscriptions    int64                  `protobuf:"varint,4,opt,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	MaxSubscriptions int64                  `protobuf:"varint,5,opt,name=max_subscriptions,json=maxSubscriptions,proto3" json:"max_subscriptions,omitempty"`
+	ConnectedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=connected_at,json=connectedAt,proto3" json:"connected_at,omitempty"`
+	LastMessage      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_message,json=lastMessage,proto3" json:"last_message,omitempty"`
+	Messages         uint64                 `protobuf:"varint,8,opt,name=messages,proto3" json:"messages,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *DataHistoryJob) GetEndDate() string {
-	if x != nil {
-		return x.EndDate
-	}
-	return ""
+func (x *WebsocketConnectionHealth) Reset() {
+	*x = WebsocketConnectionHealth{}
+	mi := &file_rpc_proto_msgTypes[152]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *DataHistoryJob) GetInterval() int64 {
-	if x != nil {
-		return x.Interval
-	}
-	return 0
+func (x *WebsocketConnectionHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *DataHistoryJob) GetRequestSizeLimit() uint64 {
-	if x != nil {
-		return x.RequestSizeLimit
-	}
-	return 0
-}
+func (*WebsocketConnectionHealth) ProtoMessage() {}
 
-func (x *DataHistoryJob) GetMaxRetryAttempts() uint64 {
+func (x *WebsocketConnectionHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[152]
 	if x != nil {
-		return x.MaxRetryAttempts
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *DataHistoryJob) GetBatchSize() uint64 {
-	if x != nil {
-		return x.BatchSize
-	}
-	return 0
+// Deprecated: Use WebsocketConnectionHealth.ProtoReflect.Descriptor instead.
+func (*WebsocketConnectionHealth) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{152}
 }
 
-func (x *DataHistoryJob) GetStatus() string {
+func (x *WebsocketConnectionHealth) GetUrl() string {
 	if x != nil {
-		return x.Status
+		return x.Url
 	}
 	return ""
 }
 
-func (x *DataHistoryJob) GetDataType() string {
+func (x *WebsocketConnectionHealth) GetMessageFilter() string {
 	if x != nil {
-		return x.DataType
+		return x.MessageFilter
 	}
 	return ""
 }
 
-func (x *DataHistoryJob) GetConversionInterval() int64 {
-	if x != nil {
-		return x.ConversionInterval
-	}
-	return 0
-}
-
-func (x *DataHistoryJob) GetOverwriteExistingData() bool {
+func (x *WebsocketConnectionHealth) GetConnected() bool {
 	if x != nil {
-		return x.OverwriteExistingData
+		return x.Connected
 	}
 	return false
 }
 
-func (x *DataHistoryJob) GetPrerequisiteJobNickname() string {
-	if x != nil {
-		return x.PrerequisiteJobNickname
-	}
-	return ""
-}
-
-func (x *DataHistoryJob) GetDecimalPlaceComparison() uint64 {
+func (x *WebsocketConnectionHealth) GetSubscriptions() int64 {
 	if x != nil {
-		return x.DecimalPlaceComparison
+		return x.Subscriptions
 	}
 	return 0
 }
 
-func (x *DataHistoryJob) GetSecondaryExchangeName() string {
-	if x != nil {
-		return x.SecondaryExchangeName
-	}
-	return ""
-}
-
-func (x *DataHistoryJob) GetIssueTolerancePercentage() float64 {
+func (x *WebsocketConnectionHealth) GetMaxSubscriptions() int64 {
 	if x != nil {
-		return x.IssueTolerancePercentage
+		return x.MaxSubscriptions
 	}
 	return 0
 }
 
-func (x *DataHistoryJob) GetReplaceOnIssue() bool {
+func (x *WebsocketConnectionHealth) GetConnectedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.ReplaceOnIssue
+		return x.ConnectedAt
 	}
-	return false
+	return nil
 }
 
-func (x *DataHistoryJob) GetJobResults() []*DataHistoryJobResult {
+func (x *WebsocketConnectionHealth) GetLastMessage() *timestamppb.Timestamp {
 	if x != nil {
-		return x.JobResults
+		return x.LastMessage
 	}
 	return nil
 }
 
-func (x *DataHistoryJob) GetResultSummaries() []string {
+func (x *WebsocketConnectionHealth) GetMessages() uint64 {
 	if x != nil {
-		return x.ResultSummaries
+		return x.Messages
 	}
-	return nil
+	return 0
 }
 
-type DataHistoryJobResult struct {
+type WebsocketSetEnabledRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	StartDate     string                 `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate       string                 `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
-	HasData       bool                   `protobuf:"varint,3,opt,name=has_data,json=hasData,proto3" json:"has_data,omitempty"`
-	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
-	RunDate       string                 `protobuf:"bytes,5,opt,name=run_date,json=runDate,proto3" json:"run_date,omitempty"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Enable        bool                   `protobuf:"varint,2,opt,name=enable,proto3" json:"enable,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DataHistoryJobResult) Reset() {
-	*x = DataHistoryJobResult{}
-	mi := &file_rpc_proto_msgTypes[156]
+func (x *WebsocketSetEnabledRequest) Reset() {
+	*x = WebsocketSetEnabledRequest{}
+	mi := &file_rpc_proto_msgTypes[153]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DataHistoryJobResult) String() string {
+func (x *WebsocketSetEnabledRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DataHistoryJobResult) ProtoMessage() {}
+func (*WebsocketSetEnabledRequest) ProtoMessage() {}
 
-func (x *DataHistoryJobResult) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[156]
+func (x *WebsocketSetEnabledRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[153]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9619,68 +9404,47 @@ func (x *DataHistoryJobResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DataHistoryJobResult.ProtoReflect.Descriptor instead.
-func (*DataHistoryJobResult) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{156}
-}
-
-func (x *DataHistoryJobResult) GetStartDate() string {
-	if x != nil {
-		return x.StartDate
-	}
-	return ""
+// Deprecated: Use WebsocketSetEnabledRequest.ProtoReflect.Descriptor instead.
+func (*WebsocketSetEnabledRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{153}
 }
 
-func (x *DataHistoryJobResult) GetEndDate() string {
+func (x *WebsocketSetEnabledRequest) GetExchange() string {
 	if x != nil {
-		return x.EndDate
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *DataHistoryJobResult) GetHasData() bool {
+func (x *WebsocketSetEnabledRequest) GetEnable() bool {
 	if x != nil {
-		return x.HasData
+		return x.Enable
 	}
 	return false
 }
 
-func (x *DataHistoryJobResult) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
-}
-
-func (x *DataHistoryJobResult) GetRunDate() string {
-	if x != nil {
-		return x.RunDate
-	}
-	return ""
-}
-
-type DataHistoryJobs struct {
+type WebsocketGetSubscriptionsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Results       []*DataHistoryJob      `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DataHistoryJobs) Reset() {
-	*x = DataHistoryJobs{}
-	mi := &file_rpc_proto_msgTypes[157]
+func (x *WebsocketGetSubscriptionsRequest) Reset() {
+	*x = WebsocketGetSubscriptionsRequest{}
+	mi := &file_rpc_proto_msgTypes[154]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DataHistoryJobs) String() string {
+func (x *WebsocketGetSubscriptionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DataHistoryJobs) ProtoMessage() {}
+func (*WebsocketGetSubscriptionsRequest) ProtoMessage() {}
 
-func (x *DataHistoryJobs) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[157]
+func (x *WebsocketGetSubscriptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[154]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9691,41 +9455,43 @@ func (x *DataHistoryJobs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DataHistoryJobs.ProtoReflect.Descriptor instead.
-func (*DataHistoryJobs) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{157}
+// Deprecated: Use WebsocketGetSubscriptionsRequest.ProtoReflect.Descriptor instead.
+func (*WebsocketGetSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{154}
 }
 
-func (x *DataHistoryJobs) GetResults() []*DataHistoryJob {
+func (x *WebsocketGetSubscriptionsRequest) GetExchange() string {
 	if x != nil {
-		return x.Results
+		return x.Exchange
 	}
-	return nil
+	return ""
 }
 
-type GetDataHistoryJobsBetweenRequest struct {
+type WebsocketSubscription struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	StartDate     string                 `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate       string                 `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Channel       string                 `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	Pairs         string                 `protobuf:"bytes,2,opt,name=pairs,proto3" json:"pairs,omitempty"`
+	Asset         string                 `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
+	Params        string                 `protobuf:"bytes,4,opt,name=params,proto3" json:"params,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDataHistoryJobsBetweenRequest) Reset() {
-	*x = GetDataHistoryJobsBetweenRequest{}
-	mi := &file_rpc_proto_msgTypes[158]
+func (x *WebsocketSubscription) Reset() {
+	*x = WebsocketSubscription{}
+	mi := &file_rpc_proto_msgTypes[155]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDataHistoryJobsBetweenRequest) String() string {
+func (x *WebsocketSubscription) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDataHistoryJobsBetweenRequest) ProtoMessage() {}
+func (*WebsocketSubscription) ProtoMessage() {}
 
-func (x *GetDataHistoryJobsBetweenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[158]
+func (x *WebsocketSubscription) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[155]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9736,49 +9502,62 @@ func (x *GetDataHistoryJobsBetweenRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDataHistoryJobsBetweenRequest.ProtoReflect.Descriptor instead.
-func (*GetDataHistoryJobsBetweenRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{158}
+// Deprecated: Use WebsocketSubscription.ProtoReflect.Descriptor instead.
+func (*WebsocketSubscription) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{155}
 }
 
-func (x *GetDataHistoryJobsBetweenRequest) GetStartDate() string {
+func (x *WebsocketSubscription) GetChannel() string {
 	if x != nil {
-		return x.StartDate
+		return x.Channel
 	}
 	return ""
 }
 
-func (x *GetDataHistoryJobsBetweenRequest) GetEndDate() string {
+func (x *WebsocketSubscription) GetPairs() string {
 	if x != nil {
-		return x.EndDate
+		return x.Pairs
 	}
 	return ""
 }
 
-type SetDataHistoryJobStatusRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Nickname      string                 `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"`
-	Status        int64                  `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`
+func (x *WebsocketSubscription) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *WebsocketSubscription) GetParams() string {
+	if x != nil {
+		return x.Params
+	}
+	return ""
+}
+
+type WebsocketGetSubscriptionsResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Exchange      string                   `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Subscriptions []*WebsocketSubscription `protobuf:"bytes,2,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetDataHistoryJobStatusRequest) Reset() {
-	*x = SetDataHistoryJobStatusRequest{}
-	mi := &file_rpc_proto_msgTypes[159]
+func (x *WebsocketGetSubscriptionsResponse) Reset() {
+	*x = WebsocketGetSubscriptionsResponse{}
+	mi := &file_rpc_proto_msgTypes[156]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetDataHistoryJobStatusRequest) String() string {
+func (x *WebsocketGetSubscriptionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetDataHistoryJobStatusRequest) ProtoMessage() {}
+func (*WebsocketGetSubscriptionsResponse) ProtoMessage() {}
 
-func (x *SetDataHistoryJobStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[159]
+func (x *WebsocketGetSubscriptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[156]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9789,55 +9568,100 @@ func (x *SetDataHistoryJobStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetDataHistoryJobStatusRequest.ProtoReflect.Descriptor instead.
-func (*SetDataHistoryJobStatusRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{159}
+// Deprecated: Use WebsocketGetSubscriptionsResponse.ProtoReflect.Descriptor instead.
+func (*WebsocketGetSubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{156}
 }
 
-func (x *SetDataHistoryJobStatusRequest) GetId() string {
+func (x *WebsocketGetSubscriptionsResponse) GetExchange() string {
 	if x != nil {
-		return x.Id
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *SetDataHistoryJobStatusRequest) GetNickname() string {
+func (x *WebsocketGetSubscriptionsResponse) GetSubscriptions() []*WebsocketSubscription {
 	if x != nil {
-		return x.Nickname
+		return x.Subscriptions
+	}
+	return nil
+}
+
+type WebsocketSetProxyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Proxy         string                 `protobuf:"bytes,2,opt,name=proxy,proto3" json:"proxy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebsocketSetProxyRequest) Reset() {
+	*x = WebsocketSetProxyRequest{}
+	mi := &file_rpc_proto_msgTypes[157]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebsocketSetProxyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebsocketSetProxyRequest) ProtoMessage() {}
+
+func (x *WebsocketSetProxyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[157]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebsocketSetProxyRequest.ProtoReflect.Descriptor instead.
+func (*WebsocketSetProxyRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{157}
+}
+
+func (x *WebsocketSetProxyRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *SetDataHistoryJobStatusRequest) GetStatus() int64 {
+func (x *WebsocketSetProxyRequest) GetProxy() string {
 	if x != nil {
-		return x.Status
+		return x.Proxy
 	}
-	return 0
+	return ""
 }
 
-type UpdateDataHistoryJobPrerequisiteRequest struct {
-	state                   protoimpl.MessageState `protogen:"open.v1"`
-	Nickname                string                 `protobuf:"bytes,1,opt,name=nickname,proto3" json:"nickname,omitempty"`
-	PrerequisiteJobNickname string                 `protobuf:"bytes,2,opt,name=prerequisite_job_nickname,json=prerequisiteJobNickname,proto3" json:"prerequisite_job_nickname,omitempty"`
-	unknownFields           protoimpl.UnknownFields
-	sizeCache               protoimpl.SizeCache
+type WebsocketSetURLRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateDataHistoryJobPrerequisiteRequest) Reset() {
-	*x = UpdateDataHistoryJobPrerequisiteRequest{}
-	mi := &file_rpc_proto_msgTypes[160]
+func (x *WebsocketSetURLRequest) Reset() {
+	*x = WebsocketSetURLRequest{}
+	mi := &file_rpc_proto_msgTypes[158]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateDataHistoryJobPrerequisiteRequest) String() string {
+func (x *WebsocketSetURLRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateDataHistoryJobPrerequisiteRequest) ProtoMessage() {}
+func (*WebsocketSetURLRequest) ProtoMessage() {}
 
-func (x *UpdateDataHistoryJobPrerequisiteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[160]
+func (x *WebsocketSetURLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[158]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9848,52 +9672,52 @@ func (x *UpdateDataHistoryJobPrerequisiteRequest) ProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateDataHistoryJobPrerequisiteRequest.ProtoReflect.Descriptor instead.
-func (*UpdateDataHistoryJobPrerequisiteRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{160}
+// Deprecated: Use WebsocketSetURLRequest.ProtoReflect.Descriptor instead.
+func (*WebsocketSetURLRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{158}
 }
 
-func (x *UpdateDataHistoryJobPrerequisiteRequest) GetNickname() string {
+func (x *WebsocketSetURLRequest) GetExchange() string {
 	if x != nil {
-		return x.Nickname
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *UpdateDataHistoryJobPrerequisiteRequest) GetPrerequisiteJobNickname() string {
+func (x *WebsocketSetURLRequest) GetUrl() string {
 	if x != nil {
-		return x.PrerequisiteJobNickname
+		return x.Url
 	}
 	return ""
 }
 
-type ModifyOrderRequest struct {
+type FindMissingCandlePeriodsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	OrderId       string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	ExchangeName  string                 `protobuf:"bytes,1,opt,name=exchange_name,json=exchangeName,proto3" json:"exchange_name,omitempty"`
+	AssetType     string                 `protobuf:"bytes,2,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
 	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	Asset         string                 `protobuf:"bytes,4,opt,name=asset,proto3" json:"asset,omitempty"`
-	Amount        float64                `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
-	Price         float64                `protobuf:"fixed64,6,opt,name=price,proto3" json:"price,omitempty"`
+	Interval      int64                  `protobuf:"varint,4,opt,name=interval,proto3" json:"interval,omitempty"`
+	Start         string                 `protobuf:"bytes,5,opt,name=start,proto3" json:"start,omitempty"`
+	End           string                 `protobuf:"bytes,6,opt,name=end,proto3" json:"end,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ModifyOrderRequest) Reset() {
-	*x = ModifyOrderRequest{}
-	mi := &file_rpc_proto_msgTypes[161]
+func (x *FindMissingCandlePeriodsRequest) Reset() {
+	*x = FindMissingCandlePeriodsRequest{}
+	mi := &file_rpc_proto_msgTypes[159]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ModifyOrderRequest) String() string {
+func (x *FindMissingCandlePeriodsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ModifyOrderRequest) ProtoMessage() {}
+func (*FindMissingCandlePeriodsRequest) ProtoMessage() {}
 
-func (x *ModifyOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[161]
+func (x *FindMissingCandlePeriodsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[159]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9904,75 +9728,79 @@ func (x *ModifyOrderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ModifyOrderRequest.ProtoReflect.Descriptor instead.
-func (*ModifyOrderRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{161}
+// Deprecated: Use FindMissingCandlePeriodsRequest.ProtoReflect.Descriptor instead.
+func (*FindMissingCandlePeriodsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{159}
 }
 
-func (x *ModifyOrderRequest) GetExchange() string {
+func (x *FindMissingCandlePeriodsRequest) GetExchangeName() string {
 	if x != nil {
-		return x.Exchange
+		return x.ExchangeName
 	}
 	return ""
 }
 
-func (x *ModifyOrderRequest) GetOrderId() string {
+func (x *FindMissingCandlePeriodsRequest) GetAssetType() string {
 	if x != nil {
-		return x.OrderId
+		return x.AssetType
 	}
 	return ""
 }
 
-func (x *ModifyOrderRequest) GetPair() *CurrencyPair {
+func (x *FindMissingCandlePeriodsRequest) GetPair() *CurrencyPair {
 	if x != nil {
 		return x.Pair
 	}
 	return nil
 }
 
-func (x *ModifyOrderRequest) GetAsset() string {
+func (x *FindMissingCandlePeriodsRequest) GetInterval() int64 {
 	if x != nil {
-		return x.Asset
+		return x.Interval
 	}
-	return ""
+	return 0
 }
 
-func (x *ModifyOrderRequest) GetAmount() float64 {
+func (x *FindMissingCandlePeriodsRequest) GetStart() string {
 	if x != nil {
-		return x.Amount
+		return x.Start
 	}
-	return 0
+	return ""
 }
 
-func (x *ModifyOrderRequest) GetPrice() float64 {
+func (x *FindMissingCandlePeriodsRequest) GetEnd() string {
 	if x != nil {
-		return x.Price
+		return x.End
 	}
-	return 0
+	return ""
 }
 
-type ModifyOrderResponse struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	ModifiedOrderId string                 `protobuf:"bytes,1,opt,name=modified_order_id,json=modifiedOrderId,proto3" json:"modified_order_id,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type FindMissingTradePeriodsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExchangeName  string                 `protobuf:"bytes,1,opt,name=exchange_name,json=exchangeName,proto3" json:"exchange_name,omitempty"`
+	AssetType     string                 `protobuf:"bytes,2,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	Start         string                 `protobuf:"bytes,4,opt,name=start,proto3" json:"start,omitempty"`
+	End           string                 `protobuf:"bytes,5,opt,name=end,proto3" json:"end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ModifyOrderResponse) Reset() {
-	*x = ModifyOrderResponse{}
-	mi := &file_rpc_proto_msgTypes[162]
+func (x *FindMissingTradePeriodsRequest) Reset() {
+	*x = FindMissingTradePeriodsRequest{}
+	mi := &file_rpc_proto_msgTypes[160]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ModifyOrderResponse) String() string {
+func (x *FindMissingTradePeriodsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ModifyOrderResponse) ProtoMessage() {}
+func (*FindMissingTradePeriodsRequest) ProtoMessage() {}
 
-func (x *ModifyOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[162]
+func (x *FindMissingTradePeriodsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[160]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9983,86 +9811,72 @@ func (x *ModifyOrderResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ModifyOrderResponse.ProtoReflect.Descriptor instead.
-func (*ModifyOrderResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{162}
+// Deprecated: Use FindMissingTradePeriodsRequest.ProtoReflect.Descriptor instead.
+func (*FindMissingTradePeriodsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{160}
 }
 
-func (x *ModifyOrderResponse) GetModifiedOrderId() string {
+func (x *FindMissingTradePeriodsRequest) GetExchangeName() string {
 	if x != nil {
-		return x.ModifiedOrderId
+		return x.ExchangeName
 	}
 	return ""
 }
 
-type CurrencyStateGetAllRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *FindMissingTradePeriodsRequest) GetAssetType() string {
+	if x != nil {
+		return x.AssetType
+	}
+	return ""
 }
 
-func (x *CurrencyStateGetAllRequest) Reset() {
-	*x = CurrencyStateGetAllRequest{}
-	mi := &file_rpc_proto_msgTypes[163]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *CurrencyStateGetAllRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*CurrencyStateGetAllRequest) ProtoMessage() {}
-
-func (x *CurrencyStateGetAllRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[163]
+func (x *FindMissingTradePeriodsRequest) GetPair() *CurrencyPair {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Pair
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use CurrencyStateGetAllRequest.ProtoReflect.Descriptor instead.
-func (*CurrencyStateGetAllRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{163}
+func (x *FindMissingTradePeriodsRequest) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
 }
 
-func (x *CurrencyStateGetAllRequest) GetExchange() string {
+func (x *FindMissingTradePeriodsRequest) GetEnd() string {
 	if x != nil {
-		return x.Exchange
+		return x.End
 	}
 	return ""
 }
 
-type CurrencyStateTradingRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
-	Asset         string                 `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type FindMissingIntervalsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ExchangeName   string                 `protobuf:"bytes,1,opt,name=exchange_name,json=exchangeName,proto3" json:"exchange_name,omitempty"`
+	AssetType      string                 `protobuf:"bytes,2,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Pair           *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	MissingPeriods []string               `protobuf:"bytes,4,rep,name=missing_periods,json=missingPeriods,proto3" json:"missing_periods,omitempty"`
+	Status         string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *CurrencyStateTradingRequest) Reset() {
-	*x = CurrencyStateTradingRequest{}
-	mi := &file_rpc_proto_msgTypes[164]
+func (x *FindMissingIntervalsResponse) Reset() {
+	*x = FindMissingIntervalsResponse{}
+	mi := &file_rpc_proto_msgTypes[161]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CurrencyStateTradingRequest) String() string {
+func (x *FindMissingIntervalsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CurrencyStateTradingRequest) ProtoMessage() {}
+func (*FindMissingIntervalsResponse) ProtoMessage() {}
 
-func (x *CurrencyStateTradingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[164]
+func (x *FindMissingIntervalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[161]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10073,56 +9887,69 @@ func (x *CurrencyStateTradingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CurrencyStateTradingRequest.ProtoReflect.Descriptor instead.
-func (*CurrencyStateTradingRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{164}
+// Deprecated: Use FindMissingIntervalsResponse.ProtoReflect.Descriptor instead.
+func (*FindMissingIntervalsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{161}
 }
 
-func (x *CurrencyStateTradingRequest) GetExchange() string {
+func (x *FindMissingIntervalsResponse) GetExchangeName() string {
 	if x != nil {
-		return x.Exchange
+		return x.ExchangeName
 	}
 	return ""
 }
 
-func (x *CurrencyStateTradingRequest) GetCode() string {
+func (x *FindMissingIntervalsResponse) GetAssetType() string {
 	if x != nil {
-		return x.Code
+		return x.AssetType
 	}
 	return ""
 }
 
-func (x *CurrencyStateTradingRequest) GetAsset() string {
+func (x *FindMissingIntervalsResponse) GetPair() *CurrencyPair {
 	if x != nil {
-		return x.Asset
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *FindMissingIntervalsResponse) GetMissingPeriods() []string {
+	if x != nil {
+		return x.MissingPeriods
+	}
+	return nil
+}
+
+func (x *FindMissingIntervalsResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
 	}
 	return ""
 }
 
-type CurrencyStateTradingPairRequest struct {
+type SetExchangeTradeProcessingRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Pair          string                 `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
-	Asset         string                 `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
+	Status        bool                   `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CurrencyStateTradingPairRequest) Reset() {
-	*x = CurrencyStateTradingPairRequest{}
-	mi := &file_rpc_proto_msgTypes[165]
+func (x *SetExchangeTradeProcessingRequest) Reset() {
+	*x = SetExchangeTradeProcessingRequest{}
+	mi := &file_rpc_proto_msgTypes[162]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CurrencyStateTradingPairRequest) String() string {
+func (x *SetExchangeTradeProcessingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CurrencyStateTradingPairRequest) ProtoMessage() {}
+func (*SetExchangeTradeProcessingRequest) ProtoMessage() {}
 
-func (x *CurrencyStateTradingPairRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[165]
+func (x *SetExchangeTradeProcessingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[162]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10133,56 +9960,65 @@ func (x *CurrencyStateTradingPairRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CurrencyStateTradingPairRequest.ProtoReflect.Descriptor instead.
-func (*CurrencyStateTradingPairRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{165}
+// Deprecated: Use SetExchangeTradeProcessingRequest.ProtoReflect.Descriptor instead.
+func (*SetExchangeTradeProcessingRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{162}
 }
 
-func (x *CurrencyStateTradingPairRequest) GetExchange() string {
+func (x *SetExchangeTradeProcessingRequest) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *CurrencyStateTradingPairRequest) GetPair() string {
-	if x != nil {
-		return x.Pair
-	}
-	return ""
-}
-
-func (x *CurrencyStateTradingPairRequest) GetAsset() string {
+func (x *SetExchangeTradeProcessingRequest) GetStatus() bool {
 	if x != nil {
-		return x.Asset
+		return x.Status
 	}
-	return ""
+	return false
 }
 
-type CurrencyStateWithdrawRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
-	Asset         string                 `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type UpsertDataHistoryJobRequest struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	Nickname                 string                 `protobuf:"bytes,1,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	Exchange                 string                 `protobuf:"bytes,2,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset                    string                 `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair                     *CurrencyPair          `protobuf:"bytes,4,opt,name=pair,proto3" json:"pair,omitempty"`
+	StartDate                string                 `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate                  string                 `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Interval                 int64                  `protobuf:"varint,7,opt,name=interval,proto3" json:"interval,omitempty"`
+	RequestSizeLimit         uint64                 `protobuf:"varint,8,opt,name=request_size_limit,json=requestSizeLimit,proto3" json:"request_size_limit,omitempty"`
+	DataType                 int64                  `protobuf:"varint,9,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
+	MaxRetryAttempts         uint64                 `protobuf:"varint,10,opt,name=max_retry_attempts,json=maxRetryAttempts,proto3" json:"max_retry_attempts,omitempty"`
+	BatchSize                uint64                 `protobuf:"varint,11,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	InsertOnly               bool                   `protobuf:"varint,12,opt,name=insert_only,json=insertOnly,proto3" json:"insert_only,omitempty"`
+	ConversionInterval       int64                  `protobuf:"varint,13,opt,name=conversion_interval,json=conversionInterval,proto3" json:"conversion_interval,omitempty"`
+	OverwriteExistingData    bool                   `protobuf:"varint,14,opt,name=overwrite_existing_data,json=overwriteExistingData,proto3" json:"overwrite_existing_data,omitempty"`
+	PrerequisiteJobNickname  string                 `protobuf:"bytes,15,opt,name=prerequisite_job_nickname,json=prerequisiteJobNickname,proto3" json:"prerequisite_job_nickname,omitempty"`
+	DecimalPlaceComparison   uint64                 `protobuf:"varint,16,opt,name=decimal_place_comparison,json=decimalPlaceComparison,proto3" json:"decimal_place_comparison,omitempty"`
+	SecondaryExchangeName    string                 `protobuf:"bytes,17,opt,name=secondary_exchange_name,json=secondaryExchangeName,proto3" json:"secondary_exchange_name,omitempty"`
+	IssueTolerancePercentage float64                `protobuf:"fixed64,18,opt,name=issue_tolerance_percentage,json=issueTolerancePercentage,proto3" json:"issue_tolerance_percentage,omitempty"`
+	ReplaceOnIssue           bool                   `protobuf:"varint,19,opt,name=replace_on_issue,json=replaceOnIssue,proto3" json:"replace_on_issue,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
 }
 
-func (x *CurrencyStateWithdrawRequest) Reset() {
-	*x = CurrencyStateWithdrawRequest{}
-	mi := &file_rpc_proto_msgTypes[166]
+func (x *UpsertDataHistoryJobRequest) Reset() {
+	*x = UpsertDataHistoryJobRequest{}
+	mi := &file_rpc_proto_msgTypes[163]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CurrencyStateWithdrawRequest) String() string {
+func (x *UpsertDataHistoryJobRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CurrencyStateWithdrawRequest) ProtoMessage() {}
+func (*UpsertDataHistoryJobRequest) ProtoMessage() {}
 
-func (x *CurrencyStateWithdrawRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[166]
+func (x *UpsertDataHistoryJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[163]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10193,236 +10029,166 @@ func (x *CurrencyStateWithdrawRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CurrencyStateWithdrawRequest.ProtoReflect.Descriptor instead.
-func (*CurrencyStateWithdrawRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{166}
+// Deprecated: Use UpsertDataHistoryJobRequest.ProtoReflect.Descriptor instead.
+func (*UpsertDataHistoryJobRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{163}
 }
 
-func (x *CurrencyStateWithdrawRequest) GetExchange() string {
+func (x *UpsertDataHistoryJobRequest) GetNickname() string {
 	if x != nil {
-		return x.Exchange
+		return x.Nickname
 	}
 	return ""
 }
 
-func (x *CurrencyStateWithdrawRequest) GetCode() string {
+func (x *UpsertDataHistoryJobRequest) GetExchange() string {
 	if x != nil {
-		return x.Code
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *CurrencyStateWithdrawRequest) GetAsset() string {
+func (x *UpsertDataHistoryJobRequest) GetAsset() string {
 	if x != nil {
 		return x.Asset
 	}
 	return ""
 }
 
-type CurrencyStateDepositRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
-	Asset         string                 `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *CurrencyStateDepositRequest) Reset() {
-	*x = CurrencyStateDepositRequest{}
-	mi := &file_rpc_proto_msgTypes[167]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *CurrencyStateDepositRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*CurrencyStateDepositRequest) ProtoMessage() {}
-
-func (x *CurrencyStateDepositRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[167]
+func (x *UpsertDataHistoryJobRequest) GetPair() *CurrencyPair {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Pair
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use CurrencyStateDepositRequest.ProtoReflect.Descriptor instead.
-func (*CurrencyStateDepositRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{167}
+	return nil
 }
 
-func (x *CurrencyStateDepositRequest) GetExchange() string {
+func (x *UpsertDataHistoryJobRequest) GetStartDate() string {
 	if x != nil {
-		return x.Exchange
+		return x.StartDate
 	}
 	return ""
 }
 
-func (x *CurrencyStateDepositRequest) GetCode() string {
+func (x *UpsertDataHistoryJobRequest) GetEndDate() string {
 	if x != nil {
-		return x.Code
+		return x.EndDate
 	}
 	return ""
 }
 
-func (x *CurrencyStateDepositRequest) GetAsset() string {
+func (x *UpsertDataHistoryJobRequest) GetInterval() int64 {
 	if x != nil {
-		return x.Asset
+		return x.Interval
 	}
-	return ""
+	return 0
 }
 
-type CurrencyStateResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	CurrencyStates []*CurrencyState       `protobuf:"bytes,1,rep,name=currency_states,json=currencyStates,proto3" json:"currency_states,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *UpsertDataHistoryJobRequest) GetRequestSizeLimit() uint64 {
+	if x != nil {
+		return x.RequestSizeLimit
+	}
+	return 0
 }
 
-func (x *CurrencyStateResponse) Reset() {
-	*x = CurrencyStateResponse{}
-	mi := &file_rpc_proto_msgTypes[168]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *CurrencyStateResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*CurrencyStateResponse) ProtoMessage() {}
-
-func (x *CurrencyStateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[168]
+func (x *UpsertDataHistoryJobRequest) GetDataType() int64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.DataType
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use CurrencyStateResponse.ProtoReflect.Descriptor instead.
-func (*CurrencyStateResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{168}
+	return 0
 }
 
-func (x *CurrencyStateResponse) GetCurrencyStates() []*CurrencyState {
+func (x *UpsertDataHistoryJobRequest) GetMaxRetryAttempts() uint64 {
 	if x != nil {
-		return x.CurrencyStates
+		return x.MaxRetryAttempts
 	}
-	return nil
-}
-
-type CurrencyState struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Currency        string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
-	Asset           string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	WithdrawEnabled bool                   `protobuf:"varint,3,opt,name=withdraw_enabled,json=withdrawEnabled,proto3" json:"withdraw_enabled,omitempty"`
-	DepositEnabled  bool                   `protobuf:"varint,4,opt,name=deposit_enabled,json=depositEnabled,proto3" json:"deposit_enabled,omitempty"`
-	TradingEnabled  bool                   `protobuf:"varint,5,opt,name=trading_enabled,json=tradingEnabled,proto3" json:"trading_enabled,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	return 0
 }
 
-func (x *CurrencyState) Reset() {
-	*x = CurrencyState{}
-	mi := &file_rpc_proto_msgTypes[169]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *UpsertDataHistoryJobRequest) GetBatchSize() uint64 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
 }
 
-func (x *CurrencyState) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *UpsertDataHistoryJobRequest) GetInsertOnly() bool {
+	if x != nil {
+		return x.InsertOnly
+	}
+	return false
 }
 
-func (*CurrencyState) ProtoMessage() {}
-
-func (x *CurrencyState) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[169]
+func (x *UpsertDataHistoryJobRequest) GetConversionInterval() int64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ConversionInterval
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use CurrencyState.ProtoReflect.Descriptor instead.
-func (*CurrencyState) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{169}
+func (x *UpsertDataHistoryJobRequest) GetOverwriteExistingData() bool {
+	if x != nil {
+		return x.OverwriteExistingData
+	}
+	return false
 }
 
-func (x *CurrencyState) GetCurrency() string {
+func (x *UpsertDataHistoryJobRequest) GetPrerequisiteJobNickname() string {
 	if x != nil {
-		return x.Currency
+		return x.PrerequisiteJobNickname
 	}
 	return ""
 }
 
-func (x *CurrencyState) GetAsset() string {
+func (x *UpsertDataHistoryJobRequest) GetDecimalPlaceComparison() uint64 {
 	if x != nil {
-		return x.Asset
+		return x.DecimalPlaceComparison
 	}
-	return ""
+	return 0
 }
 
-func (x *CurrencyState) GetWithdrawEnabled() bool {
+func (x *UpsertDataHistoryJobRequest) GetSecondaryExchangeName() string {
 	if x != nil {
-		return x.WithdrawEnabled
+		return x.SecondaryExchangeName
 	}
-	return false
+	return ""
 }
 
-func (x *CurrencyState) GetDepositEnabled() bool {
+func (x *UpsertDataHistoryJobRequest) GetIssueTolerancePercentage() float64 {
 	if x != nil {
-		return x.DepositEnabled
+		return x.IssueTolerancePercentage
 	}
-	return false
+	return 0
 }
 
-func (x *CurrencyState) GetTradingEnabled() bool {
+func (x *UpsertDataHistoryJobRequest) GetReplaceOnIssue() bool {
 	if x != nil {
-		return x.TradingEnabled
+		return x.ReplaceOnIssue
 	}
 	return false
 }
 
-type FundingRate struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
-	Rate          string                 `protobuf:"bytes,2,opt,name=rate,proto3" json:"rate,omitempty"`
-	Payment       string                 `protobuf:"bytes,3,opt,name=payment,proto3" json:"payment,omitempty"`
+type InsertSequentialJobsRequest struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Jobs          []*UpsertDataHistoryJobRequest `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FundingRate) Reset() {
-	*x = FundingRate{}
-	mi := &file_rpc_proto_msgTypes[170]
+func (x *InsertSequentialJobsRequest) Reset() {
+	*x = InsertSequentialJobsRequest{}
+	mi := &file_rpc_proto_msgTypes[164]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FundingRate) String() string {
+func (x *InsertSequentialJobsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FundingRate) ProtoMessage() {}
+func (*InsertSequentialJobsRequest) ProtoMessage() {}
 
-func (x *FundingRate) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[170]
+func (x *InsertSequentialJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[164]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10433,65 +10199,40 @@ func (x *FundingRate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FundingRate.ProtoReflect.Descriptor instead.
-func (*FundingRate) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{170}
-}
-
-func (x *FundingRate) GetDate() string {
-	if x != nil {
-		return x.Date
-	}
-	return ""
-}
-
-func (x *FundingRate) GetRate() string {
-	if x != nil {
-		return x.Rate
-	}
-	return ""
+// Deprecated: Use InsertSequentialJobsRequest.ProtoReflect.Descriptor instead.
+func (*InsertSequentialJobsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{164}
 }
 
-func (x *FundingRate) GetPayment() string {
+func (x *InsertSequentialJobsRequest) GetJobs() []*UpsertDataHistoryJobRequest {
 	if x != nil {
-		return x.Payment
+		return x.Jobs
 	}
-	return ""
+	return nil
 }
 
-type FundingData struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Exchange        string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset           string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair            *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	PaymentCurrency string                 `protobuf:"bytes,4,opt,name=payment_currency,json=paymentCurrency,proto3" json:"payment_currency,omitempty"`
-	StartDate       string                 `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate         string                 `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
-	Rates           []*FundingRate         `protobuf:"bytes,7,rep,name=rates,proto3" json:"rates,omitempty"`
-	LatestRate      *FundingRate           `protobuf:"bytes,8,opt,name=latest_rate,json=latestRate,proto3" json:"latest_rate,omitempty"`
-	UpcomingRate    *FundingRate           `protobuf:"bytes,9,opt,name=upcoming_rate,json=upcomingRate,proto3" json:"upcoming_rate,omitempty"`
-	PaymentSum      string                 `protobuf:"bytes,10,opt,name=payment_sum,json=paymentSum,proto3" json:"payment_sum,omitempty"`
-	PaymentMessage  string                 `protobuf:"bytes,11,opt,name=payment_message,json=paymentMessage,proto3" json:"payment_message,omitempty"`
-	TimeOfNextRate  string                 `protobuf:"bytes,12,opt,name=time_of_next_rate,json=timeOfNextRate,proto3" json:"time_of_next_rate,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type InsertSequentialJobsResponse struct {
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	Jobs          []*UpsertDataHistoryJobResponse `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FundingData) Reset() {
-	*x = FundingData{}
-	mi := &file_rpc_proto_msgTypes[171]
+func (x *InsertSequentialJobsResponse) Reset() {
+	*x = InsertSequentialJobsResponse{}
+	mi := &file_rpc_proto_msgTypes[165]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FundingData) String() string {
+func (x *InsertSequentialJobsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FundingData) ProtoMessage() {}
+func (*InsertSequentialJobsResponse) ProtoMessage() {}
 
-func (x *FundingData) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[171]
+func (x *InsertSequentialJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[165]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10502,143 +10243,173 @@ func (x *FundingData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FundingData.ProtoReflect.Descriptor instead.
-func (*FundingData) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{171}
+// Deprecated: Use InsertSequentialJobsResponse.ProtoReflect.Descriptor instead.
+func (*InsertSequentialJobsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{165}
 }
 
-func (x *FundingData) GetExchange() string {
+func (x *InsertSequentialJobsResponse) GetJobs() []*UpsertDataHistoryJobResponse {
 	if x != nil {
-		return x.Exchange
+		return x.Jobs
 	}
-	return ""
+	return nil
 }
 
-func (x *FundingData) GetAsset() string {
-	if x != nil {
-		return x.Asset
-	}
-	return ""
+type UpsertDataHistoryJobResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	JobId         string                 `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FundingData) GetPair() *CurrencyPair {
-	if x != nil {
-		return x.Pair
-	}
-	return nil
+func (x *UpsertDataHistoryJobResponse) Reset() {
+	*x = UpsertDataHistoryJobResponse{}
+	mi := &file_rpc_proto_msgTypes[166]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *FundingData) GetPaymentCurrency() string {
+func (x *UpsertDataHistoryJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertDataHistoryJobResponse) ProtoMessage() {}
+
+func (x *UpsertDataHistoryJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[166]
 	if x != nil {
-		return x.PaymentCurrency
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *FundingData) GetStartDate() string {
+// Deprecated: Use UpsertDataHistoryJobResponse.ProtoReflect.Descriptor instead.
+func (*UpsertDataHistoryJobResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{166}
+}
+
+func (x *UpsertDataHistoryJobResponse) GetMessage() string {
 	if x != nil {
-		return x.StartDate
+		return x.Message
 	}
 	return ""
 }
 
-func (x *FundingData) GetEndDate() string {
+func (x *UpsertDataHistoryJobResponse) GetJobId() string {
 	if x != nil {
-		return x.EndDate
+		return x.JobId
 	}
 	return ""
 }
 
-func (x *FundingData) GetRates() []*FundingRate {
-	if x != nil {
-		return x.Rates
-	}
-	return nil
+type GetDataHistoryJobDetailsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Nickname      string                 `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	FullDetails   bool                   `protobuf:"varint,3,opt,name=full_details,json=fullDetails,proto3" json:"full_details,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FundingData) GetLatestRate() *FundingRate {
-	if x != nil {
-		return x.LatestRate
-	}
-	return nil
+func (x *GetDataHistoryJobDetailsRequest) Reset() {
+	*x = GetDataHistoryJobDetailsRequest{}
+	mi := &file_rpc_proto_msgTypes[167]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *FundingData) GetUpcomingRate() *FundingRate {
+func (x *GetDataHistoryJobDetailsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataHistoryJobDetailsRequest) ProtoMessage() {}
+
+func (x *GetDataHistoryJobDetailsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[167]
 	if x != nil {
-		return x.UpcomingRate
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *FundingData) GetPaymentSum() string {
+// Deprecated: Use GetDataHistoryJobDetailsRequest.ProtoReflect.Descriptor instead.
+func (*GetDataHistoryJobDetailsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{167}
+}
+
+func (x *GetDataHistoryJobDetailsRequest) GetId() string {
 	if x != nil {
-		return x.PaymentSum
+		return x.Id
 	}
 	return ""
 }
 
-func (x *FundingData) GetPaymentMessage() string {
+func (x *GetDataHistoryJobDetailsRequest) GetNickname() string {
 	if x != nil {
-		return x.PaymentMessage
+		return x.Nickname
 	}
 	return ""
 }
 
-func (x *FundingData) GetTimeOfNextRate() string {
+func (x *GetDataHistoryJobDetailsRequest) GetFullDetails() bool {
 	if x != nil {
-		return x.TimeOfNextRate
+		return x.FullDetails
 	}
-	return ""
+	return false
 }
 
-type FuturesPositionStats struct {
-	state                        protoimpl.MessageState `protogen:"open.v1"`
-	MaintenanceMarginRequirement string                 `protobuf:"bytes,1,opt,name=maintenance_margin_requirement,json=maintenanceMarginRequirement,proto3" json:"maintenance_margin_requirement,omitempty"`
-	InitialMarginRequirement     string                 `protobuf:"bytes,2,opt,name=initial_margin_requirement,json=initialMarginRequirement,proto3" json:"initial_margin_requirement,omitempty"`
-	EstimatedLiquidationPrice    string                 `protobuf:"bytes,3,opt,name=estimated_liquidation_price,json=estimatedLiquidationPrice,proto3" json:"estimated_liquidation_price,omitempty"`
-	CollateralUsed               string                 `protobuf:"bytes,4,opt,name=collateral_used,json=collateralUsed,proto3" json:"collateral_used,omitempty"`
-	MarkPrice                    string                 `protobuf:"bytes,5,opt,name=mark_price,json=markPrice,proto3" json:"mark_price,omitempty"`
-	CurrentSize                  string                 `protobuf:"bytes,6,opt,name=current_size,json=currentSize,proto3" json:"current_size,omitempty"`
-	ContractSize                 string                 `protobuf:"bytes,7,opt,name=contract_size,json=contractSize,proto3" json:"contract_size,omitempty"`
-	ContractMultiplier           string                 `protobuf:"bytes,8,opt,name=contract_multiplier,json=contractMultiplier,proto3" json:"contract_multiplier,omitempty"`
-	ContractSettlementType       string                 `protobuf:"bytes,9,opt,name=contract_settlement_type,json=contractSettlementType,proto3" json:"contract_settlement_type,omitempty"`
-	BreakEvenPrice               string                 `protobuf:"bytes,10,opt,name=break_even_price,json=breakEvenPrice,proto3" json:"break_even_price,omitempty"`
-	AverageOpenPrice             string                 `protobuf:"bytes,11,opt,name=average_open_price,json=averageOpenPrice,proto3" json:"average_open_price,omitempty"`
-	RecentPnl                    string                 `protobuf:"bytes,12,opt,name=recent_pnl,json=recentPnl,proto3" json:"recent_pnl,omitempty"`
-	MarginFraction               string                 `protobuf:"bytes,13,opt,name=margin_fraction,json=marginFraction,proto3" json:"margin_fraction,omitempty"`
-	FreeCollateral               string                 `protobuf:"bytes,14,opt,name=free_collateral,json=freeCollateral,proto3" json:"free_collateral,omitempty"`
-	TotalCollateral              string                 `protobuf:"bytes,15,opt,name=total_collateral,json=totalCollateral,proto3" json:"total_collateral,omitempty"`
-	FrozenBalance                string                 `protobuf:"bytes,16,opt,name=frozen_balance,json=frozenBalance,proto3" json:"frozen_balance,omitempty"`
-	EquityOfCurrency             string                 `protobuf:"bytes,17,opt,name=equity_of_currency,json=equityOfCurrency,proto3" json:"equity_of_currency,omitempty"`
-	AvailableEquity              string                 `protobuf:"bytes,18,opt,name=available_equity,json=availableEquity,proto3" json:"available_equity,omitempty"`
-	CashBalance                  string                 `protobuf:"bytes,19,opt,name=cash_balance,json=cashBalance,proto3" json:"cash_balance,omitempty"`
-	DiscountEquity               string                 `protobuf:"bytes,20,opt,name=discount_equity,json=discountEquity,proto3" json:"discount_equity,omitempty"`
-	EquityUsd                    string                 `protobuf:"bytes,21,opt,name=equity_usd,json=equityUsd,proto3" json:"equity_usd,omitempty"`
-	IsolatedEquity               string                 `protobuf:"bytes,22,opt,name=isolated_equity,json=isolatedEquity,proto3" json:"isolated_equity,omitempty"`
-	IsolatedLiabilities          string                 `protobuf:"bytes,23,opt,name=isolated_liabilities,json=isolatedLiabilities,proto3" json:"isolated_liabilities,omitempty"`
-	IsolatedUpl                  string                 `protobuf:"bytes,24,opt,name=isolated_upl,json=isolatedUpl,proto3" json:"isolated_upl,omitempty"`
-	NotionalLeverage             string                 `protobuf:"bytes,25,opt,name=notional_leverage,json=notionalLeverage,proto3" json:"notional_leverage,omitempty"`
-	TotalEquity                  string                 `protobuf:"bytes,26,opt,name=total_equity,json=totalEquity,proto3" json:"total_equity,omitempty"`
-	StrategyEquity               string                 `protobuf:"bytes,27,opt,name=strategy_equity,json=strategyEquity,proto3" json:"strategy_equity,omitempty"`
-	unknownFields                protoimpl.UnknownFields
-	sizeCache                    protoimpl.SizeCache
+type DataHistoryJob struct {
+	state                    protoimpl.MessageState  `protogen:"open.v1"`
+	Id                       string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Nickname                 string                  `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	Exchange                 string                  `protobuf:"bytes,3,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset                    string                  `protobuf:"bytes,4,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair                     *CurrencyPair           `protobuf:"bytes,5,opt,name=pair,proto3" json:"pair,omitempty"`
+	StartDate                string                  `protobuf:"bytes,6,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate                  string                  `protobuf:"bytes,7,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Interval                 int64                   `protobuf:"varint,8,opt,name=interval,proto3" json:"interval,omitempty"`
+	RequestSizeLimit         uint64                  `protobuf:"varint,9,opt,name=request_size_limit,json=requestSizeLimit,proto3" json:"request_size_limit,omitempty"`
+	MaxRetryAttempts         uint64                  `protobuf:"varint,10,opt,name=max_retry_attempts,json=maxRetryAttempts,proto3" json:"max_retry_attempts,omitempty"`
+	BatchSize                uint64                  `protobuf:"varint,11,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	Status                   string                  `protobuf:"bytes,12,opt,name=status,proto3" json:"status,omitempty"`
+	DataType                 string                  `protobuf:"bytes,13,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
+	ConversionInterval       int64                   `protobuf:"varint,14,opt,name=conversion_interval,json=conversionInterval,proto3" json:"conversion_interval,omitempty"`
+	OverwriteExistingData    bool                    `protobuf:"varint,15,opt,name=overwrite_existing_data,json=overwriteExistingData,proto3" json:"overwrite_existing_data,omitempty"`
+	PrerequisiteJobNickname  string                  `protobuf:"bytes,16,opt,name=prerequisite_job_nickname,json=prerequisiteJobNickname,proto3" json:"prerequisite_job_nickname,omitempty"`
+	DecimalPlaceComparison   uint64                  `protobuf:"varint,17,opt,name=decimal_place_comparison,json=decimalPlaceComparison,proto3" json:"decimal_place_comparison,omitempty"`
+	SecondaryExchangeName    string                  `protobuf:"bytes,18,opt,name=secondary_exchange_name,json=secondaryExchangeName,proto3" json:"secondary_exchange_name,omitempty"`
+	IssueTolerancePercentage float64                 `protobuf:"fixed64,19,opt,name=issue_tolerance_percentage,json=issueTolerancePercentage,proto3" json:"issue_tolerance_percentage,omitempty"`
+	ReplaceOnIssue           bool                    `protobuf:"varint,20,opt,name=replace_on_issue,json=replaceOnIssue,proto3" json:"replace_on_issue,omitempty"`
+	JobResults               []*DataHistoryJobResult `protobuf:"bytes,21,rep,name=job_results,json=jobResults,proto3" json:"job_results,omitempty"`
+	ResultSummaries          []string                `protobuf:"bytes,22,rep,name=result_summaries,json=resultSummaries,proto3" json:"result_summaries,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
 }
 
-func (x *FuturesPositionStats) Reset() {
-	*x = FuturesPositionStats{}
-	mi := &file_rpc_proto_msgTypes[172]
+func (x *DataHistoryJob) Reset() {
+	*x = DataHistoryJob{}
+	mi := &file_rpc_proto_msgTypes[168]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FuturesPositionStats) String() string {
+func (x *DataHistoryJob) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FuturesPositionStats) ProtoMessage() {}
+func (*DataHistoryJob) ProtoMessage() {}
 
-func (x *FuturesPositionStats) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[172]
+func (x *DataHistoryJob) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[168]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10649,240 +10420,2293 @@ func (x *FuturesPositionStats) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FuturesPositionStats.ProtoReflect.Descriptor instead.
-func (*FuturesPositionStats) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{172}
-}
-
-func (x *FuturesPositionStats) GetMaintenanceMarginRequirement() string {
-	if x != nil {
-		return x.MaintenanceMarginRequirement
-	}
-	return ""
+// Deprecated: Use DataHistoryJob.ProtoReflect.Descriptor instead.
+func (*DataHistoryJob) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{168}
 }
 
-func (x *FuturesPositionStats) GetInitialMarginRequirement() string {
+func (x *DataHistoryJob) GetId() string {
 	if x != nil {
-		return x.InitialMarginRequirement
+		return x.Id
 	}
 	return ""
 }
 
-func (x *FuturesPositionStats) GetEstimatedLiquidationPrice() string {
+func (x *DataHistoryJob) GetNickname() string {
 	if x != nil {
-		return x.EstimatedLiquidationPrice
+		return x.Nickname
 	}
 	return ""
 }
 
-func (x *FuturesPositionStats) GetCollateralUsed() string {
+func (x *DataHistoryJob) GetExchange() string {
 	if x != nil {
-		return x.CollateralUsed
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *FuturesPositionStats) GetMarkPrice() string {
+func (x *DataHistoryJob) GetAsset() string {
 	if x != nil {
-		return x.MarkPrice
+		return x.Asset
 	}
 	return ""
 }
 
-func (x *FuturesPositionStats) GetCurrentSize() string {
+func (x *DataHistoryJob) GetPair() *CurrencyPair {
 	if x != nil {
-		return x.CurrentSize
+		return x.Pair
 	}
-	return ""
+	return nil
 }
 
-func (x *FuturesPositionStats) GetContractSize() string {
+func (x *DataHistoryJob) GetStartDate() string {
 	if x != nil {
-		return x.ContractSize
+		return x.StartDate
 	}
 	return ""
 }
 
-func (x *FuturesPositionStats) GetContractMultiplier() string {
+func (x *DataHistoryJob) GetEndDate() string {
 	if x != nil {
-		return x.ContractMultiplier
+		return x.EndDate
 	}
 	return ""
 }
 
-func (x *FuturesPositionStats) GetContractSettlementType() string {
+func (x *DataHistoryJob) GetInterval() int64 {
 	if x != nil {
-		return x.ContractSettlementType
+		return x.Interval
 	}
-	return ""
+	return 0
 }
 
-func (x *FuturesPositionStats) GetBreakEvenPrice() string {
+func (x *DataHistoryJob) GetRequestSizeLimit() uint64 {
 	if x != nil {
-		return x.BreakEvenPrice
+		return x.RequestSizeLimit
 	}
-	return ""
+	return 0
 }
 
-func (x *FuturesPositionStats) GetAverageOpenPrice() string {
+func (x *DataHistoryJob) GetMaxRetryAttempts() uint64 {
 	if x != nil {
-		return x.AverageOpenPrice
+		return x.MaxRetryAttempts
 	}
-	return ""
+	return 0
 }
 
-func (x *FuturesPositionStats) GetRecentPnl() string {
+func (x *DataHistoryJob) GetBatchSize() uint64 {
 	if x != nil {
-		return x.RecentPnl
+		return x.BatchSize
 	}
-	return ""
+	return 0
 }
 
-func (x *FuturesPositionStats) GetMarginFraction() string {
+func (x *DataHistoryJob) GetStatus() string {
 	if x != nil {
-		return x.MarginFraction
+		return x.Status
 	}
 	return ""
 }
 
-func (x *FuturesPositionStats) GetFreeCollateral() string {
+func (x *DataHistoryJob) GetDataType() string {
 	if x != nil {
-		return x.FreeCollateral
+		return x.DataType
 	}
 	return ""
 }
 
-func (x *FuturesPositionStats) GetTotalCollateral() string {
+func (x *DataHistoryJob) GetConversionInterval() int64 {
 	if x != nil {
-		return x.TotalCollateral
+		return x.ConversionInterval
 	}
-	return ""
+	return 0
 }
 
-func (x *FuturesPositionStats) GetFrozenBalance() string {
+func (x *DataHistoryJob) GetOverwriteExistingData() bool {
 	if x != nil {
-		return x.FrozenBalance
+		return x.OverwriteExistingData
 	}
-	return ""
+	return false
 }
 
-func (x *FuturesPositionStats) GetEquityOfCurrency() string {
+func (x *DataHistoryJob) GetPrerequisiteJobNickname() string {
 	if x != nil {
-		return x.EquityOfCurrency
+		return x.PrerequisiteJobNickname
 	}
 	return ""
 }
 
-func (x *FuturesPositionStats) GetAvailableEquity() string {
+func (x *DataHistoryJob) GetDecimalPlaceComparison() uint64 {
 	if x != nil {
-		return x.AvailableEquity
+		return x.DecimalPlaceComparison
 	}
-	return ""
+	return 0
 }
 
-func (x *FuturesPositionStats) GetCashBalance() string {
+func (x *DataHistoryJob) GetSecondaryExchangeName() string {
 	if x != nil {
-		return x.CashBalance
+		return x.SecondaryExchangeName
 	}
 	return ""
 }
 
-func (x *FuturesPositionStats) GetDiscountEquity() string {
+func (x *DataHistoryJob) GetIssueTolerancePercentage() float64 {
 	if x != nil {
-		return x.DiscountEquity
+		return x.IssueTolerancePercentage
 	}
-	return ""
+	return 0
 }
 
-func (x *FuturesPositionStats) GetEquityUsd() string {
+func (x *DataHistoryJob) GetReplaceOnIssue() bool {
 	if x != nil {
-		return x.EquityUsd
+		return x.ReplaceOnIssue
 	}
-	return ""
+	return false
 }
 
-func (x *FuturesPositionStats) GetIsolatedEquity() string {
+func (x *DataHistoryJob) GetJobResults() []*DataHistoryJobResult {
 	if x != nil {
-		return x.IsolatedEquity
+		return x.JobResults
 	}
-	return ""
+	return nil
 }
 
-func (x *FuturesPositionStats) GetIsolatedLiabilities() string {
+func (x *DataHistoryJob) GetResultSummaries() []string {
 	if x != nil {
-		return x.IsolatedLiabilities
+		return x.ResultSummaries
 	}
-	return ""
+	return nil
 }
 
-func (x *FuturesPositionStats) GetIsolatedUpl() string {
-	if x != nil {
+type DataHistoryJobResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartDate     string                 `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       string                 `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	HasData       bool                   `protobuf:"varint,3,opt,name=has_data,json=hasData,proto3" json:"has_data,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	RunDate       string                 `protobuf:"bytes,5,opt,name=run_date,json=runDate,proto3" json:"run_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DataHistoryJobResult) Reset() {
+	*x = DataHistoryJobResult{}
+	mi := &file_rpc_proto_msgTypes[169]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DataHistoryJobResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataHistoryJobResult) ProtoMessage() {}
+
+func (x *DataHistoryJobResult) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[169]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataHistoryJobResult.ProtoReflect.Descriptor instead.
+func (*DataHistoryJobResult) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{169}
+}
+
+func (x *DataHistoryJobResult) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *DataHistoryJobResult) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *DataHistoryJobResult) GetHasData() bool {
+	if x != nil {
+		return x.HasData
+	}
+	return false
+}
+
+func (x *DataHistoryJobResult) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *DataHistoryJobResult) GetRunDate() string {
+	if x != nil {
+		return x.RunDate
+	}
+	return ""
+}
+
+type DataHistoryJobs struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*DataHistoryJob      `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DataHistoryJobs) Reset() {
+	*x = DataHistoryJobs{}
+	mi := &file_rpc_proto_msgTypes[170]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DataHistoryJobs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataHistoryJobs) ProtoMessage() {}
+
+func (x *DataHistoryJobs) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[170]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataHistoryJobs.ProtoReflect.Descriptor instead.
+func (*DataHistoryJobs) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{170}
+}
+
+func (x *DataHistoryJobs) GetResults() []*DataHistoryJob {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type GetDataHistoryJobsBetweenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartDate     string                 `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       string                 `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDataHistoryJobsBetweenRequest) Reset() {
+	*x = GetDataHistoryJobsBetweenRequest{}
+	mi := &file_rpc_proto_msgTypes[171]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDataHistoryJobsBetweenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataHistoryJobsBetweenRequest) ProtoMessage() {}
+
+func (x *GetDataHistoryJobsBetweenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[171]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataHistoryJobsBetweenRequest.ProtoReflect.Descriptor instead.
+func (*GetDataHistoryJobsBetweenRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{171}
+}
+
+func (x *GetDataHistoryJobsBetweenRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *GetDataHistoryJobsBetweenRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+type SetDataHistoryJobStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Nickname      string                 `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	Status        int64                  `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDataHistoryJobStatusRequest) Reset() {
+	*x = SetDataHistoryJobStatusRequest{}
+	mi := &file_rpc_proto_msgTypes[172]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDataHistoryJobStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDataHistoryJobStatusRequest) ProtoMessage() {}
+
+func (x *SetDataHistoryJobStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[172]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDataHistoryJobStatusRequest.ProtoReflect.Descriptor instead.
+func (*SetDataHistoryJobStatusRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{172}
+}
+
+func (x *SetDataHistoryJobStatusRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SetDataHistoryJobStatusRequest) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *SetDataHistoryJobStatusRequest) GetStatus() int64 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+type UpdateDataHistoryJobPrerequisiteRequest struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	Nickname                string                 `protobuf:"bytes,1,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	PrerequisiteJobNickname string                 `protobuf:"bytes,2,opt,name=prerequisite_job_nickname,json=prerequisiteJobNickname,proto3" json:"prerequisite_job_nickname,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *UpdateDataHistoryJobPrerequisiteRequest) Reset() {
+	*x = UpdateDataHistoryJobPrerequisiteRequest{}
+	mi := &file_rpc_proto_msgTypes[173]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDataHistoryJobPrerequisiteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDataHistoryJobPrerequisiteRequest) ProtoMessage() {}
+
+func (x *UpdateDataHistoryJobPrerequisiteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[173]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDataHistoryJobPrerequisiteRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDataHistoryJobPrerequisiteRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{173}
+}
+
+func (x *UpdateDataHistoryJobPrerequisiteRequest) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *UpdateDataHistoryJobPrerequisiteRequest) GetPrerequisiteJobNickname() string {
+	if x != nil {
+		return x.PrerequisiteJobNickname
+	}
+	return ""
+}
+
+type ModifyOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	OrderId       string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	Asset         string                 `protobuf:"bytes,4,opt,name=asset,proto3" json:"asset,omitempty"`
+	Amount        float64                `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Price         float64                `protobuf:"fixed64,6,opt,name=price,proto3" json:"price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModifyOrderRequest) Reset() {
+	*x = ModifyOrderRequest{}
+	mi := &file_rpc_proto_msgTypes[174]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModifyOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModifyOrderRequest) ProtoMessage() {}
+
+func (x *ModifyOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[174]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModifyOrderRequest.ProtoReflect.Descriptor instead.
+func (*ModifyOrderRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{174}
+}
+
+func (x *ModifyOrderRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *ModifyOrderRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *ModifyOrderRequest) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *ModifyOrderRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *ModifyOrderRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ModifyOrderRequest) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+type ModifyOrderResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ModifiedOrderId string                 `protobuf:"bytes,1,opt,name=modified_order_id,json=modifiedOrderId,proto3" json:"modified_order_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ModifyOrderResponse) Reset() {
+	*x = ModifyOrderResponse{}
+	mi := &file_rpc_proto_msgTypes[175]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModifyOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModifyOrderResponse) ProtoMessage() {}
+
+func (x *ModifyOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[175]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModifyOrderResponse.ProtoReflect.Descriptor instead.
+func (*ModifyOrderResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{175}
+}
+
+func (x *ModifyOrderResponse) GetModifiedOrderId() string {
+	if x != nil {
+		return x.ModifiedOrderId
+	}
+	return ""
+}
+
+type CurrencyStateGetAllRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CurrencyStateGetAllRequest) Reset() {
+	*x = CurrencyStateGetAllRequest{}
+	mi := &file_rpc_proto_msgTypes[176]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CurrencyStateGetAllRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrencyStateGetAllRequest) ProtoMessage() {}
+
+func (x *CurrencyStateGetAllRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[176]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurrencyStateGetAllRequest.ProtoReflect.Descriptor instead.
+func (*CurrencyStateGetAllRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{176}
+}
+
+func (x *CurrencyStateGetAllRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+type CurrencyStateTradingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Asset         string                 `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CurrencyStateTradingRequest) Reset() {
+	*x = CurrencyStateTradingRequest{}
+	mi := &file_rpc_proto_msgTypes[177]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CurrencyStateTradingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrencyStateTradingRequest) ProtoMessage() {}
+
+func (x *CurrencyStateTradingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[177]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurrencyStateTradingRequest.ProtoReflect.Descriptor instead.
+func (*CurrencyStateTradingRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{177}
+}
+
+func (x *CurrencyStateTradingRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *CurrencyStateTradingRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CurrencyStateTradingRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+type CurrencyStateTradingPairRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair          string                 `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	Asset         string                 `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CurrencyStateTradingPairRequest) Reset() {
+	*x = CurrencyStateTradingPairRequest{}
+	mi := &file_rpc_proto_msgTypes[178]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CurrencyStateTradingPairRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrencyStateTradingPairRequest) ProtoMessage() {}
+
+func (x *CurrencyStateTradingPairRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[178]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurrencyStateTradingPairRequest.ProtoReflect.Descriptor instead.
+func (*CurrencyStateTradingPairRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{178}
+}
+
+func (x *CurrencyStateTradingPairRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *CurrencyStateTradingPairRequest) GetPair() string {
+	if x != nil {
+		return x.Pair
+	}
+	return ""
+}
+
+func (x *CurrencyStateTradingPairRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+type CurrencyStateWithdrawRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Asset         string                 `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CurrencyStateWithdrawRequest) Reset() {
+	*x = CurrencyStateWithdrawRequest{}
+	mi := &file_rpc_proto_msgTypes[179]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CurrencyStateWithdrawRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrencyStateWithdrawRequest) ProtoMessage() {}
+
+func (x *CurrencyStateWithdrawRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[179]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurrencyStateWithdrawRequest.ProtoReflect.Descriptor instead.
+func (*CurrencyStateWithdrawRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{179}
+}
+
+func (x *CurrencyStateWithdrawRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *CurrencyStateWithdrawRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CurrencyStateWithdrawRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+type CurrencyStateDepositRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Asset         string                 `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CurrencyStateDepositRequest) Reset() {
+	*x = CurrencyStateDepositRequest{}
+	mi := &file_rpc_proto_msgTypes[180]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CurrencyStateDepositRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrencyStateDepositRequest) ProtoMessage() {}
+
+func (x *CurrencyStateDepositRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[180]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurrencyStateDepositRequest.ProtoReflect.Descriptor instead.
+func (*CurrencyStateDepositRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{180}
+}
+
+func (x *CurrencyStateDepositRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *CurrencyStateDepositRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CurrencyStateDepositRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+type CurrencyStateResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CurrencyStates []*CurrencyState       `protobuf:"bytes,1,rep,name=currency_states,json=currencyStates,proto3" json:"currency_states,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CurrencyStateResponse) Reset() {
+	*x = CurrencyStateResponse{}
+	mi := &file_rpc_proto_msgTypes[181]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CurrencyStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrencyStateResponse) ProtoMessage() {}
+
+func (x *CurrencyStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[181]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurrencyStateResponse.ProtoReflect.Descriptor instead.
+func (*CurrencyStateResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{181}
+}
+
+func (x *CurrencyStateResponse) GetCurrencyStates() []*CurrencyState {
+	if x != nil {
+		return x.CurrencyStates
+	}
+	return nil
+}
+
+type CurrencyState struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Currency        string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	Asset           string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	WithdrawEnabled bool                   `protobuf:"varint,3,opt,name=withdraw_enabled,json=withdrawEnabled,proto3" json:"withdraw_enabled,omitempty"`
+	DepositEnabled  bool                   `protobuf:"varint,4,opt,name=deposit_enabled,json=depositEnabled,proto3" json:"deposit_enabled,omitempty"`
+	TradingEnabled  bool                   `protobuf:"varint,5,opt,name=trading_enabled,json=tradingEnabled,proto3" json:"trading_enabled,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CurrencyState) Reset() {
+	*x = CurrencyState{}
+	mi := &file_rpc_proto_msgTypes[182]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CurrencyState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrencyState) ProtoMessage() {}
+
+func (x *CurrencyState) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[182]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurrencyState.ProtoReflect.Descriptor instead.
+func (*CurrencyState) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{182}
+}
+
+func (x *CurrencyState) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CurrencyState) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *CurrencyState) GetWithdrawEnabled() bool {
+	if x != nil {
+		return x.WithdrawEnabled
+	}
+	return false
+}
+
+func (x *CurrencyState) GetDepositEnabled() bool {
+	if x != nil {
+		return x.DepositEnabled
+	}
+	return false
+}
+
+func (x *CurrencyState) GetTradingEnabled() bool {
+	if x != nil {
+		return x.TradingEnabled
+	}
+	return false
+}
+
+type FundingRate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Rate          string                 `protobuf:"bytes,2,opt,name=rate,proto3" json:"rate,omitempty"`
+	Payment       string                 `protobuf:"bytes,3,opt,name=payment,proto3" json:"payment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FundingRate) Reset() {
+	*x = FundingRate{}
+	mi := &file_rpc_proto_msgTypes[183]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FundingRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FundingRate) ProtoMessage() {}
+
+func (x *FundingRate) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[183]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FundingRate.ProtoReflect.Descriptor instead.
+func (*FundingRate) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{183}
+}
+
+func (x *FundingRate) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *FundingRate) GetRate() string {
+	if x != nil {
+		return x.Rate
+	}
+	return ""
+}
+
+func (x *FundingRate) GetPayment() string {
+	if x != nil {
+		return x.Payment
+	}
+	return ""
+}
+
+type FundingData struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Exchange        string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset           string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair            *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	PaymentCurrency string                 `protobuf:"bytes,4,opt,name=payment_currency,json=paymentCurrency,proto3" json:"payment_currency,omitempty"`
+	StartDate       string                 `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate         string                 `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Rates           []*FundingRate         `protobuf:"bytes,7,rep,name=rates,proto3" json:"rates,omitempty"`
+	LatestRate      *FundingRate           `protobuf:"bytes,8,opt,name=latest_rate,json=latestRate,proto3" json:"latest_rate,omitempty"`
+	UpcomingRate    *FundingRate           `protobuf:"bytes,9,opt,name=upcoming_rate,json=upcomingRate,proto3" json:"upcoming_rate,omitempty"`
+	PaymentSum      string                 `protobuf:"bytes,10,opt,name=payment_sum,json=paymentSum,proto3" json:"payment_sum,omitempty"`
+	PaymentMessage  string                 `protobuf:"bytes,11,opt,name=payment_message,json=paymentMessage,proto3" json:"payment_message,omitempty"`
+	TimeOfNextRate  string                 `protobuf:"bytes,12,opt,name=time_of_next_rate,json=timeOfNextRate,proto3" json:"time_of_next_rate,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *FundingData) Reset() {
+	*x = FundingData{}
+	mi := &file_rpc_proto_msgTypes[184]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FundingData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FundingData) ProtoMessage() {}
+
+func (x *FundingData) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[184]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FundingData.ProtoReflect.Descriptor instead.
+func (*FundingData) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{184}
+}
+
+func (x *FundingData) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *FundingData) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *FundingData) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *FundingData) GetPaymentCurrency() string {
+	if x != nil {
+		return x.PaymentCurrency
+	}
+	return ""
+}
+
+func (x *FundingData) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *FundingData) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *FundingData) GetRates() []*FundingRate {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
+}
+
+func (x *FundingData) GetLatestRate() *FundingRate {
+	if x != nil {
+		return x.LatestRate
+	}
+	return nil
+}
+
+func (x *FundingData) GetUpcomingRate() *FundingRate {
+	if x != nil {
+		return x.UpcomingRate
+	}
+	return nil
+}
+
+func (x *FundingData) GetPaymentSum() string {
+	if x != nil {
+		return x.PaymentSum
+	}
+	return ""
+}
+
+func (x *FundingData) GetPaymentMessage() string {
+	if x != nil {
+		return x.PaymentMessage
+	}
+	return ""
+}
+
+func (x *FundingData) GetTimeOfNextRate() string {
+	if x != nil {
+		return x.TimeOfNextRate
+	}
+	return ""
+}
+
+type FuturesPositionStats struct {
+	state                        protoimpl.MessageState `protogen:"open.v1"`
+	MaintenanceMarginRequirement string                 `protobuf:"bytes,1,opt,name=maintenance_margin_requirement,json=maintenanceMarginRequirement,proto3" json:"maintenance_margin_requirement,omitempty"`
+	InitialMarginRequirement     string                 `protobuf:"bytes,2,opt,name=initial_margin_requirement,json=initialMarginRequirement,proto3" json:"initial_margin_requirement,omitempty"`
+	EstimatedLiquidationPrice    string                 `protobuf:"bytes,3,opt,name=estimated_liquidation_price,json=estimatedLiquidationPrice,proto3" json:"estimated_liquidation_price,omitempty"`
+	CollateralUsed               string                 `protobuf:"bytes,4,opt,name=collateral_used,json=collateralUsed,proto3" json:"collateral_used,omitempty"`
+	MarkPrice                    string                 `protobuf:"bytes,5,opt,name=mark_price,json=markPrice,proto3" json:"mark_price,omitempty"`
+	CurrentSize                  string                 `protobuf:"bytes,6,opt,name=current_size,json=currentSize,proto3" json:"current_size,omitempty"`
+	ContractSize                 string                 `protobuf:"bytes,7,opt,name=contract_size,json=contractSize,proto3" json:"contract_size,omitempty"`
+	ContractMultiplier           string                 `protobuf:"bytes,8,opt,name=contract_multiplier,json=contractMultiplier,proto3" json:"contract_multiplier,omitempty"`
+	ContractSettlementType       string                 `protobuf:"bytes,9,opt,name=contract_settlement_type,json=contractSettlementType,proto3" json:"contract_settlement_type,omitempty"`
+	BreakEvenPrice               string                 `protobuf:"bytes,10,opt,name=break_even_price,json=breakEvenPrice,proto3" json:"break_even_price,omitempty"`
+	AverageOpenPrice             string                 `protobuf:"bytes,11,opt,name=average_open_price,json=averageOpenPrice,proto3" json:"average_open_price,omitempty"`
+	RecentPnl                    string                 `protobuf:"bytes,12,opt,name=recent_pnl,json=recentPnl,proto3" json:"recent_pnl,omitempty"`
+	MarginFraction               string                 `protobuf:"bytes,13,opt,name=margin_fraction,json=marginFraction,proto3" json:"margin_fraction,omitempty"`
+	FreeCollateral               string                 `protobuf:"bytes,14,opt,name=free_collateral,json=freeCollateral,proto3" json:"free_collateral,omitempty"`
+	TotalCollateral              string                 `protobuf:"bytes,15,opt,name=total_collateral,json=totalCollateral,proto3" json:"total_collateral,omitempty"`
+	FrozenBalance                string                 `protobuf:"bytes,16,opt,name=frozen_balance,json=frozenBalance,proto3" json:"frozen_balance,omitempty"`
+	EquityOfCurrency             string                 `protobuf:"bytes,17,opt,name=equity_of_currency,json=equityOfCurrency,proto3" json:"equity_of_currency,omitempty"`
+	AvailableEquity              string                 `protobuf:"bytes,18,opt,name=available_equity,json=availableEquity,proto3" json:"available_equity,omitempty"`
+	CashBalance                  string                 `protobuf:"bytes,19,opt,name=cash_balance,json=cashBalance,proto3" json:"cash_balance,omitempty"`
+	DiscountEquity               string                 `protobuf:"bytes,20,opt,name=discount_equity,json=discountEquity,proto3" json:"discount_equity,omitempty"`
+	EquityUsd                    string                 `protobuf:"bytes,21,opt,name=equity_usd,json=equityUsd,proto3" json:"equity_usd,omitempty"`
+	IsolatedEquity               string                 `protobuf:"bytes,22,opt,name=isolated_equity,json=isolatedEquity,proto3" json:"isolated_equity,omitempty"`
+	IsolatedLiabilities          string                 `protobuf:"bytes,23,opt,name=isolated_liabilities,json=isolatedLiabilities,proto3" json:"isolated_liabilities,omitempty"`
+	IsolatedUpl                  string                 `protobuf:"bytes,24,opt,name=isolated_upl,json=isolatedUpl,proto3" json:"isolated_upl,omitempty"`
+	NotionalLeverage             string                 `protobuf:"bytes,25,opt,name=notional_leverage,json=notionalLeverage,proto3" json:"notional_leverage,omitempty"`
+	TotalEquity                  string                 `protobuf:"bytes,26,opt,name=total_equity,json=totalEquity,proto3" json:"total_equity,omitempty"`
+	StrategyEquity               string                 `protobuf:"bytes,27,opt,name=strategy_equity,json=strategyEquity,proto3" json:"strategy_equity,omitempty"`
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
+}
+
+func (x *FuturesPositionStats) Reset() {
+	*x = FuturesPositionStats{}
+	mi := &file_rpc_proto_msgTypes[185]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FuturesPositionStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FuturesPositionStats) ProtoMessage() {}
+
+func (x *FuturesPositionStats) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[185]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FuturesPositionStats.ProtoReflect.Descriptor instead.
+func (*FuturesPositionStats) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{185}
+}
+
+func (x *FuturesPositionStats) GetMaintenanceMarginRequirement() string {
+	if x != nil {
+		return x.MaintenanceMarginRequirement
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetInitialMarginRequirement() string {
+	if x != nil {
+		return x.InitialMarginRequirement
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetEstimatedLiquidationPrice() string {
+	if x != nil {
+		return x.EstimatedLiquidationPrice
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetCollateralUsed() string {
+	if x != nil {
+		return x.CollateralUsed
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetMarkPrice() string {
+	if x != nil {
+		return x.MarkPrice
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetCurrentSize() string {
+	if x != nil {
+		return x.CurrentSize
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetContractSize() string {
+	if x != nil {
+		return x.ContractSize
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetContractMultiplier() string {
+	if x != nil {
+		return x.ContractMultiplier
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetContractSettlementType() string {
+	if x != nil {
+		return x.ContractSettlementType
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetBreakEvenPrice() string {
+	if x != nil {
+		return x.BreakEvenPrice
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetAverageOpenPrice() string {
+	if x != nil {
+		return x.AverageOpenPrice
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetRecentPnl() string {
+	if x != nil {
+		return x.RecentPnl
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetMarginFraction() string {
+	if x != nil {
+		return x.MarginFraction
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetFreeCollateral() string {
+	if x != nil {
+		return x.FreeCollateral
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetTotalCollateral() string {
+	if x != nil {
+		return x.TotalCollateral
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetFrozenBalance() string {
+	if x != nil {
+		return x.FrozenBalance
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetEquityOfCurrency() string {
+	if x != nil {
+		return x.EquityOfCurrency
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetAvailableEquity() string {
+	if x != nil {
+		return x.AvailableEquity
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetCashBalance() string {
+	if x != nil {
+		return x.CashBalance
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetDiscountEquity() string {
+	if x != nil {
+		return x.DiscountEquity
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetEquityUsd() string {
+	if x != nil {
+		return x.EquityUsd
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetIsolatedEquity() string {
+	if x != nil {
+		return x.IsolatedEquity
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetIsolatedLiabilities() string {
+	if x != nil {
+		return x.IsolatedLiabilities
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetIsolatedUpl() string {
+	if x != nil {
 		return x.IsolatedUpl
 	}
 	return ""
 }
 
-func (x *FuturesPositionStats) GetNotionalLeverage() string {
+func (x *FuturesPositionStats) GetNotionalLeverage() string {
+	if x != nil {
+		return x.NotionalLeverage
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetTotalEquity() string {
+	if x != nil {
+		return x.TotalEquity
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetStrategyEquity() string {
+	if x != nil {
+		return x.StrategyEquity
+	}
+	return ""
+}
+
+type FuturePosition struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Exchange               string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset                  string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair                   *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	Status                 string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	OpeningDate            string                 `protobuf:"bytes,5,opt,name=opening_date,json=openingDate,proto3" json:"opening_date,omitempty"`
+	OpeningDirection       string                 `protobuf:"bytes,6,opt,name=opening_direction,json=openingDirection,proto3" json:"opening_direction,omitempty"`
+	OpeningPrice           string                 `protobuf:"bytes,7,opt,name=opening_price,json=openingPrice,proto3" json:"opening_price,omitempty"`
+	OpeningSize            string                 `protobuf:"bytes,8,opt,name=opening_size,json=openingSize,proto3" json:"opening_size,omitempty"`
+	CurrentDirection       string                 `protobuf:"bytes,9,opt,name=current_direction,json=currentDirection,proto3" json:"current_direction,omitempty"`
+	CurrentPrice           string                 `protobuf:"bytes,10,opt,name=current_price,json=currentPrice,proto3" json:"current_price,omitempty"`
+	CurrentSize            string                 `protobuf:"bytes,11,opt,name=current_size,json=currentSize,proto3" json:"current_size,omitempty"`
+	UnrealisedPnl          string                 `protobuf:"bytes,12,opt,name=unrealised_pnl,json=unrealisedPnl,proto3" json:"unrealised_pnl,omitempty"`
+	RealisedPnl            string                 `protobuf:"bytes,13,opt,name=realised_pnl,json=realisedPnl,proto3" json:"realised_pnl,omitempty"`
+	ClosingDate            string                 `protobuf:"bytes,14,opt,name=closing_date,json=closingDate,proto3" json:"closing_date,omitempty"`
+	OrderCount             int64                  `protobuf:"varint,15,opt,name=order_count,json=orderCount,proto3" json:"order_count,omitempty"`
+	ContractSettlementType string                 `protobuf:"bytes,16,opt,name=contract_settlement_type,json=contractSettlementType,proto3" json:"contract_settlement_type,omitempty"`
+	Orders                 []*OrderDetails        `protobuf:"bytes,17,rep,name=orders,proto3" json:"orders,omitempty"`
+	PositionStats          *FuturesPositionStats  `protobuf:"bytes,18,opt,name=position_stats,json=positionStats,proto3" json:"position_stats,omitempty"`
+	FundingData            *FundingData           `protobuf:"bytes,19,opt,name=funding_data,json=fundingData,proto3" json:"funding_data,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *FuturePosition) Reset() {
+	*x = FuturePosition{}
+	mi := &file_rpc_proto_msgTypes[186]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FuturePosition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FuturePosition) ProtoMessage() {}
+
+func (x *FuturePosition) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[186]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FuturePosition.ProtoReflect.Descriptor instead.
+func (*FuturePosition) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{186}
+}
+
+func (x *FuturePosition) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *FuturePosition) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *FuturePosition) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *FuturePosition) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *FuturePosition) GetOpeningDate() string {
+	if x != nil {
+		return x.OpeningDate
+	}
+	return ""
+}
+
+func (x *FuturePosition) GetOpeningDirection() string {
+	if x != nil {
+		return x.OpeningDirection
+	}
+	return ""
+}
+
+func (x *FuturePosition) GetOpeningPrice() string {
+	if x != nil {
+		return x.OpeningPrice
+	}
+	return ""
+}
+
+func (x *FuturePosition) GetOpeningSize() string {
+	if x != nil {
+		return x.OpeningSize
+	}
+	return ""
+}
+
+func (x *FuturePosition) GetCurrentDirection() string {
+	if x != nil {
+		return x.CurrentDirection
+	}
+	return ""
+}
+
+func (x *FuturePosition) GetCurrentPrice() string {
+	if x != nil {
+		return x.CurrentPrice
+	}
+	return ""
+}
+
+func (x *FuturePosition) GetCurrentSize() string {
+	if x != nil {
+		return x.CurrentSize
+	}
+	return ""
+}
+
+func (x *FuturePosition) GetUnrealisedPnl() string {
+	if x != nil {
+		return x.UnrealisedPnl
+	}
+	return ""
+}
+
+func (x *FuturePosition) GetRealisedPnl() string {
+	if x != nil {
+		return x.RealisedPnl
+	}
+	return ""
+}
+
+func (x *FuturePosition) GetClosingDate() string {
+	if x != nil {
+		return x.ClosingDate
+	}
+	return ""
+}
+
+func (x *FuturePosition) GetOrderCount() int64 {
+	if x != nil {
+		return x.OrderCount
+	}
+	return 0
+}
+
+func (x *FuturePosition) GetContractSettlementType() string {
+	if x != nil {
+		return x.ContractSettlementType
+	}
+	return ""
+}
+
+func (x *FuturePosition) GetOrders() []*OrderDetails {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+func (x *FuturePosition) GetPositionStats() *FuturesPositionStats {
+	if x != nil {
+		return x.PositionStats
+	}
+	return nil
+}
+
+func (x *FuturePosition) GetFundingData() *FundingData {
+	if x != nil {
+		return x.FundingData
+	}
+	return nil
+}
+
+type GetManagedPositionRequest struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	Exchange                string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset                   string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair                    *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	IncludeFullOrderData    bool                   `protobuf:"varint,4,opt,name=include_full_order_data,json=includeFullOrderData,proto3" json:"include_full_order_data,omitempty"`
+	GetFundingPayments      bool                   `protobuf:"varint,5,opt,name=get_funding_payments,json=getFundingPayments,proto3" json:"get_funding_payments,omitempty"`
+	IncludeFullFundingRates bool                   `protobuf:"varint,6,opt,name=include_full_funding_rates,json=includeFullFundingRates,proto3" json:"include_full_funding_rates,omitempty"`
+	IncludePredictedRate    bool                   `protobuf:"varint,7,opt,name=include_predicted_rate,json=includePredictedRate,proto3" json:"include_predicted_rate,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *GetManagedPositionRequest) Reset() {
+	*x = GetManagedPositionRequest{}
+	mi := &file_rpc_proto_msgTypes[187]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetManagedPositionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetManagedPositionRequest) ProtoMessage() {}
+
+func (x *GetManagedPositionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[187]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetManagedPositionRequest.ProtoReflect.Descriptor instead.
+func (*GetManagedPositionRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{187}
+}
+
+func (x *GetManagedPositionRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *GetManagedPositionRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *GetManagedPositionRequest) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *GetManagedPositionRequest) GetIncludeFullOrderData() bool {
+	if x != nil {
+		return x.IncludeFullOrderData
+	}
+	return false
+}
+
+func (x *GetManagedPositionRequest) GetGetFundingPayments() bool {
+	if x != nil {
+		return x.GetFundingPayments
+	}
+	return false
+}
+
+func (x *GetManagedPositionRequest) GetIncludeFullFundingRates() bool {
+	if x != nil {
+		return x.IncludeFullFundingRates
+	}
+	return false
+}
+
+func (x *GetManagedPositionRequest) GetIncludePredictedRate() bool {
+	if x != nil {
+		return x.IncludePredictedRate
+	}
+	return false
+}
+
+type GetAllManagedPositionsRequest struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	IncludeFullOrderData    bool                   `protobuf:"varint,1,opt,name=include_full_order_data,json=includeFullOrderData,proto3" json:"include_full_order_data,omitempty"`
+	GetFundingPayments      bool                   `protobuf:"varint,2,opt,name=get_funding_payments,json=getFundingPayments,proto3" json:"get_funding_payments,omitempty"`
+	IncludeFullFundingRates bool                   `protobuf:"varint,3,opt,name=include_full_funding_rates,json=includeFullFundingRates,proto3" json:"include_full_funding_rates,omitempty"`
+	IncludePredictedRate    bool                   `protobuf:"varint,4,opt,name=include_predicted_rate,json=includePredictedRate,proto3" json:"include_predicted_rate,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *GetAllManagedPositionsRequest) Reset() {
+	*x = GetAllManagedPositionsRequest{}
+	mi := &file_rpc_proto_msgTypes[188]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllManagedPositionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllManagedPositionsRequest) ProtoMessage() {}
+
+func (x *GetAllManagedPositionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[188]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllManagedPositionsRequest.ProtoReflect.Descriptor instead.
+func (*GetAllManagedPositionsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{188}
+}
+
+func (x *GetAllManagedPositionsRequest) GetIncludeFullOrderData() bool {
+	if x != nil {
+		return x.IncludeFullOrderData
+	}
+	return false
+}
+
+func (x *GetAllManagedPositionsRequest) GetGetFundingPayments() bool {
+	if x != nil {
+		return x.GetFundingPayments
+	}
+	return false
+}
+
+func (x *GetAllManagedPositionsRequest) GetIncludeFullFundingRates() bool {
+	if x != nil {
+		return x.IncludeFullFundingRates
+	}
+	return false
+}
+
+func (x *GetAllManagedPositionsRequest) GetIncludePredictedRate() bool {
+	if x != nil {
+		return x.IncludePredictedRate
+	}
+	return false
+}
+
+type GetManagedPositionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Positions     []*FuturePosition      `protobuf:"bytes,1,rep,name=positions,proto3" json:"positions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetManagedPositionsResponse) Reset() {
+	*x = GetManagedPositionsResponse{}
+	mi := &file_rpc_proto_msgTypes[189]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetManagedPositionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetManagedPositionsResponse) ProtoMessage() {}
+
+func (x *GetManagedPositionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[189]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetManagedPositionsResponse.ProtoReflect.Descriptor instead.
+func (*GetManagedPositionsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{189}
+}
+
+func (x *GetManagedPositionsResponse) GetPositions() []*FuturePosition {
+	if x != nil {
+		return x.Positions
+	}
+	return nil
+}
+
+type GetFuturesPositionsSummaryRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Exchange       string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset          string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair           *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	UnderlyingPair *CurrencyPair          `protobuf:"bytes,4,opt,name=underlying_pair,json=underlyingPair,proto3" json:"underlying_pair,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetFuturesPositionsSummaryRequest) Reset() {
+	*x = GetFuturesPositionsSummaryRequest{}
+	mi := &file_rpc_proto_msgTypes[190]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFuturesPositionsSummaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFuturesPositionsSummaryRequest) ProtoMessage() {}
+
+func (x *GetFuturesPositionsSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[190]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFuturesPositionsSummaryRequest.ProtoReflect.Descriptor instead.
+func (*GetFuturesPositionsSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{190}
+}
+
+func (x *GetFuturesPositionsSummaryRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *GetFuturesPositionsSummaryRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *GetFuturesPositionsSummaryRequest) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *GetFuturesPositionsSummaryRequest) GetUnderlyingPair() *CurrencyPair {
+	if x != nil {
+		return x.UnderlyingPair
+	}
+	return nil
+}
+
+type GetFuturesPositionsSummaryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	PositionStats *FuturesPositionStats  `protobuf:"bytes,4,opt,name=position_stats,json=positionStats,proto3" json:"position_stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFuturesPositionsSummaryResponse) Reset() {
+	*x = GetFuturesPositionsSummaryResponse{}
+	mi := &file_rpc_proto_msgTypes[191]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFuturesPositionsSummaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFuturesPositionsSummaryResponse) ProtoMessage() {}
+
+func (x *GetFuturesPositionsSummaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[191]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFuturesPositionsSummaryResponse.ProtoReflect.Descriptor instead.
+func (*GetFuturesPositionsSummaryResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{191}
+}
+
+func (x *GetFuturesPositionsSummaryResponse) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *GetFuturesPositionsSummaryResponse) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *GetFuturesPositionsSummaryResponse) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *GetFuturesPositionsSummaryResponse) GetPositionStats() *FuturesPositionStats {
+	if x != nil {
+		return x.PositionStats
+	}
+	return nil
+}
+
+type GetFuturesPositionsOrdersRequest struct {
+	state                     protoimpl.MessageState `protogen:"open.v1"`
+	Exchange                  string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset                     string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair                      *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	UnderlyingPair            *CurrencyPair          `protobuf:"bytes,4,opt,name=underlying_pair,json=underlyingPair,proto3" json:"underlying_pair,omitempty"`
+	StartDate                 string                 `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate                   string                 `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	RespectOrderHistoryLimits bool                   `protobuf:"varint,7,opt,name=respect_order_history_limits,json=respectOrderHistoryLimits,proto3" json:"respect_order_history_limits,omitempty"`
+	SyncWithOrderManager      bool                   `protobuf:"varint,8,opt,name=sync_with_order_manager,json=syncWithOrderManager,proto3" json:"sync_with_order_manager,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *GetFuturesPositionsOrdersRequest) Reset() {
+	*x = GetFuturesPositionsOrdersRequest{}
+	mi := &file_rpc_proto_msgTypes[192]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFuturesPositionsOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFuturesPositionsOrdersRequest) ProtoMessage() {}
+
+func (x *GetFuturesPositionsOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[192]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFuturesPositionsOrdersRequest.ProtoReflect.Descriptor instead.
+func (*GetFuturesPositionsOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{192}
+}
+
+func (x *GetFuturesPositionsOrdersRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *GetFuturesPositionsOrdersRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *GetFuturesPositionsOrdersRequest) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *GetFuturesPositionsOrdersRequest) GetUnderlyingPair() *CurrencyPair {
+	if x != nil {
+		return x.UnderlyingPair
+	}
+	return nil
+}
+
+func (x *GetFuturesPositionsOrdersRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *GetFuturesPositionsOrdersRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *GetFuturesPositionsOrdersRequest) GetRespectOrderHistoryLimits() bool {
+	if x != nil {
+		return x.RespectOrderHistoryLimits
+	}
+	return false
+}
+
+func (x *GetFuturesPositionsOrdersRequest) GetSyncWithOrderManager() bool {
+	if x != nil {
+		return x.SyncWithOrderManager
+	}
+	return false
+}
+
+type GetFuturesPositionsOrdersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Positions     []*FuturePosition      `protobuf:"bytes,6,rep,name=positions,proto3" json:"positions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFuturesPositionsOrdersResponse) Reset() {
+	*x = GetFuturesPositionsOrdersResponse{}
+	mi := &file_rpc_proto_msgTypes[193]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFuturesPositionsOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFuturesPositionsOrdersResponse) ProtoMessage() {}
+
+func (x *GetFuturesPositionsOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[193]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFuturesPositionsOrdersResponse.ProtoReflect.Descriptor instead.
+func (*GetFuturesPositionsOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{193}
+}
+
+func (x *GetFuturesPositionsOrdersResponse) GetPositions() []*FuturePosition {
+	if x != nil {
+		return x.Positions
+	}
+	return nil
+}
+
+type GetCollateralModeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCollateralModeRequest) Reset() {
+	*x = GetCollateralModeRequest{}
+	mi := &file_rpc_proto_msgTypes[194]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCollateralModeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollateralModeRequest) ProtoMessage() {}
+
+func (x *GetCollateralModeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[194]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCollateralModeRequest.ProtoReflect.Descriptor instead.
+func (*GetCollateralModeRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{194}
+}
+
+func (x *GetCollateralModeRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *GetCollateralModeRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+type GetCollateralModeResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Exchange       string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset          string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	CollateralMode string                 `protobuf:"bytes,3,opt,name=collateral_mode,json=collateralMode,proto3" json:"collateral_mode,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetCollateralModeResponse) Reset() {
+	*x = GetCollateralModeResponse{}
+	mi := &file_rpc_proto_msgTypes[195]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCollateralModeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollateralModeResponse) ProtoMessage() {}
+
+func (x *GetCollateralModeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[195]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCollateralModeResponse.ProtoReflect.Descriptor instead.
+func (*GetCollateralModeResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{195}
+}
+
+func (x *GetCollateralModeResponse) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *GetCollateralModeResponse) GetAsset() string {
 	if x != nil {
-		return x.NotionalLeverage
+		return x.Asset
 	}
 	return ""
 }
 
-func (x *FuturesPositionStats) GetTotalEquity() string {
+func (x *GetCollateralModeResponse) GetCollateralMode() string {
 	if x != nil {
-		return x.TotalEquity
+		return x.CollateralMode
 	}
 	return ""
 }
 
-func (x *FuturesPositionStats) GetStrategyEquity() string {
+type SetCollateralModeRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Exchange       string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset          string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	CollateralMode string                 `protobuf:"bytes,3,opt,name=collateral_mode,json=collateralMode,proto3" json:"collateral_mode,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SetCollateralModeRequest) Reset() {
+	*x = SetCollateralModeRequest{}
+	mi := &file_rpc_proto_msgTypes[196]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCollateralModeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCollateralModeRequest) ProtoMessage() {}
+
+func (x *SetCollateralModeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[196]
 	if x != nil {
-		return x.StrategyEquity
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCollateralModeRequest.ProtoReflect.Descriptor instead.
+func (*SetCollateralModeRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{196}
+}
+
+func (x *SetCollateralModeRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
 	}
 	return ""
 }
 
-type FuturePosition struct {
-	state                  protoimpl.MessageState `protogen:"open.v1"`
-	Exchange               string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset                  string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair                   *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	Status                 string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
-	OpeningDate            string                 `protobuf:"bytes,5,opt,name=opening_date,json=openingDate,proto3" json:"opening_date,omitempty"`
-	OpeningDirection       string                 `protobuf:"bytes,6,opt,name=opening_direction,json=openingDirection,proto3" json:"opening_direction,omitempty"`
-	OpeningPrice           string                 `protobuf:"bytes,7,opt,name=opening_price,json=openingPrice,proto3" json:"opening_price,omitempty"`
-	OpeningSize            string                 `protobuf:"bytes,8,opt,name=opening_size,json=openingSize,proto3" json:"opening_size,omitempty"`
-	CurrentDirection       string                 `protobuf:"bytes,9,opt,name=current_direction,json=currentDirection,proto3" json:"current_direction,omitempty"`
-	CurrentPrice           string                 `protobuf:"bytes,10,opt,name=current_price,json=currentPrice,proto3" json:"current_price,omitempty"`
-	CurrentSize            string                 `protobuf:"bytes,11,opt,name=current_size,json=currentSize,proto3" json:"current_size,omitempty"`
-	UnrealisedPnl          string                 `protobuf:"bytes,12,opt,name=unrealised_pnl,json=unrealisedPnl,proto3" json:"unrealised_pnl,omitempty"`
-	RealisedPnl            string                 `protobuf:"bytes,13,opt,name=realised_pnl,json=realisedPnl,proto3" json:"realised_pnl,omitempty"`
-	ClosingDate            string                 `protobuf:"bytes,14,opt,name=closing_date,json=closingDate,proto3" json:"closing_date,omitempty"`
-	OrderCount             int64                  `protobuf:"varint,15,opt,name=order_count,json=orderCount,proto3" json:"order_count,omitempty"`
-	ContractSettlementType string                 `protobuf:"bytes,16,opt,name=contract_settlement_type,json=contractSettlementType,proto3" json:"contract_settlement_type,omitempty"`
-	Orders                 []*OrderDetails        `protobuf:"bytes,17,rep,name=orders,proto3" json:"orders,omitempty"`
-	PositionStats          *FuturesPositionStats  `protobuf:"bytes,18,opt,name=position_stats,json=positionStats,proto3" json:"position_stats,omitempty"`
-	FundingData            *FundingData           `protobuf:"bytes,19,opt,name=funding_data,json=fundingData,proto3" json:"funding_data,omitempty"`
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+func (x *SetCollateralModeRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
 }
 
-func (x *FuturePosition) Reset() {
-	*x = FuturePosition{}
-	mi := &file_rpc_proto_msgTypes[173]
+func (x *SetCollateralModeRequest) GetCollateralMode() string {
+	if x != nil {
+		return x.CollateralMode
+	}
+	return ""
+}
+
+type SetCollateralModeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Success       bool                   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCollateralModeResponse) Reset() {
+	*x = SetCollateralModeResponse{}
+	mi := &file_rpc_proto_msgTypes[197]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FuturePosition) String() string {
+func (x *SetCollateralModeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FuturePosition) ProtoMessage() {}
+func (*SetCollateralModeResponse) ProtoMessage() {}
 
-func (x *FuturePosition) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[173]
+func (x *SetCollateralModeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[197]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10893,172 +12717,279 @@ func (x *FuturePosition) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FuturePosition.ProtoReflect.Descriptor instead.
-func (*FuturePosition) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{173}
+// Deprecated: Use SetCollateralModeResponse.ProtoReflect.Descriptor instead.
+func (*SetCollateralModeResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{197}
 }
 
-func (x *FuturePosition) GetExchange() string {
+func (x *SetCollateralModeResponse) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *FuturePosition) GetAsset() string {
+func (x *SetCollateralModeResponse) GetAsset() string {
 	if x != nil {
 		return x.Asset
 	}
 	return ""
 }
 
-func (x *FuturePosition) GetPair() *CurrencyPair {
+func (x *SetCollateralModeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetMarginTypeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMarginTypeRequest) Reset() {
+	*x = GetMarginTypeRequest{}
+	mi := &file_rpc_proto_msgTypes[198]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMarginTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMarginTypeRequest) ProtoMessage() {}
+
+func (x *GetMarginTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[198]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMarginTypeRequest.ProtoReflect.Descriptor instead.
+func (*GetMarginTypeRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{198}
+}
+
+func (x *GetMarginTypeRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *GetMarginTypeRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *GetMarginTypeRequest) GetPair() *CurrencyPair {
 	if x != nil {
 		return x.Pair
 	}
 	return nil
 }
 
-func (x *FuturePosition) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
+type GetMarginTypeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	MarginType    string                 `protobuf:"bytes,4,opt,name=margin_type,json=marginType,proto3" json:"margin_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMarginTypeResponse) Reset() {
+	*x = GetMarginTypeResponse{}
+	mi := &file_rpc_proto_msgTypes[199]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMarginTypeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMarginTypeResponse) ProtoMessage() {}
+
+func (x *GetMarginTypeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[199]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMarginTypeResponse.ProtoReflect.Descriptor instead.
+func (*GetMarginTypeResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{199}
 }
 
-func (x *FuturePosition) GetOpeningDate() string {
+func (x *GetMarginTypeResponse) GetExchange() string {
 	if x != nil {
-		return x.OpeningDate
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *FuturePosition) GetOpeningDirection() string {
+func (x *GetMarginTypeResponse) GetAsset() string {
 	if x != nil {
-		return x.OpeningDirection
+		return x.Asset
 	}
 	return ""
 }
 
-func (x *FuturePosition) GetOpeningPrice() string {
+func (x *GetMarginTypeResponse) GetPair() *CurrencyPair {
 	if x != nil {
-		return x.OpeningPrice
+		return x.Pair
 	}
-	return ""
+	return nil
 }
 
-func (x *FuturePosition) GetOpeningSize() string {
+func (x *GetMarginTypeResponse) GetMarginType() string {
 	if x != nil {
-		return x.OpeningSize
+		return x.MarginType
 	}
 	return ""
 }
 
-func (x *FuturePosition) GetCurrentDirection() string {
-	if x != nil {
-		return x.CurrentDirection
-	}
-	return ""
+type ChangePositionMarginRequest struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	Exchange                string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset                   string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair                    *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	MarginType              string                 `protobuf:"bytes,4,opt,name=margin_type,json=marginType,proto3" json:"margin_type,omitempty"`
+	OriginalAllocatedMargin float64                `protobuf:"fixed64,5,opt,name=original_allocated_margin,json=originalAllocatedMargin,proto3" json:"original_allocated_margin,omitempty"`
+	NewAllocatedMargin      float64                `protobuf:"fixed64,6,opt,name=new_allocated_margin,json=newAllocatedMargin,proto3" json:"new_allocated_margin,omitempty"`
+	MarginSide              string                 `protobuf:"bytes,7,opt,name=margin_side,json=marginSide,proto3" json:"margin_side,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
 }
 
-func (x *FuturePosition) GetCurrentPrice() string {
-	if x != nil {
-		return x.CurrentPrice
-	}
-	return ""
+func (x *ChangePositionMarginRequest) Reset() {
+	*x = ChangePositionMarginRequest{}
+	mi := &file_rpc_proto_msgTypes[200]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *FuturePosition) GetCurrentSize() string {
-	if x != nil {
-		return x.CurrentSize
-	}
-	return ""
+func (x *ChangePositionMarginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *FuturePosition) GetUnrealisedPnl() string {
+func (*ChangePositionMarginRequest) ProtoMessage() {}
+
+func (x *ChangePositionMarginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[200]
 	if x != nil {
-		return x.UnrealisedPnl
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *FuturePosition) GetRealisedPnl() string {
+// Deprecated: Use ChangePositionMarginRequest.ProtoReflect.Descriptor instead.
+func (*ChangePositionMarginRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{200}
+}
+
+func (x *ChangePositionMarginRequest) GetExchange() string {
 	if x != nil {
-		return x.RealisedPnl
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *FuturePosition) GetClosingDate() string {
+func (x *ChangePositionMarginRequest) GetAsset() string {
 	if x != nil {
-		return x.ClosingDate
+		return x.Asset
 	}
 	return ""
 }
 
-func (x *FuturePosition) GetOrderCount() int64 {
+func (x *ChangePositionMarginRequest) GetPair() *CurrencyPair {
 	if x != nil {
-		return x.OrderCount
+		return x.Pair
 	}
-	return 0
+	return nil
 }
 
-func (x *FuturePosition) GetContractSettlementType() string {
+func (x *ChangePositionMarginRequest) GetMarginType() string {
 	if x != nil {
-		return x.ContractSettlementType
+		return x.MarginType
 	}
 	return ""
 }
 
-func (x *FuturePosition) GetOrders() []*OrderDetails {
+func (x *ChangePositionMarginRequest) GetOriginalAllocatedMargin() float64 {
 	if x != nil {
-		return x.Orders
+		return x.OriginalAllocatedMargin
 	}
-	return nil
+	return 0
 }
 
-func (x *FuturePosition) GetPositionStats() *FuturesPositionStats {
+func (x *ChangePositionMarginRequest) GetNewAllocatedMargin() float64 {
 	if x != nil {
-		return x.PositionStats
+		return x.NewAllocatedMargin
 	}
-	return nil
+	return 0
 }
 
-func (x *FuturePosition) GetFundingData() *FundingData {
+func (x *ChangePositionMarginRequest) GetMarginSide() string {
 	if x != nil {
-		return x.FundingData
+		return x.MarginSide
 	}
-	return nil
+	return ""
 }
 
-type GetManagedPositionRequest struct {
-	state                   protoimpl.MessageState `protogen:"open.v1"`
-	Exchange                string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset                   string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair                    *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	IncludeFullOrderData    bool                   `protobuf:"varint,4,opt,name=include_full_order_data,json=includeFullOrderData,proto3" json:"include_full_order_data,omitempty"`
-	GetFundingPayments      bool                   `protobuf:"varint,5,opt,name=get_funding_payments,json=getFundingPayments,proto3" json:"get_funding_payments,omitempty"`
-	IncludeFullFundingRates bool                   `protobuf:"varint,6,opt,name=include_full_funding_rates,json=includeFullFundingRates,proto3" json:"include_full_funding_rates,omitempty"`
-	IncludePredictedRate    bool                   `protobuf:"varint,7,opt,name=include_predicted_rate,json=includePredictedRate,proto3" json:"include_predicted_rate,omitempty"`
-	unknownFields           protoimpl.UnknownFields
-	sizeCache               protoimpl.SizeCache
+type ChangePositionMarginResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Exchange           string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset              string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair               *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	MarginType         string                 `protobuf:"bytes,4,opt,name=margin_type,json=marginType,proto3" json:"margin_type,omitempty"`
+	NewAllocatedMargin float64                `protobuf:"fixed64,5,opt,name=new_allocated_margin,json=newAllocatedMargin,proto3" json:"new_allocated_margin,omitempty"`
+	MarginSide         string                 `protobuf:"bytes,6,opt,name=margin_side,json=marginSide,proto3" json:"margin_side,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *GetManagedPositionRequest) Reset() {
-	*x = GetManagedPositionRequest{}
-	mi := &file_rpc_proto_msgTypes[174]
+func (x *ChangePositionMarginResponse) Reset() {
+	*x = ChangePositionMarginResponse{}
+	mi := &file_rpc_proto_msgTypes[201]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetManagedPositionRequest) String() string {
+func (x *ChangePositionMarginResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetManagedPositionRequest) ProtoMessage() {}
+func (*ChangePositionMarginResponse) ProtoMessage() {}
 
-func (x *GetManagedPositionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[174]
+func (x *ChangePositionMarginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[201]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11069,85 +13000,78 @@ func (x *GetManagedPositionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetManagedPositionRequest.ProtoReflect.Descriptor instead.
-func (*GetManagedPositionRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{174}
+// Deprecated: Use ChangePositionMarginResponse.ProtoReflect.Descriptor instead.
+func (*ChangePositionMarginResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{201}
 }
 
-func (x *GetManagedPositionRequest) GetExchange() string {
+func (x *ChangePositionMarginResponse) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *GetManagedPositionRequest) GetAsset() string {
+func (x *ChangePositionMarginResponse) GetAsset() string {
 	if x != nil {
 		return x.Asset
 	}
 	return ""
 }
 
-func (x *GetManagedPositionRequest) GetPair() *CurrencyPair {
+func (x *ChangePositionMarginResponse) GetPair() *CurrencyPair {
 	if x != nil {
 		return x.Pair
 	}
 	return nil
 }
 
-func (x *GetManagedPositionRequest) GetIncludeFullOrderData() bool {
-	if x != nil {
-		return x.IncludeFullOrderData
-	}
-	return false
-}
-
-func (x *GetManagedPositionRequest) GetGetFundingPayments() bool {
+func (x *ChangePositionMarginResponse) GetMarginType() string {
 	if x != nil {
-		return x.GetFundingPayments
+		return x.MarginType
 	}
-	return false
+	return ""
 }
 
-func (x *GetManagedPositionRequest) GetIncludeFullFundingRates() bool {
+func (x *ChangePositionMarginResponse) GetNewAllocatedMargin() float64 {
 	if x != nil {
-		return x.IncludeFullFundingRates
+		return x.NewAllocatedMargin
 	}
-	return false
+	return 0
 }
 
-func (x *GetManagedPositionRequest) GetIncludePredictedRate() bool {
+func (x *ChangePositionMarginResponse) GetMarginSide() string {
 	if x != nil {
-		return x.IncludePredictedRate
+		return x.MarginSide
 	}
-	return false
+	return ""
 }
 
-type GetAllManagedPositionsRequest struct {
-	state                   protoimpl.MessageState `protogen:"open.v1"`
-	IncludeFullOrderData    bool                   `protobuf:"varint,1,opt,name=include_full_order_data,json=includeFullOrderData,proto3" json:"include_full_order_data,omitempty"`
-	GetFundingPayments      bool                   `protobuf:"varint,2,opt,name=get_funding_payments,json=getFundingPayments,proto3" json:"get_funding_payments,omitempty"`
-	IncludeFullFundingRates bool                   `protobuf:"varint,3,opt,name=include_full_funding_rates,json=includeFullFundingRates,proto3" json:"include_full_funding_rates,omitempty"`
-	IncludePredictedRate    bool                   `protobuf:"varint,4,opt,name=include_predicted_rate,json=includePredictedRate,proto3" json:"include_predicted_rate,omitempty"`
-	unknownFields           protoimpl.UnknownFields
-	sizeCache               protoimpl.SizeCache
+type SetMarginTypeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	MarginType    string                 `protobuf:"bytes,4,opt,name=margin_type,json=marginType,proto3" json:"margin_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAllManagedPositionsRequest) Reset() {
-	*x = GetAllManagedPositionsRequest{}
-	mi := &file_rpc_proto_msgTypes[175]
+func (x *SetMarginTypeRequest) Reset() {
+	*x = SetMarginTypeRequest{}
+	mi := &file_rpc_proto_msgTypes[202]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAllManagedPositionsRequest) String() string {
+func (x *SetMarginTypeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllManagedPositionsRequest) ProtoMessage() {}
+func (*SetMarginTypeRequest) ProtoMessage() {}
 
-func (x *GetAllManagedPositionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[175]
+func (x *SetMarginTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[202]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11158,61 +13082,64 @@ func (x *GetAllManagedPositionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllManagedPositionsRequest.ProtoReflect.Descriptor instead.
-func (*GetAllManagedPositionsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{175}
+// Deprecated: Use SetMarginTypeRequest.ProtoReflect.Descriptor instead.
+func (*SetMarginTypeRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{202}
 }
 
-func (x *GetAllManagedPositionsRequest) GetIncludeFullOrderData() bool {
+func (x *SetMarginTypeRequest) GetExchange() string {
 	if x != nil {
-		return x.IncludeFullOrderData
+		return x.Exchange
 	}
-	return false
+	return ""
 }
 
-func (x *GetAllManagedPositionsRequest) GetGetFundingPayments() bool {
+func (x *SetMarginTypeRequest) GetAsset() string {
 	if x != nil {
-		return x.GetFundingPayments
+		return x.Asset
 	}
-	return false
+	return ""
 }
 
-func (x *GetAllManagedPositionsRequest) GetIncludeFullFundingRates() bool {
+func (x *SetMarginTypeRequest) GetPair() *CurrencyPair {
 	if x != nil {
-		return x.IncludeFullFundingRates
+		return x.Pair
 	}
-	return false
+	return nil
 }
 
-func (x *GetAllManagedPositionsRequest) GetIncludePredictedRate() bool {
+func (x *SetMarginTypeRequest) GetMarginType() string {
 	if x != nil {
-		return x.IncludePredictedRate
+		return x.MarginType
 	}
-	return false
+	return ""
 }
 
-type GetManagedPositionsResponse struct {
+type SetMarginTypeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Positions     []*FuturePosition      `protobuf:"bytes,1,rep,name=positions,proto3" json:"positions,omitempty"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	Success       bool                   `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetManagedPositionsResponse) Reset() {
-	*x = GetManagedPositionsResponse{}
-	mi := &file_rpc_proto_msgTypes[176]
+func (x *SetMarginTypeResponse) Reset() {
+	*x = SetMarginTypeResponse{}
+	mi := &file_rpc_proto_msgTypes[203]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetManagedPositionsResponse) String() string {
+func (x *SetMarginTypeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetManagedPositionsResponse) ProtoMessage() {}
+func (*SetMarginTypeResponse) ProtoMessage() {}
 
-func (x *GetManagedPositionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[176]
+func (x *SetMarginTypeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[203]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11223,43 +13150,66 @@ func (x *GetManagedPositionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetManagedPositionsResponse.ProtoReflect.Descriptor instead.
-func (*GetManagedPositionsResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{176}
+// Deprecated: Use SetMarginTypeResponse.ProtoReflect.Descriptor instead.
+func (*SetMarginTypeResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{203}
 }
 
-func (x *GetManagedPositionsResponse) GetPositions() []*FuturePosition {
+func (x *SetMarginTypeResponse) GetExchange() string {
 	if x != nil {
-		return x.Positions
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *SetMarginTypeResponse) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *SetMarginTypeResponse) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
 	}
 	return nil
 }
 
-type GetFuturesPositionsSummaryRequest struct {
+func (x *SetMarginTypeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetLeverageRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Exchange       string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
 	Asset          string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
 	Pair           *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
 	UnderlyingPair *CurrencyPair          `protobuf:"bytes,4,opt,name=underlying_pair,json=underlyingPair,proto3" json:"underlying_pair,omitempty"`
+	MarginType     string                 `protobuf:"bytes,5,opt,name=margin_type,json=marginType,proto3" json:"margin_type,omitempty"`
+	OrderSide      string                 `protobuf:"bytes,6,opt,name=order_side,json=orderSide,proto3" json:"order_side,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetFuturesPositionsSummaryRequest) Reset() {
-	*x = GetFuturesPositionsSummaryRequest{}
-	mi := &file_rpc_proto_msgTypes[177]
+func (x *GetLeverageRequest) Reset() {
+	*x = GetLeverageRequest{}
+	mi := &file_rpc_proto_msgTypes[204]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFuturesPositionsSummaryRequest) String() string {
+func (x *GetLeverageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFuturesPositionsSummaryRequest) ProtoMessage() {}
+func (*GetLeverageRequest) ProtoMessage() {}
 
-func (x *GetFuturesPositionsSummaryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[177]
+func (x *GetLeverageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[204]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11270,64 +13220,81 @@ func (x *GetFuturesPositionsSummaryRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFuturesPositionsSummaryRequest.ProtoReflect.Descriptor instead.
-func (*GetFuturesPositionsSummaryRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{177}
+// Deprecated: Use GetLeverageRequest.ProtoReflect.Descriptor instead.
+func (*GetLeverageRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{204}
 }
 
-func (x *GetFuturesPositionsSummaryRequest) GetExchange() string {
+func (x *GetLeverageRequest) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *GetFuturesPositionsSummaryRequest) GetAsset() string {
+func (x *GetLeverageRequest) GetAsset() string {
 	if x != nil {
 		return x.Asset
 	}
 	return ""
 }
 
-func (x *GetFuturesPositionsSummaryRequest) GetPair() *CurrencyPair {
+func (x *GetLeverageRequest) GetPair() *CurrencyPair {
 	if x != nil {
 		return x.Pair
 	}
 	return nil
 }
 
-func (x *GetFuturesPositionsSummaryRequest) GetUnderlyingPair() *CurrencyPair {
+func (x *GetLeverageRequest) GetUnderlyingPair() *CurrencyPair {
 	if x != nil {
 		return x.UnderlyingPair
 	}
 	return nil
 }
 
-type GetFuturesPositionsSummaryResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	PositionStats *FuturesPositionStats  `protobuf:"bytes,4,opt,name=position_stats,json=positionStats,proto3" json:"position_stats,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GetLeverageRequest) GetMarginType() string {
+	if x != nil {
+		return x.MarginType
+	}
+	return ""
 }
 
-func (x *GetFuturesPositionsSummaryResponse) Reset() {
-	*x = GetFuturesPositionsSummaryResponse{}
-	mi := &file_rpc_proto_msgTypes[178]
+func (x *GetLeverageRequest) GetOrderSide() string {
+	if x != nil {
+		return x.OrderSide
+	}
+	return ""
+}
+
+type GetLeverageResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Exchange       string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset          string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair           *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	UnderlyingPair *CurrencyPair          `protobuf:"bytes,4,opt,name=underlying_pair,json=underlyingPair,proto3" json:"underlying_pair,omitempty"`
+	MarginType     string                 `protobuf:"bytes,5,opt,name=margin_type,json=marginType,proto3" json:"margin_type,omitempty"`
+	Leverage       float64                `protobuf:"fixed64,6,opt,name=leverage,proto3" json:"leverage,omitempty"`
+	OrderSide      string                 `protobuf:"bytes,7,opt,name=order_side,json=orderSide,proto3" json:"order_side,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetLeverageResponse) Reset() {
+	*x = GetLeverageResponse{}
+	mi := &file_rpc_proto_msgTypes[205]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFuturesPositionsSummaryResponse) String() string {
+func (x *GetLeverageResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFuturesPositionsSummaryResponse) ProtoMessage() {}
+func (*GetLeverageResponse) ProtoMessage() {}
 
-func (x *GetFuturesPositionsSummaryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[178]
+func (x *GetLeverageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[205]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11338,68 +13305,88 @@ func (x *GetFuturesPositionsSummaryResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFuturesPositionsSummaryResponse.ProtoReflect.Descriptor instead.
-func (*GetFuturesPositionsSummaryResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{178}
+// Deprecated: Use GetLeverageResponse.ProtoReflect.Descriptor instead.
+func (*GetLeverageResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{205}
 }
 
-func (x *GetFuturesPositionsSummaryResponse) GetExchange() string {
+func (x *GetLeverageResponse) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *GetFuturesPositionsSummaryResponse) GetAsset() string {
+func (x *GetLeverageResponse) GetAsset() string {
 	if x != nil {
 		return x.Asset
 	}
 	return ""
 }
 
-func (x *GetFuturesPositionsSummaryResponse) GetPair() *CurrencyPair {
+func (x *GetLeverageResponse) GetPair() *CurrencyPair {
 	if x != nil {
 		return x.Pair
 	}
 	return nil
 }
 
-func (x *GetFuturesPositionsSummaryResponse) GetPositionStats() *FuturesPositionStats {
+func (x *GetLeverageResponse) GetUnderlyingPair() *CurrencyPair {
 	if x != nil {
-		return x.PositionStats
+		return x.UnderlyingPair
 	}
 	return nil
 }
 
-type GetFuturesPositionsOrdersRequest struct {
-	state                     protoimpl.MessageState `protogen:"open.v1"`
-	Exchange                  string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset                     string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair                      *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	UnderlyingPair            *CurrencyPair          `protobuf:"bytes,4,opt,name=underlying_pair,json=underlyingPair,proto3" json:"underlying_pair,omitempty"`
-	StartDate                 string                 `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate                   string                 `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
-	RespectOrderHistoryLimits bool                   `protobuf:"varint,7,opt,name=respect_order_history_limits,json=respectOrderHistoryLimits,proto3" json:"respect_order_history_limits,omitempty"`
-	SyncWithOrderManager      bool                   `protobuf:"varint,8,opt,name=sync_with_order_manager,json=syncWithOrderManager,proto3" json:"sync_with_order_manager,omitempty"`
-	unknownFields             protoimpl.UnknownFields
-	sizeCache                 protoimpl.SizeCache
+func (x *GetLeverageResponse) GetMarginType() string {
+	if x != nil {
+		return x.MarginType
+	}
+	return ""
 }
 
-func (x *GetFuturesPositionsOrdersRequest) Reset() {
-	*x = GetFuturesPositionsOrdersRequest{}
-	mi := &file_rpc_proto_msgTypes[179]
+func (x *GetLeverageResponse) GetLeverage() float64 {
+	if x != nil {
+		return x.Leverage
+	}
+	return 0
+}
+
+func (x *GetLeverageResponse) GetOrderSide() string {
+	if x != nil {
+		return x.OrderSide
+	}
+	return ""
+}
+
+type SetLeverageRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Exchange       string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset          string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair           *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	UnderlyingPair *CurrencyPair          `protobuf:"bytes,4,opt,name=underlying_pair,json=underlyingPair,proto3" json:"underlying_pair,omitempty"`
+	MarginType     string                 `protobuf:"bytes,5,opt,name=margin_type,json=marginType,proto3" json:"margin_type,omitempty"`
+	Leverage       float64                `protobuf:"fixed64,6,opt,name=leverage,proto3" json:"leverage,omitempty"`
+	OrderSide      string                 `protobuf:"bytes,7,opt,name=order_side,json=orderSide,proto3" json:"order_side,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SetLeverageRequest) Reset() {
+	*x = SetLeverageRequest{}
+	mi := &file_rpc_proto_msgTypes[206]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFuturesPositionsOrdersRequest) String() string {
+func (x *SetLeverageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFuturesPositionsOrdersRequest) ProtoMessage() {}
+func (*SetLeverageRequest) ProtoMessage() {}
 
-func (x *GetFuturesPositionsOrdersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[179]
+func (x *SetLeverageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[206]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11410,89 +13397,88 @@ func (x *GetFuturesPositionsOrdersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFuturesPositionsOrdersRequest.ProtoReflect.Descriptor instead.
-func (*GetFuturesPositionsOrdersRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{179}
+// Deprecated: Use SetLeverageRequest.ProtoReflect.Descriptor instead.
+func (*SetLeverageRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{206}
 }
 
-func (x *GetFuturesPositionsOrdersRequest) GetExchange() string {
+func (x *SetLeverageRequest) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *GetFuturesPositionsOrdersRequest) GetAsset() string {
+func (x *SetLeverageRequest) GetAsset() string {
 	if x != nil {
 		return x.Asset
 	}
 	return ""
 }
 
-func (x *GetFuturesPositionsOrdersRequest) GetPair() *CurrencyPair {
+func (x *SetLeverageRequest) GetPair() *CurrencyPair {
 	if x != nil {
 		return x.Pair
 	}
 	return nil
 }
 
-func (x *GetFuturesPositionsOrdersRequest) GetUnderlyingPair() *CurrencyPair {
+func (x *SetLeverageRequest) GetUnderlyingPair() *CurrencyPair {
 	if x != nil {
 		return x.UnderlyingPair
 	}
 	return nil
 }
 
-func (x *GetFuturesPositionsOrdersRequest) GetStartDate() string {
-	if x != nil {
-		return x.StartDate
-	}
-	return ""
-}
-
-func (x *GetFuturesPositionsOrdersRequest) GetEndDate() string {
+func (x *SetLeverageRequest) GetMarginType() string {
 	if x != nil {
-		return x.EndDate
+		return x.MarginType
 	}
 	return ""
 }
 
-func (x *GetFuturesPositionsOrdersRequest) GetRespectOrderHistoryLimits() bool {
+func (x *SetLeverageRequest) GetLeverage() float64 {
 	if x != nil {
-		return x.RespectOrderHistoryLimits
+		return x.Leverage
 	}
-	return false
+	return 0
 }
 
-func (x *GetFuturesPositionsOrdersRequest) GetSyncWithOrderManager() bool {
+func (x *SetLeverageRequest) GetOrderSide() string {
 	if x != nil {
-		return x.SyncWithOrderManager
+		return x.OrderSide
 	}
-	return false
+	return ""
 }
 
-type GetFuturesPositionsOrdersResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Positions     []*FuturePosition      `protobuf:"bytes,6,rep,name=positions,proto3" json:"positions,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type SetLeverageResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Exchange       string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset          string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair           *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	UnderlyingPair *CurrencyPair          `protobuf:"bytes,4,opt,name=underlying_pair,json=underlyingPair,proto3" json:"underlying_pair,omitempty"`
+	MarginType     string                 `protobuf:"bytes,5,opt,name=margin_type,json=marginType,proto3" json:"margin_type,omitempty"`
+	OrderSide      string                 `protobuf:"bytes,6,opt,name=order_side,json=orderSide,proto3" json:"order_side,omitempty"`
+	Success        bool                   `protobuf:"varint,7,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetFuturesPositionsOrdersResponse) Reset() {
-	*x = GetFuturesPositionsOrdersResponse{}
-	mi := &file_rpc_proto_msgTypes[180]
+func (x *SetLeverageResponse) Reset() {
+	*x = SetLeverageResponse{}
+	mi := &file_rpc_proto_msgTypes[207]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFuturesPositionsOrdersResponse) String() string {
+func (x *SetLeverageResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFuturesPositionsOrdersResponse) ProtoMessage() {}
+func (*SetLeverageResponse) ProtoMessage() {}
 
-func (x *GetFuturesPositionsOrdersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[180]
+func (x *SetLeverageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[207]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11503,94 +13489,86 @@ func (x *GetFuturesPositionsOrdersResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFuturesPositionsOrdersResponse.ProtoReflect.Descriptor instead.
-func (*GetFuturesPositionsOrdersResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{180}
+// Deprecated: Use SetLeverageResponse.ProtoReflect.Descriptor instead.
+func (*SetLeverageResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{207}
 }
 
-func (x *GetFuturesPositionsOrdersResponse) GetPositions() []*FuturePosition {
+func (x *SetLeverageResponse) GetExchange() string {
 	if x != nil {
-		return x.Positions
+		return x.Exchange
 	}
-	return nil
-}
-
-type GetCollateralModeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return ""
 }
 
-func (x *GetCollateralModeRequest) Reset() {
-	*x = GetCollateralModeRequest{}
-	mi := &file_rpc_proto_msgTypes[181]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *SetLeverageResponse) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
 }
 
-func (x *GetCollateralModeRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SetLeverageResponse) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
 }
 
-func (*GetCollateralModeRequest) ProtoMessage() {}
-
-func (x *GetCollateralModeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[181]
+func (x *SetLeverageResponse) GetUnderlyingPair() *CurrencyPair {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.UnderlyingPair
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use GetCollateralModeRequest.ProtoReflect.Descriptor instead.
-func (*GetCollateralModeRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{181}
+func (x *SetLeverageResponse) GetMarginType() string {
+	if x != nil {
+		return x.MarginType
+	}
+	return ""
 }
 
-func (x *GetCollateralModeRequest) GetExchange() string {
+func (x *SetLeverageResponse) GetOrderSide() string {
 	if x != nil {
-		return x.Exchange
+		return x.OrderSide
 	}
 	return ""
 }
 
-func (x *GetCollateralModeRequest) GetAsset() string {
+func (x *SetLeverageResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Asset
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-type GetCollateralModeResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Exchange       string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset          string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	CollateralMode string                 `protobuf:"bytes,3,opt,name=collateral_mode,json=collateralMode,proto3" json:"collateral_mode,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type GetCollateralRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Exchange          string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset             string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	IncludeBreakdown  bool                   `protobuf:"varint,3,opt,name=include_breakdown,json=includeBreakdown,proto3" json:"include_breakdown,omitempty"`
+	CalculateOffline  bool                   `protobuf:"varint,4,opt,name=calculate_offline,json=calculateOffline,proto3" json:"calculate_offline,omitempty"`
+	IncludeZeroValues bool                   `protobuf:"varint,5,opt,name=include_zero_values,json=includeZeroValues,proto3" json:"include_zero_values,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *GetCollateralModeResponse) Reset() {
-	*x = GetCollateralModeResponse{}
-	mi := &file_rpc_proto_msgTypes[182]
+func (x *GetCollateralRequest) Reset() {
+	*x = GetCollateralRequest{}
+	mi := &file_rpc_proto_msgTypes[208]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCollateralModeResponse) String() string {
+func (x *GetCollateralRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCollateralModeResponse) ProtoMessage() {}
+func (*GetCollateralRequest) ProtoMessage() {}
 
-func (x *GetCollateralModeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[182]
+func (x *GetCollateralRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[208]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11601,56 +13579,78 @@ func (x *GetCollateralModeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCollateralModeResponse.ProtoReflect.Descriptor instead.
-func (*GetCollateralModeResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{182}
+// Deprecated: Use GetCollateralRequest.ProtoReflect.Descriptor instead.
+func (*GetCollateralRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{208}
 }
 
-func (x *GetCollateralModeResponse) GetExchange() string {
+func (x *GetCollateralRequest) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *GetCollateralModeResponse) GetAsset() string {
+func (x *GetCollateralRequest) GetAsset() string {
 	if x != nil {
 		return x.Asset
 	}
 	return ""
 }
 
-func (x *GetCollateralModeResponse) GetCollateralMode() string {
+func (x *GetCollateralRequest) GetIncludeBreakdown() bool {
 	if x != nil {
-		return x.CollateralMode
+		return x.IncludeBreakdown
 	}
-	return ""
+	return false
 }
 
-type SetCollateralModeRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Exchange       string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset          string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	CollateralMode string                 `protobuf:"bytes,3,opt,name=collateral_mode,json=collateralMode,proto3" json:"collateral_mode,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *GetCollateralRequest) GetCalculateOffline() bool {
+	if x != nil {
+		return x.CalculateOffline
+	}
+	return false
 }
 
-func (x *SetCollateralModeRequest) Reset() {
-	*x = SetCollateralModeRequest{}
-	mi := &file_rpc_proto_msgTypes[183]
+func (x *GetCollateralRequest) GetIncludeZeroValues() bool {
+	if x != nil {
+		return x.IncludeZeroValues
+	}
+	return false
+}
+
+type GetCollateralResponse struct {
+	state                                       protoimpl.MessageState   `protogen:"open.v1"`
+	SubAccount                                  string                   `protobuf:"bytes,1,opt,name=sub_account,json=subAccount,proto3" json:"sub_account,omitempty"`
+	CollateralCurrency                          string                   `protobuf:"bytes,2,opt,name=collateral_currency,json=collateralCurrency,proto3" json:"collateral_currency,omitempty"`
+	TotalValueOfPositiveSpotBalances            string                   `protobuf:"bytes,3,opt,name=total_value_of_positive_spot_balances,json=totalValueOfPositiveSpotBalances,proto3" json:"total_value_of_positive_spot_balances,omitempty"`
+	CollateralContributedByPositiveSpotBalances string                   `protobuf:"bytes,4,opt,name=collateral_contributed_by_positive_spot_balances,json=collateralContributedByPositiveSpotBalances,proto3" json:"collateral_contributed_by_positive_spot_balances,omitempty"`
+	UsedCollateral                              string                   `protobuf:"bytes,5,opt,name=used_collateral,json=usedCollateral,proto3" json:"used_collateral,omitempty"`
+	UsedBreakdown                               *CollateralUsedBreakdown `protobuf:"bytes,6,opt,name=used_breakdown,json=usedBreakdown,proto3" json:"used_breakdown,omitempty"`
+	AvailableCollateral                         string                   `protobuf:"bytes,7,opt,name=available_collateral,json=availableCollateral,proto3" json:"available_collateral,omitempty"`
+	MaintenanceCollateral                       string                   `protobuf:"bytes,8,opt,name=maintenance_collateral,json=maintenanceCollateral,proto3" json:"maintenance_collateral,omitempty"`
+	UnrealisedPnl                               string                   `protobuf:"bytes,9,opt,name=unrealised_pnl,json=unrealisedPnl,proto3" json:"unrealised_pnl,omitempty"`
+	CurrencyBreakdown                           []*CollateralForCurrency `protobuf:"bytes,10,rep,name=currency_breakdown,json=currencyBreakdown,proto3" json:"currency_breakdown,omitempty"`
+	PositionBreakdown                           []*CollateralByPosition  `protobuf:"bytes,11,rep,name=position_breakdown,json=positionBreakdown,proto3" json:"position_breakdown,omitempty"`
+	unknownFields                               protoimpl.UnknownFields
+	sizeCache                                   protoimpl.SizeCache
+}
+
+func (x *GetCollateralResponse) Reset() {
+	*x = GetCollateralResponse{}
+	mi := &file_rpc_proto_msgTypes[209]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetCollateralModeRequest) String() string {
+func (x *GetCollateralResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetCollateralModeRequest) ProtoMessage() {}
+func (*GetCollateralResponse) ProtoMessage() {}
 
-func (x *SetCollateralModeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[183]
+func (x *GetCollateralResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[209]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11661,116 +13661,122 @@ func (x *SetCollateralModeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetCollateralModeRequest.ProtoReflect.Descriptor instead.
-func (*SetCollateralModeRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{183}
+// Deprecated: Use GetCollateralResponse.ProtoReflect.Descriptor instead.
+func (*GetCollateralResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{209}
 }
 
-func (x *SetCollateralModeRequest) GetExchange() string {
+func (x *GetCollateralResponse) GetSubAccount() string {
 	if x != nil {
-		return x.Exchange
+		return x.SubAccount
 	}
 	return ""
 }
 
-func (x *SetCollateralModeRequest) GetAsset() string {
+func (x *GetCollateralResponse) GetCollateralCurrency() string {
 	if x != nil {
-		return x.Asset
+		return x.CollateralCurrency
 	}
 	return ""
 }
 
-func (x *SetCollateralModeRequest) GetCollateralMode() string {
+func (x *GetCollateralResponse) GetTotalValueOfPositiveSpotBalances() string {
 	if x != nil {
-		return x.CollateralMode
+		return x.TotalValueOfPositiveSpotBalances
 	}
 	return ""
 }
 
-type SetCollateralModeResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Success       bool                   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *SetCollateralModeResponse) Reset() {
-	*x = SetCollateralModeResponse{}
-	mi := &file_rpc_proto_msgTypes[184]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *GetCollateralResponse) GetCollateralContributedByPositiveSpotBalances() string {
+	if x != nil {
+		return x.CollateralContributedByPositiveSpotBalances
+	}
+	return ""
 }
 
-func (x *SetCollateralModeResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetCollateralResponse) GetUsedCollateral() string {
+	if x != nil {
+		return x.UsedCollateral
+	}
+	return ""
 }
 
-func (*SetCollateralModeResponse) ProtoMessage() {}
-
-func (x *SetCollateralModeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[184]
+func (x *GetCollateralResponse) GetUsedBreakdown() *CollateralUsedBreakdown {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.UsedBreakdown
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use SetCollateralModeResponse.ProtoReflect.Descriptor instead.
-func (*SetCollateralModeResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{184}
+func (x *GetCollateralResponse) GetAvailableCollateral() string {
+	if x != nil {
+		return x.AvailableCollateral
+	}
+	return ""
 }
 
-func (x *SetCollateralModeResponse) GetExchange() string {
+func (x *GetCollateralResponse) GetMaintenanceCollateral() string {
 	if x != nil {
-		return x.Exchange
+		return x.MaintenanceCollateral
 	}
 	return ""
 }
 
-func (x *SetCollateralModeResponse) GetAsset() string {
+func (x *GetCollateralResponse) GetUnrealisedPnl() string {
 	if x != nil {
-		return x.Asset
+		return x.UnrealisedPnl
 	}
 	return ""
 }
 
-func (x *SetCollateralModeResponse) GetSuccess() bool {
+func (x *GetCollateralResponse) GetCurrencyBreakdown() []*CollateralForCurrency {
 	if x != nil {
-		return x.Success
+		return x.CurrencyBreakdown
 	}
-	return false
+	return nil
 }
 
-type GetMarginTypeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GetCollateralResponse) GetPositionBreakdown() []*CollateralByPosition {
+	if x != nil {
+		return x.PositionBreakdown
+	}
+	return nil
 }
 
-func (x *GetMarginTypeRequest) Reset() {
-	*x = GetMarginTypeRequest{}
-	mi := &file_rpc_proto_msgTypes[185]
+type CollateralForCurrency struct {
+	state                       protoimpl.MessageState   `protogen:"open.v1"`
+	Currency                    string                   `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	ExcludedFromCollateral      bool                     `protobuf:"varint,2,opt,name=excluded_from_collateral,json=excludedFromCollateral,proto3" json:"excluded_from_collateral,omitempty"`
+	TotalFunds                  string                   `protobuf:"bytes,3,opt,name=total_funds,json=totalFunds,proto3" json:"total_funds,omitempty"`
+	AvailableForUseAsCollateral string                   `protobuf:"bytes,4,opt,name=available_for_use_as_collateral,json=availableForUseAsCollateral,proto3" json:"available_for_use_as_collateral,omitempty"`
+	ApproxFairMarketValue       string                   `protobuf:"bytes,5,opt,name=approx_fair_market_value,json=approxFairMarketValue,proto3" json:"approx_fair_market_value,omitempty"`
+	Weighting                   string                   `protobuf:"bytes,6,opt,name=weighting,proto3" json:"weighting,omitempty"`
+	CollateralContribution      string                   `protobuf:"bytes,7,opt,name=collateral_contribution,json=collateralContribution,proto3" json:"collateral_contribution,omitempty"`
+	ScaledToCurrency            string                   `protobuf:"bytes,8,opt,name=scaled_to_currency,json=scaledToCurrency,proto3" json:"scaled_to_currency,omitempty"`
+	UnrealisedPnl               string                   `protobuf:"bytes,9,opt,name=unrealised_pnl,json=unrealisedPnl,proto3" json:"unrealised_pnl,omitempty"`
+	FundsInUse                  string                   `protobuf:"bytes,10,opt,name=funds_in_use,json=fundsInUse,proto3" json:"funds_in_use,omitempty"`
+	AdditionalCollateralUsed    string                   `protobuf:"bytes,11,opt,name=additional_collateral_used,json=additionalCollateralUsed,proto3" json:"additional_collateral_used,omitempty"`
+	UsedBreakdown               *CollateralUsedBreakdown `protobuf:"bytes,12,opt,name=used_breakdown,json=usedBreakdown,proto3" json:"used_breakdown,omitempty"`
+	Error                       string                   `protobuf:"bytes,13,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *CollateralForCurrency) Reset() {
+	*x = CollateralForCurrency{}
+	mi := &file_rpc_proto_msgTypes[210]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMarginTypeRequest) String() string {
+func (x *CollateralForCurrency) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMarginTypeRequest) ProtoMessage() {}
+func (*CollateralForCurrency) ProtoMessage() {}
 
-func (x *GetMarginTypeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[185]
+func (x *CollateralForCurrency) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[210]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11781,128 +13787,130 @@ func (x *GetMarginTypeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMarginTypeRequest.ProtoReflect.Descriptor instead.
-func (*GetMarginTypeRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{185}
+// Deprecated: Use CollateralForCurrency.ProtoReflect.Descriptor instead.
+func (*CollateralForCurrency) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{210}
 }
 
-func (x *GetMarginTypeRequest) GetExchange() string {
+func (x *CollateralForCurrency) GetCurrency() string {
 	if x != nil {
-		return x.Exchange
+		return x.Currency
 	}
 	return ""
 }
 
-func (x *GetMarginTypeRequest) GetAsset() string {
+func (x *CollateralForCurrency) GetExcludedFromCollateral() bool {
 	if x != nil {
-		return x.Asset
+		return x.ExcludedFromCollateral
 	}
-	return ""
+	return false
 }
 
-func (x *GetMarginTypeRequest) GetPair() *CurrencyPair {
+func (x *CollateralForCurrency) GetTotalFunds() string {
 	if x != nil {
-		return x.Pair
+		return x.TotalFunds
 	}
-	return nil
+	return ""
 }
 
-type GetMarginTypeResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	MarginType    string                 `protobuf:"bytes,4,opt,name=margin_type,json=marginType,proto3" json:"margin_type,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *CollateralForCurrency) GetAvailableForUseAsCollateral() string {
+	if x != nil {
+		return x.AvailableForUseAsCollateral
+	}
+	return ""
 }
 
-func (x *GetMarginTypeResponse) Reset() {
-	*x = GetMarginTypeResponse{}
-	mi := &file_rpc_proto_msgTypes[186]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *CollateralForCurrency) GetApproxFairMarketValue() string {
+	if x != nil {
+		return x.ApproxFairMarketValue
+	}
+	return ""
 }
 
-func (x *GetMarginTypeResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CollateralForCurrency) GetWeighting() string {
+	if x != nil {
+		return x.Weighting
+	}
+	return ""
 }
 
-func (*GetMarginTypeResponse) ProtoMessage() {}
+func (x *CollateralForCurrency) GetCollateralContribution() string {
+	if x != nil {
+		return x.CollateralContribution
+	}
+	return ""
+}
 
-func (x *GetMarginTypeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[186]
+func (x *CollateralForCurrency) GetScaledToCurrency() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ScaledToCurrency
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetMarginTypeResponse.ProtoReflect.Descriptor instead.
-func (*GetMarginTypeResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{186}
+func (x *CollateralForCurrency) GetUnrealisedPnl() string {
+	if x != nil {
+		return x.UnrealisedPnl
+	}
+	return ""
 }
 
-func (x *GetMarginTypeResponse) GetExchange() string {
+func (x *CollateralForCurrency) GetFundsInUse() string {
 	if x != nil {
-		return x.Exchange
+		return x.FundsInUse
 	}
 	return ""
 }
 
-func (x *GetMarginTypeResponse) GetAsset() string {
+func (x *CollateralForCurrency) GetAdditionalCollateralUsed() string {
 	if x != nil {
-		return x.Asset
+		return x.AdditionalCollateralUsed
 	}
 	return ""
 }
 
-func (x *GetMarginTypeResponse) GetPair() *CurrencyPair {
+func (x *CollateralForCurrency) GetUsedBreakdown() *CollateralUsedBreakdown {
 	if x != nil {
-		return x.Pair
+		return x.UsedBreakdown
 	}
 	return nil
 }
 
-func (x *GetMarginTypeResponse) GetMarginType() string {
+func (x *CollateralForCurrency) GetError() string {
 	if x != nil {
-		return x.MarginType
+		return x.Error
 	}
 	return ""
 }
 
-type ChangePositionMarginRequest struct {
-	state                   protoimpl.MessageState `protogen:"open.v1"`
-	Exchange                string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset                   string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair                    *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	MarginType              string                 `protobuf:"bytes,4,opt,name=margin_type,json=marginType,proto3" json:"margin_type,omitempty"`
-	OriginalAllocatedMargin float64                `protobuf:"fixed64,5,opt,name=original_allocated_margin,json=originalAllocatedMargin,proto3" json:"original_allocated_margin,omitempty"`
-	NewAllocatedMargin      float64                `protobuf:"fixed64,6,opt,name=new_allocated_margin,json=newAllocatedMargin,proto3" json:"new_allocated_margin,omitempty"`
-	MarginSide              string                 `protobuf:"bytes,7,opt,name=margin_side,json=marginSide,proto3" json:"margin_side,omitempty"`
-	unknownFields           protoimpl.UnknownFields
-	sizeCache               protoimpl.SizeCache
+type CollateralByPosition struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Currency            string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	Size                string                 `protobuf:"bytes,2,opt,name=size,proto3" json:"size,omitempty"`
+	OpenOrderSize       string                 `protobuf:"bytes,3,opt,name=open_order_size,json=openOrderSize,proto3" json:"open_order_size,omitempty"`
+	PositionSize        string                 `protobuf:"bytes,4,opt,name=position_size,json=positionSize,proto3" json:"position_size,omitempty"`
+	MarkPrice           string                 `protobuf:"bytes,5,opt,name=mark_price,json=markPrice,proto3" json:"mark_price,omitempty"`
+	RequiredMargin      string                 `protobuf:"bytes,6,opt,name=required_margin,json=requiredMargin,proto3" json:"required_margin,omitempty"`
+	TotalCollateralUsed string                 `protobuf:"bytes,7,opt,name=total_collateral_used,json=totalCollateralUsed,proto3" json:"total_collateral_used,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
-func (x *ChangePositionMarginRequest) Reset() {
-	*x = ChangePositionMarginRequest{}
-	mi := &file_rpc_proto_msgTypes[187]
+func (x *CollateralByPosition) Reset() {
+	*x = CollateralByPosition{}
+	mi := &file_rpc_proto_msgTypes[211]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChangePositionMarginRequest) String() string {
+func (x *CollateralByPosition) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangePositionMarginRequest) ProtoMessage() {}
+func (*CollateralByPosition) ProtoMessage() {}
 
-func (x *ChangePositionMarginRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[187]
+func (x *CollateralByPosition) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[211]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11913,169 +13921,190 @@ func (x *ChangePositionMarginRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangePositionMarginRequest.ProtoReflect.Descriptor instead.
-func (*ChangePositionMarginRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{187}
+// Deprecated: Use CollateralByPosition.ProtoReflect.Descriptor instead.
+func (*CollateralByPosition) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{211}
 }
 
-func (x *ChangePositionMarginRequest) GetExchange() string {
+func (x *CollateralByPosition) GetCurrency() string {
 	if x != nil {
-		return x.Exchange
+		return x.Currency
 	}
 	return ""
 }
 
-func (x *ChangePositionMarginRequest) GetAsset() string {
+func (x *CollateralByPosition) GetSize() string {
 	if x != nil {
-		return x.Asset
+		return x.Size
 	}
 	return ""
 }
 
-func (x *ChangePositionMarginRequest) GetPair() *CurrencyPair {
+func (x *CollateralByPosition) GetOpenOrderSize() string {
 	if x != nil {
-		return x.Pair
+		return x.OpenOrderSize
 	}
-	return nil
+	return ""
 }
 
-func (x *ChangePositionMarginRequest) GetMarginType() string {
+func (x *CollateralByPosition) GetPositionSize() string {
 	if x != nil {
-		return x.MarginType
+		return x.PositionSize
 	}
 	return ""
 }
 
-func (x *ChangePositionMarginRequest) GetOriginalAllocatedMargin() float64 {
+func (x *CollateralByPosition) GetMarkPrice() string {
 	if x != nil {
-		return x.OriginalAllocatedMargin
+		return x.MarkPrice
 	}
-	return 0
+	return ""
 }
 
-func (x *ChangePositionMarginRequest) GetNewAllocatedMargin() float64 {
+func (x *CollateralByPosition) GetRequiredMargin() string {
 	if x != nil {
-		return x.NewAllocatedMargin
+		return x.RequiredMargin
 	}
-	return 0
+	return ""
 }
 
-func (x *ChangePositionMarginRequest) GetMarginSide() string {
+func (x *CollateralByPosition) GetTotalCollateralUsed() string {
 	if x != nil {
-		return x.MarginSide
+		return x.TotalCollateralUsed
 	}
 	return ""
 }
 
-type ChangePositionMarginResponse struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	Exchange           string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset              string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair               *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	MarginType         string                 `protobuf:"bytes,4,opt,name=margin_type,json=marginType,proto3" json:"margin_type,omitempty"`
-	NewAllocatedMargin float64                `protobuf:"fixed64,5,opt,name=new_allocated_margin,json=newAllocatedMargin,proto3" json:"new_allocated_margin,omitempty"`
-	MarginSide         string                 `protobuf:"bytes,6,opt,name=margin_side,json=marginSide,proto3" json:"margin_side,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type CollateralUsedBreakdown struct {
+	state                           protoimpl.MessageState `protogen:"open.v1"`
+	LockedInStakes                  string                 `protobuf:"bytes,1,opt,name=locked_in_stakes,json=lockedInStakes,proto3" json:"locked_in_stakes,omitempty"`
+	LockedInNftBids                 string                 `protobuf:"bytes,2,opt,name=locked_in_nft_bids,json=lockedInNftBids,proto3" json:"locked_in_nft_bids,omitempty"`
+	LockedInFeeVoucher              string                 `protobuf:"bytes,3,opt,name=locked_in_fee_voucher,json=lockedInFeeVoucher,proto3" json:"locked_in_fee_voucher,omitempty"`
+	LockedInSpotMarginFundingOffers string                 `protobuf:"bytes,4,opt,name=locked_in_spot_margin_funding_offers,json=lockedInSpotMarginFundingOffers,proto3" json:"locked_in_spot_margin_funding_offers,omitempty"`
+	LockedInSpotOrders              string                 `protobuf:"bytes,5,opt,name=locked_in_spot_orders,json=lockedInSpotOrders,proto3" json:"locked_in_spot_orders,omitempty"`
+	LockedAsCollateral              string                 `protobuf:"bytes,6,opt,name=locked_as_collateral,json=lockedAsCollateral,proto3" json:"locked_as_collateral,omitempty"`
+	UsedInFutures                   string                 `protobuf:"bytes,7,opt,name=used_in_futures,json=usedInFutures,proto3" json:"used_in_futures,omitempty"`
+	UsedInSpotMargin                string                 `protobuf:"bytes,8,opt,name=used_in_spot_margin,json=usedInSpotMargin,proto3" json:"used_in_spot_margin,omitempty"`
+	unknownFields                   protoimpl.UnknownFields
+	sizeCache                       protoimpl.SizeCache
 }
 
-func (x *ChangePositionMarginResponse) Reset() {
-	*x = ChangePositionMarginResponse{}
-	mi := &file_rpc_proto_msgTypes[188]
+func (x *CollateralUsedBreakdown) Reset() {
+	*x = CollateralUsedBreakdown{}
+	mi := &file_rpc_proto_msgTypes[212]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChangePositionMarginResponse) String() string {
+func (x *CollateralUsedBreakdown) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangePositionMarginResponse) ProtoMessage() {}
+func (*CollateralUsedBreakdown) ProtoMessage() {}
+
+func (x *CollateralUsedBreakdown) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[212]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollateralUsedBreakdown.ProtoReflect.Descriptor instead.
+func (*CollateralUsedBreakdown) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{212}
+}
 
-func (x *ChangePositionMarginResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[188]
+func (x *CollateralUsedBreakdown) GetLockedInStakes() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.LockedInStakes
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ChangePositionMarginResponse.ProtoReflect.Descriptor instead.
-func (*ChangePositionMarginResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{188}
+func (x *CollateralUsedBreakdown) GetLockedInNftBids() string {
+	if x != nil {
+		return x.LockedInNftBids
+	}
+	return ""
 }
 
-func (x *ChangePositionMarginResponse) GetExchange() string {
+func (x *CollateralUsedBreakdown) GetLockedInFeeVoucher() string {
 	if x != nil {
-		return x.Exchange
+		return x.LockedInFeeVoucher
 	}
 	return ""
 }
 
-func (x *ChangePositionMarginResponse) GetAsset() string {
+func (x *CollateralUsedBreakdown) GetLockedInSpotMarginFundingOffers() string {
 	if x != nil {
-		return x.Asset
+		return x.LockedInSpotMarginFundingOffers
 	}
 	return ""
 }
 
-func (x *ChangePositionMarginResponse) GetPair() *CurrencyPair {
+func (x *CollateralUsedBreakdown) GetLockedInSpotOrders() string {
 	if x != nil {
-		return x.Pair
+		return x.LockedInSpotOrders
 	}
-	return nil
+	return ""
 }
 
-func (x *ChangePositionMarginResponse) GetMarginType() string {
+func (x *CollateralUsedBreakdown) GetLockedAsCollateral() string {
 	if x != nil {
-		return x.MarginType
+		return x.LockedAsCollateral
 	}
 	return ""
 }
 
-func (x *ChangePositionMarginResponse) GetNewAllocatedMargin() float64 {
+func (x *CollateralUsedBreakdown) GetUsedInFutures() string {
 	if x != nil {
-		return x.NewAllocatedMargin
+		return x.UsedInFutures
 	}
-	return 0
+	return ""
 }
 
-func (x *ChangePositionMarginResponse) GetMarginSide() string {
+func (x *CollateralUsedBreakdown) GetUsedInSpotMargin() string {
 	if x != nil {
-		return x.MarginSide
+		return x.UsedInSpotMargin
 	}
 	return ""
 }
 
-type SetMarginTypeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	MarginType    string                 `protobuf:"bytes,4,opt,name=margin_type,json=marginType,proto3" json:"margin_type,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetFundingRatesRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Exchange             string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset                string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair                 *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	StartDate            string                 `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate              string                 `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	PaymentCurrency      string                 `protobuf:"bytes,6,opt,name=payment_currency,json=paymentCurrency,proto3" json:"payment_currency,omitempty"`
+	IncludePredicted     bool                   `protobuf:"varint,7,opt,name=include_predicted,json=includePredicted,proto3" json:"include_predicted,omitempty"`
+	IncludePayments      bool                   `protobuf:"varint,8,opt,name=include_payments,json=includePayments,proto3" json:"include_payments,omitempty"`
+	RespectHistoryLimits bool                   `protobuf:"varint,9,opt,name=respect_history_limits,json=respectHistoryLimits,proto3" json:"respect_history_limits,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
-func (x *SetMarginTypeRequest) Reset() {
-	*x = SetMarginTypeRequest{}
-	mi := &file_rpc_proto_msgTypes[189]
+func (x *GetFundingRatesRequest) Reset() {
+	*x = GetFundingRatesRequest{}
+	mi := &file_rpc_proto_msgTypes[213]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetMarginTypeRequest) String() string {
+func (x *GetFundingRatesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetMarginTypeRequest) ProtoMessage() {}
+func (*GetFundingRatesRequest) ProtoMessage() {}
 
-func (x *SetMarginTypeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[189]
+func (x *GetFundingRatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[213]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12086,64 +14115,96 @@ func (x *SetMarginTypeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetMarginTypeRequest.ProtoReflect.Descriptor instead.
-func (*SetMarginTypeRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{189}
+// Deprecated: Use GetFundingRatesRequest.ProtoReflect.Descriptor instead.
+func (*GetFundingRatesRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{213}
 }
 
-func (x *SetMarginTypeRequest) GetExchange() string {
+func (x *GetFundingRatesRequest) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *SetMarginTypeRequest) GetAsset() string {
+func (x *GetFundingRatesRequest) GetAsset() string {
 	if x != nil {
 		return x.Asset
 	}
 	return ""
 }
 
-func (x *SetMarginTypeRequest) GetPair() *CurrencyPair {
+func (x *GetFundingRatesRequest) GetPair() *CurrencyPair {
 	if x != nil {
 		return x.Pair
 	}
 	return nil
 }
 
-func (x *SetMarginTypeRequest) GetMarginType() string {
+func (x *GetFundingRatesRequest) GetStartDate() string {
 	if x != nil {
-		return x.MarginType
+		return x.StartDate
 	}
 	return ""
 }
 
-type SetMarginTypeResponse struct {
+func (x *GetFundingRatesRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *GetFundingRatesRequest) GetPaymentCurrency() string {
+	if x != nil {
+		return x.PaymentCurrency
+	}
+	return ""
+}
+
+func (x *GetFundingRatesRequest) GetIncludePredicted() bool {
+	if x != nil {
+		return x.IncludePredicted
+	}
+	return false
+}
+
+func (x *GetFundingRatesRequest) GetIncludePayments() bool {
+	if x != nil {
+		return x.IncludePayments
+	}
+	return false
+}
+
+func (x *GetFundingRatesRequest) GetRespectHistoryLimits() bool {
+	if x != nil {
+		return x.RespectHistoryLimits
+	}
+	return false
+}
+
+type GetFundingRatesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	Success       bool                   `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	Rates         *FundingData           `protobuf:"bytes,1,opt,name=rates,proto3" json:"rates,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetMarginTypeResponse) Reset() {
-	*x = SetMarginTypeResponse{}
-	mi := &file_rpc_proto_msgTypes[190]
+func (x *GetFundingRatesResponse) Reset() {
+	*x = GetFundingRatesResponse{}
+	mi := &file_rpc_proto_msgTypes[214]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetMarginTypeResponse) String() string {
+func (x *GetFundingRatesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetMarginTypeResponse) ProtoMessage() {}
+func (*GetFundingRatesResponse) ProtoMessage() {}
 
-func (x *SetMarginTypeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[190]
+func (x *GetFundingRatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[214]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12154,66 +14215,43 @@ func (x *SetMarginTypeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetMarginTypeResponse.ProtoReflect.Descriptor instead.
-func (*SetMarginTypeResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{190}
-}
-
-func (x *SetMarginTypeResponse) GetExchange() string {
-	if x != nil {
-		return x.Exchange
-	}
-	return ""
-}
-
-func (x *SetMarginTypeResponse) GetAsset() string {
-	if x != nil {
-		return x.Asset
-	}
-	return ""
+// Deprecated: Use GetFundingRatesResponse.ProtoReflect.Descriptor instead.
+func (*GetFundingRatesResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{214}
 }
 
-func (x *SetMarginTypeResponse) GetPair() *CurrencyPair {
+func (x *GetFundingRatesResponse) GetRates() *FundingData {
 	if x != nil {
-		return x.Pair
+		return x.Rates
 	}
 	return nil
 }
 
-func (x *SetMarginTypeResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
-}
-
-type GetLeverageRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Exchange       string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset          string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair           *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	UnderlyingPair *CurrencyPair          `protobuf:"bytes,4,opt,name=underlying_pair,json=underlyingPair,proto3" json:"underlying_pair,omitempty"`
-	MarginType     string                 `protobuf:"bytes,5,opt,name=margin_type,json=marginType,proto3" json:"margin_type,omitempty"`
-	OrderSide      string                 `protobuf:"bytes,6,opt,name=order_side,json=orderSide,proto3" json:"order_side,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type GetLatestFundingRateRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Exchange         string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset            string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair             *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	IncludePredicted bool                   `protobuf:"varint,4,opt,name=include_predicted,json=includePredicted,proto3" json:"include_predicted,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *GetLeverageRequest) Reset() {
-	*x = GetLeverageRequest{}
-	mi := &file_rpc_proto_msgTypes[191]
+func (x *GetLatestFundingRateRequest) Reset() {
+	*x = GetLatestFundingRateRequest{}
+	mi := &file_rpc_proto_msgTypes[215]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLeverageRequest) String() string {
+func (x *GetLatestFundingRateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLeverageRequest) ProtoMessage() {}
+func (*GetLatestFundingRateRequest) ProtoMessage() {}
 
-func (x *GetLeverageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[191]
+func (x *GetLatestFundingRateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[215]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12224,81 +14262,104 @@ func (x *GetLeverageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLeverageRequest.ProtoReflect.Descriptor instead.
-func (*GetLeverageRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{191}
+// Deprecated: Use GetLatestFundingRateRequest.ProtoReflect.Descriptor instead.
+func (*GetLatestFundingRateRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{215}
 }
 
-func (x *GetLeverageRequest) GetExchange() string {
+func (x *GetLatestFundingRateRequest) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *GetLeverageRequest) GetAsset() string {
+func (x *GetLatestFundingRateRequest) GetAsset() string {
 	if x != nil {
 		return x.Asset
 	}
 	return ""
 }
 
-func (x *GetLeverageRequest) GetPair() *CurrencyPair {
+func (x *GetLatestFundingRateRequest) GetPair() *CurrencyPair {
 	if x != nil {
 		return x.Pair
 	}
 	return nil
 }
 
-func (x *GetLeverageRequest) GetUnderlyingPair() *CurrencyPair {
+func (x *GetLatestFundingRateRequest) GetIncludePredicted() bool {
+	if x != nil {
+		return x.IncludePredicted
+	}
+	return false
+}
+
+type GetLatestFundingRateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rate          *FundingData           `protobuf:"bytes,1,opt,name=rate,proto3" json:"rate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLatestFundingRateResponse) Reset() {
+	*x = GetLatestFundingRateResponse{}
+	mi := &file_rpc_proto_msgTypes[216]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLatestFundingRateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLatestFundingRateResponse) ProtoMessage() {}
+
+func (x *GetLatestFundingRateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[216]
 	if x != nil {
-		return x.UnderlyingPair
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *GetLeverageRequest) GetMarginType() string {
-	if x != nil {
-		return x.MarginType
-	}
-	return ""
+// Deprecated: Use GetLatestFundingRateResponse.ProtoReflect.Descriptor instead.
+func (*GetLatestFundingRateResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{216}
 }
 
-func (x *GetLeverageRequest) GetOrderSide() string {
+func (x *GetLatestFundingRateResponse) GetRate() *FundingData {
 	if x != nil {
-		return x.OrderSide
+		return x.Rate
 	}
-	return ""
+	return nil
 }
 
-type GetLeverageResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Exchange       string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset          string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair           *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	UnderlyingPair *CurrencyPair          `protobuf:"bytes,4,opt,name=underlying_pair,json=underlyingPair,proto3" json:"underlying_pair,omitempty"`
-	MarginType     string                 `protobuf:"bytes,5,opt,name=margin_type,json=marginType,proto3" json:"margin_type,omitempty"`
-	Leverage       float64                `protobuf:"fixed64,6,opt,name=leverage,proto3" json:"leverage,omitempty"`
-	OrderSide      string                 `protobuf:"bytes,7,opt,name=order_side,json=orderSide,proto3" json:"order_side,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type ShutdownRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetLeverageResponse) Reset() {
-	*x = GetLeverageResponse{}
-	mi := &file_rpc_proto_msgTypes[192]
+func (x *ShutdownRequest) Reset() {
+	*x = ShutdownRequest{}
+	mi := &file_rpc_proto_msgTypes[217]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLeverageResponse) String() string {
+func (x *ShutdownRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLeverageResponse) ProtoMessage() {}
+func (*ShutdownRequest) ProtoMessage() {}
 
-func (x *GetLeverageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[192]
+func (x *ShutdownRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[217]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12309,88 +14370,92 @@ func (x *GetLeverageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLeverageResponse.ProtoReflect.Descriptor instead.
-func (*GetLeverageResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{192}
-}
-
-func (x *GetLeverageResponse) GetExchange() string {
-	if x != nil {
-		return x.Exchange
-	}
-	return ""
+// Deprecated: Use ShutdownRequest.ProtoReflect.Descriptor instead.
+func (*ShutdownRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{217}
 }
 
-func (x *GetLeverageResponse) GetAsset() string {
-	if x != nil {
-		return x.Asset
-	}
-	return ""
+type ShutdownResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetLeverageResponse) GetPair() *CurrencyPair {
-	if x != nil {
-		return x.Pair
-	}
-	return nil
+func (x *ShutdownResponse) Reset() {
+	*x = ShutdownResponse{}
+	mi := &file_rpc_proto_msgTypes[218]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLeverageResponse) GetUnderlyingPair() *CurrencyPair {
-	if x != nil {
-		return x.UnderlyingPair
-	}
-	return nil
+func (x *ShutdownResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GetLeverageResponse) GetMarginType() string {
-	if x != nil {
-		return x.MarginType
-	}
-	return ""
-}
+func (*ShutdownResponse) ProtoMessage() {}
 
-func (x *GetLeverageResponse) GetLeverage() float64 {
+func (x *ShutdownResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[218]
 	if x != nil {
-		return x.Leverage
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *GetLeverageResponse) GetOrderSide() string {
-	if x != nil {
-		return x.OrderSide
-	}
-	return ""
+// Deprecated: Use ShutdownResponse.ProtoReflect.Descriptor instead.
+func (*ShutdownResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{218}
 }
 
-type SetLeverageRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Exchange       string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset          string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair           *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	UnderlyingPair *CurrencyPair          `protobuf:"bytes,4,opt,name=underlying_pair,json=underlyingPair,proto3" json:"underlying_pair,omitempty"`
-	MarginType     string                 `protobuf:"bytes,5,opt,name=margin_type,json=marginType,proto3" json:"margin_type,omitempty"`
-	Leverage       float64                `protobuf:"fixed64,6,opt,name=leverage,proto3" json:"leverage,omitempty"`
-	OrderSide      string                 `protobuf:"bytes,7,opt,name=order_side,json=orderSide,proto3" json:"order_side,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type GetTechnicalAnalysisRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Exchange              string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                  *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType             string                 `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	AlgorithmType         string                 `protobuf:"bytes,4,opt,name=algorithm_type,json=algorithmType,proto3" json:"algorithm_type,omitempty"`
+	Interval              int64                  `protobuf:"varint,5,opt,name=interval,proto3" json:"interval,omitempty"`
+	Start                 *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=start,proto3" json:"start,omitempty"`
+	End                   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=end,proto3" json:"end,omitempty"`
+	Period                int64                  `protobuf:"varint,8,opt,name=period,proto3" json:"period,omitempty"`
+	FastPeriod            int64                  `protobuf:"varint,9,opt,name=fast_period,json=fastPeriod,proto3" json:"fast_period,omitempty"`
+	SlowPeriod            int64                  `protobuf:"varint,10,opt,name=slow_period,json=slowPeriod,proto3" json:"slow_period,omitempty"`
+	StandardDeviationUp   float64                `protobuf:"fixed64,11,opt,name=standard_deviation_up,json=standardDeviationUp,proto3" json:"standard_deviation_up,omitempty"`
+	StandardDeviationDown float64                `protobuf:"fixed64,12,opt,name=standard_deviation_down,json=standardDeviationDown,proto3" json:"standard_deviation_down,omitempty"`
+	MovingAverageType     int64                  `protobuf:"varint,13,opt,name=moving_average_type,json=movingAverageType,proto3" json:"moving_average_type,omitempty"`
+	OtherExchange         string                 `protobuf:"bytes,14,opt,name=other_exchange,json=otherExchange,proto3" json:"other_exchange,omitempty"`
+	OtherPair             *CurrencyPair          `protobuf:"bytes,15,opt,name=other_pair,json=otherPair,proto3" json:"other_pair,omitempty"`
+	OtherAssetType        string                 `protobuf:"bytes,16,opt,name=other_asset_type,json=otherAssetType,proto3" json:"other_asset_type,omitempty"`
+	SmoothingPeriod       int64                  `protobuf:"varint,17,opt,name=smoothing_period,json=smoothingPeriod,proto3" json:"smoothing_period,omitempty"`
+	SignalPeriod          int64                  `protobuf:"varint,18,opt,name=signal_period,json=signalPeriod,proto3" json:"signal_period,omitempty"`
+	SecondaryPeriod       int64                  `protobuf:"varint,19,opt,name=secondary_period,json=secondaryPeriod,proto3" json:"secondary_period,omitempty"`
+	Multiplier            float64                `protobuf:"fixed64,20,opt,name=multiplier,proto3" json:"multiplier,omitempty"`
+	AccelerationStep      float64                `protobuf:"fixed64,21,opt,name=acceleration_step,json=accelerationStep,proto3" json:"acceleration_step,omitempty"`
+	AccelerationMax       float64                `protobuf:"fixed64,22,opt,name=acceleration_max,json=accelerationMax,proto3" json:"acceleration_max,omitempty"`
+	Displacement          int64                  `protobuf:"varint,23,opt,name=displacement,proto3" json:"displacement,omitempty"`
+	SessionInterval       int64                  `protobuf:"varint,24,opt,name=session_interval,json=sessionInterval,proto3" json:"session_interval,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
-func (x *SetLeverageRequest) Reset() {
-	*x = SetLeverageRequest{}
-	mi := &file_rpc_proto_msgTypes[193]
+func (x *GetTechnicalAnalysisRequest) Reset() {
+	*x = GetTechnicalAnalysisRequest{}
+	mi := &file_rpc_proto_msgTypes[219]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetLeverageRequest) String() string {
+func (x *GetTechnicalAnalysisRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetLeverageRequest) ProtoMessage() {}
+func (*GetTechnicalAnalysisRequest) ProtoMessage() {}
 
-func (x *SetLeverageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[193]
+func (x *GetTechnicalAnalysisRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[219]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12401,260 +14466,201 @@ func (x *SetLeverageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetLeverageRequest.ProtoReflect.Descriptor instead.
-func (*SetLeverageRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{193}
+// Deprecated: Use GetTechnicalAnalysisRequest.ProtoReflect.Descriptor instead.
+func (*GetTechnicalAnalysisRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{219}
 }
 
-func (x *SetLeverageRequest) GetExchange() string {
+func (x *GetTechnicalAnalysisRequest) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *SetLeverageRequest) GetAsset() string {
-	if x != nil {
-		return x.Asset
-	}
-	return ""
-}
-
-func (x *SetLeverageRequest) GetPair() *CurrencyPair {
+func (x *GetTechnicalAnalysisRequest) GetPair() *CurrencyPair {
 	if x != nil {
 		return x.Pair
 	}
 	return nil
 }
 
-func (x *SetLeverageRequest) GetUnderlyingPair() *CurrencyPair {
+func (x *GetTechnicalAnalysisRequest) GetAssetType() string {
 	if x != nil {
-		return x.UnderlyingPair
+		return x.AssetType
 	}
-	return nil
+	return ""
 }
 
-func (x *SetLeverageRequest) GetMarginType() string {
+func (x *GetTechnicalAnalysisRequest) GetAlgorithmType() string {
 	if x != nil {
-		return x.MarginType
+		return x.AlgorithmType
 	}
 	return ""
 }
 
-func (x *SetLeverageRequest) GetLeverage() float64 {
+func (x *GetTechnicalAnalysisRequest) GetInterval() int64 {
 	if x != nil {
-		return x.Leverage
+		return x.Interval
 	}
 	return 0
 }
 
-func (x *SetLeverageRequest) GetOrderSide() string {
+func (x *GetTechnicalAnalysisRequest) GetStart() *timestamppb.Timestamp {
 	if x != nil {
-		return x.OrderSide
+		return x.Start
 	}
-	return ""
+	return nil
 }
 
-type SetLeverageResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Exchange       string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset          string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair           *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	UnderlyingPair *CurrencyPair          `protobuf:"bytes,4,opt,name=underlying_pair,json=underlyingPair,proto3" json:"underlying_pair,omitempty"`
-	MarginType     string                 `protobuf:"bytes,5,opt,name=margin_type,json=marginType,proto3" json:"margin_type,omitempty"`
-	OrderSide      string                 `protobuf:"bytes,6,opt,name=order_side,json=orderSide,proto3" json:"order_side,omitempty"`
-	Success        bool                   `protobuf:"varint,7,opt,name=success,proto3" json:"success,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *GetTechnicalAnalysisRequest) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
 }
 
-func (x *SetLeverageResponse) Reset() {
-	*x = SetLeverageResponse{}
-	mi := &file_rpc_proto_msgTypes[194]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *GetTechnicalAnalysisRequest) GetPeriod() int64 {
+	if x != nil {
+		return x.Period
+	}
+	return 0
 }
 
-func (x *SetLeverageResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetTechnicalAnalysisRequest) GetFastPeriod() int64 {
+	if x != nil {
+		return x.FastPeriod
+	}
+	return 0
 }
 
-func (*SetLeverageResponse) ProtoMessage() {}
-
-func (x *SetLeverageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[194]
+func (x *GetTechnicalAnalysisRequest) GetSlowPeriod() int64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.SlowPeriod
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use SetLeverageResponse.ProtoReflect.Descriptor instead.
-func (*SetLeverageResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{194}
+func (x *GetTechnicalAnalysisRequest) GetStandardDeviationUp() float64 {
+	if x != nil {
+		return x.StandardDeviationUp
+	}
+	return 0
 }
 
-func (x *SetLeverageResponse) GetExchange() string {
+func (x *GetTechnicalAnalysisRequest) GetStandardDeviationDown() float64 {
 	if x != nil {
-		return x.Exchange
+		return x.StandardDeviationDown
 	}
-	return ""
+	return 0
 }
 
-func (x *SetLeverageResponse) GetAsset() string {
+func (x *GetTechnicalAnalysisRequest) GetMovingAverageType() int64 {
 	if x != nil {
-		return x.Asset
+		return x.MovingAverageType
 	}
-	return ""
+	return 0
 }
 
-func (x *SetLeverageResponse) GetPair() *CurrencyPair {
+func (x *GetTechnicalAnalysisRequest) GetOtherExchange() string {
 	if x != nil {
-		return x.Pair
+		return x.OtherExchange
 	}
-	return nil
+	return ""
 }
 
-func (x *SetLeverageResponse) GetUnderlyingPair() *CurrencyPair {
+func (x *GetTechnicalAnalysisRequest) GetOtherPair() *CurrencyPair {
 	if x != nil {
-		return x.UnderlyingPair
+		return x.OtherPair
 	}
 	return nil
 }
 
-func (x *SetLeverageResponse) GetMarginType() string {
+func (x *GetTechnicalAnalysisRequest) GetOtherAssetType() string {
 	if x != nil {
-		return x.MarginType
+		return x.OtherAssetType
 	}
 	return ""
 }
 
-func (x *SetLeverageResponse) GetOrderSide() string {
+func (x *GetTechnicalAnalysisRequest) GetSmoothingPeriod() int64 {
 	if x != nil {
-		return x.OrderSide
+		return x.SmoothingPeriod
 	}
-	return ""
+	return 0
 }
 
-func (x *SetLeverageResponse) GetSuccess() bool {
+func (x *GetTechnicalAnalysisRequest) GetSignalPeriod() int64 {
 	if x != nil {
-		return x.Success
+		return x.SignalPeriod
 	}
-	return false
-}
-
-type GetCollateralRequest struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	Exchange          string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset             string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	IncludeBreakdown  bool                   `protobuf:"varint,3,opt,name=include_breakdown,json=includeBreakdown,proto3" json:"include_breakdown,omitempty"`
-	CalculateOffline  bool                   `protobuf:"varint,4,opt,name=calculate_offline,json=calculateOffline,proto3" json:"calculate_offline,omitempty"`
-	IncludeZeroValues bool                   `protobuf:"varint,5,opt,name=include_zero_values,json=includeZeroValues,proto3" json:"include_zero_values,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
-}
-
-func (x *GetCollateralRequest) Reset() {
-	*x = GetCollateralRequest{}
-	mi := &file_rpc_proto_msgTypes[195]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetCollateralRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return 0
 }
 
-func (*GetCollateralRequest) ProtoMessage() {}
-
-func (x *GetCollateralRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[195]
+func (x *GetTechnicalAnalysisRequest) GetSecondaryPeriod() int64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.SecondaryPeriod
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetCollateralRequest.ProtoReflect.Descriptor instead.
-func (*GetCollateralRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{195}
+	return 0
 }
 
-func (x *GetCollateralRequest) GetExchange() string {
+func (x *GetTechnicalAnalysisRequest) GetMultiplier() float64 {
 	if x != nil {
-		return x.Exchange
+		return x.Multiplier
 	}
-	return ""
+	return 0
 }
 
-func (x *GetCollateralRequest) GetAsset() string {
+func (x *GetTechnicalAnalysisRequest) GetAccelerationStep() float64 {
 	if x != nil {
-		return x.Asset
+		return x.AccelerationStep
 	}
-	return ""
+	return 0
 }
 
-func (x *GetCollateralRequest) GetIncludeBreakdown() bool {
+func (x *GetTechnicalAnalysisRequest) GetAccelerationMax() float64 {
 	if x != nil {
-		return x.IncludeBreakdown
+		return x.AccelerationMax
 	}
-	return false
+	return 0
 }
 
-func (x *GetCollateralRequest) GetCalculateOffline() bool {
+func (x *GetTechnicalAnalysisRequest) GetDisplacement() int64 {
 	if x != nil {
-		return x.CalculateOffline
+		return x.Displacement
 	}
-	return false
+	return 0
 }
 
-func (x *GetCollateralRequest) GetIncludeZeroValues() bool {
+func (x *GetTechnicalAnalysisRequest) GetSessionInterval() int64 {
 	if x != nil {
-		return x.IncludeZeroValues
+		return x.SessionInterval
 	}
-	return false
+	return 0
 }
 
-type GetCollateralResponse struct {
-	state                                       protoimpl.MessageState   `protogen:"open.v1"`
-	SubAccount                                  string                   `protobuf:"bytes,1,opt,name=sub_account,json=subAccount,proto3" json:"sub_account,omitempty"`
-	CollateralCurrency                          string                   `protobuf:"bytes,2,opt,name=collateral_currency,json=collateralCurrency,proto3" json:"collateral_currency,omitempty"`
-	TotalValueOfPositiveSpotBalances            string                   `protobuf:"bytes,3,opt,name=total_value_of_positive_spot_balances,json=totalValueOfPositiveSpotBalances,proto3" json:"total_value_of_positive_spot_balances,omitempty"`
-	CollateralContributedByPositiveSpotBalances string                   `protobuf:"bytes,4,opt,name=collateral_contributed_by_positive_spot_balances,json=collateralContributedByPositiveSpotBalances,proto3" json:"collateral_contributed_by_positive_spot_balances,omitempty"`
-	UsedCollateral                              string                   `protobuf:"bytes,5,opt,name=used_collateral,json=usedCollateral,proto3" json:"used_collateral,omitempty"`
-	UsedBreakdown                               *CollateralUsedBreakdown `protobuf:"bytes,6,opt,name=used_breakdown,json=usedBreakdown,proto3" json:"used_breakdown,omitempty"`
-	AvailableCollateral                         string                   `protobuf:"bytes,7,opt,name=available_collateral,json=availableCollateral,proto3" json:"available_collateral,omitempty"`
-	MaintenanceCollateral                       string                   `protobuf:"bytes,8,opt,name=maintenance_collateral,json=maintenanceCollateral,proto3" json:"maintenance_collateral,omitempty"`
-	UnrealisedPnl                               string                   `protobuf:"bytes,9,opt,name=unrealised_pnl,json=unrealisedPnl,proto3" json:"unrealised_pnl,omitempty"`
-	CurrencyBreakdown                           []*CollateralForCurrency `protobuf:"bytes,10,rep,name=currency_breakdown,json=currencyBreakdown,proto3" json:"currency_breakdown,omitempty"`
-	PositionBreakdown                           []*CollateralByPosition  `protobuf:"bytes,11,rep,name=position_breakdown,json=positionBreakdown,proto3" json:"position_breakdown,omitempty"`
-	unknownFields                               protoimpl.UnknownFields
-	sizeCache                                   protoimpl.SizeCache
+type ListOfSignals struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Signals       []float64              `protobuf:"fixed64,1,rep,packed,name=signals,proto3" json:"signals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCollateralResponse) Reset() {
-	*x = GetCollateralResponse{}
-	mi := &file_rpc_proto_msgTypes[196]
+func (x *ListOfSignals) Reset() {
+	*x = ListOfSignals{}
+	mi := &file_rpc_proto_msgTypes[220]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCollateralResponse) String() string {
+func (x *ListOfSignals) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCollateralResponse) ProtoMessage() {}
+func (*ListOfSignals) ProtoMessage() {}
 
-func (x *GetCollateralResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[196]
+func (x *ListOfSignals) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[220]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12665,122 +14671,96 @@ func (x *GetCollateralResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCollateralResponse.ProtoReflect.Descriptor instead.
-func (*GetCollateralResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{196}
-}
-
-func (x *GetCollateralResponse) GetSubAccount() string {
-	if x != nil {
-		return x.SubAccount
-	}
-	return ""
-}
-
-func (x *GetCollateralResponse) GetCollateralCurrency() string {
-	if x != nil {
-		return x.CollateralCurrency
-	}
-	return ""
-}
-
-func (x *GetCollateralResponse) GetTotalValueOfPositiveSpotBalances() string {
-	if x != nil {
-		return x.TotalValueOfPositiveSpotBalances
-	}
-	return ""
+// Deprecated: Use ListOfSignals.ProtoReflect.Descriptor instead.
+func (*ListOfSignals) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{220}
 }
 
-func (x *GetCollateralResponse) GetCollateralContributedByPositiveSpotBalances() string {
+func (x *ListOfSignals) GetSignals() []float64 {
 	if x != nil {
-		return x.CollateralContributedByPositiveSpotBalances
+		return x.Signals
 	}
-	return ""
+	return nil
 }
 
-func (x *GetCollateralResponse) GetUsedCollateral() string {
-	if x != nil {
-		return x.UsedCollateral
-	}
-	return ""
+type GetTechnicalAnalysisResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Signals       map[string]*ListOfSignals `protobuf:"bytes,1,rep,name=signals,proto3" json:"signals,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCollateralResponse) GetUsedBreakdown() *CollateralUsedBreakdown {
-	if x != nil {
-		return x.UsedBreakdown
-	}
-	return nil
+func (x *GetTechnicalAnalysisResponse) Reset() {
+	*x = GetTechnicalAnalysisResponse{}
+	mi := &file_rpc_proto_msgTypes[221]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCollateralResponse) GetAvailableCollateral() string {
-	if x != nil {
-		return x.AvailableCollateral
-	}
-	return ""
+func (x *GetTechnicalAnalysisResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GetCollateralResponse) GetMaintenanceCollateral() string {
-	if x != nil {
-		return x.MaintenanceCollateral
-	}
-	return ""
-}
+func (*GetTechnicalAnalysisResponse) ProtoMessage() {}
 
-func (x *GetCollateralResponse) GetUnrealisedPnl() string {
+func (x *GetTechnicalAnalysisResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[221]
 	if x != nil {
-		return x.UnrealisedPnl
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *GetCollateralResponse) GetCurrencyBreakdown() []*CollateralForCurrency {
-	if x != nil {
-		return x.CurrencyBreakdown
-	}
-	return nil
+// Deprecated: Use GetTechnicalAnalysisResponse.ProtoReflect.Descriptor instead.
+func (*GetTechnicalAnalysisResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{221}
 }
 
-func (x *GetCollateralResponse) GetPositionBreakdown() []*CollateralByPosition {
+func (x *GetTechnicalAnalysisResponse) GetSignals() map[string]*ListOfSignals {
 	if x != nil {
-		return x.PositionBreakdown
+		return x.Signals
 	}
 	return nil
 }
 
-type CollateralForCurrency struct {
-	state                       protoimpl.MessageState   `protogen:"open.v1"`
-	Currency                    string                   `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
-	ExcludedFromCollateral      bool                     `protobuf:"varint,2,opt,name=excluded_from_collateral,json=excludedFromCollateral,proto3" json:"excluded_from_collateral,omitempty"`
-	TotalFunds                  string                   `protobuf:"bytes,3,opt,name=total_funds,json=totalFunds,proto3" json:"total_funds,omitempty"`
-	AvailableForUseAsCollateral string                   `protobuf:"bytes,4,opt,name=available_for_use_as_collateral,json=availableForUseAsCollateral,proto3" json:"available_for_use_as_collateral,omitempty"`
-	ApproxFairMarketValue       string                   `protobuf:"bytes,5,opt,name=approx_fair_market_value,json=approxFairMarketValue,proto3" json:"approx_fair_market_value,omitempty"`
-	Weighting                   string                   `protobuf:"bytes,6,opt,name=weighting,proto3" json:"weighting,omitempty"`
-	CollateralContribution      string                   `protobuf:"bytes,7,opt,name=collateral_contribution,json=collateralContribution,proto3" json:"collateral_contribution,omitempty"`
-	ScaledToCurrency            string                   `protobuf:"bytes,8,opt,name=scaled_to_currency,json=scaledToCurrency,proto3" json:"scaled_to_currency,omitempty"`
-	UnrealisedPnl               string                   `protobuf:"bytes,9,opt,name=unrealised_pnl,json=unrealisedPnl,proto3" json:"unrealised_pnl,omitempty"`
-	FundsInUse                  string                   `protobuf:"bytes,10,opt,name=funds_in_use,json=fundsInUse,proto3" json:"funds_in_use,omitempty"`
-	AdditionalCollateralUsed    string                   `protobuf:"bytes,11,opt,name=additional_collateral_used,json=additionalCollateralUsed,proto3" json:"additional_collateral_used,omitempty"`
-	UsedBreakdown               *CollateralUsedBreakdown `protobuf:"bytes,12,opt,name=used_breakdown,json=usedBreakdown,proto3" json:"used_breakdown,omitempty"`
-	Error                       string                   `protobuf:"bytes,13,opt,name=error,proto3" json:"error,omitempty"`
-	unknownFields               protoimpl.UnknownFields
-	sizeCache                   protoimpl.SizeCache
+type GetMarginRatesHistoryRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Exchange           string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset              string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Currency           string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	StartDate          string                 `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate            string                 `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	GetPredictedRate   bool                   `protobuf:"varint,6,opt,name=get_predicted_rate,json=getPredictedRate,proto3" json:"get_predicted_rate,omitempty"`
+	GetLendingPayments bool                   `protobuf:"varint,7,opt,name=get_lending_payments,json=getLendingPayments,proto3" json:"get_lending_payments,omitempty"`
+	GetBorrowRates     bool                   `protobuf:"varint,8,opt,name=get_borrow_rates,json=getBorrowRates,proto3" json:"get_borrow_rates,omitempty"`
+	GetBorrowCosts     bool                   `protobuf:"varint,9,opt,name=get_borrow_costs,json=getBorrowCosts,proto3" json:"get_borrow_costs,omitempty"`
+	IncludeAllRates    bool                   `protobuf:"varint,10,opt,name=include_all_rates,json=includeAllRates,proto3" json:"include_all_rates,omitempty"`
+	CalculateOffline   bool                   `protobuf:"varint,11,opt,name=calculate_offline,json=calculateOffline,proto3" json:"calculate_offline,omitempty"`
+	TakerFeeRate       string                 `protobuf:"bytes,12,opt,name=taker_fee_rate,json=takerFeeRate,proto3" json:"taker_fee_rate,omitempty"`
+	Rates              []*MarginRate          `protobuf:"bytes,13,rep,name=rates,proto3" json:"rates,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *CollateralForCurrency) Reset() {
-	*x = CollateralForCurrency{}
-	mi := &file_rpc_proto_msgTypes[197]
+func (x *GetMarginRatesHistoryRequest) Reset() {
+	*x = GetMarginRatesHistoryRequest{}
+	mi := &file_rpc_proto_msgTypes[222]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CollateralForCurrency) String() string {
+func (x *GetMarginRatesHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CollateralForCurrency) ProtoMessage() {}
+func (*GetMarginRatesHistoryRequest) ProtoMessage() {}
 
-func (x *CollateralForCurrency) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[197]
+func (x *GetMarginRatesHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[222]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12791,130 +14771,125 @@ func (x *CollateralForCurrency) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CollateralForCurrency.ProtoReflect.Descriptor instead.
-func (*CollateralForCurrency) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{197}
+// Deprecated: Use GetMarginRatesHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetMarginRatesHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{222}
 }
 
-func (x *CollateralForCurrency) GetCurrency() string {
+func (x *GetMarginRatesHistoryRequest) GetExchange() string {
 	if x != nil {
-		return x.Currency
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *CollateralForCurrency) GetExcludedFromCollateral() bool {
+func (x *GetMarginRatesHistoryRequest) GetAsset() string {
 	if x != nil {
-		return x.ExcludedFromCollateral
+		return x.Asset
 	}
-	return false
+	return ""
 }
 
-func (x *CollateralForCurrency) GetTotalFunds() string {
+func (x *GetMarginRatesHistoryRequest) GetCurrency() string {
 	if x != nil {
-		return x.TotalFunds
+		return x.Currency
 	}
 	return ""
 }
 
-func (x *CollateralForCurrency) GetAvailableForUseAsCollateral() string {
+func (x *GetMarginRatesHistoryRequest) GetStartDate() string {
 	if x != nil {
-		return x.AvailableForUseAsCollateral
+		return x.StartDate
 	}
 	return ""
 }
 
-func (x *CollateralForCurrency) GetApproxFairMarketValue() string {
+func (x *GetMarginRatesHistoryRequest) GetEndDate() string {
 	if x != nil {
-		return x.ApproxFairMarketValue
+		return x.EndDate
 	}
 	return ""
 }
 
-func (x *CollateralForCurrency) GetWeighting() string {
+func (x *GetMarginRatesHistoryRequest) GetGetPredictedRate() bool {
 	if x != nil {
-		return x.Weighting
+		return x.GetPredictedRate
 	}
-	return ""
+	return false
 }
 
-func (x *CollateralForCurrency) GetCollateralContribution() string {
+func (x *GetMarginRatesHistoryRequest) GetGetLendingPayments() bool {
 	if x != nil {
-		return x.CollateralContribution
+		return x.GetLendingPayments
 	}
-	return ""
+	return false
 }
 
-func (x *CollateralForCurrency) GetScaledToCurrency() string {
+func (x *GetMarginRatesHistoryRequest) GetGetBorrowRates() bool {
 	if x != nil {
-		return x.ScaledToCurrency
+		return x.GetBorrowRates
 	}
-	return ""
+	return false
 }
 
-func (x *CollateralForCurrency) GetUnrealisedPnl() string {
+func (x *GetMarginRatesHistoryRequest) GetGetBorrowCosts() bool {
 	if x != nil {
-		return x.UnrealisedPnl
+		return x.GetBorrowCosts
 	}
-	return ""
+	return false
 }
 
-func (x *CollateralForCurrency) GetFundsInUse() string {
+func (x *GetMarginRatesHistoryRequest) GetIncludeAllRates() bool {
 	if x != nil {
-		return x.FundsInUse
+		return x.IncludeAllRates
 	}
-	return ""
+	return false
 }
 
-func (x *CollateralForCurrency) GetAdditionalCollateralUsed() string {
+func (x *GetMarginRatesHistoryRequest) GetCalculateOffline() bool {
 	if x != nil {
-		return x.AdditionalCollateralUsed
+		return x.CalculateOffline
 	}
-	return ""
+	return false
 }
 
-func (x *CollateralForCurrency) GetUsedBreakdown() *CollateralUsedBreakdown {
+func (x *GetMarginRatesHistoryRequest) GetTakerFeeRate() string {
 	if x != nil {
-		return x.UsedBreakdown
+		return x.TakerFeeRate
 	}
-	return nil
+	return ""
 }
 
-func (x *CollateralForCurrency) GetError() string {
+func (x *GetMarginRatesHistoryRequest) GetRates() []*MarginRate {
 	if x != nil {
-		return x.Error
+		return x.Rates
 	}
-	return ""
+	return nil
 }
 
-type CollateralByPosition struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	Currency            string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
-	Size                string                 `protobuf:"bytes,2,opt,name=size,proto3" json:"size,omitempty"`
-	OpenOrderSize       string                 `protobuf:"bytes,3,opt,name=open_order_size,json=openOrderSize,proto3" json:"open_order_size,omitempty"`
-	PositionSize        string                 `protobuf:"bytes,4,opt,name=position_size,json=positionSize,proto3" json:"position_size,omitempty"`
-	MarkPrice           string                 `protobuf:"bytes,5,opt,name=mark_price,json=markPrice,proto3" json:"mark_price,omitempty"`
-	RequiredMargin      string                 `protobuf:"bytes,6,opt,name=required_margin,json=requiredMargin,proto3" json:"required_margin,omitempty"`
-	TotalCollateralUsed string                 `protobuf:"bytes,7,opt,name=total_collateral_used,json=totalCollateralUsed,proto3" json:"total_collateral_used,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+type LendingPayment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Payment       string                 `protobuf:"bytes,1,opt,name=payment,proto3" json:"payment,omitempty"`
+	Size          string                 `protobuf:"bytes,2,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CollateralByPosition) Reset() {
-	*x = CollateralByPosition{}
-	mi := &file_rpc_proto_msgTypes[198]
+func (x *LendingPayment) Reset() {
+	*x = LendingPayment{}
+	mi := &file_rpc_proto_msgTypes[223]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CollateralByPosition) String() string {
+func (x *LendingPayment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CollateralByPosition) ProtoMessage() {}
+func (*LendingPayment) ProtoMessage() {}
 
-func (x *CollateralByPosition) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[198]
+func (x *LendingPayment) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[223]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12925,89 +14900,106 @@ func (x *CollateralByPosition) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CollateralByPosition.ProtoReflect.Descriptor instead.
-func (*CollateralByPosition) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{198}
+// Deprecated: Use LendingPayment.ProtoReflect.Descriptor instead.
+func (*LendingPayment) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{223}
 }
 
-func (x *CollateralByPosition) GetCurrency() string {
+func (x *LendingPayment) GetPayment() string {
 	if x != nil {
-		return x.Currency
+		return x.Payment
 	}
 	return ""
 }
 
-func (x *CollateralByPosition) GetSize() string {
+func (x *LendingPayment) GetSize() string {
 	if x != nil {
 		return x.Size
 	}
 	return ""
 }
 
-func (x *CollateralByPosition) GetOpenOrderSize() string {
-	if x != nil {
-		return x.OpenOrderSize
-	}
-	return ""
+type BorrowCost struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cost          string                 `protobuf:"bytes,1,opt,name=cost,proto3" json:"cost,omitempty"`
+	Size          string                 `protobuf:"bytes,2,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CollateralByPosition) GetPositionSize() string {
-	if x != nil {
-		return x.PositionSize
-	}
-	return ""
+func (x *BorrowCost) Reset() {
+	*x = BorrowCost{}
+	mi := &file_rpc_proto_msgTypes[224]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *CollateralByPosition) GetMarkPrice() string {
+func (x *BorrowCost) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BorrowCost) ProtoMessage() {}
+
+func (x *BorrowCost) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[224]
 	if x != nil {
-		return x.MarkPrice
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *CollateralByPosition) GetRequiredMargin() string {
+// Deprecated: Use BorrowCost.ProtoReflect.Descriptor instead.
+func (*BorrowCost) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{224}
+}
+
+func (x *BorrowCost) GetCost() string {
 	if x != nil {
-		return x.RequiredMargin
+		return x.Cost
 	}
 	return ""
 }
 
-func (x *CollateralByPosition) GetTotalCollateralUsed() string {
+func (x *BorrowCost) GetSize() string {
 	if x != nil {
-		return x.TotalCollateralUsed
+		return x.Size
 	}
 	return ""
 }
 
-type CollateralUsedBreakdown struct {
-	state                           protoimpl.MessageState `protogen:"open.v1"`
-	LockedInStakes                  string                 `protobuf:"bytes,1,opt,name=locked_in_stakes,json=lockedInStakes,proto3" json:"locked_in_stakes,omitempty"`
-	LockedInNftBids                 string                 `protobuf:"bytes,2,opt,name=locked_in_nft_bids,json=lockedInNftBids,proto3" json:"locked_in_nft_bids,omitempty"`
-	LockedInFeeVoucher              string                 `protobuf:"bytes,3,opt,name=locked_in_fee_voucher,json=lockedInFeeVoucher,proto3" json:"locked_in_fee_voucher,omitempty"`
-	LockedInSpotMarginFundingOffers string                 `protobuf:"bytes,4,opt,name=locked_in_spot_margin_funding_offers,json=lockedInSpotMarginFundingOffers,proto3" json:"locked_in_spot_margin_funding_offers,omitempty"`
-	LockedInSpotOrders              string                 `protobuf:"bytes,5,opt,name=locked_in_spot_orders,json=lockedInSpotOrders,proto3" json:"locked_in_spot_orders,omitempty"`
-	LockedAsCollateral              string                 `protobuf:"bytes,6,opt,name=locked_as_collateral,json=lockedAsCollateral,proto3" json:"locked_as_collateral,omitempty"`
-	UsedInFutures                   string                 `protobuf:"bytes,7,opt,name=used_in_futures,json=usedInFutures,proto3" json:"used_in_futures,omitempty"`
-	UsedInSpotMargin                string                 `protobuf:"bytes,8,opt,name=used_in_spot_margin,json=usedInSpotMargin,proto3" json:"used_in_spot_margin,omitempty"`
-	unknownFields                   protoimpl.UnknownFields
-	sizeCache                       protoimpl.SizeCache
+type MarginRate struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Time             string                 `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	MarketBorrowSize string                 `protobuf:"bytes,2,opt,name=market_borrow_size,json=marketBorrowSize,proto3" json:"market_borrow_size,omitempty"`
+	HourlyRate       string                 `protobuf:"bytes,3,opt,name=hourly_rate,json=hourlyRate,proto3" json:"hourly_rate,omitempty"`
+	YearlyRate       string                 `protobuf:"bytes,4,opt,name=yearly_rate,json=yearlyRate,proto3" json:"yearly_rate,omitempty"`
+	HourlyBorrowRate string                 `protobuf:"bytes,5,opt,name=hourly_borrow_rate,json=hourlyBorrowRate,proto3" json:"hourly_borrow_rate,omitempty"`
+	YearlyBorrowRate string                 `protobuf:"bytes,6,opt,name=yearly_borrow_rate,json=yearlyBorrowRate,proto3" json:"yearly_borrow_rate,omitempty"`
+	LendingPayment   *LendingPayment        `protobuf:"bytes,7,opt,name=lending_payment,json=lendingPayment,proto3" json:"lending_payment,omitempty"`
+	BorrowCost       *BorrowCost            `protobuf:"bytes,8,opt,name=borrow_cost,json=borrowCost,proto3" json:"borrow_cost,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *CollateralUsedBreakdown) Reset() {
-	*x = CollateralUsedBreakdown{}
-	mi := &file_rpc_proto_msgTypes[199]
+func (x *MarginRate) Reset() {
+	*x = MarginRate{}
+	mi := &file_rpc_proto_msgTypes[225]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CollateralUsedBreakdown) String() string {
+func (x *MarginRate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CollateralUsedBreakdown) ProtoMessage() {}
+func (*MarginRate) ProtoMessage() {}
 
-func (x *CollateralUsedBreakdown) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[199]
+func (x *MarginRate) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[225]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13018,97 +15010,97 @@ func (x *CollateralUsedBreakdown) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CollateralUsedBreakdown.ProtoReflect.Descriptor instead.
-func (*CollateralUsedBreakdown) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{199}
+// Deprecated: Use MarginRate.ProtoReflect.Descriptor instead.
+func (*MarginRate) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{225}
 }
 
-func (x *CollateralUsedBreakdown) GetLockedInStakes() string {
+func (x *MarginRate) GetTime() string {
 	if x != nil {
-		return x.LockedInStakes
+		return x.Time
 	}
 	return ""
 }
 
-func (x *CollateralUsedBreakdown) GetLockedInNftBids() string {
+func (x *MarginRate) GetMarketBorrowSize() string {
 	if x != nil {
-		return x.LockedInNftBids
+		return x.MarketBorrowSize
 	}
 	return ""
 }
 
-func (x *CollateralUsedBreakdown) GetLockedInFeeVoucher() string {
+func (x *MarginRate) GetHourlyRate() string {
 	if x != nil {
-		return x.LockedInFeeVoucher
+		return x.HourlyRate
 	}
 	return ""
 }
 
-func (x *CollateralUsedBreakdown) GetLockedInSpotMarginFundingOffers() string {
+func (x *MarginRate) GetYearlyRate() string {
 	if x != nil {
-		return x.LockedInSpotMarginFundingOffers
+		return x.YearlyRate
 	}
 	return ""
 }
 
-func (x *CollateralUsedBreakdown) GetLockedInSpotOrders() string {
+func (x *MarginRate) GetHourlyBorrowRate() string {
 	if x != nil {
-		return x.LockedInSpotOrders
+		return x.HourlyBorrowRate
 	}
 	return ""
 }
 
-func (x *CollateralUsedBreakdown) GetLockedAsCollateral() string {
+func (x *MarginRate) GetYearlyBorrowRate() string {
 	if x != nil {
-		return x.LockedAsCollateral
+		return x.YearlyBorrowRate
 	}
 	return ""
 }
 
-func (x *CollateralUsedBreakdown) GetUsedInFutures() string {
+func (x *MarginRate) GetLendingPayment() *LendingPayment {
 	if x != nil {
-		return x.UsedInFutures
+		return x.LendingPayment
 	}
-	return ""
+	return nil
 }
 
-func (x *CollateralUsedBreakdown) GetUsedInSpotMargin() string {
+func (x *MarginRate) GetBorrowCost() *BorrowCost {
 	if x != nil {
-		return x.UsedInSpotMargin
+		return x.BorrowCost
 	}
-	return ""
+	return nil
 }
 
-type GetFundingRatesRequest struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	Exchange             string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset                string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair                 *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	StartDate            string                 `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate              string                 `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
-	PaymentCurrency      string                 `protobuf:"bytes,6,opt,name=payment_currency,json=paymentCurrency,proto3" json:"payment_currency,omitempty"`
-	IncludePredicted     bool                   `protobuf:"varint,7,opt,name=include_predicted,json=includePredicted,proto3" json:"include_predicted,omitempty"`
-	IncludePayments      bool                   `protobuf:"varint,8,opt,name=include_payments,json=includePayments,proto3" json:"include_payments,omitempty"`
-	RespectHistoryLimits bool                   `protobuf:"varint,9,opt,name=respect_history_limits,json=respectHistoryLimits,proto3" json:"respect_history_limits,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+type GetMarginRatesHistoryResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Rates              []*MarginRate          `protobuf:"bytes,1,rep,name=rates,proto3" json:"rates,omitempty"`
+	TotalRates         int64                  `protobuf:"varint,2,opt,name=total_rates,json=totalRates,proto3" json:"total_rates,omitempty"`
+	SumBorrowCosts     string                 `protobuf:"bytes,3,opt,name=sum_borrow_costs,json=sumBorrowCosts,proto3" json:"sum_borrow_costs,omitempty"`
+	AvgBorrowSize      string                 `protobuf:"bytes,4,opt,name=avg_borrow_size,json=avgBorrowSize,proto3" json:"avg_borrow_size,omitempty"`
+	SumLendingPayments string                 `protobuf:"bytes,5,opt,name=sum_lending_payments,json=sumLendingPayments,proto3" json:"sum_lending_payments,omitempty"`
+	AvgLendingSize     string                 `protobuf:"bytes,6,opt,name=avg_lending_size,json=avgLendingSize,proto3" json:"avg_lending_size,omitempty"`
+	LatestRate         *MarginRate            `protobuf:"bytes,7,opt,name=latest_rate,json=latestRate,proto3" json:"latest_rate,omitempty"`
+	PredictedRate      *MarginRate            `protobuf:"bytes,8,opt,name=predicted_rate,json=predictedRate,proto3" json:"predicted_rate,omitempty"`
+	TakerFeeRate       string                 `protobuf:"bytes,9,opt,name=taker_fee_rate,json=takerFeeRate,proto3" json:"taker_fee_rate,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *GetFundingRatesRequest) Reset() {
-	*x = GetFundingRatesRequest{}
-	mi := &file_rpc_proto_msgTypes[200]
+func (x *GetMarginRatesHistoryResponse) Reset() {
+	*x = GetMarginRatesHistoryResponse{}
+	mi := &file_rpc_proto_msgTypes[226]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFundingRatesRequest) String() string {
+func (x *GetMarginRatesHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFundingRatesRequest) ProtoMessage() {}
+func (*GetMarginRatesHistoryResponse) ProtoMessage() {}
 
-func (x *GetFundingRatesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[200]
+func (x *GetMarginRatesHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[226]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13119,96 +15111,102 @@ func (x *GetFundingRatesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFundingRatesRequest.ProtoReflect.Descriptor instead.
-func (*GetFundingRatesRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{200}
+// Deprecated: Use GetMarginRatesHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetMarginRatesHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{226}
 }
 
-func (x *GetFundingRatesRequest) GetExchange() string {
+func (x *GetMarginRatesHistoryResponse) GetRates() []*MarginRate {
 	if x != nil {
-		return x.Exchange
+		return x.Rates
 	}
-	return ""
+	return nil
 }
 
-func (x *GetFundingRatesRequest) GetAsset() string {
+func (x *GetMarginRatesHistoryResponse) GetTotalRates() int64 {
 	if x != nil {
-		return x.Asset
+		return x.TotalRates
 	}
-	return ""
+	return 0
 }
 
-func (x *GetFundingRatesRequest) GetPair() *CurrencyPair {
+func (x *GetMarginRatesHistoryResponse) GetSumBorrowCosts() string {
 	if x != nil {
-		return x.Pair
+		return x.SumBorrowCosts
 	}
-	return nil
+	return ""
 }
 
-func (x *GetFundingRatesRequest) GetStartDate() string {
+func (x *GetMarginRatesHistoryResponse) GetAvgBorrowSize() string {
 	if x != nil {
-		return x.StartDate
+		return x.AvgBorrowSize
 	}
 	return ""
 }
 
-func (x *GetFundingRatesRequest) GetEndDate() string {
+func (x *GetMarginRatesHistoryResponse) GetSumLendingPayments() string {
 	if x != nil {
-		return x.EndDate
+		return x.SumLendingPayments
 	}
 	return ""
 }
 
-func (x *GetFundingRatesRequest) GetPaymentCurrency() string {
+func (x *GetMarginRatesHistoryResponse) GetAvgLendingSize() string {
 	if x != nil {
-		return x.PaymentCurrency
+		return x.AvgLendingSize
 	}
 	return ""
 }
 
-func (x *GetFundingRatesRequest) GetIncludePredicted() bool {
+func (x *GetMarginRatesHistoryResponse) GetLatestRate() *MarginRate {
 	if x != nil {
-		return x.IncludePredicted
+		return x.LatestRate
 	}
-	return false
+	return nil
 }
 
-func (x *GetFundingRatesRequest) GetIncludePayments() bool {
+func (x *GetMarginRatesHistoryResponse) GetPredictedRate() *MarginRate {
 	if x != nil {
-		return x.IncludePayments
+		return x.PredictedRate
 	}
-	return false
+	return nil
 }
 
-func (x *GetFundingRatesRequest) GetRespectHistoryLimits() bool {
+func (x *GetMarginRatesHistoryResponse) GetTakerFeeRate() string {
 	if x != nil {
-		return x.RespectHistoryLimits
+		return x.TakerFeeRate
 	}
-	return false
+	return ""
 }
 
-type GetFundingRatesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Rates         *FundingData           `protobuf:"bytes,1,opt,name=rates,proto3" json:"rates,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetOrderbookMovementRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Exchange              string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset                 string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair                  *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	Amount                float64                `protobuf:"fixed64,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	Sell                  bool                   `protobuf:"varint,7,opt,name=sell,proto3" json:"sell,omitempty"`
+	RequiresRestOrderbook bool                   `protobuf:"varint,8,opt,name=requires_rest_orderbook,json=requiresRestOrderbook,proto3" json:"requires_rest_orderbook,omitempty"`
+	Purchase              bool                   `protobuf:"varint,9,opt,name=purchase,proto3" json:"purchase,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
-func (x *GetFundingRatesResponse) Reset() {
-	*x = GetFundingRatesResponse{}
-	mi := &file_rpc_proto_msgTypes[201]
+func (x *GetOrderbookMovementRequest) Reset() {
+	*x = GetOrderbookMovementRequest{}
+	mi := &file_rpc_proto_msgTypes[227]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFundingRatesResponse) String() string {
+func (x *GetOrderbookMovementRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFundingRatesResponse) ProtoMessage() {}
+func (*GetOrderbookMovementRequest) ProtoMessage() {}
 
-func (x *GetFundingRatesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[201]
+func (x *GetOrderbookMovementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[227]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13219,108 +15217,95 @@ func (x *GetFundingRatesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFundingRatesResponse.ProtoReflect.Descriptor instead.
-func (*GetFundingRatesResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{201}
+// Deprecated: Use GetOrderbookMovementRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderbookMovementRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{227}
 }
 
-func (x *GetFundingRatesResponse) GetRates() *FundingData {
+func (x *GetOrderbookMovementRequest) GetExchange() string {
 	if x != nil {
-		return x.Rates
+		return x.Exchange
 	}
-	return nil
-}
-
-type GetLatestFundingRateRequest struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Exchange         string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset            string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair             *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	IncludePredicted bool                   `protobuf:"varint,4,opt,name=include_predicted,json=includePredicted,proto3" json:"include_predicted,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
-}
-
-func (x *GetLatestFundingRateRequest) Reset() {
-	*x = GetLatestFundingRateRequest{}
-	mi := &file_rpc_proto_msgTypes[202]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetLatestFundingRateRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return ""
 }
 
-func (*GetLatestFundingRateRequest) ProtoMessage() {}
-
-func (x *GetLatestFundingRateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[202]
+func (x *GetOrderbookMovementRequest) GetAsset() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Asset
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetLatestFundingRateRequest.ProtoReflect.Descriptor instead.
-func (*GetLatestFundingRateRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{202}
+func (x *GetOrderbookMovementRequest) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
 }
 
-func (x *GetLatestFundingRateRequest) GetExchange() string {
+func (x *GetOrderbookMovementRequest) GetAmount() float64 {
 	if x != nil {
-		return x.Exchange
+		return x.Amount
 	}
-	return ""
+	return 0
 }
 
-func (x *GetLatestFundingRateRequest) GetAsset() string {
+func (x *GetOrderbookMovementRequest) GetSell() bool {
 	if x != nil {
-		return x.Asset
+		return x.Sell
 	}
-	return ""
+	return false
 }
 
-func (x *GetLatestFundingRateRequest) GetPair() *CurrencyPair {
+func (x *GetOrderbookMovementRequest) GetRequiresRestOrderbook() bool {
 	if x != nil {
-		return x.Pair
+		return x.RequiresRestOrderbook
 	}
-	return nil
+	return false
 }
 
-func (x *GetLatestFundingRateRequest) GetIncludePredicted() bool {
+func (x *GetOrderbookMovementRequest) GetPurchase() bool {
 	if x != nil {
-		return x.IncludePredicted
+		return x.Purchase
 	}
 	return false
 }
 
-type GetLatestFundingRateResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Rate          *FundingData           `protobuf:"bytes,1,opt,name=rate,proto3" json:"rate,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetOrderbookMovementResponse struct {
+	state                     protoimpl.MessageState `protogen:"open.v1"`
+	NominalPercentage         float64                `protobuf:"fixed64,1,opt,name=nominal_percentage,json=nominalPercentage,proto3" json:"nominal_percentage,omitempty"`
+	ImpactPercentage          float64                `protobuf:"fixed64,2,opt,name=impact_percentage,json=impactPercentage,proto3" json:"impact_percentage,omitempty"`
+	SlippageCost              float64                `protobuf:"fixed64,3,opt,name=slippage_cost,json=slippageCost,proto3" json:"slippage_cost,omitempty"`
+	CurrencyBought            string                 `protobuf:"bytes,4,opt,name=currency_bought,json=currencyBought,proto3" json:"currency_bought,omitempty"`
+	Bought                    float64                `protobuf:"fixed64,5,opt,name=bought,proto3" json:"bought,omitempty"`
+	CurrencySold              string                 `protobuf:"bytes,6,opt,name=currency_sold,json=currencySold,proto3" json:"currency_sold,omitempty"`
+	Sold                      float64                `protobuf:"fixed64,7,opt,name=sold,proto3" json:"sold,omitempty"`
+	SideAffected              string                 `protobuf:"bytes,8,opt,name=side_affected,json=sideAffected,proto3" json:"side_affected,omitempty"`
+	UpdateProtocol            string                 `protobuf:"bytes,9,opt,name=update_protocol,json=updateProtocol,proto3" json:"update_protocol,omitempty"`
+	FullOrderbookSideConsumed bool                   `protobuf:"varint,10,opt,name=full_orderbook_side_consumed,json=fullOrderbookSideConsumed,proto3" json:"full_orderbook_side_consumed,omitempty"`
+	StartPrice                float64                `protobuf:"fixed64,11,opt,name=start_price,json=startPrice,proto3" json:"start_price,omitempty"`
+	EndPrice                  float64                `protobuf:"fixed64,12,opt,name=end_price,json=endPrice,proto3" json:"end_price,omitempty"`
+	NoSlippageOccurred        bool                   `protobuf:"varint,13,opt,name=no_slippage_occurred,json=noSlippageOccurred,proto3" json:"no_slippage_occurred,omitempty"`
+	AverageOrderCost          float64                `protobuf:"fixed64,14,opt,name=average_order_cost,json=averageOrderCost,proto3" json:"average_order_cost,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
 }
 
-func (x *GetLatestFundingRateResponse) Reset() {
-	*x = GetLatestFundingRateResponse{}
-	mi := &file_rpc_proto_msgTypes[203]
+func (x *GetOrderbookMovementResponse) Reset() {
+	*x = GetOrderbookMovementResponse{}
+	mi := &file_rpc_proto_msgTypes[228]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLatestFundingRateResponse) String() string {
+func (x *GetOrderbookMovementResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLatestFundingRateResponse) ProtoMessage() {}
+func (*GetOrderbookMovementResponse) ProtoMessage() {}
 
-func (x *GetLatestFundingRateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[203]
+func (x *GetOrderbookMovementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[228]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13331,127 +15316,136 @@ func (x *GetLatestFundingRateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLatestFundingRateResponse.ProtoReflect.Descriptor instead.
-func (*GetLatestFundingRateResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{203}
+// Deprecated: Use GetOrderbookMovementResponse.ProtoReflect.Descriptor instead.
+func (*GetOrderbookMovementResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{228}
+}
+
+func (x *GetOrderbookMovementResponse) GetNominalPercentage() float64 {
+	if x != nil {
+		return x.NominalPercentage
+	}
+	return 0
+}
+
+func (x *GetOrderbookMovementResponse) GetImpactPercentage() float64 {
+	if x != nil {
+		return x.ImpactPercentage
+	}
+	return 0
+}
+
+func (x *GetOrderbookMovementResponse) GetSlippageCost() float64 {
+	if x != nil {
+		return x.SlippageCost
+	}
+	return 0
 }
 
-func (x *GetLatestFundingRateResponse) GetRate() *FundingData {
+func (x *GetOrderbookMovementResponse) GetCurrencyBought() string {
 	if x != nil {
-		return x.Rate
+		return x.CurrencyBought
 	}
-	return nil
+	return ""
 }
 
-type ShutdownRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GetOrderbookMovementResponse) GetBought() float64 {
+	if x != nil {
+		return x.Bought
+	}
+	return 0
 }
 
-func (x *ShutdownRequest) Reset() {
-	*x = ShutdownRequest{}
-	mi := &file_rpc_proto_msgTypes[204]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *GetOrderbookMovementResponse) GetCurrencySold() string {
+	if x != nil {
+		return x.CurrencySold
+	}
+	return ""
 }
 
-func (x *ShutdownRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetOrderbookMovementResponse) GetSold() float64 {
+	if x != nil {
+		return x.Sold
+	}
+	return 0
 }
 
-func (*ShutdownRequest) ProtoMessage() {}
-
-func (x *ShutdownRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[204]
+func (x *GetOrderbookMovementResponse) GetSideAffected() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.SideAffected
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ShutdownRequest.ProtoReflect.Descriptor instead.
-func (*ShutdownRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{204}
+func (x *GetOrderbookMovementResponse) GetUpdateProtocol() string {
+	if x != nil {
+		return x.UpdateProtocol
+	}
+	return ""
 }
 
-type ShutdownResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GetOrderbookMovementResponse) GetFullOrderbookSideConsumed() bool {
+	if x != nil {
+		return x.FullOrderbookSideConsumed
+	}
+	return false
 }
 
-func (x *ShutdownResponse) Reset() {
-	*x = ShutdownResponse{}
-	mi := &file_rpc_proto_msgTypes[205]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *GetOrderbookMovementResponse) GetStartPrice() float64 {
+	if x != nil {
+		return x.StartPrice
+	}
+	return 0
 }
 
-func (x *ShutdownResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetOrderbookMovementResponse) GetEndPrice() float64 {
+	if x != nil {
+		return x.EndPrice
+	}
+	return 0
 }
 
-func (*ShutdownResponse) ProtoMessage() {}
-
-func (x *ShutdownResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[205]
+func (x *GetOrderbookMovementResponse) GetNoSlippageOccurred() bool {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.NoSlippageOccurred
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use ShutdownResponse.ProtoReflect.Descriptor instead.
-func (*ShutdownResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{205}
+func (x *GetOrderbookMovementResponse) GetAverageOrderCost() float64 {
+	if x != nil {
+		return x.AverageOrderCost
+	}
+	return 0
 }
 
-type GetTechnicalAnalysisRequest struct {
+type GetOrderbookAmountByNominalRequest struct {
 	state                 protoimpl.MessageState `protogen:"open.v1"`
 	Exchange              string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Pair                  *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
-	AssetType             string                 `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
-	AlgorithmType         string                 `protobuf:"bytes,4,opt,name=algorithm_type,json=algorithmType,proto3" json:"algorithm_type,omitempty"`
-	Interval              int64                  `protobuf:"varint,5,opt,name=interval,proto3" json:"interval,omitempty"`
-	Start                 *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=start,proto3" json:"start,omitempty"`
-	End                   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=end,proto3" json:"end,omitempty"`
-	Period                int64                  `protobuf:"varint,8,opt,name=period,proto3" json:"period,omitempty"`
-	FastPeriod            int64                  `protobuf:"varint,9,opt,name=fast_period,json=fastPeriod,proto3" json:"fast_period,omitempty"`
-	SlowPeriod            int64                  `protobuf:"varint,10,opt,name=slow_period,json=slowPeriod,proto3" json:"slow_period,omitempty"`
-	StandardDeviationUp   float64                `protobuf:"fixed64,11,opt,name=standard_deviation_up,json=standardDeviationUp,proto3" json:"standard_deviation_up,omitempty"`
-	StandardDeviationDown float64                `protobuf:"fixed64,12,opt,name=standard_deviation_down,json=standardDeviationDown,proto3" json:"standard_deviation_down,omitempty"`
-	MovingAverageType     int64                  `protobuf:"varint,13,opt,name=moving_average_type,json=movingAverageType,proto3" json:"moving_average_type,omitempty"`
-	OtherExchange         string                 `protobuf:"bytes,14,opt,name=other_exchange,json=otherExchange,proto3" json:"other_exchange,omitempty"`
-	OtherPair             *CurrencyPair          `protobuf:"bytes,15,opt,name=other_pair,json=otherPair,proto3" json:"other_pair,omitempty"`
-	OtherAssetType        string                 `protobuf:"bytes,16,opt,name=other_asset_type,json=otherAssetType,proto3" json:"other_asset_type,omitempty"`
+	Asset                 string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair                  *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	NominalPercentage     float64                `protobuf:"fixed64,6,opt,name=nominal_percentage,json=nominalPercentage,proto3" json:"nominal_percentage,omitempty"`
+	Sell                  bool                   `protobuf:"varint,7,opt,name=sell,proto3" json:"sell,omitempty"`
+	RequiresRestOrderbook bool                   `protobuf:"varint,8,opt,name=requires_rest_orderbook,json=requiresRestOrderbook,proto3" json:"requires_rest_orderbook,omitempty"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
 
-func (x *GetTechnicalAnalysisRequest) Reset() {
-	*x = GetTechnicalAnalysisRequest{}
-	mi := &file_rpc_proto_msgTypes[206]
+func (x *GetOrderbookAmountByNominalRequest) Reset() {
+	*x = GetOrderbookAmountByNominalRequest{}
+	mi := &file_rpc_proto_msgTypes[229]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTechnicalAnalysisRequest) String() string {
+func (x *GetOrderbookAmountByNominalRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTechnicalAnalysisRequest) ProtoMessage() {}
+func (*GetOrderbookAmountByNominalRequest) ProtoMessage() {}
 
-func (x *GetTechnicalAnalysisRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[206]
+func (x *GetOrderbookAmountByNominalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[229]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13462,145 +15456,204 @@ func (x *GetTechnicalAnalysisRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTechnicalAnalysisRequest.ProtoReflect.Descriptor instead.
-func (*GetTechnicalAnalysisRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{206}
+// Deprecated: Use GetOrderbookAmountByNominalRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderbookAmountByNominalRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{229}
 }
 
-func (x *GetTechnicalAnalysisRequest) GetExchange() string {
+func (x *GetOrderbookAmountByNominalRequest) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *GetTechnicalAnalysisRequest) GetPair() *CurrencyPair {
+func (x *GetOrderbookAmountByNominalRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *GetOrderbookAmountByNominalRequest) GetPair() *CurrencyPair {
 	if x != nil {
 		return x.Pair
 	}
 	return nil
 }
 
-func (x *GetTechnicalAnalysisRequest) GetAssetType() string {
+func (x *GetOrderbookAmountByNominalRequest) GetNominalPercentage() float64 {
 	if x != nil {
-		return x.AssetType
+		return x.NominalPercentage
 	}
-	return ""
+	return 0
 }
 
-func (x *GetTechnicalAnalysisRequest) GetAlgorithmType() string {
+func (x *GetOrderbookAmountByNominalRequest) GetSell() bool {
 	if x != nil {
-		return x.AlgorithmType
+		return x.Sell
 	}
-	return ""
+	return false
 }
 
-func (x *GetTechnicalAnalysisRequest) GetInterval() int64 {
+func (x *GetOrderbookAmountByNominalRequest) GetRequiresRestOrderbook() bool {
 	if x != nil {
-		return x.Interval
+		return x.RequiresRestOrderbook
 	}
-	return 0
+	return false
 }
 
-func (x *GetTechnicalAnalysisRequest) GetStart() *timestamppb.Timestamp {
+type GetOrderbookAmountByNominalResponse struct {
+	state                                protoimpl.MessageState `protogen:"open.v1"`
+	AmountRequired                       float64                `protobuf:"fixed64,1,opt,name=amount_required,json=amountRequired,proto3" json:"amount_required,omitempty"`
+	CurrencySelling                      string                 `protobuf:"bytes,2,opt,name=currency_selling,json=currencySelling,proto3" json:"currency_selling,omitempty"`
+	AmountReceived                       float64                `protobuf:"fixed64,3,opt,name=amount_received,json=amountReceived,proto3" json:"amount_received,omitempty"`
+	CurrencyBuying                       string                 `protobuf:"bytes,4,opt,name=currency_buying,json=currencyBuying,proto3" json:"currency_buying,omitempty"`
+	StartPrice                           float64                `protobuf:"fixed64,5,opt,name=start_price,json=startPrice,proto3" json:"start_price,omitempty"`
+	EndPrice                             float64                `protobuf:"fixed64,6,opt,name=end_price,json=endPrice,proto3" json:"end_price,omitempty"`
+	AverageOrderCost                     float64                `protobuf:"fixed64,7,opt,name=average_order_cost,json=averageOrderCost,proto3" json:"average_order_cost,omitempty"`
+	SideAffected                         string                 `protobuf:"bytes,8,opt,name=side_affected,json=sideAffected,proto3" json:"side_affected,omitempty"`
+	ApproximateNominalSlippagePercentage float64                `protobuf:"fixed64,9,opt,name=approximate_nominal_slippage_percentage,json=approximateNominalSlippagePercentage,proto3" json:"approximate_nominal_slippage_percentage,omitempty"`
+	UpdateProtocol                       string                 `protobuf:"bytes,10,opt,name=update_protocol,json=updateProtocol,proto3" json:"update_protocol,omitempty"`
+	FullOrderbookSideConsumed            bool                   `protobuf:"varint,11,opt,name=full_orderbook_side_consumed,json=fullOrderbookSideConsumed,proto3" json:"full_orderbook_side_consumed,omitempty"`
+	unknownFields                        protoimpl.UnknownFields
+	sizeCache                            protoimpl.SizeCache
+}
+
+func (x *GetOrderbookAmountByNominalResponse) Reset() {
+	*x = GetOrderbookAmountByNominalResponse{}
+	mi := &file_rpc_proto_msgTypes[230]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderbookAmountByNominalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderbookAmountByNominalResponse) ProtoMessage() {}
+
+func (x *GetOrderbookAmountByNominalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[230]
 	if x != nil {
-		return x.Start
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *GetTechnicalAnalysisRequest) GetEnd() *timestamppb.Timestamp {
+// Deprecated: Use GetOrderbookAmountByNominalResponse.ProtoReflect.Descriptor instead.
+func (*GetOrderbookAmountByNominalResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{230}
+}
+
+func (x *GetOrderbookAmountByNominalResponse) GetAmountRequired() float64 {
 	if x != nil {
-		return x.End
+		return x.AmountRequired
 	}
-	return nil
+	return 0
 }
 
-func (x *GetTechnicalAnalysisRequest) GetPeriod() int64 {
+func (x *GetOrderbookAmountByNominalResponse) GetCurrencySelling() string {
 	if x != nil {
-		return x.Period
+		return x.CurrencySelling
 	}
-	return 0
+	return ""
 }
 
-func (x *GetTechnicalAnalysisRequest) GetFastPeriod() int64 {
+func (x *GetOrderbookAmountByNominalResponse) GetAmountReceived() float64 {
 	if x != nil {
-		return x.FastPeriod
+		return x.AmountReceived
 	}
 	return 0
 }
 
-func (x *GetTechnicalAnalysisRequest) GetSlowPeriod() int64 {
+func (x *GetOrderbookAmountByNominalResponse) GetCurrencyBuying() string {
 	if x != nil {
-		return x.SlowPeriod
+		return x.CurrencyBuying
 	}
-	return 0
+	return ""
 }
 
-func (x *GetTechnicalAnalysisRequest) GetStandardDeviationUp() float64 {
+func (x *GetOrderbookAmountByNominalResponse) GetStartPrice() float64 {
 	if x != nil {
-		return x.StandardDeviationUp
+		return x.StartPrice
 	}
 	return 0
 }
 
-func (x *GetTechnicalAnalysisRequest) GetStandardDeviationDown() float64 {
+func (x *GetOrderbookAmountByNominalResponse) GetEndPrice() float64 {
 	if x != nil {
-		return x.StandardDeviationDown
+		return x.EndPrice
 	}
 	return 0
 }
 
-func (x *GetTechnicalAnalysisRequest) GetMovingAverageType() int64 {
+func (x *GetOrderbookAmountByNominalResponse) GetAverageOrderCost() float64 {
 	if x != nil {
-		return x.MovingAverageType
+		return x.AverageOrderCost
 	}
 	return 0
 }
 
-func (x *GetTechnicalAnalysisRequest) GetOtherExchange() string {
+func (x *GetOrderbookAmountByNominalResponse) GetSideAffected() string {
 	if x != nil {
-		return x.OtherExchange
+		return x.SideAffected
+	}
+	return ""
+}
+
+func (x *GetOrderbookAmountByNominalResponse) GetApproximateNominalSlippagePercentage() float64 {
+	if x != nil {
+		return x.ApproximateNominalSlippagePercentage
 	}
-	return ""
+	return 0
 }
 
-func (x *GetTechnicalAnalysisRequest) GetOtherPair() *CurrencyPair {
+func (x *GetOrderbookAmountByNominalResponse) GetUpdateProtocol() string {
 	if x != nil {
-		return x.OtherPair
+		return x.UpdateProtocol
 	}
-	return nil
+	return ""
 }
 
-func (x *GetTechnicalAnalysisRequest) GetOtherAssetType() string {
+func (x *GetOrderbookAmountByNominalResponse) GetFullOrderbookSideConsumed() bool {
 	if x != nil {
-		return x.OtherAssetType
+		return x.FullOrderbookSideConsumed
 	}
-	return ""
+	return false
 }
 
-type ListOfSignals struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Signals       []float64              `protobuf:"fixed64,1,rep,packed,name=signals,proto3" json:"signals,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetOrderbookAmountByImpactRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Exchange              string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset                 string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair                  *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	ImpactPercentage      float64                `protobuf:"fixed64,6,opt,name=impact_percentage,json=impactPercentage,proto3" json:"impact_percentage,omitempty"`
+	Sell                  bool                   `protobuf:"varint,7,opt,name=sell,proto3" json:"sell,omitempty"`
+	RequiresRestOrderbook bool                   `protobuf:"varint,8,opt,name=requires_rest_orderbook,json=requiresRestOrderbook,proto3" json:"requires_rest_orderbook,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
-func (x *ListOfSignals) Reset() {
-	*x = ListOfSignals{}
-	mi := &file_rpc_proto_msgTypes[207]
+func (x *GetOrderbookAmountByImpactRequest) Reset() {
+	*x = GetOrderbookAmountByImpactRequest{}
+	mi := &file_rpc_proto_msgTypes[231]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListOfSignals) String() string {
+func (x *GetOrderbookAmountByImpactRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListOfSignals) ProtoMessage() {}
+func (*GetOrderbookAmountByImpactRequest) ProtoMessage() {}
 
-func (x *ListOfSignals) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[207]
+func (x *GetOrderbookAmountByImpactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[231]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13611,96 +15664,85 @@ func (x *ListOfSignals) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListOfSignals.ProtoReflect.Descriptor instead.
-func (*ListOfSignals) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{207}
+// Deprecated: Use GetOrderbookAmountByImpactRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderbookAmountByImpactRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{231}
 }
 
-func (x *ListOfSignals) GetSignals() []float64 {
+func (x *GetOrderbookAmountByImpactRequest) GetExchange() string {
 	if x != nil {
-		return x.Signals
+		return x.Exchange
 	}
-	return nil
-}
-
-type GetTechnicalAnalysisResponse struct {
-	state         protoimpl.MessageState    `protogen:"open.v1"`
-	Signals       map[string]*ListOfSignals `protobuf:"bytes,1,rep,name=signals,proto3" json:"signals,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return ""
 }
 
-func (x *GetTechnicalAnalysisResponse) Reset() {
-	*x = GetTechnicalAnalysisResponse{}
-	mi := &file_rpc_proto_msgTypes[208]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *GetOrderbookAmountByImpactRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
 }
 
-func (x *GetTechnicalAnalysisResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetOrderbookAmountByImpactRequest) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
 }
 
-func (*GetTechnicalAnalysisResponse) ProtoMessage() {}
-
-func (x *GetTechnicalAnalysisResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[208]
+func (x *GetOrderbookAmountByImpactRequest) GetImpactPercentage() float64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ImpactPercentage
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GetTechnicalAnalysisResponse.ProtoReflect.Descriptor instead.
-func (*GetTechnicalAnalysisResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{208}
+func (x *GetOrderbookAmountByImpactRequest) GetSell() bool {
+	if x != nil {
+		return x.Sell
+	}
+	return false
 }
 
-func (x *GetTechnicalAnalysisResponse) GetSignals() map[string]*ListOfSignals {
+func (x *GetOrderbookAmountByImpactRequest) GetRequiresRestOrderbook() bool {
 	if x != nil {
-		return x.Signals
+		return x.RequiresRestOrderbook
 	}
-	return nil
+	return false
 }
 
-type GetMarginRatesHistoryRequest struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	Exchange           string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset              string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Currency           string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
-	StartDate          string                 `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate            string                 `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
-	GetPredictedRate   bool                   `protobuf:"varint,6,opt,name=get_predicted_rate,json=getPredictedRate,proto3" json:"get_predicted_rate,omitempty"`
-	GetLendingPayments bool                   `protobuf:"varint,7,opt,name=get_lending_payments,json=getLendingPayments,proto3" json:"get_lending_payments,omitempty"`
-	GetBorrowRates     bool                   `protobuf:"varint,8,opt,name=get_borrow_rates,json=getBorrowRates,proto3" json:"get_borrow_rates,omitempty"`
-	GetBorrowCosts     bool                   `protobuf:"varint,9,opt,name=get_borrow_costs,json=getBorrowCosts,proto3" json:"get_borrow_costs,omitempty"`
-	IncludeAllRates    bool                   `protobuf:"varint,10,opt,name=include_all_rates,json=includeAllRates,proto3" json:"include_all_rates,omitempty"`
-	CalculateOffline   bool                   `protobuf:"varint,11,opt,name=calculate_offline,json=calculateOffline,proto3" json:"calculate_offline,omitempty"`
-	TakerFeeRate       string                 `protobuf:"bytes,12,opt,name=taker_fee_rate,json=takerFeeRate,proto3" json:"taker_fee_rate,omitempty"`
-	Rates              []*MarginRate          `protobuf:"bytes,13,rep,name=rates,proto3" json:"rates,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type GetOrderbookAmountByImpactResponse struct {
+	state                               protoimpl.MessageState `protogen:"open.v1"`
+	AmountRequired                      float64                `protobuf:"fixed64,1,opt,name=amount_required,json=amountRequired,proto3" json:"amount_required,omitempty"`
+	CurrencySelling                     string                 `protobuf:"bytes,2,opt,name=currency_selling,json=currencySelling,proto3" json:"currency_selling,omitempty"`
+	AmountReceived                      float64                `protobuf:"fixed64,3,opt,name=amount_received,json=amountReceived,proto3" json:"amount_received,omitempty"`
+	CurrencyBuying                      string                 `protobuf:"bytes,4,opt,name=currency_buying,json=currencyBuying,proto3" json:"currency_buying,omitempty"`
+	StartPrice                          float64                `protobuf:"fixed64,5,opt,name=start_price,json=startPrice,proto3" json:"start_price,omitempty"`
+	EndPrice                            float64                `protobuf:"fixed64,6,opt,name=end_price,json=endPrice,proto3" json:"end_price,omitempty"`
+	AverageOrderCost                    float64                `protobuf:"fixed64,7,opt,name=average_order_cost,json=averageOrderCost,proto3" json:"average_order_cost,omitempty"`
+	SideAffected                        string                 `protobuf:"bytes,8,opt,name=side_affected,json=sideAffected,proto3" json:"side_affected,omitempty"`
+	ApproximateImpactSlippagePercentage float64                `protobuf:"fixed64,9,opt,name=approximate_impact_slippage_percentage,json=approximateImpactSlippagePercentage,proto3" json:"approximate_impact_slippage_percentage,omitempty"`
+	UpdateProtocol                      string                 `protobuf:"bytes,10,opt,name=update_protocol,json=updateProtocol,proto3" json:"update_protocol,omitempty"`
+	FullOrderbookSideConsumed           bool                   `protobuf:"varint,11,opt,name=full_orderbook_side_consumed,json=fullOrderbookSideConsumed,proto3" json:"full_orderbook_side_consumed,omitempty"`
+	unknownFields                       protoimpl.UnknownFields
+	sizeCache                           protoimpl.SizeCache
 }
 
-func (x *GetMarginRatesHistoryRequest) Reset() {
-	*x = GetMarginRatesHistoryRequest{}
-	mi := &file_rpc_proto_msgTypes[209]
+func (x *GetOrderbookAmountByImpactResponse) Reset() {
+	*x = GetOrderbookAmountByImpactResponse{}
+	mi := &file_rpc_proto_msgTypes[232]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMarginRatesHistoryRequest) String() string {
+func (x *GetOrderbookAmountByImpactResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMarginRatesHistoryRequest) ProtoMessage() {}
+func (*GetOrderbookAmountByImpactResponse) ProtoMessage() {}
 
-func (x *GetMarginRatesHistoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[209]
+func (x *GetOrderbookAmountByImpactResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[232]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13711,125 +15753,163 @@ func (x *GetMarginRatesHistoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMarginRatesHistoryRequest.ProtoReflect.Descriptor instead.
-func (*GetMarginRatesHistoryRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{209}
+// Deprecated: Use GetOrderbookAmountByImpactResponse.ProtoReflect.Descriptor instead.
+func (*GetOrderbookAmountByImpactResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{232}
 }
 
-func (x *GetMarginRatesHistoryRequest) GetExchange() string {
+func (x *GetOrderbookAmountByImpactResponse) GetAmountRequired() float64 {
 	if x != nil {
-		return x.Exchange
+		return x.AmountRequired
 	}
-	return ""
+	return 0
 }
 
-func (x *GetMarginRatesHistoryRequest) GetAsset() string {
+func (x *GetOrderbookAmountByImpactResponse) GetCurrencySelling() string {
 	if x != nil {
-		return x.Asset
+		return x.CurrencySelling
 	}
 	return ""
 }
 
-func (x *GetMarginRatesHistoryRequest) GetCurrency() string {
+func (x *GetOrderbookAmountByImpactResponse) GetAmountReceived() float64 {
 	if x != nil {
-		return x.Currency
+		return x.AmountReceived
 	}
-	return ""
+	return 0
 }
 
-func (x *GetMarginRatesHistoryRequest) GetStartDate() string {
+func (x *GetOrderbookAmountByImpactResponse) GetCurrencyBuying() string {
 	if x != nil {
-		return x.StartDate
+		return x.CurrencyBuying
 	}
 	return ""
 }
 
-func (x *GetMarginRatesHistoryRequest) GetEndDate() string {
+func (x *GetOrderbookAmountByImpactResponse) GetStartPrice() float64 {
 	if x != nil {
-		return x.EndDate
+		return x.StartPrice
 	}
-	return ""
+	return 0
 }
 
-func (x *GetMarginRatesHistoryRequest) GetGetPredictedRate() bool {
+func (x *GetOrderbookAmountByImpactResponse) GetEndPrice() float64 {
 	if x != nil {
-		return x.GetPredictedRate
+		return x.EndPrice
 	}
-	return false
+	return 0
 }
 
-func (x *GetMarginRatesHistoryRequest) GetGetLendingPayments() bool {
+func (x *GetOrderbookAmountByImpactResponse) GetAverageOrderCost() float64 {
 	if x != nil {
-		return x.GetLendingPayments
+		return x.AverageOrderCost
 	}
-	return false
+	return 0
 }
 
-func (x *GetMarginRatesHistoryRequest) GetGetBorrowRates() bool {
+func (x *GetOrderbookAmountByImpactResponse) GetSideAffected() string {
 	if x != nil {
-		return x.GetBorrowRates
+		return x.SideAffected
 	}
-	return false
+	return ""
 }
 
-func (x *GetMarginRatesHistoryRequest) GetGetBorrowCosts() bool {
+func (x *GetOrderbookAmountByImpactResponse) GetApproximateImpactSlippagePercentage() float64 {
 	if x != nil {
-		return x.GetBorrowCosts
+		return x.ApproximateImpactSlippagePercentage
 	}
-	return false
+	return 0
 }
 
-func (x *GetMarginRatesHistoryRequest) GetIncludeAllRates() bool {
+func (x *GetOrderbookAmountByImpactResponse) GetUpdateProtocol() string {
 	if x != nil {
-		return x.IncludeAllRates
+		return x.UpdateProtocol
 	}
-	return false
+	return ""
 }
 
-func (x *GetMarginRatesHistoryRequest) GetCalculateOffline() bool {
+func (x *GetOrderbookAmountByImpactResponse) GetFullOrderbookSideConsumed() bool {
 	if x != nil {
-		return x.CalculateOffline
+		return x.FullOrderbookSideConsumed
 	}
 	return false
 }
 
-func (x *GetMarginRatesHistoryRequest) GetTakerFeeRate() string {
+type GetOpenInterestRequest struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Exchange      string                     `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Data          []*OpenInterestDataRequest `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOpenInterestRequest) Reset() {
+	*x = GetOpenInterestRequest{}
+	mi := &file_rpc_proto_msgTypes[233]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOpenInterestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOpenInterestRequest) ProtoMessage() {}
+
+func (x *GetOpenInterestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[233]
 	if x != nil {
-		return x.TakerFeeRate
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOpenInterestRequest.ProtoReflect.Descriptor instead.
+func (*GetOpenInterestRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{233}
+}
+
+func (x *GetOpenInterestRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *GetMarginRatesHistoryRequest) GetRates() []*MarginRate {
+func (x *GetOpenInterestRequest) GetData() []*OpenInterestDataRequest {
 	if x != nil {
-		return x.Rates
+		return x.Data
 	}
 	return nil
 }
 
-type LendingPayment struct {
+type OpenInterestDataRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Payment       string                 `protobuf:"bytes,1,opt,name=payment,proto3" json:"payment,omitempty"`
-	Size          string                 `protobuf:"bytes,2,opt,name=size,proto3" json:"size,omitempty"`
+	Asset         string                 `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LendingPayment) Reset() {
-	*x = LendingPayment{}
-	mi := &file_rpc_proto_msgTypes[210]
+func (x *OpenInterestDataRequest) Reset() {
+	*x = OpenInterestDataRequest{}
+	mi := &file_rpc_proto_msgTypes[234]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LendingPayment) String() string {
+func (x *OpenInterestDataRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LendingPayment) ProtoMessage() {}
+func (*OpenInterestDataRequest) ProtoMessage() {}
 
-func (x *LendingPayment) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[210]
+func (x *OpenInterestDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[234]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13840,48 +15920,47 @@ func (x *LendingPayment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LendingPayment.ProtoReflect.Descriptor instead.
-func (*LendingPayment) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{210}
+// Deprecated: Use OpenInterestDataRequest.ProtoReflect.Descriptor instead.
+func (*OpenInterestDataRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{234}
 }
 
-func (x *LendingPayment) GetPayment() string {
+func (x *OpenInterestDataRequest) GetAsset() string {
 	if x != nil {
-		return x.Payment
+		return x.Asset
 	}
 	return ""
 }
 
-func (x *LendingPayment) GetSize() string {
+func (x *OpenInterestDataRequest) GetPair() *CurrencyPair {
 	if x != nil {
-		return x.Size
+		return x.Pair
 	}
-	return ""
+	return nil
 }
 
-type BorrowCost struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Cost          string                 `protobuf:"bytes,1,opt,name=cost,proto3" json:"cost,omitempty"`
-	Size          string                 `protobuf:"bytes,2,opt,name=size,proto3" json:"size,omitempty"`
+type GetOpenInterestResponse struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Data          []*OpenInterestDataResponse `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BorrowCost) Reset() {
-	*x = BorrowCost{}
-	mi := &file_rpc_proto_msgTypes[211]
+func (x *GetOpenInterestResponse) Reset() {
+	*x = GetOpenInterestResponse{}
+	mi := &file_rpc_proto_msgTypes[235]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BorrowCost) String() string {
+func (x *GetOpenInterestResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BorrowCost) ProtoMessage() {}
+func (*GetOpenInterestResponse) ProtoMessage() {}
 
-func (x *BorrowCost) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[211]
+func (x *GetOpenInterestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[235]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13892,54 +15971,43 @@ func (x *BorrowCost) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BorrowCost.ProtoReflect.Descriptor instead.
-func (*BorrowCost) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{211}
-}
-
-func (x *BorrowCost) GetCost() string {
-	if x != nil {
-		return x.Cost
-	}
-	return ""
+// Deprecated: Use GetOpenInterestResponse.ProtoReflect.Descriptor instead.
+func (*GetOpenInterestResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{235}
 }
 
-func (x *BorrowCost) GetSize() string {
+func (x *GetOpenInterestResponse) GetData() []*OpenInterestDataResponse {
 	if x != nil {
-		return x.Size
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-type MarginRate struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Time             string                 `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
-	MarketBorrowSize string                 `protobuf:"bytes,2,opt,name=market_borrow_size,json=marketBorrowSize,proto3" json:"market_borrow_size,omitempty"`
-	HourlyRate       string                 `protobuf:"bytes,3,opt,name=hourly_rate,json=hourlyRate,proto3" json:"hourly_rate,omitempty"`
-	YearlyRate       string                 `protobuf:"bytes,4,opt,name=yearly_rate,json=yearlyRate,proto3" json:"yearly_rate,omitempty"`
-	HourlyBorrowRate string                 `protobuf:"bytes,5,opt,name=hourly_borrow_rate,json=hourlyBorrowRate,proto3" json:"hourly_borrow_rate,omitempty"`
-	YearlyBorrowRate string                 `protobuf:"bytes,6,opt,name=yearly_borrow_rate,json=yearlyBorrowRate,proto3" json:"yearly_borrow_rate,omitempty"`
-	LendingPayment   *LendingPayment        `protobuf:"bytes,7,opt,name=lending_payment,json=lendingPayment,proto3" json:"lending_payment,omitempty"`
-	BorrowCost       *BorrowCost            `protobuf:"bytes,8,opt,name=borrow_cost,json=borrowCost,proto3" json:"borrow_cost,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type OpenInterestDataResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	OpenInterest  float64                `protobuf:"fixed64,4,opt,name=open_interest,json=openInterest,proto3" json:"open_interest,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MarginRate) Reset() {
-	*x = MarginRate{}
-	mi := &file_rpc_proto_msgTypes[212]
+func (x *OpenInterestDataResponse) Reset() {
+	*x = OpenInterestDataResponse{}
+	mi := &file_rpc_proto_msgTypes[236]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MarginRate) String() string {
+func (x *OpenInterestDataResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MarginRate) ProtoMessage() {}
+func (*OpenInterestDataResponse) ProtoMessage() {}
 
-func (x *MarginRate) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[212]
+func (x *OpenInterestDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[236]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13950,97 +16018,121 @@ func (x *MarginRate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MarginRate.ProtoReflect.Descriptor instead.
-func (*MarginRate) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{212}
+// Deprecated: Use OpenInterestDataResponse.ProtoReflect.Descriptor instead.
+func (*OpenInterestDataResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{236}
 }
 
-func (x *MarginRate) GetTime() string {
+func (x *OpenInterestDataResponse) GetExchange() string {
 	if x != nil {
-		return x.Time
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *MarginRate) GetMarketBorrowSize() string {
+func (x *OpenInterestDataResponse) GetAsset() string {
 	if x != nil {
-		return x.MarketBorrowSize
+		return x.Asset
 	}
 	return ""
 }
 
-func (x *MarginRate) GetHourlyRate() string {
+func (x *OpenInterestDataResponse) GetPair() *CurrencyPair {
 	if x != nil {
-		return x.HourlyRate
+		return x.Pair
 	}
-	return ""
+	return nil
 }
 
-func (x *MarginRate) GetYearlyRate() string {
+func (x *OpenInterestDataResponse) GetOpenInterest() float64 {
 	if x != nil {
-		return x.YearlyRate
+		return x.OpenInterest
 	}
-	return ""
+	return 0
 }
 
-func (x *MarginRate) GetHourlyBorrowRate() string {
+type GetCurrencyTradeURLRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCurrencyTradeURLRequest) Reset() {
+	*x = GetCurrencyTradeURLRequest{}
+	mi := &file_rpc_proto_msgTypes[237]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCurrencyTradeURLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCurrencyTradeURLRequest) ProtoMessage() {}
+
+func (x *GetCurrencyTradeURLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[237]
 	if x != nil {
-		return x.HourlyBorrowRate
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *MarginRate) GetYearlyBorrowRate() string {
+// Deprecated: Use GetCurrencyTradeURLRequest.ProtoReflect.Descriptor instead.
+func (*GetCurrencyTradeURLRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{237}
+}
+
+func (x *GetCurrencyTradeURLRequest) GetExchange() string {
 	if x != nil {
-		return x.YearlyBorrowRate
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *MarginRate) GetLendingPayment() *LendingPayment {
+func (x *GetCurrencyTradeURLRequest) GetAsset() string {
 	if x != nil {
-		return x.LendingPayment
+		return x.Asset
 	}
-	return nil
+	return ""
 }
 
-func (x *MarginRate) GetBorrowCost() *BorrowCost {
+func (x *GetCurrencyTradeURLRequest) GetPair() *CurrencyPair {
 	if x != nil {
-		return x.BorrowCost
+		return x.Pair
 	}
 	return nil
 }
 
-type GetMarginRatesHistoryResponse struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	Rates              []*MarginRate          `protobuf:"bytes,1,rep,name=rates,proto3" json:"rates,omitempty"`
-	TotalRates         int64                  `protobuf:"varint,2,opt,name=total_rates,json=totalRates,proto3" json:"total_rates,omitempty"`
-	SumBorrowCosts     string                 `protobuf:"bytes,3,opt,name=sum_borrow_costs,json=sumBorrowCosts,proto3" json:"sum_borrow_costs,omitempty"`
-	AvgBorrowSize      string                 `protobuf:"bytes,4,opt,name=avg_borrow_size,json=avgBorrowSize,proto3" json:"avg_borrow_size,omitempty"`
-	SumLendingPayments string                 `protobuf:"bytes,5,opt,name=sum_lending_payments,json=sumLendingPayments,proto3" json:"sum_lending_payments,omitempty"`
-	AvgLendingSize     string                 `protobuf:"bytes,6,opt,name=avg_lending_size,json=avgLendingSize,proto3" json:"avg_lending_size,omitempty"`
-	LatestRate         *MarginRate            `protobuf:"bytes,7,opt,name=latest_rate,json=latestRate,proto3" json:"latest_rate,omitempty"`
-	PredictedRate      *MarginRate            `protobuf:"bytes,8,opt,name=predicted_rate,json=predictedRate,proto3" json:"predicted_rate,omitempty"`
-	TakerFeeRate       string                 `protobuf:"bytes,9,opt,name=taker_fee_rate,json=takerFeeRate,proto3" json:"taker_fee_rate,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type GetCurrencyTradeURLResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMarginRatesHistoryResponse) Reset() {
-	*x = GetMarginRatesHistoryResponse{}
-	mi := &file_rpc_proto_msgTypes[213]
+func (x *GetCurrencyTradeURLResponse) Reset() {
+	*x = GetCurrencyTradeURLResponse{}
+	mi := &file_rpc_proto_msgTypes[238]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMarginRatesHistoryResponse) String() string {
+func (x *GetCurrencyTradeURLResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMarginRatesHistoryResponse) ProtoMessage() {}
+func (*GetCurrencyTradeURLResponse) ProtoMessage() {}
 
-func (x *GetMarginRatesHistoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[213]
+func (x *GetCurrencyTradeURLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[238]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14051,102 +16143,112 @@ func (x *GetMarginRatesHistoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMarginRatesHistoryResponse.ProtoReflect.Descriptor instead.
-func (*GetMarginRatesHistoryResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{213}
+// Deprecated: Use GetCurrencyTradeURLResponse.ProtoReflect.Descriptor instead.
+func (*GetCurrencyTradeURLResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{238}
 }
 
-func (x *GetMarginRatesHistoryResponse) GetRates() []*MarginRate {
+func (x *GetCurrencyTradeURLResponse) GetUrl() string {
 	if x != nil {
-		return x.Rates
+		return x.Url
 	}
-	return nil
+	return ""
 }
 
-func (x *GetMarginRatesHistoryResponse) GetTotalRates() int64 {
-	if x != nil {
-		return x.TotalRates
-	}
-	return 0
+type GetCandleStreamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType     string                 `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	TimeInterval  int64                  `protobuf:"varint,4,opt,name=time_interval,json=timeInterval,proto3" json:"time_interval,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMarginRatesHistoryResponse) GetSumBorrowCosts() string {
-	if x != nil {
-		return x.SumBorrowCosts
-	}
-	return ""
+func (x *GetCandleStreamRequest) Reset() {
+	*x = GetCandleStreamRequest{}
+	mi := &file_rpc_proto_msgTypes[239]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMarginRatesHistoryResponse) GetAvgBorrowSize() string {
-	if x != nil {
-		return x.AvgBorrowSize
-	}
-	return ""
+func (x *GetCandleStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GetMarginRatesHistoryResponse) GetSumLendingPayments() string {
+func (*GetCandleStreamRequest) ProtoMessage() {}
+
+func (x *GetCandleStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[239]
 	if x != nil {
-		return x.SumLendingPayments
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *GetMarginRatesHistoryResponse) GetAvgLendingSize() string {
+// Deprecated: Use GetCandleStreamRequest.ProtoReflect.Descriptor instead.
+func (*GetCandleStreamRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{239}
+}
+
+func (x *GetCandleStreamRequest) GetExchange() string {
 	if x != nil {
-		return x.AvgLendingSize
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *GetMarginRatesHistoryResponse) GetLatestRate() *MarginRate {
+func (x *GetCandleStreamRequest) GetPair() *CurrencyPair {
 	if x != nil {
-		return x.LatestRate
+		return x.Pair
 	}
 	return nil
 }
 
-func (x *GetMarginRatesHistoryResponse) GetPredictedRate() *MarginRate {
+func (x *GetCandleStreamRequest) GetAssetType() string {
 	if x != nil {
-		return x.PredictedRate
+		return x.AssetType
 	}
-	return nil
+	return ""
 }
 
-func (x *GetMarginRatesHistoryResponse) GetTakerFeeRate() string {
+func (x *GetCandleStreamRequest) GetTimeInterval() int64 {
 	if x != nil {
-		return x.TakerFeeRate
+		return x.TimeInterval
 	}
-	return ""
+	return 0
 }
 
-type GetOrderbookMovementRequest struct {
-	state                 protoimpl.MessageState `protogen:"open.v1"`
-	Exchange              string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset                 string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair                  *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	Amount                float64                `protobuf:"fixed64,6,opt,name=amount,proto3" json:"amount,omitempty"`
-	Sell                  bool                   `protobuf:"varint,7,opt,name=sell,proto3" json:"sell,omitempty"`
-	RequiresRestOrderbook bool                   `protobuf:"varint,8,opt,name=requires_rest_orderbook,json=requiresRestOrderbook,proto3" json:"requires_rest_orderbook,omitempty"`
-	Purchase              bool                   `protobuf:"varint,9,opt,name=purchase,proto3" json:"purchase,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+type CandleStreamResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	Asset         string                 `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
+	Interval      string                 `protobuf:"bytes,4,opt,name=interval,proto3" json:"interval,omitempty"`
+	Candle        *Candle                `protobuf:"bytes,5,opt,name=candle,proto3" json:"candle,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOrderbookMovementRequest) Reset() {
-	*x = GetOrderbookMovementRequest{}
-	mi := &file_rpc_proto_msgTypes[214]
+func (x *CandleStreamResponse) Reset() {
+	*x = CandleStreamResponse{}
+	mi := &file_rpc_proto_msgTypes[240]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrderbookMovementRequest) String() string {
+func (x *CandleStreamResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrderbookMovementRequest) ProtoMessage() {}
+func (*CandleStreamResponse) ProtoMessage() {}
 
-func (x *GetOrderbookMovementRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[214]
+func (x *CandleStreamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[240]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14157,95 +16259,68 @@ func (x *GetOrderbookMovementRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrderbookMovementRequest.ProtoReflect.Descriptor instead.
-func (*GetOrderbookMovementRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{214}
+// Deprecated: Use CandleStreamResponse.ProtoReflect.Descriptor instead.
+func (*CandleStreamResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{240}
 }
 
-func (x *GetOrderbookMovementRequest) GetExchange() string {
+func (x *CandleStreamResponse) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *GetOrderbookMovementRequest) GetAsset() string {
-	if x != nil {
-		return x.Asset
-	}
-	return ""
-}
-
-func (x *GetOrderbookMovementRequest) GetPair() *CurrencyPair {
+func (x *CandleStreamResponse) GetPair() *CurrencyPair {
 	if x != nil {
 		return x.Pair
 	}
 	return nil
 }
 
-func (x *GetOrderbookMovementRequest) GetAmount() float64 {
-	if x != nil {
-		return x.Amount
-	}
-	return 0
-}
-
-func (x *GetOrderbookMovementRequest) GetSell() bool {
+func (x *CandleStreamResponse) GetAsset() string {
 	if x != nil {
-		return x.Sell
+		return x.Asset
 	}
-	return false
+	return ""
 }
 
-func (x *GetOrderbookMovementRequest) GetRequiresRestOrderbook() bool {
+func (x *CandleStreamResponse) GetInterval() string {
 	if x != nil {
-		return x.RequiresRestOrderbook
+		return x.Interval
 	}
-	return false
+	return ""
 }
 
-func (x *GetOrderbookMovementRequest) GetPurchase() bool {
+func (x *CandleStreamResponse) GetCandle() *Candle {
 	if x != nil {
-		return x.Purchase
+		return x.Candle
 	}
-	return false
+	return nil
 }
 
-type GetOrderbookMovementResponse struct {
-	state                     protoimpl.MessageState `protogen:"open.v1"`
-	NominalPercentage         float64                `protobuf:"fixed64,1,opt,name=nominal_percentage,json=nominalPercentage,proto3" json:"nominal_percentage,omitempty"`
-	ImpactPercentage          float64                `protobuf:"fixed64,2,opt,name=impact_percentage,json=impactPercentage,proto3" json:"impact_percentage,omitempty"`
-	SlippageCost              float64                `protobuf:"fixed64,3,opt,name=slippage_cost,json=slippageCost,proto3" json:"slippage_cost,omitempty"`
-	CurrencyBought            string                 `protobuf:"bytes,4,opt,name=currency_bought,json=currencyBought,proto3" json:"currency_bought,omitempty"`
-	Bought                    float64                `protobuf:"fixed64,5,opt,name=bought,proto3" json:"bought,omitempty"`
-	CurrencySold              string                 `protobuf:"bytes,6,opt,name=currency_sold,json=currencySold,proto3" json:"currency_sold,omitempty"`
-	Sold                      float64                `protobuf:"fixed64,7,opt,name=sold,proto3" json:"sold,omitempty"`
-	SideAffected              string                 `protobuf:"bytes,8,opt,name=side_affected,json=sideAffected,proto3" json:"side_affected,omitempty"`
-	UpdateProtocol            string                 `protobuf:"bytes,9,opt,name=update_protocol,json=updateProtocol,proto3" json:"update_protocol,omitempty"`
-	FullOrderbookSideConsumed bool                   `protobuf:"varint,10,opt,name=full_orderbook_side_consumed,json=fullOrderbookSideConsumed,proto3" json:"full_orderbook_side_consumed,omitempty"`
-	StartPrice                float64                `protobuf:"fixed64,11,opt,name=start_price,json=startPrice,proto3" json:"start_price,omitempty"`
-	EndPrice                  float64                `protobuf:"fixed64,12,opt,name=end_price,json=endPrice,proto3" json:"end_price,omitempty"`
-	NoSlippageOccurred        bool                   `protobuf:"varint,13,opt,name=no_slippage_occurred,json=noSlippageOccurred,proto3" json:"no_slippage_occurred,omitempty"`
-	AverageOrderCost          float64                `protobuf:"fixed64,14,opt,name=average_order_cost,json=averageOrderCost,proto3" json:"average_order_cost,omitempty"`
-	unknownFields             protoimpl.UnknownFields
-	sizeCache                 protoimpl.SizeCache
+type GetRateLimitUsageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOrderbookMovementResponse) Reset() {
-	*x = GetOrderbookMovementResponse{}
-	mi := &file_rpc_proto_msgTypes[215]
+func (x *GetRateLimitUsageRequest) Reset() {
+	*x = GetRateLimitUsageRequest{}
+	mi := &file_rpc_proto_msgTypes[241]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrderbookMovementResponse) String() string {
+func (x *GetRateLimitUsageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrderbookMovementResponse) ProtoMessage() {}
+func (*GetRateLimitUsageRequest) ProtoMessage() {}
 
-func (x *GetOrderbookMovementResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[215]
+func (x *GetRateLimitUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[241]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14256,136 +16331,178 @@ func (x *GetOrderbookMovementResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrderbookMovementResponse.ProtoReflect.Descriptor instead.
-func (*GetOrderbookMovementResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{215}
+// Deprecated: Use GetRateLimitUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetRateLimitUsageRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{241}
 }
 
-func (x *GetOrderbookMovementResponse) GetNominalPercentage() float64 {
+func (x *GetRateLimitUsageRequest) GetExchange() string {
 	if x != nil {
-		return x.NominalPercentage
+		return x.Exchange
 	}
-	return 0
+	return ""
 }
 
-func (x *GetOrderbookMovementResponse) GetImpactPercentage() float64 {
-	if x != nil {
-		return x.ImpactPercentage
-	}
-	return 0
+type RateLimitUsage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Used          int64                  `protobuf:"varint,2,opt,name=used,proto3" json:"used,omitempty"`
+	Limit         int64                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Interval      int64                  `protobuf:"varint,4,opt,name=interval,proto3" json:"interval,omitempty"`
+	ResetAt       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=reset_at,json=resetAt,proto3" json:"reset_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOrderbookMovementResponse) GetSlippageCost() float64 {
+func (x *RateLimitUsage) Reset() {
+	*x = RateLimitUsage{}
+	mi := &file_rpc_proto_msgTypes[242]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RateLimitUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateLimitUsage) ProtoMessage() {}
+
+func (x *RateLimitUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[242]
 	if x != nil {
-		return x.SlippageCost
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *GetOrderbookMovementResponse) GetCurrencyBought() string {
+// Deprecated: Use RateLimitUsage.ProtoReflect.Descriptor instead.
+func (*RateLimitUsage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{242}
+}
+
+func (x *RateLimitUsage) GetName() string {
 	if x != nil {
-		return x.CurrencyBought
+		return x.Name
 	}
 	return ""
 }
 
-func (x *GetOrderbookMovementResponse) GetBought() float64 {
+func (x *RateLimitUsage) GetUsed() int64 {
 	if x != nil {
-		return x.Bought
+		return x.Used
 	}
 	return 0
 }
 
-func (x *GetOrderbookMovementResponse) GetCurrencySold() string {
+func (x *RateLimitUsage) GetLimit() int64 {
 	if x != nil {
-		return x.CurrencySold
+		return x.Limit
 	}
-	return ""
+	return 0
 }
 
-func (x *GetOrderbookMovementResponse) GetSold() float64 {
+func (x *RateLimitUsage) GetInterval() int64 {
 	if x != nil {
-		return x.Sold
+		return x.Interval
 	}
 	return 0
 }
 
-func (x *GetOrderbookMovementResponse) GetSideAffected() string {
+func (x *RateLimitUsage) GetResetAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.SideAffected
+		return x.ResetAt
 	}
-	return ""
+	return nil
 }
 
-func (x *GetOrderbookMovementResponse) GetUpdateProtocol() string {
+func (x *RateLimitUsage) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.UpdateProtocol
+		return x.UpdatedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *GetOrderbookMovementResponse) GetFullOrderbookSideConsumed() bool {
-	if x != nil {
-		return x.FullOrderbookSideConsumed
-	}
-	return false
+type GetRateLimitUsageResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Usage          []*RateLimitUsage      `protobuf:"bytes,1,rep,name=usage,proto3" json:"usage,omitempty"`
+	ThrottledUntil *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=throttled_until,json=throttledUntil,proto3" json:"throttled_until,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetOrderbookMovementResponse) GetStartPrice() float64 {
-	if x != nil {
-		return x.StartPrice
-	}
-	return 0
+func (x *GetRateLimitUsageResponse) Reset() {
+	*x = GetRateLimitUsageResponse{}
+	mi := &file_rpc_proto_msgTypes[243]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrderbookMovementResponse) GetEndPrice() float64 {
+func (x *GetRateLimitUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRateLimitUsageResponse) ProtoMessage() {}
+
+func (x *GetRateLimitUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[243]
 	if x != nil {
-		return x.EndPrice
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *GetOrderbookMovementResponse) GetNoSlippageOccurred() bool {
+// Deprecated: Use GetRateLimitUsageResponse.ProtoReflect.Descriptor instead.
+func (*GetRateLimitUsageResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{243}
+}
+
+func (x *GetRateLimitUsageResponse) GetUsage() []*RateLimitUsage {
 	if x != nil {
-		return x.NoSlippageOccurred
+		return x.Usage
 	}
-	return false
+	return nil
 }
 
-func (x *GetOrderbookMovementResponse) GetAverageOrderCost() float64 {
+func (x *GetRateLimitUsageResponse) GetThrottledUntil() *timestamppb.Timestamp {
 	if x != nil {
-		return x.AverageOrderCost
+		return x.ThrottledUntil
 	}
-	return 0
+	return nil
 }
 
-type GetOrderbookAmountByNominalRequest struct {
-	state                 protoimpl.MessageState `protogen:"open.v1"`
-	Exchange              string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset                 string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair                  *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	NominalPercentage     float64                `protobuf:"fixed64,6,opt,name=nominal_percentage,json=nominalPercentage,proto3" json:"nominal_percentage,omitempty"`
-	Sell                  bool                   `protobuf:"varint,7,opt,name=sell,proto3" json:"sell,omitempty"`
-	RequiresRestOrderbook bool                   `protobuf:"varint,8,opt,name=requires_rest_orderbook,json=requiresRestOrderbook,proto3" json:"requires_rest_orderbook,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+type GetMicrostructureStreamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType     string                 `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOrderbookAmountByNominalRequest) Reset() {
-	*x = GetOrderbookAmountByNominalRequest{}
-	mi := &file_rpc_proto_msgTypes[216]
+func (x *GetMicrostructureStreamRequest) Reset() {
+	*x = GetMicrostructureStreamRequest{}
+	mi := &file_rpc_proto_msgTypes[244]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrderbookAmountByNominalRequest) String() string {
+func (x *GetMicrostructureStreamRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrderbookAmountByNominalRequest) ProtoMessage() {}
+func (*GetMicrostructureStreamRequest) ProtoMessage() {}
 
-func (x *GetOrderbookAmountByNominalRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[216]
+func (x *GetMicrostructureStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[244]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14396,85 +16513,125 @@ func (x *GetOrderbookAmountByNominalRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrderbookAmountByNominalRequest.ProtoReflect.Descriptor instead.
-func (*GetOrderbookAmountByNominalRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{216}
+// Deprecated: Use GetMicrostructureStreamRequest.ProtoReflect.Descriptor instead.
+func (*GetMicrostructureStreamRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{244}
 }
 
-func (x *GetOrderbookAmountByNominalRequest) GetExchange() string {
+func (x *GetMicrostructureStreamRequest) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *GetOrderbookAmountByNominalRequest) GetAsset() string {
+func (x *GetMicrostructureStreamRequest) GetPair() *CurrencyPair {
 	if x != nil {
-		return x.Asset
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *GetMicrostructureStreamRequest) GetAssetType() string {
+	if x != nil {
+		return x.AssetType
 	}
 	return ""
 }
 
-func (x *GetOrderbookAmountByNominalRequest) GetPair() *CurrencyPair {
+type DepthAtBasisPoints struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BasisPoints   float64                `protobuf:"fixed64,1,opt,name=basis_points,json=basisPoints,proto3" json:"basis_points,omitempty"`
+	BidAmount     float64                `protobuf:"fixed64,2,opt,name=bid_amount,json=bidAmount,proto3" json:"bid_amount,omitempty"`
+	AskAmount     float64                `protobuf:"fixed64,3,opt,name=ask_amount,json=askAmount,proto3" json:"ask_amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DepthAtBasisPoints) Reset() {
+	*x = DepthAtBasisPoints{}
+	mi := &file_rpc_proto_msgTypes[245]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DepthAtBasisPoints) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepthAtBasisPoints) ProtoMessage() {}
+
+func (x *DepthAtBasisPoints) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[245]
 	if x != nil {
-		return x.Pair
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepthAtBasisPoints.ProtoReflect.Descriptor instead.
+func (*DepthAtBasisPoints) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{245}
 }
 
-func (x *GetOrderbookAmountByNominalRequest) GetNominalPercentage() float64 {
+func (x *DepthAtBasisPoints) GetBasisPoints() float64 {
 	if x != nil {
-		return x.NominalPercentage
+		return x.BasisPoints
 	}
 	return 0
 }
 
-func (x *GetOrderbookAmountByNominalRequest) GetSell() bool {
+func (x *DepthAtBasisPoints) GetBidAmount() float64 {
 	if x != nil {
-		return x.Sell
+		return x.BidAmount
 	}
-	return false
+	return 0
 }
 
-func (x *GetOrderbookAmountByNominalRequest) GetRequiresRestOrderbook() bool {
+func (x *DepthAtBasisPoints) GetAskAmount() float64 {
 	if x != nil {
-		return x.RequiresRestOrderbook
+		return x.AskAmount
 	}
-	return false
+	return 0
 }
 
-type GetOrderbookAmountByNominalResponse struct {
-	state                                protoimpl.MessageState `protogen:"open.v1"`
-	AmountRequired                       float64                `protobuf:"fixed64,1,opt,name=amount_required,json=amountRequired,proto3" json:"amount_required,omitempty"`
-	CurrencySelling                      string                 `protobuf:"bytes,2,opt,name=currency_selling,json=currencySelling,proto3" json:"currency_selling,omitempty"`
-	AmountReceived                       float64                `protobuf:"fixed64,3,opt,name=amount_received,json=amountReceived,proto3" json:"amount_received,omitempty"`
-	CurrencyBuying                       string                 `protobuf:"bytes,4,opt,name=currency_buying,json=currencyBuying,proto3" json:"currency_buying,omitempty"`
-	StartPrice                           float64                `protobuf:"fixed64,5,opt,name=start_price,json=startPrice,proto3" json:"start_price,omitempty"`
-	EndPrice                             float64                `protobuf:"fixed64,6,opt,name=end_price,json=endPrice,proto3" json:"end_price,omitempty"`
-	AverageOrderCost                     float64                `protobuf:"fixed64,7,opt,name=average_order_cost,json=averageOrderCost,proto3" json:"average_order_cost,omitempty"`
-	SideAffected                         string                 `protobuf:"bytes,8,opt,name=side_affected,json=sideAffected,proto3" json:"side_affected,omitempty"`
-	ApproximateNominalSlippagePercentage float64                `protobuf:"fixed64,9,opt,name=approximate_nominal_slippage_percentage,json=approximateNominalSlippagePercentage,proto3" json:"approximate_nominal_slippage_percentage,omitempty"`
-	UpdateProtocol                       string                 `protobuf:"bytes,10,opt,name=update_protocol,json=updateProtocol,proto3" json:"update_protocol,omitempty"`
-	FullOrderbookSideConsumed            bool                   `protobuf:"varint,11,opt,name=full_orderbook_side_consumed,json=fullOrderbookSideConsumed,proto3" json:"full_orderbook_side_consumed,omitempty"`
-	unknownFields                        protoimpl.UnknownFields
-	sizeCache                            protoimpl.SizeCache
+type MicrostructureResponse struct {
+	state                        protoimpl.MessageState `protogen:"open.v1"`
+	Exchange                     string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                         *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	Asset                        string                 `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
+	UpdateTime                   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	MidPrice                     float64                `protobuf:"fixed64,5,opt,name=mid_price,json=midPrice,proto3" json:"mid_price,omitempty"`
+	Microprice                   float64                `protobuf:"fixed64,6,opt,name=microprice,proto3" json:"microprice,omitempty"`
+	OrderFlowImbalance           float64                `protobuf:"fixed64,7,opt,name=order_flow_imbalance,json=orderFlowImbalance,proto3" json:"order_flow_imbalance,omitempty"`
+	CumulativeOrderFlowImbalance float64                `protobuf:"fixed64,8,opt,name=cumulative_order_flow_imbalance,json=cumulativeOrderFlowImbalance,proto3" json:"cumulative_order_flow_imbalance,omitempty"`
+	Depth                        []*DepthAtBasisPoints  `protobuf:"bytes,9,rep,name=depth,proto3" json:"depth,omitempty"`
+	ResilienceMicros             int64                  `protobuf:"varint,10,opt,name=resilience_micros,json=resilienceMicros,proto3" json:"resilience_micros,omitempty"`
+	Recovering                   bool                   `protobuf:"varint,11,opt,name=recovering,proto3" json:"recovering,omitempty"`
+	RealisedSpreadBps            float64                `protobuf:"fixed64,12,opt,name=realised_spread_bps,json=realisedSpreadBps,proto3" json:"realised_spread_bps,omitempty"`
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
 }
 
-func (x *GetOrderbookAmountByNominalResponse) Reset() {
-	*x = GetOrderbookAmountByNominalResponse{}
-	mi := &file_rpc_proto_msgTypes[217]
+func (x *MicrostructureResponse) Reset() {
+	*x = MicrostructureResponse{}
+	mi := &file_rpc_proto_msgTypes[246]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrderbookAmountByNominalResponse) String() string {
+func (x *MicrostructureResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrderbookAmountByNominalResponse) ProtoMessage() {}
+func (*MicrostructureResponse) ProtoMessage() {}
 
-func (x *GetOrderbookAmountByNominalResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[217]
+func (x *MicrostructureResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[246]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14485,115 +16642,116 @@ func (x *GetOrderbookAmountByNominalResponse) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrderbookAmountByNominalResponse.ProtoReflect.Descriptor instead.
-func (*GetOrderbookAmountByNominalResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{217}
+// Deprecated: Use MicrostructureResponse.ProtoReflect.Descriptor instead.
+func (*MicrostructureResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{246}
 }
 
-func (x *GetOrderbookAmountByNominalResponse) GetAmountRequired() float64 {
+func (x *MicrostructureResponse) GetExchange() string {
 	if x != nil {
-		return x.AmountRequired
+		return x.Exchange
 	}
-	return 0
+	return ""
 }
 
-func (x *GetOrderbookAmountByNominalResponse) GetCurrencySelling() string {
+func (x *MicrostructureResponse) GetPair() *CurrencyPair {
 	if x != nil {
-		return x.CurrencySelling
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *MicrostructureResponse) GetAsset() string {
+	if x != nil {
+		return x.Asset
 	}
 	return ""
 }
 
-func (x *GetOrderbookAmountByNominalResponse) GetAmountReceived() float64 {
+func (x *MicrostructureResponse) GetUpdateTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.AmountReceived
+		return x.UpdateTime
 	}
-	return 0
+	return nil
 }
 
-func (x *GetOrderbookAmountByNominalResponse) GetCurrencyBuying() string {
+func (x *MicrostructureResponse) GetMidPrice() float64 {
 	if x != nil {
-		return x.CurrencyBuying
+		return x.MidPrice
 	}
-	return ""
+	return 0
 }
 
-func (x *GetOrderbookAmountByNominalResponse) GetStartPrice() float64 {
+func (x *MicrostructureResponse) GetMicroprice() float64 {
 	if x != nil {
-		return x.StartPrice
+		return x.Microprice
 	}
 	return 0
 }
 
-func (x *GetOrderbookAmountByNominalResponse) GetEndPrice() float64 {
+func (x *MicrostructureResponse) GetOrderFlowImbalance() float64 {
 	if x != nil {
-		return x.EndPrice
+		return x.OrderFlowImbalance
 	}
 	return 0
 }
 
-func (x *GetOrderbookAmountByNominalResponse) GetAverageOrderCost() float64 {
+func (x *MicrostructureResponse) GetCumulativeOrderFlowImbalance() float64 {
 	if x != nil {
-		return x.AverageOrderCost
+		return x.CumulativeOrderFlowImbalance
 	}
 	return 0
 }
 
-func (x *GetOrderbookAmountByNominalResponse) GetSideAffected() string {
+func (x *MicrostructureResponse) GetDepth() []*DepthAtBasisPoints {
 	if x != nil {
-		return x.SideAffected
+		return x.Depth
 	}
-	return ""
+	return nil
 }
 
-func (x *GetOrderbookAmountByNominalResponse) GetApproximateNominalSlippagePercentage() float64 {
+func (x *MicrostructureResponse) GetResilienceMicros() int64 {
 	if x != nil {
-		return x.ApproximateNominalSlippagePercentage
+		return x.ResilienceMicros
 	}
 	return 0
 }
 
-func (x *GetOrderbookAmountByNominalResponse) GetUpdateProtocol() string {
+func (x *MicrostructureResponse) GetRecovering() bool {
 	if x != nil {
-		return x.UpdateProtocol
+		return x.Recovering
 	}
-	return ""
+	return false
 }
 
-func (x *GetOrderbookAmountByNominalResponse) GetFullOrderbookSideConsumed() bool {
+func (x *MicrostructureResponse) GetRealisedSpreadBps() float64 {
 	if x != nil {
-		return x.FullOrderbookSideConsumed
+		return x.RealisedSpreadBps
 	}
-	return false
+	return 0
 }
 
-type GetOrderbookAmountByImpactRequest struct {
-	state                 protoimpl.MessageState `protogen:"open.v1"`
-	Exchange              string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset                 string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair                  *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	ImpactPercentage      float64                `protobuf:"fixed64,6,opt,name=impact_percentage,json=impactPercentage,proto3" json:"impact_percentage,omitempty"`
-	Sell                  bool                   `protobuf:"varint,7,opt,name=sell,proto3" json:"sell,omitempty"`
-	RequiresRestOrderbook bool                   `protobuf:"varint,8,opt,name=requires_rest_orderbook,json=requiresRestOrderbook,proto3" json:"requires_rest_orderbook,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+type ReloadConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOrderbookAmountByImpactRequest) Reset() {
-	*x = GetOrderbookAmountByImpactRequest{}
-	mi := &file_rpc_proto_msgTypes[218]
+func (x *ReloadConfigRequest) Reset() {
+	*x = ReloadConfigRequest{}
+	mi := &file_rpc_proto_msgTypes[247]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrderbookAmountByImpactRequest) String() string {
+func (x *ReloadConfigRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrderbookAmountByImpactRequest) ProtoMessage() {}
+func (*ReloadConfigRequest) ProtoMessage() {}
 
-func (x *GetOrderbookAmountByImpactRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[218]
+func (x *ReloadConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[247]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14604,85 +16762,105 @@ func (x *GetOrderbookAmountByImpactRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrderbookAmountByImpactRequest.ProtoReflect.Descriptor instead.
-func (*GetOrderbookAmountByImpactRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{218}
+// Deprecated: Use ReloadConfigRequest.ProtoReflect.Descriptor instead.
+func (*ReloadConfigRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{247}
 }
 
-func (x *GetOrderbookAmountByImpactRequest) GetExchange() string {
-	if x != nil {
-		return x.Exchange
-	}
-	return ""
+type ReloadConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchanges     []string               `protobuf:"bytes,1,rep,name=exchanges,proto3" json:"exchanges,omitempty"`
+	Subsystems    []string               `protobuf:"bytes,2,rep,name=subsystems,proto3" json:"subsystems,omitempty"`
+	Ignored       []string               `protobuf:"bytes,3,rep,name=ignored,proto3" json:"ignored,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOrderbookAmountByImpactRequest) GetAsset() string {
-	if x != nil {
-		return x.Asset
-	}
-	return ""
+func (x *ReloadConfigResponse) Reset() {
+	*x = ReloadConfigResponse{}
+	mi := &file_rpc_proto_msgTypes[248]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrderbookAmountByImpactRequest) GetPair() *CurrencyPair {
+func (x *ReloadConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReloadConfigResponse) ProtoMessage() {}
+
+func (x *ReloadConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[248]
 	if x != nil {
-		return x.Pair
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *GetOrderbookAmountByImpactRequest) GetImpactPercentage() float64 {
+// Deprecated: Use ReloadConfigResponse.ProtoReflect.Descriptor instead.
+func (*ReloadConfigResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{248}
+}
+
+func (x *ReloadConfigResponse) GetExchanges() []string {
 	if x != nil {
-		return x.ImpactPercentage
+		return x.Exchanges
 	}
-	return 0
+	return nil
 }
 
-func (x *GetOrderbookAmountByImpactRequest) GetSell() bool {
+func (x *ReloadConfigResponse) GetSubsystems() []string {
 	if x != nil {
-		return x.Sell
+		return x.Subsystems
 	}
-	return false
+	return nil
 }
 
-func (x *GetOrderbookAmountByImpactRequest) GetRequiresRestOrderbook() bool {
+func (x *ReloadConfigResponse) GetIgnored() []string {
 	if x != nil {
-		return x.RequiresRestOrderbook
+		return x.Ignored
 	}
-	return false
+	return nil
 }
 
-type GetOrderbookAmountByImpactResponse struct {
-	state                               protoimpl.MessageState `protogen:"open.v1"`
-	AmountRequired                      float64                `protobuf:"fixed64,1,opt,name=amount_required,json=amountRequired,proto3" json:"amount_required,omitempty"`
-	CurrencySelling                     string                 `protobuf:"bytes,2,opt,name=currency_selling,json=currencySelling,proto3" json:"currency_selling,omitempty"`
-	AmountReceived                      float64                `protobuf:"fixed64,3,opt,name=amount_received,json=amountReceived,proto3" json:"amount_received,omitempty"`
-	CurrencyBuying                      string                 `protobuf:"bytes,4,opt,name=currency_buying,json=currencyBuying,proto3" json:"currency_buying,omitempty"`
-	StartPrice                          float64                `protobuf:"fixed64,5,opt,name=start_price,json=startPrice,proto3" json:"start_price,omitempty"`
-	EndPrice                            float64                `protobuf:"fixed64,6,opt,name=end_price,json=endPrice,proto3" json:"end_price,omitempty"`
-	AverageOrderCost                    float64                `protobuf:"fixed64,7,opt,name=average_order_cost,json=averageOrderCost,proto3" json:"average_order_cost,omitempty"`
-	SideAffected                        string                 `protobuf:"bytes,8,opt,name=side_affected,json=sideAffected,proto3" json:"side_affected,omitempty"`
-	ApproximateImpactSlippagePercentage float64                `protobuf:"fixed64,9,opt,name=approximate_impact_slippage_percentage,json=approximateImpactSlippagePercentage,proto3" json:"approximate_impact_slippage_percentage,omitempty"`
-	UpdateProtocol                      string                 `protobuf:"bytes,10,opt,name=update_protocol,json=updateProtocol,proto3" json:"update_protocol,omitempty"`
-	FullOrderbookSideConsumed           bool                   `protobuf:"varint,11,opt,name=full_orderbook_side_consumed,json=fullOrderbookSideConsumed,proto3" json:"full_orderbook_side_consumed,omitempty"`
-	unknownFields                       protoimpl.UnknownFields
-	sizeCache                           protoimpl.SizeCache
+type TreasuryTransfer struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Currency         string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	From             string                 `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To               string                 `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	Chain            string                 `protobuf:"bytes,5,opt,name=chain,proto3" json:"chain,omitempty"`
+	Address          string                 `protobuf:"bytes,6,opt,name=address,proto3" json:"address,omitempty"`
+	Amount           float64                `protobuf:"fixed64,7,opt,name=amount,proto3" json:"amount,omitempty"`
+	Fee              float64                `protobuf:"fixed64,8,opt,name=fee,proto3" json:"fee,omitempty"`
+	Status           string                 `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+	WithdrawalStatus string                 `protobuf:"bytes,10,opt,name=withdrawal_status,json=withdrawalStatus,proto3" json:"withdrawal_status,omitempty"`
+	Error            string                 `protobuf:"bytes,11,opt,name=error,proto3" json:"error,omitempty"`
+	SubmittedAt      *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at,omitempty"`
+	SettledAt        *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=settled_at,json=settledAt,proto3" json:"settled_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *GetOrderbookAmountByImpactResponse) Reset() {
-	*x = GetOrderbookAmountByImpactResponse{}
-	mi := &file_rpc_proto_msgTypes[219]
+func (x *TreasuryTransfer) Reset() {
+	*x = TreasuryTransfer{}
+	mi := &file_rpc_proto_msgTypes[249]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrderbookAmountByImpactResponse) String() string {
+func (x *TreasuryTransfer) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrderbookAmountByImpactResponse) ProtoMessage() {}
+func (*TreasuryTransfer) ProtoMessage() {}
 
-func (x *GetOrderbookAmountByImpactResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[219]
+func (x *TreasuryTransfer) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[249]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14693,111 +16871,160 @@ func (x *GetOrderbookAmountByImpactResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrderbookAmountByImpactResponse.ProtoReflect.Descriptor instead.
-func (*GetOrderbookAmountByImpactResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{219}
+// Deprecated: Use TreasuryTransfer.ProtoReflect.Descriptor instead.
+func (*TreasuryTransfer) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{249}
 }
 
-func (x *GetOrderbookAmountByImpactResponse) GetAmountRequired() float64 {
+func (x *TreasuryTransfer) GetId() string {
 	if x != nil {
-		return x.AmountRequired
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-func (x *GetOrderbookAmountByImpactResponse) GetCurrencySelling() string {
+func (x *TreasuryTransfer) GetCurrency() string {
 	if x != nil {
-		return x.CurrencySelling
+		return x.Currency
 	}
 	return ""
 }
 
-func (x *GetOrderbookAmountByImpactResponse) GetAmountReceived() float64 {
+func (x *TreasuryTransfer) GetFrom() string {
 	if x != nil {
-		return x.AmountReceived
+		return x.From
 	}
-	return 0
+	return ""
 }
 
-func (x *GetOrderbookAmountByImpactResponse) GetCurrencyBuying() string {
+func (x *TreasuryTransfer) GetTo() string {
 	if x != nil {
-		return x.CurrencyBuying
+		return x.To
 	}
 	return ""
 }
 
-func (x *GetOrderbookAmountByImpactResponse) GetStartPrice() float64 {
+func (x *TreasuryTransfer) GetChain() string {
 	if x != nil {
-		return x.StartPrice
+		return x.Chain
 	}
-	return 0
+	return ""
 }
 
-func (x *GetOrderbookAmountByImpactResponse) GetEndPrice() float64 {
+func (x *TreasuryTransfer) GetAddress() string {
 	if x != nil {
-		return x.EndPrice
+		return x.Address
+	}
+	return ""
+}
+
+func (x *TreasuryTransfer) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
 	}
 	return 0
 }
 
-func (x *GetOrderbookAmountByImpactResponse) GetAverageOrderCost() float64 {
+func (x *TreasuryTransfer) GetFee() float64 {
 	if x != nil {
-		return x.AverageOrderCost
+		return x.Fee
 	}
 	return 0
 }
 
-func (x *GetOrderbookAmountByImpactResponse) GetSideAffected() string {
+func (x *TreasuryTransfer) GetStatus() string {
 	if x != nil {
-		return x.SideAffected
+		return x.Status
 	}
 	return ""
 }
 
-func (x *GetOrderbookAmountByImpactResponse) GetApproximateImpactSlippagePercentage() float64 {
+func (x *TreasuryTransfer) GetWithdrawalStatus() string {
 	if x != nil {
-		return x.ApproximateImpactSlippagePercentage
+		return x.WithdrawalStatus
 	}
-	return 0
+	return ""
 }
 
-func (x *GetOrderbookAmountByImpactResponse) GetUpdateProtocol() string {
+func (x *TreasuryTransfer) GetError() string {
 	if x != nil {
-		return x.UpdateProtocol
+		return x.Error
 	}
 	return ""
 }
 
-func (x *GetOrderbookAmountByImpactResponse) GetFullOrderbookSideConsumed() bool {
+func (x *TreasuryTransfer) GetSubmittedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.FullOrderbookSideConsumed
+		return x.SubmittedAt
 	}
-	return false
+	return nil
 }
 
-type GetOpenInterestRequest struct {
-	state         protoimpl.MessageState     `protogen:"open.v1"`
-	Exchange      string                     `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Data          []*OpenInterestDataRequest `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+func (x *TreasuryTransfer) GetSettledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SettledAt
+	}
+	return nil
+}
+
+type GetTreasuryTransfersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTreasuryTransfersRequest) Reset() {
+	*x = GetTreasuryTransfersRequest{}
+	mi := &file_rpc_proto_msgTypes[250]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTreasuryTransfersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTreasuryTransfersRequest) ProtoMessage() {}
+
+func (x *GetTreasuryTransfersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[250]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTreasuryTransfersRequest.ProtoReflect.Descriptor instead.
+func (*GetTreasuryTransfersRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{250}
+}
+
+type RebalanceTreasuryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlanOnly      bool                   `protobuf:"varint,1,opt,name=plan_only,json=planOnly,proto3" json:"plan_only,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOpenInterestRequest) Reset() {
-	*x = GetOpenInterestRequest{}
-	mi := &file_rpc_proto_msgTypes[220]
+func (x *RebalanceTreasuryRequest) Reset() {
+	*x = RebalanceTreasuryRequest{}
+	mi := &file_rpc_proto_msgTypes[251]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOpenInterestRequest) String() string {
+func (x *RebalanceTreasuryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOpenInterestRequest) ProtoMessage() {}
+func (*RebalanceTreasuryRequest) ProtoMessage() {}
 
-func (x *GetOpenInterestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[220]
+func (x *RebalanceTreasuryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[251]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14808,48 +17035,40 @@ func (x *GetOpenInterestRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOpenInterestRequest.ProtoReflect.Descriptor instead.
-func (*GetOpenInterestRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{220}
-}
-
-func (x *GetOpenInterestRequest) GetExchange() string {
-	if x != nil {
-		return x.Exchange
-	}
-	return ""
+// Deprecated: Use RebalanceTreasuryRequest.ProtoReflect.Descriptor instead.
+func (*RebalanceTreasuryRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{251}
 }
 
-func (x *GetOpenInterestRequest) GetData() []*OpenInterestDataRequest {
+func (x *RebalanceTreasuryRequest) GetPlanOnly() bool {
 	if x != nil {
-		return x.Data
+		return x.PlanOnly
 	}
-	return nil
+	return false
 }
 
-type OpenInterestDataRequest struct {
+type TreasuryTransfersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Asset         string                 `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair          *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	Transfers     []*TreasuryTransfer    `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OpenInterestDataRequest) Reset() {
-	*x = OpenInterestDataRequest{}
-	mi := &file_rpc_proto_msgTypes[221]
+func (x *TreasuryTransfersResponse) Reset() {
+	*x = TreasuryTransfersResponse{}
+	mi := &file_rpc_proto_msgTypes[252]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OpenInterestDataRequest) String() string {
+func (x *TreasuryTransfersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OpenInterestDataRequest) ProtoMessage() {}
+func (*TreasuryTransfersResponse) ProtoMessage() {}
 
-func (x *OpenInterestDataRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[221]
+func (x *TreasuryTransfersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[252]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14860,47 +17079,43 @@ func (x *OpenInterestDataRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OpenInterestDataRequest.ProtoReflect.Descriptor instead.
-func (*OpenInterestDataRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{221}
-}
-
-func (x *OpenInterestDataRequest) GetAsset() string {
-	if x != nil {
-		return x.Asset
-	}
-	return ""
+// Deprecated: Use TreasuryTransfersResponse.ProtoReflect.Descriptor instead.
+func (*TreasuryTransfersResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{252}
 }
 
-func (x *OpenInterestDataRequest) GetPair() *CurrencyPair {
+func (x *TreasuryTransfersResponse) GetTransfers() []*TreasuryTransfer {
 	if x != nil {
-		return x.Pair
+		return x.Transfers
 	}
 	return nil
 }
 
-type GetOpenInterestResponse struct {
-	state         protoimpl.MessageState      `protogen:"open.v1"`
-	Data          []*OpenInterestDataResponse `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+type FundingTransition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Time          *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=time,proto3" json:"time,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOpenInterestResponse) Reset() {
-	*x = GetOpenInterestResponse{}
-	mi := &file_rpc_proto_msgTypes[222]
+func (x *FundingTransition) Reset() {
+	*x = FundingTransition{}
+	mi := &file_rpc_proto_msgTypes[253]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOpenInterestResponse) String() string {
+func (x *FundingTransition) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOpenInterestResponse) ProtoMessage() {}
+func (*FundingTransition) ProtoMessage() {}
 
-func (x *GetOpenInterestResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[222]
+func (x *FundingTransition) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[253]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14911,43 +17126,76 @@ func (x *GetOpenInterestResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOpenInterestResponse.ProtoReflect.Descriptor instead.
-func (*GetOpenInterestResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{222}
+// Deprecated: Use FundingTransition.ProtoReflect.Descriptor instead.
+func (*FundingTransition) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{253}
 }
 
-func (x *GetOpenInterestResponse) GetData() []*OpenInterestDataResponse {
+func (x *FundingTransition) GetFrom() string {
 	if x != nil {
-		return x.Data
+		return x.From
+	}
+	return ""
+}
+
+func (x *FundingTransition) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *FundingTransition) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *FundingTransition) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
 	}
 	return nil
 }
 
-type OpenInterestDataResponse struct {
+type FundingTransfer struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	OpenInterest  float64                `protobuf:"fixed64,4,opt,name=open_interest,json=openInterest,proto3" json:"open_interest,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Currency      string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	Amount        float64                `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Fee           float64                `protobuf:"fixed64,6,opt,name=fee,proto3" json:"fee,omitempty"`
+	Chain         string                 `protobuf:"bytes,7,opt,name=chain,proto3" json:"chain,omitempty"`
+	Address       string                 `protobuf:"bytes,8,opt,name=address,proto3" json:"address,omitempty"`
+	TxId          string                 `protobuf:"bytes,9,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	Status        string                 `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`
+	State         string                 `protobuf:"bytes,11,opt,name=state,proto3" json:"state,omitempty"`
+	Stuck         bool                   `protobuf:"varint,12,opt,name=stuck,proto3" json:"stuck,omitempty"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	FirstSeen     *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=first_seen,json=firstSeen,proto3" json:"first_seen,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Transitions   []*FundingTransition   `protobuf:"bytes,16,rep,name=transitions,proto3" json:"transitions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OpenInterestDataResponse) Reset() {
-	*x = OpenInterestDataResponse{}
-	mi := &file_rpc_proto_msgTypes[223]
+func (x *FundingTransfer) Reset() {
+	*x = FundingTransfer{}
+	mi := &file_rpc_proto_msgTypes[254]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OpenInterestDataResponse) String() string {
+func (x *FundingTransfer) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OpenInterestDataResponse) ProtoMessage() {}
+func (*FundingTransfer) ProtoMessage() {}
 
-func (x *OpenInterestDataResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[223]
+func (x *FundingTransfer) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[254]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14958,63 +17206,146 @@ func (x *OpenInterestDataResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OpenInterestDataResponse.ProtoReflect.Descriptor instead.
-func (*OpenInterestDataResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{223}
+// Deprecated: Use FundingTransfer.ProtoReflect.Descriptor instead.
+func (*FundingTransfer) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{254}
 }
 
-func (x *OpenInterestDataResponse) GetExchange() string {
+func (x *FundingTransfer) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *OpenInterestDataResponse) GetAsset() string {
+func (x *FundingTransfer) GetId() string {
 	if x != nil {
-		return x.Asset
+		return x.Id
 	}
 	return ""
 }
 
-func (x *OpenInterestDataResponse) GetPair() *CurrencyPair {
+func (x *FundingTransfer) GetType() string {
 	if x != nil {
-		return x.Pair
+		return x.Type
 	}
-	return nil
+	return ""
 }
 
-func (x *OpenInterestDataResponse) GetOpenInterest() float64 {
+func (x *FundingTransfer) GetCurrency() string {
 	if x != nil {
-		return x.OpenInterest
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *FundingTransfer) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
 	}
 	return 0
 }
 
-type GetCurrencyTradeURLRequest struct {
+func (x *FundingTransfer) GetFee() float64 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
+func (x *FundingTransfer) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *FundingTransfer) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *FundingTransfer) GetTxId() string {
+	if x != nil {
+		return x.TxId
+	}
+	return ""
+}
+
+func (x *FundingTransfer) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *FundingTransfer) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *FundingTransfer) GetStuck() bool {
+	if x != nil {
+		return x.Stuck
+	}
+	return false
+}
+
+func (x *FundingTransfer) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *FundingTransfer) GetFirstSeen() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FirstSeen
+	}
+	return nil
+}
+
+func (x *FundingTransfer) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *FundingTransfer) GetTransitions() []*FundingTransition {
+	if x != nil {
+		return x.Transitions
+	}
+	return nil
+}
+
+type GetFundingTransfersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	ActiveOnly    bool                   `protobuf:"varint,2,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCurrencyTradeURLRequest) Reset() {
-	*x = GetCurrencyTradeURLRequest{}
-	mi := &file_rpc_proto_msgTypes[224]
+func (x *GetFundingTransfersRequest) Reset() {
+	*x = GetFundingTransfersRequest{}
+	mi := &file_rpc_proto_msgTypes[255]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCurrencyTradeURLRequest) String() string {
+func (x *GetFundingTransfersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCurrencyTradeURLRequest) ProtoMessage() {}
+func (*GetFundingTransfersRequest) ProtoMessage() {}
 
-func (x *GetCurrencyTradeURLRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[224]
+func (x *GetFundingTransfersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[255]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -15025,54 +17356,47 @@ func (x *GetCurrencyTradeURLRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCurrencyTradeURLRequest.ProtoReflect.Descriptor instead.
-func (*GetCurrencyTradeURLRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{224}
+// Deprecated: Use GetFundingTransfersRequest.ProtoReflect.Descriptor instead.
+func (*GetFundingTransfersRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{255}
 }
 
-func (x *GetCurrencyTradeURLRequest) GetExchange() string {
+func (x *GetFundingTransfersRequest) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *GetCurrencyTradeURLRequest) GetAsset() string {
-	if x != nil {
-		return x.Asset
-	}
-	return ""
-}
-
-func (x *GetCurrencyTradeURLRequest) GetPair() *CurrencyPair {
+func (x *GetFundingTransfersRequest) GetActiveOnly() bool {
 	if x != nil {
-		return x.Pair
+		return x.ActiveOnly
 	}
-	return nil
+	return false
 }
 
-type GetCurrencyTradeURLResponse struct {
+type GetFundingTransfersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Transfers     []*FundingTransfer     `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCurrencyTradeURLResponse) Reset() {
-	*x = GetCurrencyTradeURLResponse{}
-	mi := &file_rpc_proto_msgTypes[225]
+func (x *GetFundingTransfersResponse) Reset() {
+	*x = GetFundingTransfersResponse{}
+	mi := &file_rpc_proto_msgTypes[256]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCurrencyTradeURLResponse) String() string {
+func (x *GetFundingTransfersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCurrencyTradeURLResponse) ProtoMessage() {}
+func (*GetFundingTransfersResponse) ProtoMessage() {}
 
-func (x *GetCurrencyTradeURLResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[225]
+func (x *GetFundingTransfersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[256]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -15083,16 +17407,16 @@ func (x *GetCurrencyTradeURLResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCurrencyTradeURLResponse.ProtoReflect.Descriptor instead.
-func (*GetCurrencyTradeURLResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{225}
+// Deprecated: Use GetFundingTransfersResponse.ProtoReflect.Descriptor instead.
+func (*GetFundingTransfersResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{256}
 }
 
-func (x *GetCurrencyTradeURLResponse) GetUrl() string {
+func (x *GetFundingTransfersResponse) GetTransfers() []*FundingTransfer {
 	if x != nil {
-		return x.Url
+		return x.Transfers
 	}
-	return ""
+	return nil
 }
 
 var File_rpc_proto protoreflect.FileDescriptor
@@ -15231,16 +17555,17 @@ const file_rpc_proto_rawDesc = "" +
 	"\x15GetOrderbooksResponse\x122\n" +
 	"\n" +
 	"orderbooks\x18\x01 \x03(\v2\x12.gctrpc.OrderbooksR\n" +
-	"orderbooks\"V\n" +
+	"orderbooks\"\x85\x01\n" +
 	"\x19GetAccountBalancesRequest\x12\x1a\n" +
 	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x1d\n" +
 	"\n" +
-	"asset_type\x18\x02 \x01(\tR\tassetType\"V\n" +
+	"asset_type\x18\x02 \x01(\tR\tassetType\x12-\n" +
+	"\x12valuation_currency\x18\x03 \x01(\tR\x11valuationCurrency\"V\n" +
 	"\aAccount\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12;\n" +
 	"\n" +
 	"currencies\x18\x02 \x03(\v2\x1b.gctrpc.AccountCurrencyInfoR\n" +
-	"currencies\"\x81\x02\n" +
+	"currencies\"\xd7\x02\n" +
 	"\x13AccountCurrencyInfo\x12\x1a\n" +
 	"\bcurrency\x18\x01 \x01(\tR\bcurrency\x12\x1f\n" +
 	"\vtotal_value\x18\x02 \x01(\x01R\n" +
@@ -15250,10 +17575,25 @@ const file_rpc_proto_rawDesc = "" +
 	"\x13free_without_borrow\x18\x05 \x01(\x01R\x11freeWithoutBorrow\x12\x1a\n" +
 	"\bborrowed\x18\x06 \x01(\x01R\bborrowed\x129\n" +
 	"\n" +
-	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"e\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x14\n" +
+	"\x05value\x18\b \x01(\x01R\x05value\x12>\n" +
+	"\x0fconversion_path\x18\t \x03(\v2\x15.gctrpc.ConversionHopR\x0econversionPath\"\xe2\x01\n" +
+	"\rConversionHop\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\x12\x12\n" +
+	"\x04rate\x18\x03 \x01(\x01R\x04rate\x12\x16\n" +
+	"\x06source\x18\x04 \x01(\tR\x06source\x12\x12\n" +
+	"\x04pair\x18\x05 \x01(\tR\x04pair\x12\x14\n" +
+	"\x05asset\x18\x06 \x01(\tR\x05asset\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1c\n" +
+	"\tstaleness\x18\b \x01(\tR\tstaleness\"\xb5\x01\n" +
 	"\x1aGetAccountBalancesResponse\x12\x1a\n" +
 	"\bexchange\x18\x01 \x01(\tR\bexchange\x12+\n" +
-	"\baccounts\x18\x02 \x03(\v2\x0f.gctrpc.AccountR\baccounts\"\x12\n" +
+	"\baccounts\x18\x02 \x03(\v2\x0f.gctrpc.AccountR\baccounts\x12-\n" +
+	"\x12valuation_currency\x18\x03 \x01(\tR\x11valuationCurrency\x12\x1f\n" +
+	"\vtotal_value\x18\x04 \x01(\x01R\n" +
+	"totalValue\"\x12\n" +
 	"\x10GetConfigRequest\"'\n" +
 	"\x11GetConfigResponse\x12\x12\n" +
 	"\x04data\x18\x01 \x01(\fR\x04data\"\x85\x01\n" +
@@ -15264,15 +17604,18 @@ const file_rpc_proto_rawDesc = "" +
 	"\abalance\x18\x04 \x01(\x01R\abalance\"\x15\n" +
 	"\x13GetPortfolioRequest\"N\n" +
 	"\x14GetPortfolioResponse\x126\n" +
-	"\tportfolio\x18\x01 \x03(\v2\x18.gctrpc.PortfolioAddressR\tportfolio\"\x1c\n" +
-	"\x1aGetPortfolioSummaryRequest\"n\n" +
+	"\tportfolio\x18\x01 \x03(\v2\x18.gctrpc.PortfolioAddressR\tportfolio\"K\n" +
+	"\x1aGetPortfolioSummaryRequest\x12-\n" +
+	"\x12valuation_currency\x18\x01 \x01(\tR\x11valuationCurrency\"\xc4\x01\n" +
 	"\x04Coin\x12\x12\n" +
 	"\x04coin\x18\x01 \x01(\tR\x04coin\x12\x18\n" +
 	"\abalance\x18\x02 \x01(\x01R\abalance\x12\x18\n" +
 	"\aaddress\x18\x03 \x01(\tR\aaddress\x12\x1e\n" +
 	"\n" +
 	"percentage\x18\x04 \x01(\x01R\n" +
-	"percentage\"h\n" +
+	"percentage\x12\x14\n" +
+	"\x05value\x18\x05 \x01(\x01R\x05value\x12>\n" +
+	"\x0fconversion_path\x18\x06 \x03(\v2\x15.gctrpc.ConversionHopR\x0econversionPath\"h\n" +
 	"\x12OfflineCoinSummary\x12\x18\n" +
 	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x18\n" +
 	"\abalance\x18\x02 \x01(\x01R\abalance\x12\x1e\n" +
@@ -15291,27 +17634,31 @@ const file_rpc_proto_rawDesc = "" +
 	"\n" +
 	"CoinsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12/\n" +
-	"\x05value\x18\x02 \x01(\v2\x19.gctrpc.OnlineCoinSummaryR\x05value:\x028\x01\"\xcb\x04\n" +
+	"\x05value\x18\x02 \x01(\v2\x19.gctrpc.OnlineCoinSummaryR\x05value:\x028\x01\"\x9b\x05\n" +
 	"\x1bGetPortfolioSummaryResponse\x12-\n" +
 	"\vcoin_totals\x18\x01 \x03(\v2\f.gctrpc.CoinR\n" +
 	"coinTotals\x121\n" +
 	"\rcoins_offline\x18\x02 \x03(\v2\f.gctrpc.CoinR\fcoinsOffline\x12p\n" +
 	"\x15coins_offline_summary\x18\x03 \x03(\v2<.gctrpc.GetPortfolioSummaryResponse.CoinsOfflineSummaryEntryR\x13coinsOfflineSummary\x12/\n" +
 	"\fcoins_online\x18\x04 \x03(\v2\f.gctrpc.CoinR\vcoinsOnline\x12m\n" +
-	"\x14coins_online_summary\x18\x05 \x03(\v2;.gctrpc.GetPortfolioSummaryResponse.CoinsOnlineSummaryEntryR\x12coinsOnlineSummary\x1a\\\n" +
+	"\x14coins_online_summary\x18\x05 \x03(\v2;.gctrpc.GetPortfolioSummaryResponse.CoinsOnlineSummaryEntryR\x12coinsOnlineSummary\x12-\n" +
+	"\x12valuation_currency\x18\x06 \x01(\tR\x11valuationCurrency\x12\x1f\n" +
+	"\vtotal_value\x18\a \x01(\x01R\n" +
+	"totalValue\x1a\\\n" +
 	"\x18CoinsOfflineSummaryEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12*\n" +
 	"\x05value\x18\x02 \x01(\v2\x14.gctrpc.OfflineCoinsR\x05value:\x028\x01\x1aZ\n" +
 	"\x17CoinsOnlineSummaryEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12)\n" +
-	"\x05value\x18\x02 \x01(\v2\x13.gctrpc.OnlineCoinsR\x05value:\x028\x01\"\xe3\x01\n" +
+	"\x05value\x18\x02 \x01(\v2\x13.gctrpc.OnlineCoinsR\x05value:\x028\x01\"\xf9\x01\n" +
 	"\x1aAddPortfolioAddressRequest\x12\x18\n" +
 	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x1b\n" +
 	"\tcoin_type\x18\x02 \x01(\tR\bcoinType\x12 \n" +
 	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x18\n" +
 	"\abalance\x18\x04 \x01(\x01R\abalance\x12/\n" +
 	"\x13supported_exchanges\x18\x05 \x01(\tR\x12supportedExchanges\x12!\n" +
-	"\fcold_storage\x18\x06 \x01(\bR\vcoldStorage\"x\n" +
+	"\fcold_storage\x18\x06 \x01(\bR\vcoldStorage\x12\x14\n" +
+	"\x05chain\x18\a \x01(\tR\x05chain\"x\n" +
 	"\x1dRemovePortfolioAddressRequest\x12\x18\n" +
 	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x1b\n" +
 	"\tcoin_type\x18\x02 \x01(\tR\bcoinType\x12 \n" +
@@ -15335,7 +17682,7 @@ const file_rpc_proto_rawDesc = "" +
 	"\finverse_rate\x18\x04 \x01(\x01R\vinverseRate\"V\n" +
 	"\x15GetForexRatesResponse\x12=\n" +
 	"\vforex_rates\x18\x01 \x03(\v2\x1c.gctrpc.ForexRatesConversionR\n" +
-	"forexRates\"\xb5\x04\n" +
+	"forexRates\"\xcf\x04\n" +
 	"\fOrderDetails\x12\x1a\n" +
 	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x0e\n" +
 	"\x02id\x18\x02 \x01(\tR\x02id\x12&\n" +
@@ -15360,7 +17707,8 @@ const file_rpc_proto_rawDesc = "" +
 	"\x03fee\x18\x0f \x01(\x01R\x03fee\x12\x12\n" +
 	"\x04cost\x18\x10 \x01(\x01R\x04cost\x12,\n" +
 	"\x06trades\x18\x11 \x03(\v2\x14.gctrpc.TradeHistoryR\x06trades\x12'\n" +
-	"\x0fcontract_amount\x18\x12 \x01(\x01R\x0econtractAmount\"\xf3\x01\n" +
+	"\x0fcontract_amount\x18\x12 \x01(\x01R\x0econtractAmount\x12\x18\n" +
+	"\aaccount\x18\x13 \x01(\tR\aaccount\"\xf3\x01\n" +
 	"\fTradeHistory\x12#\n" +
 	"\rcreation_time\x18\x01 \x01(\x03R\fcreationTime\x12\x0e\n" +
 	"\x02id\x18\x02 \x01(\tR\x02id\x12\x14\n" +
@@ -15516,13 +17864,15 @@ const file_rpc_proto_rawDesc = "" +
 	"\bexchange\x18\x01 \x01(\tR\bexchange\x12&\n" +
 	"\x0ecryptocurrency\x18\x02 \x01(\tR\x0ecryptocurrency\"<\n" +
 	"\"GetAvailableTransferChainsResponse\x12\x16\n" +
-	"\x06chains\x18\x01 \x03(\tR\x06chains\"\xaf\x01\n" +
+	"\x06chains\x18\x01 \x03(\tR\x06chains\"\x80\x02\n" +
 	"\x13WithdrawFiatRequest\x12\x1a\n" +
 	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x1a\n" +
 	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12\x16\n" +
 	"\x06amount\x18\x03 \x01(\x01R\x06amount\x12 \n" +
 	"\vdescription\x18\x04 \x01(\tR\vdescription\x12&\n" +
-	"\x0fbank_account_id\x18\x05 \x01(\tR\rbankAccountId\"\xec\x01\n" +
+	"\x0fbank_account_id\x18\x05 \x01(\tR\rbankAccountId\x12!\n" +
+	"\frequested_by\x18\x06 \x01(\tR\vrequestedBy\x12,\n" +
+	"\x12requested_by_token\x18\a \x01(\tR\x10requestedByToken\"\xbd\x02\n" +
 	"\x15WithdrawCryptoRequest\x12\x1a\n" +
 	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x18\n" +
 	"\aaddress\x18\x02 \x01(\tR\aaddress\x12\x1f\n" +
@@ -15532,7 +17882,10 @@ const file_rpc_proto_rawDesc = "" +
 	"\x06amount\x18\x05 \x01(\x01R\x06amount\x12\x10\n" +
 	"\x03fee\x18\x06 \x01(\x01R\x03fee\x12 \n" +
 	"\vdescription\x18\a \x01(\tR\vdescription\x12\x14\n" +
-	"\x05chain\x18\b \x01(\tR\x05chain\":\n" +
+	"\x05chain\x18\b \x01(\tR\x05chain\x12!\n" +
+	"\frequested_by\x18\t \x01(\tR\vrequestedBy\x12,\n" +
+	"\x12requested_by_token\x18\n" +
+	" \x01(\tR\x10requestedByToken\":\n" +
 	"\x10WithdrawResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
 	"\x06status\x18\x02 \x01(\tR\x06status\",\n" +
@@ -15579,13 +17932,62 @@ const file_rpc_proto_rawDesc = "" +
 	"\x0eaccount_number\x18\x03 \x01(\tR\raccountNumber\x12\x10\n" +
 	"\x03bsb\x18\x04 \x01(\tR\x03bsb\x12\x14\n" +
 	"\x05swift\x18\x05 \x01(\tR\x05swift\x12\x12\n" +
-	"\x04iban\x18\x06 \x01(\tR\x04iban\"y\n" +
+	"\x04iban\x18\x06 \x01(\tR\x04iban\"\x8f\x01\n" +
 	"\x15CryptoWithdrawalEvent\x12\x18\n" +
 	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x1f\n" +
 	"\vaddress_tag\x18\x02 \x01(\tR\n" +
 	"addressTag\x12\x10\n" +
 	"\x03fee\x18\x03 \x01(\x01R\x03fee\x12\x13\n" +
-	"\x05tx_id\x18\x04 \x01(\tR\x04txId\"1\n" +
+	"\x05tx_id\x18\x04 \x01(\tR\x04txId\x12\x14\n" +
+	"\x05chain\x18\x05 \x01(\tR\x05chain\"\xe1\x01\n" +
+	"\x11WithdrawalAddress\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12\x14\n" +
+	"\x05chain\x18\x03 \x01(\tR\x05chain\x12\x18\n" +
+	"\aaddress\x18\x04 \x01(\tR\aaddress\x12\x1f\n" +
+	"\vaddress_tag\x18\x05 \x01(\tR\n" +
+	"addressTag\x12\x14\n" +
+	"\x05label\x18\x06 \x01(\tR\x05label\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xa0\x01\n" +
+	"\x1bAddWithdrawalAddressRequest\x12\x1a\n" +
+	"\bcurrency\x18\x01 \x01(\tR\bcurrency\x12\x14\n" +
+	"\x05chain\x18\x02 \x01(\tR\x05chain\x12\x18\n" +
+	"\aaddress\x18\x03 \x01(\tR\aaddress\x12\x1f\n" +
+	"\vaddress_tag\x18\x04 \x01(\tR\n" +
+	"addressTag\x12\x14\n" +
+	"\x05label\x18\x05 \x01(\tR\x05label\"0\n" +
+	"\x1eRemoveWithdrawalAddressRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\";\n" +
+	"\x1dGetWithdrawalAddressesRequest\x12\x1a\n" +
+	"\bcurrency\x18\x01 \x01(\tR\bcurrency\"Y\n" +
+	"\x1eGetWithdrawalAddressesResponse\x127\n" +
+	"\taddresses\x18\x01 \x03(\v2\x19.gctrpc.WithdrawalAddressR\taddresses\"m\n" +
+	"\x12WithdrawalApproval\x12\x1a\n" +
+	"\bapprover\x18\x01 \x01(\tR\bapprover\x12;\n" +
+	"\vapproved_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"approvedAt\"\xff\x02\n" +
+	"\x11PendingWithdrawal\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bexchange\x18\x02 \x01(\tR\bexchange\x128\n" +
+	"\arequest\x18\x03 \x01(\v2\x1e.gctrpc.WithdrawalRequestEventR\arequest\x12!\n" +
+	"\frequested_by\x18\x04 \x01(\tR\vrequestedBy\x12=\n" +
+	"\frequested_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vrequestedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12-\n" +
+	"\x12required_approvals\x18\a \x01(\x03R\x11requiredApprovals\x128\n" +
+	"\tapprovals\x18\b \x03(\v2\x1a.gctrpc.WithdrawalApprovalR\tapprovals\"\x1e\n" +
+	"\x1cGetPendingWithdrawalsRequest\"\\\n" +
+	"\x1dGetPendingWithdrawalsResponse\x12;\n" +
+	"\vwithdrawals\x18\x01 \x03(\v2\x19.gctrpc.PendingWithdrawalR\vwithdrawals\"m\n" +
+	"\x18ApproveWithdrawalRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bapprover\x18\x02 \x01(\tR\bapprover\x12%\n" +
+	"\x0eapprover_token\x18\x03 \x01(\tR\rapproverToken\"l\n" +
+	"\x17RejectWithdrawalRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bapprover\x18\x02 \x01(\tR\bapprover\x12%\n" +
+	"\x0eapprover_token\x18\x03 \x01(\tR\rapproverToken\"1\n" +
 	"\x17GetLoggerDetailsRequest\x12\x16\n" +
 	"\x06logger\x18\x01 \x01(\tR\x06logger\"n\n" +
 	"\x18GetLoggerDetailsResponse\x12\x12\n" +
@@ -15751,7 +18153,7 @@ const file_rpc_proto_rawDesc = "" +
 	"\x19GetExchangeAssetsResponse\x12\x16\n" +
 	"\x06assets\x18\x01 \x01(\tR\x06assets\"5\n" +
 	"\x17WebsocketGetInfoRequest\x12\x1a\n" +
-	"\bexchange\x18\x01 \x01(\tR\bexchange\"\x93\x02\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\"\xd8\x02\n" +
 	"\x18WebsocketGetInfoResponse\x12\x1a\n" +
 	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x1c\n" +
 	"\tsupported\x18\x02 \x01(\bR\tsupported\x12\x18\n" +
@@ -15760,7 +18162,17 @@ const file_rpc_proto_rawDesc = "" +
 	"\rauthenticated\x18\x05 \x01(\bR\rauthenticated\x12\x1f\n" +
 	"\vrunning_url\x18\x06 \x01(\tR\n" +
 	"runningUrl\x12#\n" +
-	"\rproxy_address\x18\a \x01(\tR\fproxyAddress\"P\n" +
+	"\rproxy_address\x18\a \x01(\tR\fproxyAddress\x12C\n" +
+	"\vconnections\x18\b \x03(\v2!.gctrpc.WebsocketConnectionHealthR\vconnections\"\xdf\x02\n" +
+	"\x19WebsocketConnectionHealth\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12%\n" +
+	"\x0emessage_filter\x18\x02 \x01(\tR\rmessageFilter\x12\x1c\n" +
+	"\tconnected\x18\x03 \x01(\bR\tconnected\x12$\n" +
+	"\rsubscriptions\x18\x04 \x01(\x03R\rsubscriptions\x12+\n" +
+	"\x11max_subscriptions\x18\x05 \x01(\x03R\x10maxSubscriptions\x12=\n" +
+	"\fconnected_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vconnectedAt\x12=\n" +
+	"\flast_message\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vlastMessage\x12\x1a\n" +
+	"\bmessages\x18\b \x01(\x04R\bmessages\"P\n" +
 	"\x1aWebsocketSetEnabledRequest\x12\x1a\n" +
 	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x16\n" +
 	"\x06enable\x18\x02 \x01(\bR\x06enable\">\n" +
@@ -16204,7 +18616,7 @@ const file_rpc_proto_rawDesc = "" +
 	"\x1cGetLatestFundingRateResponse\x12'\n" +
 	"\x04rate\x18\x01 \x01(\v2\x13.gctrpc.FundingDataR\x04rate\"\x11\n" +
 	"\x0fShutdownRequest\"\x12\n" +
-	"\x10ShutdownResponse\"\xa1\x05\n" +
+	"\x10ShutdownResponse\"\xe3\a\n" +
 	"\x1bGetTechnicalAnalysisRequest\x12\x1a\n" +
 	"\bexchange\x18\x01 \x01(\tR\bexchange\x12(\n" +
 	"\x04pair\x18\x02 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12\x1d\n" +
@@ -16226,7 +18638,17 @@ const file_rpc_proto_rawDesc = "" +
 	"\x0eother_exchange\x18\x0e \x01(\tR\rotherExchange\x123\n" +
 	"\n" +
 	"other_pair\x18\x0f \x01(\v2\x14.gctrpc.CurrencyPairR\totherPair\x12(\n" +
-	"\x10other_asset_type\x18\x10 \x01(\tR\x0eotherAssetType\")\n" +
+	"\x10other_asset_type\x18\x10 \x01(\tR\x0eotherAssetType\x12)\n" +
+	"\x10smoothing_period\x18\x11 \x01(\x03R\x0fsmoothingPeriod\x12#\n" +
+	"\rsignal_period\x18\x12 \x01(\x03R\fsignalPeriod\x12)\n" +
+	"\x10secondary_period\x18\x13 \x01(\x03R\x0fsecondaryPeriod\x12\x1e\n" +
+	"\n" +
+	"multiplier\x18\x14 \x01(\x01R\n" +
+	"multiplier\x12+\n" +
+	"\x11acceleration_step\x18\x15 \x01(\x01R\x10accelerationStep\x12)\n" +
+	"\x10acceleration_max\x18\x16 \x01(\x01R\x0faccelerationMax\x12\"\n" +
+	"\fdisplacement\x18\x17 \x01(\x03R\fdisplacement\x12)\n" +
+	"\x10session_interval\x18\x18 \x01(\x03R\x0fsessionInterval\")\n" +
 	"\rListOfSignals\x12\x18\n" +
 	"\asignals\x18\x01 \x03(\x01R\asignals\"\xbe\x01\n" +
 	"\x1cGetTechnicalAnalysisResponse\x12K\n" +
@@ -16367,7 +18789,121 @@ const file_rpc_proto_rawDesc = "" +
 	"\x05asset\x18\x02 \x01(\tR\x05asset\x12(\n" +
 	"\x04pair\x18\x03 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\"/\n" +
 	"\x1bGetCurrencyTradeURLResponse\x12\x10\n" +
-	"\x03url\x18\x01 \x01(\tR\x03url2\xccl\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\"\xa2\x01\n" +
+	"\x16GetCandleStreamRequest\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12(\n" +
+	"\x04pair\x18\x02 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12\x1d\n" +
+	"\n" +
+	"asset_type\x18\x03 \x01(\tR\tassetType\x12#\n" +
+	"\rtime_interval\x18\x04 \x01(\x03R\ftimeInterval\"\xb6\x01\n" +
+	"\x14CandleStreamResponse\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12(\n" +
+	"\x04pair\x18\x02 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12\x14\n" +
+	"\x05asset\x18\x03 \x01(\tR\x05asset\x12\x1a\n" +
+	"\binterval\x18\x04 \x01(\tR\binterval\x12&\n" +
+	"\x06candle\x18\x05 \x01(\v2\x0e.gctrpc.CandleR\x06candle\"6\n" +
+	"\x18GetRateLimitUsageRequest\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\"\xdc\x01\n" +
+	"\x0eRateLimitUsage\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04used\x18\x02 \x01(\x03R\x04used\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x03R\x05limit\x12\x1a\n" +
+	"\binterval\x18\x04 \x01(\x03R\binterval\x125\n" +
+	"\breset_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\aresetAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x8e\x01\n" +
+	"\x19GetRateLimitUsageResponse\x12,\n" +
+	"\x05usage\x18\x01 \x03(\v2\x16.gctrpc.RateLimitUsageR\x05usage\x12C\n" +
+	"\x0fthrottled_until\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x0ethrottledUntil\"\x85\x01\n" +
+	"\x1eGetMicrostructureStreamRequest\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12(\n" +
+	"\x04pair\x18\x02 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12\x1d\n" +
+	"\n" +
+	"asset_type\x18\x03 \x01(\tR\tassetType\"u\n" +
+	"\x12DepthAtBasisPoints\x12!\n" +
+	"\fbasis_points\x18\x01 \x01(\x01R\vbasisPoints\x12\x1d\n" +
+	"\n" +
+	"bid_amount\x18\x02 \x01(\x01R\tbidAmount\x12\x1d\n" +
+	"\n" +
+	"ask_amount\x18\x03 \x01(\x01R\taskAmount\"\x96\x04\n" +
+	"\x16MicrostructureResponse\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12(\n" +
+	"\x04pair\x18\x02 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12\x14\n" +
+	"\x05asset\x18\x03 \x01(\tR\x05asset\x12;\n" +
+	"\vupdate_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updateTime\x12\x1b\n" +
+	"\tmid_price\x18\x05 \x01(\x01R\bmidPrice\x12\x1e\n" +
+	"\n" +
+	"microprice\x18\x06 \x01(\x01R\n" +
+	"microprice\x120\n" +
+	"\x14order_flow_imbalance\x18\a \x01(\x01R\x12orderFlowImbalance\x12E\n" +
+	"\x1fcumulative_order_flow_imbalance\x18\b \x01(\x01R\x1ccumulativeOrderFlowImbalance\x120\n" +
+	"\x05depth\x18\t \x03(\v2\x1a.gctrpc.DepthAtBasisPointsR\x05depth\x12+\n" +
+	"\x11resilience_micros\x18\n" +
+	" \x01(\x03R\x10resilienceMicros\x12\x1e\n" +
+	"\n" +
+	"recovering\x18\v \x01(\bR\n" +
+	"recovering\x12.\n" +
+	"\x13realised_spread_bps\x18\f \x01(\x01R\x11realisedSpreadBps\"\x15\n" +
+	"\x13ReloadConfigRequest\"n\n" +
+	"\x14ReloadConfigResponse\x12\x1c\n" +
+	"\texchanges\x18\x01 \x03(\tR\texchanges\x12\x1e\n" +
+	"\n" +
+	"subsystems\x18\x02 \x03(\tR\n" +
+	"subsystems\x12\x18\n" +
+	"\aignored\x18\x03 \x03(\tR\aignored\"\x91\x03\n" +
+	"\x10TreasuryTransfer\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12\x12\n" +
+	"\x04from\x18\x03 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x04 \x01(\tR\x02to\x12\x14\n" +
+	"\x05chain\x18\x05 \x01(\tR\x05chain\x12\x18\n" +
+	"\aaddress\x18\x06 \x01(\tR\aaddress\x12\x16\n" +
+	"\x06amount\x18\a \x01(\x01R\x06amount\x12\x10\n" +
+	"\x03fee\x18\b \x01(\x01R\x03fee\x12\x16\n" +
+	"\x06status\x18\t \x01(\tR\x06status\x12+\n" +
+	"\x11withdrawal_status\x18\n" +
+	" \x01(\tR\x10withdrawalStatus\x12\x14\n" +
+	"\x05error\x18\v \x01(\tR\x05error\x12=\n" +
+	"\fsubmitted_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\vsubmittedAt\x129\n" +
+	"\n" +
+	"settled_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tsettledAt\"\x1d\n" +
+	"\x1bGetTreasuryTransfersRequest\"7\n" +
+	"\x18RebalanceTreasuryRequest\x12\x1b\n" +
+	"\tplan_only\x18\x01 \x01(\bR\bplanOnly\"S\n" +
+	"\x19TreasuryTransfersResponse\x126\n" +
+	"\ttransfers\x18\x01 \x03(\v2\x18.gctrpc.TreasuryTransferR\ttransfers\"\x7f\n" +
+	"\x11FundingTransition\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12.\n" +
+	"\x04time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\"\x8d\x04\n" +
+	"\x0fFundingTransfer\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\x01R\x06amount\x12\x10\n" +
+	"\x03fee\x18\x06 \x01(\x01R\x03fee\x12\x14\n" +
+	"\x05chain\x18\a \x01(\tR\x05chain\x12\x18\n" +
+	"\aaddress\x18\b \x01(\tR\aaddress\x12\x13\n" +
+	"\x05tx_id\x18\t \x01(\tR\x04txId\x12\x16\n" +
+	"\x06status\x18\n" +
+	" \x01(\tR\x06status\x12\x14\n" +
+	"\x05state\x18\v \x01(\tR\x05state\x12\x14\n" +
+	"\x05stuck\x18\f \x01(\bR\x05stuck\x128\n" +
+	"\ttimestamp\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x129\n" +
+	"\n" +
+	"first_seen\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tfirstSeen\x129\n" +
+	"\n" +
+	"updated_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12;\n" +
+	"\vtransitions\x18\x10 \x03(\v2\x19.gctrpc.FundingTransitionR\vtransitions\"Y\n" +
+	"\x1aGetFundingTransfersRequest\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x1f\n" +
+	"\vactive_only\x18\x02 \x01(\bR\n" +
+	"activeOnly\"T\n" +
+	"\x1bGetFundingTransfersResponse\x125\n" +
+	"\ttransfers\x18\x01 \x03(\v2\x17.gctrpc.FundingTransferR\ttransfers2\xa4y\n" +
 	"\x15GoCryptoTraderService\x12O\n" +
 	"\aGetInfo\x12\x16.gctrpc.GetInfoRequest\x1a\x17.gctrpc.GetInfoResponse\"\x13\x82\xd3\xe4\x93\x02\r\x12\v/v1/getinfo\x12g\n" +
 	"\rGetSubsystems\x12\x1c.gctrpc.GetSubsystemsRequest\x1a\x1d.gctrpc.GetSubsystemsResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/getsubsystems\x12h\n" +
@@ -16414,7 +18950,13 @@ const file_rpc_proto_rawDesc = "" +
 	"\x1bWithdrawCryptocurrencyFunds\x12\x1d.gctrpc.WithdrawCryptoRequest\x1a\x18.gctrpc.WithdrawResponse\"3\x82\xd3\xe4\x93\x02-:\x01*\"(/v1/withdrawithdrawcryptofundswfiatfunds\x12\x82\x01\n" +
 	"\x13WithdrawalEventByID\x12\".gctrpc.WithdrawalEventByIDRequest\x1a#.gctrpc.WithdrawalEventByIDResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/withdrawaleventbyid\x12\x9d\x01\n" +
 	"\x1aWithdrawalEventsByExchange\x12).gctrpc.WithdrawalEventsByExchangeRequest\x1a*.gctrpc.WithdrawalEventsByExchangeResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/withdrawaleventbyexchange\x12\x91\x01\n" +
-	"\x16WithdrawalEventsByDate\x12%.gctrpc.WithdrawalEventsByDateRequest\x1a*.gctrpc.WithdrawalEventsByExchangeResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1/withdrawaleventbydate\x12s\n" +
+	"\x16WithdrawalEventsByDate\x12%.gctrpc.WithdrawalEventsByDateRequest\x1a*.gctrpc.WithdrawalEventsByExchangeResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1/withdrawaleventbydate\x12{\n" +
+	"\x14AddWithdrawalAddress\x12#.gctrpc.AddWithdrawalAddressRequest\x1a\x19.gctrpc.WithdrawalAddress\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/addwithdrawaladdress\x12\x82\x01\n" +
+	"\x17RemoveWithdrawalAddress\x12&.gctrpc.RemoveWithdrawalAddressRequest\x1a\x17.gctrpc.GenericResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v1/removewithdrawaladdress\x12\x8b\x01\n" +
+	"\x16GetWithdrawalAddresses\x12%.gctrpc.GetWithdrawalAddressesRequest\x1a&.gctrpc.GetWithdrawalAddressesResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v1/getwithdrawaladdresses\x12\x87\x01\n" +
+	"\x15GetPendingWithdrawals\x12$.gctrpc.GetPendingWithdrawalsRequest\x1a%.gctrpc.GetPendingWithdrawalsResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/getpendingwithdrawals\x12q\n" +
+	"\x11ApproveWithdrawal\x12 .gctrpc.ApproveWithdrawalRequest\x1a\x18.gctrpc.WithdrawResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/approvewithdrawal\x12m\n" +
+	"\x10RejectWithdrawal\x12\x1f.gctrpc.RejectWithdrawalRequest\x1a\x17.gctrpc.GenericResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/rejectwithdrawal\x12s\n" +
 	"\x10GetLoggerDetails\x12\x1f.gctrpc.GetLoggerDetailsRequest\x1a .gctrpc.GetLoggerDetailsResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/getloggerdetails\x12v\n" +
 	"\x10SetLoggerDetails\x12\x1f.gctrpc.SetLoggerDetailsRequest\x1a .gctrpc.GetLoggerDetailsResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/setloggerdetails\x12v\n" +
 	"\x10GetExchangePairs\x12\x1f.gctrpc.GetExchangePairsRequest\x1a .gctrpc.GetExchangePairsResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/getexchangepairs\x12j\n" +
@@ -16484,7 +19026,14 @@ const file_rpc_proto_rawDesc = "" +
 	"\vSetLeverage\x12\x1a.gctrpc.SetLeverageRequest\x1a\x1b.gctrpc.SetLeverageResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/getleverage\x12\x86\x01\n" +
 	"\x14ChangePositionMargin\x12#.gctrpc.ChangePositionMarginRequest\x1a$.gctrpc.ChangePositionMarginResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/changepositionmargin\x12o\n" +
 	"\x0fGetOpenInterest\x12\x1e.gctrpc.GetOpenInterestRequest\x1a\x1f.gctrpc.GetOpenInterestResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/getopeninterest\x12\x7f\n" +
-	"\x13GetCurrencyTradeURL\x12\".gctrpc.GetCurrencyTradeURLRequest\x1a#.gctrpc.GetCurrencyTradeURLResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/getcurrencytradeurlB0Z.github.com/thrasher-corp/gocryptotrader/gctrpcb\x06proto3"
+	"\x13GetCurrencyTradeURL\x12\".gctrpc.GetCurrencyTradeURLRequest\x1a#.gctrpc.GetCurrencyTradeURLResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/getcurrencytradeurl\x12n\n" +
+	"\x0fGetCandleStream\x12\x1e.gctrpc.GetCandleStreamRequest\x1a\x1c.gctrpc.CandleStreamResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/getcandlestream0\x01\x12w\n" +
+	"\x11GetRateLimitUsage\x12 .gctrpc.GetRateLimitUsageRequest\x1a!.gctrpc.GetRateLimitUsageResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/getratelimitusage\x12\x88\x01\n" +
+	"\x17GetMicrostructureStream\x12&.gctrpc.GetMicrostructureStreamRequest\x1a\x1e.gctrpc.MicrostructureResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v1/getmicrostructurestream0\x01\x12f\n" +
+	"\fReloadConfig\x12\x1b.gctrpc.ReloadConfigRequest\x1a\x1c.gctrpc.ReloadConfigResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/v1/reloadconfig\x12\x80\x01\n" +
+	"\x14GetTreasuryTransfers\x12#.gctrpc.GetTreasuryTransfersRequest\x1a!.gctrpc.TreasuryTransfersResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/v1/gettreasurytransfers\x12z\n" +
+	"\x11RebalanceTreasury\x12 .gctrpc.RebalanceTreasuryRequest\x1a!.gctrpc.TreasuryTransfersResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/rebalancetreasury\x12\x7f\n" +
+	"\x13GetFundingTransfers\x12\".gctrpc.GetFundingTransfersRequest\x1a#.gctrpc.GetFundingTransfersResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/getfundingtransfersB0Z.github.com/thrasher-corp/gocryptotrader/gctrpcb\x06proto3"
 
 var (
 	file_rpc_proto_rawDescOnce sync.Once
@@ -16498,7 +19047,7 @@ func file_rpc_proto_rawDescGZIP() []byte {
 	return file_rpc_proto_rawDescData
 }
 
-var file_rpc_proto_msgTypes = make([]protoimpl.MessageInfo, 240)
+var file_rpc_proto_msgTypes = make([]protoimpl.MessageInfo, 271)
 var file_rpc_proto_goTypes = []any{
 	(*GetInfoRequest)(nil),                            // 0: gctrpc.GetInfoRequest
 	(*GetInfoResponse)(nil),                           // 1: gctrpc.GetInfoResponse
@@ -16535,221 +19084,252 @@ var file_rpc_proto_goTypes = []any{
 	(*GetAccountBalancesRequest)(nil),                 // 32: gctrpc.GetAccountBalancesRequest
 	(*Account)(nil),                                   // 33: gctrpc.Account
 	(*AccountCurrencyInfo)(nil),                       // 34: gctrpc.AccountCurrencyInfo
-	(*GetAccountBalancesResponse)(nil),                // 35: gctrpc.GetAccountBalancesResponse
-	(*GetConfigRequest)(nil),                          // 36: gctrpc.GetConfigRequest
-	(*GetConfigResponse)(nil),                         // 37: gctrpc.GetConfigResponse
-	(*PortfolioAddress)(nil),                          // 38: gctrpc.PortfolioAddress
-	(*GetPortfolioRequest)(nil),                       // 39: gctrpc.GetPortfolioRequest
-	(*GetPortfolioResponse)(nil),                      // 40: gctrpc.GetPortfolioResponse
-	(*GetPortfolioSummaryRequest)(nil),                // 41: gctrpc.GetPortfolioSummaryRequest
-	(*Coin)(nil),                                      // 42: gctrpc.Coin
-	(*OfflineCoinSummary)(nil),                        // 43: gctrpc.OfflineCoinSummary
-	(*OnlineCoinSummary)(nil),                         // 44: gctrpc.OnlineCoinSummary
-	(*OfflineCoins)(nil),                              // 45: gctrpc.OfflineCoins
-	(*OnlineCoins)(nil),                               // 46: gctrpc.OnlineCoins
-	(*GetPortfolioSummaryResponse)(nil),               // 47: gctrpc.GetPortfolioSummaryResponse
-	(*AddPortfolioAddressRequest)(nil),                // 48: gctrpc.AddPortfolioAddressRequest
-	(*RemovePortfolioAddressRequest)(nil),             // 49: gctrpc.RemovePortfolioAddressRequest
-	(*GetForexProvidersRequest)(nil),                  // 50: gctrpc.GetForexProvidersRequest
-	(*ForexProvider)(nil),                             // 51: gctrpc.ForexProvider
-	(*GetForexProvidersResponse)(nil),                 // 52: gctrpc.GetForexProvidersResponse
-	(*GetForexRatesRequest)(nil),                      // 53: gctrpc.GetForexRatesRequest
-	(*ForexRatesConversion)(nil),                      // 54: gctrpc.ForexRatesConversion
-	(*GetForexRatesResponse)(nil),                     // 55: gctrpc.GetForexRatesResponse
-	(*OrderDetails)(nil),                              // 56: gctrpc.OrderDetails
-	(*TradeHistory)(nil),                              // 57: gctrpc.TradeHistory
-	(*GetOrdersRequest)(nil),                          // 58: gctrpc.GetOrdersRequest
-	(*GetOrdersResponse)(nil),                         // 59: gctrpc.GetOrdersResponse
-	(*GetOrderRequest)(nil),                           // 60: gctrpc.GetOrderRequest
-	(*SubmitOrderRequest)(nil),                        // 61: gctrpc.SubmitOrderRequest
-	(*Trades)(nil),                                    // 62: gctrpc.Trades
-	(*SubmitOrderResponse)(nil),                       // 63: gctrpc.SubmitOrderResponse
-	(*SimulateOrderRequest)(nil),                      // 64: gctrpc.SimulateOrderRequest
-	(*SimulateOrderResponse)(nil),                     // 65: gctrpc.SimulateOrderResponse
-	(*WhaleBombRequest)(nil),                          // 66: gctrpc.WhaleBombRequest
-	(*CancelOrderRequest)(nil),                        // 67: gctrpc.CancelOrderRequest
-	(*CancelBatchOrdersRequest)(nil),                  // 68: gctrpc.CancelBatchOrdersRequest
-	(*Orders)(nil),                                    // 69: gctrpc.Orders
-	(*CancelBatchOrdersResponse)(nil),                 // 70: gctrpc.CancelBatchOrdersResponse
-	(*CancelAllOrdersRequest)(nil),                    // 71: gctrpc.CancelAllOrdersRequest
-	(*CancelAllOrdersResponse)(nil),                   // 72: gctrpc.CancelAllOrdersResponse
-	(*GetEventsRequest)(nil),                          // 73: gctrpc.GetEventsRequest
-	(*ConditionParams)(nil),                           // 74: gctrpc.ConditionParams
-	(*GetEventsResponse)(nil),                         // 75: gctrpc.GetEventsResponse
-	(*AddEventRequest)(nil),                           // 76: gctrpc.AddEventRequest
-	(*AddEventResponse)(nil),                          // 77: gctrpc.AddEventResponse
-	(*RemoveEventRequest)(nil),                        // 78: gctrpc.RemoveEventRequest
-	(*GetCryptocurrencyDepositAddressesRequest)(nil),  // 79: gctrpc.GetCryptocurrencyDepositAddressesRequest
-	(*DepositAddress)(nil),                            // 80: gctrpc.DepositAddress
-	(*DepositAddresses)(nil),                          // 81: gctrpc.DepositAddresses
-	(*GetCryptocurrencyDepositAddressesResponse)(nil), // 82: gctrpc.GetCryptocurrencyDepositAddressesResponse
-	(*GetCryptocurrencyDepositAddressRequest)(nil),    // 83: gctrpc.GetCryptocurrencyDepositAddressRequest
-	(*GetCryptocurrencyDepositAddressResponse)(nil),   // 84: gctrpc.GetCryptocurrencyDepositAddressResponse
-	(*GetAvailableTransferChainsRequest)(nil),         // 85: gctrpc.GetAvailableTransferChainsRequest
-	(*GetAvailableTransferChainsResponse)(nil),        // 86: gctrpc.GetAvailableTransferChainsResponse
-	(*WithdrawFiatRequest)(nil),                       // 87: gctrpc.WithdrawFiatRequest
-	(*WithdrawCryptoRequest)(nil),                     // 88: gctrpc.WithdrawCryptoRequest
-	(*WithdrawResponse)(nil),                          // 89: gctrpc.WithdrawResponse
-	(*WithdrawalEventByIDRequest)(nil),                // 90: gctrpc.WithdrawalEventByIDRequest
-	(*WithdrawalEventByIDResponse)(nil),               // 91: gctrpc.WithdrawalEventByIDResponse
-	(*WithdrawalEventsByExchangeRequest)(nil),         // 92: gctrpc.WithdrawalEventsByExchangeRequest
-	(*WithdrawalEventsByDateRequest)(nil),             // 93: gctrpc.WithdrawalEventsByDateRequest
-	(*WithdrawalEventsByExchangeResponse)(nil),        // 94: gctrpc.WithdrawalEventsByExchangeResponse
-	(*WithdrawalEventResponse)(nil),                   // 95: gctrpc.WithdrawalEventResponse
-	(*WithdrawalExchangeEvent)(nil),                   // 96: gctrpc.WithdrawalExchangeEvent
-	(*WithdrawalRequestEvent)(nil),                    // 97: gctrpc.WithdrawalRequestEvent
-	(*FiatWithdrawalEvent)(nil),                       // 98: gctrpc.FiatWithdrawalEvent
-	(*CryptoWithdrawalEvent)(nil),                     // 99: gctrpc.CryptoWithdrawalEvent
-	(*GetLoggerDetailsRequest)(nil),                   // 100: gctrpc.GetLoggerDetailsRequest
-	(*GetLoggerDetailsResponse)(nil),                  // 101: gctrpc.GetLoggerDetailsResponse
-	(*SetLoggerDetailsRequest)(nil),                   // 102: gctrpc.SetLoggerDetailsRequest
-	(*GetExchangePairsRequest)(nil),                   // 103: gctrpc.GetExchangePairsRequest
-	(*GetExchangePairsResponse)(nil),                  // 104: gctrpc.GetExchangePairsResponse
-	(*SetExchangePairRequest)(nil),                    // 105: gctrpc.SetExchangePairRequest
-	(*GetOrderbookStreamRequest)(nil),                 // 106: gctrpc.GetOrderbookStreamRequest
-	(*GetExchangeOrderbookStreamRequest)(nil),         // 107: gctrpc.GetExchangeOrderbookStreamRequest
-	(*GetTickerStreamRequest)(nil),                    // 108: gctrpc.GetTickerStreamRequest
-	(*GetExchangeTickerStreamRequest)(nil),            // 109: gctrpc.GetExchangeTickerStreamRequest
-	(*GetAuditEventRequest)(nil),                      // 110: gctrpc.GetAuditEventRequest
-	(*GetAuditEventResponse)(nil),                     // 111: gctrpc.GetAuditEventResponse
-	(*GetSavedTradesRequest)(nil),                     // 112: gctrpc.GetSavedTradesRequest
-	(*SavedTrades)(nil),                               // 113: gctrpc.SavedTrades
-	(*SavedTradesResponse)(nil),                       // 114: gctrpc.SavedTradesResponse
-	(*ConvertTradesToCandlesRequest)(nil),             // 115: gctrpc.ConvertTradesToCandlesRequest
-	(*GetHistoricCandlesRequest)(nil),                 // 116: gctrpc.GetHistoricCandlesRequest
-	(*GetHistoricCandlesResponse)(nil),                // 117: gctrpc.GetHistoricCandlesResponse
-	(*Candle)(nil),                                    // 118: gctrpc.Candle
-	(*AuditEvent)(nil),                                // 119: gctrpc.AuditEvent
-	(*GCTScript)(nil),                                 // 120: gctrpc.GCTScript
-	(*GCTScriptExecuteRequest)(nil),                   // 121: gctrpc.GCTScriptExecuteRequest
-	(*GCTScriptStopRequest)(nil),                      // 122: gctrpc.GCTScriptStopRequest
-	(*GCTScriptStopAllRequest)(nil),                   // 123: gctrpc.GCTScriptStopAllRequest
-	(*GCTScriptStatusRequest)(nil),                    // 124: gctrpc.GCTScriptStatusRequest
-	(*GCTScriptListAllRequest)(nil),                   // 125: gctrpc.GCTScriptListAllRequest
-	(*GCTScriptUploadRequest)(nil),                    // 126: gctrpc.GCTScriptUploadRequest
-	(*GCTScriptReadScriptRequest)(nil),                // 127: gctrpc.GCTScriptReadScriptRequest
-	(*GCTScriptQueryRequest)(nil),                     // 128: gctrpc.GCTScriptQueryRequest
-	(*GCTScriptAutoLoadRequest)(nil),                  // 129: gctrpc.GCTScriptAutoLoadRequest
-	(*GCTScriptStatusResponse)(nil),                   // 130: gctrpc.GCTScriptStatusResponse
-	(*GCTScriptQueryResponse)(nil),                    // 131: gctrpc.GCTScriptQueryResponse
-	(*GenericResponse)(nil),                           // 132: gctrpc.GenericResponse
-	(*SetExchangeAssetRequest)(nil),                   // 133: gctrpc.SetExchangeAssetRequest
-	(*SetExchangeAllPairsRequest)(nil),                // 134: gctrpc.SetExchangeAllPairsRequest
-	(*UpdateExchangeSupportedPairsRequest)(nil),       // 135: gctrpc.UpdateExchangeSupportedPairsRequest
-	(*GetExchangeAssetsRequest)(nil),                  // 136: gctrpc.GetExchangeAssetsRequest
-	(*GetExchangeAssetsResponse)(nil),                 // 137: gctrpc.GetExchangeAssetsResponse
-	(*WebsocketGetInfoRequest)(nil),                   // 138: gctrpc.WebsocketGetInfoRequest
-	(*WebsocketGetInfoResponse)(nil),                  // 139: gctrpc.WebsocketGetInfoResponse
-	(*WebsocketSetEnabledRequest)(nil),                // 140: gctrpc.WebsocketSetEnabledRequest
-	(*WebsocketGetSubscriptionsRequest)(nil),          // 141: gctrpc.WebsocketGetSubscriptionsRequest
-	(*WebsocketSubscription)(nil),                     // 142: gctrpc.WebsocketSubscription
-	(*WebsocketGetSubscriptionsResponse)(nil),         // 143: gctrpc.WebsocketGetSubscriptionsResponse
-	(*WebsocketSetProxyRequest)(nil),                  // 144: gctrpc.WebsocketSetProxyRequest
-	(*WebsocketSetURLRequest)(nil),                    // 145: gctrpc.WebsocketSetURLRequest
-	(*FindMissingCandlePeriodsRequest)(nil),           // 146: gctrpc.FindMissingCandlePeriodsRequest
-	(*FindMissingTradePeriodsRequest)(nil),            // 147: gctrpc.FindMissingTradePeriodsRequest
-	(*FindMissingIntervalsResponse)(nil),              // 148: gctrpc.FindMissingIntervalsResponse
-	(*SetExchangeTradeProcessingRequest)(nil),         // 149: gctrpc.SetExchangeTradeProcessingRequest
-	(*UpsertDataHistoryJobRequest)(nil),               // 150: gctrpc.UpsertDataHistoryJobRequest
-	(*InsertSequentialJobsRequest)(nil),               // 151: gctrpc.InsertSequentialJobsRequest
-	(*InsertSequentialJobsResponse)(nil),              // 152: gctrpc.InsertSequentialJobsResponse
-	(*UpsertDataHistoryJobResponse)(nil),              // 153: gctrpc.UpsertDataHistoryJobResponse
-	(*GetDataHistoryJobDetailsRequest)(nil),           // 154: gctrpc.GetDataHistoryJobDetailsRequest
-	(*DataHistoryJob)(nil),                            // 155: gctrpc.DataHistoryJob
-	(*DataHistoryJobResult)(nil),                      // 156: gctrpc.DataHistoryJobResult
-	(*DataHistoryJobs)(nil),                           // 157: gctrpc.DataHistoryJobs
-	(*GetDataHistoryJobsBetweenRequest)(nil),          // 158: gctrpc.GetDataHistoryJobsBetweenRequest
-	(*SetDataHistoryJobStatusRequest)(nil),            // 159: gctrpc.SetDataHistoryJobStatusRequest
-	(*UpdateDataHistoryJobPrerequisiteRequest)(nil),   // 160: gctrpc.UpdateDataHistoryJobPrerequisiteRequest
-	(*ModifyOrderRequest)(nil),                        // 161: gctrpc.ModifyOrderRequest
-	(*ModifyOrderResponse)(nil),                       // 162: gctrpc.ModifyOrderResponse
-	(*CurrencyStateGetAllRequest)(nil),                // 163: gctrpc.CurrencyStateGetAllRequest
-	(*CurrencyStateTradingRequest)(nil),               // 164: gctrpc.CurrencyStateTradingRequest
-	(*CurrencyStateTradingPairRequest)(nil),           // 165: gctrpc.CurrencyStateTradingPairRequest
-	(*CurrencyStateWithdrawRequest)(nil),              // 166: gctrpc.CurrencyStateWithdrawRequest
-	(*CurrencyStateDepositRequest)(nil),               // 167: gctrpc.CurrencyStateDepositRequest
-	(*CurrencyStateResponse)(nil),                     // 168: gctrpc.CurrencyStateResponse
-	(*CurrencyState)(nil),                             // 169: gctrpc.CurrencyState
-	(*FundingRate)(nil),                               // 170: gctrpc.FundingRate
-	(*FundingData)(nil),                               // 171: gctrpc.FundingData
-	(*FuturesPositionStats)(nil),                      // 172: gctrpc.FuturesPositionStats
-	(*FuturePosition)(nil),                            // 173: gctrpc.FuturePosition
-	(*GetManagedPositionRequest)(nil),                 // 174: gctrpc.GetManagedPositionRequest
-	(*GetAllManagedPositionsRequest)(nil),             // 175: gctrpc.GetAllManagedPositionsRequest
-	(*GetManagedPositionsResponse)(nil),               // 176: gctrpc.GetManagedPositionsResponse
-	(*GetFuturesPositionsSummaryRequest)(nil),         // 177: gctrpc.GetFuturesPositionsSummaryRequest
-	(*GetFuturesPositionsSummaryResponse)(nil),        // 178: gctrpc.GetFuturesPositionsSummaryResponse
-	(*GetFuturesPositionsOrdersRequest)(nil),          // 179: gctrpc.GetFuturesPositionsOrdersRequest
-	(*GetFuturesPositionsOrdersResponse)(nil),         // 180: gctrpc.GetFuturesPositionsOrdersResponse
-	(*GetCollateralModeRequest)(nil),                  // 181: gctrpc.GetCollateralModeRequest
-	(*GetCollateralModeResponse)(nil),                 // 182: gctrpc.GetCollateralModeResponse
-	(*SetCollateralModeRequest)(nil),                  // 183: gctrpc.SetCollateralModeRequest
-	(*SetCollateralModeResponse)(nil),                 // 184: gctrpc.SetCollateralModeResponse
-	(*GetMarginTypeRequest)(nil),                      // 185: gctrpc.GetMarginTypeRequest
-	(*GetMarginTypeResponse)(nil),                     // 186: gctrpc.GetMarginTypeResponse
-	(*ChangePositionMarginRequest)(nil),               // 187: gctrpc.ChangePositionMarginRequest
-	(*ChangePositionMarginResponse)(nil),              // 188: gctrpc.ChangePositionMarginResponse
-	(*SetMarginTypeRequest)(nil),                      // 189: gctrpc.SetMarginTypeRequest
-	(*SetMarginTypeResponse)(nil),                     // 190: gctrpc.SetMarginTypeResponse
-	(*GetLeverageRequest)(nil),                        // 191: gctrpc.GetLeverageRequest
-	(*GetLeverageResponse)(nil),                       // 192: gctrpc.GetLeverageResponse
-	(*SetLeverageRequest)(nil),                        // 193: gctrpc.SetLeverageRequest
-	(*SetLeverageResponse)(nil),                       // 194: gctrpc.SetLeverageResponse
-	(*GetCollateralRequest)(nil),                      // 195: gctrpc.GetCollateralRequest
-	(*GetCollateralResponse)(nil),                     // 196: gctrpc.GetCollateralResponse
-	(*CollateralForCurrency)(nil),                     // 197: gctrpc.CollateralForCurrency
-	(*CollateralByPosition)(nil),                      // 198: gctrpc.CollateralByPosition
-	(*CollateralUsedBreakdown)(nil),                   // 199: gctrpc.CollateralUsedBreakdown
-	(*GetFundingRatesRequest)(nil),                    // 200: gctrpc.GetFundingRatesRequest
-	(*GetFundingRatesResponse)(nil),                   // 201: gctrpc.GetFundingRatesResponse
-	(*GetLatestFundingRateRequest)(nil),               // 202: gctrpc.GetLatestFundingRateRequest
-	(*GetLatestFundingRateResponse)(nil),              // 203: gctrpc.GetLatestFundingRateResponse
-	(*ShutdownRequest)(nil),                           // 204: gctrpc.ShutdownRequest
-	(*ShutdownResponse)(nil),                          // 205: gctrpc.ShutdownResponse
-	(*GetTechnicalAnalysisRequest)(nil),               // 206: gctrpc.GetTechnicalAnalysisRequest
-	(*ListOfSignals)(nil),                             // 207: gctrpc.ListOfSignals
-	(*GetTechnicalAnalysisResponse)(nil),              // 208: gctrpc.GetTechnicalAnalysisResponse
-	(*GetMarginRatesHistoryRequest)(nil),              // 209: gctrpc.GetMarginRatesHistoryRequest
-	(*LendingPayment)(nil),                            // 210: gctrpc.LendingPayment
-	(*BorrowCost)(nil),                                // 211: gctrpc.BorrowCost
-	(*MarginRate)(nil),                                // 212: gctrpc.MarginRate
-	(*GetMarginRatesHistoryResponse)(nil),             // 213: gctrpc.GetMarginRatesHistoryResponse
-	(*GetOrderbookMovementRequest)(nil),               // 214: gctrpc.GetOrderbookMovementRequest
-	(*GetOrderbookMovementResponse)(nil),              // 215: gctrpc.GetOrderbookMovementResponse
-	(*GetOrderbookAmountByNominalRequest)(nil),        // 216: gctrpc.GetOrderbookAmountByNominalRequest
-	(*GetOrderbookAmountByNominalResponse)(nil),       // 217: gctrpc.GetOrderbookAmountByNominalResponse
-	(*GetOrderbookAmountByImpactRequest)(nil),         // 218: gctrpc.GetOrderbookAmountByImpactRequest
-	(*GetOrderbookAmountByImpactResponse)(nil),        // 219: gctrpc.GetOrderbookAmountByImpactResponse
-	(*GetOpenInterestRequest)(nil),                    // 220: gctrpc.GetOpenInterestRequest
-	(*OpenInterestDataRequest)(nil),                   // 221: gctrpc.OpenInterestDataRequest
-	(*GetOpenInterestResponse)(nil),                   // 222: gctrpc.GetOpenInterestResponse
-	(*OpenInterestDataResponse)(nil),                  // 223: gctrpc.OpenInterestDataResponse
-	(*GetCurrencyTradeURLRequest)(nil),                // 224: gctrpc.GetCurrencyTradeURLRequest
-	(*GetCurrencyTradeURLResponse)(nil),               // 225: gctrpc.GetCurrencyTradeURLResponse
-	nil,                                               // 226: gctrpc.GetInfoResponse.SubsystemStatusEntry
-	nil,                                               // 227: gctrpc.GetInfoResponse.RpcEndpointsEntry
-	nil,                                               // 228: gctrpc.GetCommunicationRelayersResponse.CommunicationRelayersEntry
-	nil,                                               // 229: gctrpc.GetSubsystemsResponse.SubsystemsStatusEntry
-	nil,                                               // 230: gctrpc.GetRPCEndpointsResponse.EndpointsEntry
-	nil,                                               // 231: gctrpc.GetExchangeOTPsResponse.OtpCodesEntry
-	nil,                                               // 232: gctrpc.GetExchangeInfoResponse.SupportedAssetsEntry
-	nil,                                               // 233: gctrpc.OnlineCoins.CoinsEntry
-	nil,                                               // 234: gctrpc.GetPortfolioSummaryResponse.CoinsOfflineSummaryEntry
-	nil,                                               // 235: gctrpc.GetPortfolioSummaryResponse.CoinsOnlineSummaryEntry
-	nil,                                               // 236: gctrpc.Orders.OrderStatusEntry
-	nil,                                               // 237: gctrpc.GetCryptocurrencyDepositAddressesResponse.AddressesEntry
-	nil,                                               // 238: gctrpc.GetExchangePairsResponse.SupportedAssetsEntry
-	nil,                                               // 239: gctrpc.GetTechnicalAnalysisResponse.SignalsEntry
-	(*timestamppb.Timestamp)(nil),                     // 240: google.protobuf.Timestamp
+	(*ConversionHop)(nil),                             // 35: gctrpc.ConversionHop
+	(*GetAccountBalancesResponse)(nil),                // 36: gctrpc.GetAccountBalancesResponse
+	(*GetConfigRequest)(nil),                          // 37: gctrpc.GetConfigRequest
+	(*GetConfigResponse)(nil),                         // 38: gctrpc.GetConfigResponse
+	(*PortfolioAddress)(nil),                          // 39: gctrpc.PortfolioAddress
+	(*GetPortfolioRequest)(nil),                       // 40: gctrpc.GetPortfolioRequest
+	(*GetPortfolioResponse)(nil),                      // 41: gctrpc.GetPortfolioResponse
+	(*GetPortfolioSummaryRequest)(nil),                // 42: gctrpc.GetPortfolioSummaryRequest
+	(*Coin)(nil),                                      // 43: gctrpc.Coin
+	(*OfflineCoinSummary)(nil),                        // 44: gctrpc.OfflineCoinSummary
+	(*OnlineCoinSummary)(nil),                         // 45: gctrpc.OnlineCoinSummary
+	(*OfflineCoins)(nil),                              // 46: gctrpc.OfflineCoins
+	(*OnlineCoins)(nil),                               // 47: gctrpc.OnlineCoins
+	(*GetPortfolioSummaryResponse)(nil),               // 48: gctrpc.GetPortfolioSummaryResponse
+	(*AddPortfolioAddressRequest)(nil),                // 49: gctrpc.AddPortfolioAddressRequest
+	(*RemovePortfolioAddressRequest)(nil),             // 50: gctrpc.RemovePortfolioAddressRequest
+	(*GetForexProvidersRequest)(nil),                  // 51: gctrpc.GetForexProvidersRequest
+	(*ForexProvider)(nil),                             // 52: gctrpc.ForexProvider
+	(*GetForexProvidersResponse)(nil),                 // 53: gctrpc.GetForexProvidersResponse
+	(*GetForexRatesRequest)(nil),                      // 54: gctrpc.GetForexRatesRequest
+	(*ForexRatesConversion)(nil),                      // 55: gctrpc.ForexRatesConversion
+	(*GetForexRatesResponse)(nil),                     // 56: gctrpc.GetForexRatesResponse
+	(*OrderDetails)(nil),                              // 57: gctrpc.OrderDetails
+	(*TradeHistory)(nil),                              // 58: gctrpc.TradeHistory
+	(*GetOrdersRequest)(nil),                          // 59: gctrpc.GetOrdersRequest
+	(*GetOrdersResponse)(nil),                         // 60: gctrpc.GetOrdersResponse
+	(*GetOrderRequest)(nil),                           // 61: gctrpc.GetOrderRequest
+	(*SubmitOrderRequest)(nil),                        // 62: gctrpc.SubmitOrderRequest
+	(*Trades)(nil),                                    // 63: gctrpc.Trades
+	(*SubmitOrderResponse)(nil),                       // 64: gctrpc.SubmitOrderResponse
+	(*SimulateOrderRequest)(nil),                      // 65: gctrpc.SimulateOrderRequest
+	(*SimulateOrderResponse)(nil),                     // 66: gctrpc.SimulateOrderResponse
+	(*WhaleBombRequest)(nil),                          // 67: gctrpc.WhaleBombRequest
+	(*CancelOrderRequest)(nil),                        // 68: gctrpc.CancelOrderRequest
+	(*CancelBatchOrdersRequest)(nil),                  // 69: gctrpc.CancelBatchOrdersRequest
+	(*Orders)(nil),                                    // 70: gctrpc.Orders
+	(*CancelBatchOrdersResponse)(nil),                 // 71: gctrpc.CancelBatchOrdersResponse
+	(*CancelAllOrdersRequest)(nil),                    // 72: gctrpc.CancelAllOrdersRequest
+	(*CancelAllOrdersResponse)(nil),                   // 73: gctrpc.CancelAllOrdersResponse
+	(*GetEventsRequest)(nil),                          // 74: gctrpc.GetEventsRequest
+	(*ConditionParams)(nil),                           // 75: gctrpc.ConditionParams
+	(*GetEventsResponse)(nil),                         // 76: gctrpc.GetEventsResponse
+	(*AddEventRequest)(nil),                           // 77: gctrpc.AddEventRequest
+	(*AddEventResponse)(nil),                          // 78: gctrpc.AddEventResponse
+	(*RemoveEventRequest)(nil),                        // 79: gctrpc.RemoveEventRequest
+	(*GetCryptocurrencyDepositAddressesRequest)(nil),  // 80: gctrpc.GetCryptocurrencyDepositAddressesRequest
+	(*DepositAddress)(nil),                            // 81: gctrpc.DepositAddress
+	(*DepositAddresses)(nil),                          // 82: gctrpc.DepositAddresses
+	(*GetCryptocurrencyDepositAddressesResponse)(nil), // 83: gctrpc.GetCryptocurrencyDepositAddressesResponse
+	(*GetCryptocurrencyDepositAddressRequest)(nil),    // 84: gctrpc.GetCryptocurrencyDepositAddressRequest
+	(*GetCryptocurrencyDepositAddressResponse)(nil),   // 85: gctrpc.GetCryptocurrencyDepositAddressResponse
+	(*GetAvailableTransferChainsRequest)(nil),         // 86: gctrpc.GetAvailableTransferChainsRequest
+	(*GetAvailableTransferChainsResponse)(nil),        // 87: gctrpc.GetAvailableTransferChainsResponse
+	(*WithdrawFiatRequest)(nil),                       // 88: gctrpc.WithdrawFiatRequest
+	(*WithdrawCryptoRequest)(nil),                     // 89: gctrpc.WithdrawCryptoRequest
+	(*WithdrawResponse)(nil),                          // 90: gctrpc.WithdrawResponse
+	(*WithdrawalEventByIDRequest)(nil),                // 91: gctrpc.WithdrawalEventByIDRequest
+	(*WithdrawalEventByIDResponse)(nil),               // 92: gctrpc.WithdrawalEventByIDResponse
+	(*WithdrawalEventsByExchangeRequest)(nil),         // 93: gctrpc.WithdrawalEventsByExchangeRequest
+	(*WithdrawalEventsByDateRequest)(nil),             // 94: gctrpc.WithdrawalEventsByDateRequest
+	(*WithdrawalEventsByExchangeResponse)(nil),        // 95: gctrpc.WithdrawalEventsByExchangeResponse
+	(*WithdrawalEventResponse)(nil),                   // 96: gctrpc.WithdrawalEventResponse
+	(*WithdrawalExchangeEvent)(nil),                   // 97: gctrpc.WithdrawalExchangeEvent
+	(*WithdrawalRequestEvent)(nil),                    // 98: gctrpc.WithdrawalRequestEvent
+	(*FiatWithdrawalEvent)(nil),                       // 99: gctrpc.FiatWithdrawalEvent
+	(*CryptoWithdrawalEvent)(nil),                     // 100: gctrpc.CryptoWithdrawalEvent
+	(*WithdrawalAddress)(nil),                         // 101: gctrpc.WithdrawalAddress
+	(*AddWithdrawalAddressRequest)(nil),               // 102: gctrpc.AddWithdrawalAddressRequest
+	(*RemoveWithdrawalAddressRequest)(nil),            // 103: gctrpc.RemoveWithdrawalAddressRequest
+	(*GetWithdrawalAddressesRequest)(nil),             // 104: gctrpc.GetWithdrawalAddressesRequest
+	(*GetWithdrawalAddressesResponse)(nil),            // 105: gctrpc.GetWithdrawalAddressesResponse
+	(*WithdrawalApproval)(nil),                        // 106: gctrpc.WithdrawalApproval
+	(*PendingWithdrawal)(nil),                         // 107: gctrpc.PendingWithdrawal
+	(*GetPendingWithdrawalsRequest)(nil),              // 108: gctrpc.GetPendingWithdrawalsRequest
+	(*GetPendingWithdrawalsResponse)(nil),             // 109: gctrpc.GetPendingWithdrawalsResponse
+	(*ApproveWithdrawalRequest)(nil),                  // 110: gctrpc.ApproveWithdrawalRequest
+	(*RejectWithdrawalRequest)(nil),                   // 111: gctrpc.RejectWithdrawalRequest
+	(*GetLoggerDetailsRequest)(nil),                   // 112: gctrpc.GetLoggerDetailsRequest
+	(*GetLoggerDetailsResponse)(nil),                  // 113: gctrpc.GetLoggerDetailsResponse
+	(*SetLoggerDetailsRequest)(nil),                   // 114: gctrpc.SetLoggerDetailsRequest
+	(*GetExchangePairsRequest)(nil),                   // 115: gctrpc.GetExchangePairsRequest
+	(*GetExchangePairsResponse)(nil),                  // 116: gctrpc.GetExchangePairsResponse
+	(*SetExchangePairRequest)(nil),                    // 117: gctrpc.SetExchangePairRequest
+	(*GetOrderbookStreamRequest)(nil),                 // 118: gctrpc.GetOrderbookStreamRequest
+	(*GetExchangeOrderbookStreamRequest)(nil),         // 119: gctrpc.GetExchangeOrderbookStreamRequest
+	(*GetTickerStreamRequest)(nil),                    // 120: gctrpc.GetTickerStreamRequest
+	(*GetExchangeTickerStreamRequest)(nil),            // 121: gctrpc.GetExchangeTickerStreamRequest
+	(*GetAuditEventRequest)(nil),                      // 122: gctrpc.GetAuditEventRequest
+	(*GetAuditEventResponse)(nil),                     // 123: gctrpc.GetAuditEventResponse
+	(*GetSavedTradesRequest)(nil),                     // 124: gctrpc.GetSavedTradesRequest
+	(*SavedTrades)(nil),                               // 125: gctrpc.SavedTrades
+	(*SavedTradesResponse)(nil),                       // 126: gctrpc.SavedTradesResponse
+	(*ConvertTradesToCandlesRequest)(nil),             // 127: gctrpc.ConvertTradesToCandlesRequest
+	(*GetHistoricCandlesRequest)(nil),                 // 128: gctrpc.GetHistoricCandlesRequest
+	(*GetHistoricCandlesResponse)(nil),                // 129: gctrpc.GetHistoricCandlesResponse
+	(*Candle)(nil),                                    // 130: gctrpc.Candle
+	(*AuditEvent)(nil),                                // 131: gctrpc.AuditEvent
+	(*GCTScript)(nil),                                 // 132: gctrpc.GCTScript
+	(*GCTScriptExecuteRequest)(nil),                   // 133: gctrpc.GCTScriptExecuteRequest
+	(*GCTScriptStopRequest)(nil),                      // 134: gctrpc.GCTScriptStopRequest
+	(*GCTScriptStopAllRequest)(nil),                   // 135: gctrpc.GCTScriptStopAllRequest
+	(*GCTScriptStatusRequest)(nil),                    // 136: gctrpc.GCTScriptStatusRequest
+	(*GCTScriptListAllRequest)(nil),                   // 137: gctrpc.GCTScriptListAllRequest
+	(*GCTScriptUploadRequest)(nil),                    // 138: gctrpc.GCTScriptUploadRequest
+	(*GCTScriptReadScriptRequest)(nil),                // 139: gctrpc.GCTScriptReadScriptRequest
+	(*GCTScriptQueryRequest)(nil),                     // 140: gctrpc.GCTScriptQueryRequest
+	(*GCTScriptAutoLoadRequest)(nil),                  // 141: gctrpc.GCTScriptAutoLoadRequest
+	(*GCTScriptStatusResponse)(nil),                   // 142: gctrpc.GCTScriptStatusResponse
+	(*GCTScriptQueryResponse)(nil),                    // 143: gctrpc.GCTScriptQueryResponse
+	(*GenericResponse)(nil),                           // 144: gctrpc.GenericResponse
+	(*SetExchangeAssetRequest)(nil),                   // 145: gctrpc.SetExchangeAssetRequest
+	(*SetExchangeAllPairsRequest)(nil),                // 146: gctrpc.SetExchangeAllPairsRequest
+	(*UpdateExchangeSupportedPairsRequest)(nil),       // 147: gctrpc.UpdateExchangeSupportedPairsRequest
+	(*GetExchangeAssetsRequest)(nil),                  // 148: gctrpc.GetExchangeAssetsRequest
+	(*GetExchangeAssetsResponse)(nil),                 // 149: gctrpc.GetExchangeAssetsResponse
+	(*WebsocketGetInfoRequest)(nil),                   // 150: gctrpc.WebsocketGetInfoRequest
+	(*WebsocketGetInfoResponse)(nil),                  // 151: gctrpc.WebsocketGetInfoResponse
+	(*WebsocketConnectionHealth)(nil),                 // 152: gctrpc.WebsocketConnectionHealth
+	(*WebsocketSetEnabledRequest)(nil),                // 153: gctrpc.WebsocketSetEnabledRequest
+	(*WebsocketGetSubscriptionsRequest)(nil),          // 154: gctrpc.WebsocketGetSubscriptionsRequest
+	(*WebsocketSubscription)(nil),                     // 155: gctrpc.WebsocketSubscription
+	(*WebsocketGetSubscriptionsResponse)(nil),         // 156: gctrpc.WebsocketGetSubscriptionsResponse
+	(*WebsocketSetProxyRequest)(nil),                  // 157: gctrpc.WebsocketSetProxyRequest
+	(*WebsocketSetURLRequest)(nil),                    // 158: gctrpc.WebsocketSetURLRequest
+	(*FindMissingCandlePeriodsRequest)(nil),           // 159: gctrpc.FindMissingCandlePeriodsRequest
+	(*FindMissingTradePeriodsRequest)(nil),            // 160: gctrpc.FindMissingTradePeriodsRequest
+	(*FindMissingIntervalsResponse)(nil),              // 161: gctrpc.FindMissingIntervalsResponse
+	(*SetExchangeTradeProcessingRequest)(nil),         // 162: gctrpc.SetExchangeTradeProcessingRequest
+	(*UpsertDataHistoryJobRequest)(nil),               // 163: gctrpc.UpsertDataHistoryJobRequest
+	(*InsertSequentialJobsRequest)(nil),               // 164: gctrpc.InsertSequentialJobsRequest
+	(*InsertSequentialJobsResponse)(nil),              // 165: gctrpc.InsertSequentialJobsResponse
+	(*UpsertDataHistoryJobResponse)(nil),              // 166: gctrpc.UpsertDataHistoryJobResponse
+	(*GetDataHistoryJobDetailsRequest)(nil),           // 167: gctrpc.GetDataHistoryJobDetailsRequest
+	(*DataHistoryJob)(nil),                            // 168: gctrpc.DataHistoryJob
+	(*DataHistoryJobResult)(nil),                      // 169: gctrpc.DataHistoryJobResult
+	(*DataHistoryJobs)(nil),                           // 170: gctrpc.DataHistoryJobs
+	(*GetDataHistoryJobsBetweenRequest)(nil),          // 171: gctrpc.GetDataHistoryJobsBetweenRequest
+	(*SetDataHistoryJobStatusRequest)(nil),            // 172: gctrpc.SetDataHistoryJobStatusRequest
+	(*UpdateDataHistoryJobPrerequisiteRequest)(nil),   // 173: gctrpc.UpdateDataHistoryJobPrerequisiteRequest
+	(*ModifyOrderRequest)(nil),                        // 174: gctrpc.ModifyOrderRequest
+	(*ModifyOrderResponse)(nil),                       // 175: gctrpc.ModifyOrderResponse
+	(*CurrencyStateGetAllRequest)(nil),                // 176: gctrpc.CurrencyStateGetAllRequest
+	(*CurrencyStateTradingRequest)(nil),               // 177: gctrpc.CurrencyStateTradingRequest
+	(*CurrencyStateTradingPairRequest)(nil),           // 178: gctrpc.CurrencyStateTradingPairRequest
+	(*CurrencyStateWithdrawRequest)(nil),              // 179: gctrpc.CurrencyStateWithdrawRequest
+	(*CurrencyStateDepositRequest)(nil),               // 180: gctrpc.CurrencyStateDepositRequest
+	(*CurrencyStateResponse)(nil),                     // 181: gctrpc.CurrencyStateResponse
+	(*CurrencyState)(nil),                             // 182: gctrpc.CurrencyState
+	(*FundingRate)(nil),                               // 183: gctrpc.FundingRate
+	(*FundingData)(nil),                               // 184: gctrpc.FundingData
+	(*FuturesPositionStats)(nil),                      // 185: gctrpc.FuturesPositionStats
+	(*FuturePosition)(nil),                            // 186: gctrpc.FuturePosition
+	(*GetManagedPositionRequest)(nil),                 // 187: gctrpc.GetManagedPositionRequest
+	(*GetAllManagedPositionsRequest)(nil),             // 188: gctrpc.GetAllManagedPositionsRequest
+	(*GetManagedPositionsResponse)(nil),               // 189: gctrpc.GetManagedPositionsResponse
+	(*GetFuturesPositionsSummaryRequest)(nil),         // 190: gctrpc.GetFuturesPositionsSummaryRequest
+	(*GetFuturesPositionsSummaryResponse)(nil),        // 191: gctrpc.GetFuturesPositionsSummaryResponse
+	(*GetFuturesPositionsOrdersRequest)(nil),          // 192: gctrpc.GetFuturesPositionsOrdersRequest
+	(*GetFuturesPositionsOrdersResponse)(nil),         // 193: gctrpc.GetFuturesPositionsOrdersResponse
+	(*GetCollateralModeRequest)(nil),                  // 194: gctrpc.GetCollateralModeRequest
+	(*GetCollateralModeResponse)(nil),                 // 195: gctrpc.GetCollateralModeResponse
+	(*SetCollateralModeRequest)(nil),                  // 196: gctrpc.SetCollateralModeRequest
+	(*SetCollateralModeResponse)(nil),                 // 197: gctrpc.SetCollateralModeResponse
+	(*GetMarginTypeRequest)(nil),                      // 198: gctrpc.GetMarginTypeRequest
+	(*GetMarginTypeResponse)(nil),                     // 199: gctrpc.GetMarginTypeResponse
+	(*ChangePositionMarginRequest)(nil),               // 200: gctrpc.ChangePositionMarginRequest
+	(*ChangePositionMarginResponse)(nil),              // 201: gctrpc.ChangePositionMarginResponse
+	(*SetMarginTypeRequest)(nil),                      // 202: gctrpc.SetMarginTypeRequest
+	(*SetMarginTypeResponse)(nil),                     // 203: gctrpc.SetMarginTypeResponse
+	(*GetLeverageRequest)(nil),                        // 204: gctrpc.GetLeverageRequest
+	(*GetLeverageResponse)(nil),                       // 205: gctrpc.GetLeverageResponse
+	(*SetLeverageRequest)(nil),                        // 206: gctrpc.SetLeverageRequest
+	(*SetLeverageResponse)(nil),                       // 207: gctrpc.SetLeverageResponse
+	(*GetCollateralRequest)(nil),                      // 208: gctrpc.GetCollateralRequest
+	(*GetCollateralResponse)(nil),                     // 209: gctrpc.GetCollateralResponse
+	(*CollateralForCurrency)(nil),                     // 210: gctrpc.CollateralForCurrency
+	(*CollateralByPosition)(nil),                      // 211: gctrpc.CollateralByPosition
+	(*CollateralUsedBreakdown)(nil),                   // 212: gctrpc.CollateralUsedBreakdown
+	(*GetFundingRatesRequest)(nil),                    // 213: gctrpc.GetFundingRatesRequest
+	(*GetFundingRatesResponse)(nil),                   // 214: gctrpc.GetFundingRatesResponse
+	(*GetLatestFundingRateRequest)(nil),               // 215: gctrpc.GetLatestFundingRateRequest
+	(*GetLatestFundingRateResponse)(nil),              // 216: gctrpc.GetLatestFundingRateResponse
+	(*ShutdownRequest)(nil),                           // 217: gctrpc.ShutdownRequest
+	(*ShutdownResponse)(nil),                          // 218: gctrpc.ShutdownResponse
+	(*GetTechnicalAnalysisRequest)(nil),               // 219: gctrpc.GetTechnicalAnalysisRequest
+	(*ListOfSignals)(nil),                             // 220: gctrpc.ListOfSignals
+	(*GetTechnicalAnalysisResponse)(nil),              // 221: gctrpc.GetTechnicalAnalysisResponse
+	(*GetMarginRatesHistoryRequest)(nil),              // 222: gctrpc.GetMarginRatesHistoryRequest
+	(*LendingPayment)(nil),                            // 223: gctrpc.LendingPayment
+	(*BorrowCost)(nil),                                // 224: gctrpc.BorrowCost
+	(*MarginRate)(nil),                                // 225: gctrpc.MarginRate
+	(*GetMarginRatesHistoryResponse)(nil),             // 226: gctrpc.GetMarginRatesHistoryResponse
+	(*GetOrderbookMovementRequest)(nil),               // 227: gctrpc.GetOrderbookMovementRequest
+	(*GetOrderbookMovementResponse)(nil),              // 228: gctrpc.GetOrderbookMovementResponse
+	(*GetOrderbookAmountByNominalRequest)(nil),        // 229: gctrpc.GetOrderbookAmountByNominalRequest
+	(*GetOrderbookAmountByNominalResponse)(nil),       // 230: gctrpc.GetOrderbookAmountByNominalResponse
+	(*GetOrderbookAmountByImpactRequest)(nil),         // 231: gctrpc.GetOrderbookAmountByImpactRequest
+	(*GetOrderbookAmountByImpactResponse)(nil),        // 232: gctrpc.GetOrderbookAmountByImpactResponse
+	(*GetOpenInterestRequest)(nil),                    // 233: gctrpc.GetOpenInterestRequest
+	(*OpenInterestDataRequest)(nil),                   // 234: gctrpc.OpenInterestDataRequest
+	(*GetOpenInterestResponse)(nil),                   // 235: gctrpc.GetOpenInterestResponse
+	(*OpenInterestDataResponse)(nil),                  // 236: gctrpc.OpenInterestDataResponse
+	(*GetCurrencyTradeURLRequest)(nil),                // 237: gctrpc.GetCurrencyTradeURLRequest
+	(*GetCurrencyTradeURLResponse)(nil),               // 238: gctrpc.GetCurrencyTradeURLResponse
+	(*GetCandleStreamRequest)(nil),                    // 239: gctrpc.GetCandleStreamRequest
+	(*CandleStreamResponse)(nil),                      // 240: gctrpc.CandleStreamResponse
+	(*GetRateLimitUsageRequest)(nil),                  // 241: gctrpc.GetRateLimitUsageRequest
+	(*RateLimitUsage)(nil),                            // 242: gctrpc.RateLimitUsage
+	(*GetRateLimitUsageResponse)(nil),                 // 243: gctrpc.GetRateLimitUsageResponse
+	(*GetMicrostructureStreamRequest)(nil),            // 244: gctrpc.GetMicrostructureStreamRequest
+	(*DepthAtBasisPoints)(nil),                        // 245: gctrpc.DepthAtBasisPoints
+	(*MicrostructureResponse)(nil),                    // 246: gctrpc.MicrostructureResponse
+	(*ReloadConfigRequest)(nil),                       // 247: gctrpc.ReloadConfigRequest
+	(*ReloadConfigResponse)(nil),                      // 248: gctrpc.ReloadConfigResponse
+	(*TreasuryTransfer)(nil),                          // 249: gctrpc.TreasuryTransfer
+	(*GetTreasuryTransfersRequest)(nil),               // 250: gctrpc.GetTreasuryTransfersRequest
+	(*RebalanceTreasuryRequest)(nil),                  // 251: gctrpc.RebalanceTreasuryRequest
+	(*TreasuryTransfersResponse)(nil),                 // 252: gctrpc.TreasuryTransfersResponse
+	(*FundingTransition)(nil),                         // 253: gctrpc.FundingTransition
+	(*FundingTransfer)(nil),                           // 254: gctrpc.FundingTransfer
+	(*GetFundingTransfersRequest)(nil),                // 255: gctrpc.GetFundingTransfersRequest
+	(*GetFundingTransfersResponse)(nil),               // 256: gctrpc.GetFundingTransfersResponse
+	nil,                                               // 257: gctrpc.GetInfoResponse.SubsystemStatusEntry
+	nil,                                               // 258: gctrpc.GetInfoResponse.RpcEndpointsEntry
+	nil,                                               // 259: gctrpc.GetCommunicationRelayersResponse.CommunicationRelayersEntry
+	nil,                                               // 260: gctrpc.GetSubsystemsResponse.SubsystemsStatusEntry
+	nil,                                               // 261: gctrpc.GetRPCEndpointsResponse.EndpointsEntry
+	nil,                                               // 262: gctrpc.GetExchangeOTPsResponse.OtpCodesEntry
+	nil,                                               // 263: gctrpc.GetExchangeInfoResponse.SupportedAssetsEntry
+	nil,                                               // 264: gctrpc.OnlineCoins.CoinsEntry
+	nil,                                               // 265: gctrpc.GetPortfolioSummaryResponse.CoinsOfflineSummaryEntry
+	nil,                                               // 266: gctrpc.GetPortfolioSummaryResponse.CoinsOnlineSummaryEntry
+	nil,                                               // 267: gctrpc.Orders.OrderStatusEntry
+	nil,                                               // 268: gctrpc.GetCryptocurrencyDepositAddressesResponse.AddressesEntry
+	nil,                                               // 269: gctrpc.GetExchangePairsResponse.SupportedAssetsEntry
+	nil,                                               // 270: gctrpc.GetTechnicalAnalysisResponse.SignalsEntry
+	(*timestamppb.Timestamp)(nil),                     // 271: google.protobuf.Timestamp
 }
 var file_rpc_proto_depIdxs = []int32{
-	226, // 0: gctrpc.GetInfoResponse.subsystem_status:type_name -> gctrpc.GetInfoResponse.SubsystemStatusEntry
-	227, // 1: gctrpc.GetInfoResponse.rpc_endpoints:type_name -> gctrpc.GetInfoResponse.RpcEndpointsEntry
-	228, // 2: gctrpc.GetCommunicationRelayersResponse.communication_relayers:type_name -> gctrpc.GetCommunicationRelayersResponse.CommunicationRelayersEntry
-	229, // 3: gctrpc.GetSubsystemsResponse.subsystems_status:type_name -> gctrpc.GetSubsystemsResponse.SubsystemsStatusEntry
-	230, // 4: gctrpc.GetRPCEndpointsResponse.endpoints:type_name -> gctrpc.GetRPCEndpointsResponse.EndpointsEntry
-	231, // 5: gctrpc.GetExchangeOTPsResponse.otp_codes:type_name -> gctrpc.GetExchangeOTPsResponse.OtpCodesEntry
-	232, // 6: gctrpc.GetExchangeInfoResponse.supported_assets:type_name -> gctrpc.GetExchangeInfoResponse.SupportedAssetsEntry
+	257, // 0: gctrpc.GetInfoResponse.subsystem_status:type_name -> gctrpc.GetInfoResponse.SubsystemStatusEntry
+	258, // 1: gctrpc.GetInfoResponse.rpc_endpoints:type_name -> gctrpc.GetInfoResponse.RpcEndpointsEntry
+	259, // 2: gctrpc.GetCommunicationRelayersResponse.communication_relayers:type_name -> gctrpc.GetCommunicationRelayersResponse.CommunicationRelayersEntry
+	260, // 3: gctrpc.GetSubsystemsResponse.subsystems_status:type_name -> gctrpc.GetSubsystemsResponse.SubsystemsStatusEntry
+	261, // 4: gctrpc.GetRPCEndpointsResponse.endpoints:type_name -> gctrpc.GetRPCEndpointsResponse.EndpointsEntry
+	262, // 5: gctrpc.GetExchangeOTPsResponse.otp_codes:type_name -> gctrpc.GetExchangeOTPsResponse.OtpCodesEntry
+	263, // 6: gctrpc.GetExchangeInfoResponse.supported_assets:type_name -> gctrpc.GetExchangeInfoResponse.SupportedAssetsEntry
 	21,  // 7: gctrpc.GetTickerRequest.pair:type_name -> gctrpc.CurrencyPair
 	21,  // 8: gctrpc.TickerResponse.pair:type_name -> gctrpc.CurrencyPair
 	22,  // 9: gctrpc.Tickers.tickers:type_name -> gctrpc.TickerResponse
@@ -16761,379 +19341,439 @@ var file_rpc_proto_depIdxs = []int32{
 	28,  // 15: gctrpc.Orderbooks.orderbooks:type_name -> gctrpc.OrderbookResponse
 	30,  // 16: gctrpc.GetOrderbooksResponse.orderbooks:type_name -> gctrpc.Orderbooks
 	34,  // 17: gctrpc.Account.currencies:type_name -> gctrpc.AccountCurrencyInfo
-	240, // 18: gctrpc.AccountCurrencyInfo.updated_at:type_name -> google.protobuf.Timestamp
-	33,  // 19: gctrpc.GetAccountBalancesResponse.accounts:type_name -> gctrpc.Account
-	38,  // 20: gctrpc.GetPortfolioResponse.portfolio:type_name -> gctrpc.PortfolioAddress
-	43,  // 21: gctrpc.OfflineCoins.addresses:type_name -> gctrpc.OfflineCoinSummary
-	233, // 22: gctrpc.OnlineCoins.coins:type_name -> gctrpc.OnlineCoins.CoinsEntry
-	42,  // 23: gctrpc.GetPortfolioSummaryResponse.coin_totals:type_name -> gctrpc.Coin
-	42,  // 24: gctrpc.GetPortfolioSummaryResponse.coins_offline:type_name -> gctrpc.Coin
-	234, // 25: gctrpc.GetPortfolioSummaryResponse.coins_offline_summary:type_name -> gctrpc.GetPortfolioSummaryResponse.CoinsOfflineSummaryEntry
-	42,  // 26: gctrpc.GetPortfolioSummaryResponse.coins_online:type_name -> gctrpc.Coin
-	235, // 27: gctrpc.GetPortfolioSummaryResponse.coins_online_summary:type_name -> gctrpc.GetPortfolioSummaryResponse.CoinsOnlineSummaryEntry
-	51,  // 28: gctrpc.GetForexProvidersResponse.forex_providers:type_name -> gctrpc.ForexProvider
-	54,  // 29: gctrpc.GetForexRatesResponse.forex_rates:type_name -> gctrpc.ForexRatesConversion
-	57,  // 30: gctrpc.OrderDetails.trades:type_name -> gctrpc.TradeHistory
-	21,  // 31: gctrpc.GetOrdersRequest.pair:type_name -> gctrpc.CurrencyPair
-	56,  // 32: gctrpc.GetOrdersResponse.orders:type_name -> gctrpc.OrderDetails
-	21,  // 33: gctrpc.GetOrderRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 34: gctrpc.SubmitOrderRequest.pair:type_name -> gctrpc.CurrencyPair
-	62,  // 35: gctrpc.SubmitOrderResponse.trades:type_name -> gctrpc.Trades
-	21,  // 36: gctrpc.SimulateOrderRequest.pair:type_name -> gctrpc.CurrencyPair
-	27,  // 37: gctrpc.SimulateOrderResponse.orders:type_name -> gctrpc.OrderbookItem
-	21,  // 38: gctrpc.WhaleBombRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 39: gctrpc.CancelOrderRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 40: gctrpc.CancelBatchOrdersRequest.pair:type_name -> gctrpc.CurrencyPair
-	236, // 41: gctrpc.Orders.order_status:type_name -> gctrpc.Orders.OrderStatusEntry
-	69,  // 42: gctrpc.CancelBatchOrdersResponse.orders:type_name -> gctrpc.Orders
-	69,  // 43: gctrpc.CancelAllOrdersResponse.orders:type_name -> gctrpc.Orders
-	74,  // 44: gctrpc.GetEventsResponse.condition_params:type_name -> gctrpc.ConditionParams
-	21,  // 45: gctrpc.GetEventsResponse.pair:type_name -> gctrpc.CurrencyPair
-	74,  // 46: gctrpc.AddEventRequest.condition_params:type_name -> gctrpc.ConditionParams
-	21,  // 47: gctrpc.AddEventRequest.pair:type_name -> gctrpc.CurrencyPair
-	80,  // 48: gctrpc.DepositAddresses.addresses:type_name -> gctrpc.DepositAddress
-	237, // 49: gctrpc.GetCryptocurrencyDepositAddressesResponse.addresses:type_name -> gctrpc.GetCryptocurrencyDepositAddressesResponse.AddressesEntry
-	95,  // 50: gctrpc.WithdrawalEventByIDResponse.event:type_name -> gctrpc.WithdrawalEventResponse
-	95,  // 51: gctrpc.WithdrawalEventsByExchangeResponse.event:type_name -> gctrpc.WithdrawalEventResponse
-	96,  // 52: gctrpc.WithdrawalEventResponse.exchange:type_name -> gctrpc.WithdrawalExchangeEvent
-	97,  // 53: gctrpc.WithdrawalEventResponse.request:type_name -> gctrpc.WithdrawalRequestEvent
-	240, // 54: gctrpc.WithdrawalEventResponse.created_at:type_name -> google.protobuf.Timestamp
-	240, // 55: gctrpc.WithdrawalEventResponse.updated_at:type_name -> google.protobuf.Timestamp
-	98,  // 56: gctrpc.WithdrawalRequestEvent.fiat:type_name -> gctrpc.FiatWithdrawalEvent
-	99,  // 57: gctrpc.WithdrawalRequestEvent.crypto:type_name -> gctrpc.CryptoWithdrawalEvent
-	238, // 58: gctrpc.GetExchangePairsResponse.supported_assets:type_name -> gctrpc.GetExchangePairsResponse.SupportedAssetsEntry
-	21,  // 59: gctrpc.SetExchangePairRequest.pairs:type_name -> gctrpc.CurrencyPair
-	21,  // 60: gctrpc.GetOrderbookStreamRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 61: gctrpc.GetTickerStreamRequest.pair:type_name -> gctrpc.CurrencyPair
-	119, // 62: gctrpc.GetAuditEventResponse.events:type_name -> gctrpc.AuditEvent
-	21,  // 63: gctrpc.GetSavedTradesRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 64: gctrpc.SavedTradesResponse.pair:type_name -> gctrpc.CurrencyPair
-	113, // 65: gctrpc.SavedTradesResponse.trades:type_name -> gctrpc.SavedTrades
-	21,  // 66: gctrpc.ConvertTradesToCandlesRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 67: gctrpc.GetHistoricCandlesRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 68: gctrpc.GetHistoricCandlesResponse.pair:type_name -> gctrpc.CurrencyPair
-	118, // 69: gctrpc.GetHistoricCandlesResponse.candle:type_name -> gctrpc.Candle
-	120, // 70: gctrpc.GCTScriptExecuteRequest.script:type_name -> gctrpc.GCTScript
-	120, // 71: gctrpc.GCTScriptStopRequest.script:type_name -> gctrpc.GCTScript
-	120, // 72: gctrpc.GCTScriptReadScriptRequest.script:type_name -> gctrpc.GCTScript
-	120, // 73: gctrpc.GCTScriptQueryRequest.script:type_name -> gctrpc.GCTScript
-	120, // 74: gctrpc.GCTScriptStatusResponse.scripts:type_name -> gctrpc.GCTScript
-	120, // 75: gctrpc.GCTScriptQueryResponse.script:type_name -> gctrpc.GCTScript
-	142, // 76: gctrpc.WebsocketGetSubscriptionsResponse.subscriptions:type_name -> gctrpc.WebsocketSubscription
-	21,  // 77: gctrpc.FindMissingCandlePeriodsRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 78: gctrpc.FindMissingTradePeriodsRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 79: gctrpc.FindMissingIntervalsResponse.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 80: gctrpc.UpsertDataHistoryJobRequest.pair:type_name -> gctrpc.CurrencyPair
-	150, // 81: gctrpc.InsertSequentialJobsRequest.jobs:type_name -> gctrpc.UpsertDataHistoryJobRequest
-	153, // 82: gctrpc.InsertSequentialJobsResponse.jobs:type_name -> gctrpc.UpsertDataHistoryJobResponse
-	21,  // 83: gctrpc.DataHistoryJob.pair:type_name -> gctrpc.CurrencyPair
-	156, // 84: gctrpc.DataHistoryJob.job_results:type_name -> gctrpc.DataHistoryJobResult
-	155, // 85: gctrpc.DataHistoryJobs.results:type_name -> gctrpc.DataHistoryJob
-	21,  // 86: gctrpc.ModifyOrderRequest.pair:type_name -> gctrpc.CurrencyPair
-	169, // 87: gctrpc.CurrencyStateResponse.currency_states:type_name -> gctrpc.CurrencyState
-	21,  // 88: gctrpc.FundingData.pair:type_name -> gctrpc.CurrencyPair
-	170, // 89: gctrpc.FundingData.rates:type_name -> gctrpc.FundingRate
-	170, // 90: gctrpc.FundingData.latest_rate:type_name -> gctrpc.FundingRate
-	170, // 91: gctrpc.FundingData.upcoming_rate:type_name -> gctrpc.FundingRate
-	21,  // 92: gctrpc.FuturePosition.pair:type_name -> gctrpc.CurrencyPair
-	56,  // 93: gctrpc.FuturePosition.orders:type_name -> gctrpc.OrderDetails
-	172, // 94: gctrpc.FuturePosition.position_stats:type_name -> gctrpc.FuturesPositionStats
-	171, // 95: gctrpc.FuturePosition.funding_data:type_name -> gctrpc.FundingData
-	21,  // 96: gctrpc.GetManagedPositionRequest.pair:type_name -> gctrpc.CurrencyPair
-	173, // 97: gctrpc.GetManagedPositionsResponse.positions:type_name -> gctrpc.FuturePosition
-	21,  // 98: gctrpc.GetFuturesPositionsSummaryRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 99: gctrpc.GetFuturesPositionsSummaryRequest.underlying_pair:type_name -> gctrpc.CurrencyPair
-	21,  // 100: gctrpc.GetFuturesPositionsSummaryResponse.pair:type_name -> gctrpc.CurrencyPair
-	172, // 101: gctrpc.GetFuturesPositionsSummaryResponse.position_stats:type_name -> gctrpc.FuturesPositionStats
-	21,  // 102: gctrpc.GetFuturesPositionsOrdersRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 103: gctrpc.GetFuturesPositionsOrdersRequest.underlying_pair:type_name -> gctrpc.CurrencyPair
-	173, // 104: gctrpc.GetFuturesPositionsOrdersResponse.positions:type_name -> gctrpc.FuturePosition
-	21,  // 105: gctrpc.GetMarginTypeRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 106: gctrpc.GetMarginTypeResponse.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 107: gctrpc.ChangePositionMarginRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 108: gctrpc.ChangePositionMarginResponse.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 109: gctrpc.SetMarginTypeRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 110: gctrpc.SetMarginTypeResponse.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 111: gctrpc.GetLeverageRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 112: gctrpc.GetLeverageRequest.underlying_pair:type_name -> gctrpc.CurrencyPair
-	21,  // 113: gctrpc.GetLeverageResponse.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 114: gctrpc.GetLeverageResponse.underlying_pair:type_name -> gctrpc.CurrencyPair
-	21,  // 115: gctrpc.SetLeverageRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 116: gctrpc.SetLeverageRequest.underlying_pair:type_name -> gctrpc.CurrencyPair
-	21,  // 117: gctrpc.SetLeverageResponse.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 118: gctrpc.SetLeverageResponse.underlying_pair:type_name -> gctrpc.CurrencyPair
-	199, // 119: gctrpc.GetCollateralResponse.used_breakdown:type_name -> gctrpc.CollateralUsedBreakdown
-	197, // 120: gctrpc.GetCollateralResponse.currency_breakdown:type_name -> gctrpc.CollateralForCurrency
-	198, // 121: gctrpc.GetCollateralResponse.position_breakdown:type_name -> gctrpc.CollateralByPosition
-	199, // 122: gctrpc.CollateralForCurrency.used_breakdown:type_name -> gctrpc.CollateralUsedBreakdown
-	21,  // 123: gctrpc.GetFundingRatesRequest.pair:type_name -> gctrpc.CurrencyPair
-	171, // 124: gctrpc.GetFundingRatesResponse.rates:type_name -> gctrpc.FundingData
-	21,  // 125: gctrpc.GetLatestFundingRateRequest.pair:type_name -> gctrpc.CurrencyPair
-	171, // 126: gctrpc.GetLatestFundingRateResponse.rate:type_name -> gctrpc.FundingData
-	21,  // 127: gctrpc.GetTechnicalAnalysisRequest.pair:type_name -> gctrpc.CurrencyPair
-	240, // 128: gctrpc.GetTechnicalAnalysisRequest.start:type_name -> google.protobuf.Timestamp
-	240, // 129: gctrpc.GetTechnicalAnalysisRequest.end:type_name -> google.protobuf.Timestamp
-	21,  // 130: gctrpc.GetTechnicalAnalysisRequest.other_pair:type_name -> gctrpc.CurrencyPair
-	239, // 131: gctrpc.GetTechnicalAnalysisResponse.signals:type_name -> gctrpc.GetTechnicalAnalysisResponse.SignalsEntry
-	212, // 132: gctrpc.GetMarginRatesHistoryRequest.rates:type_name -> gctrpc.MarginRate
-	210, // 133: gctrpc.MarginRate.lending_payment:type_name -> gctrpc.LendingPayment
-	211, // 134: gctrpc.MarginRate.borrow_cost:type_name -> gctrpc.BorrowCost
-	212, // 135: gctrpc.GetMarginRatesHistoryResponse.rates:type_name -> gctrpc.MarginRate
-	212, // 136: gctrpc.GetMarginRatesHistoryResponse.latest_rate:type_name -> gctrpc.MarginRate
-	212, // 137: gctrpc.GetMarginRatesHistoryResponse.predicted_rate:type_name -> gctrpc.MarginRate
-	21,  // 138: gctrpc.GetOrderbookMovementRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 139: gctrpc.GetOrderbookAmountByNominalRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 140: gctrpc.GetOrderbookAmountByImpactRequest.pair:type_name -> gctrpc.CurrencyPair
-	221, // 141: gctrpc.GetOpenInterestRequest.data:type_name -> gctrpc.OpenInterestDataRequest
-	21,  // 142: gctrpc.OpenInterestDataRequest.pair:type_name -> gctrpc.CurrencyPair
-	223, // 143: gctrpc.GetOpenInterestResponse.data:type_name -> gctrpc.OpenInterestDataResponse
-	21,  // 144: gctrpc.OpenInterestDataResponse.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 145: gctrpc.GetCurrencyTradeURLRequest.pair:type_name -> gctrpc.CurrencyPair
-	9,   // 146: gctrpc.GetInfoResponse.RpcEndpointsEntry.value:type_name -> gctrpc.RPCEndpoint
-	3,   // 147: gctrpc.GetCommunicationRelayersResponse.CommunicationRelayersEntry.value:type_name -> gctrpc.CommunicationRelayer
-	9,   // 148: gctrpc.GetRPCEndpointsResponse.EndpointsEntry.value:type_name -> gctrpc.RPCEndpoint
-	18,  // 149: gctrpc.GetExchangeInfoResponse.SupportedAssetsEntry.value:type_name -> gctrpc.PairsSupported
-	44,  // 150: gctrpc.OnlineCoins.CoinsEntry.value:type_name -> gctrpc.OnlineCoinSummary
-	45,  // 151: gctrpc.GetPortfolioSummaryResponse.CoinsOfflineSummaryEntry.value:type_name -> gctrpc.OfflineCoins
-	46,  // 152: gctrpc.GetPortfolioSummaryResponse.CoinsOnlineSummaryEntry.value:type_name -> gctrpc.OnlineCoins
-	81,  // 153: gctrpc.GetCryptocurrencyDepositAddressesResponse.AddressesEntry.value:type_name -> gctrpc.DepositAddresses
-	18,  // 154: gctrpc.GetExchangePairsResponse.SupportedAssetsEntry.value:type_name -> gctrpc.PairsSupported
-	207, // 155: gctrpc.GetTechnicalAnalysisResponse.SignalsEntry.value:type_name -> gctrpc.ListOfSignals
-	0,   // 156: gctrpc.GoCryptoTraderService.GetInfo:input_type -> gctrpc.GetInfoRequest
-	6,   // 157: gctrpc.GoCryptoTraderService.GetSubsystems:input_type -> gctrpc.GetSubsystemsRequest
-	5,   // 158: gctrpc.GoCryptoTraderService.EnableSubsystem:input_type -> gctrpc.GenericSubsystemRequest
-	5,   // 159: gctrpc.GoCryptoTraderService.DisableSubsystem:input_type -> gctrpc.GenericSubsystemRequest
-	8,   // 160: gctrpc.GoCryptoTraderService.GetRPCEndpoints:input_type -> gctrpc.GetRPCEndpointsRequest
-	2,   // 161: gctrpc.GoCryptoTraderService.GetCommunicationRelayers:input_type -> gctrpc.GetCommunicationRelayersRequest
-	12,  // 162: gctrpc.GoCryptoTraderService.GetExchanges:input_type -> gctrpc.GetExchangesRequest
-	11,  // 163: gctrpc.GoCryptoTraderService.DisableExchange:input_type -> gctrpc.GenericExchangeNameRequest
-	11,  // 164: gctrpc.GoCryptoTraderService.GetExchangeInfo:input_type -> gctrpc.GenericExchangeNameRequest
-	11,  // 165: gctrpc.GoCryptoTraderService.GetExchangeOTPCode:input_type -> gctrpc.GenericExchangeNameRequest
-	15,  // 166: gctrpc.GoCryptoTraderService.GetExchangeOTPCodes:input_type -> gctrpc.GetExchangeOTPsRequest
-	11,  // 167: gctrpc.GoCryptoTraderService.EnableExchange:input_type -> gctrpc.GenericExchangeNameRequest
-	20,  // 168: gctrpc.GoCryptoTraderService.GetTicker:input_type -> gctrpc.GetTickerRequest
-	23,  // 169: gctrpc.GoCryptoTraderService.GetTickers:input_type -> gctrpc.GetTickersRequest
-	26,  // 170: gctrpc.GoCryptoTraderService.GetOrderbook:input_type -> gctrpc.GetOrderbookRequest
-	29,  // 171: gctrpc.GoCryptoTraderService.GetOrderbooks:input_type -> gctrpc.GetOrderbooksRequest
-	32,  // 172: gctrpc.GoCryptoTraderService.GetAccountBalances:input_type -> gctrpc.GetAccountBalancesRequest
-	32,  // 173: gctrpc.GoCryptoTraderService.UpdateAccountBalances:input_type -> gctrpc.GetAccountBalancesRequest
-	32,  // 174: gctrpc.GoCryptoTraderService.GetAccountBalancesStream:input_type -> gctrpc.GetAccountBalancesRequest
-	36,  // 175: gctrpc.GoCryptoTraderService.GetConfig:input_type -> gctrpc.GetConfigRequest
-	39,  // 176: gctrpc.GoCryptoTraderService.GetPortfolio:input_type -> gctrpc.GetPortfolioRequest
-	41,  // 177: gctrpc.GoCryptoTraderService.GetPortfolioSummary:input_type -> gctrpc.GetPortfolioSummaryRequest
-	48,  // 178: gctrpc.GoCryptoTraderService.AddPortfolioAddress:input_type -> gctrpc.AddPortfolioAddressRequest
-	49,  // 179: gctrpc.GoCryptoTraderService.RemovePortfolioAddress:input_type -> gctrpc.RemovePortfolioAddressRequest
-	50,  // 180: gctrpc.GoCryptoTraderService.GetForexProviders:input_type -> gctrpc.GetForexProvidersRequest
-	53,  // 181: gctrpc.GoCryptoTraderService.GetForexRates:input_type -> gctrpc.GetForexRatesRequest
-	58,  // 182: gctrpc.GoCryptoTraderService.GetOrders:input_type -> gctrpc.GetOrdersRequest
-	60,  // 183: gctrpc.GoCryptoTraderService.GetOrder:input_type -> gctrpc.GetOrderRequest
-	61,  // 184: gctrpc.GoCryptoTraderService.SubmitOrder:input_type -> gctrpc.SubmitOrderRequest
-	64,  // 185: gctrpc.GoCryptoTraderService.SimulateOrder:input_type -> gctrpc.SimulateOrderRequest
-	66,  // 186: gctrpc.GoCryptoTraderService.WhaleBomb:input_type -> gctrpc.WhaleBombRequest
-	67,  // 187: gctrpc.GoCryptoTraderService.CancelOrder:input_type -> gctrpc.CancelOrderRequest
-	68,  // 188: gctrpc.GoCryptoTraderService.CancelBatchOrders:input_type -> gctrpc.CancelBatchOrdersRequest
-	71,  // 189: gctrpc.GoCryptoTraderService.CancelAllOrders:input_type -> gctrpc.CancelAllOrdersRequest
-	73,  // 190: gctrpc.GoCryptoTraderService.GetEvents:input_type -> gctrpc.GetEventsRequest
-	76,  // 191: gctrpc.GoCryptoTraderService.AddEvent:input_type -> gctrpc.AddEventRequest
-	78,  // 192: gctrpc.GoCryptoTraderService.RemoveEvent:input_type -> gctrpc.RemoveEventRequest
-	79,  // 193: gctrpc.GoCryptoTraderService.GetCryptocurrencyDepositAddresses:input_type -> gctrpc.GetCryptocurrencyDepositAddressesRequest
-	83,  // 194: gctrpc.GoCryptoTraderService.GetCryptocurrencyDepositAddress:input_type -> gctrpc.GetCryptocurrencyDepositAddressRequest
-	85,  // 195: gctrpc.GoCryptoTraderService.GetAvailableTransferChains:input_type -> gctrpc.GetAvailableTransferChainsRequest
-	87,  // 196: gctrpc.GoCryptoTraderService.WithdrawFiatFunds:input_type -> gctrpc.WithdrawFiatRequest
-	88,  // 197: gctrpc.GoCryptoTraderService.WithdrawCryptocurrencyFunds:input_type -> gctrpc.WithdrawCryptoRequest
-	90,  // 198: gctrpc.GoCryptoTraderService.WithdrawalEventByID:input_type -> gctrpc.WithdrawalEventByIDRequest
-	92,  // 199: gctrpc.GoCryptoTraderService.WithdrawalEventsByExchange:input_type -> gctrpc.WithdrawalEventsByExchangeRequest
-	93,  // 200: gctrpc.GoCryptoTraderService.WithdrawalEventsByDate:input_type -> gctrpc.WithdrawalEventsByDateRequest
-	100, // 201: gctrpc.GoCryptoTraderService.GetLoggerDetails:input_type -> gctrpc.GetLoggerDetailsRequest
-	102, // 202: gctrpc.GoCryptoTraderService.SetLoggerDetails:input_type -> gctrpc.SetLoggerDetailsRequest
-	103, // 203: gctrpc.GoCryptoTraderService.GetExchangePairs:input_type -> gctrpc.GetExchangePairsRequest
-	105, // 204: gctrpc.GoCryptoTraderService.SetExchangePair:input_type -> gctrpc.SetExchangePairRequest
-	106, // 205: gctrpc.GoCryptoTraderService.GetOrderbookStream:input_type -> gctrpc.GetOrderbookStreamRequest
-	107, // 206: gctrpc.GoCryptoTraderService.GetExchangeOrderbookStream:input_type -> gctrpc.GetExchangeOrderbookStreamRequest
-	108, // 207: gctrpc.GoCryptoTraderService.GetTickerStream:input_type -> gctrpc.GetTickerStreamRequest
-	109, // 208: gctrpc.GoCryptoTraderService.GetExchangeTickerStream:input_type -> gctrpc.GetExchangeTickerStreamRequest
-	110, // 209: gctrpc.GoCryptoTraderService.GetAuditEvent:input_type -> gctrpc.GetAuditEventRequest
-	121, // 210: gctrpc.GoCryptoTraderService.GCTScriptExecute:input_type -> gctrpc.GCTScriptExecuteRequest
-	126, // 211: gctrpc.GoCryptoTraderService.GCTScriptUpload:input_type -> gctrpc.GCTScriptUploadRequest
-	127, // 212: gctrpc.GoCryptoTraderService.GCTScriptReadScript:input_type -> gctrpc.GCTScriptReadScriptRequest
-	124, // 213: gctrpc.GoCryptoTraderService.GCTScriptStatus:input_type -> gctrpc.GCTScriptStatusRequest
-	128, // 214: gctrpc.GoCryptoTraderService.GCTScriptQuery:input_type -> gctrpc.GCTScriptQueryRequest
-	122, // 215: gctrpc.GoCryptoTraderService.GCTScriptStop:input_type -> gctrpc.GCTScriptStopRequest
-	123, // 216: gctrpc.GoCryptoTraderService.GCTScriptStopAll:input_type -> gctrpc.GCTScriptStopAllRequest
-	125, // 217: gctrpc.GoCryptoTraderService.GCTScriptListAll:input_type -> gctrpc.GCTScriptListAllRequest
-	129, // 218: gctrpc.GoCryptoTraderService.GCTScriptAutoLoadToggle:input_type -> gctrpc.GCTScriptAutoLoadRequest
-	116, // 219: gctrpc.GoCryptoTraderService.GetHistoricCandles:input_type -> gctrpc.GetHistoricCandlesRequest
-	133, // 220: gctrpc.GoCryptoTraderService.SetExchangeAsset:input_type -> gctrpc.SetExchangeAssetRequest
-	134, // 221: gctrpc.GoCryptoTraderService.SetAllExchangePairs:input_type -> gctrpc.SetExchangeAllPairsRequest
-	135, // 222: gctrpc.GoCryptoTraderService.UpdateExchangeSupportedPairs:input_type -> gctrpc.UpdateExchangeSupportedPairsRequest
-	136, // 223: gctrpc.GoCryptoTraderService.GetExchangeAssets:input_type -> gctrpc.GetExchangeAssetsRequest
-	138, // 224: gctrpc.GoCryptoTraderService.WebsocketGetInfo:input_type -> gctrpc.WebsocketGetInfoRequest
-	140, // 225: gctrpc.GoCryptoTraderService.WebsocketSetEnabled:input_type -> gctrpc.WebsocketSetEnabledRequest
-	141, // 226: gctrpc.GoCryptoTraderService.WebsocketGetSubscriptions:input_type -> gctrpc.WebsocketGetSubscriptionsRequest
-	144, // 227: gctrpc.GoCryptoTraderService.WebsocketSetProxy:input_type -> gctrpc.WebsocketSetProxyRequest
-	145, // 228: gctrpc.GoCryptoTraderService.WebsocketSetURL:input_type -> gctrpc.WebsocketSetURLRequest
-	112, // 229: gctrpc.GoCryptoTraderService.GetRecentTrades:input_type -> gctrpc.GetSavedTradesRequest
-	112, // 230: gctrpc.GoCryptoTraderService.GetHistoricTrades:input_type -> gctrpc.GetSavedTradesRequest
-	112, // 231: gctrpc.GoCryptoTraderService.GetSavedTrades:input_type -> gctrpc.GetSavedTradesRequest
-	115, // 232: gctrpc.GoCryptoTraderService.ConvertTradesToCandles:input_type -> gctrpc.ConvertTradesToCandlesRequest
-	146, // 233: gctrpc.GoCryptoTraderService.FindMissingSavedCandleIntervals:input_type -> gctrpc.FindMissingCandlePeriodsRequest
-	147, // 234: gctrpc.GoCryptoTraderService.FindMissingSavedTradeIntervals:input_type -> gctrpc.FindMissingTradePeriodsRequest
-	149, // 235: gctrpc.GoCryptoTraderService.SetExchangeTradeProcessing:input_type -> gctrpc.SetExchangeTradeProcessingRequest
-	150, // 236: gctrpc.GoCryptoTraderService.UpsertDataHistoryJob:input_type -> gctrpc.UpsertDataHistoryJobRequest
-	154, // 237: gctrpc.GoCryptoTraderService.GetDataHistoryJobDetails:input_type -> gctrpc.GetDataHistoryJobDetailsRequest
-	0,   // 238: gctrpc.GoCryptoTraderService.GetActiveDataHistoryJobs:input_type -> gctrpc.GetInfoRequest
-	158, // 239: gctrpc.GoCryptoTraderService.GetDataHistoryJobsBetween:input_type -> gctrpc.GetDataHistoryJobsBetweenRequest
-	154, // 240: gctrpc.GoCryptoTraderService.GetDataHistoryJobSummary:input_type -> gctrpc.GetDataHistoryJobDetailsRequest
-	159, // 241: gctrpc.GoCryptoTraderService.SetDataHistoryJobStatus:input_type -> gctrpc.SetDataHistoryJobStatusRequest
-	160, // 242: gctrpc.GoCryptoTraderService.UpdateDataHistoryJobPrerequisite:input_type -> gctrpc.UpdateDataHistoryJobPrerequisiteRequest
-	58,  // 243: gctrpc.GoCryptoTraderService.GetManagedOrders:input_type -> gctrpc.GetOrdersRequest
-	161, // 244: gctrpc.GoCryptoTraderService.ModifyOrder:input_type -> gctrpc.ModifyOrderRequest
-	163, // 245: gctrpc.GoCryptoTraderService.CurrencyStateGetAll:input_type -> gctrpc.CurrencyStateGetAllRequest
-	164, // 246: gctrpc.GoCryptoTraderService.CurrencyStateTrading:input_type -> gctrpc.CurrencyStateTradingRequest
-	167, // 247: gctrpc.GoCryptoTraderService.CurrencyStateDeposit:input_type -> gctrpc.CurrencyStateDepositRequest
-	166, // 248: gctrpc.GoCryptoTraderService.CurrencyStateWithdraw:input_type -> gctrpc.CurrencyStateWithdrawRequest
-	165, // 249: gctrpc.GoCryptoTraderService.CurrencyStateTradingPair:input_type -> gctrpc.CurrencyStateTradingPairRequest
-	177, // 250: gctrpc.GoCryptoTraderService.GetFuturesPositionsSummary:input_type -> gctrpc.GetFuturesPositionsSummaryRequest
-	179, // 251: gctrpc.GoCryptoTraderService.GetFuturesPositionsOrders:input_type -> gctrpc.GetFuturesPositionsOrdersRequest
-	195, // 252: gctrpc.GoCryptoTraderService.GetCollateral:input_type -> gctrpc.GetCollateralRequest
-	204, // 253: gctrpc.GoCryptoTraderService.Shutdown:input_type -> gctrpc.ShutdownRequest
-	206, // 254: gctrpc.GoCryptoTraderService.GetTechnicalAnalysis:input_type -> gctrpc.GetTechnicalAnalysisRequest
-	209, // 255: gctrpc.GoCryptoTraderService.GetMarginRatesHistory:input_type -> gctrpc.GetMarginRatesHistoryRequest
-	174, // 256: gctrpc.GoCryptoTraderService.GetManagedPosition:input_type -> gctrpc.GetManagedPositionRequest
-	175, // 257: gctrpc.GoCryptoTraderService.GetAllManagedPositions:input_type -> gctrpc.GetAllManagedPositionsRequest
-	200, // 258: gctrpc.GoCryptoTraderService.GetFundingRates:input_type -> gctrpc.GetFundingRatesRequest
-	202, // 259: gctrpc.GoCryptoTraderService.GetLatestFundingRate:input_type -> gctrpc.GetLatestFundingRateRequest
-	214, // 260: gctrpc.GoCryptoTraderService.GetOrderbookMovement:input_type -> gctrpc.GetOrderbookMovementRequest
-	216, // 261: gctrpc.GoCryptoTraderService.GetOrderbookAmountByNominal:input_type -> gctrpc.GetOrderbookAmountByNominalRequest
-	218, // 262: gctrpc.GoCryptoTraderService.GetOrderbookAmountByImpact:input_type -> gctrpc.GetOrderbookAmountByImpactRequest
-	181, // 263: gctrpc.GoCryptoTraderService.GetCollateralMode:input_type -> gctrpc.GetCollateralModeRequest
-	191, // 264: gctrpc.GoCryptoTraderService.GetLeverage:input_type -> gctrpc.GetLeverageRequest
-	183, // 265: gctrpc.GoCryptoTraderService.SetCollateralMode:input_type -> gctrpc.SetCollateralModeRequest
-	189, // 266: gctrpc.GoCryptoTraderService.SetMarginType:input_type -> gctrpc.SetMarginTypeRequest
-	193, // 267: gctrpc.GoCryptoTraderService.SetLeverage:input_type -> gctrpc.SetLeverageRequest
-	187, // 268: gctrpc.GoCryptoTraderService.ChangePositionMargin:input_type -> gctrpc.ChangePositionMarginRequest
-	220, // 269: gctrpc.GoCryptoTraderService.GetOpenInterest:input_type -> gctrpc.GetOpenInterestRequest
-	224, // 270: gctrpc.GoCryptoTraderService.GetCurrencyTradeURL:input_type -> gctrpc.GetCurrencyTradeURLRequest
-	1,   // 271: gctrpc.GoCryptoTraderService.GetInfo:output_type -> gctrpc.GetInfoResponse
-	7,   // 272: gctrpc.GoCryptoTraderService.GetSubsystems:output_type -> gctrpc.GetSubsystemsResponse
-	132, // 273: gctrpc.GoCryptoTraderService.EnableSubsystem:output_type -> gctrpc.GenericResponse
-	132, // 274: gctrpc.GoCryptoTraderService.DisableSubsystem:output_type -> gctrpc.GenericResponse
-	10,  // 275: gctrpc.GoCryptoTraderService.GetRPCEndpoints:output_type -> gctrpc.GetRPCEndpointsResponse
-	4,   // 276: gctrpc.GoCryptoTraderService.GetCommunicationRelayers:output_type -> gctrpc.GetCommunicationRelayersResponse
-	13,  // 277: gctrpc.GoCryptoTraderService.GetExchanges:output_type -> gctrpc.GetExchangesResponse
-	132, // 278: gctrpc.GoCryptoTraderService.DisableExchange:output_type -> gctrpc.GenericResponse
-	19,  // 279: gctrpc.GoCryptoTraderService.GetExchangeInfo:output_type -> gctrpc.GetExchangeInfoResponse
-	14,  // 280: gctrpc.GoCryptoTraderService.GetExchangeOTPCode:output_type -> gctrpc.GetExchangeOTPResponse
-	16,  // 281: gctrpc.GoCryptoTraderService.GetExchangeOTPCodes:output_type -> gctrpc.GetExchangeOTPsResponse
-	132, // 282: gctrpc.GoCryptoTraderService.EnableExchange:output_type -> gctrpc.GenericResponse
-	22,  // 283: gctrpc.GoCryptoTraderService.GetTicker:output_type -> gctrpc.TickerResponse
-	25,  // 284: gctrpc.GoCryptoTraderService.GetTickers:output_type -> gctrpc.GetTickersResponse
-	28,  // 285: gctrpc.GoCryptoTraderService.GetOrderbook:output_type -> gctrpc.OrderbookResponse
-	31,  // 286: gctrpc.GoCryptoTraderService.GetOrderbooks:output_type -> gctrpc.GetOrderbooksResponse
-	35,  // 287: gctrpc.GoCryptoTraderService.GetAccountBalances:output_type -> gctrpc.GetAccountBalancesResponse
-	35,  // 288: gctrpc.GoCryptoTraderService.UpdateAccountBalances:output_type -> gctrpc.GetAccountBalancesResponse
-	35,  // 289: gctrpc.GoCryptoTraderService.GetAccountBalancesStream:output_type -> gctrpc.GetAccountBalancesResponse
-	37,  // 290: gctrpc.GoCryptoTraderService.GetConfig:output_type -> gctrpc.GetConfigResponse
-	40,  // 291: gctrpc.GoCryptoTraderService.GetPortfolio:output_type -> gctrpc.GetPortfolioResponse
-	47,  // 292: gctrpc.GoCryptoTraderService.GetPortfolioSummary:output_type -> gctrpc.GetPortfolioSummaryResponse
-	132, // 293: gctrpc.GoCryptoTraderService.AddPortfolioAddress:output_type -> gctrpc.GenericResponse
-	132, // 294: gctrpc.GoCryptoTraderService.RemovePortfolioAddress:output_type -> gctrpc.GenericResponse
-	52,  // 295: gctrpc.GoCryptoTraderService.GetForexProviders:output_type -> gctrpc.GetForexProvidersResponse
-	55,  // 296: gctrpc.GoCryptoTraderService.GetForexRates:output_type -> gctrpc.GetForexRatesResponse
-	59,  // 297: gctrpc.GoCryptoTraderService.GetOrders:output_type -> gctrpc.GetOrdersResponse
-	56,  // 298: gctrpc.GoCryptoTraderService.GetOrder:output_type -> gctrpc.OrderDetails
-	63,  // 299: gctrpc.GoCryptoTraderService.SubmitOrder:output_type -> gctrpc.SubmitOrderResponse
-	65,  // 300: gctrpc.GoCryptoTraderService.SimulateOrder:output_type -> gctrpc.SimulateOrderResponse
-	65,  // 301: gctrpc.GoCryptoTraderService.WhaleBomb:output_type -> gctrpc.SimulateOrderResponse
-	132, // 302: gctrpc.GoCryptoTraderService.CancelOrder:output_type -> gctrpc.GenericResponse
-	70,  // 303: gctrpc.GoCryptoTraderService.CancelBatchOrders:output_type -> gctrpc.CancelBatchOrdersResponse
-	72,  // 304: gctrpc.GoCryptoTraderService.CancelAllOrders:output_type -> gctrpc.CancelAllOrdersResponse
-	75,  // 305: gctrpc.GoCryptoTraderService.GetEvents:output_type -> gctrpc.GetEventsResponse
-	77,  // 306: gctrpc.GoCryptoTraderService.AddEvent:output_type -> gctrpc.AddEventResponse
-	132, // 307: gctrpc.GoCryptoTraderService.RemoveEvent:output_type -> gctrpc.GenericResponse
-	82,  // 308: gctrpc.GoCryptoTraderService.GetCryptocurrencyDepositAddresses:output_type -> gctrpc.GetCryptocurrencyDepositAddressesResponse
-	84,  // 309: gctrpc.GoCryptoTraderService.GetCryptocurrencyDepositAddress:output_type -> gctrpc.GetCryptocurrencyDepositAddressResponse
-	86,  // 310: gctrpc.GoCryptoTraderService.GetAvailableTransferChains:output_type -> gctrpc.GetAvailableTransferChainsResponse
-	89,  // 311: gctrpc.GoCryptoTraderService.WithdrawFiatFunds:output_type -> gctrpc.WithdrawResponse
-	89,  // 312: gctrpc.GoCryptoTraderService.WithdrawCryptocurrencyFunds:output_type -> gctrpc.WithdrawResponse
-	91,  // 313: gctrpc.GoCryptoTraderService.WithdrawalEventByID:output_type -> gctrpc.WithdrawalEventByIDResponse
-	94,  // 314: gctrpc.GoCryptoTraderService.WithdrawalEventsByExchange:output_type -> gctrpc.WithdrawalEventsByExchangeResponse
-	94,  // 315: gctrpc.GoCryptoTraderService.WithdrawalEventsByDate:output_type -> gctrpc.WithdrawalEventsByExchangeResponse
-	101, // 316: gctrpc.GoCryptoTraderService.GetLoggerDetails:output_type -> gctrpc.GetLoggerDetailsResponse
-	101, // 317: gctrpc.GoCryptoTraderService.SetLoggerDetails:output_type -> gctrpc.GetLoggerDetailsResponse
-	104, // 318: gctrpc.GoCryptoTraderService.GetExchangePairs:output_type -> gctrpc.GetExchangePairsResponse
-	132, // 319: gctrpc.GoCryptoTraderService.SetExchangePair:output_type -> gctrpc.GenericResponse
-	28,  // 320: gctrpc.GoCryptoTraderService.GetOrderbookStream:output_type -> gctrpc.OrderbookResponse
-	28,  // 321: gctrpc.GoCryptoTraderService.GetExchangeOrderbookStream:output_type -> gctrpc.OrderbookResponse
-	22,  // 322: gctrpc.GoCryptoTraderService.GetTickerStream:output_type -> gctrpc.TickerResponse
-	22,  // 323: gctrpc.GoCryptoTraderService.GetExchangeTickerStream:output_type -> gctrpc.TickerResponse
-	111, // 324: gctrpc.GoCryptoTraderService.GetAuditEvent:output_type -> gctrpc.GetAuditEventResponse
-	132, // 325: gctrpc.GoCryptoTraderService.GCTScriptExecute:output_type -> gctrpc.GenericResponse
-	132, // 326: gctrpc.GoCryptoTraderService.GCTScriptUpload:output_type -> gctrpc.GenericResponse
-	131, // 327: gctrpc.GoCryptoTraderService.GCTScriptReadScript:output_type -> gctrpc.GCTScriptQueryResponse
-	130, // 328: gctrpc.GoCryptoTraderService.GCTScriptStatus:output_type -> gctrpc.GCTScriptStatusResponse
-	131, // 329: gctrpc.GoCryptoTraderService.GCTScriptQuery:output_type -> gctrpc.GCTScriptQueryResponse
-	132, // 330: gctrpc.GoCryptoTraderService.GCTScriptStop:output_type -> gctrpc.GenericResponse
-	132, // 331: gctrpc.GoCryptoTraderService.GCTScriptStopAll:output_type -> gctrpc.GenericResponse
-	130, // 332: gctrpc.GoCryptoTraderService.GCTScriptListAll:output_type -> gctrpc.GCTScriptStatusResponse
-	132, // 333: gctrpc.GoCryptoTraderService.GCTScriptAutoLoadToggle:output_type -> gctrpc.GenericResponse
-	117, // 334: gctrpc.GoCryptoTraderService.GetHistoricCandles:output_type -> gctrpc.GetHistoricCandlesResponse
-	132, // 335: gctrpc.GoCryptoTraderService.SetExchangeAsset:output_type -> gctrpc.GenericResponse
-	132, // 336: gctrpc.GoCryptoTraderService.SetAllExchangePairs:output_type -> gctrpc.GenericResponse
-	132, // 337: gctrpc.GoCryptoTraderService.UpdateExchangeSupportedPairs:output_type -> gctrpc.GenericResponse
-	137, // 338: gctrpc.GoCryptoTraderService.GetExchangeAssets:output_type -> gctrpc.GetExchangeAssetsResponse
-	139, // 339: gctrpc.GoCryptoTraderService.WebsocketGetInfo:output_type -> gctrpc.WebsocketGetInfoResponse
-	132, // 340: gctrpc.GoCryptoTraderService.WebsocketSetEnabled:output_type -> gctrpc.GenericResponse
-	143, // 341: gctrpc.GoCryptoTraderService.WebsocketGetSubscriptions:output_type -> gctrpc.WebsocketGetSubscriptionsResponse
-	132, // 342: gctrpc.GoCryptoTraderService.WebsocketSetProxy:output_type -> gctrpc.GenericResponse
-	132, // 343: gctrpc.GoCryptoTraderService.WebsocketSetURL:output_type -> gctrpc.GenericResponse
-	114, // 344: gctrpc.GoCryptoTraderService.GetRecentTrades:output_type -> gctrpc.SavedTradesResponse
-	114, // 345: gctrpc.GoCryptoTraderService.GetHistoricTrades:output_type -> gctrpc.SavedTradesResponse
-	114, // 346: gctrpc.GoCryptoTraderService.GetSavedTrades:output_type -> gctrpc.SavedTradesResponse
-	117, // 347: gctrpc.GoCryptoTraderService.ConvertTradesToCandles:output_type -> gctrpc.GetHistoricCandlesResponse
-	148, // 348: gctrpc.GoCryptoTraderService.FindMissingSavedCandleIntervals:output_type -> gctrpc.FindMissingIntervalsResponse
-	148, // 349: gctrpc.GoCryptoTraderService.FindMissingSavedTradeIntervals:output_type -> gctrpc.FindMissingIntervalsResponse
-	132, // 350: gctrpc.GoCryptoTraderService.SetExchangeTradeProcessing:output_type -> gctrpc.GenericResponse
-	153, // 351: gctrpc.GoCryptoTraderService.UpsertDataHistoryJob:output_type -> gctrpc.UpsertDataHistoryJobResponse
-	155, // 352: gctrpc.GoCryptoTraderService.GetDataHistoryJobDetails:output_type -> gctrpc.DataHistoryJob
-	157, // 353: gctrpc.GoCryptoTraderService.GetActiveDataHistoryJobs:output_type -> gctrpc.DataHistoryJobs
-	157, // 354: gctrpc.GoCryptoTraderService.GetDataHistoryJobsBetween:output_type -> gctrpc.DataHistoryJobs
-	155, // 355: gctrpc.GoCryptoTraderService.GetDataHistoryJobSummary:output_type -> gctrpc.DataHistoryJob
-	132, // 356: gctrpc.GoCryptoTraderService.SetDataHistoryJobStatus:output_type -> gctrpc.GenericResponse
-	132, // 357: gctrpc.GoCryptoTraderService.UpdateDataHistoryJobPrerequisite:output_type -> gctrpc.GenericResponse
-	59,  // 358: gctrpc.GoCryptoTraderService.GetManagedOrders:output_type -> gctrpc.GetOrdersResponse
-	162, // 359: gctrpc.GoCryptoTraderService.ModifyOrder:output_type -> gctrpc.ModifyOrderResponse
-	168, // 360: gctrpc.GoCryptoTraderService.CurrencyStateGetAll:output_type -> gctrpc.CurrencyStateResponse
-	132, // 361: gctrpc.GoCryptoTraderService.CurrencyStateTrading:output_type -> gctrpc.GenericResponse
-	132, // 362: gctrpc.GoCryptoTraderService.CurrencyStateDeposit:output_type -> gctrpc.GenericResponse
-	132, // 363: gctrpc.GoCryptoTraderService.CurrencyStateWithdraw:output_type -> gctrpc.GenericResponse
-	132, // 364: gctrpc.GoCryptoTraderService.CurrencyStateTradingPair:output_type -> gctrpc.GenericResponse
-	178, // 365: gctrpc.GoCryptoTraderService.GetFuturesPositionsSummary:output_type -> gctrpc.GetFuturesPositionsSummaryResponse
-	180, // 366: gctrpc.GoCryptoTraderService.GetFuturesPositionsOrders:output_type -> gctrpc.GetFuturesPositionsOrdersResponse
-	196, // 367: gctrpc.GoCryptoTraderService.GetCollateral:output_type -> gctrpc.GetCollateralResponse
-	205, // 368: gctrpc.GoCryptoTraderService.Shutdown:output_type -> gctrpc.ShutdownResponse
-	208, // 369: gctrpc.GoCryptoTraderService.GetTechnicalAnalysis:output_type -> gctrpc.GetTechnicalAnalysisResponse
-	213, // 370: gctrpc.GoCryptoTraderService.GetMarginRatesHistory:output_type -> gctrpc.GetMarginRatesHistoryResponse
-	176, // 371: gctrpc.GoCryptoTraderService.GetManagedPosition:output_type -> gctrpc.GetManagedPositionsResponse
-	176, // 372: gctrpc.GoCryptoTraderService.GetAllManagedPositions:output_type -> gctrpc.GetManagedPositionsResponse
-	201, // 373: gctrpc.GoCryptoTraderService.GetFundingRates:output_type -> gctrpc.GetFundingRatesResponse
-	203, // 374: gctrpc.GoCryptoTraderService.GetLatestFundingRate:output_type -> gctrpc.GetLatestFundingRateResponse
-	215, // 375: gctrpc.GoCryptoTraderService.GetOrderbookMovement:output_type -> gctrpc.GetOrderbookMovementResponse
-	217, // 376: gctrpc.GoCryptoTraderService.GetOrderbookAmountByNominal:output_type -> gctrpc.GetOrderbookAmountByNominalResponse
-	219, // 377: gctrpc.GoCryptoTraderService.GetOrderbookAmountByImpact:output_type -> gctrpc.GetOrderbookAmountByImpactResponse
-	182, // 378: gctrpc.GoCryptoTraderService.GetCollateralMode:output_type -> gctrpc.GetCollateralModeResponse
-	192, // 379: gctrpc.GoCryptoTraderService.GetLeverage:output_type -> gctrpc.GetLeverageResponse
-	184, // 380: gctrpc.GoCryptoTraderService.SetCollateralMode:output_type -> gctrpc.SetCollateralModeResponse
-	190, // 381: gctrpc.GoCryptoTraderService.SetMarginType:output_type -> gctrpc.SetMarginTypeResponse
-	194, // 382: gctrpc.GoCryptoTraderService.SetLeverage:output_type -> gctrpc.SetLeverageResponse
-	188, // 383: gctrpc.GoCryptoTraderService.ChangePositionMargin:output_type -> gctrpc.ChangePositionMarginResponse
-	222, // 384: gctrpc.GoCryptoTraderService.GetOpenInterest:output_type -> gctrpc.GetOpenInterestResponse
-	225, // 385: gctrpc.GoCryptoTraderService.GetCurrencyTradeURL:output_type -> gctrpc.GetCurrencyTradeURLResponse
-	271, // [271:386] is the sub-list for method output_type
-	156, // [156:271] is the sub-list for method input_type
-	156, // [156:156] is the sub-list for extension type_name
-	156, // [156:156] is the sub-list for extension extendee
-	0,   // [0:156] is the sub-list for field type_name
+	271, // 18: gctrpc.AccountCurrencyInfo.updated_at:type_name -> google.protobuf.Timestamp
+	35,  // 19: gctrpc.AccountCurrencyInfo.conversion_path:type_name -> gctrpc.ConversionHop
+	271, // 20: gctrpc.ConversionHop.updated_at:type_name -> google.protobuf.Timestamp
+	33,  // 21: gctrpc.GetAccountBalancesResponse.accounts:type_name -> gctrpc.Account
+	39,  // 22: gctrpc.GetPortfolioResponse.portfolio:type_name -> gctrpc.PortfolioAddress
+	35,  // 23: gctrpc.Coin.conversion_path:type_name -> gctrpc.ConversionHop
+	44,  // 24: gctrpc.OfflineCoins.addresses:type_name -> gctrpc.OfflineCoinSummary
+	264, // 25: gctrpc.OnlineCoins.coins:type_name -> gctrpc.OnlineCoins.CoinsEntry
+	43,  // 26: gctrpc.GetPortfolioSummaryResponse.coin_totals:type_name -> gctrpc.Coin
+	43,  // 27: gctrpc.GetPortfolioSummaryResponse.coins_offline:type_name -> gctrpc.Coin
+	265, // 28: gctrpc.GetPortfolioSummaryResponse.coins_offline_summary:type_name -> gctrpc.GetPortfolioSummaryResponse.CoinsOfflineSummaryEntry
+	43,  // 29: gctrpc.GetPortfolioSummaryResponse.coins_online:type_name -> gctrpc.Coin
+	266, // 30: gctrpc.GetPortfolioSummaryResponse.coins_online_summary:type_name -> gctrpc.GetPortfolioSummaryResponse.CoinsOnlineSummaryEntry
+	52,  // 31: gctrpc.GetForexProvidersResponse.forex_providers:type_name -> gctrpc.ForexProvider
+	55,  // 32: gctrpc.GetForexRatesResponse.forex_rates:type_name -> gctrpc.ForexRatesConversion
+	58,  // 33: gctrpc.OrderDetails.trades:type_name -> gctrpc.TradeHistory
+	21,  // 34: gctrpc.GetOrdersRequest.pair:type_name -> gctrpc.CurrencyPair
+	57,  // 35: gctrpc.GetOrdersResponse.orders:type_name -> gctrpc.OrderDetails
+	21,  // 36: gctrpc.GetOrderRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 37: gctrpc.SubmitOrderRequest.pair:type_name -> gctrpc.CurrencyPair
+	63,  // 38: gctrpc.SubmitOrderResponse.trades:type_name -> gctrpc.Trades
+	21,  // 39: gctrpc.SimulateOrderRequest.pair:type_name -> gctrpc.CurrencyPair
+	27,  // 40: gctrpc.SimulateOrderResponse.orders:type_name -> gctrpc.OrderbookItem
+	21,  // 41: gctrpc.WhaleBombRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 42: gctrpc.CancelOrderRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 43: gctrpc.CancelBatchOrdersRequest.pair:type_name -> gctrpc.CurrencyPair
+	267, // 44: gctrpc.Orders.order_status:type_name -> gctrpc.Orders.OrderStatusEntry
+	70,  // 45: gctrpc.CancelBatchOrdersResponse.orders:type_name -> gctrpc.Orders
+	70,  // 46: gctrpc.CancelAllOrdersResponse.orders:type_name -> gctrpc.Orders
+	75,  // 47: gctrpc.GetEventsResponse.condition_params:type_name -> gctrpc.ConditionParams
+	21,  // 48: gctrpc.GetEventsResponse.pair:type_name -> gctrpc.CurrencyPair
+	75,  // 49: gctrpc.AddEventRequest.condition_params:type_name -> gctrpc.ConditionParams
+	21,  // 50: gctrpc.AddEventRequest.pair:type_name -> gctrpc.CurrencyPair
+	81,  // 51: gctrpc.DepositAddresses.addresses:type_name -> gctrpc.DepositAddress
+	268, // 52: gctrpc.GetCryptocurrencyDepositAddressesResponse.addresses:type_name -> gctrpc.GetCryptocurrencyDepositAddressesResponse.AddressesEntry
+	96,  // 53: gctrpc.WithdrawalEventByIDResponse.event:type_name -> gctrpc.WithdrawalEventResponse
+	96,  // 54: gctrpc.WithdrawalEventsByExchangeResponse.event:type_name -> gctrpc.WithdrawalEventResponse
+	97,  // 55: gctrpc.WithdrawalEventResponse.exchange:type_name -> gctrpc.WithdrawalExchangeEvent
+	98,  // 56: gctrpc.WithdrawalEventResponse.request:type_name -> gctrpc.WithdrawalRequestEvent
+	271, // 57: gctrpc.WithdrawalEventResponse.created_at:type_name -> google.protobuf.Timestamp
+	271, // 58: gctrpc.WithdrawalEventResponse.updated_at:type_name -> google.protobuf.Timestamp
+	99,  // 59: gctrpc.WithdrawalRequestEvent.fiat:type_name -> gctrpc.FiatWithdrawalEvent
+	100, // 60: gctrpc.WithdrawalRequestEvent.crypto:type_name -> gctrpc.CryptoWithdrawalEvent
+	271, // 61: gctrpc.WithdrawalAddress.created_at:type_name -> google.protobuf.Timestamp
+	101, // 62: gctrpc.GetWithdrawalAddressesResponse.addresses:type_name -> gctrpc.WithdrawalAddress
+	271, // 63: gctrpc.WithdrawalApproval.approved_at:type_name -> google.protobuf.Timestamp
+	98,  // 64: gctrpc.PendingWithdrawal.request:type_name -> gctrpc.WithdrawalRequestEvent
+	271, // 65: gctrpc.PendingWithdrawal.requested_at:type_name -> google.protobuf.Timestamp
+	271, // 66: gctrpc.PendingWithdrawal.expires_at:type_name -> google.protobuf.Timestamp
+	106, // 67: gctrpc.PendingWithdrawal.approvals:type_name -> gctrpc.WithdrawalApproval
+	107, // 68: gctrpc.GetPendingWithdrawalsResponse.withdrawals:type_name -> gctrpc.PendingWithdrawal
+	269, // 69: gctrpc.GetExchangePairsResponse.supported_assets:type_name -> gctrpc.GetExchangePairsResponse.SupportedAssetsEntry
+	21,  // 70: gctrpc.SetExchangePairRequest.pairs:type_name -> gctrpc.CurrencyPair
+	21,  // 71: gctrpc.GetOrderbookStreamRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 72: gctrpc.GetTickerStreamRequest.pair:type_name -> gctrpc.CurrencyPair
+	131, // 73: gctrpc.GetAuditEventResponse.events:type_name -> gctrpc.AuditEvent
+	21,  // 74: gctrpc.GetSavedTradesRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 75: gctrpc.SavedTradesResponse.pair:type_name -> gctrpc.CurrencyPair
+	125, // 76: gctrpc.SavedTradesResponse.trades:type_name -> gctrpc.SavedTrades
+	21,  // 77: gctrpc.ConvertTradesToCandlesRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 78: gctrpc.GetHistoricCandlesRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 79: gctrpc.GetHistoricCandlesResponse.pair:type_name -> gctrpc.CurrencyPair
+	130, // 80: gctrpc.GetHistoricCandlesResponse.candle:type_name -> gctrpc.Candle
+	132, // 81: gctrpc.GCTScriptExecuteRequest.script:type_name -> gctrpc.GCTScript
+	132, // 82: gctrpc.GCTScriptStopRequest.script:type_name -> gctrpc.GCTScript
+	132, // 83: gctrpc.GCTScriptReadScriptRequest.script:type_name -> gctrpc.GCTScript
+	132, // 84: gctrpc.GCTScriptQueryRequest.script:type_name -> gctrpc.GCTScript
+	132, // 85: gctrpc.GCTScriptStatusResponse.scripts:type_name -> gctrpc.GCTScript
+	132, // 86: gctrpc.GCTScriptQueryResponse.script:type_name -> gctrpc.GCTScript
+	152, // 87: gctrpc.WebsocketGetInfoResponse.connections:type_name -> gctrpc.WebsocketConnectionHealth
+	271, // 88: gctrpc.WebsocketConnectionHealth.connected_at:type_name -> google.protobuf.Timestamp
+	271, // 89: gctrpc.WebsocketConnectionHealth.last_message:type_name -> google.protobuf.Timestamp
+	155, // 90: gctrpc.WebsocketGetSubscriptionsResponse.subscriptions:type_name -> gctrpc.WebsocketSubscription
+	21,  // 91: gctrpc.FindMissingCandlePeriodsRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 92: gctrpc.FindMissingTradePeriodsRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 93: gctrpc.FindMissingIntervalsResponse.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 94: gctrpc.UpsertDataHistoryJobRequest.pair:type_name -> gctrpc.CurrencyPair
+	163, // 95: gctrpc.InsertSequentialJobsRequest.jobs:type_name -> gctrpc.UpsertDataHistoryJobRequest
+	166, // 96: gctrpc.InsertSequentialJobsResponse.jobs:type_name -> gctrpc.UpsertDataHistoryJobResponse
+	21,  // 97: gctrpc.DataHistoryJob.pair:type_name -> gctrpc.CurrencyPair
+	169, // 98: gctrpc.DataHistoryJob.job_results:type_name -> gctrpc.DataHistoryJobResult
+	168, // 99: gctrpc.DataHistoryJobs.results:type_name -> gctrpc.DataHistoryJob
+	21,  // 100: gctrpc.ModifyOrderRequest.pair:type_name -> gctrpc.CurrencyPair
+	182, // 101: gctrpc.CurrencyStateResponse.currency_states:type_name -> gctrpc.CurrencyState
+	21,  // 102: gctrpc.FundingData.pair:type_name -> gctrpc.CurrencyPair
+	183, // 103: gctrpc.FundingData.rates:type_name -> gctrpc.FundingRate
+	183, // 104: gctrpc.FundingData.latest_rate:type_name -> gctrpc.FundingRate
+	183, // 105: gctrpc.FundingData.upcoming_rate:type_name -> gctrpc.FundingRate
+	21,  // 106: gctrpc.FuturePosition.pair:type_name -> gctrpc.CurrencyPair
+	57,  // 107: gctrpc.FuturePosition.orders:type_name -> gctrpc.OrderDetails
+	185, // 108: gctrpc.FuturePosition.position_stats:type_name -> gctrpc.FuturesPositionStats
+	184, // 109: gctrpc.FuturePosition.funding_data:type_name -> gctrpc.FundingData
+	21,  // 110: gctrpc.GetManagedPositionRequest.pair:type_name -> gctrpc.CurrencyPair
+	186, // 111: gctrpc.GetManagedPositionsResponse.positions:type_name -> gctrpc.FuturePosition
+	21,  // 112: gctrpc.GetFuturesPositionsSummaryRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 113: gctrpc.GetFuturesPositionsSummaryRequest.underlying_pair:type_name -> gctrpc.CurrencyPair
+	21,  // 114: gctrpc.GetFuturesPositionsSummaryResponse.pair:type_name -> gctrpc.CurrencyPair
+	185, // 115: gctrpc.GetFuturesPositionsSummaryResponse.position_stats:type_name -> gctrpc.FuturesPositionStats
+	21,  // 116: gctrpc.GetFuturesPositionsOrdersRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 117: gctrpc.GetFuturesPositionsOrdersRequest.underlying_pair:type_name -> gctrpc.CurrencyPair
+	186, // 118: gctrpc.GetFuturesPositionsOrdersResponse.positions:type_name -> gctrpc.FuturePosition
+	21,  // 119: gctrpc.GetMarginTypeRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 120: gctrpc.GetMarginTypeResponse.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 121: gctrpc.ChangePositionMarginRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 122: gctrpc.ChangePositionMarginResponse.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 123: gctrpc.SetMarginTypeRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 124: gctrpc.SetMarginTypeResponse.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 125: gctrpc.GetLeverageRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 126: gctrpc.GetLeverageRequest.underlying_pair:type_name -> gctrpc.CurrencyPair
+	21,  // 127: gctrpc.GetLeverageResponse.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 128: gctrpc.GetLeverageResponse.underlying_pair:type_name -> gctrpc.CurrencyPair
+	21,  // 129: gctrpc.SetLeverageRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 130: gctrpc.SetLeverageRequest.underlying_pair:type_name -> gctrpc.CurrencyPair
+	21,  // 131: gctrpc.SetLeverageResponse.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 132: gctrpc.SetLeverageResponse.underlying_pair:type_name -> gctrpc.CurrencyPair
+	212, // 133: gctrpc.GetCollateralResponse.used_breakdown:type_name -> gctrpc.CollateralUsedBreakdown
+	210, // 134: gctrpc.GetCollateralResponse.currency_breakdown:type_name -> gctrpc.CollateralForCurrency
+	211, // 135: gctrpc.GetCollateralResponse.position_breakdown:type_name -> gctrpc.CollateralByPosition
+	212, // 136: gctrpc.CollateralForCurrency.used_breakdown:type_name -> gctrpc.CollateralUsedBreakdown
+	21,  // 137: gctrpc.GetFundingRatesRequest.pair:type_name -> gctrpc.CurrencyPair
+	184, // 138: gctrpc.GetFundingRatesResponse.rates:type_name -> gctrpc.FundingData
+	21,  // 139: gctrpc.GetLatestFundingRateRequest.pair:type_name -> gctrpc.CurrencyPair
+	184, // 140: gctrpc.GetLatestFundingRateResponse.rate:type_name -> gctrpc.FundingData
+	21,  // 141: gctrpc.GetTechnicalAnalysisRequest.pair:type_name -> gctrpc.CurrencyPair
+	271, // 142: gctrpc.GetTechnicalAnalysisRequest.start:type_name -> google.protobuf.Timestamp
+	271, // 143: gctrpc.GetTechnicalAnalysisRequest.end:type_name -> google.protobuf.Timestamp
+	21,  // 144: gctrpc.GetTechnicalAnalysisRequest.other_pair:type_name -> gctrpc.CurrencyPair
+	270, // 145: gctrpc.GetTechnicalAnalysisResponse.signals:type_name -> gctrpc.GetTechnicalAnalysisResponse.SignalsEntry
+	225, // 146: gctrpc.GetMarginRatesHistoryRequest.rates:type_name -> gctrpc.MarginRate
+	223, // 147: gctrpc.MarginRate.lending_payment:type_name -> gctrpc.LendingPayment
+	224, // 148: gctrpc.MarginRate.borrow_cost:type_name -> gctrpc.BorrowCost
+	225, // 149: gctrpc.GetMarginRatesHistoryResponse.rates:type_name -> gctrpc.MarginRate
+	225, // 150: gctrpc.GetMarginRatesHistoryResponse.latest_rate:type_name -> gctrpc.MarginRate
+	225, // 151: gctrpc.GetMarginRatesHistoryResponse.predicted_rate:type_name -> gctrpc.MarginRate
+	21,  // 152: gctrpc.GetOrderbookMovementRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 153: gctrpc.GetOrderbookAmountByNominalRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 154: gctrpc.GetOrderbookAmountByImpactRequest.pair:type_name -> gctrpc.CurrencyPair
+	234, // 155: gctrpc.GetOpenInterestRequest.data:type_name -> gctrpc.OpenInterestDataRequest
+	21,  // 156: gctrpc.OpenInterestDataRequest.pair:type_name -> gctrpc.CurrencyPair
+	236, // 157: gctrpc.GetOpenInterestResponse.data:type_name -> gctrpc.OpenInterestDataResponse
+	21,  // 158: gctrpc.OpenInterestDataResponse.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 159: gctrpc.GetCurrencyTradeURLRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 160: gctrpc.GetCandleStreamRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 161: gctrpc.CandleStreamResponse.pair:type_name -> gctrpc.CurrencyPair
+	130, // 162: gctrpc.CandleStreamResponse.candle:type_name -> gctrpc.Candle
+	271, // 163: gctrpc.RateLimitUsage.reset_at:type_name -> google.protobuf.Timestamp
+	271, // 164: gctrpc.RateLimitUsage.updated_at:type_name -> google.protobuf.Timestamp
+	242, // 165: gctrpc.GetRateLimitUsageResponse.usage:type_name -> gctrpc.RateLimitUsage
+	271, // 166: gctrpc.GetRateLimitUsageResponse.throttled_until:type_name -> google.protobuf.Timestamp
+	21,  // 167: gctrpc.GetMicrostructureStreamRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 168: gctrpc.MicrostructureResponse.pair:type_name -> gctrpc.CurrencyPair
+	271, // 169: gctrpc.MicrostructureResponse.update_time:type_name -> google.protobuf.Timestamp
+	245, // 170: gctrpc.MicrostructureResponse.depth:type_name -> gctrpc.DepthAtBasisPoints
+	271, // 171: gctrpc.TreasuryTransfer.submitted_at:type_name -> google.protobuf.Timestamp
+	271, // 172: gctrpc.TreasuryTransfer.settled_at:type_name -> google.protobuf.Timestamp
+	249, // 173: gctrpc.TreasuryTransfersResponse.transfers:type_name -> gctrpc.TreasuryTransfer
+	271, // 174: gctrpc.FundingTransition.time:type_name -> google.protobuf.Timestamp
+	271, // 175: gctrpc.FundingTransfer.timestamp:type_name -> google.protobuf.Timestamp
+	271, // 176: gctrpc.FundingTransfer.first_seen:type_name -> google.protobuf.Timestamp
+	271, // 177: gctrpc.FundingTransfer.updated_at:type_name -> google.protobuf.Timestamp
+	253, // 178: gctrpc.FundingTransfer.transitions:type_name -> gctrpc.FundingTransition
+	254, // 179: gctrpc.GetFundingTransfersResponse.transfers:type_name -> gctrpc.FundingTransfer
+	9,   // 180: gctrpc.GetInfoResponse.RpcEndpointsEntry.value:type_name -> gctrpc.RPCEndpoint
+	3,   // 181: gctrpc.GetCommunicationRelayersResponse.CommunicationRelayersEntry.value:type_name -> gctrpc.CommunicationRelayer
+	9,   // 182: gctrpc.GetRPCEndpointsResponse.EndpointsEntry.value:type_name -> gctrpc.RPCEndpoint
+	18,  // 183: gctrpc.GetExchangeInfoResponse.SupportedAssetsEntry.value:type_name -> gctrpc.PairsSupported
+	45,  // 184: gctrpc.OnlineCoins.CoinsEntry.value:type_name -> gctrpc.OnlineCoinSummary
+	46,  // 185: gctrpc.GetPortfolioSummaryResponse.CoinsOfflineSummaryEntry.value:type_name -> gctrpc.OfflineCoins
+	47,  // 186: gctrpc.GetPortfolioSummaryResponse.CoinsOnlineSummaryEntry.value:type_name -> gctrpc.OnlineCoins
+	82,  // 187: gctrpc.GetCryptocurrencyDepositAddressesResponse.AddressesEntry.value:type_name -> gctrpc.DepositAddresses
+	18,  // 188: gctrpc.GetExchangePairsResponse.SupportedAssetsEntry.value:type_name -> gctrpc.PairsSupported
+	220, // 189: gctrpc.GetTechnicalAnalysisResponse.SignalsEntry.value:type_name -> gctrpc.ListOfSignals
+	0,   // 190: gctrpc.GoCryptoTraderService.GetInfo:input_type -> gctrpc.GetInfoRequest
+	6,   // 191: gctrpc.GoCryptoTraderService.GetSubsystems:input_type -> gctrpc.GetSubsystemsRequest
+	5,   // 192: gctrpc.GoCryptoTraderService.EnableSubsystem:input_type -> gctrpc.GenericSubsystemRequest
+	5,   // 193: gctrpc.GoCryptoTraderService.DisableSubsystem:input_type -> gctrpc.GenericSubsystemRequest
+	8,   // 194: gctrpc.GoCryptoTraderService.GetRPCEndpoints:input_type -> gctrpc.GetRPCEndpointsRequest
+	2,   // 195: gctrpc.GoCryptoTraderService.GetCommunicationRelayers:input_type -> gctrpc.GetCommunicationRelayersRequest
+	12,  // 196: gctrpc.GoCryptoTraderService.GetExchanges:input_type -> gctrpc.GetExchangesRequest
+	11,  // 197: gctrpc.GoCryptoTraderService.DisableExchange:input_type -> gctrpc.GenericExchangeNameRequest
+	11,  // 198: gctrpc.GoCryptoTraderService.GetExchangeInfo:input_type -> gctrpc.GenericExchangeNameRequest
+	11,  // 199: gctrpc.GoCryptoTraderService.GetExchangeOTPCode:input_type -> gctrpc.GenericExchangeNameRequest
+	15,  // 200: gctrpc.GoCryptoTraderService.GetExchangeOTPCodes:input_type -> gctrpc.GetExchangeOTPsRequest
+	11,  // 201: gctrpc.GoCryptoTraderService.EnableExchange:input_type -> gctrpc.GenericExchangeNameRequest
+	20,  // 202: gctrpc.GoCryptoTraderService.GetTicker:input_type -> gctrpc.GetTickerRequest
+	23,  // 203: gctrpc.GoCryptoTraderService.GetTickers:input_type -> gctrpc.GetTickersRequest
+	26,  // 204: gctrpc.GoCryptoTraderService.GetOrderbook:input_type -> gctrpc.GetOrderbookRequest
+	29,  // 205: gctrpc.GoCryptoTraderService.GetOrderbooks:input_type -> gctrpc.GetOrderbooksRequest
+	32,  // 206: gctrpc.GoCryptoTraderService.GetAccountBalances:input_type -> gctrpc.GetAccountBalancesRequest
+	32,  // 207: gctrpc.GoCryptoTraderService.UpdateAccountBalances:input_type -> gctrpc.GetAccountBalancesRequest
+	32,  // 208: gctrpc.GoCryptoTraderService.GetAccountBalancesStream:input_type -> gctrpc.GetAccountBalancesRequest
+	37,  // 209: gctrpc.GoCryptoTraderService.GetConfig:input_type -> gctrpc.GetConfigRequest
+	40,  // 210: gctrpc.GoCryptoTraderService.GetPortfolio:input_type -> gctrpc.GetPortfolioRequest
+	42,  // 211: gctrpc.GoCryptoTraderService.GetPortfolioSummary:input_type -> gctrpc.GetPortfolioSummaryRequest
+	49,  // 212: gctrpc.GoCryptoTraderService.AddPortfolioAddress:input_type -> gctrpc.AddPortfolioAddressRequest
+	50,  // 213: gctrpc.GoCryptoTraderService.RemovePortfolioAddress:input_type -> gctrpc.RemovePortfolioAddressRequest
+	51,  // 214: gctrpc.GoCryptoTraderService.GetForexProviders:input_type -> gctrpc.GetForexProvidersRequest
+	54,  // 215: gctrpc.GoCryptoTraderService.GetForexRates:input_type -> gctrpc.GetForexRatesRequest
+	59,  // 216: gctrpc.GoCryptoTraderService.GetOrders:input_type -> gctrpc.GetOrdersRequest
+	61,  // 217: gctrpc.GoCryptoTraderService.GetOrder:input_type -> gctrpc.GetOrderRequest
+	62,  // 218: gctrpc.GoCryptoTraderService.SubmitOrder:input_type -> gctrpc.SubmitOrderRequest
+	65,  // 219: gctrpc.GoCryptoTraderService.SimulateOrder:input_type -> gctrpc.SimulateOrderRequest
+	67,  // 220: gctrpc.GoCryptoTraderService.WhaleBomb:input_type -> gctrpc.WhaleBombRequest
+	68,  // 221: gctrpc.GoCryptoTraderService.CancelOrder:input_type -> gctrpc.CancelOrderRequest
+	69,  // 222: gctrpc.GoCryptoTraderService.CancelBatchOrders:input_type -> gctrpc.CancelBatchOrdersRequest
+	72,  // 223: gctrpc.GoCryptoTraderService.CancelAllOrders:input_type -> gctrpc.CancelAllOrdersRequest
+	74,  // 224: gctrpc.GoCryptoTraderService.GetEvents:input_type -> gctrpc.GetEventsRequest
+	77,  // 225: gctrpc.GoCryptoTraderService.AddEvent:input_type -> gctrpc.AddEventRequest
+	79,  // 226: gctrpc.GoCryptoTraderService.RemoveEvent:input_type -> gctrpc.RemoveEventRequest
+	80,  // 227: gctrpc.GoCryptoTraderService.GetCryptocurrencyDepositAddresses:input_type -> gctrpc.GetCryptocurrencyDepositAddressesRequest
+	84,  // 228: gctrpc.GoCryptoTraderService.GetCryptocurrencyDepositAddress:input_type -> gctrpc.GetCryptocurrencyDepositAddressRequest
+	86,  // 229: gctrpc.GoCryptoTraderService.GetAvailableTransferChains:input_type -> gctrpc.GetAvailableTransferChainsRequest
+	88,  // 230: gctrpc.GoCryptoTraderService.WithdrawFiatFunds:input_type -> gctrpc.WithdrawFiatRequest
+	89,  // 231: gctrpc.GoCryptoTraderService.WithdrawCryptocurrencyFunds:input_type -> gctrpc.WithdrawCryptoRequest
+	91,  // 232: gctrpc.GoCryptoTraderService.WithdrawalEventByID:input_type -> gctrpc.WithdrawalEventByIDRequest
+	93,  // 233: gctrpc.GoCryptoTraderService.WithdrawalEventsByExchange:input_type -> gctrpc.WithdrawalEventsByExchangeRequest
+	94,  // 234: gctrpc.GoCryptoTraderService.WithdrawalEventsByDate:input_type -> gctrpc.WithdrawalEventsByDateRequest
+	102, // 235: gctrpc.GoCryptoTraderService.AddWithdrawalAddress:input_type -> gctrpc.AddWithdrawalAddressRequest
+	103, // 236: gctrpc.GoCryptoTraderService.RemoveWithdrawalAddress:input_type -> gctrpc.RemoveWithdrawalAddressRequest
+	104, // 237: gctrpc.GoCryptoTraderService.GetWithdrawalAddresses:input_type -> gctrpc.GetWithdrawalAddressesRequest
+	108, // 238: gctrpc.GoCryptoTraderService.GetPendingWithdrawals:input_type -> gctrpc.GetPendingWithdrawalsRequest
+	110, // 239: gctrpc.GoCryptoTraderService.ApproveWithdrawal:input_type -> gctrpc.ApproveWithdrawalRequest
+	111, // 240: gctrpc.GoCryptoTraderService.RejectWithdrawal:input_type -> gctrpc.RejectWithdrawalRequest
+	112, // 241: gctrpc.GoCryptoTraderService.GetLoggerDetails:input_type -> gctrpc.GetLoggerDetailsRequest
+	114, // 242: gctrpc.GoCryptoTraderService.SetLoggerDetails:input_type -> gctrpc.SetLoggerDetailsRequest
+	115, // 243: gctrpc.GoCryptoTraderService.GetExchangePairs:input_type -> gctrpc.GetExchangePairsRequest
+	117, // 244: gctrpc.GoCryptoTraderService.SetExchangePair:input_type -> gctrpc.SetExchangePairRequest
+	118, // 245: gctrpc.GoCryptoTraderService.GetOrderbookStream:input_type -> gctrpc.GetOrderbookStreamRequest
+	119, // 246: gctrpc.GoCryptoTraderService.GetExchangeOrderbookStream:input_type -> gctrpc.GetExchangeOrderbookStreamRequest
+	120, // 247: gctrpc.GoCryptoTraderService.GetTickerStream:input_type -> gctrpc.GetTickerStreamRequest
+	121, // 248: gctrpc.GoCryptoTraderService.GetExchangeTickerStream:input_type -> gctrpc.GetExchangeTickerStreamRequest
+	122, // 249: gctrpc.GoCryptoTraderService.GetAuditEvent:input_type -> gctrpc.GetAuditEventRequest
+	133, // 250: gctrpc.GoCryptoTraderService.GCTScriptExecute:input_type -> gctrpc.GCTScriptExecuteRequest
+	138, // 251: gctrpc.GoCryptoTraderService.GCTScriptUpload:input_type -> gctrpc.GCTScriptUploadRequest
+	139, // 252: gctrpc.GoCryptoTraderService.GCTScriptReadScript:input_type -> gctrpc.GCTScriptReadScriptRequest
+	136, // 253: gctrpc.GoCryptoTraderService.GCTScriptStatus:input_type -> gctrpc.GCTScriptStatusRequest
+	140, // 254: gctrpc.GoCryptoTraderService.GCTScriptQuery:input_type -> gctrpc.GCTScriptQueryRequest
+	134, // 255: gctrpc.GoCryptoTraderService.GCTScriptStop:input_type -> gctrpc.GCTScriptStopRequest
+	135, // 256: gctrpc.GoCryptoTraderService.GCTScriptStopAll:input_type -> gctrpc.GCTScriptStopAllRequest
+	137, // 257: gctrpc.GoCryptoTraderService.GCTScriptListAll:input_type -> gctrpc.GCTScriptListAllRequest
+	141, // 258: gctrpc.GoCryptoTraderService.GCTScriptAutoLoadToggle:input_type -> gctrpc.GCTScriptAutoLoadRequest
+	128, // 259: gctrpc.GoCryptoTraderService.GetHistoricCandles:input_type -> gctrpc.GetHistoricCandlesRequest
+	145, // 260: gctrpc.GoCryptoTraderService.SetExchangeAsset:input_type -> gctrpc.SetExchangeAssetRequest
+	146, // 261: gctrpc.GoCryptoTraderService.SetAllExchangePairs:input_type -> gctrpc.SetExchangeAllPairsRequest
+	147, // 262: gctrpc.GoCryptoTraderService.UpdateExchangeSupportedPairs:input_type -> gctrpc.UpdateExchangeSupportedPairsRequest
+	148, // 263: gctrpc.GoCryptoTraderService.GetExchangeAssets:input_type -> gctrpc.GetExchangeAssetsRequest
+	150, // 264: gctrpc.GoCryptoTraderService.WebsocketGetInfo:input_type -> gctrpc.WebsocketGetInfoRequest
+	153, // 265: gctrpc.GoCryptoTraderService.WebsocketSetEnabled:input_type -> gctrpc.WebsocketSetEnabledRequest
+	154, // 266: gctrpc.GoCryptoTraderService.WebsocketGetSubscriptions:input_type -> gctrpc.WebsocketGetSubscriptionsRequest
+	157, // 267: gctrpc.GoCryptoTraderService.WebsocketSetProxy:input_type -> gctrpc.WebsocketSetProxyRequest
+	158, // 268: gctrpc.GoCryptoTraderService.WebsocketSetURL:input_type -> gctrpc.WebsocketSetURLRequest
+	124, // 269: gctrpc.GoCryptoTraderService.GetRecentTrades:input_type -> gctrpc.GetSavedTradesRequest
+	124, // 270: gctrpc.GoCryptoTraderService.GetHistoricTrades:input_type -> gctrpc.GetSavedTradesRequest
+	124, // 271: gctrpc.GoCryptoTraderService.GetSavedTrades:input_type -> gctrpc.GetSavedTradesRequest
+	127, // 272: gctrpc.GoCryptoTraderService.ConvertTradesToCandles:input_type -> gctrpc.ConvertTradesToCandlesRequest
+	159, // 273: gctrpc.GoCryptoTraderService.FindMissingSavedCandleIntervals:input_type -> gctrpc.FindMissingCandlePeriodsRequest
+	160, // 274: gctrpc.GoCryptoTraderService.FindMissingSavedTradeIntervals:input_type -> gctrpc.FindMissingTradePeriodsRequest
+	162, // 275: gctrpc.GoCryptoTraderService.SetExchangeTradeProcessing:input_type -> gctrpc.SetExchangeTradeProcessingRequest
+	163, // 276: gctrpc.GoCryptoTraderService.UpsertDataHistoryJob:input_type -> gctrpc.UpsertDataHistoryJobRequest
+	167, // 277: gctrpc.GoCryptoTraderService.GetDataHistoryJobDetails:input_type -> gctrpc.GetDataHistoryJobDetailsRequest
+	0,   // 278: gctrpc.GoCryptoTraderService.GetActiveDataHistoryJobs:input_type -> gctrpc.GetInfoRequest
+	171, // 279: gctrpc.GoCryptoTraderService.GetDataHistoryJobsBetween:input_type -> gctrpc.GetDataHistoryJobsBetweenRequest
+	167, // 280: gctrpc.GoCryptoTraderService.GetDataHistoryJobSummary:input_type -> gctrpc.GetDataHistoryJobDetailsRequest
+	172, // 281: gctrpc.GoCryptoTraderService.SetDataHistoryJobStatus:input_type -> gctrpc.SetDataHistoryJobStatusRequest
+	173, // 282: gctrpc.GoCryptoTraderService.UpdateDataHistoryJobPrerequisite:input_type -> gctrpc.UpdateDataHistoryJobPrerequisiteRequest
+	59,  // 283: gctrpc.GoCryptoTraderService.GetManagedOrders:input_type -> gctrpc.GetOrdersRequest
+	174, // 284: gctrpc.GoCryptoTraderService.ModifyOrder:input_type -> gctrpc.ModifyOrderRequest
+	176, // 285: gctrpc.GoCryptoTraderService.CurrencyStateGetAll:input_type -> gctrpc.CurrencyStateGetAllRequest
+	177, // 286: gctrpc.GoCryptoTraderService.CurrencyStateTrading:input_type -> gctrpc.CurrencyStateTradingRequest
+	180, // 287: gctrpc.GoCryptoTraderService.CurrencyStateDeposit:input_type -> gctrpc.CurrencyStateDepositRequest
+	179, // 288: gctrpc.GoCryptoTraderService.CurrencyStateWithdraw:input_type -> gctrpc.CurrencyStateWithdrawRequest
+	178, // 289: gctrpc.GoCryptoTraderService.CurrencyStateTradingPair:input_type -> gctrpc.CurrencyStateTradingPairRequest
+	190, // 290: gctrpc.GoCryptoTraderService.GetFuturesPositionsSummary:input_type -> gctrpc.GetFuturesPositionsSummaryRequest
+	192, // 291: gctrpc.GoCryptoTraderService.GetFuturesPositionsOrders:input_type -> gctrpc.GetFuturesPositionsOrdersRequest
+	208, // 292: gctrpc.GoCryptoTraderService.GetCollateral:input_type -> gctrpc.GetCollateralRequest
+	217, // 293: gctrpc.GoCryptoTraderService.Shutdown:input_type -> gctrpc.ShutdownRequest
+	219, // 294: gctrpc.GoCryptoTraderService.GetTechnicalAnalysis:input_type -> gctrpc.GetTechnicalAnalysisRequest
+	222, // 295: gctrpc.GoCryptoTraderService.GetMarginRatesHistory:input_type -> gctrpc.GetMarginRatesHistoryRequest
+	187, // 296: gctrpc.GoCryptoTraderService.GetManagedPosition:input_type -> gctrpc.GetManagedPositionRequest
+	188, // 297: gctrpc.GoCryptoTraderService.GetAllManagedPositions:input_type -> gctrpc.GetAllManagedPositionsRequest
+	213, // 298: gctrpc.GoCryptoTraderService.GetFundingRates:input_type -> gctrpc.GetFundingRatesRequest
+	215, // 299: gctrpc.GoCryptoTraderService.GetLatestFundingRate:input_type -> gctrpc.GetLatestFundingRateRequest
+	227, // 300: gctrpc.GoCryptoTraderService.GetOrderbookMovement:input_type -> gctrpc.GetOrderbookMovementRequest
+	229, // 301: gctrpc.GoCryptoTraderService.GetOrderbookAmountByNominal:input_type -> gctrpc.GetOrderbookAmountByNominalRequest
+	231, // 302: gctrpc.GoCryptoTraderService.GetOrderbookAmountByImpact:input_type -> gctrpc.GetOrderbookAmountByImpactRequest
+	194, // 303: gctrpc.GoCryptoTraderService.GetCollateralMode:input_type -> gctrpc.GetCollateralModeRequest
+	204, // 304: gctrpc.GoCryptoTraderService.GetLeverage:input_type -> gctrpc.GetLeverageRequest
+	196, // 305: gctrpc.GoCryptoTraderService.SetCollateralMode:input_type -> gctrpc.SetCollateralModeRequest
+	202, // 306: gctrpc.GoCryptoTraderService.SetMarginType:input_type -> gctrpc.SetMarginTypeRequest
+	206, // 307: gctrpc.GoCryptoTraderService.SetLeverage:input_type -> gctrpc.SetLeverageRequest
+	200, // 308: gctrpc.GoCryptoTraderService.ChangePositionMargin:input_type -> gctrpc.ChangePositionMarginRequest
+	233, // 309: gctrpc.GoCryptoTraderService.GetOpenInterest:input_type -> gctrpc.GetOpenInterestRequest
+	237, // 310: gctrpc.GoCryptoTraderService.GetCurrencyTradeURL:input_type -> gctrpc.GetCurrencyTradeURLRequest
+	239, // 311: gctrpc.GoCryptoTraderService.GetCandleStream:input_type -> gctrpc.GetCandleStreamRequest
+	241, // 312: gctrpc.GoCryptoTraderService.GetRateLimitUsage:input_type -> gctrpc.GetRateLimitUsageRequest
+	244, // 313: gctrpc.GoCryptoTraderService.GetMicrostructureStream:input_type -> gctrpc.GetMicrostructureStreamRequest
+	247, // 314: gctrpc.GoCryptoTraderService.ReloadConfig:input_type -> gctrpc.ReloadConfigRequest
+	250, // 315: gctrpc.GoCryptoTraderService.GetTreasuryTransfers:input_type -> gctrpc.GetTreasuryTransfersRequest
+	251, // 316: gctrpc.GoCryptoTraderService.RebalanceTreasury:input_type -> gctrpc.RebalanceTreasuryRequest
+	255, // 317: gctrpc.GoCryptoTraderService.GetFundingTransfers:input_type -> gctrpc.GetFundingTransfersRequest
+	1,   // 318: gctrpc.GoCryptoTraderService.GetInfo:output_type -> gctrpc.GetInfoResponse
+	7,   // 319: gctrpc.GoCryptoTraderService.GetSubsystems:output_type -> gctrpc.GetSubsystemsResponse
+	144, // 320: gctrpc.GoCryptoTraderService.EnableSubsystem:output_type -> gctrpc.GenericResponse
+	144, // 321: gctrpc.GoCryptoTraderService.DisableSubsystem:output_type -> gctrpc.GenericResponse
+	10,  // 322: gctrpc.GoCryptoTraderService.GetRPCEndpoints:output_type -> gctrpc.GetRPCEndpointsResponse
+	4,   // 323: gctrpc.GoCryptoTraderService.GetCommunicationRelayers:output_type -> gctrpc.GetCommunicationRelayersResponse
+	13,  // 324: gctrpc.GoCryptoTraderService.GetExchanges:output_type -> gctrpc.GetExchangesResponse
+	144, // 325: gctrpc.GoCryptoTraderService.DisableExchange:output_type -> gctrpc.GenericResponse
+	19,  // 326: gctrpc.GoCryptoTraderService.GetExchangeInfo:output_type -> gctrpc.GetExchangeInfoResponse
+	14,  // 327: gctrpc.GoCryptoTraderService.GetExchangeOTPCode:output_type -> gctrpc.GetExchangeOTPResponse
+	16,  // 328: gctrpc.GoCryptoTraderService.GetExchangeOTPCodes:output_type -> gctrpc.GetExchangeOTPsResponse
+	144, // 329: gctrpc.GoCryptoTraderService.EnableExchange:output_type -> gctrpc.GenericResponse
+	22,  // 330: gctrpc.GoCryptoTraderService.GetTicker:output_type -> gctrpc.TickerResponse
+	25,  // 331: gctrpc.GoCryptoTraderService.GetTickers:output_type -> gctrpc.GetTickersResponse
+	28,  // 332: gctrpc.GoCryptoTraderService.GetOrderbook:output_type -> gctrpc.OrderbookResponse
+	31,  // 333: gctrpc.GoCryptoTraderService.GetOrderbooks:output_type -> gctrpc.GetOrderbooksResponse
+	36,  // 334: gctrpc.GoCryptoTraderService.GetAccountBalances:output_type -> gctrpc.GetAccountBalancesResponse
+	36,  // 335: gctrpc.GoCryptoTraderService.UpdateAccountBalances:output_type -> gctrpc.GetAccountBalancesResponse
+	36,  // 336: gctrpc.GoCryptoTraderService.GetAccountBalancesStream:output_type -> gctrpc.GetAccountBalancesResponse
+	38,  // 337: gctrpc.GoCryptoTraderService.GetConfig:output_type -> gctrpc.GetConfigResponse
+	41,  // 338: gctrpc.GoCryptoTraderService.GetPortfolio:output_type -> gctrpc.GetPortfolioResponse
+	48,  // 339: gctrpc.GoCryptoTraderService.GetPortfolioSummary:output_type -> gctrpc.GetPortfolioSummaryResponse
+	144, // 340: gctrpc.GoCryptoTraderService.AddPortfolioAddress:output_type -> gctrpc.GenericResponse
+	144, // 341: gctrpc.GoCryptoTraderService.RemovePortfolioAddress:output_type -> gctrpc.GenericResponse
+	53,  // 342: gctrpc.GoCryptoTraderService.GetForexProviders:output_type -> gctrpc.GetForexProvidersResponse
+	56,  // 343: gctrpc.GoCryptoTraderService.GetForexRates:output_type -> gctrpc.GetForexRatesResponse
+	60,  // 344: gctrpc.GoCryptoTraderService.GetOrders:output_type -> gctrpc.GetOrdersResponse
+	57,  // 345: gctrpc.GoCryptoTraderService.GetOrder:output_type -> gctrpc.OrderDetails
+	64,  // 346: gctrpc.GoCryptoTraderService.SubmitOrder:output_type -> gctrpc.SubmitOrderResponse
+	66,  // 347: gctrpc.GoCryptoTraderService.SimulateOrder:output_type -> gctrpc.SimulateOrderResponse
+	66,  // 348: gctrpc.GoCryptoTraderService.WhaleBomb:output_type -> gctrpc.SimulateOrderResponse
+	144, // 349: gctrpc.GoCryptoTraderService.CancelOrder:output_type -> gctrpc.GenericResponse
+	71,  // 350: gctrpc.GoCryptoTraderService.CancelBatchOrders:output_type -> gctrpc.CancelBatchOrdersResponse
+	73,  // 351: gctrpc.GoCryptoTraderService.CancelAllOrders:output_type -> gctrpc.CancelAllOrdersResponse
+	76,  // 352: gctrpc.GoCryptoTraderService.GetEvents:output_type -> gctrpc.GetEventsResponse
+	78,  // 353: gctrpc.GoCryptoTraderService.AddEvent:output_type -> gctrpc.AddEventResponse
+	144, // 354: gctrpc.GoCryptoTraderService.RemoveEvent:output_type -> gctrpc.GenericResponse
+	83,  // 355: gctrpc.GoCryptoTraderService.GetCryptocurrencyDepositAddresses:output_type -> gctrpc.GetCryptocurrencyDepositAddressesResponse
+	85,  // 356: gctrpc.GoCryptoTraderService.GetCryptocurrencyDepositAddress:output_type -> gctrpc.GetCryptocurrencyDepositAddressResponse
+	87,  // 357: gctrpc.GoCryptoTraderService.GetAvailableTransferChains:output_type -> gctrpc.GetAvailableTransferChainsResponse
+	90,  // 358: gctrpc.GoCryptoTraderService.WithdrawFiatFunds:output_type -> gctrpc.WithdrawResponse
+	90,  // 359: gctrpc.GoCryptoTraderService.WithdrawCryptocurrencyFunds:output_type -> gctrpc.WithdrawResponse
+	92,  // 360: gctrpc.GoCryptoTraderService.WithdrawalEventByID:output_type -> gctrpc.WithdrawalEventByIDResponse
+	95,  // 361: gctrpc.GoCryptoTraderService.WithdrawalEventsByExchange:output_type -> gctrpc.WithdrawalEventsByExchangeResponse
+	95,  // 362: gctrpc.GoCryptoTraderService.WithdrawalEventsByDate:output_type -> gctrpc.WithdrawalEventsByExchangeResponse
+	101, // 363: gctrpc.GoCryptoTraderService.AddWithdrawalAddress:output_type -> gctrpc.WithdrawalAddress
+	144, // 364: gctrpc.GoCryptoTraderService.RemoveWithdrawalAddress:output_type -> gctrpc.GenericResponse
+	105, // 365: gctrpc.GoCryptoTraderService.GetWithdrawalAddresses:output_type -> gctrpc.GetWithdrawalAddressesResponse
+	109, // 366: gctrpc.GoCryptoTraderService.GetPendingWithdrawals:output_type -> gctrpc.GetPendingWithdrawalsResponse
+	90,  // 367: gctrpc.GoCryptoTraderService.ApproveWithdrawal:output_type -> gctrpc.WithdrawResponse
+	144, // 368: gctrpc.GoCryptoTraderService.RejectWithdrawal:output_type -> gctrpc.GenericResponse
+	113, // 369: gctrpc.GoCryptoTraderService.GetLoggerDetails:output_type -> gctrpc.GetLoggerDetailsResponse
+	113, // 370: gctrpc.GoCryptoTraderService.SetLoggerDetails:output_type -> gctrpc.GetLoggerDetailsResponse
+	116, // 371: gctrpc.GoCryptoTraderService.GetExchangePairs:output_type -> gctrpc.GetExchangePairsResponse
+	144, // 372: gctrpc.GoCryptoTraderService.SetExchangePair:output_type -> gctrpc.GenericResponse
+	28,  // 373: gctrpc.GoCryptoTraderService.GetOrderbookStream:output_type -> gctrpc.OrderbookResponse
+	28,  // 374: gctrpc.GoCryptoTraderService.GetExchangeOrderbookStream:output_type -> gctrpc.OrderbookResponse
+	22,  // 375: gctrpc.GoCryptoTraderService.GetTickerStream:output_type -> gctrpc.TickerResponse
+	22,  // 376: gctrpc.GoCryptoTraderService.GetExchangeTickerStream:output_type -> gctrpc.TickerResponse
+	123, // 377: gctrpc.GoCryptoTraderService.GetAuditEvent:output_type -> gctrpc.GetAuditEventResponse
+	144, // 378: gctrpc.GoCryptoTraderService.GCTScriptExecute:output_type -> gctrpc.GenericResponse
+	144, // 379: gctrpc.GoCryptoTraderService.GCTScriptUpload:output_type -> gctrpc.GenericResponse
+	143, // 380: gctrpc.GoCryptoTraderService.GCTScriptReadScript:output_type -> gctrpc.GCTScriptQueryResponse
+	142, // 381: gctrpc.GoCryptoTraderService.GCTScriptStatus:output_type -> gctrpc.GCTScriptStatusResponse
+	143, // 382: gctrpc.GoCryptoTraderService.GCTScriptQuery:output_type -> gctrpc.GCTScriptQueryResponse
+	144, // 383: gctrpc.GoCryptoTraderService.GCTScriptStop:output_type -> gctrpc.GenericResponse
+	144, // 384: gctrpc.GoCryptoTraderService.GCTScriptStopAll:output_type -> gctrpc.GenericResponse
+	142, // 385: gctrpc.GoCryptoTraderService.GCTScriptListAll:output_type -> gctrpc.GCTScriptStatusResponse
+	144, // 386: gctrpc.GoCryptoTraderService.GCTScriptAutoLoadToggle:output_type -> gctrpc.GenericResponse
+	129, // 387: gctrpc.GoCryptoTraderService.GetHistoricCandles:output_type -> gctrpc.GetHistoricCandlesResponse
+	144, // 388: gctrpc.GoCryptoTraderService.SetExchangeAsset:output_type -> gctrpc.GenericResponse
+	144, // 389: gctrpc.GoCryptoTraderService.SetAllExchangePairs:output_type -> gctrpc.GenericResponse
+	144, // 390: gctrpc.GoCryptoTraderService.UpdateExchangeSupportedPairs:output_type -> gctrpc.GenericResponse
+	149, // 391: gctrpc.GoCryptoTraderService.GetExchangeAssets:output_type -> gctrpc.GetExchangeAssetsResponse
+	151, // 392: gctrpc.GoCryptoTraderService.WebsocketGetInfo:output_type -> gctrpc.WebsocketGetInfoResponse
+	144, // 393: gctrpc.GoCryptoTraderService.WebsocketSetEnabled:output_type -> gctrpc.GenericResponse
+	156, // 394: gctrpc.GoCryptoTraderService.WebsocketGetSubscriptions:output_type -> gctrpc.WebsocketGetSubscriptionsResponse
+	144, // 395: gctrpc.GoCryptoTraderService.WebsocketSetProxy:output_type -> gctrpc.GenericResponse
+	144, // 396: gctrpc.GoCryptoTraderService.WebsocketSetURL:output_type -> gctrpc.GenericResponse
+	126, // 397: gctrpc.GoCryptoTraderService.GetRecentTrades:output_type -> gctrpc.SavedTradesResponse
+	126, // 398: gctrpc.GoCryptoTraderService.GetHistoricTrades:output_type -> gctrpc.SavedTradesResponse
+	126, // 399: gctrpc.GoCryptoTraderService.GetSavedTrades:output_type -> gctrpc.SavedTradesResponse
+	129, // 400: gctrpc.GoCryptoTraderService.ConvertTradesToCandles:output_type -> gctrpc.GetHistoricCandlesResponse
+	161, // 401: gctrpc.GoCryptoTraderService.FindMissingSavedCandleIntervals:output_type -> gctrpc.FindMissingIntervalsResponse
+	161, // 402: gctrpc.GoCryptoTraderService.FindMissingSavedTradeIntervals:output_type -> gctrpc.FindMissingIntervalsResponse
+	144, // 403: gctrpc.GoCryptoTraderService.SetExchangeTradeProcessing:output_type -> gctrpc.GenericResponse
+	166, // 404: gctrpc.GoCryptoTraderService.UpsertDataHistoryJob:output_type -> gctrpc.UpsertDataHistoryJobResponse
+	168, // 405: gctrpc.GoCryptoTraderService.GetDataHistoryJobDetails:output_type -> gctrpc.DataHistoryJob
+	170, // 406: gctrpc.GoCryptoTraderService.GetActiveDataHistoryJobs:output_type -> gctrpc.DataHistoryJobs
+	170, // 407: gctrpc.GoCryptoTraderService.GetDataHistoryJobsBetween:output_type -> gctrpc.DataHistoryJobs
+	168, // 408: gctrpc.GoCryptoTraderService.GetDataHistoryJobSummary:output_type -> gctrpc.DataHistoryJob
+	144, // 409: gctrpc.GoCryptoTraderService.SetDataHistoryJobStatus:output_type -> gctrpc.GenericResponse
+	144, // 410: gctrpc.GoCryptoTraderService.UpdateDataHistoryJobPrerequisite:output_type -> gctrpc.GenericResponse
+	60,  // 411: gctrpc.GoCryptoTraderService.GetManagedOrders:output_type -> gctrpc.GetOrdersResponse
+	175, // 412: gctrpc.GoCryptoTraderService.ModifyOrder:output_type -> gctrpc.ModifyOrderResponse
+	181, // 413: gctrpc.GoCryptoTraderService.CurrencyStateGetAll:output_type -> gctrpc.CurrencyStateResponse
+	144, // 414: gctrpc.GoCryptoTraderService.CurrencyStateTrading:output_type -> gctrpc.GenericResponse
+	144, // 415: gctrpc.GoCryptoTraderService.CurrencyStateDeposit:output_type -> gctrpc.GenericResponse
+	144, // 416: gctrpc.GoCryptoTraderService.CurrencyStateWithdraw:output_type -> gctrpc.GenericResponse
+	144, // 417: gctrpc.GoCryptoTraderService.CurrencyStateTradingPair:output_type -> gctrpc.GenericResponse
+	191, // 418: gctrpc.GoCryptoTraderService.GetFuturesPositionsSummary:output_type -> gctrpc.GetFuturesPositionsSummaryResponse
+	193, // 419: gctrpc.GoCryptoTraderService.GetFuturesPositionsOrders:output_type -> gctrpc.GetFuturesPositionsOrdersResponse
+	209, // 420: gctrpc.GoCryptoTraderService.GetCollateral:output_type -> gctrpc.GetCollateralResponse
+	218, // 421: gctrpc.GoCryptoTraderService.Shutdown:output_type -> gctrpc.ShutdownResponse
+	221, // 422: gctrpc.GoCryptoTraderService.GetTechnicalAnalysis:output_type -> gctrpc.GetTechnicalAnalysisResponse
+	226, // 423: gctrpc.GoCryptoTraderService.GetMarginRatesHistory:output_type -> gctrpc.GetMarginRatesHistoryResponse
+	189, // 424: gctrpc.GoCryptoTraderService.GetManagedPosition:output_type -> gctrpc.GetManagedPositionsResponse
+	189, // 425: gctrpc.GoCryptoTraderService.GetAllManagedPositions:output_type -> gctrpc.GetManagedPositionsResponse
+	214, // 426: gctrpc.GoCryptoTraderService.GetFundingRates:output_type -> gctrpc.GetFundingRatesResponse
+	216, // 427: gctrpc.GoCryptoTraderService.GetLatestFundingRate:output_type -> gctrpc.GetLatestFundingRateResponse
+	228, // 428: gctrpc.GoCryptoTraderService.GetOrderbookMovement:output_type -> gctrpc.GetOrderbookMovementResponse
+	230, // 429: gctrpc.GoCryptoTraderService.GetOrderbookAmountByNominal:output_type -> gctrpc.GetOrderbookAmountByNominalResponse
+	232, // 430: gctrpc.GoCryptoTraderService.GetOrderbookAmountByImpact:output_type -> gctrpc.GetOrderbookAmountByImpactResponse
+	195, // 431: gctrpc.GoCryptoTraderService.GetCollateralMode:output_type -> gctrpc.GetCollateralModeResponse
+	205, // 432: gctrpc.GoCryptoTraderService.GetLeverage:output_type -> gctrpc.GetLeverageResponse
+	197, // 433: gctrpc.GoCryptoTraderService.SetCollateralMode:output_type -> gctrpc.SetCollateralModeResponse
+	203, // 434: gctrpc.GoCryptoTraderService.SetMarginType:output_type -> gctrpc.SetMarginTypeResponse
+	207, // 435: gctrpc.GoCryptoTraderService.SetLeverage:output_type -> gctrpc.SetLeverageResponse
+	201, // 436: gctrpc.GoCryptoTraderService.ChangePositionMargin:output_type -> gctrpc.ChangePositionMarginResponse
+	235, // 437: gctrpc.GoCryptoTraderService.GetOpenInterest:output_type -> gctrpc.GetOpenInterestResponse
+	238, // 438: gctrpc.GoCryptoTraderService.GetCurrencyTradeURL:output_type -> gctrpc.GetCurrencyTradeURLResponse
+	240, // 439: gctrpc.GoCryptoTraderService.GetCandleStream:output_type -> gctrpc.CandleStreamResponse
+	243, // 440: gctrpc.GoCryptoTraderService.GetRateLimitUsage:output_type -> gctrpc.GetRateLimitUsageResponse
+	246, // 441: gctrpc.GoCryptoTraderService.GetMicrostructureStream:output_type -> gctrpc.MicrostructureResponse
+	248, // 442: gctrpc.GoCryptoTraderService.ReloadConfig:output_type -> gctrpc.ReloadConfigResponse
+	252, // 443: gctrpc.GoCryptoTraderService.GetTreasuryTransfers:output_type -> gctrpc.TreasuryTransfersResponse
+	252, // 444: gctrpc.GoCryptoTraderService.RebalanceTreasury:output_type -> gctrpc.TreasuryTransfersResponse
+	256, // 445: gctrpc.GoCryptoTraderService.GetFundingTransfers:output_type -> gctrpc.GetFundingTransfersResponse
+	318, // [318:446] is the sub-list for method output_type
+	190, // [190:318] is the sub-list for method input_type
+	190, // [190:190] is the sub-list for extension type_name
+	190, // [190:190] is the sub-list for extension extendee
+	0,   // [0:190] is the sub-list for field type_name
 }
 
 func init() { file_rpc_proto_init() }
@@ -17147,7 +19787,7 @@ func file_rpc_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_proto_rawDesc), len(file_rpc_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   240,
+			NumMessages:   271,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
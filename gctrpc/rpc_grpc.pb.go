@@ -64,6 +64,12 @@ const (
 	GoCryptoTraderService_WithdrawalEventByID_FullMethodName               = "/gctrpc.GoCryptoTraderService/WithdrawalEventByID"
 	GoCryptoTraderService_WithdrawalEventsByExchange_FullMethodName        = "/gctrpc.GoCryptoTraderService/WithdrawalEventsByExchange"
 	GoCryptoTraderService_WithdrawalEventsByDate_FullMethodName            = "/gctrpc.GoCryptoTraderService/WithdrawalEventsByDate"
+	GoCryptoTraderService_AddWithdrawalAddress_FullMethodName              = "/gctrpc.GoCryptoTraderService/AddWithdrawalAddress"
+	GoCryptoTraderService_RemoveWithdrawalAddress_FullMethodName           = "/gctrpc.GoCryptoTraderService/RemoveWithdrawalAddress"
+	GoCryptoTraderService_GetWithdrawalAddresses_FullMethodName            = "/gctrpc.GoCryptoTraderService/GetWithdrawalAddresses"
+	GoCryptoTraderService_GetPendingWithdrawals_FullMethodName             = "/gctrpc.GoCryptoTraderService/GetPendingWithdrawals"
+	GoCryptoTraderService_ApproveWithdrawal_FullMethodName                 = "/gctrpc.GoCryptoTraderService/ApproveWithdrawal"
+	GoCryptoTraderService_RejectWithdrawal_FullMethodName                  = "/gctrpc.GoCryptoTraderService/RejectWithdrawal"
 	GoCryptoTraderService_GetLoggerDetails_FullMethodName                  = "/gctrpc.GoCryptoTraderService/GetLoggerDetails"
 	GoCryptoTraderService_SetLoggerDetails_FullMethodName                  = "/gctrpc.GoCryptoTraderService/SetLoggerDetails"
 	GoCryptoTraderService_GetExchangePairs_FullMethodName                  = "/gctrpc.GoCryptoTraderService/GetExchangePairs"
@@ -134,6 +140,13 @@ const (
 	GoCryptoTraderService_ChangePositionMargin_FullMethodName              = "/gctrpc.GoCryptoTraderService/ChangePositionMargin"
 	GoCryptoTraderService_GetOpenInterest_FullMethodName                   = "/gctrpc.GoCryptoTraderService/GetOpenInterest"
 	GoCryptoTraderService_GetCurrencyTradeURL_FullMethodName               = "/gctrpc.GoCryptoTraderService/GetCurrencyTradeURL"
+	GoCryptoTraderService_GetCandleStream_FullMethodName                   = "/gctrpc.GoCryptoTraderService/GetCandleStream"
+	GoCryptoTraderService_GetRateLimitUsage_FullMethodName                 = "/gctrpc.GoCryptoTraderService/GetRateLimitUsage"
+	GoCryptoTraderService_GetMicrostructureStream_FullMethodName           = "/gctrpc.GoCryptoTraderService/GetMicrostructureStream"
+	GoCryptoTraderService_ReloadConfig_FullMethodName                      = "/gctrpc.GoCryptoTraderService/ReloadConfig"
+	GoCryptoTraderService_GetTreasuryTransfers_FullMethodName              = "/gctrpc.GoCryptoTraderService/GetTreasuryTransfers"
+	GoCryptoTraderService_RebalanceTreasury_FullMethodName                 = "/gctrpc.GoCryptoTraderService/RebalanceTreasury"
+	GoCryptoTraderService_GetFundingTransfers_FullMethodName               = "/gctrpc.GoCryptoTraderService/GetFundingTransfers"
 )
 
 // GoCryptoTraderServiceClient is the client API for GoCryptoTraderService service.
@@ -185,6 +198,12 @@ type GoCryptoTraderServiceClient interface {
 	WithdrawalEventByID(ctx context.Context, in *WithdrawalEventByIDRequest, opts ...grpc.CallOption) (*WithdrawalEventByIDResponse, error)
 	WithdrawalEventsByExchange(ctx context.Context, in *WithdrawalEventsByExchangeRequest, opts ...grpc.CallOption) (*WithdrawalEventsByExchangeResponse, error)
 	WithdrawalEventsByDate(ctx context.Context, in *WithdrawalEventsByDateRequest, opts ...grpc.CallOption) (*WithdrawalEventsByExchangeResponse, error)
+	AddWithdrawalAddress(ctx context.Context, in *AddWithdrawalAddressRequest, opts ...grpc.CallOption) (*WithdrawalAddress, error)
+	RemoveWithdrawalAddress(ctx context.Context, in *RemoveWithdrawalAddressRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	GetWithdrawalAddresses(ctx context.Context, in *GetWithdrawalAddressesRequest, opts ...grpc.CallOption) (*GetWithdrawalAddressesResponse, error)
+	GetPendingWithdrawals(ctx context.Context, in *GetPendingWithdrawalsRequest, opts ...grpc.CallOption) (*GetPendingWithdrawalsResponse, error)
+	ApproveWithdrawal(ctx context.Context, in *ApproveWithdrawalRequest, opts ...grpc.CallOption) (*WithdrawResponse, error)
+	RejectWithdrawal(ctx context.Context, in *RejectWithdrawalRequest, opts ...grpc.CallOption) (*GenericResponse, error)
 	GetLoggerDetails(ctx context.Context, in *GetLoggerDetailsRequest, opts ...grpc.CallOption) (*GetLoggerDetailsResponse, error)
 	SetLoggerDetails(ctx context.Context, in *SetLoggerDetailsRequest, opts ...grpc.CallOption) (*GetLoggerDetailsResponse, error)
 	GetExchangePairs(ctx context.Context, in *GetExchangePairsRequest, opts ...grpc.CallOption) (*GetExchangePairsResponse, error)
@@ -255,6 +274,13 @@ type GoCryptoTraderServiceClient interface {
 	ChangePositionMargin(ctx context.Context, in *ChangePositionMarginRequest, opts ...grpc.CallOption) (*ChangePositionMarginResponse, error)
 	GetOpenInterest(ctx context.Context, in *GetOpenInterestRequest, opts ...grpc.CallOption) (*GetOpenInterestResponse, error)
 	GetCurrencyTradeURL(ctx context.Context, in *GetCurrencyTradeURLRequest, opts ...grpc.CallOption) (*GetCurrencyTradeURLResponse, error)
+	GetCandleStream(ctx context.Context, in *GetCandleStreamRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CandleStreamResponse], error)
+	GetRateLimitUsage(ctx context.Context, in *GetRateLimitUsageRequest, opts ...grpc.CallOption) (*GetRateLimitUsageResponse, error)
+	GetMicrostructureStream(ctx context.Context, in *GetMicrostructureStreamRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[MicrostructureResponse], error)
+	ReloadConfig(ctx context.Context, in *ReloadConfigRequest, opts ...grpc.CallOption) (*ReloadConfigResponse, error)
+	GetTreasuryTransfers(ctx context.Context, in *GetTreasuryTransfersRequest, opts ...grpc.CallOption) (*TreasuryTransfersResponse, error)
+	RebalanceTreasury(ctx context.Context, in *RebalanceTreasuryRequest, opts ...grpc.CallOption) (*TreasuryTransfersResponse, error)
+	GetFundingTransfers(ctx context.Context, in *GetFundingTransfersRequest, opts ...grpc.CallOption) (*GetFundingTransfersResponse, error)
 }
 
 type goCryptoTraderServiceClient struct {
@@ -724,6 +750,66 @@ func (c *goCryptoTraderServiceClient) WithdrawalEventsByDate(ctx context.Context
 	return out, nil
 }
 
+func (c *goCryptoTraderServiceClient) AddWithdrawalAddress(ctx context.Context, in *AddWithdrawalAddressRequest, opts ...grpc.CallOption) (*WithdrawalAddress, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WithdrawalAddress)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_AddWithdrawalAddress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) RemoveWithdrawalAddress(ctx context.Context, in *RemoveWithdrawalAddressRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_RemoveWithdrawalAddress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) GetWithdrawalAddresses(ctx context.Context, in *GetWithdrawalAddressesRequest, opts ...grpc.CallOption) (*GetWithdrawalAddressesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWithdrawalAddressesResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetWithdrawalAddresses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) GetPendingWithdrawals(ctx context.Context, in *GetPendingWithdrawalsRequest, opts ...grpc.CallOption) (*GetPendingWithdrawalsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPendingWithdrawalsResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetPendingWithdrawals_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) ApproveWithdrawal(ctx context.Context, in *ApproveWithdrawalRequest, opts ...grpc.CallOption) (*WithdrawResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WithdrawResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_ApproveWithdrawal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) RejectWithdrawal(ctx context.Context, in *RejectWithdrawalRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_RejectWithdrawal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *goCryptoTraderServiceClient) GetLoggerDetails(ctx context.Context, in *GetLoggerDetailsRequest, opts ...grpc.CallOption) (*GetLoggerDetailsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetLoggerDetailsResponse)
@@ -1469,6 +1555,94 @@ func (c *goCryptoTraderServiceClient) GetCurrencyTradeURL(ctx context.Context, i
 	return out, nil
 }
 
+func (c *goCryptoTraderServiceClient) GetCandleStream(ctx context.Context, in *GetCandleStreamRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CandleStreamResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GoCryptoTraderService_ServiceDesc.Streams[6], GoCryptoTraderService_GetCandleStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetCandleStreamRequest, CandleStreamResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GoCryptoTraderService_GetCandleStreamClient = grpc.ServerStreamingClient[CandleStreamResponse]
+
+func (c *goCryptoTraderServiceClient) GetRateLimitUsage(ctx context.Context, in *GetRateLimitUsageRequest, opts ...grpc.CallOption) (*GetRateLimitUsageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRateLimitUsageResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetRateLimitUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) GetMicrostructureStream(ctx context.Context, in *GetMicrostructureStreamRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[MicrostructureResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GoCryptoTraderService_ServiceDesc.Streams[7], GoCryptoTraderService_GetMicrostructureStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetMicrostructureStreamRequest, MicrostructureResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GoCryptoTraderService_GetMicrostructureStreamClient = grpc.ServerStreamingClient[MicrostructureResponse]
+
+func (c *goCryptoTraderServiceClient) ReloadConfig(ctx context.Context, in *ReloadConfigRequest, opts ...grpc.CallOption) (*ReloadConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReloadConfigResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_ReloadConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) GetTreasuryTransfers(ctx context.Context, in *GetTreasuryTransfersRequest, opts ...grpc.CallOption) (*TreasuryTransfersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TreasuryTransfersResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetTreasuryTransfers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) RebalanceTreasury(ctx context.Context, in *RebalanceTreasuryRequest, opts ...grpc.CallOption) (*TreasuryTransfersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TreasuryTransfersResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_RebalanceTreasury_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) GetFundingTransfers(ctx context.Context, in *GetFundingTransfersRequest, opts ...grpc.CallOption) (*GetFundingTransfersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFundingTransfersResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetFundingTransfers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GoCryptoTraderServiceServer is the server API for GoCryptoTraderService service.
 // All implementations must embed UnimplementedGoCryptoTraderServiceServer
 // for forward compatibility.
@@ -1518,6 +1692,12 @@ type GoCryptoTraderServiceServer interface {
 	WithdrawalEventByID(context.Context, *WithdrawalEventByIDRequest) (*WithdrawalEventByIDResponse, error)
 	WithdrawalEventsByExchange(context.Context, *WithdrawalEventsByExchangeRequest) (*WithdrawalEventsByExchangeResponse, error)
 	WithdrawalEventsByDate(context.Context, *WithdrawalEventsByDateRequest) (*WithdrawalEventsByExchangeResponse, error)
+	AddWithdrawalAddress(context.Context, *AddWithdrawalAddressRequest) (*WithdrawalAddress, error)
+	RemoveWithdrawalAddress(context.Context, *RemoveWithdrawalAddressRequest) (*GenericResponse, error)
+	GetWithdrawalAddresses(context.Context, *GetWithdrawalAddressesRequest) (*GetWithdrawalAddressesResponse, error)
+	GetPendingWithdrawals(context.Context, *GetPendingWithdrawalsRequest) (*GetPendingWithdrawalsResponse, error)
+	ApproveWithdrawal(context.Context, *ApproveWithdrawalRequest) (*WithdrawResponse, error)
+	RejectWithdrawal(context.Context, *RejectWithdrawalRequest) (*GenericResponse, error)
 	GetLoggerDetails(context.Context, *GetLoggerDetailsRequest) (*GetLoggerDetailsResponse, error)
 	SetLoggerDetails(context.Context, *SetLoggerDetailsRequest) (*GetLoggerDetailsResponse, error)
 	GetExchangePairs(context.Context, *GetExchangePairsRequest) (*GetExchangePairsResponse, error)
@@ -1588,6 +1768,13 @@ type GoCryptoTraderServiceServer interface {
 	ChangePositionMargin(context.Context, *ChangePositionMarginRequest) (*ChangePositionMarginResponse, error)
 	GetOpenInterest(context.Context, *GetOpenInterestRequest) (*GetOpenInterestResponse, error)
 	GetCurrencyTradeURL(context.Context, *GetCurrencyTradeURLRequest) (*GetCurrencyTradeURLResponse, error)
+	GetCandleStream(*GetCandleStreamRequest, grpc.ServerStreamingServer[CandleStreamResponse]) error
+	GetRateLimitUsage(context.Context, *GetRateLimitUsageRequest) (*GetRateLimitUsageResponse, error)
+	GetMicrostructureStream(*GetMicrostructureStreamRequest, grpc.ServerStreamingServer[MicrostructureResponse]) error
+	ReloadConfig(context.Context, *ReloadConfigRequest) (*ReloadConfigResponse, error)
+	GetTreasuryTransfers(context.Context, *GetTreasuryTransfersRequest) (*TreasuryTransfersResponse, error)
+	RebalanceTreasury(context.Context, *RebalanceTreasuryRequest) (*TreasuryTransfersResponse, error)
+	GetFundingTransfers(context.Context, *GetFundingTransfersRequest) (*GetFundingTransfersResponse, error)
 	mustEmbedUnimplementedGoCryptoTraderServiceServer()
 }
 
@@ -1733,6 +1920,24 @@ func (UnimplementedGoCryptoTraderServiceServer) WithdrawalEventsByExchange(conte
 func (UnimplementedGoCryptoTraderServiceServer) WithdrawalEventsByDate(context.Context, *WithdrawalEventsByDateRequest) (*WithdrawalEventsByExchangeResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method WithdrawalEventsByDate not implemented")
 }
+func (UnimplementedGoCryptoTraderServiceServer) AddWithdrawalAddress(context.Context, *AddWithdrawalAddressRequest) (*WithdrawalAddress, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddWithdrawalAddress not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) RemoveWithdrawalAddress(context.Context, *RemoveWithdrawalAddressRequest) (*GenericResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveWithdrawalAddress not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetWithdrawalAddresses(context.Context, *GetWithdrawalAddressesRequest) (*GetWithdrawalAddressesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetWithdrawalAddresses not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetPendingWithdrawals(context.Context, *GetPendingWithdrawalsRequest) (*GetPendingWithdrawalsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPendingWithdrawals not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) ApproveWithdrawal(context.Context, *ApproveWithdrawalRequest) (*WithdrawResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ApproveWithdrawal not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) RejectWithdrawal(context.Context, *RejectWithdrawalRequest) (*GenericResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RejectWithdrawal not implemented")
+}
 func (UnimplementedGoCryptoTraderServiceServer) GetLoggerDetails(context.Context, *GetLoggerDetailsRequest) (*GetLoggerDetailsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetLoggerDetails not implemented")
 }
@@ -1943,6 +2148,27 @@ func (UnimplementedGoCryptoTraderServiceServer) GetOpenInterest(context.Context,
 func (UnimplementedGoCryptoTraderServiceServer) GetCurrencyTradeURL(context.Context, *GetCurrencyTradeURLRequest) (*GetCurrencyTradeURLResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetCurrencyTradeURL not implemented")
 }
+func (UnimplementedGoCryptoTraderServiceServer) GetCandleStream(*GetCandleStreamRequest, grpc.ServerStreamingServer[CandleStreamResponse]) error {
+	return status.Error(codes.Unimplemented, "method GetCandleStream not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetRateLimitUsage(context.Context, *GetRateLimitUsageRequest) (*GetRateLimitUsageResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRateLimitUsage not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetMicrostructureStream(*GetMicrostructureStreamRequest, grpc.ServerStreamingServer[MicrostructureResponse]) error {
+	return status.Error(codes.Unimplemented, "method GetMicrostructureStream not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) ReloadConfig(context.Context, *ReloadConfigRequest) (*ReloadConfigResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReloadConfig not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetTreasuryTransfers(context.Context, *GetTreasuryTransfersRequest) (*TreasuryTransfersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTreasuryTransfers not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) RebalanceTreasury(context.Context, *RebalanceTreasuryRequest) (*TreasuryTransfersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RebalanceTreasury not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetFundingTransfers(context.Context, *GetFundingTransfersRequest) (*GetFundingTransfersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetFundingTransfers not implemented")
+}
 func (UnimplementedGoCryptoTraderServiceServer) mustEmbedUnimplementedGoCryptoTraderServiceServer() {}
 func (UnimplementedGoCryptoTraderServiceServer) testEmbeddedByValue()                               {}
 
@@ -2767,6 +2993,114 @@ func _GoCryptoTraderService_WithdrawalEventsByDate_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GoCryptoTraderService_AddWithdrawalAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddWithdrawalAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).AddWithdrawalAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_AddWithdrawalAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).AddWithdrawalAddress(ctx, req.(*AddWithdrawalAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_RemoveWithdrawalAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveWithdrawalAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).RemoveWithdrawalAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_RemoveWithdrawalAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).RemoveWithdrawalAddress(ctx, req.(*RemoveWithdrawalAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetWithdrawalAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWithdrawalAddressesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetWithdrawalAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetWithdrawalAddresses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetWithdrawalAddresses(ctx, req.(*GetWithdrawalAddressesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetPendingWithdrawals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPendingWithdrawalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetPendingWithdrawals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetPendingWithdrawals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetPendingWithdrawals(ctx, req.(*GetPendingWithdrawalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_ApproveWithdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveWithdrawalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).ApproveWithdrawal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_ApproveWithdrawal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).ApproveWithdrawal(ctx, req.(*ApproveWithdrawalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_RejectWithdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectWithdrawalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).RejectWithdrawal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_RejectWithdrawal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).RejectWithdrawal(ctx, req.(*RejectWithdrawalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _GoCryptoTraderService_GetLoggerDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetLoggerDetailsRequest)
 	if err := dec(in); err != nil {
@@ -3992,6 +4326,118 @@ func _GoCryptoTraderService_GetCurrencyTradeURL_Handler(srv interface{}, ctx con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GoCryptoTraderService_GetCandleStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetCandleStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoCryptoTraderServiceServer).GetCandleStream(m, &grpc.GenericServerStream[GetCandleStreamRequest, CandleStreamResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GoCryptoTraderService_GetCandleStreamServer = grpc.ServerStreamingServer[CandleStreamResponse]
+
+func _GoCryptoTraderService_GetRateLimitUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRateLimitUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetRateLimitUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetRateLimitUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetRateLimitUsage(ctx, req.(*GetRateLimitUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetMicrostructureStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetMicrostructureStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoCryptoTraderServiceServer).GetMicrostructureStream(m, &grpc.GenericServerStream[GetMicrostructureStreamRequest, MicrostructureResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GoCryptoTraderService_GetMicrostructureStreamServer = grpc.ServerStreamingServer[MicrostructureResponse]
+
+func _GoCryptoTraderService_ReloadConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReloadConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).ReloadConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_ReloadConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).ReloadConfig(ctx, req.(*ReloadConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetTreasuryTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTreasuryTransfersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetTreasuryTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetTreasuryTransfers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetTreasuryTransfers(ctx, req.(*GetTreasuryTransfersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_RebalanceTreasury_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RebalanceTreasuryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).RebalanceTreasury(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_RebalanceTreasury_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).RebalanceTreasury(ctx, req.(*RebalanceTreasuryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetFundingTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFundingTransfersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetFundingTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetFundingTransfers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetFundingTransfers(ctx, req.(*GetFundingTransfersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // GoCryptoTraderService_ServiceDesc is the grpc.ServiceDesc for GoCryptoTraderService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -4175,6 +4621,30 @@ var GoCryptoTraderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "WithdrawalEventsByDate",
 			Handler:    _GoCryptoTraderService_WithdrawalEventsByDate_Handler,
 		},
+		{
+			MethodName: "AddWithdrawalAddress",
+			Handler:    _GoCryptoTraderService_AddWithdrawalAddress_Handler,
+		},
+		{
+			MethodName: "RemoveWithdrawalAddress",
+			Handler:    _GoCryptoTraderService_RemoveWithdrawalAddress_Handler,
+		},
+		{
+			MethodName: "GetWithdrawalAddresses",
+			Handler:    _GoCryptoTraderService_GetWithdrawalAddresses_Handler,
+		},
+		{
+			MethodName: "GetPendingWithdrawals",
+			Handler:    _GoCryptoTraderService_GetPendingWithdrawals_Handler,
+		},
+		{
+			MethodName: "ApproveWithdrawal",
+			Handler:    _GoCryptoTraderService_ApproveWithdrawal_Handler,
+		},
+		{
+			MethodName: "RejectWithdrawal",
+			Handler:    _GoCryptoTraderService_RejectWithdrawal_Handler,
+		},
 		{
 			MethodName: "GetLoggerDetails",
 			Handler:    _GoCryptoTraderService_GetLoggerDetails_Handler,
@@ -4435,6 +4905,26 @@ var GoCryptoTraderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCurrencyTradeURL",
 			Handler:    _GoCryptoTraderService_GetCurrencyTradeURL_Handler,
 		},
+		{
+			MethodName: "GetRateLimitUsage",
+			Handler:    _GoCryptoTraderService_GetRateLimitUsage_Handler,
+		},
+		{
+			MethodName: "ReloadConfig",
+			Handler:    _GoCryptoTraderService_ReloadConfig_Handler,
+		},
+		{
+			MethodName: "GetTreasuryTransfers",
+			Handler:    _GoCryptoTraderService_GetTreasuryTransfers_Handler,
+		},
+		{
+			MethodName: "RebalanceTreasury",
+			Handler:    _GoCryptoTraderService_RebalanceTreasury_Handler,
+		},
+		{
+			MethodName: "GetFundingTransfers",
+			Handler:    _GoCryptoTraderService_GetFundingTransfers_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -4467,6 +4957,16 @@ var GoCryptoTraderService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _GoCryptoTraderService_GetHistoricTrades_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "GetCandleStream",
+			Handler:       _GoCryptoTraderService_GetCandleStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetMicrostructureStream",
+			Handler:       _GoCryptoTraderService_GetMicrostructureStream_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "rpc.proto",
 }
@@ -616,7 +616,7 @@ func ExchangeWithdrawCrypto(args ...objects.Object) (objects.Object, error) {
 		return errorResponsef(standardFormatting, err)
 	}
 
-	return &objects.String{Value: rtn}, nil
+	return withdrawResponse(rtn), nil
 }
 
 // ExchangeWithdrawFiat submit request to withdraw fiat assets
@@ -664,7 +664,19 @@ func ExchangeWithdrawFiat(args ...objects.Object) (objects.Object, error) {
 		return errorResponsef(standardFormatting, err)
 	}
 
-	return &objects.String{Value: rtn}, nil
+	return withdrawResponse(rtn), nil
+}
+
+// withdrawResponse converts a withdrawal response to a script object. The id
+// is the one used to approve or look up the withdrawal, which is set before
+// the exchange is called, while the status shows whether the withdrawal is
+// still pending approval
+func withdrawResponse(resp *withdraw.Response) objects.Object {
+	return &objects.Map{Value: map[string]objects.Object{
+		"id":         &objects.String{Value: resp.ID.String()},
+		"exchangeid": &objects.String{Value: resp.Exchange.ID},
+		"status":     &objects.String{Value: resp.Exchange.Status},
+	}}
 }
 
 // OHLCV defines a custom Open High Low Close Volume tengo object
@@ -214,10 +214,12 @@ func TestExchangeWithdrawCrypto(t *testing.T) {
 	address := &objects.String{Value: "0xTHISISALEGITBTCADDRESSS"}
 	amount := &objects.Float{Value: 1.0}
 
-	_, err = ExchangeWithdrawCrypto(ctx, exch, currCode, address, address, amount, amount, desc)
-	if err != nil {
-		t.Error(err)
-	}
+	resp, err := ExchangeWithdrawCrypto(ctx, exch, currCode, address, address, amount, amount, desc)
+	require.NoError(t, err, "ExchangeWithdrawCrypto must not error")
+	m, ok := resp.(*objects.Map)
+	require.True(t, ok, "ExchangeWithdrawCrypto must return a map")
+	assert.Equal(t, &objects.String{Value: "pending approval"}, m.Value["status"], "ExchangeWithdrawCrypto should return the withdrawal status")
+	assert.Contains(t, m.Value, "id", "ExchangeWithdrawCrypto should return the withdrawal ID")
 }
 
 func TestExchangeWithdrawFiat(t *testing.T) {
@@ -229,10 +231,12 @@ func TestExchangeWithdrawFiat(t *testing.T) {
 	desc := &objects.String{Value: "Hello"}
 	amount := &objects.Float{Value: 1.0}
 	bankID := &objects.String{Value: "test-bank-01"}
-	_, err = ExchangeWithdrawFiat(ctx, exch, currCode, desc, amount, bankID)
-	if err != nil {
-		t.Error(err)
-	}
+	resp, err := ExchangeWithdrawFiat(ctx, exch, currCode, desc, amount, bankID)
+	require.NoError(t, err, "ExchangeWithdrawFiat must not error")
+	m, ok := resp.(*objects.Map)
+	require.True(t, ok, "ExchangeWithdrawFiat must return a map")
+	assert.Equal(t, &objects.String{Value: "123"}, m.Value["exchangeid"], "ExchangeWithdrawFiat should return the exchange withdrawal ID")
+	assert.Equal(t, &objects.String{Value: "completed"}, m.Value["status"], "ExchangeWithdrawFiat should return the withdrawal status")
 }
 
 func TestParseInterval(t *testing.T) {
@@ -0,0 +1,56 @@
+package indicators
+
+import (
+	objects "github.com/d5/tengo/v2"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers/validator"
+)
+
+// ADXModule average directional index indicator commands
+var ADXModule = map[string]objects.Object{
+	"calculate": &objects.UserFunction{Name: "calculate", Value: adx},
+}
+
+// AverageDirectionalIndex is the string constant
+const AverageDirectionalIndex = "Average Directional Index"
+
+// ADX defines a custom Average Directional Index tengo indicator object type
+type ADX struct {
+	objects.Array
+	Period int
+}
+
+// TypeName returns the name of the custom type.
+func (o *ADX) TypeName() string {
+	return AverageDirectionalIndex
+}
+
+func adx(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 2 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	r := new(ADX)
+	if validator.IsTestExecution.Load() == true {
+		return r, nil
+	}
+
+	ohlcv, _, err := toOHLC(args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := toInts(args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	r.Period = int(params[0])
+
+	ret, err := ohlcv.GetAverageDirectionalIndex(params[0])
+	if err != nil {
+		return nil, err
+	}
+	r.Value = toSignalArrays(ret.ADX, ret.PlusDI, ret.MinusDI)
+
+	return r, nil
+}
@@ -0,0 +1,56 @@
+package indicators
+
+import (
+	objects "github.com/d5/tengo/v2"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers/validator"
+)
+
+// CCIModule commodity channel index indicator commands
+var CCIModule = map[string]objects.Object{
+	"calculate": &objects.UserFunction{Name: "calculate", Value: cci},
+}
+
+// CommodityChannelIndex is the string constant
+const CommodityChannelIndex = "Commodity Channel Index"
+
+// CCI defines a custom Commodity Channel Index tengo indicator object type
+type CCI struct {
+	objects.Array
+	Period int
+}
+
+// TypeName returns the name of the custom type.
+func (o *CCI) TypeName() string {
+	return CommodityChannelIndex
+}
+
+func cci(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 2 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	r := new(CCI)
+	if validator.IsTestExecution.Load() == true {
+		return r, nil
+	}
+
+	ohlcv, _, err := toOHLC(args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := toInts(args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	r.Period = int(params[0])
+
+	ret, err := ohlcv.GetCommodityChannelIndex(params[0])
+	if err != nil {
+		return nil, err
+	}
+	r.Value = toSignalArray(ret)
+
+	return r, nil
+}
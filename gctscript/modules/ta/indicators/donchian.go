@@ -0,0 +1,56 @@
+package indicators
+
+import (
+	objects "github.com/d5/tengo/v2"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers/validator"
+)
+
+// DonchianModule donchian channels indicator commands
+var DonchianModule = map[string]objects.Object{
+	"calculate": &objects.UserFunction{Name: "calculate", Value: donchian},
+}
+
+// DonchianChannels is the string constant
+const DonchianChannels = "Donchian Channels"
+
+// Donchian defines a custom Donchian Channels tengo indicator object type
+type Donchian struct {
+	objects.Array
+	Period int
+}
+
+// TypeName returns the name of the custom type.
+func (o *Donchian) TypeName() string {
+	return DonchianChannels
+}
+
+func donchian(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 2 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	r := new(Donchian)
+	if validator.IsTestExecution.Load() == true {
+		return r, nil
+	}
+
+	ohlcv, _, err := toOHLC(args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := toInts(args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	r.Period = int(params[0])
+
+	ret, err := ohlcv.GetDonchianChannels(params[0])
+	if err != nil {
+		return nil, err
+	}
+	r.Value = toSignalArrays(ret.Middle, ret.Upper, ret.Lower)
+
+	return r, nil
+}
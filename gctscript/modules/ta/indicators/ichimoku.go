@@ -0,0 +1,59 @@
+package indicators
+
+import (
+	objects "github.com/d5/tengo/v2"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers/validator"
+)
+
+// IchimokuModule ichimoku cloud indicator commands
+var IchimokuModule = map[string]objects.Object{
+	"calculate": &objects.UserFunction{Name: "calculate", Value: ichimoku},
+}
+
+// IchimokuCloud is the string constant
+const IchimokuCloud = "Ichimoku Cloud"
+
+// Ichimoku defines a custom Ichimoku Cloud tengo indicator object type
+type Ichimoku struct {
+	objects.Array
+	Conversion, Base, SpanB, Displacement int
+}
+
+// TypeName returns the name of the custom type.
+func (o *Ichimoku) TypeName() string {
+	return IchimokuCloud
+}
+
+func ichimoku(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 5 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	r := new(Ichimoku)
+	if validator.IsTestExecution.Load() == true {
+		return r, nil
+	}
+
+	ohlcv, _, err := toOHLC(args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := toInts(args[1:]...)
+	if err != nil {
+		return nil, err
+	}
+
+	r.Conversion = int(params[0])
+	r.Base = int(params[1])
+	r.SpanB = int(params[2])
+	r.Displacement = int(params[3])
+
+	ret, err := ohlcv.GetIchimoku(params[0], params[1], params[2], params[3])
+	if err != nil {
+		return nil, err
+	}
+	r.Value = toSignalArrays(ret.Conversion, ret.Base, ret.LeadingSpanA, ret.LeadingSpanB, ret.LaggingSpan)
+
+	return r, nil
+}
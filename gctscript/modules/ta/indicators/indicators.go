@@ -4,8 +4,11 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
+	objects "github.com/d5/tengo/v2"
 	"github.com/thrasher-corp/gct-ta/indicators"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
 )
 
@@ -59,3 +62,110 @@ func ParseMAType(in string) (indicators.MaType, error) {
 		return 0, errInvalidSelector
 	}
 }
+
+// toOHLC converts script OHLCV candle data into kline OHLC data sets along
+// with the candle times for indicators that anchor to sessions
+func toOHLC(in objects.Object) (*kline.OHLC, []time.Time, error) {
+	ohlcvInputData, valid := objects.ToInterface(in).([]any)
+	if !valid {
+		return nil, nil, fmt.Errorf(modules.ErrParameterConvertFailed, OHLCV)
+	}
+
+	ohlc := &kline.OHLC{
+		Open:   make([]float64, len(ohlcvInputData)),
+		High:   make([]float64, len(ohlcvInputData)),
+		Low:    make([]float64, len(ohlcvInputData)),
+		Close:  make([]float64, len(ohlcvInputData)),
+		Volume: make([]float64, len(ohlcvInputData)),
+	}
+	times := make([]time.Time, len(ohlcvInputData))
+	var allErrors []string
+	for x := range ohlcvInputData {
+		t, ok := ohlcvInputData[x].([]any)
+		if !ok {
+			return nil, nil, errors.New("ohlcvInputData type assert failed")
+		}
+		if len(t) < 6 {
+			return nil, nil, errors.New("ohlcvInputData invalid data length")
+		}
+		switch tt := t[0].(type) {
+		case time.Time:
+			times[x] = tt
+		case int64:
+			times[x] = time.Unix(tt, 0)
+		default:
+			allErrors = append(allErrors, fmt.Sprintf(modules.ErrParameterConvertFailed, tt))
+		}
+		for i, target := range [][]float64{ohlc.Open, ohlc.High, ohlc.Low, ohlc.Close, ohlc.Volume} {
+			value, err := toFloat64(t[i+1])
+			if err != nil {
+				allErrors = append(allErrors, err.Error())
+			}
+			target[x] = value
+		}
+	}
+	if len(allErrors) > 0 {
+		return nil, nil, errors.New(strings.Join(allErrors, ", "))
+	}
+	return ohlc, times, nil
+}
+
+// toInts converts script arguments to integer parameters
+func toInts(args ...objects.Object) ([]int64, error) {
+	out := make([]int64, len(args))
+	var allErrors []string
+	for x := range args {
+		v, ok := objects.ToInt64(args[x])
+		if !ok {
+			allErrors = append(allErrors, fmt.Sprintf(modules.ErrParameterConvertFailed, args[x]))
+		}
+		out[x] = v
+	}
+	if len(allErrors) > 0 {
+		return nil, errors.New(strings.Join(allErrors, ", "))
+	}
+	return out, nil
+}
+
+// toFloats converts script arguments to float parameters
+func toFloats(args ...objects.Object) ([]float64, error) {
+	out := make([]float64, len(args))
+	var allErrors []string
+	for x := range args {
+		v, ok := objects.ToFloat64(args[x])
+		if !ok {
+			allErrors = append(allErrors, fmt.Sprintf(modules.ErrParameterConvertFailed, args[x]))
+		}
+		out[x] = v
+	}
+	if len(allErrors) > 0 {
+		return nil, errors.New(strings.Join(allErrors, ", "))
+	}
+	return out, nil
+}
+
+// toSignalArray returns a single indicator line as script floats
+func toSignalArray(in []float64) []objects.Object {
+	out := make([]objects.Object, len(in))
+	for x := range in {
+		out[x] = &objects.Float{Value: in[x]}
+	}
+	return out
+}
+
+// toSignalArrays returns multiple indicator lines with one array per candle
+// holding each line's value in the supplied order
+func toSignalArrays(lines ...[]float64) []objects.Object {
+	if len(lines) == 0 {
+		return nil
+	}
+	out := make([]objects.Object, len(lines[0]))
+	for x := range out {
+		values := make([]objects.Object, len(lines))
+		for i := range lines {
+			values[i] = &objects.Float{Value: lines[i][x]}
+		}
+		out[x] = &objects.Array{Value: values}
+	}
+	return out
+}
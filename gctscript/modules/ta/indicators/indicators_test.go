@@ -509,3 +509,227 @@ func TestParseMAType(t *testing.T) {
 		})
 	}
 }
+
+func TestStochastic(t *testing.T) {
+	_, err := stochastic()
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+
+	v := &objects.String{Value: testString}
+	_, err = stochastic(ohlcvData, v, &objects.Int{Value: 3}, &objects.Int{Value: 3})
+	assert.ErrorContains(t, err, "failed conversion")
+
+	_, err = stochastic(ohlcvDataInvalid, &objects.Int{Value: 14}, &objects.Int{Value: 3}, &objects.Int{Value: 3})
+	assert.ErrorContains(t, err, "failed conversion")
+
+	_, err = stochastic(ohlcvData, &objects.Int{Value: 0}, &objects.Int{Value: 3}, &objects.Int{Value: 3})
+	assert.Error(t, err, "stochastic should error on an invalid period")
+
+	ret, err := stochastic(ohlcvData, &objects.Int{Value: 14}, &objects.Int{Value: 3}, &objects.Int{Value: 3})
+	require.NoError(t, err, "stochastic must not error on valid input")
+	s, ok := ret.(*Stochastic)
+	require.True(t, ok, "stochastic must return a Stochastic type")
+	assert.Len(t, s.Value, 100, "stochastic should return a value per candle")
+	assert.Equal(t, StochasticOscillator, s.TypeName())
+
+	validator.IsTestExecution.Store(true)
+	ret, err = stochastic(ohlcvData, &objects.Int{Value: 14}, &objects.Int{Value: 3}, &objects.Int{Value: 3})
+	require.NoError(t, err, "stochastic must not error on test execution")
+	assert.NotNil(t, ret)
+	validator.IsTestExecution.Store(false)
+}
+
+func TestStochRSI(t *testing.T) {
+	_, err := stochRSI()
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+
+	v := &objects.String{Value: testString}
+	_, err = stochRSI(ohlcvData, v, &objects.Int{Value: 14}, &objects.Int{Value: 3}, &objects.Int{Value: 3})
+	assert.ErrorContains(t, err, "failed conversion")
+
+	ret, err := stochRSI(ohlcvData, &objects.Int{Value: 14}, &objects.Int{Value: 14}, &objects.Int{Value: 3}, &objects.Int{Value: 3})
+	require.NoError(t, err, "stochRSI must not error on valid input")
+	assert.Equal(t, StochasticRelativeStrengthIndex, ret.TypeName())
+
+	validator.IsTestExecution.Store(true)
+	ret, err = stochRSI(ohlcvData, &objects.Int{Value: 14}, &objects.Int{Value: 14}, &objects.Int{Value: 3}, &objects.Int{Value: 3})
+	require.NoError(t, err, "stochRSI must not error on test execution")
+	assert.NotNil(t, ret)
+	validator.IsTestExecution.Store(false)
+}
+
+func TestADX(t *testing.T) {
+	_, err := adx()
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+
+	_, err = adx(ohlcvData, &objects.String{Value: testString})
+	assert.ErrorContains(t, err, "failed conversion")
+
+	_, err = adx(&objects.String{Value: testString}, &objects.Int{Value: 14})
+	assert.ErrorContains(t, err, "failed conversion")
+
+	ret, err := adx(ohlcvData, &objects.Int{Value: 14})
+	require.NoError(t, err, "adx must not error on valid input")
+	a, ok := ret.(*ADX)
+	require.True(t, ok, "adx must return an ADX type")
+	assert.Len(t, a.Value, 100, "adx should return a value per candle")
+	assert.Equal(t, AverageDirectionalIndex, a.TypeName())
+
+	validator.IsTestExecution.Store(true)
+	ret, err = adx(ohlcvData, &objects.Int{Value: 14})
+	require.NoError(t, err, "adx must not error on test execution")
+	assert.NotNil(t, ret)
+	validator.IsTestExecution.Store(false)
+}
+
+func TestIchimoku(t *testing.T) {
+	_, err := ichimoku()
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+
+	_, err = ichimoku(ohlcvData, &objects.Int{Value: 9}, &objects.Int{Value: 26}, &objects.String{Value: testString}, &objects.Int{Value: 26})
+	assert.ErrorContains(t, err, "failed conversion")
+
+	ret, err := ichimoku(ohlcvData, &objects.Int{Value: 9}, &objects.Int{Value: 26}, &objects.Int{Value: 52}, &objects.Int{Value: 26})
+	require.NoError(t, err, "ichimoku must not error on valid input")
+	assert.Equal(t, IchimokuCloud, ret.TypeName())
+
+	validator.IsTestExecution.Store(true)
+	ret, err = ichimoku(ohlcvData, &objects.Int{Value: 9}, &objects.Int{Value: 26}, &objects.Int{Value: 52}, &objects.Int{Value: 26})
+	require.NoError(t, err, "ichimoku must not error on test execution")
+	assert.NotNil(t, ret)
+	validator.IsTestExecution.Store(false)
+}
+
+func TestVWAP(t *testing.T) {
+	_, err := vwap()
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+
+	_, err = vwap(ohlcvData, objects.UndefinedValue)
+	assert.ErrorContains(t, err, "failed conversion")
+
+	_, err = vwap(ohlcvData, &objects.String{Value: testString})
+	assert.Error(t, err, "vwap should error on an invalid session")
+
+	_, err = vwap(ohlcvDataInvalid, &objects.String{Value: "24h"})
+	assert.ErrorContains(t, err, "failed conversion")
+
+	ret, err := vwap(ohlcvData, &objects.String{Value: "24h"})
+	require.NoError(t, err, "vwap must not error on valid input")
+	assert.Equal(t, AnchoredVolumeWeightedAveragePrice, ret.TypeName())
+
+	validator.IsTestExecution.Store(true)
+	ret, err = vwap(ohlcvData, &objects.String{Value: "24h"})
+	require.NoError(t, err, "vwap must not error on test execution")
+	assert.NotNil(t, ret)
+	validator.IsTestExecution.Store(false)
+}
+
+func TestKeltner(t *testing.T) {
+	_, err := keltner()
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+
+	_, err = keltner(ohlcvData, &objects.Int{Value: 20}, &objects.Int{Value: 10}, &objects.String{Value: testString})
+	assert.ErrorContains(t, err, "failed conversion")
+
+	_, err = keltner(ohlcvData, &objects.String{Value: testString}, &objects.Int{Value: 10}, &objects.Float{Value: 2})
+	assert.ErrorContains(t, err, "failed conversion")
+
+	ret, err := keltner(ohlcvData, &objects.Int{Value: 20}, &objects.Int{Value: 10}, &objects.Float{Value: 2})
+	require.NoError(t, err, "keltner must not error on valid input")
+	assert.Equal(t, KeltnerChannels, ret.TypeName())
+
+	validator.IsTestExecution.Store(true)
+	ret, err = keltner(ohlcvData, &objects.Int{Value: 20}, &objects.Int{Value: 10}, &objects.Float{Value: 2})
+	require.NoError(t, err, "keltner must not error on test execution")
+	assert.NotNil(t, ret)
+	validator.IsTestExecution.Store(false)
+}
+
+func TestDonchian(t *testing.T) {
+	_, err := donchian()
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+
+	_, err = donchian(ohlcvData, &objects.String{Value: testString})
+	assert.ErrorContains(t, err, "failed conversion")
+
+	ret, err := donchian(ohlcvData, &objects.Int{Value: 20})
+	require.NoError(t, err, "donchian must not error on valid input")
+	assert.Equal(t, DonchianChannels, ret.TypeName())
+
+	validator.IsTestExecution.Store(true)
+	ret, err = donchian(ohlcvData, &objects.Int{Value: 20})
+	require.NoError(t, err, "donchian must not error on test execution")
+	assert.NotNil(t, ret)
+	validator.IsTestExecution.Store(false)
+}
+
+func TestSupertrend(t *testing.T) {
+	_, err := supertrend()
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+
+	_, err = supertrend(ohlcvData, &objects.Int{Value: 10}, &objects.String{Value: testString})
+	assert.ErrorContains(t, err, "failed conversion")
+
+	ret, err := supertrend(ohlcvData, &objects.Int{Value: 10}, &objects.Float{Value: 3})
+	require.NoError(t, err, "supertrend must not error on valid input")
+	assert.Equal(t, SupertrendIndicator, ret.TypeName())
+
+	validator.IsTestExecution.Store(true)
+	ret, err = supertrend(ohlcvData, &objects.Int{Value: 10}, &objects.Float{Value: 3})
+	require.NoError(t, err, "supertrend must not error on test execution")
+	assert.NotNil(t, ret)
+	validator.IsTestExecution.Store(false)
+}
+
+func TestPSAR(t *testing.T) {
+	_, err := psar()
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+
+	_, err = psar(ohlcvData, &objects.Float{Value: 0.02}, &objects.String{Value: testString})
+	assert.ErrorContains(t, err, "failed conversion")
+
+	ret, err := psar(ohlcvData, &objects.Float{Value: 0.02}, &objects.Float{Value: 0.2})
+	require.NoError(t, err, "psar must not error on valid input")
+	assert.Equal(t, ParabolicSAR, ret.TypeName())
+
+	validator.IsTestExecution.Store(true)
+	ret, err = psar(ohlcvData, &objects.Float{Value: 0.02}, &objects.Float{Value: 0.2})
+	require.NoError(t, err, "psar must not error on test execution")
+	assert.NotNil(t, ret)
+	validator.IsTestExecution.Store(false)
+}
+
+func TestCCI(t *testing.T) {
+	_, err := cci()
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+
+	_, err = cci(ohlcvData, &objects.String{Value: testString})
+	assert.ErrorContains(t, err, "failed conversion")
+
+	ret, err := cci(ohlcvData, &objects.Int{Value: 20})
+	require.NoError(t, err, "cci must not error on valid input")
+	assert.Equal(t, CommodityChannelIndex, ret.TypeName())
+
+	validator.IsTestExecution.Store(true)
+	ret, err = cci(ohlcvData, &objects.Int{Value: 20})
+	require.NoError(t, err, "cci must not error on test execution")
+	assert.NotNil(t, ret)
+	validator.IsTestExecution.Store(false)
+}
+
+func TestWillR(t *testing.T) {
+	_, err := willR()
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+
+	_, err = willR(ohlcvData, &objects.String{Value: testString})
+	assert.ErrorContains(t, err, "failed conversion")
+
+	ret, err := willR(ohlcvData, &objects.Int{Value: 14})
+	require.NoError(t, err, "willR must not error on valid input")
+	assert.Equal(t, WilliamsPercentRange, ret.TypeName())
+
+	validator.IsTestExecution.Store(true)
+	ret, err = willR(ohlcvData, &objects.Int{Value: 14})
+	require.NoError(t, err, "willR must not error on test execution")
+	assert.NotNil(t, ret)
+	validator.IsTestExecution.Store(false)
+}
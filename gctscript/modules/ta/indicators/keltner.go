@@ -0,0 +1,63 @@
+package indicators
+
+import (
+	objects "github.com/d5/tengo/v2"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers/validator"
+)
+
+// KeltnerModule keltner channels indicator commands
+var KeltnerModule = map[string]objects.Object{
+	"calculate": &objects.UserFunction{Name: "calculate", Value: keltner},
+}
+
+// KeltnerChannels is the string constant
+const KeltnerChannels = "Keltner Channels"
+
+// Keltner defines a custom Keltner Channels tengo indicator object type
+type Keltner struct {
+	objects.Array
+	EMAPeriod, ATRPeriod int
+	Multiplier           float64
+}
+
+// TypeName returns the name of the custom type.
+func (o *Keltner) TypeName() string {
+	return KeltnerChannels
+}
+
+func keltner(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 4 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	r := new(Keltner)
+	if validator.IsTestExecution.Load() == true {
+		return r, nil
+	}
+
+	ohlcv, _, err := toOHLC(args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := toInts(args[1:3]...)
+	if err != nil {
+		return nil, err
+	}
+	multiplier, err := toFloats(args[3])
+	if err != nil {
+		return nil, err
+	}
+
+	r.EMAPeriod = int(params[0])
+	r.ATRPeriod = int(params[1])
+	r.Multiplier = multiplier[0]
+
+	ret, err := ohlcv.GetKeltnerChannels(params[0], params[1], multiplier[0])
+	if err != nil {
+		return nil, err
+	}
+	r.Value = toSignalArrays(ret.Middle, ret.Upper, ret.Lower)
+
+	return r, nil
+}
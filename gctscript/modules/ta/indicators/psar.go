@@ -0,0 +1,57 @@
+package indicators
+
+import (
+	objects "github.com/d5/tengo/v2"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers/validator"
+)
+
+// PSARModule parabolic stop and reverse indicator commands
+var PSARModule = map[string]objects.Object{
+	"calculate": &objects.UserFunction{Name: "calculate", Value: psar},
+}
+
+// ParabolicSAR is the string constant
+const ParabolicSAR = "Parabolic Stop and Reverse"
+
+// PSAR defines a custom Parabolic Stop and Reverse tengo indicator object type
+type PSAR struct {
+	objects.Array
+	Step, Maximum float64
+}
+
+// TypeName returns the name of the custom type.
+func (o *PSAR) TypeName() string {
+	return ParabolicSAR
+}
+
+func psar(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 3 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	r := new(PSAR)
+	if validator.IsTestExecution.Load() == true {
+		return r, nil
+	}
+
+	ohlcv, _, err := toOHLC(args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := toFloats(args[1:]...)
+	if err != nil {
+		return nil, err
+	}
+
+	r.Step = params[0]
+	r.Maximum = params[1]
+
+	ret, err := ohlcv.GetParabolicSAR(params[0], params[1])
+	if err != nil {
+		return nil, err
+	}
+	r.Value = toSignalArray(ret)
+
+	return r, nil
+}
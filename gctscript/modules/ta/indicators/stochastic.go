@@ -0,0 +1,58 @@
+package indicators
+
+import (
+	objects "github.com/d5/tengo/v2"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers/validator"
+)
+
+// StochasticModule stochastic oscillator indicator commands
+var StochasticModule = map[string]objects.Object{
+	"calculate": &objects.UserFunction{Name: "calculate", Value: stochastic},
+}
+
+// StochasticOscillator is the string constant
+const StochasticOscillator = "Stochastic Oscillator"
+
+// Stochastic defines a custom Stochastic Oscillator tengo indicator object type
+type Stochastic struct {
+	objects.Array
+	Period, Smoothing, Signal int
+}
+
+// TypeName returns the name of the custom type.
+func (o *Stochastic) TypeName() string {
+	return StochasticOscillator
+}
+
+func stochastic(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 4 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	r := new(Stochastic)
+	if validator.IsTestExecution.Load() == true {
+		return r, nil
+	}
+
+	ohlcv, _, err := toOHLC(args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := toInts(args[1:]...)
+	if err != nil {
+		return nil, err
+	}
+
+	r.Period = int(params[0])
+	r.Smoothing = int(params[1])
+	r.Signal = int(params[2])
+
+	ret, err := ohlcv.GetStochastic(params[0], params[1], params[2])
+	if err != nil {
+		return nil, err
+	}
+	r.Value = toSignalArrays(ret.K, ret.D)
+
+	return r, nil
+}
@@ -0,0 +1,59 @@
+package indicators
+
+import (
+	objects "github.com/d5/tengo/v2"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers/validator"
+)
+
+// StochRSIModule stochastic relative strength index indicator commands
+var StochRSIModule = map[string]objects.Object{
+	"calculate": &objects.UserFunction{Name: "calculate", Value: stochRSI},
+}
+
+// StochasticRelativeStrengthIndex is the string constant
+const StochasticRelativeStrengthIndex = "Stochastic Relative Strength Index"
+
+// StochRSI defines a custom Stochastic Relative Strength Index tengo indicator object type
+type StochRSI struct {
+	objects.Array
+	RSIPeriod, Period, Smoothing, Signal int
+}
+
+// TypeName returns the name of the custom type.
+func (o *StochRSI) TypeName() string {
+	return StochasticRelativeStrengthIndex
+}
+
+func stochRSI(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 5 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	r := new(StochRSI)
+	if validator.IsTestExecution.Load() == true {
+		return r, nil
+	}
+
+	ohlcv, _, err := toOHLC(args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := toInts(args[1:]...)
+	if err != nil {
+		return nil, err
+	}
+
+	r.RSIPeriod = int(params[0])
+	r.Period = int(params[1])
+	r.Smoothing = int(params[2])
+	r.Signal = int(params[3])
+
+	ret, err := ohlcv.GetStochasticRSI(ohlcv.Close, params[0], params[1], params[2], params[3])
+	if err != nil {
+		return nil, err
+	}
+	r.Value = toSignalArrays(ret.K, ret.D)
+
+	return r, nil
+}
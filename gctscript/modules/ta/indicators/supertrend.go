@@ -0,0 +1,62 @@
+package indicators
+
+import (
+	objects "github.com/d5/tengo/v2"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers/validator"
+)
+
+// SupertrendModule supertrend indicator commands
+var SupertrendModule = map[string]objects.Object{
+	"calculate": &objects.UserFunction{Name: "calculate", Value: supertrend},
+}
+
+// SupertrendIndicator is the string constant
+const SupertrendIndicator = "Supertrend"
+
+// Supertrend defines a custom Supertrend tengo indicator object type
+type Supertrend struct {
+	objects.Array
+	Period     int
+	Multiplier float64
+}
+
+// TypeName returns the name of the custom type.
+func (o *Supertrend) TypeName() string {
+	return SupertrendIndicator
+}
+
+func supertrend(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 3 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	r := new(Supertrend)
+	if validator.IsTestExecution.Load() == true {
+		return r, nil
+	}
+
+	ohlcv, _, err := toOHLC(args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := toInts(args[1])
+	if err != nil {
+		return nil, err
+	}
+	multiplier, err := toFloats(args[2])
+	if err != nil {
+		return nil, err
+	}
+
+	r.Period = int(params[0])
+	r.Multiplier = multiplier[0]
+
+	ret, err := ohlcv.GetSupertrend(params[0], multiplier[0])
+	if err != nil {
+		return nil, err
+	}
+	r.Value = toSignalArrays(ret.Values, ret.Direction)
+
+	return r, nil
+}
@@ -0,0 +1,75 @@
+package indicators
+
+import (
+	"fmt"
+	"time"
+
+	objects "github.com/d5/tengo/v2"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers/validator"
+)
+
+// VWAPModule anchored volume weighted average price indicator commands
+var VWAPModule = map[string]objects.Object{
+	"calculate": &objects.UserFunction{Name: "calculate", Value: vwap},
+}
+
+// AnchoredVolumeWeightedAveragePrice is the string constant
+const AnchoredVolumeWeightedAveragePrice = "Anchored Volume Weighted Average Price"
+
+// VWAP defines a custom Anchored Volume Weighted Average Price tengo
+// indicator object type
+type VWAP struct {
+	objects.Array
+	Session time.Duration
+}
+
+// TypeName returns the name of the custom type.
+func (o *VWAP) TypeName() string {
+	return AnchoredVolumeWeightedAveragePrice
+}
+
+func vwap(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 2 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	r := new(VWAP)
+	if validator.IsTestExecution.Load() == true {
+		return r, nil
+	}
+
+	ohlcv, times, err := toOHLC(args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	inSession, ok := objects.ToString(args[1])
+	if !ok {
+		return nil, fmt.Errorf(modules.ErrParameterConvertFailed, inSession)
+	}
+	session, err := time.ParseDuration(inSession)
+	if err != nil {
+		return nil, err
+	}
+	r.Session = session
+
+	item := kline.Item{Candles: make([]kline.Candle, len(times))}
+	for x := range times {
+		item.Candles[x] = kline.Candle{
+			Time:   times[x],
+			Open:   ohlcv.Open[x],
+			High:   ohlcv.High[x],
+			Low:    ohlcv.Low[x],
+			Close:  ohlcv.Close[x],
+			Volume: ohlcv.Volume[x],
+		}
+	}
+	ret, err := item.GetAnchoredVWAPs(kline.Interval(session))
+	if err != nil {
+		return nil, err
+	}
+	r.Value = toSignalArray(ret)
+	return r, nil
+}
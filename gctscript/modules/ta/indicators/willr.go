@@ -0,0 +1,56 @@
+package indicators
+
+import (
+	objects "github.com/d5/tengo/v2"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers/validator"
+)
+
+// WillRModule williams percent range indicator commands
+var WillRModule = map[string]objects.Object{
+	"calculate": &objects.UserFunction{Name: "calculate", Value: willR},
+}
+
+// WilliamsPercentRange is the string constant
+const WilliamsPercentRange = "Williams Percent Range"
+
+// WillR defines a custom Williams Percent Range tengo indicator object type
+type WillR struct {
+	objects.Array
+	Period int
+}
+
+// TypeName returns the name of the custom type.
+func (o *WillR) TypeName() string {
+	return WilliamsPercentRange
+}
+
+func willR(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 2 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	r := new(WillR)
+	if validator.IsTestExecution.Load() == true {
+		return r, nil
+	}
+
+	ohlcv, _, err := toOHLC(args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := toInts(args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	r.Period = int(params[0])
+
+	ret, err := ohlcv.GetWilliamsPercentRange(params[0])
+	if err != nil {
+		return nil, err
+	}
+	r.Value = toSignalArray(ret)
+
+	return r, nil
+}
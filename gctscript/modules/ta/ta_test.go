@@ -7,5 +7,5 @@ import (
 )
 
 func TestGetModuleMap(t *testing.T) {
-	require.Len(t, AllModuleNames(), 9, "AllModuleNames must return 9 modules")
+	require.Len(t, AllModuleNames(), 20, "AllModuleNames must return 20 modules")
 }
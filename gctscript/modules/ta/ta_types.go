@@ -16,4 +16,15 @@ var Modules = map[string]map[string]tengo.Object{
 	"indicator/mfi":                    indicators.MfiModule,
 	"indicator/atr":                    indicators.AtrModule,
 	"indicator/correlationcoefficient": indicators.CorrelationCoefficientModule,
+	"indicator/stochastic":             indicators.StochasticModule,
+	"indicator/stochrsi":               indicators.StochRSIModule,
+	"indicator/adx":                    indicators.ADXModule,
+	"indicator/ichimoku":               indicators.IchimokuModule,
+	"indicator/vwap":                   indicators.VWAPModule,
+	"indicator/keltner":                indicators.KeltnerModule,
+	"indicator/donchian":               indicators.DonchianModule,
+	"indicator/supertrend":             indicators.SupertrendModule,
+	"indicator/psar":                   indicators.PSARModule,
+	"indicator/cci":                    indicators.CCIModule,
+	"indicator/willr":                  indicators.WillRModule,
 }
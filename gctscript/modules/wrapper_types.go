@@ -37,8 +37,8 @@ type GCTExchange interface {
 	CancelOrder(ctx context.Context, exch, orderid string, pair currency.Pair, item asset.Item) (bool, error)
 	AccountBalances(ctx context.Context, exch string, assetType asset.Item) (accounts.SubAccounts, error)
 	DepositAddress(exch, chain string, currencyCode currency.Code) (*deposit.Address, error)
-	WithdrawalFiatFunds(ctx context.Context, bankAccountID string, request *withdraw.Request) (out string, err error)
-	WithdrawalCryptoFunds(ctx context.Context, request *withdraw.Request) (out string, err error)
+	WithdrawalFiatFunds(ctx context.Context, bankAccountID string, request *withdraw.Request) (*withdraw.Response, error)
+	WithdrawalCryptoFunds(ctx context.Context, request *withdraw.Request) (*withdraw.Response, error)
 	OHLCV(ctx context.Context, exch string, pair currency.Pair, item asset.Item, start, end time.Time, interval kline.Interval) (*kline.Item, error)
 }
 
@@ -21,6 +21,9 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
 )
 
+// ScriptRequester is the fixed requester of withdrawals submitted by scripts
+const ScriptRequester = "gctscript"
+
 // Exchange implements all required methods for Wrapper
 type Exchange struct{}
 
@@ -90,6 +93,16 @@ func (e Exchange) QueryOrder(ctx context.Context, exch, orderID string, pair cur
 	return &o, nil
 }
 
+// checkAccess returns an error if the exchange is not loaded or its credentials
+// are not permitted operations requiring the access control level
+func (e Exchange) checkAccess(ctx context.Context, exch string, required accounts.AccessControl) error {
+	ex, err := e.GetExchange(exch)
+	if err != nil {
+		return err
+	}
+	return engine.CheckCredentialAccess(ctx, ex, required)
+}
+
 // SubmitOrder submit new order on exchange
 func (e Exchange) SubmitOrder(ctx context.Context, submit *order.Submit) (*order.SubmitResponse, error) {
 	r, err := engine.Bot.OrderManager.Submit(ctx, submit)
@@ -109,6 +122,9 @@ func (e Exchange) SubmitOrder(ctx context.Context, submit *order.Submit) (*order
 
 // CancelOrder wrapper to cancel order on exchange
 func (e Exchange) CancelOrder(ctx context.Context, exch, orderID string, cp currency.Pair, a asset.Item) (bool, error) {
+	if err := e.checkAccess(ctx, exch, accounts.TradeAccess); err != nil {
+		return false, err
+	}
 	orderDetails, err := e.QueryOrder(ctx, exch, orderID, cp, a)
 	if err != nil {
 		return false, err
@@ -155,17 +171,20 @@ func (e Exchange) DepositAddress(exch, chain string, currencyCode currency.Code)
 }
 
 // WithdrawalFiatFunds withdraw funds from exchange to requested fiat source
-func (e Exchange) WithdrawalFiatFunds(ctx context.Context, bankAccountID string, request *withdraw.Request) (string, error) {
+func (e Exchange) WithdrawalFiatFunds(ctx context.Context, bankAccountID string, request *withdraw.Request) (*withdraw.Response, error) {
 	ex, err := e.GetExchange(request.Exchange)
 	if err != nil {
-		return "", err
+		return nil, err
+	}
+	if err := engine.CheckCredentialAccess(ctx, ex, accounts.WithdrawAccess); err != nil {
+		return nil, err
 	}
 	var v *banking.Account
 	v, err = banking.GetBankAccountByID(bankAccountID)
 	if err != nil {
 		v, err = ex.GetBase().GetExchangeBankAccounts(bankAccountID, request.Currency.String())
 		if err != nil {
-			return "", err
+			return nil, err
 		}
 	}
 
@@ -173,7 +192,7 @@ func (e Exchange) WithdrawalFiatFunds(ctx context.Context, bankAccountID string,
 	if err == nil {
 		otpValue, errParse := strconv.ParseInt(otp, 10, 64)
 		if errParse != nil {
-			return "", errors.New("failed to generate OTP unable to continue")
+			return nil, errors.New("failed to generate OTP unable to continue")
 		}
 		request.OneTimePassword = otpValue
 	}
@@ -188,34 +207,27 @@ func (e Exchange) WithdrawalFiatFunds(ctx context.Context, bankAccountID string,
 	request.Fiat.Bank.SWIFTCode = v.SWIFTCode
 	request.Fiat.Bank.IBAN = v.IBAN
 
-	resp, err := engine.Bot.WithdrawManager.SubmitWithdrawal(ctx, request)
-	if err != nil {
-		return "", err
-	}
-	return resp.Exchange.ID, nil
+	request.RequestedBy = ScriptRequester
+	return engine.Bot.WithdrawManager.SubmitWithdrawal(ctx, request)
 }
 
 // WithdrawalCryptoFunds withdraw funds from exchange to requested Crypto source
-func (e Exchange) WithdrawalCryptoFunds(ctx context.Context, request *withdraw.Request) (string, error) {
+func (e Exchange) WithdrawalCryptoFunds(ctx context.Context, request *withdraw.Request) (*withdraw.Response, error) {
 	// Checks if exchange is enabled or not so we don't call OTP generation
-	_, err := e.GetExchange(request.Exchange)
-	if err != nil {
-		return "", err
+	if err := e.checkAccess(ctx, request.Exchange, accounts.WithdrawAccess); err != nil {
+		return nil, err
 	}
 	otp, err := engine.Bot.GetExchangeOTPByName(request.Exchange)
 	if err == nil {
 		v, errParse := strconv.ParseInt(otp, 10, 64)
 		if errParse != nil {
-			return "", errors.New("failed to generate OTP unable to continue")
+			return nil, errors.New("failed to generate OTP unable to continue")
 		}
 		request.OneTimePassword = v
 	}
 
-	resp, err := engine.Bot.WithdrawManager.SubmitWithdrawal(ctx, request)
-	if err != nil {
-		return "", err
-	}
-	return resp.Exchange.ID, nil
+	request.RequestedBy = ScriptRequester
+	return engine.Bot.WithdrawManager.SubmitWithdrawal(ctx, request)
 }
 
 // OHLCV returns open high low close volume candles for requested exchange/pair/asset/start & end time
@@ -8,11 +8,16 @@ import (
 	"testing"
 	"time"
 
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/engine"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
 )
 
 // change these if you wish to test another exchange and/or currency pair
@@ -195,6 +200,35 @@ func TestOHLCV(t *testing.T) {
 	}
 }
 
+func TestExchange_WithdrawalCryptoFunds(t *testing.T) {
+	// Not parallel: replaces the global engine withdraw manager
+	wm, err := engine.SetupWithdrawManager(engine.Bot.ExchangeManager, nil, true)
+	require.NoError(t, err, "SetupWithdrawManager must not error")
+	require.NoError(t, wm.UpdateConfig(&config.WithdrawManager{
+		RequiredApprovals: 1,
+		ApprovalTimeout:   time.Hour,
+		Approvers:         []config.WithdrawApprover{{Name: "alice", Token: "a"}},
+	}), "UpdateConfig must not error")
+	engine.Bot.WithdrawManager = wm
+
+	resp, err := exchangeTest.WithdrawalCryptoFunds(t.Context(), &withdraw.Request{
+		Exchange: exchName,
+		Currency: currency.BTC,
+		Amount:   1,
+		Type:     withdraw.Crypto,
+		Crypto:   withdraw.CryptoRequest{Address: "0xTHISISALEGITBTCADDRESSS"},
+	})
+	require.NoError(t, err, "WithdrawalCryptoFunds must not error")
+	assert.NotEqual(t, uuid.Nil, resp.ID, "WithdrawalCryptoFunds should return the pending withdrawal ID")
+	assert.Equal(t, engine.WithdrawStatusPendingApproval, resp.Exchange.Status, "WithdrawalCryptoFunds should return the pending approval status")
+
+	pending, err := wm.GetPendingWithdrawals()
+	require.NoError(t, err, "GetPendingWithdrawals must not error")
+	require.Len(t, pending, 1, "GetPendingWithdrawals must return the script withdrawal")
+	assert.Equal(t, resp.ID, pending[0].ID, "pending withdrawal ID should match the returned ID")
+	assert.Equal(t, ScriptRequester, pending[0].Request.RequestedBy, "script withdrawals should be requested by the script requester")
+}
+
 func setupEngine() (err error) {
 	engine.Bot, err = engine.NewFromSettings(&settings, nil)
 	if err != nil {
@@ -223,21 +223,34 @@ func (w Wrapper) DepositAddress(exch, _ string, _ currency.Code) (*deposit.Addre
 }
 
 // WithdrawalCryptoFunds validator for test execution/scripts
-func (w Wrapper) WithdrawalCryptoFunds(_ context.Context, r *withdraw.Request) (out string, err error) {
+func (w Wrapper) WithdrawalCryptoFunds(_ context.Context, r *withdraw.Request) (*withdraw.Response, error) {
 	if r.Exchange == exchError.String() {
-		return r.Exchange, errTestFailed
+		return nil, errTestFailed
 	}
 
-	return "", nil
+	return &withdraw.Response{
+		Exchange: withdraw.ExchangeResponse{
+			Name:   r.Exchange,
+			Status: "pending approval",
+		},
+		RequestDetails: *r,
+	}, nil
 }
 
 // WithdrawalFiatFunds validator for test execution/scripts
-func (w Wrapper) WithdrawalFiatFunds(_ context.Context, _ string, r *withdraw.Request) (out string, err error) {
+func (w Wrapper) WithdrawalFiatFunds(_ context.Context, _ string, r *withdraw.Request) (*withdraw.Response, error) {
 	if r.Exchange == exchError.String() {
-		return r.Exchange, errTestFailed
+		return nil, errTestFailed
 	}
 
-	return "123", nil
+	return &withdraw.Response{
+		Exchange: withdraw.ExchangeResponse{
+			Name:   r.Exchange,
+			ID:     "123",
+			Status: "completed",
+		},
+		RequestDetails: *r,
+	}, nil
 }
 
 // OHLCV returns open high low close volume candles for requested exchange/pair/asset/start & end time
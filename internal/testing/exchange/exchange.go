@@ -10,8 +10,10 @@ import (
 	"net/url"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -22,6 +24,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchange/stream"
 	"github.com/thrasher-corp/gocryptotrader/exchange/websocket"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/chaos"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/mock"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/sharedtestvalues"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/subscription"
@@ -134,6 +137,52 @@ func MockWsInstance[T any, PT interface {
 	return e
 }
 
+// RecordWs attaches a recorder to every new websocket connection of the exchange so live traffic can be captured for
+// MockWsReplayInstance. The first connection is saved to path and any further connections to path with an _N suffix
+// Recordings are saved each time a connection shuts down. It must be called before the websocket is connected
+func RecordWs(tb testing.TB, e exchange.IBotExchange, path string) {
+	tb.Helper()
+	var n atomic.Int32
+	e.GetBase().Websocket.NewRecorder = func(url string) websocket.Recorder {
+		p := path
+		if i := n.Add(1); i > 1 {
+			p = strings.TrimSuffix(path, filepath.Ext(path)) + "_" + strconv.Itoa(int(i)) + filepath.Ext(path)
+		}
+		r, err := mock.NewWebsocketRecorder(p, url)
+		require.NoError(tb, err, "NewWebsocketRecorder must not error")
+		return r
+	}
+}
+
+// InjectFaults attaches a fault injector built from cfg to the exchange's HTTP requester and websocket manager so
+// recovery from exchange outages can be exercised against mock servers. It must be called before the websocket is
+// connected
+func InjectFaults(tb testing.TB, e exchange.IBotExchange, cfg *chaos.Config) *chaos.Injector {
+	tb.Helper()
+	f, err := chaos.New(cfg)
+	require.NoError(tb, err, "chaos.New must not error")
+	b := e.GetBase()
+	if b.Requester != nil {
+		require.NoError(tb, b.Requester.SetFaultInjector(f), "SetFaultInjector must not error")
+	}
+	if b.Websocket != nil {
+		b.Websocket.FaultInjector = f
+	}
+	return f
+}
+
+// MockWsReplayInstance creates a new Exchange instance with a mock websocket server replaying a recording made with
+// RecordWs. The replayer is returned so tests can inspect client messages which matched no recorded request
+func MockWsReplayInstance[T any, PT interface {
+	*T
+	exchange.IBotExchange
+}](tb testing.TB, path string, verbose ...bool) (*T, *mock.WebsocketReplayer) {
+	tb.Helper()
+	r, err := mock.NewWebsocketReplayer(path)
+	require.NoError(tb, err, "NewWebsocketReplayer must not error")
+	return MockWsInstance[T, PT](tb, r.ServeHTTP, verbose...), r
+}
+
 // FixtureError contains an error and the message that caused it
 type FixtureError struct {
 	Err error
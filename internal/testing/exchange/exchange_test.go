@@ -4,6 +4,7 @@ import (
 	"context"
 	"net/http"
 	"net/http/httptest"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -16,7 +17,10 @@ import (
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/binance"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/bybit"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/chaos"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/mock"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/sharedtestvalues"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/subscription"
 	mockws "github.com/thrasher-corp/gocryptotrader/internal/testing/websocket"
@@ -140,3 +144,74 @@ func TestSetupWsSupportsMultiConnectionManagement(t *testing.T) {
 	assert.NotNil(t, conn, "GetConnection should return a connection after SetupWs on a multi-connection manager")
 	assert.Empty(t, conn.Subscriptions().List(), "Connection subscriptions should remain empty when subscriptions are not required")
 }
+
+func TestRecordWsAndMockWsReplayInstance(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mockws.WsMockUpgrader(t, w, r, mockws.EchoHandler)
+	}))
+	t.Cleanup(server.Close)
+
+	path := filepath.Join(t.TempDir(), "ws.json")
+	e := newMultiConnectionSetupExchange(t, "ws"+strings.TrimPrefix(server.URL, "http"))
+	RecordWs(t, e, path)
+	newRecorder := e.Base.Websocket.NewRecorder
+	var recorder *mock.WebsocketRecorder
+	e.Base.Websocket.NewRecorder = func(url string) websocket.Recorder {
+		r := newRecorder(url)
+		recorder = r.(*mock.WebsocketRecorder)
+		return r
+	}
+	SetupWs(t, e)
+	require.NotNil(t, recorder, "a recorder must be created for the connection")
+
+	conn, err := e.Base.Websocket.GetConnection(multiConnectionFilter)
+	require.NoError(t, err, "GetConnection must not error")
+	require.NoError(t, conn.SendRawMessage(t.Context(), request.Unset, gws.TextMessage, []byte(`{"op":"ping"}`)), "SendRawMessage must not error")
+	require.Eventually(t, func() bool {
+		return len(recorder.Frames()) == 2
+	}, 5*time.Second, 10*time.Millisecond, "echoed message must be recorded")
+	require.NoError(t, e.Base.Websocket.Shutdown(), "Shutdown must not error")
+
+	rec, err := mock.LoadWebsocketRecording(path)
+	require.NoError(t, err, "LoadWebsocketRecording must not error after shutdown")
+	require.Len(t, rec.Frames, 2, "recording must contain the request and echoed response")
+	assert.True(t, rec.Frames[0].Outbound, "first frame should be the outbound request")
+	assert.False(t, rec.Frames[1].Outbound, "second frame should be the inbound echo")
+
+	b, replayer := MockWsReplayInstance[binance.Exchange](t, path)
+	require.NotNil(t, b, "MockWsReplayInstance must not return a nil exchange")
+	assert.True(t, b.Websocket.IsConnected(), "Websocket should be connected to the replay server")
+	assert.Empty(t, replayer.Unmatched(), "no client messages should be unmatched")
+}
+
+func TestInjectFaults(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mockws.WsMockUpgrader(t, w, r, mockws.EchoHandler)
+	}))
+	t.Cleanup(server.Close)
+
+	e := newMultiConnectionSetupExchange(t, "ws"+strings.TrimPrefix(server.URL, "http"))
+	t.Cleanup(func() {
+		if e.Base.Websocket.IsConnected() {
+			assert.NoError(t, e.Base.Websocket.Shutdown(), "Websocket shutdown should not error")
+		}
+	})
+	f := InjectFaults(t, e, &chaos.Config{DisconnectProbability: 1})
+	SetupWs(t, e)
+
+	conn, err := e.Base.Websocket.GetConnection(multiConnectionFilter)
+	require.NoError(t, err, "GetConnection must not error")
+	require.NoError(t, conn.SendRawMessage(t.Context(), request.Unset, gws.TextMessage, []byte(`{"op":"ping"}`)), "SendRawMessage must not error")
+	require.Eventually(t, func() bool {
+		return f.Stats().Disconnects == 1
+	}, 5*time.Second, 10*time.Millisecond, "echoed message must trigger a disconnect")
+	assert.Eventually(t, func() bool {
+		reconnected, err := e.Base.Websocket.GetConnection(multiConnectionFilter)
+		return err == nil && reconnected != conn && e.Base.Websocket.IsConnected()
+	}, 5*time.Second, 10*time.Millisecond, "connection monitor should reconnect after an injected disconnect")
+
+	b := new(binance.Exchange)
+	require.NoError(t, Setup(b), "Test exchange Setup must not error")
+	f = InjectFaults(t, b, &chaos.Config{})
+	assert.Same(t, f, b.Websocket.FaultInjector, "websocket manager should use the fault injector")
+}
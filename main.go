@@ -51,6 +51,7 @@ func main() {
 	flag.BoolVar(&settings.EnableCoinmarketcapAnalysis, "coinmarketcap", false, "overrides config and runs currency analysis")
 	flag.BoolVar(&settings.EnableEventManager, "eventmanager", true, "enables the event manager")
 	flag.BoolVar(&settings.EnableOrderManager, "ordermanager", true, "enables the order manager")
+	flag.BoolVar(&settings.EnablePaperTrading, "papertrading", false, "routes order manager orders to a simulated matching engine instead of live exchanges")
 	flag.BoolVar(&settings.EnableDepositAddressManager, "depositaddressmanager", true, "enables the deposit address manager")
 	flag.BoolVar(&settings.EnableConnectivityMonitor, "connectivitymonitor", true, "enables the connectivity monitor")
 	flag.BoolVar(&settings.EnableDatabaseManager, "databasemanager", true, "enables database manager")
@@ -59,6 +60,12 @@ func main() {
 	flag.BoolVar(&settings.EnableNTPClient, "ntpclient", true, "enables the NTP client to check system clock drift")
 	flag.BoolVar(&settings.EnableDispatcher, "dispatch", true, "enables the dispatch system")
 	flag.BoolVar(&settings.EnableCurrencyStateManager, "currencystatemanager", true, "enables the currency state manager")
+	flag.BoolVar(&settings.EnableCandleAggregator, "candleaggregator", false, "enables the candle aggregation manager which builds live candles from websocket trades")
+	flag.BoolVar(&settings.EnableMetricsManager, "metrics", false, "enables the Prometheus metrics exporter")
+	flag.BoolVar(&settings.EnableMicrostructureManager, "microstructure", false, "enables the microstructure manager which derives market microstructure signals from websocket orderbooks")
+	flag.BoolVar(&settings.EnableConfigReloader, "configreloader", false, "enables the config reloader which applies changes to the config file without restarting the engine")
+	flag.BoolVar(&settings.EnableTreasuryManager, "treasurymanager", false, "enables the treasury manager which transfers funds between exchanges to keep them within their target allocations")
+	flag.BoolVar(&settings.EnableFundingTracker, "fundingtracker", false, "enables the funding tracker which follows deposits and withdrawals until they are credited or fail")
 	flag.IntVar(&settings.DispatchMaxWorkerAmount, "dispatchworkers", dispatch.DefaultMaxWorkers, "sets the dispatch package max worker generation limit")
 	flag.IntVar(&settings.DispatchJobsLimit, "dispatchjobslimit", dispatch.DefaultJobsLimit, "sets the dispatch package max jobs limit")
 
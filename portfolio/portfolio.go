@@ -12,6 +12,8 @@ import (
 
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/currency/crossrate"
+	"github.com/thrasher-corp/gocryptotrader/currency/fxhistory"
 	"github.com/thrasher-corp/gocryptotrader/encoding/json"
 	"github.com/thrasher-corp/gocryptotrader/log"
 	"golang.org/x/time/rate"
@@ -39,6 +41,7 @@ var (
 	errProviderAPIKeyNotSet    = errors.New("provider API key not set")
 	errPortfolioItemNotFound   = errors.New("portfolio item not found")
 	errNoPortfolioItemsToWatch = errors.New("no portfolio items to watch")
+	errProviderAlreadyExists   = errors.New("provider already registered")
 )
 
 // GetEthereumAddressBalance fetches Ethereum address balance for a given address
@@ -215,7 +218,8 @@ func (b *Base) UpdateExchangeAddressBalance(exchangeName string, coinType curren
 }
 
 // AddAddress adds an address to the portfolio base or updates its balance if it already exists.
-func (b *Base) AddAddress(address, description string, coinType currency.Code, balance float64) error {
+// The chain picks the on-chain provider for the address and is left as is when empty
+func (b *Base) AddAddress(address, description string, coinType currency.Code, chain string, balance float64) error {
 	if address == "" {
 		return common.ErrAddressIsEmptyOrInvalid
 	}
@@ -236,6 +240,7 @@ func (b *Base) AddAddress(address, description string, coinType currency.Code, b
 		b.Addresses = append(b.Addresses, Address{
 			Address:     address,
 			CoinType:    coinType,
+			Chain:       chain,
 			Balance:     balance,
 			Description: description,
 		})
@@ -243,9 +248,24 @@ func (b *Base) AddAddress(address, description string, coinType currency.Code, b
 	}
 
 	b.UpdateAddressBalance(address, balance)
+	if chain != "" {
+		b.setAddressChain(address, coinType, chain)
+	}
 	return nil
 }
 
+// setAddressChain sets the chain of an address matching the coinType
+func (b *Base) setAddressChain(address string, coinType currency.Code, chain string) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	for x := range b.Addresses {
+		if b.Addresses[x].Address == address && b.Addresses[x].CoinType.Equal(coinType) {
+			b.Addresses[x].Chain = chain
+		}
+	}
+}
+
 // RemoveAddress removes an address when checked against the correct address and
 // coinType
 func (b *Base) RemoveAddress(address, description string, coinType currency.Code) error {
@@ -270,7 +290,9 @@ func (b *Base) RemoveAddress(address, description string, coinType currency.Code
 	return nil
 }
 
-// UpdatePortfolio adds to the portfolio addresses by coin type
+// UpdatePortfolio adds to the portfolio addresses by coin type. Balances are
+// fetched from the on-chain provider matching the chain of each address, and
+// from the built in providers for addresses no on-chain provider supports
 func (b *Base) UpdatePortfolio(ctx context.Context, addresses []string, coinType currency.Code) error {
 	if slices.ContainsFunc(addresses, func(a string) bool {
 		return a == PersonalAddress || a == ExchangeAddress
@@ -278,6 +300,32 @@ func (b *Base) UpdatePortfolio(ctx context.Context, addresses []string, coinType
 		return nil
 	}
 
+	var errs error
+	remaining := make([]string, 0, len(addresses))
+	for _, address := range addresses {
+		p := b.getChainProvider(coinType, b.getAddressChain(address, coinType))
+		if p == nil {
+			remaining = append(remaining, address)
+			continue
+		}
+		balance, err := p.GetAddressBalance(ctx, address, coinType)
+		if err != nil {
+			errs = common.AppendError(errs, fmt.Errorf("error getting balance for %s from %s: %w", address, p.GetName(), err))
+			continue
+		}
+		if err := b.AddAddress(address, PersonalAddress, coinType, "", balance); err != nil {
+			errs = common.AppendError(errs, fmt.Errorf("error adding address %s: %w", address, err))
+		}
+	}
+	if len(remaining) == 0 {
+		return errs
+	}
+	return common.AppendError(errs, b.updateBuiltInPortfolio(ctx, remaining, coinType))
+}
+
+// updateBuiltInPortfolio adds to the portfolio addresses by coin type using
+// the Ethplorer, XRPScan and CryptoID providers
+func (b *Base) updateBuiltInPortfolio(ctx context.Context, addresses []string, coinType currency.Code) error {
 	var providerName string
 	var getBalance func(ctx context.Context, address string) (float64, error)
 
@@ -318,13 +366,84 @@ func (b *Base) UpdatePortfolio(ctx context.Context, addresses []string, coinType
 			continue
 		}
 
-		if err := b.AddAddress(addresses[x], PersonalAddress, coinType, balance); err != nil {
+		if err := b.AddAddress(addresses[x], PersonalAddress, coinType, "", balance); err != nil {
 			errs = common.AppendError(errs, fmt.Errorf("error adding address %s: %w", addresses[x], err))
 		}
 	}
 	return errs
 }
 
+// RegisterProvider adds an on-chain provider to those set up from config, for
+// chains or currencies the configured provider types do not cover. Names must
+// be unique across all providers
+func (b *Base) RegisterProvider(p Provider) error {
+	if err := common.NilGuard(p); err != nil {
+		return err
+	}
+	b.getChainProviders()
+
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	if slices.ContainsFunc(b.chainProviders, func(r Provider) bool {
+		return strings.EqualFold(r.GetName(), p.GetName())
+	}) {
+		return fmt.Errorf("%w: %s", errProviderAlreadyExists, p.GetName())
+	}
+	b.chainProviders = append(b.chainProviders, p)
+	return nil
+}
+
+// getChainProviders sets up the enabled on-chain providers on first use.
+// Providers which cannot be set up are logged and left out
+func (b *Base) getChainProviders() []Provider {
+	b.chainProvidersOnce.Do(func() {
+		for i := range b.Providers {
+			if !b.Providers[i].Enabled || b.Providers[i].Type == "" {
+				continue
+			}
+			p, err := newProvider(&b.Providers[i], b.Verbose)
+			if err != nil {
+				log.Errorf(log.PortfolioMgr, "Portfolio provider %s cannot be set up: %v", b.Providers[i].Name, err)
+				continue
+			}
+			b.chainProviders = append(b.chainProviders, p)
+		}
+	})
+
+	b.mtx.RLock()
+	defer b.mtx.RUnlock()
+	return b.chainProviders
+}
+
+// getChainProvider returns the first on-chain provider supporting a currency,
+// limited to those serving the chain when it is set, or nil when there is none
+func (b *Base) getChainProvider(coinType currency.Code, chain string) Provider {
+	for _, p := range b.getChainProviders() {
+		if chain != "" && !strings.EqualFold(p.GetChain(), chain) {
+			continue
+		}
+		if p.Supports(coinType) {
+			return p
+		}
+	}
+	return nil
+}
+
+// getAddressChain returns the chain set on a personal address, or an empty
+// string when it has none
+func (b *Base) getAddressChain(address string, coinType currency.Code) string {
+	b.mtx.RLock()
+	defer b.mtx.RUnlock()
+
+	for i := range b.Addresses {
+		if b.Addresses[i].Address == address && b.Addresses[i].CoinType.Equal(coinType) && b.Addresses[i].Chain != "" {
+			return b.Addresses[i].Chain
+		}
+	}
+	return ""
+}
+
 // GetPortfolioByExchange returns currency portfolio amount by exchange
 func (b *Base) GetPortfolioByExchange(exchangeName string) map[currency.Code]float64 {
 	b.mtx.RLock()
@@ -398,7 +517,11 @@ func getPercentageSpecific(input float64, target currency.Code, totals map[curre
 
 // GetPortfolioSummary returns the complete portfolio summary, showing
 // coin totals, offline and online summaries with their relative percentages.
-func (b *Base) GetPortfolioSummary() Summary {
+// When a valuation currency is supplied, coins are valued in it through the
+// best conversion path of cached exchange tickers and forex rates. Fiat
+// holdings valued in a fiat currency use the stored historical fx rate when
+// one is recent enough
+func (b *Base) GetPortfolioSummary(ctx context.Context, valuation currency.Code) Summary {
 	personalHoldings := b.GetPersonalPortfolio()
 	exchangeHoldings := b.GetExchangePortfolio()
 	totalCoins := maps.Clone(personalHoldings)
@@ -482,9 +605,54 @@ func (b *Base) GetPortfolioSummary() Summary {
 		}
 	}
 	portfolioOutput.OfflineSummary = offlineSummary
+	if !valuation.IsEmpty() {
+		b.valueSummary(ctx, &portfolioOutput, valuation)
+	}
 	return portfolioOutput
 }
 
+// valueSummary values the summary coins in the valuation currency, leaving
+// coins without a conversion path unvalued
+func (b *Base) valueSummary(ctx context.Context, s *Summary, valuation currency.Code) {
+	g := crossrate.GetGraph()
+	now := time.Now()
+	value := func(coins []Coin) {
+		for i := range coins {
+			if coins[i].Coin.IsFiatCurrency() && valuation.IsFiatCurrency() {
+				if rate, err := fxhistory.GetRateAt(ctx, coins[i].Coin, valuation, now); err == nil {
+					coins[i].Value = coins[i].Balance * rate
+					coins[i].Path = &crossrate.Path{
+						From: coins[i].Coin,
+						To:   valuation,
+						Rate: rate,
+						Hops: []crossrate.Hop{{From: coins[i].Coin, To: valuation, Rate: rate, Source: crossrate.ForexSource}},
+					}
+					continue
+				}
+			}
+			v, path, err := g.Convert(coins[i].Balance, coins[i].Coin, valuation)
+			if err != nil {
+				if b.Verbose {
+					log.Debugf(log.PortfolioMgr, "Portfolio unable to value %s in %s: %v", coins[i].Coin, valuation, err)
+				}
+				continue
+			}
+			coins[i].Value = v
+			coins[i].Path = path
+			if b.Verbose {
+				log.Debugf(log.PortfolioMgr, "Portfolio valued %s in %s via %s", coins[i].Coin, valuation, path)
+			}
+		}
+	}
+	value(s.Totals)
+	value(s.Offline)
+	value(s.Online)
+	s.ValuationCurrency = valuation
+	for i := range s.Totals {
+		s.TotalValue += s.Totals[i].Value
+	}
+}
+
 // GetPortfolioAddressesGroupedByCoin returns portfolio addresses grouped by coin
 func (b *Base) GetPortfolioAddressesGroupedByCoin() map[currency.Code][]string {
 	b.mtx.RLock()
@@ -573,11 +741,11 @@ func (b *Base) IsWhiteListed(address string) bool {
 }
 
 // GetProvider returns a provider by name
-func (p providers) GetProvider(name string) (provider, bool) {
+func (p providers) GetProvider(name string) (providerConfig, bool) {
 	for _, provider := range p {
 		if strings.EqualFold(provider.Name, name) {
 			return provider, true
 		}
 	}
-	return provider{}, false
+	return providerConfig{}, false
 }
@@ -8,9 +8,12 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/core"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/currency/crossrate"
+	"github.com/thrasher-corp/gocryptotrader/currency/fxhistory"
 )
 
 const (
@@ -80,7 +83,7 @@ func TestGetAddressBalance(t *testing.T) {
 	)
 
 	b := Base{}
-	assert.NoError(t, b.AddAddress(testLTCAddress, description, currency.LTC, balance))
+	assert.NoError(t, b.AddAddress(testLTCAddress, description, currency.LTC, "", balance))
 
 	r, ok := b.GetAddressBalance("meow", description, currency.LTC)
 	assert.False(t, ok, "GetAddressBalance should return false for non-existent address")
@@ -103,7 +106,7 @@ func TestAddressExists(t *testing.T) {
 	t.Parallel()
 	b := Base{}
 	assert.False(t, b.AddressExists("meow"))
-	assert.NoError(t, b.AddAddress("someaddress", "desc", currency.NewCode("LTCWALLETTEST"), 0.02))
+	assert.NoError(t, b.AddAddress("someaddress", "desc", currency.NewCode("LTCWALLETTEST"), "", 0.02))
 	assert.True(t, b.AddressExists("someaddress"))
 }
 
@@ -132,7 +135,7 @@ func TestAddExchangeAddress(t *testing.T) {
 func TestUpdateAddressBalance(t *testing.T) {
 	t.Parallel()
 	b := Base{}
-	assert.NoError(t, b.AddAddress("someaddress", "desc", currency.LTC, 0.02))
+	assert.NoError(t, b.AddAddress("someaddress", "desc", currency.LTC, "", 0.02))
 	b.UpdateAddressBalance("someaddress", 0.03)
 	bal, ok := b.GetAddressBalance("someaddress", "desc", currency.LTC)
 	assert.True(t, ok, "GetAddressBalance should return true for existing address")
@@ -165,16 +168,24 @@ func TestUpdateExchangeAddressBalance(t *testing.T) {
 func TestAddAddress(t *testing.T) {
 	t.Parallel()
 	b := Base{}
-	assert.ErrorIs(t, b.AddAddress("", "desc", currency.LTC, 0.02), common.ErrAddressIsEmptyOrInvalid)
-	assert.ErrorIs(t, b.AddAddress("someaddress", "", currency.EMPTYCODE, 0.02), currency.ErrCurrencyCodeEmpty)
-	assert.NoError(t, b.AddAddress("okx", ExchangeAddress, currency.LTC, 0.02))
+	assert.ErrorIs(t, b.AddAddress("", "desc", currency.LTC, "", 0.02), common.ErrAddressIsEmptyOrInvalid)
+	assert.ErrorIs(t, b.AddAddress("someaddress", "", currency.EMPTYCODE, "", 0.02), currency.ErrCurrencyCodeEmpty)
+	assert.NoError(t, b.AddAddress("okx", ExchangeAddress, currency.LTC, "", 0.02))
 	assert.True(t, b.ExchangeAddressCoinExists("okx", currency.LTC), "ExchangeAddressCoinExists should return true for an existing address and coin")
-	assert.NoError(t, b.AddAddress("someaddress", PersonalAddress, currency.LTC, 0.03))
+	assert.NoError(t, b.AddAddress("someaddress", PersonalAddress, currency.LTC, "", 0.03))
 	assert.True(t, b.AddressExists("someaddress"), "AddressExists should return true for an existing address")
-	assert.NoError(t, b.AddAddress("someaddress", PersonalAddress, currency.LTC, 69))
+	assert.NoError(t, b.AddAddress("someaddress", PersonalAddress, currency.LTC, "", 69))
 	bal, ok := b.GetAddressBalance("someaddress", PersonalAddress, currency.LTC)
 	assert.True(t, ok, "GetAddressBalance should return true for existing address")
 	assert.Equal(t, 69.0, bal, "GetAddressBalance should return the correct balance")
+	assert.Empty(t, b.getAddressChain("someaddress", currency.LTC), "AddAddress should not set a chain when none is given")
+
+	assert.NoError(t, b.AddAddress("someaddress", PersonalAddress, currency.LTC, "litecoin", 70))
+	assert.Equal(t, "litecoin", b.getAddressChain("someaddress", currency.LTC), "AddAddress should set the chain of an existing address")
+	assert.NoError(t, b.AddAddress("someaddress", PersonalAddress, currency.LTC, "", 71))
+	assert.Equal(t, "litecoin", b.getAddressChain("someaddress", currency.LTC), "AddAddress should keep the chain when none is given")
+	assert.NoError(t, b.AddAddress("tronaddress", PersonalAddress, currency.USDT, "tron", 1))
+	assert.Equal(t, "tron", b.getAddressChain("tronaddress", currency.USDT), "AddAddress should set the chain of a new address")
 }
 
 func TestRemoveAddress(t *testing.T) {
@@ -183,7 +194,7 @@ func TestRemoveAddress(t *testing.T) {
 	assert.ErrorIs(t, b.RemoveAddress("", "desc", currency.LTC), common.ErrAddressIsEmptyOrInvalid)
 	assert.ErrorIs(t, b.RemoveAddress("someaddress", "", currency.EMPTYCODE), currency.ErrCurrencyCodeEmpty)
 	assert.ErrorIs(t, b.RemoveAddress("someaddress", "desc", currency.LTC), errPortfolioItemNotFound)
-	assert.NoError(t, b.AddAddress("someaddress", "desc", currency.LTC, 0.02))
+	assert.NoError(t, b.AddAddress("someaddress", "desc", currency.LTC, "", 0.02))
 	assert.NoError(t, b.RemoveAddress("someaddress", "desc", currency.LTC))
 	assert.False(t, b.AddressExists("someaddress"), "AddressExists should return false for non-existent address")
 }
@@ -209,7 +220,7 @@ func TestUpdatePortfolio(t *testing.T) {
 	assert.ErrorIs(t, b.UpdatePortfolio(t.Context(), []string{testETHAddress}, currency.ADA), currency.ErrCurrencyNotSupported)
 	assert.ErrorIs(t, b.UpdatePortfolio(t.Context(), []string{testBTCAddress}, currency.BTC), errProviderNotFound)
 
-	b.Providers = append(b.Providers, provider{
+	b.Providers = append(b.Providers, providerConfig{
 		Name: "CryptoID",
 	})
 
@@ -230,7 +241,7 @@ func TestGetPortfolioByExchange(t *testing.T) {
 	b := Base{}
 	b.AddExchangeAddress("Okx", currency.LTC, 0.07)
 	b.AddExchangeAddress("Bitfinex", currency.LTC, 0.05)
-	assert.NoError(t, b.AddAddress("someaddress", "LTC", currency.NewCode(PersonalAddress), 0.03))
+	assert.NoError(t, b.AddAddress("someaddress", "LTC", currency.NewCode(PersonalAddress), "", 0.03))
 	assert.Equal(t, 0.07, b.GetPortfolioByExchange("Okx")[currency.LTC], "GetPortfolioByExchange should return the correct balance")
 	assert.Equal(t, 0.05, b.GetPortfolioByExchange("Bitfinex")[currency.LTC], "GetPortfolioByExchange should return the correct balance")
 }
@@ -238,18 +249,18 @@ func TestGetPortfolioByExchange(t *testing.T) {
 func TestGetExchangePortfolio(t *testing.T) {
 	t.Parallel()
 	b := Base{}
-	assert.NoError(t, b.AddAddress("Okx", ExchangeAddress, currency.LTC, 0.03))
-	assert.NoError(t, b.AddAddress("Bitfinex", ExchangeAddress, currency.LTC, 0.05))
-	assert.NoError(t, b.AddAddress("someaddress", PersonalAddress, currency.LTC, 0.03))
+	assert.NoError(t, b.AddAddress("Okx", ExchangeAddress, currency.LTC, "", 0.03))
+	assert.NoError(t, b.AddAddress("Bitfinex", ExchangeAddress, currency.LTC, "", 0.05))
+	assert.NoError(t, b.AddAddress("someaddress", PersonalAddress, currency.LTC, "", 0.03))
 	assert.Equal(t, 0.08, b.GetExchangePortfolio()[currency.LTC], "GetExchangePortfolio should return the correct balance")
 }
 
 func TestGetPersonalPortfolio(t *testing.T) {
 	t.Parallel()
 	b := Base{}
-	assert.NoError(t, b.AddAddress("someaddress", PersonalAddress, currency.WIF, 0.02))
-	assert.NoError(t, b.AddAddress("anotheraddress", PersonalAddress, currency.WIF, 0.03))
-	assert.NoError(t, b.AddAddress("Exchange", ExchangeAddress, currency.WIF, 0.01))
+	assert.NoError(t, b.AddAddress("someaddress", PersonalAddress, currency.WIF, "", 0.02))
+	assert.NoError(t, b.AddAddress("anotheraddress", PersonalAddress, currency.WIF, "", 0.03))
+	assert.NoError(t, b.AddAddress("Exchange", ExchangeAddress, currency.WIF, "", 0.01))
 	assert.Equal(t, 0.05, b.GetPersonalPortfolio()[currency.WIF], "GetPersonalPortfolio should return the correct balance")
 }
 
@@ -257,18 +268,18 @@ func TestGetPortfolioSummary(t *testing.T) {
 	t.Parallel()
 	b := Base{}
 	// Personal holdings
-	assert.NoError(t, b.AddAddress("someaddress", PersonalAddress, currency.LTC, 1))
-	assert.NoError(t, b.AddAddress("someaddress2", PersonalAddress, currency.LTC, 2))
-	assert.NoError(t, b.AddAddress("someaddress3", PersonalAddress, currency.BTC, 100))
-	assert.NoError(t, b.AddAddress("0xde0b295669a9fd93d5f28d9ec85e40f4cb697bae", PersonalAddress, currency.ETH, 69))
-	assert.NoError(t, b.AddAddress("0x9edc81c813b26165f607a8d1b8db87a02f34307f", PersonalAddress, currency.ETH, 420))
+	assert.NoError(t, b.AddAddress("someaddress", PersonalAddress, currency.LTC, "", 1))
+	assert.NoError(t, b.AddAddress("someaddress2", PersonalAddress, currency.LTC, "", 2))
+	assert.NoError(t, b.AddAddress("someaddress3", PersonalAddress, currency.BTC, "", 100))
+	assert.NoError(t, b.AddAddress("0xde0b295669a9fd93d5f28d9ec85e40f4cb697bae", PersonalAddress, currency.ETH, "", 69))
+	assert.NoError(t, b.AddAddress("0x9edc81c813b26165f607a8d1b8db87a02f34307f", PersonalAddress, currency.ETH, "", 420))
 
 	// Exchange holdings
 	b.AddExchangeAddress("Bitfinex", currency.LTC, 20)
 	b.AddExchangeAddress("Bitfinex", currency.BTC, 100)
 	b.AddExchangeAddress("Okx", currency.ETH, 42)
 
-	value := b.GetPortfolioSummary()
+	value := b.GetPortfolioSummary(t.Context(), currency.EMPTYCODE)
 
 	getTotalsVal := func(c currency.Code) Coin {
 		for x := range value.Totals {
@@ -285,11 +296,41 @@ func TestGetPortfolioSummary(t *testing.T) {
 	assert.Equal(t, 69.0+420.0+42, getTotalsVal(currency.ETH).Balance, "ETH balance should be correct")
 }
 
+func TestGetPortfolioSummaryValuation(t *testing.T) {
+	t.Parallel()
+	b := Base{}
+	require.NoError(t, b.AddAddress("someaddress", PersonalAddress, currency.USDT, "", 10), "AddAddress must not error")
+	require.NoError(t, b.AddAddress("someaddress2", PersonalAddress, currency.WIF, "", 3), "AddAddress must not error")
+	require.NoError(t, b.AddAddress("bankaccount", PersonalAddress, currency.CHF, "", 4), "AddAddress must not error")
+	b.AddExchangeAddress("Bitfinex", currency.DAI, 5)
+	require.NoError(t, fxhistory.Add(fxhistory.Rate{From: currency.CHF, To: currency.USD, Rate: 1.25, Time: time.Now().Add(-time.Minute)}), "Add must not error")
+
+	value := b.GetPortfolioSummary(t.Context(), currency.USD)
+	assert.Equal(t, currency.USD, value.ValuationCurrency, "GetPortfolioSummary should set the valuation currency")
+	assert.Equal(t, 20.0, value.TotalValue, "GetPortfolioSummary should total the valued coins")
+	for _, c := range value.Totals {
+		switch {
+		case c.Coin.Equal(currency.USDT):
+			assert.Equal(t, 10.0, c.Value, "USDT should be valued through its peg")
+			require.NotNil(t, c.Path, "Valued coins must return their conversion path")
+			assert.Equal(t, crossrate.PegSource, c.Path.Hops[0].Source, "USDT should be valued through its peg")
+		case c.Coin.Equal(currency.WIF):
+			assert.Zero(t, c.Value, "Coins without a conversion path should not be valued")
+		case c.Coin.Equal(currency.CHF):
+			assert.Equal(t, 5.0, c.Value, "Fiat holdings should be valued with the historical fx rate")
+			require.NotNil(t, c.Path, "Valued coins must return their conversion path")
+			assert.Equal(t, crossrate.ForexSource, c.Path.Hops[0].Source, "Fiat holdings should be valued with a forex rate")
+		}
+	}
+	require.Len(t, value.Online, 1, "Online holdings must be summarised")
+	assert.Equal(t, 5.0, value.Online[0].Value, "Online holdings should be valued")
+}
+
 func TestGetPortfolioAddressesGroupedByCoin(t *testing.T) {
 	t.Parallel()
 	b := Base{}
-	assert.NoError(t, b.AddAddress(testLTCAddress, PersonalAddress, currency.LTC, 0.02))
-	assert.NoError(t, b.AddAddress("Exchange", ExchangeAddress, currency.LTC, 0.03))
+	assert.NoError(t, b.AddAddress(testLTCAddress, PersonalAddress, currency.LTC, "", 0.02))
+	assert.NoError(t, b.AddAddress("Exchange", ExchangeAddress, currency.LTC, "", 0.03))
 	assert.Len(t, b.GetPortfolioAddressesGroupedByCoin(), 1, "GetPortfolioAddressesGroupedByCoin should return the correct number of addresses")
 	assert.Equal(t, testLTCAddress, b.GetPortfolioAddressesGroupedByCoin()[currency.LTC][0], "GetPortfolioAddressesGroupedByCoin should return the correct address")
 }
@@ -348,13 +389,13 @@ func TestStartPortfolioWatcher(t *testing.T) {
 	b := Base{}
 	assert.ErrorIs(t, b.StartPortfolioWatcher(t.Context(), time.Second), errNoPortfolioItemsToWatch)
 
-	assert.NoError(t, b.AddAddress(testXRPAddress, PersonalAddress, currency.XRP, 0.02))
+	assert.NoError(t, b.AddAddress(testXRPAddress, PersonalAddress, currency.XRP, "", 0.02))
 
 	ctx, cancel := context.WithCancel(t.Context())
 	cancel()
 	assert.ErrorIs(t, b.StartPortfolioWatcher(ctx, 0), context.Canceled, "StartPortfolioWatcher should return context.Canceled")
 
-	b.Providers = append(b.Providers, provider{
+	b.Providers = append(b.Providers, providerConfig{
 		Name:    "XRPScan",
 		Enabled: true,
 	})
@@ -392,5 +433,5 @@ func TestGetProvider(t *testing.T) {
 	assert.True(t, p.Enabled, "GetProvider should return the correct provider enabled status")
 	p, ok = b.Providers.GetProvider("NonExistent")
 	assert.False(t, ok, "GetProvider should return false for non-existent provider")
-	assert.Equal(t, provider{}, p, "GetProvider should return an empty provider for non-existent provider")
+	assert.Equal(t, providerConfig{}, p, "GetProvider should return an empty provider for non-existent provider")
 }
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/currency/crossrate"
 	"golang.org/x/time/rate"
 )
 
@@ -17,6 +18,8 @@ type Base struct {
 	mtx                 sync.RWMutex
 	cryptoIDLimiter     *rate.Limiter
 	cryptoIDLimiterOnce sync.Once
+	chainProviders      []Provider
+	chainProvidersOnce  sync.Once
 }
 
 // Address sub type holding address information for portfolio
@@ -24,6 +27,7 @@ type Address struct {
 	Address            string
 	AddressTag         string
 	CoinType           currency.Code
+	Chain              string `json:",omitempty"`
 	Balance            float64
 	Description        string
 	WhiteListed        bool
@@ -108,6 +112,9 @@ type Coin struct {
 	Balance    float64       `json:"balance"`
 	Address    string        `json:"address,omitempty"`
 	Percentage float64       `json:"percentage,omitempty"`
+	Value      float64       `json:"value,omitempty"`
+	// Path is the conversion route the value was found through
+	Path *crossrate.Path `json:"path,omitempty"`
 }
 
 // OfflineCoinSummary stores a coin types address, balance and percentage
@@ -132,6 +139,9 @@ type Summary struct {
 	OfflineSummary map[currency.Code][]OfflineCoinSummary         `json:"offline_summary"`
 	Online         []Coin                                         `json:"coins_online"`
 	OnlineSummary  map[string]map[currency.Code]OnlineCoinSummary `json:"online_summary"`
+	// ValuationCurrency is the currency coin values and TotalValue are in
+	ValuationCurrency currency.Code `json:"valuation_currency,omitzero"`
+	TotalValue        float64       `json:"total_value,omitempty"`
 }
 
 // XRPScanAccount defines the return type for account data
@@ -167,10 +177,22 @@ type AccountInfo struct {
 	Verified    bool   `json:"verified"`
 }
 
-type provider struct {
+// providerConfig holds the config of a balance provider. The built in
+// Ethplorer, XRPScan and CryptoID providers only use the name, enabled and API
+// key, while on-chain providers are set up from the rest by their type
+type providerConfig struct {
 	Name    string `json:"name"`
 	Enabled bool   `json:"enabled"`
 	APIKey  string `json:"apiKey,omitempty"`
+	Type    string `json:"type,omitempty"`
+	// Chain is matched against the chain of portfolio addresses, defaulting to
+	// the lower case provider name
+	Chain string `json:"chain,omitempty"`
+	URL   string `json:"url,omitempty"`
+	// Currency is the native currency of the chain, defaulting by type
+	Currency          currency.Code   `json:"currency,omitzero"`
+	RequestsPerSecond int             `json:"requestsPerSecond,omitempty"`
+	Tokens            []providerToken `json:"tokens,omitempty"`
 }
 
-type providers []provider
+type providers []providerConfig
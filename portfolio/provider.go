@@ -0,0 +1,288 @@
+package portfolio
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
+)
+
+// newProvider sets up the on-chain provider of a config entry, filling in the
+// defaults of its type. Each provider gets its own requester so that its rate
+// limit is kept apart from the other providers
+func newProvider(cfg *providerConfig, verbose bool) (Provider, error) {
+	var defaultURL string
+	var defaultCurrency currency.Code
+	switch strings.ToLower(cfg.Type) {
+	case providerTypeEsplora:
+		defaultURL, defaultCurrency = esploraDefaultURL, currency.BTC
+	case providerTypeSolana:
+		defaultURL, defaultCurrency = solanaDefaultURL, currency.SOL
+	case providerTypeTron:
+		defaultURL, defaultCurrency = tronDefaultURL, currency.TRX
+	case providerTypeEVM:
+		defaultCurrency = currency.ETH
+	default:
+		return nil, fmt.Errorf("%s %w: %q", cfg.Name, errProviderTypeUnknown, cfg.Type)
+	}
+	c := chainProvider{
+		name:    cfg.Name,
+		chain:   cfg.Chain,
+		url:     strings.TrimSuffix(cfg.URL, "/"),
+		apiKey:  cfg.APIKey,
+		native:  cfg.Currency,
+		tokens:  cfg.Tokens,
+		verbose: verbose,
+	}
+	if c.chain == "" {
+		c.chain = strings.ToLower(cfg.Name)
+	}
+	if c.url == "" {
+		c.url = defaultURL
+	}
+	if c.url == "" {
+		return nil, fmt.Errorf("%s %w", cfg.Name, errProviderURLNotSet)
+	}
+	if c.native.IsEmpty() {
+		c.native = defaultCurrency
+	}
+	for i := range c.tokens {
+		if c.tokens[i].Currency.IsEmpty() || c.tokens[i].Contract == "" {
+			return nil, fmt.Errorf("%s %w: %+v", cfg.Name, errInvalidProviderToken, c.tokens[i])
+		}
+	}
+	rps := cfg.RequestsPerSecond
+	if rps <= 0 {
+		rps = defaultProviderRequestsPerSecond
+	}
+	var err error
+	c.requester, err = request.New(cfg.Name,
+		common.NewHTTPClientWithTimeout(providerTimeout),
+		request.WithLimiter(request.NewBasicRateLimit(time.Second, rps, 1)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	switch strings.ToLower(cfg.Type) {
+	case providerTypeEsplora:
+		return &esploraProvider{c}, nil
+	case providerTypeSolana:
+		return &solanaProvider{c}, nil
+	case providerTypeTron:
+		return &tronProvider{c}, nil
+	default:
+		return &evmProvider{c}, nil
+	}
+}
+
+// GetName returns the configured name of the provider
+func (c *chainProvider) GetName() string {
+	return c.name
+}
+
+// GetChain returns the chain the provider serves
+func (c *chainProvider) GetChain() string {
+	return c.chain
+}
+
+// Supports returns whether the provider can fetch balances of a currency
+func (c *chainProvider) Supports(coinType currency.Code) bool {
+	return c.native.Equal(coinType) || c.getToken(coinType) != nil
+}
+
+func (c *chainProvider) getToken(coinType currency.Code) *providerToken {
+	idx := slices.IndexFunc(c.tokens, func(t providerToken) bool {
+		return t.Currency.Equal(coinType)
+	})
+	if idx == -1 {
+		return nil
+	}
+	return &c.tokens[idx]
+}
+
+func (c *chainProvider) errNotSupported(coinType currency.Code) error {
+	return fmt.Errorf("%s %w: %s", c.name, currency.ErrCurrencyNotSupported, coinType)
+}
+
+// sendRequest sends a request through the rate limited requester of the
+// provider, encoding body as JSON when set
+func (c *chainProvider) sendRequest(ctx context.Context, method, path string, headers map[string]string, body, result any) error {
+	var payload []byte
+	if body != nil {
+		var err error
+		if payload, err = json.Marshal(body); err != nil {
+			return err
+		}
+		if headers == nil {
+			headers = make(map[string]string)
+		}
+		headers["Content-Type"] = "application/json"
+	}
+	return c.requester.SendPayload(ctx, request.Unset, func() (*request.Item, error) {
+		item := &request.Item{
+			Method:  method,
+			Path:    path,
+			Headers: headers,
+			Result:  result,
+			Verbose: c.verbose,
+		}
+		if payload != nil {
+			item.Body = bytes.NewReader(payload)
+		}
+		return item, nil
+	}, request.UnauthenticatedRequest)
+}
+
+// callRPC calls a JSON-RPC 2.0 method on the provider URL
+func (c *chainProvider) callRPC(ctx context.Context, method string, params []any, result any) error {
+	var resp jsonRPCResponse
+	if err := c.sendRequest(ctx, http.MethodPost, c.url, nil, &jsonRPCRequest{
+		JSONRPC: "2.0",
+		ID:      1,
+		Method:  method,
+		Params:  params,
+	}, &resp); err != nil {
+		return err
+	}
+	if resp.Error != nil {
+		return fmt.Errorf("%s %s %w: %d %s", c.name, method, errJSONRPCResponse, resp.Error.Code, resp.Error.Message)
+	}
+	return json.Unmarshal(resp.Result, result)
+}
+
+// GetAddressBalance returns the confirmed balance of a Bitcoin style address
+func (p *esploraProvider) GetAddressBalance(ctx context.Context, address string, coinType currency.Code) (float64, error) {
+	if !p.native.Equal(coinType) {
+		return 0, p.errNotSupported(coinType)
+	}
+	var resp esploraAddress
+	if err := p.sendRequest(ctx, http.MethodGet, p.url+"/address/"+url.PathEscape(address), nil, nil, &resp); err != nil {
+		return 0, err
+	}
+	return scaleBalance(big.NewInt(resp.ChainStats.FundedTXOSum-resp.ChainStats.SpentTXOSum), esploraDecimals), nil
+}
+
+// GetAddressBalance returns the SOL or SPL token balance of a Solana address.
+// Token balances are summed across every token account of the owner
+func (p *solanaProvider) GetAddressBalance(ctx context.Context, address string, coinType currency.Code) (float64, error) {
+	if p.native.Equal(coinType) {
+		var resp solanaBalance
+		if err := p.callRPC(ctx, "getBalance", []any{address}, &resp); err != nil {
+			return 0, err
+		}
+		return scaleBalance(new(big.Int).SetUint64(resp.Value), solanaDecimals), nil
+	}
+	token := p.getToken(coinType)
+	if token == nil {
+		return 0, p.errNotSupported(coinType)
+	}
+	var resp solanaTokenAccounts
+	if err := p.callRPC(ctx, "getTokenAccountsByOwner", []any{
+		address,
+		map[string]string{"mint": token.Contract},
+		map[string]string{"encoding": "jsonParsed"},
+	}, &resp); err != nil {
+		return 0, err
+	}
+	var total float64
+	for i := range resp.Value {
+		amount := resp.Value[i].Account.Data.Parsed.Info.TokenAmount
+		v, ok := new(big.Int).SetString(amount.Amount, 10)
+		if !ok {
+			return 0, fmt.Errorf("%s %w: %q", p.name, errInvalidBalance, amount.Amount)
+		}
+		total += scaleBalance(v, amount.Decimals)
+	}
+	return total, nil
+}
+
+// GetAddressBalance returns the TRX or TRC-20 token balance of a Tron address.
+// An address which has not been activated holds nothing
+func (p *tronProvider) GetAddressBalance(ctx context.Context, address string, coinType currency.Code) (float64, error) {
+	token := p.getToken(coinType)
+	if !p.native.Equal(coinType) && token == nil {
+		return 0, p.errNotSupported(coinType)
+	}
+	var headers map[string]string
+	if p.apiKey != "" {
+		headers = map[string]string{"TRON-PRO-API-KEY": p.apiKey}
+	}
+	var resp tronAccounts
+	if err := p.sendRequest(ctx, http.MethodGet, p.url+"/v1/accounts/"+url.PathEscape(address), headers, nil, &resp); err != nil {
+		return 0, err
+	}
+	if !resp.Success {
+		return 0, fmt.Errorf("%s %w: %s", p.name, errJSONRPCResponse, resp.Error)
+	}
+	if len(resp.Data) == 0 {
+		return 0, nil
+	}
+	if token == nil {
+		return scaleBalance(big.NewInt(resp.Data[0].Balance), tronDecimals), nil
+	}
+	for _, holding := range resp.Data[0].TRC20 {
+		if amount, ok := holding[token.Contract]; ok {
+			v, ok := new(big.Int).SetString(amount, 10)
+			if !ok {
+				return 0, fmt.Errorf("%s %w: %q", p.name, errInvalidBalance, amount)
+			}
+			return scaleBalance(v, token.Decimals), nil
+		}
+	}
+	return 0, nil
+}
+
+// GetAddressBalance returns the native or ERC-20 token balance of an EVM
+// address at the latest block
+func (p *evmProvider) GetAddressBalance(ctx context.Context, address string, coinType currency.Code) (float64, error) {
+	address = strings.ToLower(address)
+	if err := common.IsValidCryptoAddress(address, "eth"); err != nil {
+		return 0, err
+	}
+	var result string
+	var decimals uint8
+	if p.native.Equal(coinType) {
+		if err := p.callRPC(ctx, "eth_getBalance", []any{address, "latest"}, &result); err != nil {
+			return 0, err
+		}
+		decimals = evmDecimals
+	} else {
+		token := p.getToken(coinType)
+		if token == nil {
+			return 0, p.errNotSupported(coinType)
+		}
+		call := map[string]string{
+			"to":   token.Contract,
+			"data": erc20BalanceOf + strings.Repeat("0", 24) + strings.TrimPrefix(address, "0x"),
+		}
+		if err := p.callRPC(ctx, "eth_call", []any{call, "latest"}, &result); err != nil {
+			return 0, err
+		}
+		decimals = token.Decimals
+	}
+	v, ok := new(big.Int).SetString(strings.TrimPrefix(result, "0x"), 16)
+	if !ok {
+		return 0, fmt.Errorf("%s %w: %q", p.name, errInvalidBalance, result)
+	}
+	return scaleBalance(v, decimals), nil
+}
+
+// scaleBalance converts an integer amount of the smallest unit of a currency
+// into whole units
+func scaleBalance(v *big.Int, decimals uint8) float64 {
+	f, _ := new(big.Float).Quo(
+		new(big.Float).SetInt(v),
+		new(big.Float).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil)),
+	).Float64()
+	return f
+}
@@ -0,0 +1,283 @@
+package portfolio
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+)
+
+const (
+	testSOLAddress  = "9WzDXwBbmkg8ZTbNMqUxvQRAyrZzDsGYdLVL9zYtAWWM"
+	testTRXAddress  = "TLyqzVGLV1srkB7dToTAEqgDSfPtXRJZYH"
+	testUSDTTRC20   = "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t"
+	testUSDCMint    = "EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v"
+	testUSDTERC20   = "0xdac17f958d2ee523a2206206994597c13d831ec7"
+	testEVMChecksum = "0xB794F5eA0ba39494cE839613fffBA74279579268"
+
+	esploraFixture = `{"address":"1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa","chain_stats":{"funded_txo_count":2,"funded_txo_sum":150000000,"spent_txo_count":1,"spent_txo_sum":50000000,"tx_count":3},"mempool_stats":{"funded_txo_count":0,"funded_txo_sum":0,"spent_txo_count":0,"spent_txo_sum":0,"tx_count":0}}`
+	tronFixture    = `{"data":[{"address":"41","balance":2500000,"trc20":[{"TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t":"12340000"}]}],"success":true,"meta":{"at":1,"page_size":1}}`
+	tronFixtureNew = `{"data":[],"success":true,"meta":{"at":1,"page_size":0}}`
+)
+
+// newProviderTestServer serves JSON-RPC results by method, or REST fixtures
+// by path when no method is sent
+func newProviderTestServer(t *testing.T, fixtures map[string]string) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		key := r.URL.Path
+		if r.Method == http.MethodPost {
+			body, err := io.ReadAll(r.Body)
+			if !assert.NoError(t, err, "ReadAll should not error") {
+				return
+			}
+			var req jsonRPCRequest
+			if !assert.NoError(t, json.Unmarshal(body, &req), "Unmarshal should not error") {
+				return
+			}
+			assert.Equal(t, "2.0", req.JSONRPC, "request should be JSON-RPC 2.0")
+			key = req.Method
+			if req.Method == "eth_call" {
+				call, ok := req.Params[0].(map[string]any)
+				if assert.True(t, ok, "eth_call should send a call object") {
+					key += " " + call["to"].(string) + " " + call["data"].(string)
+				}
+			}
+		}
+		if key == "/v1/accounts/"+testTRXAddress && r.Header.Get("TRON-PRO-API-KEY") != "" {
+			key += " authenticated"
+		}
+		resp, ok := fixtures[key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":"not found"}`))
+			return
+		}
+		_, _ = w.Write([]byte(resp))
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestNewProvider(t *testing.T) {
+	t.Parallel()
+	_, err := newProvider(&providerConfig{Name: "Blockbook", Type: "blockbook"}, false)
+	assert.ErrorIs(t, err, errProviderTypeUnknown)
+	_, err = newProvider(&providerConfig{Name: "Polygon", Type: "evm"}, false)
+	assert.ErrorIs(t, err, errProviderURLNotSet)
+	_, err = newProvider(&providerConfig{Name: "Ethereum", Type: "evm", URL: "http://localhost", Tokens: []providerToken{{Currency: currency.USDT}}}, false)
+	assert.ErrorIs(t, err, errInvalidProviderToken)
+
+	p, err := newProvider(&providerConfig{Name: "Mempool", Type: "Esplora"}, false)
+	require.NoError(t, err, "newProvider must not error")
+	require.IsType(t, &esploraProvider{}, p, "newProvider must return the provider of its type")
+	assert.Equal(t, "Mempool", p.GetName(), "GetName should return the configured name")
+	assert.Equal(t, "mempool", p.GetChain(), "GetChain should default to the lower case name")
+	assert.Equal(t, esploraDefaultURL, p.(*esploraProvider).url, "newProvider should default the URL")
+	assert.True(t, p.Supports(currency.BTC), "Supports should default to the native currency of the type")
+	assert.False(t, p.Supports(currency.LTC), "Supports should return false for other currencies")
+
+	p, err = newProvider(&providerConfig{Name: "Polygon", Type: "evm", Chain: "matic", URL: "http://localhost/", Currency: currency.MATIC}, false)
+	require.NoError(t, err, "newProvider must not error")
+	assert.Equal(t, "matic", p.GetChain(), "GetChain should return the configured chain")
+	assert.Equal(t, "http://localhost", p.(*evmProvider).url, "newProvider should trim the trailing slash")
+	assert.True(t, p.Supports(currency.MATIC), "Supports should return true for the configured currency")
+	assert.False(t, p.Supports(currency.ETH), "Supports should not return true for the default currency once configured")
+}
+
+func TestEsploraProvider(t *testing.T) {
+	t.Parallel()
+	srv := newProviderTestServer(t, map[string]string{"/address/" + testBTCAddress: esploraFixture})
+	p, err := newProvider(&providerConfig{Name: "Esplora", Type: "esplora", URL: srv.URL}, false)
+	require.NoError(t, err, "newProvider must not error")
+
+	balance, err := p.GetAddressBalance(t.Context(), testBTCAddress, currency.BTC)
+	require.NoError(t, err, "GetAddressBalance must not error")
+	assert.Equal(t, 1.0, balance, "GetAddressBalance should return funded less spent outputs")
+	_, err = p.GetAddressBalance(t.Context(), testBTCAddress, currency.LTC)
+	assert.ErrorIs(t, err, currency.ErrCurrencyNotSupported)
+	_, err = p.GetAddressBalance(t.Context(), testLTCAddress, currency.BTC)
+	assert.Error(t, err, "GetAddressBalance should error on a failed request")
+}
+
+func TestSolanaProvider(t *testing.T) {
+	t.Parallel()
+	srv := newProviderTestServer(t, map[string]string{
+		"getBalance": `{"jsonrpc":"2.0","result":{"context":{"slot":1},"value":1500000000},"id":1}`,
+		"getTokenAccountsByOwner": `{"jsonrpc":"2.0","result":{"context":{"slot":1},"value":[` +
+			`{"pubkey":"a","account":{"data":{"parsed":{"info":{"mint":"` + testUSDCMint + `","tokenAmount":{"amount":"2500000","decimals":6,"uiAmount":2.5}}},"program":"spl-token"}}},` +
+			`{"pubkey":"b","account":{"data":{"parsed":{"info":{"mint":"` + testUSDCMint + `","tokenAmount":{"amount":"500000","decimals":6,"uiAmount":0.5}}},"program":"spl-token"}}}]},"id":1}`,
+	})
+	p, err := newProvider(&providerConfig{
+		Name:   "Solana",
+		Type:   "solana",
+		URL:    srv.URL,
+		Tokens: []providerToken{{Currency: currency.USDC, Contract: testUSDCMint, Decimals: 6}},
+	}, false)
+	require.NoError(t, err, "newProvider must not error")
+
+	balance, err := p.GetAddressBalance(t.Context(), testSOLAddress, currency.SOL)
+	require.NoError(t, err, "GetAddressBalance must not error")
+	assert.Equal(t, 1.5, balance, "GetAddressBalance should return the balance in SOL")
+	balance, err = p.GetAddressBalance(t.Context(), testSOLAddress, currency.USDC)
+	require.NoError(t, err, "GetAddressBalance must not error")
+	assert.Equal(t, 3.0, balance, "GetAddressBalance should sum every token account")
+	_, err = p.GetAddressBalance(t.Context(), testSOLAddress, currency.USDT)
+	assert.ErrorIs(t, err, currency.ErrCurrencyNotSupported)
+
+	srv = newProviderTestServer(t, map[string]string{
+		"getBalance": `{"jsonrpc":"2.0","error":{"code":-32602,"message":"Invalid param: WrongSize"},"id":1}`,
+	})
+	p, err = newProvider(&providerConfig{Name: "Solana", Type: "solana", URL: srv.URL}, false)
+	require.NoError(t, err, "newProvider must not error")
+	_, err = p.GetAddressBalance(t.Context(), "bad", currency.SOL)
+	assert.ErrorIs(t, err, errJSONRPCResponse)
+}
+
+func TestTronProvider(t *testing.T) {
+	t.Parallel()
+	srv := newProviderTestServer(t, map[string]string{
+		"/v1/accounts/" + testTRXAddress:                    tronFixtureNew,
+		"/v1/accounts/" + testTRXAddress + " authenticated": tronFixture,
+	})
+	cfg := &providerConfig{
+		Name:   "TronGrid",
+		Type:   "tron",
+		URL:    srv.URL,
+		Tokens: []providerToken{{Currency: currency.USDT, Contract: testUSDTTRC20, Decimals: 6}},
+	}
+	p, err := newProvider(cfg, false)
+	require.NoError(t, err, "newProvider must not error")
+	balance, err := p.GetAddressBalance(t.Context(), testTRXAddress, currency.TRX)
+	require.NoError(t, err, "GetAddressBalance must not error")
+	assert.Zero(t, balance, "GetAddressBalance should return nothing for an address which has not been activated")
+
+	cfg.APIKey = "key"
+	p, err = newProvider(cfg, false)
+	require.NoError(t, err, "newProvider must not error")
+	balance, err = p.GetAddressBalance(t.Context(), testTRXAddress, currency.TRX)
+	require.NoError(t, err, "GetAddressBalance must not error")
+	assert.Equal(t, 2.5, balance, "GetAddressBalance should return the balance in TRX")
+	balance, err = p.GetAddressBalance(t.Context(), testTRXAddress, currency.USDT)
+	require.NoError(t, err, "GetAddressBalance must not error")
+	assert.Equal(t, 12.34, balance, "GetAddressBalance should return the TRC-20 balance")
+	_, err = p.GetAddressBalance(t.Context(), testTRXAddress, currency.USDC)
+	assert.ErrorIs(t, err, currency.ErrCurrencyNotSupported)
+}
+
+func TestEVMProvider(t *testing.T) {
+	t.Parallel()
+	owner := strings.TrimPrefix(testETHAddress, "0x")
+	srv := newProviderTestServer(t, map[string]string{
+		"eth_getBalance": `{"jsonrpc":"2.0","id":1,"result":"0x1bc16d674ec80000"}`,
+		"eth_call " + testUSDTERC20 + " " + erc20BalanceOf + strings.Repeat("0", 24) + owner: `{"jsonrpc":"2.0","id":1,"result":"0x0000000000000000000000000000000000000000000000000000000005f5e100"}`,
+	})
+	p, err := newProvider(&providerConfig{
+		Name:   "Ethereum",
+		Type:   "evm",
+		URL:    srv.URL,
+		Tokens: []providerToken{{Currency: currency.USDT, Contract: testUSDTERC20, Decimals: 6}},
+	}, false)
+	require.NoError(t, err, "newProvider must not error")
+
+	balance, err := p.GetAddressBalance(t.Context(), testEVMChecksum, currency.ETH)
+	require.NoError(t, err, "GetAddressBalance must not error")
+	assert.Equal(t, 2.0, balance, "GetAddressBalance should return the balance in ether")
+	balance, err = p.GetAddressBalance(t.Context(), testETHAddress, currency.USDT)
+	require.NoError(t, err, "GetAddressBalance must not error")
+	assert.Equal(t, 100.0, balance, "GetAddressBalance should return the ERC-20 balance")
+	_, err = p.GetAddressBalance(t.Context(), testBTCAddress, currency.ETH)
+	assert.ErrorIs(t, err, common.ErrAddressIsEmptyOrInvalid)
+	_, err = p.GetAddressBalance(t.Context(), testETHAddress, currency.USDC)
+	assert.ErrorIs(t, err, currency.ErrCurrencyNotSupported)
+}
+
+func TestProviderRateLimit(t *testing.T) {
+	t.Parallel()
+	srv := newProviderTestServer(t, map[string]string{"/address/" + testBTCAddress: esploraFixture})
+	p, err := newProvider(&providerConfig{Name: "Esplora", Type: "esplora", URL: srv.URL, RequestsPerSecond: 10}, false)
+	require.NoError(t, err, "newProvider must not error")
+	start := time.Now()
+	for range 3 {
+		_, err = p.GetAddressBalance(t.Context(), testBTCAddress, currency.BTC)
+		require.NoError(t, err, "GetAddressBalance must not error")
+	}
+	assert.GreaterOrEqual(t, time.Since(start), 150*time.Millisecond, "GetAddressBalance should be held to the rate limit of the provider")
+}
+
+func TestUpdatePortfolioChainProviders(t *testing.T) {
+	t.Parallel()
+	esplora := newProviderTestServer(t, map[string]string{"/address/" + testBTCAddress: esploraFixture})
+	tron := newProviderTestServer(t, map[string]string{"/v1/accounts/" + testTRXAddress + " authenticated": tronFixture})
+	b := Base{
+		Addresses: []Address{
+			{Address: testBTCAddress, CoinType: currency.BTC, Description: PersonalAddress},
+			{Address: testTRXAddress, CoinType: currency.USDT, Description: PersonalAddress, Chain: "tron"},
+		},
+		Providers: providers{
+			{Name: "Esplora", Enabled: true, Type: "esplora", URL: esplora.URL},
+			{Name: "Ethereum", Enabled: true, Type: "evm", URL: "http://localhost", Tokens: []providerToken{{Currency: currency.USDT, Contract: testUSDTERC20, Decimals: 6}}},
+			{Name: "Tron", Enabled: true, Type: "tron", URL: tron.URL, APIKey: "key", Tokens: []providerToken{{Currency: currency.USDT, Contract: testUSDTTRC20, Decimals: 6}}},
+			{Name: "Broken", Enabled: true, Type: "unknown"},
+			{Name: "Disabled", Type: "solana"},
+		},
+	}
+	require.NoError(t, b.UpdatePortfolio(t.Context(), []string{testBTCAddress}, currency.BTC), "UpdatePortfolio must not error")
+	balance, ok := b.GetAddressBalance(testBTCAddress, PersonalAddress, currency.BTC)
+	require.True(t, ok, "GetAddressBalance must find the address")
+	assert.Equal(t, 1.0, balance, "UpdatePortfolio should use the provider supporting the currency")
+
+	require.NoError(t, b.UpdatePortfolio(t.Context(), []string{testTRXAddress}, currency.USDT), "UpdatePortfolio must not error")
+	balance, ok = b.GetAddressBalance(testTRXAddress, PersonalAddress, currency.USDT)
+	require.True(t, ok, "GetAddressBalance must find the address")
+	assert.Equal(t, 12.34, balance, "UpdatePortfolio should use the provider of the address chain")
+	assert.Len(t, b.getChainProviders(), 3, "getChainProviders should leave out disabled providers and those which cannot be set up")
+
+	assert.ErrorIs(t, b.UpdatePortfolio(t.Context(), []string{testXRPAddress}, currency.XRP), errProviderNotFound, "UpdatePortfolio should fall back to the built in providers")
+}
+
+type testProvider struct {
+	name    string
+	chain   string
+	balance float64
+}
+
+func (p *testProvider) GetName() string { return p.name }
+
+func (p *testProvider) GetChain() string { return p.chain }
+
+func (p *testProvider) Supports(c currency.Code) bool { return c.Equal(currency.DOGE) }
+
+func (p *testProvider) GetAddressBalance(context.Context, string, currency.Code) (float64, error) {
+	return p.balance, nil
+}
+
+func TestRegisterProvider(t *testing.T) {
+	t.Parallel()
+	b := Base{
+		Providers: providers{
+			{Name: "Esplora", Enabled: true, Type: "esplora", URL: "http://localhost"},
+		},
+	}
+	require.ErrorIs(t, b.RegisterProvider(nil), common.ErrNilPointer, "RegisterProvider must error on a nil provider")
+	require.ErrorIs(t, b.RegisterProvider(&testProvider{name: "esplora"}), errProviderAlreadyExists, "RegisterProvider must error on a name already set up from config")
+	require.NoError(t, b.RegisterProvider(&testProvider{name: "Dogechain", chain: "dogecoin", balance: 42}), "RegisterProvider must not error")
+	require.ErrorIs(t, b.RegisterProvider(&testProvider{name: "DOGECHAIN"}), errProviderAlreadyExists, "RegisterProvider must error on a name already registered")
+	assert.Len(t, b.getChainProviders(), 2, "getChainProviders should return the configured and registered providers")
+
+	require.NoError(t, b.AddAddress("DH5yaieqoZN36fDVciNyRueRGvGLR3mr7L", PersonalAddress, currency.DOGE, "dogecoin", 0), "AddAddress must not error")
+	require.NoError(t, b.UpdatePortfolio(t.Context(), []string{"DH5yaieqoZN36fDVciNyRueRGvGLR3mr7L"}, currency.DOGE), "UpdatePortfolio must not error")
+	balance, ok := b.GetAddressBalance("DH5yaieqoZN36fDVciNyRueRGvGLR3mr7L", PersonalAddress, currency.DOGE)
+	require.True(t, ok, "GetAddressBalance must find the address")
+	assert.Equal(t, 42.0, balance, "UpdatePortfolio should use the registered provider")
+}
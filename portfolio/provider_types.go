@@ -0,0 +1,151 @@
+package portfolio
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
+)
+
+// On-chain provider types, set as the type of a provider in config
+const (
+	providerTypeEsplora = "esplora"
+	providerTypeSolana  = "solana"
+	providerTypeTron    = "tron"
+	providerTypeEVM     = "evm"
+)
+
+const (
+	esploraDefaultURL = "https://blockstream.info/api"
+	solanaDefaultURL  = "https://api.mainnet-beta.solana.com"
+	tronDefaultURL    = "https://api.trongrid.io"
+
+	esploraDecimals = 8
+	solanaDecimals  = 9
+	tronDecimals    = 6
+	evmDecimals     = 18
+
+	// defaultProviderRequestsPerSecond is conservative enough for the free
+	// tiers of public block explorers and nodes
+	defaultProviderRequestsPerSecond = 5
+	providerTimeout                  = 15 * time.Second
+
+	// erc20BalanceOf is the selector of the ERC-20 balanceOf(address) call
+	erc20BalanceOf = "0x70a08231"
+)
+
+var (
+	errProviderTypeUnknown  = errors.New("unknown provider type")
+	errProviderURLNotSet    = errors.New("provider URL not set")
+	errInvalidProviderToken = errors.New("invalid provider token")
+	errJSONRPCResponse      = errors.New("JSON-RPC error response")
+	errInvalidBalance       = errors.New("invalid balance")
+)
+
+// Provider fetches the on-chain balance of an address from a block explorer or
+// node. Portfolio addresses pick a provider by chain, or use the first which
+// supports their currency when they have no chain set. Providers beyond the
+// configured types can be added with Base.RegisterProvider
+type Provider interface {
+	GetName() string
+	GetChain() string
+	Supports(currency.Code) bool
+	GetAddressBalance(ctx context.Context, address string, coinType currency.Code) (float64, error)
+}
+
+// providerToken is a token held on a chain, such as an ERC-20 or TRC-20
+// contract or a Solana mint
+type providerToken struct {
+	Currency currency.Code `json:"currency"`
+	Contract string        `json:"contract"`
+	Decimals uint8         `json:"decimals"`
+}
+
+// chainProvider holds the config and rate limited requester shared by the
+// on-chain providers
+type chainProvider struct {
+	name      string
+	chain     string
+	url       string
+	apiKey    string
+	native    currency.Code
+	tokens    []providerToken
+	verbose   bool
+	requester *request.Requester
+}
+
+type esploraProvider struct{ chainProvider }
+
+type solanaProvider struct{ chainProvider }
+
+type tronProvider struct{ chainProvider }
+
+type evmProvider struct{ chainProvider }
+
+// esploraAddress is the Esplora address endpoint response
+type esploraAddress struct {
+	Address    string       `json:"address"`
+	ChainStats esploraStats `json:"chain_stats"`
+}
+
+// esploraStats holds the confirmed outputs funding and spent by an address, in
+// satoshis
+type esploraStats struct {
+	FundedTXOSum int64 `json:"funded_txo_sum"`
+	SpentTXOSum  int64 `json:"spent_txo_sum"`
+	TXCount      int64 `json:"tx_count"`
+}
+
+type jsonRPCRequest struct {
+	JSONRPC string `json:"jsonrpc"`
+	ID      int64  `json:"id"`
+	Method  string `json:"method"`
+	Params  []any  `json:"params"`
+}
+
+type jsonRPCResponse struct {
+	Result json.RawMessage `json:"result"`
+	Error  *jsonRPCError   `json:"error"`
+}
+
+type jsonRPCError struct {
+	Code    int64  `json:"code"`
+	Message string `json:"message"`
+}
+
+// solanaBalance is the getBalance result, in lamports
+type solanaBalance struct {
+	Value uint64 `json:"value"`
+}
+
+// solanaTokenAccounts is the jsonParsed getTokenAccountsByOwner result
+type solanaTokenAccounts struct {
+	Value []struct {
+		Account struct {
+			Data struct {
+				Parsed struct {
+					Info struct {
+						TokenAmount struct {
+							Amount   string `json:"amount"`
+							Decimals uint8  `json:"decimals"`
+						} `json:"tokenAmount"`
+					} `json:"info"`
+				} `json:"parsed"`
+			} `json:"data"`
+		} `json:"account"`
+	} `json:"value"`
+}
+
+// tronAccounts is the TronGrid account endpoint response. Data is empty for an
+// address which has not been activated
+type tronAccounts struct {
+	Data []struct {
+		Balance int64               `json:"balance"`
+		TRC20   []map[string]string `json:"trc20"`
+	} `json:"data"`
+	Success bool   `json:"success"`
+	Error   string `json:"error"`
+}
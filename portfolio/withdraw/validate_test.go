@@ -103,7 +103,7 @@ var (
 
 func TestMain(m *testing.M) {
 	var p portfolio.Base
-	err := p.AddAddress(core.BitcoinDonationAddress, "test", currency.BTC, 1500)
+	err := p.AddAddress(core.BitcoinDonationAddress, "test", currency.BTC, "", 1500)
 	if err != nil {
 		fmt.Printf("failed to add portfolio address with reason: %v, unable to continue tests", err)
 		os.Exit(0)
@@ -112,7 +112,7 @@ func TestMain(m *testing.M) {
 	p.Addresses[0].ColdStorage = true
 	p.Addresses[0].SupportedExchanges = "BTC Markets,Binance"
 
-	err = p.AddAddress(testBTCAddress, "test", currency.BTC, 1500)
+	err = p.AddAddress(testBTCAddress, "test", currency.BTC, "", 1500)
 	if err != nil {
 		fmt.Printf("failed to add portfolio address with reason: %v, unable to continue tests", err)
 		os.Exit(0)
@@ -127,6 +127,10 @@ type Request struct {
 	Travel TravelRule `json:"travel_rule"`
 
 	IdempotencyToken string
+
+	// RequestedBy names who requested the withdrawal, who cannot also approve
+	// it when approvals are required
+	RequestedBy string `json:"requested_by"`
 }
 
 // Response holds complete details for Response